@@ -25,6 +25,7 @@ import (
 	"github.com/abcsuite/abcwallet/rpc/legacyrpc"
 	"github.com/abcsuite/abcwallet/rpc/rpcserver"
 	"github.com/abcsuite/abcwallet/wallet"
+	"github.com/abcsuite/abcwallet/wallet/txauthor"
 )
 
 var (
@@ -106,6 +107,32 @@ func walletMain() error {
 	}
 	loader := ldr.NewLoader(activeNet.Params, dbDir, stakeOptions,
 		cfg.AddrIdxScanLen, cfg.AllowHighFees, cfg.RelayFee.ToCoin())
+	if cfg.MemoryDB {
+		loader.UseMemoryDB()
+	}
+
+	// Set the default coin selection strategy of the wallet once loaded.
+	if cfg.CoinSelection != "" {
+		strategy, _ := txauthor.ParseCoinSelectionStrategy(cfg.CoinSelection)
+		loader.RunAfterLoad(func(w *wallet.Wallet) {
+			w.SetCoinSelectionStrategy(strategy)
+		})
+	}
+
+	// Set the default expiry of created transactions once loaded.
+	if cfg.TxExpiry != 0 {
+		loader.RunAfterLoad(func(w *wallet.Wallet) {
+			w.SetDefaultTxExpiry(cfg.TxExpiry)
+		})
+	}
+
+	// Check the wallet database and repair any fixable problems before it is
+	// used if automatic repair is enabled.
+	if cfg.AutomaticRepair {
+		loader.RunAfterLoad(func(w *wallet.Wallet) {
+			checkConsistency(w, true)
+		})
+	}
 
 	passphrase := []byte{}
 	if !cfg.NoInitialLoad {
@@ -126,8 +153,9 @@ func walletMain() error {
 		}
 
 		// Load the wallet database.  It must have been created already
-		// or this will return an appropriate error.
-		w, err := loader.OpenExistingWallet(walletPass, true)
+		// or this will return an appropriate error.  Temporary wallets
+		// kept in memory are instead created now if they do not exist.
+		w, err := openWallet(loader, walletPass)
 		if err != nil {
 			log.Errorf("Open failed: %v", err)
 			return err
@@ -157,8 +185,8 @@ func walletMain() error {
 	}
 
 	// Create and start chain RPC client so it's ready to connect to
-	// the wallet when loaded later.
-	if !cfg.NoInitialLoad {
+	// the wallet when loaded later.  Offline wallets never connect.
+	if !cfg.NoInitialLoad && !cfg.Offline {
 		go rpcClientConnectLoop(passphrase, legacyRPCServer, loader)
 	}
 
@@ -171,6 +199,21 @@ func walletMain() error {
 		})
 	}
 
+	// Periodically back up the wallet once it is loaded if a backup directory
+	// is configured.
+	if cfg.BackupDir != "" {
+		backupSchedule := &ldr.BackupSchedule{
+			Dir:       cfg.BackupDir,
+			Interval:  cfg.BackupInterval,
+			Retention: cfg.BackupRetention,
+		}
+		quitBackups := make(chan struct{})
+		go loader.RunBackupSchedule(backupSchedule, quitBackups)
+		addInterruptHandler(func() {
+			close(quitBackups)
+		})
+	}
+
 	if cfg.PipeRx != nil {
 		go serviceControlPipeRx(uintptr(*cfg.PipeRx))
 	}
@@ -182,6 +225,9 @@ func walletMain() error {
 		// The only possible err here is ErrTicketBuyerStopped, which can be
 		// safely ignored.
 		_ = loader.StopTicketPurchase()
+		if w, ok := loader.LoadedWallet(); ok && cfg.RollbackTest {
+			checkConsistency(w, false)
+		}
 		err := loader.UnloadWallet()
 		if err != nil && err != ldr.ErrWalletNotLoaded {
 			log.Errorf("Failed to close wallet: %v", err)
@@ -213,6 +259,53 @@ func walletMain() error {
 	return nil
 }
 
+// openWallet opens the existing wallet, or creates a temporary simulation wallet
+// when a wallet kept in memory is requested with --createtemp and no wallet
+// database exists.
+func openWallet(loader *ldr.Loader, walletPass []byte) (*wallet.Wallet, error) {
+	if cfg.CreateTemp && cfg.MemoryDB {
+		exists, err := loader.WalletExists()
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			log.Infof("Creating a temporary simulation wallet in memory")
+			pubPass := []byte(wallet.InsecurePubPassphrase)
+			return loader.CreateNewWallet(pubPass, wallet.SimulationPassphrase, nil)
+		}
+	}
+	return loader.OpenExistingWallet(walletPass, true)
+}
+
+// checkConsistency checks the consistency of the wallet database, optionally
+// repairing fixable problems, and logs all found problems.
+func checkConsistency(w *wallet.Wallet, repair bool) {
+	log.Info("Checking wallet database consistency")
+	report, err := w.CheckConsistency(repair)
+	if err != nil {
+		log.Errorf("Failed to check wallet database consistency: %v", err)
+		return
+	}
+	for _, p := range report.Problems {
+		switch {
+		case p.Fixed:
+			log.Warnf("Repaired %s problem: %s", p.Check, p.Description)
+		case p.Fixable:
+			log.Warnf("Repairable %s problem: %s", p.Check, p.Description)
+		default:
+			log.Errorf("Unrepairable %s problem: %s", p.Check, p.Description)
+		}
+	}
+	if report.Consistent() {
+		log.Infof("Wallet database is consistent (%d blocks, %d transactions, "+
+			"%d credits, %d debits)", report.Blocks, report.TxRecords,
+			report.Credits, report.Debits)
+	} else {
+		log.Errorf("Wallet database is inconsistent: %d problems found",
+			len(report.Problems))
+	}
+}
+
 // startPromptPass prompts the user for a password to unlock their wallet in
 // the event that it was restored from seed or --promptpass flag is set.
 func startPromptPass(w *wallet.Wallet) []byte {
@@ -0,0 +1,414 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Command airgap moves funds from a wallet whose keys are kept on a computer
+// without network access.  A watching-only wallet for the offline account
+// creates an unsigned transaction file, the offline wallet (started with
+// --offline) describes and signs it, and an online wallet publishes the
+// signed transaction.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/abcsuite/abcd/wire"
+	"github.com/abcsuite/abcrpcclient"
+	"github.com/abcsuite/abcutil"
+	"github.com/abcsuite/abcwallet/internal/cfgutil"
+	"github.com/abcsuite/abcwallet/netparams"
+	"github.com/abcsuite/abcwallet/rpc/walletjson"
+	"github.com/jessevdk/go-flags"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+var (
+	walletDataDirectory = abcutil.AppDataDir("abcwallet", false)
+	newlineBytes        = []byte{'\n'}
+)
+
+func fatalf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format, args...)
+	os.Stderr.Write(newlineBytes)
+	os.Exit(1)
+}
+
+func errContext(err error, context string) error {
+	return fmt.Errorf("%s: %v", context, err)
+}
+
+const usage = `create|sign|publish
+
+  create   Create an unsigned transaction file using a watching-only wallet
+  sign     Describe and sign a transaction file using an offline wallet
+  publish  Publish a signed transaction file using an online wallet`
+
+// Flags.
+var opts = struct {
+	TestNet               bool     `long:"testnet" description:"Use the test aero network"`
+	SimNet                bool     `long:"simnet" description:"Use the simulation aero network"`
+	RPCConnect            string   `short:"c" long:"connect" description:"Hostname[:port] of wallet RPC server"`
+	RPCUsername           string   `short:"u" long:"rpcuser" description:"Wallet RPC username"`
+	RPCCertificateFile    string   `long:"cafile" description:"Wallet RPC TLS certificate"`
+	Account               string   `long:"account" description:"Account to spend outputs from (create)"`
+	Payments              []string `long:"pay" description:"Payment of the form address:amount, may be repeated (create)"`
+	RequiredConfirmations int      `long:"minconf" description:"Required confirmations to include an output (create)"`
+	InputFile             string   `short:"i" long:"in" description:"Transaction file to read (sign, publish)"`
+	OutputFile            string   `short:"o" long:"out" description:"Transaction file to write (create, sign)"`
+	Yes                   bool     `short:"y" long:"yes" description:"Sign without asking for confirmation (sign)"`
+}{
+	RPCConnect:            "localhost",
+	RPCCertificateFile:    filepath.Join(walletDataDirectory, "rpc.cert"),
+	Account:               "default",
+	RequiredConfirmations: 1,
+}
+
+// action is the first non-flag argument.
+var action string
+
+// Parse and validate flags.
+func init() {
+	// Unset localhost defaults if certificate file can not be found.
+	certFileExists, err := cfgutil.FileExists(opts.RPCCertificateFile)
+	if err != nil {
+		fatalf("%v", err)
+	}
+	if !certFileExists {
+		opts.RPCConnect = ""
+		opts.RPCCertificateFile = ""
+	}
+
+	parser := flags.NewParser(&opts, flags.Default)
+	parser.Usage = usage
+	args, err := parser.Parse()
+	if err != nil {
+		os.Exit(1)
+	}
+	if len(args) != 1 {
+		parser.WriteHelp(os.Stderr)
+		os.Exit(1)
+	}
+	action = args[0]
+
+	if opts.TestNet && opts.SimNet {
+		fatalf("Multiple aero networks may not be used simultaneously")
+	}
+	var activeNet = &netparams.MainNetParams
+	if opts.TestNet {
+		activeNet = &netparams.TestNet2Params
+	} else if opts.SimNet {
+		activeNet = &netparams.SimNetParams
+	}
+
+	switch action {
+	case "create":
+		if len(opts.Payments) == 0 {
+			fatalf("At least one payment is required")
+		}
+		if opts.RequiredConfirmations < 0 {
+			fatalf("Required confirmations must be non-negative")
+		}
+		if opts.OutputFile == "" {
+			opts.OutputFile = "unsigned.pstx"
+		}
+	case "sign":
+		if opts.InputFile == "" {
+			opts.InputFile = "unsigned.pstx"
+		}
+		if opts.OutputFile == "" {
+			opts.OutputFile = "signed.tx"
+		}
+	case "publish":
+		if opts.InputFile == "" {
+			opts.InputFile = "signed.tx"
+		}
+	default:
+		fatalf("Unknown action `%s`, must be one of create, sign, or publish", action)
+	}
+
+	if opts.RPCConnect == "" {
+		fatalf("RPC hostname[:port] is required")
+	}
+	rpcConnect, err := cfgutil.NormalizeAddress(opts.RPCConnect, activeNet.JSONRPCServerPort)
+	if err != nil {
+		fatalf("Invalid RPC network address `%v`: %v", opts.RPCConnect, err)
+	}
+	opts.RPCConnect = rpcConnect
+
+	if opts.RPCUsername == "" {
+		fatalf("RPC username is required")
+	}
+
+	certFileExists, err = cfgutil.FileExists(opts.RPCCertificateFile)
+	if err != nil {
+		fatalf("%v", err)
+	}
+	if !certFileExists {
+		fatalf("RPC certificate file `%s` not found", opts.RPCCertificateFile)
+	}
+}
+
+func main() {
+	err := run()
+	if err != nil {
+		fatalf("%v", err)
+	}
+}
+
+func run() error {
+	rpcPassword, err := promptSecret("Wallet RPC password")
+	if err != nil {
+		return errContext(err, "failed to read RPC password")
+	}
+
+	// Open RPC client.
+	rpcCertificate, err := ioutil.ReadFile(opts.RPCCertificateFile)
+	if err != nil {
+		return errContext(err, "failed to read RPC certificate")
+	}
+	rpcClient, err := abcrpcclient.New(&abcrpcclient.ConnConfig{
+		Host:         opts.RPCConnect,
+		User:         opts.RPCUsername,
+		Pass:         rpcPassword,
+		Certificates: rpcCertificate,
+		HTTPPostMode: true,
+	}, nil)
+	if err != nil {
+		return errContext(err, "failed to create RPC client")
+	}
+	defer rpcClient.Shutdown()
+
+	switch action {
+	case "create":
+		return create(rpcClient)
+	case "sign":
+		return sign(rpcClient)
+	default:
+		return publish(rpcClient)
+	}
+}
+
+// request performs a wallet RPC which is not provided by the RPC client and
+// decodes the result into res.
+func request(rpcClient *abcrpcclient.Client, res interface{}, method string,
+	params ...interface{}) error {
+
+	rawParams := make([]json.RawMessage, 0, len(params))
+	for _, param := range params {
+		rawParam, err := json.Marshal(param)
+		if err != nil {
+			return err
+		}
+		rawParams = append(rawParams, rawParam)
+	}
+	rawRes, err := rpcClient.RawRequest(method, rawParams)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(rawRes, res)
+}
+
+// create creates the unsigned transaction file using the watching-only wallet.
+func create(rpcClient *abcrpcclient.Client) error {
+	amounts := make(map[string]float64, len(opts.Payments))
+	for _, payment := range opts.Payments {
+		i := strings.LastIndexByte(payment, ':')
+		if i == -1 {
+			return fmt.Errorf("payment `%s` is not of the form address:amount", payment)
+		}
+		addr := payment[:i]
+		amount, err := strconv.ParseFloat(payment[i+1:], 64)
+		if err != nil {
+			return errContext(err, fmt.Sprintf("invalid amount in payment `%s`", payment))
+		}
+		if _, ok := amounts[addr]; ok {
+			return fmt.Errorf("address `%s` is paid more than once", addr)
+		}
+		amounts[addr] = amount
+	}
+
+	var hexStr string
+	err := request(rpcClient, &hexStr, "createpartialtransaction", opts.Account,
+		amounts, opts.RequiredConfirmations)
+	if err != nil {
+		return errContext(err, "failed to create transaction")
+	}
+	err = writeHexFile(opts.OutputFile, hexStr)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Wrote unsigned transaction to %s\n", opts.OutputFile)
+	return nil
+}
+
+// sign describes the unsigned transaction file, and after confirmation, signs
+// it using the offline wallet.
+func sign(rpcClient *abcrpcclient.Client) error {
+	hexStr, err := readHexFile(opts.InputFile)
+	if err != nil {
+		return err
+	}
+
+	var desc walletjson.DescribePartialTransactionResult
+	err = request(rpcClient, &desc, "describepartialtransaction", hexStr)
+	if err != nil {
+		return errContext(err, "failed to verify transaction")
+	}
+	printDescription(&desc)
+	signable := false
+	for i := range desc.Inputs {
+		signable = signable || desc.Inputs[i].Signable
+	}
+	if !signable {
+		return fmt.Errorf("no inputs may be signed by this wallet")
+	}
+	if !opts.Yes {
+		ok, err := promptConfirm("Sign this transaction")
+		if err != nil {
+			return errContext(err, "failed to read confirmation")
+		}
+		if !ok {
+			return fmt.Errorf("transaction was not signed")
+		}
+	}
+
+	privatePassphrase, err := promptSecret("Wallet private passphrase")
+	if err != nil {
+		return errContext(err, "failed to read private passphrase")
+	}
+
+	// Unlock the wallet, sign the transaction, and immediately lock.
+	err = rpcClient.WalletPassphrase(privatePassphrase, 60)
+	if err != nil {
+		return errContext(err, "failed to unlock wallet")
+	}
+	var res walletjson.SignPartialTransactionResult
+	err = request(rpcClient, &res, "signpartialtransaction", hexStr)
+	_ = rpcClient.WalletLock()
+	if err != nil {
+		return errContext(err, "failed to sign transaction")
+	}
+
+	if !res.Complete {
+		// Other keys must still sign, so write the partially signed
+		// transaction to be signed again.
+		err = writeHexFile(opts.OutputFile, res.Hex)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Signed %d inputs, but the transaction requires more "+
+			"signatures.  Wrote partially signed transaction to %s\n",
+			len(res.SignedInputs), opts.OutputFile)
+		return nil
+	}
+	err = writeHexFile(opts.OutputFile, res.SignedTx)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Wrote signed transaction %s to %s\n", desc.Txid, opts.OutputFile)
+	return nil
+}
+
+// publish publishes the signed transaction file using the online wallet.
+func publish(rpcClient *abcrpcclient.Client) error {
+	hexStr, err := readHexFile(opts.InputFile)
+	if err != nil {
+		return err
+	}
+	serializedTx, err := hex.DecodeString(hexStr)
+	if err != nil {
+		return errContext(err, "failed to decode transaction")
+	}
+	var tx wire.MsgTx
+	err = tx.Deserialize(bytes.NewReader(serializedTx))
+	if err != nil {
+		return errContext(err, "failed to decode transaction (was it signed?)")
+	}
+
+	txHash, err := rpcClient.SendRawTransaction(&tx, false)
+	if err != nil {
+		return errContext(err, "failed to publish transaction")
+	}
+	fmt.Printf("Published transaction %v\n", txHash)
+	return nil
+}
+
+func printDescription(desc *walletjson.DescribePartialTransactionResult) {
+	fmt.Printf("Transaction %s\n\n", desc.Txid)
+	fmt.Printf("Inputs:\n")
+	for _, in := range desc.Inputs {
+		fmt.Printf("  %v  %s:%d\n", amount(in.Amount), in.Txid, in.Vout)
+	}
+	fmt.Printf("Outputs:\n")
+	for _, out := range desc.Outputs {
+		dest := strings.Join(out.Addresses, ", ")
+		if dest == "" {
+			dest = "script " + out.ScriptPubKey
+		}
+		if out.Owned {
+			dest += " (this wallet)"
+		}
+		fmt.Printf("  %v  %s\n", amount(out.Amount), dest)
+	}
+	fmt.Printf("\nTotal input:  %v\n", amount(desc.TotalInput))
+	fmt.Printf("Total output: %v\n", amount(desc.TotalOutput))
+	fmt.Printf("Fee:          %v (%v/kB, estimated size %d bytes)\n\n",
+		amount(desc.Fee), amount(desc.FeeRate), desc.EstimatedSize)
+}
+
+// amount converts a JSON amount to an abcutil.Amount for printing.  Amounts
+// are validated by the wallet, so conversion errors are not expected.
+func amount(f float64) abcutil.Amount {
+	a, _ := abcutil.NewAmount(f)
+	return a
+}
+
+func readHexFile(name string) (string, error) {
+	b, err := ioutil.ReadFile(name)
+	if err != nil {
+		return "", errContext(err, "failed to read transaction file")
+	}
+	return strings.TrimSpace(string(b)), nil
+}
+
+func writeHexFile(name, hexStr string) error {
+	err := ioutil.WriteFile(name, []byte(hexStr+"\n"), 0644)
+	if err != nil {
+		return errContext(err, "failed to write transaction file")
+	}
+	return nil
+}
+
+func promptSecret(what string) (string, error) {
+	fmt.Printf("%s: ", what)
+	fd := int(os.Stdin.Fd())
+	input, err := terminal.ReadPassword(fd)
+	fmt.Println()
+	if err != nil {
+		return "", err
+	}
+	return string(input), nil
+}
+
+func promptConfirm(what string) (bool, error) {
+	fmt.Printf("%s? [y/N]: ", what)
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil {
+		return false, err
+	}
+	switch strings.ToLower(strings.TrimSpace(line)) {
+	case "y", "yes":
+		return true, nil
+	default:
+		return false, nil
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/abcsuite/abcutil"
+	"github.com/abcsuite/abcwallet/netparams"
+	"github.com/abcsuite/abcwallet/wallet"
+	"github.com/abcsuite/abcwallet/wallet/udb"
+	"github.com/abcsuite/abcwallet/walletdb"
+	_ "github.com/abcsuite/abcwallet/walletdb/bdb"
+	"github.com/abcsuite/abcwallet/walletdb/encdb"
+	"github.com/jessevdk/go-flags"
+)
+
+var (
+	walletDataDirectory = abcutil.AppDataDir("abcwallet", false)
+	newlineBytes        = []byte{'\n'}
+)
+
+func fatalf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format, args...)
+	os.Stderr.Write(newlineBytes)
+	os.Exit(1)
+}
+
+func errContext(err error, context string) error {
+	return fmt.Errorf("%s: %v", context, err)
+}
+
+// Flags.
+var opts = struct {
+	TestNet bool   `long:"testnet" description:"Use the test aero network"`
+	SimNet  bool   `long:"simnet" description:"Use the simulation aero network"`
+	DbPath  string `long:"db" description:"Path to wallet database (default: wallet.db in the network directory of the abcwallet data directory)"`
+	PubPass string `long:"pubpass" description:"Public passphrase of the wallet"`
+	Repair  bool   `long:"repair" description:"Repair problems which can be fixed using the other records of the database"`
+}{
+	TestNet: false,
+	SimNet:  false,
+	DbPath:  "",
+	PubPass: wallet.InsecurePubPassphrase,
+	Repair:  false,
+}
+
+var activeNet = &netparams.MainNetParams
+
+// Parse and validate flags.
+func init() {
+	_, err := flags.Parse(&opts)
+	if err != nil {
+		os.Exit(1)
+	}
+
+	if opts.TestNet && opts.SimNet {
+		fatalf("Multiple aero networks may not be used simultaneously")
+	}
+	netDir := activeNet.Name
+	if opts.TestNet {
+		activeNet = &netparams.TestNet2Params
+		netDir = "testnet2"
+	} else if opts.SimNet {
+		activeNet = &netparams.SimNetParams
+		netDir = activeNet.Name
+	}
+
+	if opts.DbPath == "" {
+		opts.DbPath = filepath.Join(walletDataDirectory, netDir, "wallet.db")
+	}
+}
+
+func main() {
+	consistent, err := check()
+	if err != nil {
+		fatalf("%v", err)
+	}
+	if !consistent {
+		os.Exit(1)
+	}
+}
+
+func check() (consistent bool, err error) {
+	if _, err := os.Stat(opts.DbPath); err != nil {
+		return false, errContext(err, "failed to find wallet database")
+	}
+
+	// The database is only opened for writes when repairing it.  The wallet
+	// process must not be running as the database is locked while it is open.
+	db, err := walletdb.Open("bdb", opts.DbPath, !opts.Repair)
+	if err != nil {
+		return false, errContext(err, "failed to open wallet database")
+	}
+	defer db.Close()
+
+	// Databases encrypted by the public passphrase must be wrapped to
+	// decrypt their data.
+	encrypted, err := encdb.Detect(db)
+	if err != nil {
+		return false, errContext(err, "failed to read wallet database")
+	}
+	if encrypted {
+		db, err = encdb.Wrap(db, []byte(opts.PubPass))
+		if err != nil {
+			return false, errContext(err, "failed to decrypt wallet database")
+		}
+	}
+
+	report, err := udb.CheckConsistency(db, activeNet.Params, opts.Repair)
+	if err != nil {
+		return false, errContext(err, "failed to check wallet database")
+	}
+
+	fmt.Printf("Checked %d blocks, %d transactions, %d credits, %d debits, "+
+		"%d unspent outputs, %d unmined transactions, %d tickets, "+
+		"%d accounts, and %d addresses\n", report.Blocks, report.TxRecords,
+		report.Credits, report.Debits, report.Unspent, report.Unmined,
+		report.Tickets, report.Accounts, report.Addresses)
+	for _, p := range report.Problems {
+		var status string
+		switch {
+		case p.Fixed:
+			status = "repaired"
+		case p.Fixable:
+			status = "repairable"
+		default:
+			status = "unrepairable"
+		}
+		fmt.Printf("%s (%s): %s\n", p.Check, status, p.Description)
+	}
+
+	consistent = report.Consistent()
+	switch {
+	case len(report.Problems) == 0:
+		fmt.Println("The wallet database is consistent")
+	case consistent:
+		fmt.Println("All problems were repaired")
+	default:
+		fmt.Printf("The wallet database is inconsistent (%d problems)\n",
+			len(report.Problems))
+	}
+	return consistent, nil
+}
@@ -0,0 +1,211 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/abcsuite/abcutil"
+	"github.com/abcsuite/abcwallet/internal/prompt"
+	"github.com/abcsuite/abcwallet/netparams"
+	"github.com/abcsuite/abcwallet/wallet"
+	"github.com/abcsuite/abcwallet/wallet/udb"
+	"github.com/abcsuite/abcwallet/walletdb"
+	_ "github.com/abcsuite/abcwallet/walletdb/bdb"
+	"github.com/abcsuite/abcwallet/walletdb/encdb"
+	"github.com/boltdb/bolt"
+	"github.com/jessevdk/go-flags"
+)
+
+var (
+	walletDataDirectory = abcutil.AppDataDir("abcwallet", false)
+	newlineBytes        = []byte{'\n'}
+)
+
+func fatalf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format, args...)
+	os.Stderr.Write(newlineBytes)
+	os.Exit(1)
+}
+
+func errContext(err error, context string) error {
+	return fmt.Errorf("%s: %v", context, err)
+}
+
+// Flags.
+var opts = struct {
+	TestNet bool   `long:"testnet" description:"Use the test aero network"`
+	SimNet  bool   `long:"simnet" description:"Use the simulation aero network"`
+	DbPath  string `long:"db" description:"Path to wallet database (default: wallet.db in the network directory of the abcwallet data directory)"`
+	PubPass string `long:"pubpass" description:"Public passphrase of the wallet (prompted for when not set)"`
+}{
+	TestNet: false,
+	SimNet:  false,
+	DbPath:  "",
+	PubPass: "",
+}
+
+var activeNet = &netparams.MainNetParams
+
+// Parse and validate flags.
+func init() {
+	_, err := flags.Parse(&opts)
+	if err != nil {
+		os.Exit(1)
+	}
+
+	if opts.TestNet && opts.SimNet {
+		fatalf("Multiple aero networks may not be used simultaneously")
+	}
+	netDir := activeNet.Name
+	if opts.TestNet {
+		activeNet = &netparams.TestNet2Params
+		netDir = "testnet2"
+	} else if opts.SimNet {
+		activeNet = &netparams.SimNetParams
+		netDir = activeNet.Name
+	}
+
+	if opts.DbPath == "" {
+		opts.DbPath = filepath.Join(walletDataDirectory, netDir, "wallet.db")
+	}
+}
+
+func main() {
+	err := migrate()
+	if err != nil {
+		fatalf("%v", err)
+	}
+}
+
+func migrate() error {
+	if _, err := os.Stat(opts.DbPath); err != nil {
+		return errContext(err, "failed to find wallet database")
+	}
+
+	pubPass := []byte(opts.PubPass)
+	if len(pubPass) == 0 {
+		var err error
+		pubPass, err = prompt.PassPrompt(bufio.NewReader(os.Stdin),
+			"Enter the public passphrase of the wallet", false)
+		if err != nil {
+			return errContext(err, "failed to read public passphrase")
+		}
+	}
+	if string(pubPass) == wallet.InsecurePubPassphrase {
+		return fmt.Errorf("the wallet uses the default public passphrase, " +
+			"which does not protect the encrypted database; set a public " +
+			"passphrase before encrypting the database")
+	}
+
+	// The wallet process must not be running as the database is locked while
+	// it is open.
+	err := checkSource(pubPass)
+	if err != nil {
+		return err
+	}
+
+	// Write the encrypted database to a temporary file in the same directory
+	// so that it can atomically replace the original.
+	tmpPath := opts.DbPath + ".encrypting"
+	err = writeEncrypted(tmpPath, pubPass)
+	if err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	err = os.Rename(tmpPath, opts.DbPath)
+	if err != nil {
+		os.Remove(tmpPath)
+		return errContext(err, "failed to replace wallet database")
+	}
+
+	fmt.Println("The wallet database is now encrypted by the public passphrase")
+	return nil
+}
+
+// checkSource ensures the database is an unencrypted wallet database at the
+// current version and that the public passphrase is correct.
+func checkSource(pubPass []byte) error {
+	db, err := walletdb.Open("bdb", opts.DbPath, true)
+	if err != nil {
+		return errContext(err, "failed to open wallet database")
+	}
+	defer db.Close()
+
+	encrypted, err := encdb.Detect(db)
+	if err != nil {
+		return errContext(err, "failed to read wallet database")
+	}
+	if encrypted {
+		return fmt.Errorf("the wallet database is already encrypted")
+	}
+	_, _, _, err = udb.Open(db, activeNet.Params, pubPass)
+	if err != nil {
+		return errContext(err, "failed to open wallet")
+	}
+	return nil
+}
+
+// writeEncrypted copies every bucket of the wallet database into a new
+// encrypted database at path and verifies the wallet can be opened from it.
+func writeEncrypted(path string, pubPass []byte) error {
+	// The walletdb interface does not provide enumeration of top level
+	// buckets, so the unencrypted source is read with bolt directly.
+	src, err := bolt.Open(opts.DbPath, 0600, &bolt.Options{ReadOnly: true})
+	if err != nil {
+		return errContext(err, "failed to open wallet database")
+	}
+	defer src.Close()
+
+	inner, err := walletdb.Create("bdb", path)
+	if err != nil {
+		return errContext(err, "failed to create encrypted database")
+	}
+	dst, err := encdb.Init(inner, pubPass)
+	if err != nil {
+		inner.Close()
+		return errContext(err, "failed to initialize encrypted database")
+	}
+	defer dst.Close()
+
+	err = src.View(func(srcTx *bolt.Tx) error {
+		return walletdb.Update(dst, func(dstTx walletdb.ReadWriteTx) error {
+			return srcTx.ForEach(func(name []byte, b *bolt.Bucket) error {
+				dstBucket, err := dstTx.CreateTopLevelBucket(name)
+				if err != nil {
+					return err
+				}
+				return copyBucket(dstBucket, b)
+			})
+		})
+	})
+	if err != nil {
+		return errContext(err, "failed to copy wallet database")
+	}
+
+	_, _, _, err = udb.Open(dst, activeNet.Params, pubPass)
+	if err != nil {
+		return errContext(err, "failed to open wallet from encrypted database")
+	}
+	return nil
+}
+
+// copyBucket recursively copies all keys, values, and nested buckets of src
+// into dst.
+func copyBucket(dst walletdb.ReadWriteBucket, src *bolt.Bucket) error {
+	return src.ForEach(func(k, v []byte) error {
+		if srcNested := src.Bucket(k); srcNested != nil {
+			nested, err := dst.CreateBucket(k)
+			if err != nil {
+				return err
+			}
+			return copyBucket(nested, srcNested)
+		}
+		return dst.Put(k, v)
+	})
+}
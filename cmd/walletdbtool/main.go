@@ -0,0 +1,165 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/abcsuite/abcd/chaincfg/chainhash"
+	"github.com/abcsuite/abcutil"
+	"github.com/abcsuite/abcwallet/netparams"
+	"github.com/abcsuite/abcwallet/wallet"
+	"github.com/abcsuite/abcwallet/wallet/udb"
+	"github.com/abcsuite/abcwallet/walletdb"
+	_ "github.com/abcsuite/abcwallet/walletdb/bdb"
+	"github.com/abcsuite/abcwallet/walletdb/encdb"
+	"github.com/jessevdk/go-flags"
+)
+
+var (
+	walletDataDirectory = abcutil.AppDataDir("abcwallet", false)
+	newlineBytes        = []byte{'\n'}
+)
+
+func fatalf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format, args...)
+	os.Stderr.Write(newlineBytes)
+	os.Exit(1)
+}
+
+func errContext(err error, context string) error {
+	return fmt.Errorf("%s: %v", context, err)
+}
+
+// Flags.
+var opts = struct {
+	TestNet bool   `long:"testnet" description:"Use the test aero network"`
+	SimNet  bool   `long:"simnet" description:"Use the simulation aero network"`
+	DbPath  string `long:"db" description:"Path to wallet database (default: wallet.db in the network directory of the abcwallet data directory)"`
+	PubPass string `long:"pubpass" description:"Public passphrase of the wallet"`
+	JSON    bool   `long:"json" description:"Write the report as JSON"`
+	Account int64  `long:"account" description:"Only report the account with this number and its unspent outputs"`
+	Tx      string `long:"tx" description:"Also report the details of the transaction with this hash"`
+}{
+	TestNet: false,
+	SimNet:  false,
+	DbPath:  "",
+	PubPass: wallet.InsecurePubPassphrase,
+	JSON:    false,
+	Account: -1,
+	Tx:      "",
+}
+
+var (
+	activeNet = &netparams.MainNetParams
+	txHash    *chainhash.Hash
+)
+
+// Parse and validate flags.
+func init() {
+	_, err := flags.Parse(&opts)
+	if err != nil {
+		os.Exit(1)
+	}
+
+	if opts.TestNet && opts.SimNet {
+		fatalf("Multiple aero networks may not be used simultaneously")
+	}
+	netDir := activeNet.Name
+	if opts.TestNet {
+		activeNet = &netparams.TestNet2Params
+		netDir = "testnet2"
+	} else if opts.SimNet {
+		activeNet = &netparams.SimNetParams
+		netDir = activeNet.Name
+	}
+
+	if opts.DbPath == "" {
+		opts.DbPath = filepath.Join(walletDataDirectory, netDir, "wallet.db")
+	}
+
+	if opts.Account < -1 || opts.Account > int64(^uint32(0)) {
+		fatalf("Invalid account number %d", opts.Account)
+	}
+	if opts.Tx != "" {
+		txHash, err = chainhash.NewHashFromStr(opts.Tx)
+		if err != nil {
+			fatalf("Invalid transaction hash: %v", err)
+		}
+	}
+}
+
+func main() {
+	r, err := inspect()
+	if err != nil {
+		fatalf("%v", err)
+	}
+	if opts.JSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(r)
+		if err != nil {
+			fatalf("%v", err)
+		}
+		return
+	}
+	r.write(os.Stdout)
+}
+
+func inspect() (*report, error) {
+	if _, err := os.Stat(opts.DbPath); err != nil {
+		return nil, errContext(err, "failed to find wallet database")
+	}
+
+	// The database is always opened read-only, so the wallet process must
+	// not be running with the database open for writes.
+	db, err := walletdb.Open("bdb", opts.DbPath, true)
+	if err != nil {
+		return nil, errContext(err, "failed to open wallet database")
+	}
+	defer db.Close()
+
+	// Databases encrypted by the public passphrase must be wrapped to
+	// decrypt their data.
+	encrypted, err := encdb.Detect(db)
+	if err != nil {
+		return nil, errContext(err, "failed to read wallet database")
+	}
+	if encrypted {
+		db, err = encdb.Wrap(db, []byte(opts.PubPass))
+		if err != nil {
+			return nil, errContext(err, "failed to decrypt wallet database")
+		}
+	}
+
+	// Only the public passphrase is required to open the wallet.  Private
+	// keys are never decrypted.
+	addrMgr, txStore, stakeStore, err := udb.Open(db, activeNet.Params,
+		[]byte(opts.PubPass))
+	if err != nil {
+		return nil, errContext(err, "failed to open wallet")
+	}
+	defer addrMgr.Close()
+
+	i := &inspector{
+		params:     activeNet.Params,
+		addrMgr:    addrMgr,
+		txStore:    txStore,
+		stakeStore: stakeStore,
+	}
+	var r *report
+	err = walletdb.View(db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		r, err = i.report(dbtx)
+		return err
+	})
+	if err != nil {
+		return nil, errContext(err, "failed to inspect wallet database")
+	}
+	return r, nil
+}
@@ -0,0 +1,467 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/abcsuite/abcd/abcjson"
+	"github.com/abcsuite/abcd/blockchain/stake"
+	"github.com/abcsuite/abcd/chaincfg"
+	"github.com/abcsuite/abcd/chaincfg/chainhash"
+	"github.com/abcsuite/abcd/txscript"
+	"github.com/abcsuite/abcwallet/apperrors"
+	"github.com/abcsuite/abcwallet/wallet/udb"
+	"github.com/abcsuite/abcwallet/walletdb"
+)
+
+// Ticket statuses which can be determined from the wallet database alone.
+// Tickets which missed their vote are reported as live until they expire or
+// are revoked, as missed tickets are only known to the consensus daemon.
+const (
+	ticketUnmined  = "unmined"
+	ticketImmature = "immature"
+	ticketLive     = "live"
+	ticketExpired  = "expired"
+	ticketVoted    = "voted"
+	ticketRevoked  = "revoked"
+	ticketUnknown  = "unknown"
+)
+
+type report struct {
+	DBVersion         uint32             `json:"dbversion"`
+	TipHash           string             `json:"tiphash"`
+	TipHeight         int32              `json:"tipheight"`
+	Accounts          []account          `json:"accounts"`
+	Unmined           []transaction      `json:"unmined"`
+	Unspent           []credit           `json:"unspent"`
+	Tickets           []ticket           `json:"tickets"`
+	AgendaPreferences []agendaPreference `json:"agendapreferences"`
+	Transaction       *transaction       `json:"transaction,omitempty"`
+}
+
+type account struct {
+	Number                    uint32 `json:"number"`
+	Name                      string `json:"name"`
+	LastUsedExternalIndex     uint32 `json:"lastusedexternalindex"`
+	LastUsedInternalIndex     uint32 `json:"lastusedinternalindex"`
+	LastReturnedExternalIndex uint32 `json:"lastreturnedexternalindex"`
+	LastReturnedInternalIndex uint32 `json:"lastreturnedinternalindex"`
+	ImportedKeyCount          uint32 `json:"importedkeycount"`
+}
+
+type transaction struct {
+	Hash      string         `json:"hash"`
+	Type      string         `json:"type"`
+	Received  int64          `json:"received"`
+	BlockHash string         `json:"blockhash,omitempty"`
+	Height    int32          `json:"height"`
+	Label     string         `json:"label,omitempty"`
+	Credits   []creditRecord `json:"credits"`
+	Debits    []debitRecord  `json:"debits"`
+}
+
+type creditRecord struct {
+	Index  uint32  `json:"index"`
+	Amount float64 `json:"amount"`
+	Spent  bool    `json:"spent"`
+	Change bool    `json:"change"`
+}
+
+type debitRecord struct {
+	Index  uint32  `json:"index"`
+	Amount float64 `json:"amount"`
+}
+
+type credit struct {
+	OutPoint     string  `json:"outpoint"`
+	Tree         int8    `json:"tree"`
+	Amount       float64 `json:"amount"`
+	Address      string  `json:"address,omitempty"`
+	Account      *uint32 `json:"account,omitempty"`
+	Height       int32   `json:"height"`
+	FromCoinBase bool    `json:"fromcoinbase"`
+}
+
+type ticket struct {
+	Hash   string `json:"hash"`
+	Height int32  `json:"height"`
+	Status string `json:"status"`
+}
+
+type agendaPreference struct {
+	Version  uint32 `json:"version"`
+	AgendaID string `json:"agendaid"`
+	ChoiceID string `json:"choiceid"`
+}
+
+type inspector struct {
+	params     *chaincfg.Params
+	addrMgr    *udb.Manager
+	txStore    *udb.Store
+	stakeStore *udb.StakeStore
+
+	// Namespaces of the current database transaction.
+	addrmgrNs  walletdb.ReadBucket
+	txmgrNs    walletdb.ReadBucket
+	stakemgrNs walletdb.ReadBucket
+}
+
+var (
+	waddrmgrNamespaceKey  = []byte("waddrmgr")
+	wtxmgrNamespaceKey    = []byte("wtxmgr")
+	wstakemgrNamespaceKey = []byte("wstakemgr")
+)
+
+func (i *inspector) report(dbtx walletdb.ReadTx) (*report, error) {
+	i.addrmgrNs = dbtx.ReadBucket(waddrmgrNamespaceKey)
+	i.txmgrNs = dbtx.ReadBucket(wtxmgrNamespaceKey)
+	i.stakemgrNs = dbtx.ReadBucket(wstakemgrNamespaceKey)
+
+	r := new(report)
+	var err error
+	r.DBVersion, err = udb.DatabaseVersion(dbtx)
+	if err != nil {
+		return nil, err
+	}
+	tipHash, tipHeight := i.txStore.MainChainTip(i.txmgrNs)
+	r.TipHash, r.TipHeight = tipHash.String(), tipHeight
+
+	steps := []func() error{
+		func() (err error) { r.Accounts, err = i.accounts(); return },
+		func() (err error) { r.Unmined, err = i.unmined(dbtx); return },
+		func() (err error) { r.Unspent, err = i.unspent(); return },
+		func() (err error) { r.Tickets, err = i.tickets(dbtx, tipHeight); return },
+		func() (err error) { r.AgendaPreferences, err = agendaPreferences(dbtx); return },
+	}
+	for _, step := range steps {
+		err := step()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if txHash != nil {
+		details, err := i.txStore.TxDetails(i.txmgrNs, txHash)
+		if err != nil {
+			return nil, err
+		}
+		if details == nil {
+			return nil, fmt.Errorf("transaction %v is not recorded by the wallet", txHash)
+		}
+		r.Transaction = newTransaction(dbtx, details)
+	}
+
+	return r, nil
+}
+
+func (i *inspector) accounts() ([]account, error) {
+	var accounts []account
+	err := i.addrMgr.ForEachAccount(i.addrmgrNs, func(acct uint32) error {
+		if opts.Account != -1 && int64(acct) != opts.Account {
+			return nil
+		}
+		props, err := i.addrMgr.AccountProperties(i.addrmgrNs, acct)
+		if err != nil {
+			return err
+		}
+		accounts = append(accounts, account{
+			Number:                    props.AccountNumber,
+			Name:                      props.AccountName,
+			LastUsedExternalIndex:     props.LastUsedExternalIndex,
+			LastUsedInternalIndex:     props.LastUsedInternalIndex,
+			LastReturnedExternalIndex: props.LastReturnedExternalIndex,
+			LastReturnedInternalIndex: props.LastReturnedInternalIndex,
+			ImportedKeyCount:          props.ImportedKeyCount,
+		})
+		return nil
+	})
+	return accounts, err
+}
+
+func txTypeString(txType stake.TxType) string {
+	switch txType {
+	case stake.TxTypeSStx:
+		return abcjson.LTTTTicket
+	case stake.TxTypeSSGen:
+		return abcjson.LTTTVote
+	case stake.TxTypeSSRtx:
+		return abcjson.LTTTRevocation
+	default:
+		return abcjson.LTTTRegular
+	}
+}
+
+func newTransaction(dbtx walletdb.ReadTx, details *udb.TxDetails) *transaction {
+	tx := &transaction{
+		Hash:     details.Hash.String(),
+		Type:     txTypeString(details.TxType),
+		Received: details.Received.Unix(),
+		Height:   -1,
+		Label:    udb.FetchTxLabel(dbtx, &details.Hash),
+		Credits:  make([]creditRecord, 0, len(details.Credits)),
+		Debits:   make([]debitRecord, 0, len(details.Debits)),
+	}
+	if details.Block.Height != -1 {
+		tx.BlockHash = details.Block.Hash.String()
+		tx.Height = details.Block.Height
+	}
+	for _, c := range details.Credits {
+		tx.Credits = append(tx.Credits, creditRecord{
+			Index:  c.Index,
+			Amount: c.Amount.ToCoin(),
+			Spent:  c.Spent,
+			Change: c.Change,
+		})
+	}
+	for _, d := range details.Debits {
+		tx.Debits = append(tx.Debits, debitRecord{
+			Index:  d.Index,
+			Amount: d.Amount.ToCoin(),
+		})
+	}
+	return tx
+}
+
+// byReceived sorts transactions by the time they were received.
+type byReceived []transaction
+
+func (s byReceived) Len() int           { return len(s) }
+func (s byReceived) Less(i, j int) bool { return s[i].Received < s[j].Received }
+func (s byReceived) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+func (i *inspector) unmined(dbtx walletdb.ReadTx) ([]transaction, error) {
+	hashes, err := i.txStore.UnminedTxHashes(i.txmgrNs)
+	if err != nil {
+		return nil, err
+	}
+	txs := make([]transaction, 0, len(hashes))
+	for _, hash := range hashes {
+		details, err := i.txStore.TxDetails(i.txmgrNs, hash)
+		if err != nil {
+			return nil, err
+		}
+		if details == nil {
+			continue
+		}
+		txs = append(txs, *newTransaction(dbtx, details))
+	}
+	sort.Sort(byReceived(txs))
+	return txs, nil
+}
+
+// byHeight sorts unspent outputs by their block height, with unmined outputs
+// last.
+type byHeight []credit
+
+func (s byHeight) Len() int { return len(s) }
+func (s byHeight) Less(i, j int) bool {
+	hi, hj := uint32(s[i].Height), uint32(s[j].Height)
+	if hi != hj {
+		return hi < hj
+	}
+	return s[i].OutPoint < s[j].OutPoint
+}
+func (s byHeight) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+
+func (i *inspector) unspent() ([]credit, error) {
+	unspent, err := i.txStore.UnspentOutputs(i.txmgrNs)
+	if err != nil {
+		return nil, err
+	}
+	credits := make([]credit, 0, len(unspent))
+	for _, c := range unspent {
+		cr := credit{
+			OutPoint:     c.OutPoint.String(),
+			Tree:         c.OutPoint.Tree,
+			Amount:       c.Amount.ToCoin(),
+			Height:       c.Height,
+			FromCoinBase: c.FromCoinBase,
+		}
+		_, addrs, _, err := txscript.ExtractPkScriptAddrs(
+			txscript.DefaultScriptVersion, c.PkScript, i.params)
+		if err == nil && len(addrs) == 1 {
+			cr.Address = addrs[0].EncodeAddress()
+			acct, err := i.addrMgr.AddrAccount(i.addrmgrNs, addrs[0])
+			switch {
+			case err == nil:
+				cr.Account = &acct
+			case !apperrors.IsError(err, apperrors.ErrAddressNotFound):
+				return nil, err
+			}
+		}
+		if opts.Account != -1 && (cr.Account == nil || int64(*cr.Account) != opts.Account) {
+			continue
+		}
+		credits = append(credits, cr)
+	}
+	sort.Sort(byHeight(credits))
+	return credits, nil
+}
+
+// byTicketHeight sorts tickets by their block height, with unmined tickets
+// last.
+type byTicketHeight []ticket
+
+func (s byTicketHeight) Len() int { return len(s) }
+func (s byTicketHeight) Less(i, j int) bool {
+	hi, hj := uint32(s[i].Height), uint32(s[j].Height)
+	if hi != hj {
+		return hi < hj
+	}
+	return s[i].Hash < s[j].Hash
+}
+func (s byTicketHeight) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+
+func (i *inspector) tickets(dbtx walletdb.ReadTx, tipHeight int32) ([]ticket, error) {
+	hashes, err := i.stakeStore.DumpSStxHashes()
+	if err != nil {
+		return nil, err
+	}
+	voted, err := i.stakeStore.DumpSSGenTickets(i.stakemgrNs)
+	if err != nil {
+		return nil, err
+	}
+	revoked, err := i.stakeStore.DumpSSRtxTickets(i.stakemgrNs)
+	if err != nil {
+		return nil, err
+	}
+	spent := make(map[chainhash.Hash]string, len(voted)+len(revoked))
+	for _, h := range voted {
+		spent[h] = ticketVoted
+	}
+	for _, h := range revoked {
+		spent[h] = ticketRevoked
+	}
+
+	maturity := int32(i.params.TicketMaturity)
+	expiry := int32(i.params.TicketExpiry)
+	tickets := make([]ticket, 0, len(hashes))
+	for n := range hashes {
+		hash := &hashes[n]
+		t := ticket{Hash: hash.String(), Height: -1}
+		height, err := i.txStore.TxBlockHeight(dbtx, hash)
+		switch {
+		case apperrors.IsError(err, apperrors.ErrValueNoExists):
+			t.Status = ticketUnknown
+		case err != nil:
+			return nil, err
+		default:
+			t.Height = height
+		}
+		if t.Status == "" {
+			switch status, ok := spent[*hash]; {
+			case ok:
+				t.Status = status
+			case height == -1:
+				t.Status = ticketUnmined
+			case tipHeight-height < maturity:
+				t.Status = ticketImmature
+			case tipHeight-height >= maturity+expiry:
+				t.Status = ticketExpired
+			default:
+				t.Status = ticketLive
+			}
+		}
+		tickets = append(tickets, t)
+	}
+	sort.Sort(byTicketHeight(tickets))
+	return tickets, nil
+}
+
+func agendaPreferences(dbtx walletdb.ReadTx) ([]agendaPreference, error) {
+	var prefs []agendaPreference
+	err := udb.ForEachAgendaPreference(dbtx, func(version uint32, agendaID, choiceID string) error {
+		prefs = append(prefs, agendaPreference{
+			Version:  version,
+			AgendaID: agendaID,
+			ChoiceID: choiceID,
+		})
+		return nil
+	})
+	return prefs, err
+}
+
+// write writes the report in a human readable format.
+func (r *report) write(w io.Writer) {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	defer tw.Flush()
+
+	fmt.Fprintf(tw, "Database version:\t%d\n", r.DBVersion)
+	fmt.Fprintf(tw, "Main chain tip:\t%s (height %d)\n", r.TipHash, r.TipHeight)
+
+	fmt.Fprintf(tw, "\nAccounts (%d):\n", len(r.Accounts))
+	fmt.Fprintf(tw, "Number\tName\tExternal used/returned\tInternal used/returned\tImported keys\n")
+	for _, a := range r.Accounts {
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%d\n", a.Number, a.Name,
+			indexes(a.LastUsedExternalIndex, a.LastReturnedExternalIndex),
+			indexes(a.LastUsedInternalIndex, a.LastReturnedInternalIndex),
+			a.ImportedKeyCount)
+	}
+
+	fmt.Fprintf(tw, "\nUnmined transactions (%d):\n", len(r.Unmined))
+	fmt.Fprintf(tw, "Hash\tType\tReceived\tCredits\tDebits\tLabel\n")
+	for i := range r.Unmined {
+		tx := &r.Unmined[i]
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%d\t%d\t%s\n", tx.Hash, tx.Type,
+			formatTime(tx.Received), len(tx.Credits), len(tx.Debits), tx.Label)
+	}
+
+	fmt.Fprintf(tw, "\nUnspent outputs (%d):\n", len(r.Unspent))
+	fmt.Fprintf(tw, "Outpoint\tTree\tAmount\tAddress\tAccount\tHeight\n")
+	for _, c := range r.Unspent {
+		acct := "-"
+		if c.Account != nil {
+			acct = fmt.Sprint(*c.Account)
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%v\t%s\t%s\t%d\n", c.OutPoint, c.Tree,
+			c.Amount, c.Address, acct, c.Height)
+	}
+
+	fmt.Fprintf(tw, "\nTickets (%d):\n", len(r.Tickets))
+	fmt.Fprintf(tw, "Hash\tHeight\tStatus\n")
+	for _, t := range r.Tickets {
+		fmt.Fprintf(tw, "%s\t%d\t%s\n", t.Hash, t.Height, t.Status)
+	}
+
+	fmt.Fprintf(tw, "\nAgenda preferences (%d):\n", len(r.AgendaPreferences))
+	fmt.Fprintf(tw, "Version\tAgenda\tChoice\n")
+	for _, p := range r.AgendaPreferences {
+		fmt.Fprintf(tw, "%d\t%s\t%s\n", p.Version, p.AgendaID, p.ChoiceID)
+	}
+
+	if tx := r.Transaction; tx != nil {
+		fmt.Fprintf(tw, "\nTransaction %s:\n", tx.Hash)
+		fmt.Fprintf(tw, "Type:\t%s\n", tx.Type)
+		fmt.Fprintf(tw, "Received:\t%s\n", formatTime(tx.Received))
+		if tx.Height == -1 {
+			fmt.Fprintf(tw, "Block:\tunmined\n")
+		} else {
+			fmt.Fprintf(tw, "Block:\t%s (height %d)\n", tx.BlockHash, tx.Height)
+		}
+		if tx.Label != "" {
+			fmt.Fprintf(tw, "Label:\t%s\n", tx.Label)
+		}
+		for _, c := range tx.Credits {
+			fmt.Fprintf(tw, "Credit:\toutput %d, %v, spent=%v change=%v\n",
+				c.Index, c.Amount, c.Spent, c.Change)
+		}
+		for _, d := range tx.Debits {
+			fmt.Fprintf(tw, "Debit:\tinput %d, %v\n", d.Index, d.Amount)
+		}
+	}
+}
+
+func indexes(used, returned uint32) string {
+	return fmt.Sprintf("%d/%d", used, returned)
+}
+
+func formatTime(unix int64) string {
+	return time.Unix(unix, 0).UTC().Format(time.RFC3339)
+}
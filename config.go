@@ -14,12 +14,14 @@ import (
 	"runtime"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/abcsuite/abcutil"
 	"github.com/abcsuite/abcwallet/internal/cfgutil"
 	"github.com/abcsuite/abcwallet/netparams"
 	"github.com/abcsuite/abcwallet/ticketbuyer"
 	"github.com/abcsuite/abcwallet/wallet"
+	"github.com/abcsuite/abcwallet/wallet/txauthor"
 	"github.com/abcsuite/abcwallet/wallet/txrules"
 	flags "github.com/jessevdk/go-flags"
 )
@@ -44,6 +46,8 @@ const (
 	defaultAddrIdxScanLen      = wallet.DefaultGapLimit
 	defaultStakePoolColdExtKey = ""
 	defaultAllowHighFees       = false
+	defaultBackupInterval      = 24 * time.Hour
+	defaultBackupRetention     = 7
 
 	// ticket buyer options
 	defaultMaxFee                    abcutil.Amount = 1e7
@@ -90,8 +94,10 @@ type config struct {
 	LogDir             string   `long:"logdir" description:"Directory to log output."`
 	Profile            []string `long:"profile" description:"Enable HTTP profiling this interface/port"`
 	MemProfile         string   `long:"memprofile" description:"Write mem profile to the specified file"`
-	RollbackTest       bool     `long:"rollbacktest" description:"Rollback testing is a simnet testing mode that eventually stops wallet and examines wtxmgr database integrity"`
-	AutomaticRepair    bool     `long:"automaticrepair" description:"Attempt to repair the wallet automatically if a database inconsistency is found"`
+	RollbackTest       bool     `long:"rollbacktest" description:"Rollback testing is a simnet testing mode that checks the consistency of the wallet database when the wallet is stopped"`
+	AutomaticRepair    bool     `long:"automaticrepair" description:"Check the consistency of the wallet database when it is opened and repair any fixable problems"`
+	MemoryDB           bool     `long:"memorydb" description:"Keep the wallet database in memory (simnet only); changes are never written to disk and are lost on shutdown"`
+	Offline            bool     `long:"offline" description:"Never connect to a consensus server, such as when signing transactions on an air-gapped machine"`
 
 	// Wallet options
 	WalletPass          string              `long:"walletpass" default-mask:"-" description:"The public wallet password -- Only required if the wallet was created with one"`
@@ -110,8 +116,13 @@ type config struct {
 	StakePoolColdExtKey string              `long:"stakepoolcoldextkey" description:"Enables the wallet as a stake pool with an extended key in the format of \"xpub...:index\" to derive cold wallet addresses to send fees to"`
 	AllowHighFees       bool                `long:"allowhighfees" description:"Force the RPC client to use the 'allowHighFees' flag when sending transactions"`
 	RelayFee            *cfgutil.AmountFlag `long:"txfee" description:"Sets the wallet's tx fee per kb"`
+	CoinSelection       string              `long:"coinselection" description:"Default strategy for selecting transaction inputs {default, branchandbound, largestfirst, smallestfirst, oldestfirst, privacy}"`
+	TxExpiry            uint32              `long:"txexpiry" description:"Number of blocks after which unmined transactions expire by default (0 to never expire)"`
 	TicketFee           *cfgutil.AmountFlag `long:"ticketfee" description:"Sets the wallet's ticket fee per kb"`
 	PipeRx              *uint               `long:"piperx" description:"File descriptor of read end pipe to enable parent -> child process communication"`
+	BackupDir           string              `long:"backupdir" description:"Directory to periodically write wallet backups to (disabled if unset)"`
+	BackupInterval      time.Duration       `long:"backupinterval" description:"Time between scheduled wallet backups (e.g. 30m, 24h)"`
+	BackupRetention     int                 `long:"backupretention" description:"Number of most recent scheduled wallet backups to keep"`
 
 	// RPC client options
 	RPCConnect       string `short:"c" long:"rpcconnect" description:"Hostname/IP and port of abcd RPC server to connect to"`
@@ -353,6 +364,8 @@ func loadConfig() (*config, []string, error) {
 		AddrIdxScanLen:         defaultAddrIdxScanLen,
 		StakePoolColdExtKey:    defaultStakePoolColdExtKey,
 		AllowHighFees:          defaultAllowHighFees,
+		BackupInterval:         defaultBackupInterval,
+		BackupRetention:        defaultBackupRetention,
 		RelayFee:               cfgutil.NewAmountFlag(txrules.DefaultRelayFeePerKb),
 		TicketFee:              cfgutil.NewAmountFlag(txrules.DefaultRelayFeePerKb),
 
@@ -616,9 +629,48 @@ func loadConfig() (*config, []string, error) {
 		os.Exit(0)
 	}
 
-	// Warn if rollback testing is enabled, as this feature was removed.
-	if cfg.RollbackTest {
-		fmt.Fprintln(os.Stderr, "WARN: Rollback testing no longer exists")
+	// Rollback testing is only permitted on simnet.
+	if cfg.RollbackTest && !cfg.SimNet {
+		str := "%s: The --rollbacktest option is only permitted with --simnet"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+
+	// Ensure the default coin selection strategy is recognized.
+	if cfg.CoinSelection != "" {
+		_, err := txauthor.ParseCoinSelectionStrategy(cfg.CoinSelection)
+		if err != nil {
+			err := fmt.Errorf("%s: %v", funcName, err)
+			fmt.Fprintln(os.Stderr, err)
+			return loadConfigError(err)
+		}
+	}
+
+	// In-memory wallet databases are only permitted on simnet, and can not be
+	// used to create wallets that are saved to disk.
+	if cfg.MemoryDB && !cfg.SimNet {
+		str := "%s: The --memorydb option is only permitted with --simnet"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+	if cfg.MemoryDB && (cfg.Create || cfg.CreateWatchingOnly) {
+		str := "%s: The --memorydb option can not be used with --create " +
+			"or --createwatchingonly"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+
+	// Offline wallets never learn of new blocks, so they can not vote or buy
+	// tickets.
+	if cfg.Offline && (cfg.EnableTicketBuyer || cfg.EnableVoting) {
+		str := "%s: The --offline option can not be used with " +
+			"--enableticketbuyer or --enablevoting"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
 	}
 
 	// Ensure the wallet exists or create it when the create flag is set.
@@ -654,7 +706,9 @@ func loadConfig() (*config, []string, error) {
 			return loadConfigError(err)
 		}
 
-		if !tempWalletExists {
+		// Temporary wallets kept in memory are created when the
+		// wallet is loaded.
+		if !tempWalletExists && !cfg.MemoryDB {
 			// Perform the initial wallet creation wizard.
 			if err := createSimulationWallet(&cfg); err != nil {
 				fmt.Fprintln(os.Stderr, "Unable to create wallet:", err)
@@ -883,6 +937,25 @@ func loadConfig() (*config, []string, error) {
 	cfg.RPCCert = cleanAndExpandPath(cfg.RPCCert)
 	cfg.RPCKey = cleanAndExpandPath(cfg.RPCKey)
 
+	// Scheduled backups are namespaced per network like the log directory so
+	// backups of wallets for different networks are never pruned together.
+	if cfg.BackupDir != "" {
+		if cfg.BackupInterval < time.Minute {
+			str := "%s: backupinterval must be at least one minute: %v"
+			err := fmt.Errorf(str, funcName, cfg.BackupInterval)
+			fmt.Fprintln(os.Stderr, err)
+			return loadConfigError(err)
+		}
+		if cfg.BackupRetention < 1 {
+			str := "%s: backupretention must be positive: %v"
+			err := fmt.Errorf(str, funcName, cfg.BackupRetention)
+			fmt.Fprintln(os.Stderr, err)
+			return loadConfigError(err)
+		}
+		cfg.BackupDir = cleanAndExpandPath(cfg.BackupDir)
+		cfg.BackupDir = filepath.Join(cfg.BackupDir, activeNet.Params.Name)
+	}
+
 	// If the abcd username or password are unset, use the same auth as for
 	// the client.  The two settings were previously shared for abcd and
 	// client auth, so this avoids breaking backwards compatibility while
@@ -8,6 +8,13 @@
 package rpchelp
 
 var helpDescsEnUS = map[string]string{
+	// AbandonTransactionCmd help.
+	"abandontransaction--synopsis": "Removes an unmined transaction that will never be mined, and all unmined transactions spending its outputs, from the wallet.\n" +
+		"The previous outputs spent by the removed transactions become spendable again.\n" +
+		"The transaction is not removed from the mempools of the network and may be added back to the wallet if it is later mined.",
+	"abandontransaction-txid":     "Hash of the unmined transaction to abandon",
+	"abandontransaction--result0": "The hashes of all removed transactions",
+
 	// AccountAddressIndexCmd help.
 	"accountaddressindex--synopsis": "Get the current address index for some account branch",
 	"accountaddressindex-account":   "String for the account",
@@ -27,6 +34,51 @@ var helpDescsEnUS = map[string]string{
 	"addmultisigaddress-nrequired": "The number of signatures required to redeem outputs paid to this address",
 	"addmultisigaddress--result0":  "The imported pay-to-script-hash address",
 
+	// BackupWalletCmd help.
+	"backupwallet--synopsis":   "Writes a verified copy of the wallet database to a new file while the wallet continues running.",
+	"backupwallet-destination": "Path of the backup file to create (must not already exist)",
+
+	// BumpFeeCmd help.
+	"bumpfee--synopsis": "Creates and publishes a child-pays-for-parent transaction spending the change output of an unmined wallet transaction.\n" +
+		"The child pays a fee so that the unmined transaction and the child together pay the fee rate.\n" +
+		"The unmined transaction must only spend wallet outputs so that its fee is known.",
+	"bumpfee-txid":    "Hash of the unmined transaction to bump the fee of",
+	"bumpfee-feerate": "The target fee per kB of the combined serialized size of both transactions valued in aero",
+
+	// BumpFeeResult help.
+	"bumpfeeresult-txid":           "The hash of the child transaction",
+	"bumpfeeresult-hex":            "The serialized child transaction encoded as a hexadecimal string (unsigned if previewed)",
+	"bumpfeeresult-fee":            "The fee paid by the child transaction",
+	"bumpfeeresult-estimatedsize":  "The estimated serialized size of the signed child transaction",
+	"bumpfeeresult-parentfee":      "The fee paid by the unmined transaction",
+	"bumpfeeresult-parentsize":     "The serialized size of the unmined transaction",
+	"bumpfeeresult-packagefeerate": "The fee per kB paid by both transactions together",
+
+	// CheckConsistencyCmd help.
+	"checkconsistency--synopsis": "Checks that the records of the wallet database refer to one another correctly.\n" +
+		"Repairs are not reflected by the state of the running wallet and are refused.\n" +
+		"Fixable problems must be repaired by opening the wallet with --automaticrepair or by running checkwalletdb on the closed database.",
+	"checkconsistency-repair": "Must be false, as repairs can not be performed by a running wallet",
+
+	// CheckConsistencyResult help.
+	"checkconsistencyresult-consistent": "Whether no problems were found, or all found problems were repaired",
+	"checkconsistencyresult-blocks":     "The number of main chain block records checked",
+	"checkconsistencyresult-txrecords":  "The number of mined transaction records checked",
+	"checkconsistencyresult-credits":    "The number of mined credits checked",
+	"checkconsistencyresult-debits":     "The number of mined debits checked",
+	"checkconsistencyresult-unspent":    "The number of unspent outputs checked",
+	"checkconsistencyresult-unmined":    "The number of unmined transactions checked",
+	"checkconsistencyresult-tickets":    "The number of ticket records checked",
+	"checkconsistencyresult-accounts":   "The number of accounts checked",
+	"checkconsistencyresult-addresses":  "The number of addresses checked",
+	"checkconsistencyresult-problems":   "All problems that were found",
+
+	// ConsistencyProblemResult help.
+	"consistencyproblemresult-check":       "The name of the check which found the problem",
+	"consistencyproblemresult-description": "A description of the problem",
+	"consistencyproblemresult-fixable":     "Whether the problem can be repaired",
+	"consistencyproblemresult-fixed":       "Whether the problem was repaired",
+
 	// ConsolidateCmd help.
 	"consolidate--synopsis": "Consolidate n many UTXOs into a single output in the wallet.",
 	"consolidate-inputs":    "Number of UTXOs to consolidate as inputs",
@@ -43,6 +95,84 @@ var helpDescsEnUS = map[string]string{
 	"createmultisigresult-address":      "The generated pay-to-script-hash address",
 	"createmultisigresult-redeemScript": "The script required to redeem outputs paid to the multisig address",
 
+	// CreateMultisigAccountCmd help.
+	"createmultisigaccount--synopsis": "Creates a multisig account from the account extended public keys of other cosigners.\n" +
+		"Addresses of the account are pay-to-script-hash addresses of multisig scripts paying to keys derived from the wallet's next account key and every cosigner key.\n" +
+		"Watching-only wallets use the first key as the account key.\n" +
+		"Outputs of the account must be spent with createpartialtransaction and signed by the required number of cosigners.\n" +
+		"The wallet must be unlocked for this request to succeed unless it is watching-only.",
+	"createmultisigaccount-account":   "Name of the new account",
+	"createmultisigaccount-nrequired": "The number of signatures required to redeem outputs paid to addresses of the account",
+	"createmultisigaccount-keys":      "Account extended public keys of the cosigners",
+	"createmultisigaccount--result0":  "The account number of the new account",
+
+	// CreatePartialTransactionCmd help.
+	"createpartialtransaction--synopsis": "Creates an unsigned transaction paying to addresses from the unspent outputs of an account and returns it as a partially signed transaction.\n" +
+		"The partially signed transaction describes the previous outputs and key derivation paths of every input and change output so it may be verified and signed by an offline wallet created from the same seed.\n" +
+		"The wallet may be watching-only and spent outputs are not locked.",
+	"createpartialtransaction-fromaccount":    "Account to spend outputs of",
+	"createpartialtransaction-amounts":        "Pairs of payment addresses and the output amount to pay each",
+	"createpartialtransaction-amounts--desc":  "JSON object using payment addresses as keys and output amounts valued in aero to send to each address.  A key of \"data:\" followed by up to 256 hex encoded bytes with a zero amount adds a null data output carrying the bytes",
+	"createpartialtransaction-amounts--key":   "Address to pay",
+	"createpartialtransaction-amounts--value": "Amount to send to the payment address valued in aero",
+	"createpartialtransaction-minconf":        "Minimum number of block confirmations required before a transaction output is eligible to be spent",
+	"createpartialtransaction--result0":       "The serialized partially signed transaction encoded as a hexadecimal string",
+
+	// CreateVaultCmd help.
+	"createvault--synopsis": "Publishes a transaction paying from an account to a time locked savings output (vault) owned by a new key of the account.\n" +
+		"Height and time locks are absolute, while blocks and seconds locks are relative to the block the vault is mined in.\n" +
+		"The vault is counted as locked in the account's balance and is automatically spent back to the account once the lock expires.\n" +
+		"The wallet must be unlocked for this request to succeed.",
+	"createvault-account":   "The account to fund the vault from and spend it back to",
+	"createvault-amount":    "The amount to pay to the vault",
+	"createvault-locktype":  "How the lock is specified (\"height\", \"time\", \"blocks\", or \"seconds\")",
+	"createvault-lockvalue": "The block height, Unix time, number of blocks, or number of seconds of the lock (relative time locks are rounded up to a multiple of 512 seconds)",
+
+	// VaultResult help.
+	"vaultresult-txid":         "The hash of the vault transaction",
+	"vaultresult-vout":         "The output index of the vault",
+	"vaultresult-account":      "The account which funded the vault and which it is spent back to",
+	"vaultresult-amount":       "The value of the vault output",
+	"vaultresult-address":      "The P2SH address of the vault",
+	"vaultresult-keyaddress":   "The address of the key which may spend the vault",
+	"vaultresult-redeemscript": "The vault script",
+	"vaultresult-locktype":     "How the lock is specified (\"height\", \"time\", \"blocks\", or \"seconds\")",
+	"vaultresult-lockvalue":    "The block height, Unix time, number of blocks, or number of seconds of the lock",
+	"vaultresult-unlocked":     "Whether the lock has expired as of the main chain tip",
+	"vaultresult-created":      "The Unix time the vault was saved",
+	"vaultresult-spendtxid":    "The hash of the transaction which spent the vault, if spent",
+
+	// DescribePartialTransactionCmd help.
+	"describepartialtransaction--synopsis": "Verifies a partially signed transaction and describes the amounts and destinations it pays so they may be checked before signing.\n" +
+		"Input amounts are taken from the previous transactions carried by the partially signed transaction after verifying their hashes against the spent outpoints.\n" +
+		"An error is returned if the previous transaction of any input is missing, or if a redeem script does not match the script hash of its previous output.",
+	"describepartialtransaction-hex": "The serialized partially signed transaction encoded as a hexadecimal string",
+
+	// DescribePartialTransactionResult help.
+	"describepartialtransactionresult-txid":          "The hash of the transaction",
+	"describepartialtransactionresult-inputs":        "The inputs of the transaction",
+	"describepartialtransactionresult-outputs":       "The outputs of the transaction",
+	"describepartialtransactionresult-totalinput":    "The total value of all inputs",
+	"describepartialtransactionresult-totaloutput":   "The total value of all outputs",
+	"describepartialtransactionresult-fee":           "The fee paid by the transaction",
+	"describepartialtransactionresult-estimatedsize": "The estimated serialized size of the signed transaction",
+	"describepartialtransactionresult-feerate":       "The fee per kB of the estimated signed size",
+	"describepartialtransactionresult-complete":      "Whether every input has a signature script",
+
+	// PartialTransactionInputResult help.
+	"partialtransactioninputresult-txid":     "The transaction hash of the referenced previous output",
+	"partialtransactioninputresult-vout":     "The output index of the referenced previous output",
+	"partialtransactioninputresult-tree":     "The tree of the previous transaction",
+	"partialtransactioninputresult-amount":   "The value of the previous output",
+	"partialtransactioninputresult-final":    "Whether the input has a signature script",
+	"partialtransactioninputresult-signable": "Whether a wallet key which has not yet signed the input may sign it",
+
+	// PartialTransactionOutputResult help.
+	"partialtransactionoutputresult-amount":       "The value of the output",
+	"partialtransactionoutputresult-scriptpubkey": "The output script encoded as a hexadecimal string",
+	"partialtransactionoutputresult-addresses":    "The addresses paid by the output script",
+	"partialtransactionoutputresult-owned":        "Whether the output pays to a wallet key",
+
 	// DumpPrivKeyCmd help.
 	"dumpprivkey--synopsis": "Returns the private key in WIF encoding that controls some wallet address.",
 	"dumpprivkey-address":   "The address to return a private key for",
@@ -73,6 +203,11 @@ var helpDescsEnUS = map[string]string{
 	"getaddressesbyaccount-account":   "Account name to fetch addresses for",
 	"getaddressesbyaccount--result0":  "All addresses controlled by 'account'",
 
+	// GetAddressLabelCmd help.
+	"getaddresslabel--synopsis": "Returns the label of a wallet address.",
+	"getaddresslabel-address":   "The wallet address to query",
+	"getaddresslabel--result0":  "The address label, or the empty string if the address is not labeled",
+
 	// GetBalanceCmd help.
 	"getbalance--synopsis":   "Calculates and returns the balance of one or all accounts.",
 	"getbalance-minconf":     "Minimum number of block confirmations required before an unspent output's value is included in the balance",
@@ -206,6 +341,11 @@ var helpDescsEnUS = map[string]string{
 	"gettransaction-txid":             "Hash of the transaction to query",
 	"gettransaction-includewatchonly": "Also consider transactions involving watched addresses",
 
+	// GetTxLabelCmd help.
+	"gettxlabel--synopsis": "Returns the label of a transaction.",
+	"gettxlabel-txid":      "Hash of the transaction to query",
+	"gettxlabel--result0":  "The transaction label, or the empty string if the transaction is not labeled",
+
 	// HelpCmd help.
 	"help--synopsis":   "Returns a list of all commands or help for a specified command.",
 	"help-command":     "The command to retrieve help for",
@@ -261,8 +401,30 @@ var helpDescsEnUS = map[string]string{
 	"listaccounts--result0--key":   "The account name",
 	"listaccounts--result0--value": "The account balance valued in aero",
 
+	// ListAddressLabelsCmd help.
+	"listaddresslabels--synopsis": "Returns the labels of all labeled wallet addresses.",
+
+	// AddressLabelResult help.
+	"addresslabelresult-address": "The labeled wallet address",
+	"addresslabelresult-label":   "The address label",
+
 	// ListLockUnspentCmd help.
-	"listlockunspent--synopsis": "Returns a JSON array of outpoints marked as locked (with lockunspent) for this wallet session.",
+	"listlockunspent--synopsis": "Returns a JSON array of outpoints marked as locked (with lockunspent) for this wallet.",
+
+	// LockedOutpointResult help.
+	"lockedoutpointresult-txid":         "The transaction hash of the locked output",
+	"lockedoutpointresult-vout":         "The output index of the locked output",
+	"lockedoutpointresult-tree":         "The tree of the locked output",
+	"lockedoutpointresult-reason":       "The reason the output was locked, if any",
+	"lockedoutpointresult-expiryheight": "The block height at which the lock expires, or 0 if the lock does not expire",
+
+	// ListPayeesCmd help.
+	"listpayees--synopsis": "Returns all payees saved in the wallet's address book.",
+
+	// PayeeResult help.
+	"payeeresult-name":    "The name of the payee",
+	"payeeresult-address": "The saved payment address of the payee",
+	"payeeresult-note":    "An optional note about the payee",
 
 	// TransactionInput help.
 	"transactioninput-txid": "The transaction hash of the referenced output",
@@ -325,7 +487,7 @@ var helpDescsEnUS = map[string]string{
 	"listtransactionsresult-time":              "The earliest Unix time this transaction was known to exist",
 	"listtransactionsresult-timereceived":      "The earliest Unix time this transaction was known to exist",
 	"listtransactionsresult-involveswatchonly": "Unset",
-	"listtransactionsresult-comment":           "Unset",
+	"listtransactionsresult-comment":           "The transaction label, if any",
 	"listtransactionsresult-otheraccount":      "Unset",
 	"listtransactionsresult-txtype":            "The type of tx (regular tx, stake tx)",
 
@@ -336,6 +498,22 @@ var helpDescsEnUS = map[string]string{
 	"listtransactions-from":             "Number of transactions to skip before results are created",
 	"listtransactions-includewatchonly": "Unused",
 
+	// ListUnminedTransactionsCmd help.
+	"listunminedtransactions--synopsis": "Returns the broadcast state of every unmined wallet transaction.\n" +
+		"Unmined transactions are rebroadcast with an exponentially increasing delay between attempts.",
+
+	// UnminedTransactionResult help.
+	"unminedtransactionresult-txid":        "The hash of the unmined transaction",
+	"unminedtransactionresult-firstseen":   "The Unix time the transaction was added to the wallet",
+	"unminedtransactionresult-lastseen":    "The Unix time the transaction was last accepted by the consensus server",
+	"unminedtransactionresult-attempts":    "The number of times the transaction was broadcast since the wallet was started",
+	"unminedtransactionresult-lastattempt": "The Unix time of the latest broadcast, if any",
+	"unminedtransactionresult-lasterror":   "The reason the latest broadcast was rejected, if any",
+	"unminedtransactionresult-nextattempt": "The earliest Unix time the transaction will be rebroadcast, if scheduled",
+
+	// ListVaultsCmd help.
+	"listvaults--synopsis": "Returns every saved time locked vault.",
+
 	// ListUnspentCmd help.
 	"listunspent--synopsis": "Returns a JSON array of objects representing unlocked unspent outputs controlled by wallet keys.",
 	"listunspent-minconf":   "Minimum number of block confirmations required before a transaction output is considered",
@@ -358,7 +536,7 @@ var helpDescsEnUS = map[string]string{
 	// LockUnspentCmd help.
 	"lockunspent--synopsis": "Locks or unlocks an unspent output.\n" +
 		"Locked outputs are not chosen for transaction inputs of authored transactions and are not included in 'listunspent' results.\n" +
-		"Locked outputs are volatile and are not saved across wallet restarts.\n" +
+		"Locked outputs are saved across wallet restarts and are automatically unlocked when they are spent.\n" +
 		"If unlock is true and no transaction outputs are specified, all locked outputs are marked unlocked.",
 	"lockunspent-unlock":       "True to unlock outputs, false to lock",
 	"lockunspent-transactions": "Transaction outputs to lock or unlock",
@@ -372,15 +550,16 @@ var helpDescsEnUS = map[string]string{
 	"sendfrom-amount":      "Amount to send to the payment address valued in aero",
 	"sendfrom-minconf":     "Minimum number of block confirmations required before a transaction output is eligible to be spent",
 	"sendfrom-comment":     "Unused",
-	"sendfrom-commentto":   "Unused",
+	"sendfrom-commentto":   "Name of a payee saved in the address book.  If set, the payment address must match the saved payee address",
 	"sendfrom--result0":    "The transaction hash of the sent transaction",
 
 	// SendManyCmd help.
 	"sendmany--synopsis": "Authors, signs, and sends a transaction that outputs to many payment addresses.\n" +
-		"A change output is automatically included to send extra output value back to the original account.",
+		"A change output is automatically included to send extra output value back to the original account.\n" +
+		"Use sendmanysubtractfee to subtract the fee from the amounts paid.",
 	"sendmany-fromaccount":    "DEPRECATED -- Account to pick unspent outputs from",
 	"sendmany-amounts":        "Pairs of payment addresses and the output amount to pay each",
-	"sendmany-amounts--desc":  "JSON object using payment addresses as keys and output amounts valued in aero to send to each address",
+	"sendmany-amounts--desc":  "JSON object using payment addresses as keys and output amounts valued in aero to send to each address.  A key of \"data:\" followed by up to 256 hex encoded bytes with a zero amount adds a null data output carrying the bytes",
 	"sendmany-amounts--key":   "Address to pay",
 	"sendmany-amounts--value": "Amount to send to the payment address valued in aero",
 	"sendmany-minconf":        "Minimum number of block confirmations required before a transaction output is eligible to be spent",
@@ -390,11 +569,12 @@ var helpDescsEnUS = map[string]string{
 	// SendToAddressCmd help.
 	"sendtoaddress--synopsis": "Authors, signs, and sends a transaction that outputs some amount to a payment address.\n" +
 		"Unlike sendfrom, outputs are always chosen from the default account.\n" +
-		"A change output is automatically included to send extra output value back to the original account.",
+		"A change output is automatically included to send extra output value back to the original account.\n" +
+		"Use sendtoaddresssubtractfee to subtract the fee from the amount paid.",
 	"sendtoaddress-address":   "Address to pay",
 	"sendtoaddress-amount":    "Amount to send to the payment address valued in aero",
 	"sendtoaddress-comment":   "Unused",
-	"sendtoaddress-commentto": "Unused",
+	"sendtoaddress-commentto": "Name of a payee saved in the address book.  If set, the payment address must match the saved payee address",
 	"sendtoaddress--result0":  "The transaction hash of the sent transaction",
 
 	// SendToMultisigCmd help.
@@ -409,20 +589,68 @@ var helpDescsEnUS = map[string]string{
 	"sendtomultisig-comment":     "Unused",
 	"sendtomultisig--result0":    "The transaction hash of the sent transaction",
 
+	// SendWithCoinControlCmd help.
+	"sendwithcoincontrol--synopsis": "Authors, signs, and sends a transaction that outputs to many payment addresses, spending outputs chosen by the caller.\n" +
+		"If inputs are specified, exactly these outputs are spent and no others are selected.\n" +
+		"Otherwise, all included outputs are spent and any additional outputs are selected automatically, never selecting excluded or locked outputs.\n" +
+		"Inputs and included outputs must be unspent and unlocked outputs of the account with at least minconf confirmations.\n" +
+		"A change output is automatically included to send extra output value back to the original account.",
+	"sendwithcoincontrol-fromaccount":    "Account to pick unspent outputs from",
+	"sendwithcoincontrol-amounts":        "Pairs of payment addresses and the output amount to pay each",
+	"sendwithcoincontrol-amounts--desc":  "JSON object using payment addresses as keys and output amounts valued in aero to send to each address",
+	"sendwithcoincontrol-amounts--key":   "Address to pay",
+	"sendwithcoincontrol-amounts--value": "Amount to send to the payment address valued in aero",
+	"sendwithcoincontrol-minconf":        "Minimum number of block confirmations required before a transaction output is eligible to be spent",
+	"sendwithcoincontrol-inputs":         "The only outputs to spend; may not be combined with include",
+	"sendwithcoincontrol-include":        "Outputs which must be spent in addition to automatically selected outputs",
+	"sendwithcoincontrol-exclude":        "Outputs which must not be spent",
+	"sendwithcoincontrol-coinselection":  "Name of the coin selection strategy used to pick additional outputs (see setcoinselection); the wallet's strategy is used if unset",
+	"sendwithcoincontrol-expiry":         "Expiry of the transaction as a number of blocks after the next block, or as a block height when relativeexpiry is false; 0 creates a transaction which never expires, and the wallet's default expiry (see settxexpiry) is used if unset",
+	"sendwithcoincontrol-relativeexpiry": "Count the expiry as a number of blocks after the next block rather than as an absolute block height",
+	"sendwithcoincontrol--result0":       "The transaction hash of the sent transaction",
+
 	// SetGenerate help
 	"setgenerate--synopsis":    "Enable or disable stake mining",
 	"setgenerate-generate":     "True to enable stake mining, false to disable.",
 	"setgenerate-genproclimit": "Not used for stake mining",
 
+	// SetAddressLabelCmd help.
+	"setaddresslabel--synopsis": "Sets the label of a wallet address, replacing any previous label.",
+	"setaddresslabel-address":   "The wallet address to label",
+	"setaddresslabel-label":     "The new address label, or the empty string to remove the label",
+
+	// SetCoinSelectionCmd help.
+	"setcoinselection--synopsis": "Sets the default strategy used to select unspent outputs when authoring transactions.\n" +
+		"Strategies: default (database order), branchandbound (avoid change when possible), largestfirst, smallestfirst (consolidate outputs),\n" +
+		"oldestfirst, and privacy (avoid spending outputs of different addresses together).",
+	"setcoinselection-strategy": "The name of the coin selection strategy",
+
+	// SetPayeeCmd help.
+	"setpayee--synopsis": "Saves a named payee to the wallet's address book, replacing any previous payee with the same name.\n" +
+		"Sends which name the payee with the commentto parameter must pay to the saved address.",
+	"setpayee-name":    "The name of the payee",
+	"setpayee-address": "The payment address of the payee",
+	"setpayee-note":    "An optional note about the payee",
+
 	// SetTicketMaxPrice help.
 	"setticketmaxprice--synopsis": "Set the max price user is willing to pay for a ticket.",
 	"setticketmaxprice-max":       "The max price (in AER).",
 
+	// SetTxExpiryCmd help.
+	"settxexpiry--synopsis": "Sets the default expiry of authored transactions as a number of blocks after the current best block.\n" +
+		"Unmined transactions are removed from the wallet and their inputs are released when they expire.",
+	"settxexpiry-blocks": "The number of blocks in which transactions may be mined, or 0 to create transactions which never expire",
+
 	// SetTxFeeCmd help.
 	"settxfee--synopsis": "Modify the fee per kB of the serialized tx size used each time more fee is required for an authored transaction.",
 	"settxfee-amount":    "The new fee per kB of the serialized tx size valued in aero",
 	"settxfee--result0":  "The boolean 'true'",
 
+	// SetTxLabelCmd help.
+	"settxlabel--synopsis": "Sets the label of a transaction relevant to this wallet, replacing any previous label.",
+	"settxlabel-txid":      "Hash of the transaction to label",
+	"settxlabel-label":     "The new transaction label, or the empty string to remove the label",
+
 	// SetVoteChoice help.
 	"setvotechoice--synopsis": "Sets choices for defined agendas in the latest stake version supported by this software",
 	"setvotechoice-agendaid":  "The ID for the agenda to modify",
@@ -434,6 +662,19 @@ var helpDescsEnUS = map[string]string{
 	"signmessage-message":   "Message to sign",
 	"signmessage--result0":  "The signed message encoded as a base64 string",
 
+	// SignPartialTransactionCmd help.
+	"signpartialtransaction--synopsis": "Signs the inputs of a partially signed transaction which may be signed by wallet keys, finalizing the transaction if every input has been signed.\n" +
+		"Keys are found by the addresses of the previous outputs or by derivation paths verified against the wallet's account keys, so an offline wallet does not need to have synced its addresses.\n" +
+		"Nothing is signed unless the previous transaction of every input is known and matches the spent outpoint, and every redeem script matches the script hash of its previous output.\n" +
+		"The wallet must be unlocked for this request to succeed.",
+	"signpartialtransaction-hex": "The serialized partially signed transaction encoded as a hexadecimal string",
+
+	// SignPartialTransactionResult help.
+	"signpartialtransactionresult-hex":          "The serialized partially signed transaction encoded as a hexadecimal string",
+	"signpartialtransactionresult-signedinputs": "The indexes of the inputs which were signed",
+	"signpartialtransactionresult-complete":     "Whether every input has a signature script",
+	"signpartialtransactionresult-signedtx":     "The signed transaction encoded as a hexadecimal string, if complete",
+
 	// SignRawTransactionCmd help.
 	"signrawtransaction--synopsis": "Signs transaction inputs using private keys from this wallet and request.\n" +
 		"The valid flags options are ALL, NONE, SINGLE, ALL|ANYONECANPAY, NONE|ANYONECANPAY, and SINGLE|ANYONECANPAY.",
@@ -468,26 +709,29 @@ var helpDescsEnUS = map[string]string{
 	// ValidateAddressCmd help.
 	"validateaddress--synopsis": "Verify that an address is valid.\n" +
 		"Extra details are returned if the address is controlled by this wallet.\n" +
-		"The following fields are valid only when the address is controlled by this wallet (ismine=true): isscript, pubkey, iscompressed, account, addresses, hex, script, and sigsrequired.\n" +
+		"The following fields are valid only when the address is controlled by this wallet (ismine=true): isscript, pubkey, iscompressed, account, label, addresses, hex, script, and sigsrequired.\n" +
 		"The following fields are only valid when address has an associated public key: pubkey, iscompressed.\n" +
 		"The following fields are only valid when address is a pay-to-script-hash address: addresses, hex, and script.\n" +
-		"If the address is a multisig address controlled by this wallet, the multisig fields will be left unset if the wallet is locked since the redeem script cannot be decrypted.",
+		"If the address is a multisig address controlled by this wallet, the multisig fields will be left unset if the wallet is locked since the redeem script cannot be decrypted.\n" +
+		"The payees field lists the names of all address book payees saved with this address.",
 	"validateaddress-address": "Address to validate",
 
-	// ValidateAddressWalletResult help.
-	"validateaddresswalletresult-isvalid":      "Whether or not the address is valid",
-	"validateaddresswalletresult-address":      "The payment address (only when isvalid is true)",
-	"validateaddresswalletresult-ismine":       "Whether this address is controlled by the wallet (only when isvalid is true)",
-	"validateaddresswalletresult-iswatchonly":  "Unset",
-	"validateaddresswalletresult-isscript":     "Whether the payment address is a pay-to-script-hash address (only when isvalid is true)",
-	"validateaddresswalletresult-pubkey":       "The associated public key of the payment address, if any (only when isvalid is true)",
-	"validateaddresswalletresult-iscompressed": "Whether the address was created by hashing a compressed public key, if any (only when isvalid is true)",
-	"validateaddresswalletresult-account":      "The account this payment address belongs to (only when isvalid is true)",
-	"validateaddresswalletresult-addresses":    "All associated payment addresses of the script if address is a multisig address (only when isvalid is true)",
-	"validateaddresswalletresult-pubkeyaddr":   "The pubkey for this payment address (only when isvalid is true)",
-	"validateaddresswalletresult-hex":          "The redeem script ",
-	"validateaddresswalletresult-script":       "The class of redeem script for a multisig address",
-	"validateaddresswalletresult-sigsrequired": "The number of required signatures to redeem outputs to the multisig address",
+	// ValidateAddressResult help.
+	"validateaddressresult-isvalid":      "Whether or not the address is valid",
+	"validateaddressresult-address":      "The payment address (only when isvalid is true)",
+	"validateaddressresult-ismine":       "Whether this address is controlled by the wallet (only when isvalid is true)",
+	"validateaddressresult-iswatchonly":  "Unset",
+	"validateaddressresult-isscript":     "Whether the payment address is a pay-to-script-hash address (only when isvalid is true)",
+	"validateaddressresult-pubkey":       "The associated public key of the payment address, if any (only when isvalid is true)",
+	"validateaddressresult-iscompressed": "Whether the address was created by hashing a compressed public key, if any (only when isvalid is true)",
+	"validateaddressresult-account":      "The account this payment address belongs to (only when isvalid is true)",
+	"validateaddressresult-addresses":    "All associated payment addresses of the script if address is a multisig address (only when isvalid is true)",
+	"validateaddressresult-pubkeyaddr":   "The pubkey for this payment address (only when isvalid is true)",
+	"validateaddressresult-hex":          "The redeem script ",
+	"validateaddressresult-script":       "The class of redeem script for a multisig address",
+	"validateaddressresult-sigsrequired": "The number of required signatures to redeem outputs to the multisig address",
+	"validateaddressresult-label":        "The label of the payment address, if any (only when ismine is true)",
+	"validateaddressresult-payees":       "Names of address book payees saved with this payment address (only when isvalid is true)",
 
 	// VerifyMessageCmd help.
 	"verifymessage--synopsis": "Verify a message was signed with the associated private key of some address.",
@@ -571,11 +815,140 @@ var helpDescsEnUS = map[string]string{
 	// RevokeTickets help.
 	"revoketickets--synopsis": "Requests the wallet create revovactions for any previously missed tickets.  Wallet must be unlocked.",
 
+	// SearchTxLabelsCmd help.
+	"searchtxlabels--synopsis": "Returns all transaction labels containing a query string.  The search ignores case.",
+	"searchtxlabels-query":     "The string to search for, or unset to return all labels",
+
+	// TxLabelResult help.
+	"txlabelresult-txid":  "The hash of the labeled transaction",
+	"txlabelresult-label": "The transaction label",
+
+	// RemovePayeeCmd help.
+	"removepayee--synopsis": "Removes a payee from the wallet's address book.",
+	"removepayee-name":      "The name of the payee to remove",
+
+	// PreviewBumpFeeCmd help.
+	"previewbumpfee--synopsis": "Describes the child transaction that bumpfee would create with the same arguments.\n" +
+		"The transaction is not signed or published and no address is returned.",
+	"previewbumpfee-txid":    "Hash of the unmined transaction to bump the fee of",
+	"previewbumpfee-feerate": "The target fee per kB of the combined serialized size of both transactions valued in aero",
+
+	// PreviewConsolidateCmd help.
+	"previewconsolidate--synopsis": "Describes the transaction that consolidate would create with the same arguments.\n" +
+		"The transaction is not signed or published, no address is returned, and no outputs are locked.",
+	"previewconsolidate-inputs":  "Number of UTXOs to consolidate as inputs",
+	"previewconsolidate-account": "Optional: Account from which unspent outputs are picked. When no address specified, also the account used to obtain an output address.",
+	"previewconsolidate-address": "Optional: Address to pay.  Default is the next address of the account's internal branch.",
+
+	// PreviewPurchaseTicketCmd help.
+	"previewpurchaseticket--synopsis": "Describes the split transaction and tickets that purchaseticket would create with the same arguments.\n" +
+		"Nothing is signed or published, no addresses are returned, and no outputs are locked.",
+	"previewpurchaseticket-fromaccount":   "The account to use for purchase (default=\"default\")",
+	"previewpurchaseticket-spendlimit":    "Limit on the amount to spend on ticket",
+	"previewpurchaseticket-minconf":       "Minimum number of block confirmations required",
+	"previewpurchaseticket-ticketaddress": "Override the ticket address to which voting rights are given",
+	"previewpurchaseticket-numtickets":    "The number of tickets to purchase",
+	"previewpurchaseticket-pooladdress":   "The address to pay stake pool fees to",
+	"previewpurchaseticket-poolfees":      "The amount of fees to pay to the stake pool",
+	"previewpurchaseticket-expiry":        "Height at which the purchase tickets expire",
+
+	// TicketPurchasePreviewResult help.
+	"ticketpurchasepreviewresult-splittx":             "The split transaction creating the outputs spent by the tickets",
+	"ticketpurchasepreviewresult-numtickets":          "The number of tickets that would be purchased",
+	"ticketpurchasepreviewresult-ticketprice":         "The current ticket price",
+	"ticketpurchasepreviewresult-ticketfee":           "The fee paid by each ticket",
+	"ticketpurchasepreviewresult-ticketfeerate":       "The fee per kB paid by each ticket",
+	"ticketpurchasepreviewresult-estimatedticketsize": "The estimated size of each signed ticket",
+	"ticketpurchasepreviewresult-poolfee":             "The fee paid to the stake pool by each ticket, or zero when no pool is used",
+
+	// PreviewSendManyCmd help.
+	"previewsendmany--synopsis": "Describes the transaction that sendmany would create with the same arguments.\n" +
+		"If subtractfeefrom is set, the fee is subtracted from the amounts paid to those addresses as with sendmanysubtractfee.\n" +
+		"The transaction is not signed or published, no change address is returned, and no outputs are locked.",
+	"previewsendmany-fromaccount":     "Account to pick unspent outputs from",
+	"previewsendmany-amounts":         "Pairs of payment addresses and the output amount to pay each",
+	"previewsendmany-amounts--desc":   "JSON object using payment addresses as keys and output amounts valued in aero to send to each address.  A key of \"data:\" followed by up to 256 hex encoded bytes with a zero amount adds a null data output carrying the bytes",
+	"previewsendmany-amounts--key":    "Address to pay",
+	"previewsendmany-amounts--value":  "Amount to send to the payment address valued in aero",
+	"previewsendmany-minconf":         "Minimum number of block confirmations required before a transaction output is eligible to be spent",
+	"previewsendmany-subtractfeefrom": "Payment addresses whose amounts pay the fee",
+	"previewsendmany-coinselection":   "Name of the coin selection strategy used to pick outputs (see setcoinselection); the wallet's strategy is used if unset",
+	"previewsendmany-expiry":          "Expiry of the transaction as a number of blocks after the next block, or as a block height when relativeexpiry is false; 0 creates a transaction which never expires, and the wallet's default expiry (see settxexpiry) is used if unset",
+	"previewsendmany-relativeexpiry":  "Count the expiry as a number of blocks after the next block rather than as an absolute block height",
+
+	// PreviewSendToMultiSigCmd help.
+	"previewsendtomultisig--synopsis": "Describes the transaction that sendtomultisig would create with the same arguments.\n" +
+		"The transaction is not signed or published, the multisig script is not imported, and no outputs are locked.",
+	"previewsendtomultisig-amount":    "Amount to send to the payment address valued in aero",
+	"previewsendtomultisig-pubkeys":   "Pubkey to send to.",
+	"previewsendtomultisig-nrequired": "The number of signatures required to redeem outputs paid to this address",
+	"previewsendtomultisig-minconf":   "Minimum number of block confirmations required",
+
+	// PreviewSendToSStxCmd help.
+	"previewsendtosstx--synopsis": "Describes the ticket that sendtosstx would create with the same arguments.\n" +
+		"The ticket is not signed or published.  The estimated size assumes every input redeems a P2PKH output.",
+	"previewsendtosstx-amounts":        "Amounts to send",
+	"previewsendtosstx-amounts--desc":  "Unused",
+	"previewsendtosstx-amounts--key":   "Key",
+	"previewsendtosstx-amounts--value": "Value",
+	"previewsendtosstx-inputs":         "Inputs for the tx",
+	"previewsendtosstx-couts":          "Couts for the tx",
+
+	// TxPreviewResult help.
+	"txpreviewresult-hex":           "The unsigned transaction",
+	"txpreviewresult-inputs":        "The outputs spent by the transaction",
+	"txpreviewresult-totalinput":    "The total value of the spent outputs",
+	"txpreviewresult-totaloutput":   "The total value of the transaction outputs",
+	"txpreviewresult-changeindex":   "The output index of the change output, or -1 if there is no change",
+	"txpreviewresult-estimatedsize": "The estimated size of the transaction once signed",
+	"txpreviewresult-fee":           "The fee paid by the transaction",
+	"txpreviewresult-feerate":       "The fee per kB of the estimated signed size",
+
 	// RenameAccountCmd help.
 	"renameaccount--synopsis":  "Renames an account.",
 	"renameaccount-oldaccount": "The old account name to rename",
 	"renameaccount-newaccount": "The new name for the account",
 
+	// SendManySubtractFeeCmd help.
+	"sendmanysubtractfee--synopsis": "Authors, signs, and sends a transaction that outputs to many payment addresses, subtracting the fee from the amounts paid to some addresses.\n" +
+		"The fee is split evenly between the amounts paid to each address in subtractfeefrom, rather than being paid in addition to the amounts.\n" +
+		"A change output is automatically included to send extra output value back to the original account.\n" +
+		"This is a separate command because the parameters of sendmany are defined by the abcjson package shared with abcd and cannot be extended by the wallet.",
+	"sendmanysubtractfee-fromaccount":     "Account to pick unspent outputs from",
+	"sendmanysubtractfee-amounts":         "Pairs of payment addresses and the output amount to pay each",
+	"sendmanysubtractfee-amounts--desc":   "JSON object using payment addresses as keys and output amounts valued in aero to send to each address.  A key of \"data:\" followed by up to 256 hex encoded bytes with a zero amount adds a null data output carrying the bytes",
+	"sendmanysubtractfee-amounts--key":    "Address to pay",
+	"sendmanysubtractfee-amounts--value":  "Amount to send to the payment address valued in aero",
+	"sendmanysubtractfee-subtractfeefrom": "Payment addresses whose amounts pay the fee",
+	"sendmanysubtractfee-minconf":         "Minimum number of block confirmations required before a transaction output is eligible to be spent",
+	"sendmanysubtractfee-expiry":          "Expiry of the transaction as a number of blocks after the next block, or as a block height when relativeexpiry is false; 0 creates a transaction which never expires, and the wallet's default expiry (see settxexpiry) is used if unset",
+	"sendmanysubtractfee-relativeexpiry":  "Count the expiry as a number of blocks after the next block rather than as an absolute block height",
+	"sendmanysubtractfee--result0":        "The transaction hash of the sent transaction",
+
+	// SendToAddressSubtractFeeCmd help.
+	"sendtoaddresssubtractfee--synopsis": "Authors, signs, and sends a transaction that outputs some amount, less the fee, to a payment address.\n" +
+		"Like sendtoaddress, outputs are always chosen from the default account.\n" +
+		"A change output is automatically included to send extra output value back to the original account.\n" +
+		"This is a separate command because the parameters of sendtoaddress are defined by the abcjson package shared with abcd and cannot be extended by the wallet.",
+	"sendtoaddresssubtractfee-address":        "Address to pay",
+	"sendtoaddresssubtractfee-amount":         "Amount valued in aero to spend, including the fee",
+	"sendtoaddresssubtractfee-expiry":         "Expiry of the transaction as a number of blocks after the next block, or as a block height when relativeexpiry is false; 0 creates a transaction which never expires, and the wallet's default expiry (see settxexpiry) is used if unset",
+	"sendtoaddresssubtractfee-relativeexpiry": "Count the expiry as a number of blocks after the next block rather than as an absolute block height",
+	"sendtoaddresssubtractfee--result0":       "The transaction hash of the sent transaction",
+
+	// SweepAccountCmd help.
+	"sweepaccount--synopsis": "Authors, signs, and sends a transaction spending every spendable output of an account.\n" +
+		"The total output value, less the fee, is split between the destination addresses by their ratios and no change output is created.\n" +
+		"Locked outputs are not spent.",
+	"sweepaccount-sourceaccount":       "Account to sweep",
+	"sweepaccount-destinations":        "Pairs of destination addresses and the ratio of the swept value to pay each",
+	"sweepaccount-destinations--desc":  "JSON object using destination addresses as keys and positive ratios as values",
+	"sweepaccount-destinations--key":   "Address to pay",
+	"sweepaccount-destinations--value": "Share of the swept value to pay the address, relative to the other ratios",
+	"sweepaccount-minconf":             "Minimum number of block confirmations required before a transaction output is swept",
+	"sweepaccount-minvalue":            "Minimum value of a transaction output, valued in aero, for it to be swept",
+	"sweepaccount--result0":            "The transaction hash of the sent transaction",
+
 	// WalletIsLockedCmd help.
 	"walletislocked--synopsis": "Returns whether or not the wallet is locked.",
 	"walletislocked--result0":  "Whether the wallet is locked",
@@ -7,7 +7,10 @@
 
 package rpchelp
 
-import "github.com/abcsuite/abcd/abcjson"
+import (
+	"github.com/abcsuite/abcd/abcjson"
+	"github.com/abcsuite/abcwallet/rpc/walletjson"
+)
 
 // Common return types.
 var (
@@ -24,15 +27,24 @@ var Methods = []struct {
 	Method      string
 	ResultTypes []interface{}
 }{
+	{"abandontransaction", returnsStringArray},
 	{"accountaddressindex", []interface{}{(*int)(nil)}},
 	{"accountsyncaddressindex", nil},
 	{"addmultisigaddress", returnsString},
+	{"backupwallet", nil},
+	{"bumpfee", []interface{}{(*walletjson.BumpFeeResult)(nil)}},
+	{"checkconsistency", []interface{}{(*walletjson.CheckConsistencyResult)(nil)}},
 	{"consolidate", returnsString},
 	{"createmultisig", []interface{}{(*abcjson.CreateMultiSigResult)(nil)}},
+	{"createmultisigaccount", []interface{}{(*uint32)(nil)}},
+	{"createpartialtransaction", returnsString},
+	{"createvault", []interface{}{(*walletjson.VaultResult)(nil)}},
+	{"describepartialtransaction", []interface{}{(*walletjson.DescribePartialTransactionResult)(nil)}},
 	{"dumpprivkey", returnsString},
 	{"getaccount", returnsString},
 	{"getaccountaddress", returnsString},
 	{"getaddressesbyaccount", returnsStringArray},
+	{"getaddresslabel", returnsString},
 	{"getbalance", append(returnsNumber, returnsNumber[0])},
 	{"getbestblockhash", returnsString},
 	{"getblockcount", returnsNumber},
@@ -45,33 +57,47 @@ var Methods = []struct {
 	{"getreceivedbyaddress", returnsNumber},
 	{"gettickets", []interface{}{(*abcjson.GetTicketsResult)(nil)}},
 	{"gettransaction", []interface{}{(*abcjson.GetTransactionResult)(nil)}},
+	{"gettxlabel", returnsString},
 	{"getvotechoices", []interface{}{(*abcjson.GetVoteChoicesResult)(nil)}},
 	{"help", append(returnsString, returnsString[0])},
 	{"importprivkey", nil},
 	{"importscript", nil},
 	{"keypoolrefill", nil},
 	{"listaccounts", []interface{}{(*map[string]float64)(nil)}},
-	{"listlockunspent", []interface{}{(*[]abcjson.TransactionInput)(nil)}},
+	{"listaddresslabels", []interface{}{(*[]walletjson.AddressLabelResult)(nil)}},
+	{"listlockunspent", []interface{}{(*[]walletjson.LockedOutpointResult)(nil)}},
+	{"listpayees", []interface{}{(*[]walletjson.PayeeResult)(nil)}},
 	{"listreceivedbyaccount", []interface{}{(*[]abcjson.ListReceivedByAccountResult)(nil)}},
 	{"listreceivedbyaddress", []interface{}{(*[]abcjson.ListReceivedByAddressResult)(nil)}},
 	{"listsinceblock", []interface{}{(*abcjson.ListSinceBlockResult)(nil)}},
 	{"listtransactions", returnsLTRArray},
+	{"listunminedtransactions", []interface{}{(*[]walletjson.UnminedTransactionResult)(nil)}},
 	{"listunspent", []interface{}{(*abcjson.ListUnspentResult)(nil)}},
+	{"listvaults", []interface{}{(*[]walletjson.VaultResult)(nil)}},
 	{"lockunspent", returnsBool},
 	{"redeemmultisigout", []interface{}{(*abcjson.RedeemMultiSigOutResult)(nil)}},
 	{"redeemmultisigouts", []interface{}{(*abcjson.RedeemMultiSigOutResult)(nil)}},
+	{"removepayee", nil},
 	{"rescanwallet", nil},
 	{"revoketickets", nil},
+	{"searchtxlabels", []interface{}{(*[]walletjson.TxLabelResult)(nil)}},
 	{"sendfrom", returnsString},
 	{"sendmany", returnsString},
 	{"sendtoaddress", returnsString},
 	{"sendtomultisig", returnsString},
+	{"sendwithcoincontrol", returnsString},
+	{"setaddresslabel", nil},
+	{"setcoinselection", nil},
+	{"setpayee", nil},
+	{"settxexpiry", nil},
 	{"settxfee", returnsBool},
+	{"settxlabel", nil},
 	{"setvotechoice", nil},
 	{"signmessage", returnsString},
+	{"signpartialtransaction", []interface{}{(*walletjson.SignPartialTransactionResult)(nil)}},
 	{"signrawtransaction", []interface{}{(*abcjson.SignRawTransactionResult)(nil)}},
 	{"signrawtransactions", []interface{}{(*abcjson.SignRawTransactionsResult)(nil)}},
-	{"validateaddress", []interface{}{(*abcjson.ValidateAddressWalletResult)(nil)}},
+	{"validateaddress", []interface{}{(*walletjson.ValidateAddressResult)(nil)}},
 	{"verifymessage", returnsBool},
 	{"version", []interface{}{(*map[string]abcjson.VersionResult)(nil)}},
 	{"walletlock", nil},
@@ -83,7 +109,16 @@ var Methods = []struct {
 	{"getunconfirmedbalance", returnsNumber},
 	{"listaddresstransactions", returnsLTRArray},
 	{"listalltransactions", returnsLTRArray},
+	{"previewbumpfee", []interface{}{(*walletjson.BumpFeeResult)(nil)}},
+	{"previewconsolidate", []interface{}{(*walletjson.TxPreviewResult)(nil)}},
+	{"previewpurchaseticket", []interface{}{(*walletjson.TicketPurchasePreviewResult)(nil)}},
+	{"previewsendmany", []interface{}{(*walletjson.TxPreviewResult)(nil)}},
+	{"previewsendtomultisig", []interface{}{(*walletjson.TxPreviewResult)(nil)}},
+	{"previewsendtosstx", []interface{}{(*walletjson.TxPreviewResult)(nil)}},
 	{"renameaccount", nil},
+	{"sendmanysubtractfee", returnsString},
+	{"sendtoaddresssubtractfee", returnsString},
+	{"sweepaccount", returnsString},
 	{"walletislocked", returnsBool},
 	{"walletinfo", []interface{}{(*abcjson.WalletInfoResult)(nil)}},
 
@@ -0,0 +1,115 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package loader
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Scheduled backups are named by the time they were written so that sorting
+// the file names also sorts the backups from oldest to newest.
+const (
+	scheduledBackupPrefix     = "wallet-"
+	scheduledBackupSuffix     = ".db"
+	scheduledBackupTimeLayout = "20060102-150405"
+)
+
+// BackupSchedule describes periodic backups of the loaded wallet.
+type BackupSchedule struct {
+	// Dir is the directory scheduled backups are written to.  It is created
+	// if it does not exist.
+	Dir string
+
+	// Interval is the duration between each backup.
+	Interval time.Duration
+
+	// Retention is the number of most recent scheduled backups kept in Dir.
+	// Older backups are removed after each new backup is written.
+	Retention int
+}
+
+// RunBackupSchedule writes a backup of the loaded wallet to the schedule's
+// directory each interval and removes the oldest scheduled backups exceeding
+// the retention count.  Intervals that pass while no wallet is loaded are
+// skipped.  This function blocks until quit is closed.
+func (l *Loader) RunBackupSchedule(s *BackupSchedule, quit <-chan struct{}) {
+	ticker := time.NewTicker(s.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-quit:
+			return
+		case t := <-ticker.C:
+			err := l.scheduledBackup(s, t)
+			switch err {
+			case nil:
+			case ErrWalletNotLoaded:
+				log.Debugf("Skipping scheduled backup: %v", err)
+			default:
+				log.Errorf("Scheduled wallet backup failed: %v", err)
+			}
+		}
+	}
+}
+
+// scheduledBackup writes a single scheduled backup named by the time t and
+// prunes old backups.
+func (l *Loader) scheduledBackup(s *BackupSchedule, t time.Time) error {
+	w, ok := l.LoadedWallet()
+	if !ok {
+		return ErrWalletNotLoaded
+	}
+
+	err := os.MkdirAll(s.Dir, 0700)
+	if err != nil {
+		return err
+	}
+
+	name := scheduledBackupPrefix + t.UTC().Format(scheduledBackupTimeLayout) +
+		scheduledBackupSuffix
+	path := filepath.Join(s.Dir, name)
+	err = w.BackupWallet(path)
+	if err != nil {
+		return err
+	}
+	log.Infof("Wrote wallet backup %v", path)
+
+	return pruneBackups(s.Dir, s.Retention)
+}
+
+// pruneBackups removes all but the newest retention scheduled backups in dir.
+func pruneBackups(dir string, retention int) error {
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	var names []string
+	for _, fi := range infos {
+		name := fi.Name()
+		if fi.Mode().IsRegular() && strings.HasPrefix(name, scheduledBackupPrefix) &&
+			strings.HasSuffix(name, scheduledBackupSuffix) {
+			names = append(names, name)
+		}
+	}
+	if len(names) <= retention {
+		return nil
+	}
+	sort.Strings(names)
+	for _, name := range names[:len(names)-retention] {
+		path := filepath.Join(dir, name)
+		err := os.Remove(path)
+		if err != nil {
+			return err
+		}
+		log.Debugf("Removed old wallet backup %v", path)
+	}
+	return nil
+}
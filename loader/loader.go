@@ -12,14 +12,21 @@ import (
 
 	"github.com/abcsuite/abcd/chaincfg"
 	"github.com/abcsuite/abcrpcclient"
+	"github.com/abcsuite/abcwallet/apperrors"
 	"github.com/abcsuite/abcwallet/ticketbuyer"
 	"github.com/abcsuite/abcwallet/wallet"
 	"github.com/abcsuite/abcwallet/walletdb"
 	_ "github.com/abcsuite/abcwallet/walletdb/bdb" // driver loaded during init
+	"github.com/abcsuite/abcwallet/walletdb/encdb"
+	_ "github.com/abcsuite/abcwallet/walletdb/memdb" // driver loaded during init
 )
 
 const (
 	walletDbName = "wallet.db"
+
+	// Database drivers which may be used by the loader.
+	boltDBDriver   = "bdb"
+	memoryDBDriver = "memdb"
 )
 
 // Loader implements the creating of new and opening of existing wallets, while
@@ -34,6 +41,7 @@ type Loader struct {
 	chainClient *abcrpcclient.Client
 	chainParams *chaincfg.Params
 	dbDirPath   string
+	dbDriver    string
 	wallet      *wallet.Wallet
 	db          walletdb.DB
 	mu          sync.Mutex
@@ -66,6 +74,7 @@ func NewLoader(chainParams *chaincfg.Params, dbDirPath string, stakeOptions *Sta
 	return &Loader{
 		chainParams:    chainParams,
 		dbDirPath:      dbDirPath,
+		dbDriver:       boltDBDriver,
 		stakeOptions:   stakeOptions,
 		addrIdxScanLen: addrIdxScanLen,
 		allowHighFees:  allowHighFees,
@@ -73,6 +82,18 @@ func NewLoader(chainParams *chaincfg.Params, dbDirPath string, stakeOptions *Sta
 	}
 }
 
+// UseMemoryDB configures the loader to keep the databases of all wallets it
+// creates or opens in memory.  Created wallets are never written to the network
+// directory, and changes to opened wallets are not written back to the existing
+// database file.  All changes are lost when the wallet is unloaded.
+//
+// This is intended for throwaway simulation wallets and testing.
+func (l *Loader) UseMemoryDB() {
+	l.mu.Lock()
+	l.dbDriver = memoryDBDriver
+	l.mu.Unlock()
+}
+
 // onLoaded executes each added callback and prevents loader from loading any
 // additional wallets.  Requires mutex to be locked.
 func (l *Loader) onLoaded(w *wallet.Wallet, db walletdb.DB) {
@@ -111,66 +132,86 @@ func (l *Loader) CreateNewWallet(pubPassphrase, privPassphrase, seed []byte) (w
 		return nil, ErrWalletLoaded
 	}
 
+	// Databases kept in memory are not written to the network directory.
+	var db walletdb.DB
+	if l.dbDriver == memoryDBDriver {
+		db, err = walletdb.Create(memoryDBDriver)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		var dbPath string
+		db, dbPath, err = l.createBoltDB()
+		if err != nil {
+			return nil, err
+		}
+		// Attempt to remove database file if this function errors.
+		defer func() {
+			if err != nil {
+				_ = os.Remove(dbPath)
+			}
+		}()
+	}
+
+	// Initialize the newly created database for the wallet before opening.
+	err = wallet.Create(db, pubPassphrase, privPassphrase, seed, l.chainParams)
+	if err != nil {
+		return nil, err
+	}
+
+	// Open the newly-created wallet.
+	so := l.stakeOptions
+	w, err = wallet.Open(db, pubPassphrase, so.VotingEnabled, so.AddressReuse,
+		so.PruneTickets, so.TicketAddress, so.PoolAddress, so.PoolFees,
+		so.TicketFee, l.addrIdxScanLen, so.StakePoolColdExtKey, l.allowHighFees,
+		l.relayFee, l.chainParams)
+	if err != nil {
+		return nil, err
+	}
+	w.Start()
+
+	l.onLoaded(w, db)
+	return w, nil
+}
+
+// createBoltDB creates the bolt database file of a new wallet in the network
+// directory, creating the directory if necessary.
+func (l *Loader) createBoltDB() (walletdb.DB, string, error) {
 	// Ensure that the network directory exists.
 	if fi, err := os.Stat(l.dbDirPath); err != nil {
 		if os.IsNotExist(err) {
 			// Attempt data directory creation
 			if err = os.MkdirAll(l.dbDirPath, 0700); err != nil {
-				return nil, fmt.Errorf("cannot create directory: %s", err)
+				return nil, "", fmt.Errorf("cannot create directory: %s", err)
 			}
 		} else {
-			return nil, fmt.Errorf("error checking directory: %s", err)
+			return nil, "", fmt.Errorf("error checking directory: %s", err)
 		}
 	} else {
 		if !fi.IsDir() {
-			return nil, fmt.Errorf("path '%s' is not a directory", l.dbDirPath)
+			return nil, "", fmt.Errorf("path '%s' is not a directory", l.dbDirPath)
 		}
 	}
 
 	dbPath := filepath.Join(l.dbDirPath, walletDbName)
 	exists, err := fileExists(dbPath)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	if exists {
-		return nil, ErrWalletExists
+		return nil, "", ErrWalletExists
 	}
 
 	// Create the wallet database backed by bolt db.
 	err = os.MkdirAll(l.dbDirPath, 0700)
 	if err != nil {
-		return nil, err
-	}
-	db, err := walletdb.Create("bdb", dbPath)
-	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
-	// Attempt to remove database file if this function errors.
-	defer func() {
-		if err != nil {
-			_ = os.Remove(dbPath)
-		}
-	}()
-
-	// Initialize the newly created database for the wallet before opening.
-	err = wallet.Create(db, pubPassphrase, privPassphrase, seed, l.chainParams)
+	db, err := walletdb.Create(boltDBDriver, dbPath)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
-
-	// Open the newly-created wallet.
-	so := l.stakeOptions
-	w, err = wallet.Open(db, pubPassphrase, so.VotingEnabled, so.AddressReuse,
-		so.PruneTickets, so.TicketAddress, so.PoolAddress, so.PoolFees,
-		so.TicketFee, l.addrIdxScanLen, so.StakePoolColdExtKey, l.allowHighFees,
-		l.relayFee, l.chainParams)
-	if err != nil {
-		return nil, err
-	}
-	w.Start()
-
-	l.onLoaded(w, db)
-	return w, nil
+	return db, dbPath, nil
 }
 
 // OpenExistingWallet opens the wallet from the loader's wallet database path
@@ -185,9 +226,10 @@ func (l *Loader) OpenExistingWallet(pubPassphrase []byte, canConsolePrompt bool)
 		return nil, ErrWalletLoaded
 	}
 
-	// Open the database using the boltdb backend.
+	// Open the database using the boltdb backend, or read it into memory
+	// when databases are kept in memory.
 	dbPath := filepath.Join(l.dbDirPath, walletDbName)
-	db, err := walletdb.Open("bdb", dbPath)
+	db, err := walletdb.Open(l.dbDriver, dbPath)
 	if err != nil {
 		log.Errorf("Failed to open database: %v", err)
 		return nil, err
@@ -202,6 +244,24 @@ func (l *Loader) OpenExistingWallet(pubPassphrase []byte, canConsolePrompt bool)
 		}
 	}()
 
+	// Databases encrypted by the public passphrase must be wrapped to
+	// decrypt their data.
+	encrypted, err := encdb.Detect(db)
+	if err != nil {
+		return nil, err
+	}
+	if encrypted {
+		encDB, err := encdb.Wrap(db, pubPassphrase)
+		if err == encdb.ErrWrongPassphrase {
+			const str = "invalid public passphrase for encrypted database"
+			err = apperrors.E{ErrorCode: apperrors.ErrWrongPassphrase, Description: str, Err: err}
+		}
+		if err != nil {
+			return nil, err
+		}
+		db = encDB
+	}
+
 	so := l.stakeOptions
 	w, err = wallet.Open(db, pubPassphrase, so.VotingEnabled, so.AddressReuse,
 		so.PruneTickets, so.TicketAddress, so.PoolAddress, so.PoolFees,
@@ -13,6 +13,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -28,19 +31,26 @@ import (
 	"github.com/abcsuite/abcutil/hdkeychain"
 	"github.com/abcsuite/abcwallet/apperrors"
 	"github.com/abcsuite/abcwallet/chain"
+	"github.com/abcsuite/abcwallet/rpc/walletjson"
 	"github.com/abcsuite/abcwallet/wallet"
+	"github.com/abcsuite/abcwallet/wallet/pstx"
+	"github.com/abcsuite/abcwallet/wallet/txauthor"
 	"github.com/abcsuite/abcwallet/wallet/txrules"
 	"github.com/abcsuite/abcwallet/wallet/udb"
 )
 
 // API version constants
 const (
-	jsonrpcSemverString = "4.1.0"
+	jsonrpcSemverString = "4.10.0"
 	jsonrpcSemverMajor  = 4
-	jsonrpcSemverMinor  = 1
+	jsonrpcSemverMinor  = 10
 	jsonrpcSemverPatch  = 0
 )
 
+// nullDataKeyPrefix is the prefix of output amount keys which describe a null
+// data output rather than a payment address.
+const nullDataKeyPrefix = "data:"
+
 // confirms returns the number of confirmations for a transaction in a block at
 // height txHeight (or -1 for an unconfirmed tx) given the chain height
 // curHeight.
@@ -78,10 +88,14 @@ var rpcHandlers = map[string]struct {
 	noHelp bool
 }{
 	// Reference implementation wallet methods (implemented)
+	"abandontransaction":      {handler: abandonTransaction},
 	"accountaddressindex":     {handler: accountAddressIndex},
 	"accountsyncaddressindex": {handler: accountSyncAddressIndex},
 	"addmultisigaddress":      {handlerWithChain: addMultiSigAddress},
 	"addticket":               {handler: addTicket},
+	"backupwallet":            {handler: backupWallet},
+	"bumpfee":                 {handler: bumpFee},
+	"checkconsistency":        {handler: checkConsistency},
 	"consolidate":             {handler: consolidate},
 	"createmultisig":          {handler: createMultiSig},
 	"dumpprivkey":             {handler: dumpPrivKey},
@@ -89,6 +103,7 @@ var rpcHandlers = map[string]struct {
 	"getaccount":              {handler: getAccount},
 	"getaccountaddress":       {handler: getAccountAddress},
 	"getaddressesbyaccount":   {handler: getAddressesByAccount},
+	"getaddresslabel":         {handler: getAddressLabel},
 	"getbalance":              {handler: getBalance},
 	"getbestblockhash":        {handler: getBestBlockHash},
 	"getblockcount":           {handler: getBlockCount},
@@ -103,6 +118,7 @@ var rpcHandlers = map[string]struct {
 	"getticketfee":            {handler: getTicketFee},
 	"gettickets":              {handlerWithChain: getTickets},
 	"gettransaction":          {handler: getTransaction},
+	"gettxlabel":              {handler: getTxLabel},
 	"getvotechoices":          {handler: getVoteChoices},
 	"getwalletfee":            {handler: getWalletFee},
 	"help":                    {handler: helpNoChainRPC, handlerWithChain: helpWithChainRPC},
@@ -110,17 +126,22 @@ var rpcHandlers = map[string]struct {
 	"importscript":            {handlerWithChain: importScript},
 	"keypoolrefill":           {handler: keypoolRefill},
 	"listaccounts":            {handler: listAccounts},
+	"listaddresslabels":       {handler: listAddressLabels},
 	"listlockunspent":         {handler: listLockUnspent},
+	"listpayees":              {handler: listPayees},
 	"listreceivedbyaccount":   {handler: listReceivedByAccount},
 	"listreceivedbyaddress":   {handler: listReceivedByAddress},
 	"listsinceblock":          {handlerWithChain: listSinceBlock},
 	"listscripts":             {handler: listScripts},
 	"listtransactions":        {handler: listTransactions},
+	"listunminedtransactions": {handler: listUnminedTransactions},
 	"listunspent":             {handler: listUnspent},
 	"lockunspent":             {handler: lockUnspent},
 	"purchaseticket":          {handler: purchaseTicket},
+	"removepayee":             {handler: removePayee},
 	"rescanwallet":            {handlerWithChain: rescanWallet},
 	"revoketickets":           {handlerWithChain: revokeTickets},
+	"searchtxlabels":          {handler: searchTxLabels},
 	"sendfrom":                {handlerWithChain: sendFrom},
 	"sendmany":                {handler: sendMany},
 	"sendtoaddress":           {handler: sendToAddress},
@@ -128,8 +149,14 @@ var rpcHandlers = map[string]struct {
 	"sendtosstx":              {handlerWithChain: sendToSStx},
 	"sendtossgen":             {handler: sendToSSGen},
 	"sendtossrtx":             {handlerWithChain: sendToSSRtx},
+	"sendwithcoincontrol":     {handler: sendWithCoinControl},
+	"setaddresslabel":         {handler: setAddressLabel},
+	"setcoinselection":        {handler: setCoinSelection},
+	"setpayee":                {handler: setPayee},
 	"setticketfee":            {handler: setTicketFee},
+	"settxexpiry":             {handler: setTxExpiry},
 	"settxfee":                {handler: setTxFee},
+	"settxlabel":              {handler: setTxLabel},
 	"setvotechoice":           {handler: setVoteChoice},
 	"signmessage":             {handler: signMessage},
 	"signrawtransaction":      {handlerWithChain: signRawTransaction},
@@ -147,7 +174,6 @@ var rpcHandlers = map[string]struct {
 	"walletpassphrasechange":  {handler: walletPassphraseChange},
 
 	// Reference implementation methods (still unimplemented)
-	"backupwallet":         {handler: unimplemented, noHelp: true},
 	"getwalletinfo":        {handler: unimplemented, noHelp: true},
 	"importwallet":         {handler: unimplemented, noHelp: true},
 	"listaddressgroupings": {handler: unimplemented, noHelp: true},
@@ -160,17 +186,32 @@ var rpcHandlers = map[string]struct {
 	"setaccount":    {handler: unsupported, noHelp: true},
 
 	// Extensions to the reference client JSON-RPC API
-	"createnewaccount": {handler: createNewAccount},
-	"getbestblock":     {handler: getBestBlock},
+	"createmultisigaccount":      {handler: createMultisigAccount},
+	"createnewaccount":           {handler: createNewAccount},
+	"createpartialtransaction":   {handler: createPartialTransaction},
+	"createvault":                {handler: createVault},
+	"describepartialtransaction": {handler: describePartialTransaction},
+	"getbestblock":               {handler: getBestBlock},
 	// This was an extension but the reference implementation added it as
 	// well, but with a different API (no account parameter).  It's listed
 	// here because it hasn't been update to use the reference
 	// implemenation's API.
-	"getunconfirmedbalance":   {handler: getUnconfirmedBalance},
-	"listaddresstransactions": {handler: listAddressTransactions},
-	"listalltransactions":     {handler: listAllTransactions},
-	"renameaccount":           {handler: renameAccount},
-	"walletislocked":          {handler: walletIsLocked},
+	"getunconfirmedbalance":    {handler: getUnconfirmedBalance},
+	"listaddresstransactions":  {handler: listAddressTransactions},
+	"listalltransactions":      {handler: listAllTransactions},
+	"listvaults":               {handler: listVaults},
+	"previewbumpfee":           {handler: previewBumpFee},
+	"previewconsolidate":       {handler: previewConsolidate},
+	"previewpurchaseticket":    {handler: previewPurchaseTicket},
+	"previewsendmany":          {handler: previewSendMany},
+	"previewsendtomultisig":    {handler: previewSendToMultiSig},
+	"previewsendtosstx":        {handler: previewSendToSStx},
+	"renameaccount":            {handler: renameAccount},
+	"sendmanysubtractfee":      {handler: sendManySubtractFee},
+	"sendtoaddresssubtractfee": {handler: sendToAddressSubtractFee},
+	"signpartialtransaction":   {handler: signPartialTransaction},
+	"sweepaccount":             {handler: sweepAccount},
+	"walletislocked":           {handler: walletIsLocked},
 }
 
 // unimplemented handles an unimplemented RPC request with the
@@ -302,6 +343,31 @@ func jsonError(err error) *abcjson.RPCError {
 	}
 }
 
+// abandonTransaction handles an abandontransaction request by removing an
+// unmined transaction and all unmined transactions spending its outputs from
+// the wallet.  The hashes of all removed transactions are returned.
+func abandonTransaction(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.AbandonTransactionCmd)
+
+	txHash, err := chainhash.NewHashFromStr(cmd.Txid)
+	if err != nil {
+		return nil, &abcjson.RPCError{
+			Code:    abcjson.ErrRPCDecodeHexString,
+			Message: "Transaction hash string decode failed: " + err.Error(),
+		}
+	}
+
+	removed, err := w.AbandonTransaction(txHash)
+	if err != nil {
+		return nil, err
+	}
+	txids := make([]string, len(removed))
+	for i, hash := range removed {
+		txids[i] = hash.String()
+	}
+	return txids, nil
+}
+
 // accountAddressIndex returns the next address index for the passed
 // account and branch.
 func accountAddressIndex(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
@@ -354,6 +420,17 @@ func accountSyncAddressIndex(icmd interface{}, w *wallet.Wallet) (interface{}, e
 
 func makeMultiSigScript(w *wallet.Wallet, keys []string,
 	nRequired int) ([]byte, error) {
+
+	keysesPrecious, err := multiSigPubKeys(w, keys)
+	if err != nil {
+		return nil, err
+	}
+	return txscript.MultiSigScript(keysesPrecious, nRequired)
+}
+
+// multiSigPubKeys decodes the keys of a multisig script.  Each key may be
+// either a pubkey address or a pubkey hash address of the wallet.
+func multiSigPubKeys(w *wallet.Wallet, keys []string) ([]*abcutil.AddressSecpPubKey, error) {
 	keysesPrecious := make([]*abcutil.AddressSecpPubKey, len(keys))
 
 	// The address list will made up either of addreseses (pubkey hash), for
@@ -387,7 +464,7 @@ func makeMultiSigScript(w *wallet.Wallet, keys []string,
 		}
 	}
 
-	return txscript.MultiSigScript(keysesPrecious, nRequired)
+	return keysesPrecious, nil
 }
 
 // addMultiSigAddress handles an addmultisigaddress request by adding a
@@ -446,6 +523,117 @@ func addTicket(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 	return nil, err
 }
 
+// backupWallet handles a backupwallet request by writing a verified copy of
+// the wallet database to a new file at the destination path.
+func backupWallet(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*abcjson.BackupWalletCmd)
+
+	if cmd.Destination == "" {
+		return nil, InvalidParameterError{errors.New("destination may not be empty")}
+	}
+	return nil, w.BackupWallet(cmd.Destination)
+}
+
+// decodeBumpFeeArgs decodes the transaction hash and fee rate parameters of the
+// bumpfee and previewbumpfee requests.
+func decodeBumpFeeArgs(txid string, feeRate float64) (*chainhash.Hash, abcutil.Amount, error) {
+	txHash, err := chainhash.NewHashFromStr(txid)
+	if err != nil {
+		return nil, 0, &abcjson.RPCError{
+			Code:    abcjson.ErrRPCDecodeHexString,
+			Message: "Transaction hash string decode failed: " + err.Error(),
+		}
+	}
+	rate, err := abcutil.NewAmount(feeRate)
+	if err != nil {
+		return nil, 0, err
+	}
+	if rate <= 0 {
+		return nil, 0, InvalidParameterError{errors.New("feerate must be positive")}
+	}
+	return txHash, rate, nil
+}
+
+// bumpFeeResult creates the JSON-RPC result describing a child-pays-for-parent
+// transaction.
+func bumpFeeResult(bump *wallet.FeeBump) (*walletjson.BumpFeeResult, error) {
+	buf := bytes.NewBuffer(make([]byte, 0, bump.Tx.SerializeSize()))
+	err := bump.Tx.Serialize(buf)
+	if err != nil {
+		return nil, err
+	}
+	return &walletjson.BumpFeeResult{
+		Txid:           bump.Tx.TxHash().String(),
+		Hex:            hex.EncodeToString(buf.Bytes()),
+		Fee:            bump.Fee.ToCoin(),
+		EstimatedSize:  bump.EstimatedSignedSerializeSize,
+		ParentFee:      bump.ParentFee.ToCoin(),
+		ParentSize:     bump.ParentSize,
+		PackageFeeRate: bump.PackageFeeRate.ToCoin(),
+	}, nil
+}
+
+// bumpFee handles a bumpfee request by creating and publishing a transaction
+// spending the change of an unmined transaction, paying a fee so that both
+// transactions together pay the fee rate.
+func bumpFee(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.BumpFeeCmd)
+
+	txHash, feeRate, err := decodeBumpFeeArgs(cmd.Txid, cmd.FeeRate)
+	if err != nil {
+		return nil, err
+	}
+	bump, err := w.BumpFee(txHash, feeRate)
+	if err != nil {
+		return nil, sendOutputsError(err)
+	}
+	return bumpFeeResult(bump)
+}
+
+// checkConsistency handles a checkconsistency request by checking the
+// consistency of the wallet database.  Repairs are refused since they are not
+// reflected by the state of the running wallet, and must be performed with
+// --automaticrepair when the wallet is opened or with checkwalletdb.
+func checkConsistency(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.CheckConsistencyCmd)
+
+	if *cmd.Repair {
+		return nil, &abcjson.RPCError{
+			Code: abcjson.ErrRPCInvalidParameter,
+			Message: "repairs can not be performed by a running wallet; " +
+				"use --automaticrepair or checkwalletdb instead",
+		}
+	}
+
+	report, err := w.CheckConsistency(false)
+	if err != nil {
+		return nil, err
+	}
+
+	problems := make([]walletjson.ConsistencyProblemResult, 0, len(report.Problems))
+	for _, p := range report.Problems {
+		problems = append(problems, walletjson.ConsistencyProblemResult{
+			Check:       p.Check,
+			Description: p.Description,
+			Fixable:     p.Fixable,
+			Fixed:       p.Fixed,
+		})
+	}
+	return &walletjson.CheckConsistencyResult{
+		Consistent: report.Consistent(),
+		Blocks:     report.Blocks,
+		TxRecords:  report.TxRecords,
+		Credits:    report.Credits,
+		Debits:     report.Debits,
+		Unspent:    report.Unspent,
+		Unmined:    report.Unmined,
+		Tickets:    report.Tickets,
+		Accounts:   report.Accounts,
+		Addresses:  report.Addresses,
+		Problems:   problems,
+	}, nil
+}
+
 // consolidate handles a consolidate request by returning attempting to compress
 // as many inputs as given and then returning the txHash and error.
 func consolidate(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
@@ -504,6 +692,119 @@ func createMultiSig(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 	}, nil
 }
 
+// decodePartialTx decodes a hex-encoded partially signed transaction.
+func decodePartialTx(hexStr string) (*pstx.Tx, error) {
+	b, err := decodeHexStr(hexStr)
+	if err != nil {
+		return nil, err
+	}
+	p, err := pstx.Parse(b)
+	if err != nil {
+		return nil, DeserializationError{err}
+	}
+	return p, nil
+}
+
+// encodePartialTx returns the hex encoding of a partially signed transaction.
+func encodePartialTx(p *pstx.Tx) (string, error) {
+	b, err := p.Bytes()
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// createPartialTransaction handles a createpartialtransaction request by
+// creating an unsigned transaction paying to addresses from an account.  The
+// transaction is returned as a hex-encoded partially signed transaction which
+// describes every input, so it may be signed by another wallet, such as an
+// offline wallet.  The wallet may be watching-only.
+func createPartialTransaction(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.CreatePartialTransactionCmd)
+
+	account, err := w.AccountNumber(cmd.FromAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check that minconf is positive.
+	minConf := int32(*cmd.MinConf)
+	if minConf < 0 {
+		return nil, ErrNeedPositiveMinconf
+	}
+
+	pairs := make(map[string]abcutil.Amount, len(cmd.Amounts))
+	for k, v := range cmd.Amounts {
+		amt, err := abcutil.NewAmount(v)
+		if err != nil {
+			return nil, err
+		}
+		pairs[k] = amt
+	}
+	outputs, err := makeOutputs(pairs, w.ChainParams())
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := w.CreateUnsignedPartialTx(outputs, account, minConf, w.RelayFee())
+	if err != nil {
+		return nil, sendOutputsError(err)
+	}
+	return encodePartialTx(p)
+}
+
+// describePartialTransaction handles a describepartialtransaction request by
+// verifying a partially signed transaction and describing the amounts and
+// destinations of its inputs and outputs.
+func describePartialTransaction(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.DescribePartialTransactionCmd)
+
+	p, err := decodePartialTx(cmd.Hex)
+	if err != nil {
+		return nil, err
+	}
+	summary, err := w.DescribePartialTx(p)
+	if err != nil {
+		return nil, sendOutputsError(err)
+	}
+
+	inputs := make([]walletjson.PartialTransactionInputResult, 0, len(summary.Inputs))
+	for _, in := range summary.Inputs {
+		inputs = append(inputs, walletjson.PartialTransactionInputResult{
+			Txid:     in.PreviousOutPoint.Hash.String(),
+			Vout:     in.PreviousOutPoint.Index,
+			Tree:     in.PreviousOutPoint.Tree,
+			Amount:   in.Amount.ToCoin(),
+			Final:    in.Final,
+			Signable: in.Signable,
+		})
+	}
+	outputs := make([]walletjson.PartialTransactionOutputResult, 0, len(summary.Outputs))
+	for _, out := range summary.Outputs {
+		var addrs []string
+		for _, addr := range out.Addresses {
+			addrs = append(addrs, addr.EncodeAddress())
+		}
+		outputs = append(outputs, walletjson.PartialTransactionOutputResult{
+			Amount:       out.Amount.ToCoin(),
+			ScriptPubKey: hex.EncodeToString(out.PkScript),
+			Addresses:    addrs,
+			Owned:        out.Owned,
+		})
+	}
+	return &walletjson.DescribePartialTransactionResult{
+		Txid:          summary.Hash.String(),
+		Inputs:        inputs,
+		Outputs:       outputs,
+		TotalInput:    summary.TotalInput.ToCoin(),
+		TotalOutput:   summary.TotalOutput.ToCoin(),
+		Fee:           summary.Fee.ToCoin(),
+		EstimatedSize: summary.EstimatedSignedSerializeSize,
+		FeeRate:       summary.FeeRate.ToCoin(),
+		Complete:      summary.Complete,
+	}, nil
+}
+
 // dumpPrivKey handles a dumpprivkey request with the private key
 // for a single address, or an appropiate error if the wallet
 // is locked.
@@ -613,6 +914,19 @@ func getAddressesByAccount(icmd interface{}, w *wallet.Wallet) (interface{}, err
 	return addrsStr, nil
 }
 
+// getAddressLabel handles a getaddresslabel request by returning the label of
+// a wallet address, or the empty string if the address is not labeled.
+func getAddressLabel(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.GetAddressLabelCmd)
+
+	addr, err := decodeAddress(cmd.Address, w.ChainParams())
+	if err != nil {
+		return nil, err
+	}
+
+	return w.AddressLabel(addr)
+}
+
 // getBalance handles a getbalance request by returning the balance for an
 // account (wallet), or an error if the requested account does not
 // exist.
@@ -957,6 +1271,101 @@ func createNewAccount(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 	return nil, err
 }
 
+// createMultisigAccount handles a createmultisigaccount request by creating a
+// multisig account from the extended public keys of other cosigners and
+// returning its account number.
+func createMultisigAccount(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.CreateMultisigAccountCmd)
+
+	// The wildcard * is reserved by the rpc server with the special meaning
+	// of "all accounts", so disallow naming accounts to this string.
+	if cmd.Account == "*" {
+		return nil, &ErrReservedAccountName
+	}
+
+	keys := make([]*hdkeychain.ExtendedKey, len(cmd.Keys))
+	for i, s := range cmd.Keys {
+		key, err := hdkeychain.NewKeyFromString(s)
+		if err != nil {
+			return nil, InvalidParameterError{err}
+		}
+		keys[i] = key
+	}
+
+	account, err := w.NextMultisigAccount(cmd.Account, cmd.NRequired, keys)
+	if apperrors.IsError(err, apperrors.ErrLocked) {
+		return nil, &abcjson.RPCError{
+			Code: abcjson.ErrRPCWalletUnlockNeeded,
+			Message: "Creating an account requires the wallet to be unlocked. " +
+				"Enter the wallet passphrase with walletpassphrase to unlock",
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// createVault handles a createvault request by publishing a transaction paying
+// to a time locked vault owned by a new key of the account.
+func createVault(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.CreateVaultCmd)
+
+	account, err := w.AccountNumber(cmd.Account)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := abcutil.NewAmount(cmd.Amount)
+	if err != nil {
+		return nil, err
+	}
+	var lockType wallet.VaultLockType
+	switch cmd.LockType {
+	case "height":
+		lockType = wallet.VaultLockHeight
+	case "time":
+		lockType = wallet.VaultLockTime
+	case "blocks":
+		lockType = wallet.VaultLockBlocks
+	case "seconds":
+		lockType = wallet.VaultLockSeconds
+	default:
+		e := errors.New("locktype must be height, time, blocks, or seconds")
+		return nil, InvalidParameterError{e}
+	}
+
+	v, _, err := w.CreateVault(account, amount, lockType, cmd.LockValue)
+	if err != nil {
+		return nil, sendOutputsError(err)
+	}
+	return vaultResult(w, v)
+}
+
+// vaultResult describes a vault for the createvault and listvaults results.
+func vaultResult(w *wallet.Wallet, v *wallet.Vault) (*walletjson.VaultResult, error) {
+	accountName, err := w.AccountName(v.Account)
+	if err != nil {
+		return nil, err
+	}
+	result := &walletjson.VaultResult{
+		Txid:         v.OutPoint.Hash.String(),
+		Vout:         v.OutPoint.Index,
+		Account:      accountName,
+		Amount:       v.Amount.ToCoin(),
+		Address:      v.Address.EncodeAddress(),
+		KeyAddress:   v.KeyAddress.EncodeAddress(),
+		RedeemScript: hex.EncodeToString(v.Script),
+		LockType:     v.LockType.String(),
+		LockValue:    v.LockValue,
+		Unlocked:     v.Unlocked,
+		Created:      v.Created.Unix(),
+	}
+	if v.SpendTx != nil {
+		result.SpendTxid = v.SpendTx.String()
+	}
+	return result, nil
+}
+
 // renameAccount handles a renameaccount request by renaming an account.
 // If the account does not exist an appropiate error will be returned.
 func renameAccount(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
@@ -1373,6 +1782,22 @@ func getTransaction(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 	return ret, nil
 }
 
+// getTxLabel handles a gettxlabel request by returning the label of a
+// transaction, or the empty string if the transaction is not labeled.
+func getTxLabel(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.GetTxLabelCmd)
+
+	txHash, err := chainhash.NewHashFromStr(cmd.Txid)
+	if err != nil {
+		return nil, &abcjson.RPCError{
+			Code:    abcjson.ErrRPCDecodeHexString,
+			Message: "Transaction hash string decode failed: " + err.Error(),
+		}
+	}
+
+	return w.TransactionLabel(txHash)
+}
+
 // getVoteChoices handles a getvotechoices request by returning configured vote
 // preferences for each agenda of the latest supported stake version.
 func getVoteChoices(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
@@ -1551,10 +1976,57 @@ func listAccounts(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 	return accountBalances, nil
 }
 
+// listAddressLabels handles a listaddresslabels request by returning the
+// labels of all labeled wallet addresses.
+func listAddressLabels(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	labels, err := w.AddressLabels()
+	if err != nil {
+		return nil, err
+	}
+	results := make([]walletjson.AddressLabelResult, len(labels))
+	for i := range labels {
+		results[i] = walletjson.AddressLabelResult{
+			Address: labels[i].Address,
+			Label:   labels[i].Label,
+		}
+	}
+	return results, nil
+}
+
 // listLockUnspent handles a listlockunspent request by returning an slice of
 // all locked outpoints.
 func listLockUnspent(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
-	return w.LockedOutpoints(), nil
+	locked := w.LockedOutpoints()
+	results := make([]walletjson.LockedOutpointResult, len(locked))
+	for i := range locked {
+		l := &locked[i]
+		results[i] = walletjson.LockedOutpointResult{
+			Txid:         l.OutPoint.Hash.String(),
+			Vout:         l.OutPoint.Index,
+			Tree:         l.OutPoint.Tree,
+			Reason:       l.Reason,
+			ExpiryHeight: l.ExpiryHeight,
+		}
+	}
+	return results, nil
+}
+
+// listPayees handles a listpayees request by returning all payees saved in
+// the wallet's address book.
+func listPayees(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	payees, err := w.Payees()
+	if err != nil {
+		return nil, err
+	}
+	results := make([]walletjson.PayeeResult, len(payees))
+	for i := range payees {
+		results[i] = walletjson.PayeeResult{
+			Name:    payees[i].Name,
+			Address: payees[i].Address,
+			Note:    payees[i].Note,
+		}
+	}
+	return results, nil
 }
 
 // listReceivedByAccount handles a listreceivedbyaccount request by returning
@@ -1792,16 +2264,16 @@ func listAddressTransactions(icmd interface{}, w *wallet.Wallet) (interface{}, e
 	}
 
 	// Decode addresses.
-	hash160Map := make(map[string]struct{})
+	addrs := make([]abcutil.Address, 0, len(cmd.Addresses))
 	for _, addrStr := range cmd.Addresses {
 		addr, err := decodeAddress(addrStr, w.ChainParams())
 		if err != nil {
 			return nil, err
 		}
-		hash160Map[string(addr.ScriptAddress())] = struct{}{}
+		addrs = append(addrs, addr)
 	}
 
-	return w.ListAddressTransactions(hash160Map)
+	return w.ListAddressTransactions(addrs)
 }
 
 // listAllTransactions handles a listalltransactions request by returning
@@ -1821,6 +2293,54 @@ func listAllTransactions(icmd interface{}, w *wallet.Wallet) (interface{}, error
 	return w.ListAllTransactions()
 }
 
+// listUnminedTransactions handles a listunminedtransactions request by
+// returning the broadcast state of every unmined transaction.
+func listUnminedTransactions(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	states, err := w.UnminedTxStates()
+	if err != nil {
+		return nil, err
+	}
+	unixTime := func(t time.Time) int64 {
+		if t.IsZero() {
+			return 0
+		}
+		return t.Unix()
+	}
+	results := make([]walletjson.UnminedTransactionResult, len(states))
+	for i := range states {
+		s := &states[i]
+		results[i] = walletjson.UnminedTransactionResult{
+			Txid:        s.Hash.String(),
+			FirstSeen:   unixTime(s.FirstSeen),
+			LastSeen:    unixTime(s.LastSeen),
+			Attempts:    s.Attempts,
+			LastAttempt: unixTime(s.LastAttempt),
+			NextAttempt: unixTime(s.NextAttempt),
+		}
+		if s.LastError != nil {
+			results[i].LastError = s.LastError.Error()
+		}
+	}
+	return results, nil
+}
+
+// listVaults handles a listvaults request by describing every saved vault.
+func listVaults(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	vaults, err := w.Vaults()
+	if err != nil {
+		return nil, err
+	}
+	results := make([]walletjson.VaultResult, len(vaults))
+	for i, v := range vaults {
+		result, err := vaultResult(w, v)
+		if err != nil {
+			return nil, err
+		}
+		results[i] = *result
+	}
+	return results, nil
+}
+
 // listUnspent handles the listunspent command.
 func listUnspent(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 	cmd := icmd.(*abcjson.ListUnspentCmd)
@@ -1847,55 +2367,287 @@ func lockUnspent(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 
 	switch {
 	case cmd.Unlock && len(cmd.Transactions) == 0:
-		w.ResetLockedOutpoints()
+		err := w.ResetLockedOutpoints()
+		if err != nil {
+			return nil, err
+		}
 	default:
 		for _, input := range cmd.Transactions {
 			txSha, err := chainhash.NewHashFromStr(input.Txid)
 			if err != nil {
 				return nil, ParseError{err}
 			}
-			op := wire.OutPoint{Hash: *txSha, Index: input.Vout}
+			op := wire.OutPoint{Hash: *txSha, Index: input.Vout, Tree: input.Tree}
 			if cmd.Unlock {
-				w.UnlockOutpoint(op)
+				err = w.UnlockOutpoint(op)
 			} else {
-				w.LockOutpoint(op)
+				err = w.LockOutpoint(op, "", 0)
+			}
+			if err != nil {
+				return nil, err
 			}
 		}
 	}
 	return true, nil
 }
 
-// purchaseTicket indicates to the wallet that a ticket should be purchased
-// using all currently available funds. If the ticket could not be purchased
-// because there are not enough eligible funds, an error will be returned.
-func purchaseTicket(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
-	// Enforce valid and positive spend limit.
-	cmd := icmd.(*abcjson.PurchaseTicketCmd)
-	spendLimit, err := abcutil.NewAmount(cmd.SpendLimit)
+// previewBumpFee handles a previewbumpfee request by describing the child
+// transaction a bumpfee request with the same arguments would create.
+func previewBumpFee(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.PreviewBumpFeeCmd)
+
+	txHash, feeRate, err := decodeBumpFeeArgs(cmd.Txid, cmd.FeeRate)
 	if err != nil {
 		return nil, err
 	}
-	if spendLimit < 0 {
-		return nil, ErrNeedPositiveSpendLimit
+	bump, err := w.BumpFeeDryRun(txHash, feeRate)
+	if err != nil {
+		return nil, sendOutputsError(err)
 	}
+	return bumpFeeResult(bump)
+}
 
-	account, err := w.AccountNumber(cmd.FromAccount)
+// txPreviewResult creates the JSON-RPC result describing a transaction
+// preview.
+func txPreviewResult(preview *wallet.TxPreview) (*walletjson.TxPreviewResult, error) {
+	buf := bytes.NewBuffer(make([]byte, 0, preview.Tx.SerializeSize()))
+	err := preview.Tx.Serialize(buf)
 	if err != nil {
 		return nil, err
 	}
+	inputs := make([]abcjson.TransactionInput, len(preview.Tx.TxIn))
+	for i, in := range preview.Tx.TxIn {
+		inputs[i] = abcjson.TransactionInput{
+			Txid: in.PreviousOutPoint.Hash.String(),
+			Vout: in.PreviousOutPoint.Index,
+			Tree: in.PreviousOutPoint.Tree,
+		}
+	}
+	return &walletjson.TxPreviewResult{
+		Hex:           hex.EncodeToString(buf.Bytes()),
+		Inputs:        inputs,
+		TotalInput:    preview.TotalInput.ToCoin(),
+		TotalOutput:   (preview.TotalInput - preview.Fee).ToCoin(),
+		ChangeIndex:   preview.ChangeIndex,
+		EstimatedSize: preview.EstimatedSignedSerializeSize,
+		Fee:           preview.Fee.ToCoin(),
+		FeeRate:       preview.FeeRate.ToCoin(),
+	}, nil
+}
 
-	// Override the minimum number of required confirmations if specified
-	// and enforce it is positive.
-	minConf := int32(1)
-	if cmd.MinConf != nil {
-		minConf = int32(*cmd.MinConf)
-		if minConf < 0 {
-			return nil, ErrNeedPositiveMinconf
+// previewConsolidate handles a previewconsolidate request by describing the
+// transaction a consolidate request with the same arguments would create.
+func previewConsolidate(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.PreviewConsolidateCmd)
+
+	account := uint32(udb.DefaultAccountNum)
+	var err error
+	if cmd.Account != nil {
+		account, err = w.AccountNumber(*cmd.Account)
+		if err != nil {
+			return nil, err
 		}
 	}
 
-	// Set ticket address if specified.
-	var ticketAddr abcutil.Address
+	var changeAddr abcutil.Address
+	if cmd.Address != nil && *cmd.Address != "" {
+		changeAddr, err = decodeAddress(*cmd.Address, w.ChainParams())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	preview, err := w.ConsolidateDryRun(cmd.Inputs, account, changeAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return txPreviewResult(preview)
+}
+
+// previewPurchaseTicket handles a previewpurchaseticket request by describing
+// the split transaction and tickets a purchaseticket request with the same
+// arguments would create.
+func previewPurchaseTicket(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.PreviewPurchaseTicketCmd)
+	args, err := decodePurchaseTicketArgs(&abcjson.PurchaseTicketCmd{
+		FromAccount:   cmd.FromAccount,
+		SpendLimit:    cmd.SpendLimit,
+		MinConf:       cmd.MinConf,
+		TicketAddress: cmd.TicketAddress,
+		NumTickets:    cmd.NumTickets,
+		PoolAddress:   cmd.PoolAddress,
+		PoolFees:      cmd.PoolFees,
+		Expiry:        cmd.Expiry,
+	}, w)
+	if err != nil {
+		return nil, err
+	}
+
+	preview, err := w.PurchaseTicketsDryRun(0, args.spendLimit, args.minConf,
+		args.ticketAddr, args.account, args.numTickets, args.poolAddr,
+		args.poolFee, args.expiry, w.RelayFee(), w.TicketFeeIncrement())
+	if err != nil {
+		return nil, err
+	}
+
+	splitTx, err := txPreviewResult(preview.SplitTx)
+	if err != nil {
+		return nil, err
+	}
+	return &walletjson.TicketPurchasePreviewResult{
+		SplitTx:             *splitTx,
+		NumTickets:          preview.NumTickets,
+		TicketPrice:         preview.TicketPrice.ToCoin(),
+		TicketFee:           preview.TicketFee.ToCoin(),
+		TicketFeeRate:       preview.TicketFeeRate.ToCoin(),
+		EstimatedTicketSize: preview.EstimatedTicketSize,
+		PoolFee:             preview.PoolFee.ToCoin(),
+	}, nil
+}
+
+// previewSendMany handles a previewsendmany request by describing the
+// transaction a sendmany request with the same arguments would create.  The
+// fee is subtracted from the amounts paid to any addresses in
+// subtractfeefrom.
+func previewSendMany(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.PreviewSendManyCmd)
+
+	account, err := w.AccountNumber(cmd.FromAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check that minconf is positive.
+	minConf := int32(*cmd.MinConf)
+	if minConf < 0 {
+		return nil, ErrNeedPositiveMinconf
+	}
+
+	pairs := make(map[string]abcutil.Amount, len(cmd.Amounts))
+	for k, v := range cmd.Amounts {
+		amt, err := abcutil.NewAmount(v)
+		if err != nil {
+			return nil, err
+		}
+		pairs[k] = amt
+	}
+	outputs, err := makeOutputs(pairs, w.ChainParams())
+	if err != nil {
+		return nil, err
+	}
+	var subtractFeeIdxs []int
+	if cmd.SubtractFeeFrom != nil {
+		subtractFeeIdxs, err = subtractFeeIndexes(outputs,
+			*cmd.SubtractFeeFrom, w.ChainParams())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	strategy, err := parseCoinSelection(cmd.CoinSelection)
+	if err != nil {
+		return nil, err
+	}
+	expiry, err := parseTxExpiry(cmd.Expiry, cmd.RelativeExpiry)
+	if err != nil {
+		return nil, err
+	}
+
+	preview, err := w.SendOutputsDryRun(outputs, account, minConf, strategy,
+		nil, subtractFeeIdxs, expiry)
+	if err != nil {
+		return nil, sendOutputsError(err)
+	}
+
+	return txPreviewResult(preview)
+}
+
+// previewSendToMultiSig handles a previewsendtomultisig request by describing
+// the transaction a sendtomultisig request with the same arguments would
+// create.
+func previewSendToMultiSig(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.PreviewSendToMultiSigCmd)
+	amount, err := abcutil.NewAmount(cmd.Amount)
+	if err != nil {
+		return nil, err
+	}
+	pubkeys, err := multiSigPubKeys(w, cmd.Pubkeys)
+	if err != nil {
+		return nil, err
+	}
+
+	preview, err := w.CreateMultisigTxDryRun(udb.DefaultAccountNum, amount,
+		pubkeys, int8(*cmd.NRequired), int32(*cmd.MinConf))
+	if err != nil {
+		return nil, err
+	}
+
+	return txPreviewResult(preview)
+}
+
+// previewSendToSStx handles a previewsendtosstx request by describing the
+// ticket a sendtosstx request with the same arguments would create.
+func previewSendToSStx(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.PreviewSendToSStxCmd)
+
+	pair := make(map[string]abcutil.Amount, len(cmd.Amounts))
+	for k, v := range cmd.Amounts {
+		pair[k] = abcutil.Amount(v)
+	}
+
+	preview, err := w.CreateSStxTxDryRun(pair, cmd.Inputs, cmd.COuts)
+	if err != nil {
+		if err == wallet.ErrNonPositiveAmount {
+			return nil, ErrNeedPositiveAmount
+		}
+		return nil, err
+	}
+
+	return txPreviewResult(preview)
+}
+
+// purchaseTicketArgs holds the decoded arguments of a ticket purchase request.
+type purchaseTicketArgs struct {
+	spendLimit abcutil.Amount
+	account    uint32
+	minConf    int32
+	ticketAddr abcutil.Address
+	numTickets int
+	poolAddr   abcutil.Address
+	poolFee    float64
+	expiry     int32
+}
+
+// decodePurchaseTicketArgs checks and decodes the arguments of a
+// purchaseticket request.
+func decodePurchaseTicketArgs(cmd *abcjson.PurchaseTicketCmd, w *wallet.Wallet) (*purchaseTicketArgs, error) {
+	// Enforce valid and positive spend limit.
+	spendLimit, err := abcutil.NewAmount(cmd.SpendLimit)
+	if err != nil {
+		return nil, err
+	}
+	if spendLimit < 0 {
+		return nil, ErrNeedPositiveSpendLimit
+	}
+
+	account, err := w.AccountNumber(cmd.FromAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Override the minimum number of required confirmations if specified
+	// and enforce it is positive.
+	minConf := int32(1)
+	if cmd.MinConf != nil {
+		minConf = int32(*cmd.MinConf)
+		if minConf < 0 {
+			return nil, ErrNeedPositiveMinconf
+		}
+	}
+
+	// Set ticket address if specified.
+	var ticketAddr abcutil.Address
 	if cmd.TicketAddress != nil {
 		if *cmd.TicketAddress != "" {
 			addr, err := decodeAddress(*cmd.TicketAddress, w.ChainParams())
@@ -1943,9 +2695,30 @@ func purchaseTicket(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 		expiry = int32(*cmd.Expiry)
 	}
 
-	hashes, err := w.PurchaseTickets(0, spendLimit, minConf, ticketAddr,
-		account, numTickets, poolAddr, poolFee, expiry, w.RelayFee(),
-		w.TicketFeeIncrement())
+	return &purchaseTicketArgs{
+		spendLimit: spendLimit,
+		account:    account,
+		minConf:    minConf,
+		ticketAddr: ticketAddr,
+		numTickets: numTickets,
+		poolAddr:   poolAddr,
+		poolFee:    poolFee,
+		expiry:     expiry,
+	}, nil
+}
+
+// purchaseTicket indicates to the wallet that a ticket should be purchased
+// using all currently available funds. If the ticket could not be purchased
+// because there are not enough eligible funds, an error will be returned.
+func purchaseTicket(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	args, err := decodePurchaseTicketArgs(icmd.(*abcjson.PurchaseTicketCmd), w)
+	if err != nil {
+		return nil, err
+	}
+
+	hashes, err := w.PurchaseTickets(0, args.spendLimit, args.minConf,
+		args.ticketAddr, args.account, args.numTickets, args.poolAddr,
+		args.poolFee, args.expiry, w.RelayFee(), w.TicketFeeIncrement())
 	if err != nil {
 		return nil, err
 	}
@@ -1961,10 +2734,38 @@ func purchaseTicket(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 // makeOutputs creates a slice of transaction outputs from a pair of address
 // strings to amounts.  This is used to create the outputs to include in newly
 // created transactions from a JSON object describing the output destinations
-// and amounts.
+// and amounts.  Keys beginning with nullDataKeyPrefix describe a null data
+// output carrying the hex encoded data following the prefix.
 func makeOutputs(pairs map[string]abcutil.Amount, chainParams *chaincfg.Params) ([]*wire.TxOut, error) {
 	outputs := make([]*wire.TxOut, 0, len(pairs))
+	haveNullData := false
 	for addrStr, amt := range pairs {
+		if strings.HasPrefix(addrStr, nullDataKeyPrefix) {
+			if haveNullData {
+				return nil, &abcjson.RPCError{
+					Code:    abcjson.ErrRPCInvalidParameter,
+					Message: "only one output may carry data",
+				}
+			}
+			haveNullData = true
+			if amt != 0 {
+				return nil, &abcjson.RPCError{
+					Code:    abcjson.ErrRPCInvalidParameter,
+					Message: "outputs carrying data must have zero amount",
+				}
+			}
+			data, err := hex.DecodeString(addrStr[len(nullDataKeyPrefix):])
+			if err != nil {
+				return nil, DeserializationError{err}
+			}
+			output, err := txrules.NullDataOutput(data)
+			if err != nil {
+				return nil, InvalidParameterError{err}
+			}
+			outputs = append(outputs, output)
+			continue
+		}
+
 		addr, err := abcutil.DecodeAddress(addrStr, chainParams)
 		if err != nil {
 			return nil, fmt.Errorf("cannot decode address: %s", err)
@@ -1980,35 +2781,104 @@ func makeOutputs(pairs map[string]abcutil.Amount, chainParams *chaincfg.Params)
 	return outputs, nil
 }
 
-// sendPairs creates and sends payment transactions.
+// subtractFeeIndexes returns the index of the output paying to each address
+// string in addrs.
+func subtractFeeIndexes(outputs []*wire.TxOut, addrs []string, chainParams *chaincfg.Params) ([]int, error) {
+	indexes := make([]int, 0, len(addrs))
+	for i, addrStr := range addrs {
+		for _, prev := range addrs[:i] {
+			if addrStr == prev {
+				return nil, &abcjson.RPCError{
+					Code:    abcjson.ErrRPCInvalidParameter,
+					Message: fmt.Sprintf("duplicate address %v", addrStr),
+				}
+			}
+		}
+		addr, err := abcutil.DecodeAddress(addrStr, chainParams)
+		if err != nil {
+			return nil, fmt.Errorf("cannot decode address: %s", err)
+		}
+		pkScript, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create txout script: %s", err)
+		}
+		index := -1
+		for j, output := range outputs {
+			if bytes.Equal(output.PkScript, pkScript) {
+				index = j
+				break
+			}
+		}
+		if index == -1 {
+			return nil, &abcjson.RPCError{
+				Code:    abcjson.ErrRPCInvalidParameter,
+				Message: fmt.Sprintf("address %v is not a payment address", addrStr),
+			}
+		}
+		indexes = append(indexes, index)
+	}
+	return indexes, nil
+}
+
+// sendPairs creates and sends payment transactions.  The coin control is
+// optional and may be nil to select inputs automatically.  The fee is
+// subtracted from the amounts paid to the addresses in subtractFeeFrom, or
+// paid in addition to the amounts when no addresses are specified.  A nil
+// expiry uses the wallet's default expiry.
 // It returns the transaction hash in string format upon success
 // All errors are returned in abcjson.RPCError format
 func sendPairs(w *wallet.Wallet, amounts map[string]abcutil.Amount,
-	account uint32, minconf int32) (string, error) {
+	account uint32, minconf int32, strategy txauthor.CoinSelectionStrategy,
+	coinControl *wallet.CoinControl, subtractFeeFrom []string,
+	expiry *wallet.TxExpiry) (string, error) {
 	outputs, err := makeOutputs(amounts, w.ChainParams())
 	if err != nil {
 		return "", err
 	}
-	txSha, err := w.SendOutputs(outputs, account, minconf)
+	subtractFeeIdxs, err := subtractFeeIndexes(outputs, subtractFeeFrom,
+		w.ChainParams())
 	if err != nil {
-		if err == txrules.ErrAmountNegative {
-			return "", ErrNeedPositiveAmount
-		}
-		if apperrors.IsError(err, apperrors.ErrLocked) {
-			return "", &ErrWalletUnlockNeeded
-		}
-		switch err.(type) {
-		case abcjson.RPCError:
-			return "", err
-		}
+		return "", err
+	}
+	txSha, err := w.SendOutputs(outputs, account, minconf, strategy,
+		coinControl, subtractFeeIdxs, expiry)
+	if err != nil {
+		return "", sendOutputsError(err)
+	}
+
+	return txSha.String(), err
+}
 
-		return "", &abcjson.RPCError{
-			Code:    abcjson.ErrRPCInternal.Code,
+// sendOutputsError converts an error from creating a transaction paying to
+// outputs into an abcjson.RPCError.
+func sendOutputsError(err error) error {
+	if err == txrules.ErrAmountNegative {
+		return ErrNeedPositiveAmount
+	}
+	if err == txrules.ErrOutputIsDust {
+		return &abcjson.RPCError{
+			Code:    abcjson.ErrRPCInvalidParameter,
+			Message: "output amount is too small to pay the fee",
+		}
+	}
+	if apperrors.IsError(err, apperrors.ErrLocked) {
+		return &ErrWalletUnlockNeeded
+	}
+	if apperrors.IsError(err, apperrors.ErrInput) {
+		return &abcjson.RPCError{
+			Code:    abcjson.ErrRPCInvalidParameter,
 			Message: err.Error(),
 		}
 	}
+	switch err.(type) {
+	case abcjson.RPCError:
+		return err
+	}
 
-	return txSha.String(), err
+	return &abcjson.RPCError{
+		Code:    abcjson.ErrRPCInternal.Code,
+		Message: err.Error(),
+	}
 }
 
 // redeemMultiSigOut receives a transaction hash/idx and fetches the first output
@@ -2169,6 +3039,14 @@ func redeemMultiSigOuts(icmd interface{}, w *wallet.Wallet, chainClient *chain.R
 	return abcjson.RedeemMultiSigOutsResult{Results: rmsoResults}, nil
 }
 
+// removePayee handles a removepayee request by removing a payee from the
+// wallet's address book.
+func removePayee(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.RemovePayeeCmd)
+	err := w.RemovePayee(cmd.Name)
+	return nil, err
+}
+
 // rescanWallet initiates a rescan of the block chain for wallet data, blocking
 // until the rescan completes or exits with an error.
 func rescanWallet(icmd interface{}, w *wallet.Wallet, chainClient *chain.RPCClient) (interface{}, error) {
@@ -2184,6 +3062,30 @@ func revokeTickets(icmd interface{}, w *wallet.Wallet, chainClient *chain.RPCCli
 	return nil, err
 }
 
+// searchTxLabels handles a searchtxlabels request by returning all
+// transaction labels containing the query string, ignoring case.
+func searchTxLabels(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.SearchTxLabelsCmd)
+
+	var query string
+	if cmd.Query != nil {
+		query = *cmd.Query
+	}
+	labels, err := w.SearchTransactionLabels(query)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]walletjson.TxLabelResult, 0, len(labels))
+	for i := range labels {
+		results = append(results, walletjson.TxLabelResult{
+			Txid:  labels[i].Hash.String(),
+			Label: labels[i].Label,
+		})
+	}
+	return results, nil
+}
+
 // stakePoolUserInfo returns the ticket information for a given user from the
 // stake pool.
 func stakePoolUserInfo(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
@@ -2260,6 +3162,22 @@ func isNilOrEmpty(s *string) bool {
 	return s == nil || *s == ""
 }
 
+// checkPayeeAddress verifies that the encoded payment address is the address
+// saved in the wallet's address book for the named payee.  Sends which name a
+// payee (using the commentto parameter) are only allowed to pay to the saved
+// address.
+func checkPayeeAddress(w *wallet.Wallet, payee, address string) error {
+	addr, err := decodeAddress(address, w.ChainParams())
+	if err != nil {
+		return err
+	}
+	err = w.CheckPayeeAddress(payee, addr)
+	if err != nil {
+		return InvalidParameterError{err}
+	}
+	return nil
+}
+
 // sendFrom handles a sendfrom RPC request by creating a new transaction
 // spending unspent transaction outputs for a wallet to another payment
 // address.  Leftover inputs not sent to the payment address or a fee for
@@ -2270,13 +3188,20 @@ func sendFrom(icmd interface{}, w *wallet.Wallet, chainClient *chain.RPCClient)
 
 	// Transaction comments are not yet supported.  Error instead of
 	// pretending to save them.
-	if !isNilOrEmpty(cmd.Comment) || !isNilOrEmpty(cmd.CommentTo) {
+	if !isNilOrEmpty(cmd.Comment) {
 		return nil, &abcjson.RPCError{
 			Code:    abcjson.ErrRPCUnimplemented,
 			Message: "Transaction comments are not yet supported",
 		}
 	}
 
+	if !isNilOrEmpty(cmd.CommentTo) {
+		err := checkPayeeAddress(w, *cmd.CommentTo, cmd.ToAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	account, err := w.AccountNumber(cmd.FromAccount)
 	if err != nil {
 		return nil, err
@@ -2299,7 +3224,8 @@ func sendFrom(icmd interface{}, w *wallet.Wallet, chainClient *chain.RPCClient)
 		cmd.ToAddress: amt,
 	}
 
-	return sendPairs(w, pairs, account, minConf)
+	return sendPairs(w, pairs, account, minConf,
+		txauthor.CoinSelectionDefault, nil, nil, nil)
 }
 
 // sendMany handles a sendmany RPC request by creating a new transaction
@@ -2340,7 +3266,51 @@ func sendMany(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 		pairs[k] = amt
 	}
 
-	return sendPairs(w, pairs, account, minConf)
+	return sendPairs(w, pairs, account, minConf,
+		txauthor.CoinSelectionDefault, nil, nil, nil)
+}
+
+// sendManySubtractFee handles a sendmanysubtractfee RPC request by creating a
+// new transaction paying to many addresses, where the fee is subtracted from
+// the amounts paid to some of the addresses rather than being paid in addition
+// to them.  Upon success, the TxID for the created transaction is returned.
+func sendManySubtractFee(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.SendManySubtractFeeCmd)
+
+	account, err := w.AccountNumber(cmd.FromAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check that minconf is positive.
+	minConf := int32(*cmd.MinConf)
+	if minConf < 0 {
+		return nil, ErrNeedPositiveMinconf
+	}
+
+	if len(cmd.SubtractFeeFrom) == 0 {
+		return nil, &abcjson.RPCError{
+			Code:    abcjson.ErrRPCInvalidParameter,
+			Message: "no addresses to subtract the fee from",
+		}
+	}
+
+	pairs := make(map[string]abcutil.Amount, len(cmd.Amounts))
+	for k, v := range cmd.Amounts {
+		amt, err := abcutil.NewAmount(v)
+		if err != nil {
+			return nil, err
+		}
+		pairs[k] = amt
+	}
+
+	expiry, err := parseTxExpiry(cmd.Expiry, cmd.RelativeExpiry)
+	if err != nil {
+		return nil, err
+	}
+
+	return sendPairs(w, pairs, account, minConf,
+		txauthor.CoinSelectionDefault, nil, cmd.SubtractFeeFrom, expiry)
 }
 
 // sendToAddress handles a sendtoaddress RPC request by creating a new
@@ -2353,13 +3323,20 @@ func sendToAddress(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 
 	// Transaction comments are not yet supported.  Error instead of
 	// pretending to save them.
-	if !isNilOrEmpty(cmd.Comment) || !isNilOrEmpty(cmd.CommentTo) {
+	if !isNilOrEmpty(cmd.Comment) {
 		return nil, &abcjson.RPCError{
 			Code:    abcjson.ErrRPCUnimplemented,
 			Message: "Transaction comments are not yet supported",
 		}
 	}
 
+	if !isNilOrEmpty(cmd.CommentTo) {
+		err := checkPayeeAddress(w, *cmd.CommentTo, cmd.Address)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	amt, err := abcutil.NewAmount(cmd.Amount)
 	if err != nil {
 		return nil, err
@@ -2376,7 +3353,109 @@ func sendToAddress(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 	}
 
 	// sendtoaddress always spends from the default account, this matches bitcoind
-	return sendPairs(w, pairs, udb.DefaultAccountNum, 1)
+	return sendPairs(w, pairs, udb.DefaultAccountNum, 1,
+		txauthor.CoinSelectionDefault, nil, nil, nil)
+}
+
+// sendToAddressSubtractFee handles a sendtoaddresssubtractfee RPC request by
+// creating a new transaction paying an amount to a payment address, where the
+// fee is subtracted from the amount received by the payment address.  This is
+// useful for sending the entire balance of the default account.  Upon success,
+// the TxID for the created transaction is returned.
+func sendToAddressSubtractFee(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.SendToAddressSubtractFeeCmd)
+
+	amt, err := abcutil.NewAmount(cmd.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check that signed integer parameters are positive.
+	if amt < 0 {
+		return nil, ErrNeedPositiveAmount
+	}
+
+	pairs := map[string]abcutil.Amount{
+		cmd.Address: amt,
+	}
+
+	expiry, err := parseTxExpiry(cmd.Expiry, cmd.RelativeExpiry)
+	if err != nil {
+		return nil, err
+	}
+
+	// Like sendtoaddress, always spend from the default account.
+	return sendPairs(w, pairs, udb.DefaultAccountNum, 1,
+		txauthor.CoinSelectionDefault, nil, []string{cmd.Address}, expiry)
+}
+
+// sendWithCoinControl handles a sendwithcoincontrol RPC request by creating a
+// new transaction paying to many addresses, where the caller chooses the exact
+// outputs to spend, or outputs which must or must not be spent in addition to
+// those selected by the wallet.  Upon success, the TxID for the created
+// transaction is returned.
+func sendWithCoinControl(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.SendWithCoinControlCmd)
+
+	account, err := w.AccountNumber(cmd.FromAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check that minconf is positive.
+	minConf := int32(*cmd.MinConf)
+	if minConf < 0 {
+		return nil, ErrNeedPositiveMinconf
+	}
+
+	pairs := make(map[string]abcutil.Amount, len(cmd.Amounts))
+	for k, v := range cmd.Amounts {
+		amt, err := abcutil.NewAmount(v)
+		if err != nil {
+			return nil, err
+		}
+		pairs[k] = amt
+	}
+
+	decodeOutPoints := func(inputs *[]abcjson.TransactionInput) ([]wire.OutPoint, error) {
+		if inputs == nil {
+			return nil, nil
+		}
+		ops := make([]wire.OutPoint, 0, len(*inputs))
+		for _, input := range *inputs {
+			txHash, err := chainhash.NewHashFromStr(input.Txid)
+			if err != nil {
+				return nil, ParseError{err}
+			}
+			ops = append(ops, wire.OutPoint{Hash: *txHash, Index: input.Vout, Tree: input.Tree})
+		}
+		return ops, nil
+	}
+	var coinControl wallet.CoinControl
+	coinControl.Inputs, err = decodeOutPoints(cmd.Inputs)
+	if err != nil {
+		return nil, err
+	}
+	coinControl.Include, err = decodeOutPoints(cmd.Include)
+	if err != nil {
+		return nil, err
+	}
+	coinControl.Exclude, err = decodeOutPoints(cmd.Exclude)
+	if err != nil {
+		return nil, err
+	}
+
+	strategy, err := parseCoinSelection(cmd.CoinSelection)
+	if err != nil {
+		return nil, err
+	}
+	expiry, err := parseTxExpiry(cmd.Expiry, cmd.RelativeExpiry)
+	if err != nil {
+		return nil, err
+	}
+
+	return sendPairs(w, pairs, account, minConf, strategy, &coinControl, nil,
+		expiry)
 }
 
 // sendToMultiSig handles a sendtomultisig RPC request by creating a new
@@ -2399,37 +3478,9 @@ func sendToMultiSig(icmd interface{}, w *wallet.Wallet, chainClient *chain.RPCCl
 	}
 	nrequired := int8(*cmd.NRequired)
 	minconf := int32(*cmd.MinConf)
-	pubkeys := make([]*abcutil.AddressSecpPubKey, len(cmd.Pubkeys))
-
-	// The address list will made up either of addreseses (pubkey hash), for
-	// which we need to look up the keys in wallet, straight pubkeys, or a
-	// mixture of the two.
-	for i, a := range cmd.Pubkeys {
-		// Try to parse as pubkey address.
-		a, err := decodeAddress(a, w.ChainParams())
-		if err != nil {
-			return nil, err
-		}
-
-		switch addr := a.(type) {
-		case *abcutil.AddressSecpPubKey:
-			pubkeys[i] = addr
-		default:
-			pubKey, err := w.PubKeyForAddress(addr)
-			if err != nil {
-				return nil, err
-			}
-			if pubKey.GetType() != chainec.ECTypeSecp256k1 {
-				return nil, errors.New("only secp256k1 " +
-					"pubkeys are currently supported")
-			}
-			pubKeyAddr, err := abcutil.NewAddressSecpPubKey(
-				pubKey.Serialize(), w.ChainParams())
-			if err != nil {
-				return nil, err
-			}
-			pubkeys[i] = pubKeyAddr
-		}
+	pubkeys, err := multiSigPubKeys(w, cmd.Pubkeys)
+	if err != nil {
+		return nil, err
 	}
 
 	ctx, addr, script, err :=
@@ -2601,6 +3652,67 @@ func sendToSSRtx(icmd interface{}, w *wallet.Wallet, chainClient *chain.RPCClien
 	return txSha.String(), nil
 }
 
+// setAddressLabel handles a setaddresslabel request by saving the label of a
+// wallet address.  An empty label removes any existing label.
+func setAddressLabel(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.SetAddressLabelCmd)
+
+	addr, err := decodeAddress(cmd.Address, w.ChainParams())
+	if err != nil {
+		return nil, err
+	}
+
+	err = w.SetAddressLabel(addr, cmd.Label)
+	return nil, err
+}
+
+// setCoinSelection handles a setcoinselection request by changing the default
+// strategy used to select unspent outputs for authored transactions.
+func setCoinSelection(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.SetCoinSelectionCmd)
+
+	strategy, err := parseCoinSelection(&cmd.Strategy)
+	if err != nil {
+		return nil, err
+	}
+	w.SetCoinSelectionStrategy(strategy)
+	return nil, nil
+}
+
+// parseCoinSelection parses the name of an optional coin selection strategy
+// parameter.  A nil name selects the wallet's default strategy.
+func parseCoinSelection(name *string) (txauthor.CoinSelectionStrategy, error) {
+	if name == nil {
+		return txauthor.CoinSelectionDefault, nil
+	}
+	strategy, err := txauthor.ParseCoinSelectionStrategy(*name)
+	if err != nil {
+		return 0, &abcjson.RPCError{
+			Code:    abcjson.ErrRPCInvalidParameter,
+			Message: err.Error(),
+		}
+	}
+	return strategy, nil
+}
+
+// setPayee handles a setpayee request by saving a named external address to
+// the wallet's address book.
+func setPayee(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.SetPayeeCmd)
+
+	addr, err := decodeAddress(cmd.Address, w.ChainParams())
+	if err != nil {
+		return nil, err
+	}
+	var note string
+	if cmd.Note != nil {
+		note = *cmd.Note
+	}
+
+	err = w.SetPayee(cmd.Name, addr, note)
+	return nil, err
+}
+
 // setTicketFee sets the transaction fee per kilobyte added to tickets.
 func setTicketFee(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 	cmd := icmd.(*abcjson.SetTicketFeeCmd)
@@ -2620,6 +3732,41 @@ func setTicketFee(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 	return true, nil
 }
 
+// setTxExpiry handles a settxexpiry request by changing the default number of
+// blocks after which authored transactions expire.
+func setTxExpiry(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.SetTxExpiryCmd)
+
+	if cmd.Blocks < 0 {
+		return nil, &abcjson.RPCError{
+			Code:    abcjson.ErrRPCInvalidParameter,
+			Message: "expiry may not be negative",
+		}
+	}
+	w.SetDefaultTxExpiry(uint32(cmd.Blocks))
+	return nil, nil
+}
+
+// parseTxExpiry returns the transaction expiry described by optional expiry
+// parameters.  A nil expiry selects the wallet's default expiry.  Unless
+// relative is false, the expiry counts blocks from the next block rather than
+// being an absolute block height.
+func parseTxExpiry(expiry *int, relative *bool) (*wallet.TxExpiry, error) {
+	if expiry == nil {
+		return nil, nil
+	}
+	if *expiry < 0 || int64(*expiry) > math.MaxUint32 {
+		return nil, &abcjson.RPCError{
+			Code:    abcjson.ErrRPCInvalidParameter,
+			Message: fmt.Sprintf("invalid expiry %d", *expiry),
+		}
+	}
+	return &wallet.TxExpiry{
+		Height:   uint32(*expiry),
+		Relative: relative == nil || *relative,
+	}, nil
+}
+
 // setTxFee sets the transaction fee per kilobyte added to transactions.
 func setTxFee(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 	cmd := icmd.(*abcjson.SetTxFeeCmd)
@@ -2639,6 +3786,23 @@ func setTxFee(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 	return true, nil
 }
 
+// setTxLabel handles a settxlabel request by saving the label of a
+// transaction.  An empty label removes any existing label.
+func setTxLabel(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.SetTxLabelCmd)
+
+	txHash, err := chainhash.NewHashFromStr(cmd.Txid)
+	if err != nil {
+		return nil, &abcjson.RPCError{
+			Code:    abcjson.ErrRPCDecodeHexString,
+			Message: "Transaction hash string decode failed: " + err.Error(),
+		}
+	}
+
+	err = w.SetTransactionLabel(txHash, cmd.Label)
+	return nil, err
+}
+
 // setVoteChoice handles a setvotechoice request by modifying the preferred
 // choice for a voting agenda.
 func setVoteChoice(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
@@ -2666,6 +3830,50 @@ func signMessage(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 	return base64.StdEncoding.EncodeToString(sig), nil
 }
 
+// signPartialTransaction handles a signpartialtransaction request by signing
+// every input of a partially signed transaction that may be signed by a wallet
+// key, including keys only described by the derivation paths of the inputs.
+// Signature scripts are created for inputs with enough signatures, and the
+// signed transaction is returned once every input is signed.
+func signPartialTransaction(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.SignPartialTransactionCmd)
+
+	p, err := decodePartialTx(cmd.Hex)
+	if err != nil {
+		return nil, err
+	}
+	signed, err := w.SignPartialTx(p)
+	if err != nil {
+		return nil, sendOutputsError(err)
+	}
+	complete, err := w.FinalizePartialTx(p)
+	if err != nil {
+		return nil, sendOutputsError(err)
+	}
+
+	hexStr, err := encodePartialTx(p)
+	if err != nil {
+		return nil, err
+	}
+	result := &walletjson.SignPartialTransactionResult{
+		Hex:          hexStr,
+		SignedInputs: signed,
+		Complete:     complete,
+	}
+	if result.SignedInputs == nil {
+		result.SignedInputs = []int{}
+	}
+	if complete {
+		buf := bytes.NewBuffer(make([]byte, 0, p.Tx.SerializeSize()))
+		err = p.Tx.Serialize(buf)
+		if err != nil {
+			return nil, err
+		}
+		result.SignedTx = hex.EncodeToString(buf.Bytes())
+	}
+	return result, nil
+}
+
 // signRawTransaction handles the signrawtransaction command.
 func signRawTransaction(icmd interface{}, w *wallet.Wallet, chainClient *chain.RPCClient) (interface{}, error) {
 	cmd := icmd.(*abcjson.SignRawTransactionCmd)
@@ -3004,11 +4212,99 @@ func signRawTransactions(icmd interface{}, w *wallet.Wallet, chainClient *chain.
 	return &abcjson.SignRawTransactionsResult{Results: toReturn}, nil
 }
 
+// sweepAccount handles a sweepaccount RPC request by creating a new
+// transaction spending every spendable output of an account and paying the
+// total value, less the fee, to the destination addresses split by their
+// ratios.  Upon success, the TxID for the created transaction is returned.
+func sweepAccount(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
+	cmd := icmd.(*walletjson.SweepAccountCmd)
+
+	account, err := w.AccountNumber(cmd.SourceAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check that minconf is positive.
+	minConf := int32(*cmd.MinConf)
+	if minConf < 0 {
+		return nil, ErrNeedPositiveMinconf
+	}
+
+	minValue, err := abcutil.NewAmount(*cmd.MinValue)
+	if err != nil {
+		return nil, err
+	}
+	if minValue < 0 {
+		return nil, ErrNeedPositiveAmount
+	}
+
+	if len(cmd.Destinations) == 0 {
+		return nil, &abcjson.RPCError{
+			Code:    abcjson.ErrRPCInvalidParameter,
+			Message: "no sweep destinations",
+		}
+	}
+
+	// Order the destinations by address so the output receiving any
+	// remainder does not depend on map iteration order.
+	addrStrs := make([]string, 0, len(cmd.Destinations))
+	for addrStr := range cmd.Destinations {
+		addrStrs = append(addrStrs, addrStr)
+	}
+	sort.Strings(addrStrs)
+	destinations := make([]txauthor.SweepDestination, 0, len(addrStrs))
+	for _, addrStr := range addrStrs {
+		ratio := cmd.Destinations[addrStr]
+		if !(ratio > 0) || math.IsInf(ratio, 1) {
+			return nil, &abcjson.RPCError{
+				Code:    abcjson.ErrRPCInvalidParameter,
+				Message: "destination ratios must be positive",
+			}
+		}
+		addr, err := decodeAddress(addrStr, w.ChainParams())
+		if err != nil {
+			return nil, err
+		}
+		pkScript, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			return nil, ParseError{err}
+		}
+		destinations = append(destinations, txauthor.SweepDestination{
+			PkScript: pkScript,
+			Version:  txscript.DefaultScriptVersion,
+			Ratio:    ratio,
+		})
+	}
+
+	atx, err := w.SweepAccount(account, minConf, minValue, destinations)
+	if err != nil {
+		if err == txrules.ErrOutputIsDust {
+			return nil, &abcjson.RPCError{
+				Code:    abcjson.ErrRPCInvalidParameter,
+				Message: "swept value is too small to pay every destination",
+			}
+		}
+		if apperrors.IsError(err, apperrors.ErrLocked) {
+			return nil, &ErrWalletUnlockNeeded
+		}
+		switch err.(type) {
+		case txauthor.InputSourceError:
+			return nil, &abcjson.RPCError{
+				Code:    abcjson.ErrRPCWalletInsufficientFunds,
+				Message: "no spendable outputs to sweep",
+			}
+		}
+		return nil, err
+	}
+
+	return atx.Tx.TxHash().String(), nil
+}
+
 // validateAddress handles the validateaddress command.
 func validateAddress(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 	cmd := icmd.(*abcjson.ValidateAddressCmd)
 
-	result := abcjson.ValidateAddressWalletResult{}
+	result := walletjson.ValidateAddressResult{}
 	addr, err := decodeAddress(cmd.Address, w.ChainParams())
 	if err != nil {
 		// Use result zero value (IsValid=false).
@@ -3022,6 +4318,11 @@ func validateAddress(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 	result.Address = addr.EncodeAddress()
 	result.IsValid = true
 
+	result.Payees, err = w.PayeeNames(addr)
+	if err != nil {
+		return nil, err
+	}
+
 	ainfo, err := w.AddressInfo(addr)
 	if err != nil {
 		if apperrors.IsError(err, apperrors.ErrAddressNotFound) {
@@ -3039,6 +4340,10 @@ func validateAddress(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 		return nil, &ErrAccountNameNotFound
 	}
 	result.Account = acctName
+	result.Label, err = w.AddressLabel(addr)
+	if err != nil {
+		return nil, err
+	}
 
 	switch ma := ainfo.(type) {
 	case udb.ManagedPubKeyAddress:
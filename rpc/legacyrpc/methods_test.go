@@ -0,0 +1,81 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package legacyrpc
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/abcsuite/abcd/abcjson"
+	"github.com/abcsuite/abcd/chaincfg"
+	"github.com/abcsuite/abcd/txscript"
+	"github.com/abcsuite/abcutil"
+)
+
+func TestMakeOutputsNullData(t *testing.T) {
+	data := func(size int) string {
+		return nullDataKeyPrefix + strings.Repeat("2a", size)
+	}
+	isRPCError := func(err error) bool {
+		e, ok := err.(*abcjson.RPCError)
+		return ok && e.Code == abcjson.ErrRPCInvalidParameter
+	}
+	isDeserializationError := func(err error) bool {
+		_, ok := err.(DeserializationError)
+		return ok
+	}
+	isInvalidParameterError := func(err error) bool {
+		_, ok := err.(InvalidParameterError)
+		return ok
+	}
+
+	tests := []struct {
+		name  string
+		pairs map[string]abcutil.Amount
+		err   func(error) bool
+	}{
+		{"empty data", map[string]abcutil.Amount{nullDataKeyPrefix: 0}, nil},
+		{"max data", map[string]abcutil.Amount{data(txscript.MaxDataCarrierSize): 0}, nil},
+		{"oversized data", map[string]abcutil.Amount{data(txscript.MaxDataCarrierSize + 1): 0}, isInvalidParameterError},
+		{"nonzero amount", map[string]abcutil.Amount{data(32): 1}, isRPCError},
+		{"second data key", map[string]abcutil.Amount{data(32): 0, data(16): 0}, isRPCError},
+		{"bad hex", map[string]abcutil.Amount{nullDataKeyPrefix + "2g": 0}, isDeserializationError},
+		{"odd length hex", map[string]abcutil.Amount{nullDataKeyPrefix + "2a2": 0}, isDeserializationError},
+	}
+	for _, test := range tests {
+		outputs, err := makeOutputs(test.pairs, &chaincfg.MainNetParams)
+		if test.err != nil {
+			if err == nil || !test.err(err) {
+				t.Errorf("%s: unexpected error %#v", test.name, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if len(outputs) != 1 {
+			t.Errorf("%s: got %d outputs, expected 1", test.name, len(outputs))
+			continue
+		}
+		output := outputs[0]
+		class := txscript.GetScriptClass(output.Version, output.PkScript)
+		if output.Value != 0 || class != txscript.NullDataTy {
+			t.Errorf("%s: output is not a null data output", test.name)
+			continue
+		}
+		var key string
+		for k := range test.pairs {
+			key = k
+		}
+		want, _ := hex.DecodeString(key[len(nullDataKeyPrefix):])
+		pushes, err := txscript.PushedData(output.PkScript)
+		if err != nil || !bytes.Equal(bytes.Join(pushes, nil), want) {
+			t.Errorf("%s: output does not carry the data", test.name)
+		}
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"crypto/rand"
 	"encoding/hex"
+	"math"
 	"sync"
 	"time"
 
@@ -45,6 +46,7 @@ import (
 	pb "github.com/abcsuite/abcwallet/rpc/walletrpc"
 	"github.com/abcsuite/abcwallet/ticketbuyer"
 	"github.com/abcsuite/abcwallet/wallet"
+	"github.com/abcsuite/abcwallet/wallet/pstx"
 	"github.com/abcsuite/abcwallet/wallet/txauthor"
 	"github.com/abcsuite/abcwallet/wallet/txrules"
 	"github.com/abcsuite/abcwallet/wallet/udb"
@@ -54,9 +56,9 @@ import (
 
 // Public API version constants
 const (
-	semverString = "4.18.0"
+	semverString = "4.38.0"
 	semverMajor  = 4
-	semverMinor  = 18
+	semverMinor  = 38
 	semverPatch  = 0
 )
 
@@ -83,14 +85,22 @@ func errorCode(err error) codes.Code {
 			return codes.InvalidArgument
 		case apperrors.ErrAccountNotFound:
 			return codes.NotFound
+		case apperrors.ErrAddressNotFound:
+			return codes.NotFound
 		case apperrors.ErrInvalidAccount: // reserved account
 			return codes.InvalidArgument
 		case apperrors.ErrDuplicateAccount:
 			return codes.AlreadyExists
+		case apperrors.ErrAlreadyExists:
+			return codes.AlreadyExists
+		case apperrors.ErrDuplicate:
+			return codes.AlreadyExists
 		case apperrors.ErrValueNoExists:
 			return codes.NotFound
 		case apperrors.ErrInput:
 			return codes.InvalidArgument
+		case apperrors.ErrWrongNet:
+			return codes.InvalidArgument
 		}
 
 		err = e.Err
@@ -112,6 +122,8 @@ func errorCode(err error) codes.Code {
 		return codes.NotFound
 	case hdkeychain.ErrInvalidSeedLen:
 		return codes.InvalidArgument
+	case txrules.ErrOutputIsDust:
+		return codes.InvalidArgument
 	default:
 		return codes.Unknown
 	}
@@ -341,6 +353,47 @@ func (s *walletServer) NextAccount(ctx context.Context, req *pb.NextAccountReque
 	return &pb.NextAccountResponse{AccountNumber: account}, nil
 }
 
+func (s *walletServer) NextMultisigAccount(ctx context.Context, req *pb.NextMultisigAccountRequest) (
+	*pb.NextMultisigAccountResponse, error) {
+
+	defer zero.Bytes(req.Passphrase)
+
+	if req.AccountName == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "account name may not be empty")
+	}
+
+	cosignerKeys := make([]*hdkeychain.ExtendedKey, len(req.CosignerExtendedPubKeys))
+	for i, s := range req.CosignerExtendedPubKeys {
+		key, err := hdkeychain.NewKeyFromString(s)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"cosigner extended public key %d: %v", i, err)
+		}
+		cosignerKeys[i] = key
+	}
+
+	// Watching-only wallets have no private keys, and do not need to be
+	// unlocked to create the account.
+	if !s.wallet.Manager.WatchingOnly() {
+		lock := make(chan time.Time, 1)
+		defer func() {
+			lock <- time.Time{} // send matters, not the value
+		}()
+		err := s.wallet.Unlock(req.Passphrase, lock)
+		if err != nil {
+			return nil, translateError(err)
+		}
+	}
+
+	account, err := s.wallet.NextMultisigAccount(req.AccountName,
+		int(req.RequiredSignatures), cosignerKeys)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return &pb.NextMultisigAccountResponse{AccountNumber: account}, nil
+}
+
 func (s *walletServer) NextAddress(ctx context.Context, req *pb.NextAddressRequest) (
 	*pb.NextAddressResponse, error) {
 
@@ -537,6 +590,7 @@ func (s *walletServer) Balance(ctx context.Context, req *pb.BalanceRequest) (
 		ImmatureStakeGeneration: int64(bals.ImmatureStakeGeneration),
 		LockedByTickets:         int64(bals.LockedByTickets),
 		VotingAuthority:         int64(bals.VotingAuthority),
+		LockedByVaults:          int64(bals.LockedByVaults),
 	}
 	return resp, nil
 }
@@ -623,6 +677,142 @@ func (s *walletServer) BlockInfo(ctx context.Context, req *pb.BlockInfoRequest)
 	}, nil
 }
 
+func (s *walletServer) LockedOutpoints(ctx context.Context, req *pb.LockedOutpointsRequest) (
+	*pb.LockedOutpointsResponse, error) {
+
+	locked := s.wallet.LockedOutpoints()
+	resp := &pb.LockedOutpointsResponse{
+		LockedOutpoints: make([]*pb.LockedOutpointsResponse_LockedOutpoint, len(locked)),
+	}
+	for i := range locked {
+		l := &locked[i]
+		resp.LockedOutpoints[i] = &pb.LockedOutpointsResponse_LockedOutpoint{
+			TransactionHash: l.OutPoint.Hash[:],
+			OutputIndex:     l.OutPoint.Index,
+			Tree:            int32(l.OutPoint.Tree),
+			Reason:          l.Reason,
+			ExpiryHeight:    l.ExpiryHeight,
+		}
+	}
+	return resp, nil
+}
+
+func (s *walletServer) TransactionLabel(ctx context.Context, req *pb.TransactionLabelRequest) (
+	*pb.TransactionLabelResponse, error) {
+
+	txHash, err := chainhash.NewHash(req.TransactionHash)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "transaction hash: %v", err)
+	}
+
+	label, err := s.wallet.TransactionLabel(txHash)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return &pb.TransactionLabelResponse{Label: label}, nil
+}
+
+func (s *walletServer) SearchTransactionLabels(ctx context.Context, req *pb.SearchTransactionLabelsRequest) (
+	*pb.SearchTransactionLabelsResponse, error) {
+
+	labels, err := s.wallet.SearchTransactionLabels(req.Query)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	resp := &pb.SearchTransactionLabelsResponse{
+		Labels: make([]*pb.SearchTransactionLabelsResponse_TransactionLabel, len(labels)),
+	}
+	for i := range labels {
+		l := &labels[i]
+		resp.Labels[i] = &pb.SearchTransactionLabelsResponse_TransactionLabel{
+			TransactionHash: l.Hash[:],
+			Label:           l.Label,
+		}
+	}
+	return resp, nil
+}
+
+func (s *walletServer) AddressLabels(ctx context.Context, req *pb.AddressLabelsRequest) (
+	*pb.AddressLabelsResponse, error) {
+
+	labels, err := s.wallet.AddressLabels()
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	resp := &pb.AddressLabelsResponse{
+		Labels: make([]*pb.AddressLabelsResponse_AddressLabel, len(labels)),
+	}
+	for i := range labels {
+		resp.Labels[i] = &pb.AddressLabelsResponse_AddressLabel{
+			Address: labels[i].Address,
+			Label:   labels[i].Label,
+		}
+	}
+	return resp, nil
+}
+
+func (s *walletServer) Payees(ctx context.Context, req *pb.PayeesRequest) (
+	*pb.PayeesResponse, error) {
+
+	payees, err := s.wallet.Payees()
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	resp := &pb.PayeesResponse{
+		Payees: make([]*pb.PayeesResponse_Payee, len(payees)),
+	}
+	for i := range payees {
+		resp.Payees[i] = &pb.PayeesResponse_Payee{
+			Name:    payees[i].Name,
+			Address: payees[i].Address,
+			Note:    payees[i].Note,
+		}
+	}
+	return resp, nil
+}
+
+// unixTime returns the Unix time of t, or zero if t is the zero time.
+func unixTime(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+func (s *walletServer) UnminedTransactions(ctx context.Context, req *pb.UnminedTransactionsRequest) (
+	*pb.UnminedTransactionsResponse, error) {
+
+	states, err := s.wallet.UnminedTxStates()
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	resp := &pb.UnminedTransactionsResponse{
+		Transactions: make([]*pb.UnminedTransactionsResponse_UnminedTransaction, len(states)),
+	}
+	for i := range states {
+		st := &states[i]
+		var lastError string
+		if st.LastError != nil {
+			lastError = st.LastError.Error()
+		}
+		resp.Transactions[i] = &pb.UnminedTransactionsResponse_UnminedTransaction{
+			TransactionHash:   st.Hash[:],
+			FirstSeen:         unixTime(st.FirstSeen),
+			LastSeen:          unixTime(st.LastSeen),
+			BroadcastAttempts: st.Attempts,
+			LastAttempt:       unixTime(st.LastAttempt),
+			LastError:         lastError,
+			NextAttempt:       unixTime(st.NextAttempt),
+		}
+	}
+	return resp, nil
+}
+
 func (s *walletServer) FundTransaction(ctx context.Context, req *pb.FundTransactionRequest) (
 	*pb.FundTransactionResponse, error) {
 
@@ -700,7 +890,40 @@ func decodeDestination(dest *pb.ConstructTransactionRequest_OutputDestination,
 			return nil, 0, status.Errorf(codes.InvalidArgument, "script_version overflows uint16")
 		}
 		return dest.Script, uint16(dest.ScriptVersion), nil
+	case dest.Data != nil:
+		output, err := txrules.NullDataOutput(dest.Data)
+		if err != nil {
+			return nil, 0, status.Errorf(codes.InvalidArgument, "data: %v", err)
+		}
+		return output.PkScript, output.Version, nil
+	}
+}
+
+// resolvePayee checks the address of an output destination which names a payee
+// saved in the wallet's address book.  If the destination does not include an
+// address, the saved payee address is used.
+func (s *walletServer) resolvePayee(dest *pb.ConstructTransactionRequest_OutputDestination) (
+	*pb.ConstructTransactionRequest_OutputDestination, error) {
+
+	if dest == nil || dest.Payee == "" {
+		return dest, nil
+	}
+	if dest.Script != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "payee and script may not be set together")
 	}
+	if dest.Data != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "payee and data may not be set together")
+	}
+
+	p, err := s.wallet.Payee(dest.Payee)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	if dest.Address != "" && dest.Address != p.Address {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"address %v does not match the saved address of payee %q", dest.Address, dest.Payee)
+	}
+	return &pb.ConstructTransactionRequest_OutputDestination{Address: p.Address}, nil
 }
 
 func (s *walletServer) ConstructTransaction(ctx context.Context, req *pb.ConstructTransactionRequest) (
@@ -713,12 +936,32 @@ func (s *walletServer) ConstructTransaction(ctx context.Context, req *pb.Constru
 			"non_change_outputs and change_destination may not both be empty or null")
 	}
 
+	// Transactions are only standard with a single null data output, which
+	// must not pay any value.  Outputs are classified by their decoded
+	// scripts, so null data scripts given as script destinations are
+	// included.
+	dataOutput := -1
 	outputs := make([]*wire.TxOut, 0, len(req.NonChangeOutputs))
-	for _, o := range req.NonChangeOutputs {
-		script, version, err := decodeDestination(o.Destination, chainParams)
+	for i, o := range req.NonChangeOutputs {
+		dest, err := s.resolvePayee(o.Destination)
+		if err != nil {
+			return nil, err
+		}
+		script, version, err := decodeDestination(dest, chainParams)
 		if err != nil {
 			return nil, err
 		}
+		if txscript.GetScriptClass(version, script) == txscript.NullDataTy {
+			if dataOutput != -1 {
+				return nil, status.Errorf(codes.InvalidArgument,
+					"only one output may carry data")
+			}
+			if o.Amount != 0 {
+				return nil, status.Errorf(codes.InvalidArgument,
+					"outputs carrying data must have zero amount")
+			}
+			dataOutput = i
+		}
 		output := &wire.TxOut{
 			Value:    o.Amount,
 			Version:  version,
@@ -737,22 +980,105 @@ func (s *walletServer) ConstructTransaction(ctx context.Context, req *pb.Constru
 		return nil, status.Errorf(codes.InvalidArgument, "unknown output selection algorithm")
 	}
 
+	var strategy txauthor.CoinSelectionStrategy
+	switch req.CoinSelectionStrategy {
+	case pb.ConstructTransactionRequest_WALLET_DEFAULT:
+		strategy = txauthor.CoinSelectionDefault
+	case pb.ConstructTransactionRequest_BRANCH_AND_BOUND:
+		strategy = txauthor.CoinSelectionBranchAndBound
+	case pb.ConstructTransactionRequest_LARGEST_FIRST:
+		strategy = txauthor.CoinSelectionLargestFirst
+	case pb.ConstructTransactionRequest_SMALLEST_FIRST:
+		strategy = txauthor.CoinSelectionSmallestFirst
+	case pb.ConstructTransactionRequest_OLDEST_FIRST:
+		strategy = txauthor.CoinSelectionOldestFirst
+	case pb.ConstructTransactionRequest_PRIVACY:
+		strategy = txauthor.CoinSelectionPrivacy
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown coin selection strategy")
+	}
+
+	var coinControl *wallet.CoinControl
+	if len(req.ExplicitInputs) != 0 || len(req.IncludeOutpoints) != 0 ||
+		len(req.ExcludeOutpoints) != 0 {
+
+		decodeOutPoints := func(ops []*pb.ConstructTransactionRequest_OutPoint) ([]wire.OutPoint, error) {
+			decoded := make([]wire.OutPoint, 0, len(ops))
+			for _, o := range ops {
+				op, err := decodeOutPoint(o.TransactionHash, o.OutputIndex, o.Tree)
+				if err != nil {
+					return nil, err
+				}
+				decoded = append(decoded, *op)
+			}
+			return decoded, nil
+		}
+		coinControl = new(wallet.CoinControl)
+		var err error
+		coinControl.Inputs, err = decodeOutPoints(req.ExplicitInputs)
+		if err != nil {
+			return nil, err
+		}
+		coinControl.Include, err = decodeOutPoints(req.IncludeOutpoints)
+		if err != nil {
+			return nil, err
+		}
+		coinControl.Exclude, err = decodeOutPoints(req.ExcludeOutpoints)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	subtractFeeFrom := make([]int, 0, len(req.SubtractFeeFrom))
+	for j, i := range req.SubtractFeeFrom {
+		if i >= uint32(len(req.NonChangeOutputs)) {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"subtract_fee_from index %d is out of range", i)
+		}
+		for _, prev := range req.SubtractFeeFrom[:j] {
+			if i == prev {
+				return nil, status.Errorf(codes.InvalidArgument,
+					"duplicate subtract_fee_from index %d", i)
+			}
+		}
+		if int(i) == dataOutput {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"subtract_fee_from index %d carries data", i)
+		}
+		subtractFeeFrom = append(subtractFeeFrom, int(i))
+	}
+
 	feePerKb := txrules.DefaultRelayFeePerKb
 	if req.FeePerKb != 0 {
 		feePerKb = abcutil.Amount(req.FeePerKb)
 	}
 
+	var expiry *wallet.TxExpiry
+	if req.Expiry != 0 {
+		expiry = &wallet.TxExpiry{
+			Height:   req.Expiry,
+			Relative: req.RelativeExpiry,
+		}
+	}
+
 	var changeSource txauthor.ChangeSource
 	if req.ChangeDestination != nil {
 		script, version, err := decodeDestination(req.ChangeDestination, chainParams)
 		if err != nil {
 			return nil, err
 		}
+		if txscript.GetScriptClass(version, script) == txscript.NullDataTy {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"change_destination may not carry data")
+		}
 		changeSource = func() ([]byte, uint16, error) { return script, version, nil }
+	} else if req.DryRun {
+		changeSource = s.wallet.PreviewChangeSource(req.SourceAccount)
 	}
 
 	tx, err := s.wallet.NewUnsignedTransaction(outputs, feePerKb, req.SourceAccount,
-		req.RequiredConfirmations, algo, changeSource)
+		req.RequiredConfirmations, algo, strategy, coinControl, subtractFeeFrom,
+		expiry, changeSource)
 	if err != nil {
 		return nil, translateError(err)
 	}
@@ -768,11 +1094,16 @@ func (s *walletServer) ConstructTransaction(ctx context.Context, req *pb.Constru
 		return nil, translateError(err)
 	}
 
+	totalOutput := h.SumOutputValues(tx.Tx.TxOut)
+	fee := tx.TotalInput - totalOutput
 	res := &pb.ConstructTransactionResponse{
 		UnsignedTransaction:       txBuf.Bytes(),
 		TotalPreviousOutputAmount: int64(tx.TotalInput),
-		TotalOutputAmount:         int64(h.SumOutputValues(tx.Tx.TxOut)),
+		TotalOutputAmount:         int64(totalOutput),
 		EstimatedSignedSize:       uint32(tx.EstimatedSignedSerializeSize),
+		Fee:                       int64(fee),
+		FeeRate:                   int64(fee) * 1000 / int64(tx.EstimatedSignedSerializeSize),
+		ChangeIndex:               int32(tx.ChangeIndex),
 	}
 	return res, nil
 }
@@ -867,6 +1198,48 @@ func (s *walletServer) GetTransactions(req *pb.GetTransactionsRequest,
 	return nil
 }
 
+func (s *walletServer) AddressTransactions(req *pb.AddressTransactionsRequest,
+	server pb.WalletService_AddressTransactionsServer) error {
+
+	addr, err := decodeAddress(req.Address, s.wallet.ChainParams())
+	if err != nil {
+		return err
+	}
+	if req.StartingBlockHeight < 0 || req.EndingBlockHeight < 0 {
+		return status.Errorf(codes.InvalidArgument,
+			"block heights may not be negative")
+	}
+	endHeight := req.EndingBlockHeight
+	if endHeight == 0 {
+		endHeight = -1
+	}
+
+	gtr, err := s.wallet.AddressTransactions(addr, req.StartingBlockHeight,
+		endHeight, int(req.MaxTransactions), server.Context().Done())
+	if err != nil {
+		return translateError(err)
+	}
+	for i := range gtr.MinedTransactions {
+		resp := &pb.AddressTransactionsResponse{
+			MinedTransactions: marshalBlock(&gtr.MinedTransactions[i]),
+		}
+		err = server.Send(resp)
+		if err != nil {
+			return err
+		}
+	}
+	if len(gtr.UnminedTransactions) > 0 {
+		resp := &pb.AddressTransactionsResponse{
+			UnminedTransactions: marshalTransactionDetailsSlice(gtr.UnminedTransactions),
+		}
+		err = server.Send(resp)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *walletServer) ChangePassphrase(ctx context.Context, req *pb.ChangePassphraseRequest) (
 	*pb.ChangePassphraseResponse, error) {
 
@@ -971,68 +1344,48 @@ func (s *walletServer) PublishTransaction(ctx context.Context, req *pb.PublishTr
 	return &pb.PublishTransactionResponse{TransactionHash: txHash[:]}, nil
 }
 
-// PurchaseTickets purchases tickets from the wallet.
-func (s *walletServer) PurchaseTickets(ctx context.Context,
-	req *pb.PurchaseTicketsRequest) (*pb.PurchaseTicketsResponse, error) {
-	// Unmarshall the received data and prepare it as input for the ticket
-	// purchase request.
-	spendLimit := abcutil.Amount(req.SpendLimit)
-	if spendLimit < 0 {
-		return nil, status.Errorf(codes.InvalidArgument,
-			"Negative spend limit given")
+func (s *walletServer) SweepAccount(ctx context.Context, req *pb.SweepAccountRequest) (
+	*pb.SweepAccountResponse, error) {
+
+	defer zero.Bytes(req.Passphrase)
+
+	_, err := s.requireChainClient()
+	if err != nil {
+		return nil, err
 	}
 
-	minConf := int32(req.RequiredConfirmations)
-	params := s.wallet.ChainParams()
+	chainParams := s.wallet.ChainParams()
 
-	var ticketAddr abcutil.Address
-	var err error
-	if req.TicketAddress != "" {
-		ticketAddr, err = decodeAddress(req.TicketAddress, params)
-		if err != nil {
-			return nil, err
-		}
+	if len(req.Destinations) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "destinations may not be empty")
+	}
+	if req.MinValue < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "min_value may not be negative")
 	}
 
-	var poolAddr abcutil.Address
-	if req.PoolAddress != "" {
-		poolAddr, err = decodeAddress(req.PoolAddress, params)
+	destinations := make([]txauthor.SweepDestination, 0, len(req.Destinations))
+	for i, d := range req.Destinations {
+		if !(d.Ratio > 0) || math.IsInf(d.Ratio, 1) {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"ratio of destination %d must be positive", i)
+		}
+		if d.Destination != nil && d.Destination.Data != nil {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"destination %d may not carry data", i)
+		}
+		dest, err := s.resolvePayee(d.Destination)
 		if err != nil {
 			return nil, err
 		}
-	}
-
-	if req.PoolFees > 0 {
-		err = txrules.IsValidPoolFeeRate(req.PoolFees)
+		script, version, err := decodeDestination(dest, chainParams)
 		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument,
-				"Pool fees amount invalid: %v", err)
+			return nil, err
 		}
-	}
-
-	if req.PoolFees > 0 && poolAddr == nil {
-		return nil, status.Errorf(codes.InvalidArgument,
-			"Pool fees set but no pool address given")
-	}
-
-	if req.PoolFees <= 0 && poolAddr != nil {
-		return nil, status.Errorf(codes.InvalidArgument,
-			"Pool fees negative or unset but pool address given")
-	}
-
-	numTickets := int(req.NumTickets)
-	if numTickets < 1 {
-		return nil, status.Errorf(codes.InvalidArgument,
-			"Zero or negative number of tickets given")
-	}
-
-	expiry := int32(req.Expiry)
-	txFee := abcutil.Amount(req.TxFee)
-	ticketFee := abcutil.Amount(req.TicketFee)
-
-	if txFee < 0 || ticketFee < 0 {
-		return nil, status.Errorf(codes.InvalidArgument,
-			"Negative fees per KB given")
+		destinations = append(destinations, txauthor.SweepDestination{
+			PkScript: script,
+			Version:  version,
+			Ratio:    d.Ratio,
+		})
 	}
 
 	lock := make(chan time.Time, 1)
@@ -1044,17 +1397,862 @@ func (s *walletServer) PurchaseTickets(ctx context.Context,
 		return nil, translateError(err)
 	}
 
-	resp, err := s.wallet.PurchaseTickets(0, spendLimit, minConf,
-		ticketAddr, req.Account, numTickets, poolAddr, req.PoolFees,
-		expiry, txFee, ticketFee)
+	atx, err := s.wallet.SweepAccount(req.SourceAccount, req.RequiredConfirmations,
+		abcutil.Amount(req.MinValue), destinations)
 	if err != nil {
-		return nil, status.Errorf(codes.FailedPrecondition,
-			"Unable to purchase tickets: %v", err)
+		return nil, translateError(err)
 	}
 
-	hashes := marshalHashes(resp)
+	var serializedTransaction bytes.Buffer
+	serializedTransaction.Grow(atx.Tx.SerializeSize())
+	err = atx.Tx.Serialize(&serializedTransaction)
+	if err != nil {
+		return nil, translateError(err)
+	}
 
-	return &pb.PurchaseTicketsResponse{TicketHashes: hashes}, nil
+	var totalOutput int64
+	for _, output := range atx.Tx.TxOut {
+		totalOutput += output.Value
+	}
+	txHash := atx.Tx.TxHash()
+	return &pb.SweepAccountResponse{
+		TransactionHash:           txHash[:],
+		SignedTransaction:         serializedTransaction.Bytes(),
+		TotalPreviousOutputAmount: int64(atx.TotalInput),
+		TotalOutputAmount:         totalOutput,
+	}, nil
+}
+
+func (s *walletServer) AbandonTransaction(ctx context.Context, req *pb.AbandonTransactionRequest) (
+	*pb.AbandonTransactionResponse, error) {
+
+	txHash, err := chainhash.NewHash(req.TransactionHash)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "transaction_hash has invalid length")
+	}
+
+	removed, err := s.wallet.AbandonTransaction(txHash)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return &pb.AbandonTransactionResponse{
+		RemovedTransactionHashes: marshalHashes(removed),
+	}, nil
+}
+
+func (s *walletServer) BumpFee(ctx context.Context, req *pb.BumpFeeRequest) (
+	*pb.BumpFeeResponse, error) {
+
+	defer zero.Bytes(req.Passphrase)
+
+	txHash, err := chainhash.NewHash(req.TransactionHash)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "transaction_hash has invalid length")
+	}
+	if req.FeeRate <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "fee_rate must be positive")
+	}
+	feeRate := abcutil.Amount(req.FeeRate)
+
+	var bump *wallet.FeeBump
+	if req.DryRun {
+		bump, err = s.wallet.BumpFeeDryRun(txHash, feeRate)
+	} else {
+		_, err = s.requireChainClient()
+		if err != nil {
+			return nil, err
+		}
+
+		lock := make(chan time.Time, 1)
+		defer func() {
+			lock <- time.Time{} // send matters, not the value
+		}()
+		err = s.wallet.Unlock(req.Passphrase, lock)
+		if err != nil {
+			return nil, translateError(err)
+		}
+
+		bump, err = s.wallet.BumpFee(txHash, feeRate)
+	}
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	var txBuf bytes.Buffer
+	txBuf.Grow(bump.Tx.SerializeSize())
+	err = bump.Tx.Serialize(&txBuf)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	childHash := bump.Tx.TxHash()
+
+	return &pb.BumpFeeResponse{
+		TransactionHash:     childHash[:],
+		Transaction:         txBuf.Bytes(),
+		Fee:                 int64(bump.Fee),
+		EstimatedSignedSize: uint32(bump.EstimatedSignedSerializeSize),
+		ParentFee:           int64(bump.ParentFee),
+		ParentSize:          uint32(bump.ParentSize),
+		PackageFeeRate:      int64(bump.PackageFeeRate),
+	}, nil
+}
+
+func parsePartialTx(b []byte) (*pstx.Tx, error) {
+	p, err := pstx.Parse(b)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Bytes do not represent a valid partially signed transaction: %v", err)
+	}
+	return p, nil
+}
+
+func (s *walletServer) CreatePartialTransaction(ctx context.Context,
+	req *pb.CreatePartialTransactionRequest) (*pb.CreatePartialTransactionResponse, error) {
+
+	var tx wire.MsgTx
+	err := tx.Deserialize(bytes.NewReader(req.UnsignedTransaction))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Bytes do not represent a valid raw transaction: %v", err)
+	}
+
+	p, err := s.wallet.CreatePartialTx(&tx)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	b, err := p.Bytes()
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.CreatePartialTransactionResponse{PartialTransaction: b}, nil
+}
+
+func (s *walletServer) UpdatePartialTransaction(ctx context.Context,
+	req *pb.UpdatePartialTransactionRequest) (*pb.UpdatePartialTransactionResponse, error) {
+
+	p, err := parsePartialTx(req.PartialTransaction)
+	if err != nil {
+		return nil, err
+	}
+	err = s.wallet.UpdatePartialTx(p)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	b, err := p.Bytes()
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.UpdatePartialTransactionResponse{PartialTransaction: b}, nil
+}
+
+func (s *walletServer) SignPartialTransaction(ctx context.Context,
+	req *pb.SignPartialTransactionRequest) (*pb.SignPartialTransactionResponse, error) {
+
+	defer zero.Bytes(req.Passphrase)
+
+	p, err := parsePartialTx(req.PartialTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	lock := make(chan time.Time, 1)
+	defer func() {
+		lock <- time.Time{} // send matters, not the value
+	}()
+	err = s.wallet.Unlock(req.Passphrase, lock)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	signed, err := s.wallet.SignPartialTx(p)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	b, err := p.Bytes()
+	if err != nil {
+		return nil, translateError(err)
+	}
+	indexes := make([]uint32, len(signed))
+	for i, index := range signed {
+		indexes[i] = uint32(index)
+	}
+	return &pb.SignPartialTransactionResponse{
+		PartialTransaction: b,
+		SignedInputIndexes: indexes,
+	}, nil
+}
+
+func (s *walletServer) CombinePartialTransactions(ctx context.Context,
+	req *pb.CombinePartialTransactionsRequest) (*pb.CombinePartialTransactionsResponse, error) {
+
+	if len(req.PartialTransactions) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"no partial transactions to combine")
+	}
+	txs := make([]*pstx.Tx, len(req.PartialTransactions))
+	for i, b := range req.PartialTransactions {
+		p, err := parsePartialTx(b)
+		if err != nil {
+			return nil, err
+		}
+		txs[i] = p
+	}
+
+	combined, err := pstx.Combine(txs...)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Cannot combine partial transactions: %v", err)
+	}
+	b, err := combined.Bytes()
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.CombinePartialTransactionsResponse{PartialTransaction: b}, nil
+}
+
+func (s *walletServer) FinalizePartialTransaction(ctx context.Context,
+	req *pb.FinalizePartialTransactionRequest) (*pb.FinalizePartialTransactionResponse, error) {
+
+	p, err := parsePartialTx(req.PartialTransaction)
+	if err != nil {
+		return nil, err
+	}
+	complete, err := s.wallet.FinalizePartialTx(p)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	b, err := p.Bytes()
+	if err != nil {
+		return nil, translateError(err)
+	}
+	resp := &pb.FinalizePartialTransactionResponse{
+		PartialTransaction: b,
+		Complete:           complete,
+	}
+	if complete {
+		var txBuf bytes.Buffer
+		txBuf.Grow(p.Tx.SerializeSize())
+		err = p.Tx.Serialize(&txBuf)
+		if err != nil {
+			return nil, translateError(err)
+		}
+		resp.SignedTransaction = txBuf.Bytes()
+	}
+	return resp, nil
+}
+
+func marshalSigningSession(session *wallet.SigningSession) (*pb.SigningSession, error) {
+	b, err := session.PartialTx.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	inputs := make([]*pb.SigningSession_Input, len(session.Inputs))
+	for i := range session.Inputs {
+		in := &session.Inputs[i]
+		inputs[i] = &pb.SigningSession_Input{
+			PreviousTransactionHash: in.PreviousOutPoint.Hash[:],
+			PreviousOutputIndex:     in.PreviousOutPoint.Index,
+			Amount:                  int64(in.Amount),
+			RequiredSignatures:      uint32(in.RequiredSigs),
+			SignedPubKeys:           in.SignedPubKeys,
+			UnsignedPubKeys:         in.UnsignedPubKeys,
+			Final:                   in.Final,
+		}
+	}
+	resp := &pb.SigningSession{
+		TransactionHash:    session.Hash[:],
+		Created:            session.Created.Unix(),
+		PartialTransaction: b,
+		Inputs:             inputs,
+		Complete:           session.Complete,
+	}
+	if session.Complete {
+		var txBuf bytes.Buffer
+		txBuf.Grow(session.SignedTx.SerializeSize())
+		err = session.SignedTx.Serialize(&txBuf)
+		if err != nil {
+			return nil, err
+		}
+		resp.SignedTransaction = txBuf.Bytes()
+	}
+	return resp, nil
+}
+
+func (s *walletServer) CreateSigningSession(ctx context.Context,
+	req *pb.CreateSigningSessionRequest) (*pb.CreateSigningSessionResponse, error) {
+
+	var p *pstx.Tx
+	switch {
+	case req.PartialTransaction != nil:
+		var err error
+		p, err = parsePartialTx(req.PartialTransaction)
+		if err != nil {
+			return nil, err
+		}
+
+	case req.UnsignedTransaction != nil:
+		var tx wire.MsgTx
+		err := tx.Deserialize(bytes.NewReader(req.UnsignedTransaction))
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"Bytes do not represent a valid raw transaction: %v", err)
+		}
+		p = pstx.New(&tx)
+
+	case len(req.MultisigOutpoints) != 0:
+		chainParams := s.wallet.ChainParams()
+		addr, err := decodeAddress(req.DestinationAddress, chainParams)
+		if err != nil {
+			return nil, err
+		}
+		feePerKb := s.wallet.RelayFee()
+		if req.FeePerKb != 0 {
+			feePerKb = abcutil.Amount(req.FeePerKb)
+		}
+		if feePerKb < 0 {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"Negative fee per KB given")
+		}
+		outpoints := make([]wire.OutPoint, len(req.MultisigOutpoints))
+		for i, op := range req.MultisigOutpoints {
+			hash, err := chainhash.NewHash(op.TransactionHash)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument,
+					"multisig outpoint transaction hash: %v", err)
+			}
+			outpoints[i] = wire.OutPoint{Hash: *hash, Index: op.OutputIndex}
+		}
+		tx, err := s.wallet.SpendMultisigCredits(outpoints, addr, feePerKb)
+		if err != nil {
+			return nil, translateError(err)
+		}
+		p = pstx.New(tx)
+
+	default:
+		return nil, status.Errorf(codes.InvalidArgument,
+			"a partial transaction, unsigned transaction, or multisig "+
+				"outpoints are required")
+	}
+
+	session, err := s.wallet.CreateSigningSession(p)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	pbSession, err := marshalSigningSession(session)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.CreateSigningSessionResponse{Session: pbSession}, nil
+}
+
+func (s *walletServer) SigningSessions(ctx context.Context,
+	req *pb.SigningSessionsRequest) (*pb.SigningSessionsResponse, error) {
+
+	var sessions []*wallet.SigningSession
+	if req.TransactionHash != nil {
+		txHash, err := chainhash.NewHash(req.TransactionHash)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "transaction hash: %v", err)
+		}
+		session, err := s.wallet.SigningSession(txHash)
+		if err != nil {
+			return nil, translateError(err)
+		}
+		sessions = []*wallet.SigningSession{session}
+	} else {
+		var err error
+		sessions, err = s.wallet.SigningSessions()
+		if err != nil {
+			return nil, translateError(err)
+		}
+	}
+
+	resp := &pb.SigningSessionsResponse{
+		Sessions: make([]*pb.SigningSession, len(sessions)),
+	}
+	for i, session := range sessions {
+		var err error
+		resp.Sessions[i], err = marshalSigningSession(session)
+		if err != nil {
+			return nil, translateError(err)
+		}
+	}
+	return resp, nil
+}
+
+func (s *walletServer) ImportSigningSessionSignatures(ctx context.Context,
+	req *pb.ImportSigningSessionSignaturesRequest) (*pb.ImportSigningSessionSignaturesResponse, error) {
+
+	p, err := parsePartialTx(req.PartialTransaction)
+	if err != nil {
+		return nil, err
+	}
+	session, err := s.wallet.ImportSigningSessionSigs(p)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	pbSession, err := marshalSigningSession(session)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.ImportSigningSessionSignaturesResponse{Session: pbSession}, nil
+}
+
+func (s *walletServer) SignSigningSession(ctx context.Context,
+	req *pb.SignSigningSessionRequest) (*pb.SignSigningSessionResponse, error) {
+
+	defer zero.Bytes(req.Passphrase)
+
+	txHash, err := chainhash.NewHash(req.TransactionHash)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "transaction hash: %v", err)
+	}
+
+	lock := make(chan time.Time, 1)
+	defer func() {
+		lock <- time.Time{} // send matters, not the value
+	}()
+	err = s.wallet.Unlock(req.Passphrase, lock)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	session, signed, err := s.wallet.SignSigningSession(txHash)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	pbSession, err := marshalSigningSession(session)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	indexes := make([]uint32, len(signed))
+	for i, index := range signed {
+		indexes[i] = uint32(index)
+	}
+	return &pb.SignSigningSessionResponse{
+		Session:            pbSession,
+		SignedInputIndexes: indexes,
+	}, nil
+}
+
+func (s *walletServer) AbandonSigningSession(ctx context.Context,
+	req *pb.AbandonSigningSessionRequest) (*pb.AbandonSigningSessionResponse, error) {
+
+	txHash, err := chainhash.NewHash(req.TransactionHash)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "transaction hash: %v", err)
+	}
+	err = s.wallet.AbandonSigningSession(txHash)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.AbandonSigningSessionResponse{}, nil
+}
+
+func marshalSwapContract(c *wallet.SwapContract) *pb.SwapContract {
+	contract := &pb.SwapContract{
+		TransactionHash:  c.OutPoint.Hash[:],
+		OutputIndex:      c.OutPoint.Index,
+		Role:             pb.SwapContract_Role(c.Role),
+		Account:          c.Account,
+		Amount:           int64(c.Amount),
+		Contract:         c.Contract,
+		Address:          c.Address.EncodeAddress(),
+		RecipientAddress: c.RecipientAddress.EncodeAddress(),
+		RefundAddress:    c.RefundAddress.EncodeAddress(),
+		SecretHash:       c.SecretHash,
+		LockTime:         c.LockTime,
+		Secret:           c.Secret,
+		Created:          c.Created.Unix(),
+		Redeemed:         c.Redeemed,
+	}
+	if c.SpendTx != nil {
+		contract.SpendingTransactionHash = c.SpendTx[:]
+	}
+	return contract
+}
+
+func serializeTx(tx *wire.MsgTx) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.Grow(tx.SerializeSize())
+	err := tx.Serialize(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (s *walletServer) InitiateSwap(ctx context.Context, req *pb.InitiateSwapRequest) (
+	*pb.InitiateSwapResponse, error) {
+
+	defer zero.Bytes(req.Passphrase)
+
+	recipient, err := decodeAddress(req.RecipientAddress, s.wallet.ChainParams())
+	if err != nil {
+		return nil, err
+	}
+
+	lock := make(chan time.Time, 1)
+	defer func() {
+		lock <- time.Time{} // send matters, not the value
+	}()
+	err = s.wallet.Unlock(req.Passphrase, lock)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	contract, tx, err := s.wallet.InitiateSwap(req.Account, recipient,
+		abcutil.Amount(req.Amount))
+	if err != nil {
+		return nil, translateError(err)
+	}
+	b, err := serializeTx(tx)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.InitiateSwapResponse{
+		Contract:            marshalSwapContract(contract),
+		ContractTransaction: b,
+	}, nil
+}
+
+func (s *walletServer) ParticipateSwap(ctx context.Context, req *pb.ParticipateSwapRequest) (
+	*pb.ParticipateSwapResponse, error) {
+
+	defer zero.Bytes(req.Passphrase)
+
+	recipient, err := decodeAddress(req.RecipientAddress, s.wallet.ChainParams())
+	if err != nil {
+		return nil, err
+	}
+
+	lock := make(chan time.Time, 1)
+	defer func() {
+		lock <- time.Time{} // send matters, not the value
+	}()
+	err = s.wallet.Unlock(req.Passphrase, lock)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	contract, tx, err := s.wallet.ParticipateSwap(req.Account, recipient,
+		abcutil.Amount(req.Amount), req.SecretHash)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	b, err := serializeTx(tx)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.ParticipateSwapResponse{
+		Contract:            marshalSwapContract(contract),
+		ContractTransaction: b,
+	}, nil
+}
+
+func (s *walletServer) RedeemSwap(ctx context.Context, req *pb.RedeemSwapRequest) (
+	*pb.RedeemSwapResponse, error) {
+
+	defer zero.Bytes(req.Passphrase)
+
+	txHash, err := chainhash.NewHash(req.TransactionHash)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "transaction hash: %v", err)
+	}
+	op := wire.OutPoint{Hash: *txHash, Index: req.OutputIndex}
+
+	lock := make(chan time.Time, 1)
+	defer func() {
+		lock <- time.Time{} // send matters, not the value
+	}()
+	err = s.wallet.Unlock(req.Passphrase, lock)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	redeemHash, err := s.wallet.RedeemSwap(&op, req.Secret)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.RedeemSwapResponse{TransactionHash: redeemHash[:]}, nil
+}
+
+func (s *walletServer) RefundSwap(ctx context.Context, req *pb.RefundSwapRequest) (
+	*pb.RefundSwapResponse, error) {
+
+	defer zero.Bytes(req.Passphrase)
+
+	txHash, err := chainhash.NewHash(req.TransactionHash)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "transaction hash: %v", err)
+	}
+	op := wire.OutPoint{Hash: *txHash, Index: req.OutputIndex}
+
+	lock := make(chan time.Time, 1)
+	defer func() {
+		lock <- time.Time{} // send matters, not the value
+	}()
+	err = s.wallet.Unlock(req.Passphrase, lock)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	refundHash, err := s.wallet.RefundSwap(&op)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.RefundSwapResponse{TransactionHash: refundHash[:]}, nil
+}
+
+func (s *walletServer) ExtractSwapSecret(ctx context.Context, req *pb.ExtractSwapSecretRequest) (
+	*pb.ExtractSwapSecretResponse, error) {
+
+	var tx wire.MsgTx
+	err := tx.Deserialize(bytes.NewReader(req.Transaction))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Bytes do not represent a valid raw transaction: %v", err)
+	}
+	secret, err := s.wallet.ExtractSwapSecret(&tx, req.SecretHash)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.ExtractSwapSecretResponse{Secret: secret}, nil
+}
+
+func (s *walletServer) AuditSwapContract(ctx context.Context, req *pb.AuditSwapContractRequest) (
+	*pb.AuditSwapContractResponse, error) {
+
+	var tx wire.MsgTx
+	err := tx.Deserialize(bytes.NewReader(req.ContractTransaction))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Bytes do not represent a valid raw transaction: %v", err)
+	}
+	var contract *wallet.SwapContract
+	if req.Watch {
+		contract, err = s.wallet.WatchSwapContract(req.Contract, &tx)
+	} else {
+		contract, err = s.wallet.AuditSwapContract(req.Contract, &tx)
+	}
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.AuditSwapContractResponse{Contract: marshalSwapContract(contract)}, nil
+}
+
+func (s *walletServer) SwapContracts(ctx context.Context, req *pb.SwapContractsRequest) (
+	*pb.SwapContractsResponse, error) {
+
+	contracts, err := s.wallet.SwapContracts()
+	if err != nil {
+		return nil, translateError(err)
+	}
+	resp := &pb.SwapContractsResponse{
+		Contracts: make([]*pb.SwapContract, len(contracts)),
+	}
+	for i, c := range contracts {
+		resp.Contracts[i] = marshalSwapContract(c)
+	}
+	return resp, nil
+}
+
+func marshalVault(v *wallet.Vault) *pb.Vault {
+	vault := &pb.Vault{
+		TransactionHash: v.OutPoint.Hash[:],
+		OutputIndex:     v.OutPoint.Index,
+		Account:         v.Account,
+		Amount:          int64(v.Amount),
+		Script:          v.Script,
+		Address:         v.Address.EncodeAddress(),
+		KeyAddress:      v.KeyAddress.EncodeAddress(),
+		LockType:        pb.Vault_LockType(v.LockType),
+		LockValue:       v.LockValue,
+		Unlocked:        v.Unlocked,
+		Created:         v.Created.Unix(),
+	}
+	if v.SpendTx != nil {
+		vault.SpendingTransactionHash = v.SpendTx[:]
+	}
+	return vault
+}
+
+func (s *walletServer) CreateVault(ctx context.Context, req *pb.CreateVaultRequest) (
+	*pb.CreateVaultResponse, error) {
+
+	defer zero.Bytes(req.Passphrase)
+
+	var lockType wallet.VaultLockType
+	switch req.LockType {
+	case pb.Vault_HEIGHT:
+		lockType = wallet.VaultLockHeight
+	case pb.Vault_TIME:
+		lockType = wallet.VaultLockTime
+	case pb.Vault_BLOCKS:
+		lockType = wallet.VaultLockBlocks
+	case pb.Vault_SECONDS:
+		lockType = wallet.VaultLockSeconds
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown lock type %v",
+			req.LockType)
+	}
+
+	lock := make(chan time.Time, 1)
+	defer func() {
+		lock <- time.Time{} // send matters, not the value
+	}()
+	err := s.wallet.Unlock(req.Passphrase, lock)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	vault, tx, err := s.wallet.CreateVault(req.Account, abcutil.Amount(req.Amount),
+		lockType, req.LockValue)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	b, err := serializeTx(tx)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.CreateVaultResponse{
+		Vault:            marshalVault(vault),
+		VaultTransaction: b,
+	}, nil
+}
+
+func (s *walletServer) Vaults(ctx context.Context, req *pb.VaultsRequest) (
+	*pb.VaultsResponse, error) {
+
+	vaults, err := s.wallet.Vaults()
+	if err != nil {
+		return nil, translateError(err)
+	}
+	resp := &pb.VaultsResponse{
+		Vaults: make([]*pb.Vault, len(vaults)),
+	}
+	for i, v := range vaults {
+		resp.Vaults[i] = marshalVault(v)
+	}
+	return resp, nil
+}
+
+// PurchaseTickets purchases tickets from the wallet.
+func (s *walletServer) PurchaseTickets(ctx context.Context,
+	req *pb.PurchaseTicketsRequest) (*pb.PurchaseTicketsResponse, error) {
+	// Unmarshall the received data and prepare it as input for the ticket
+	// purchase request.
+	spendLimit := abcutil.Amount(req.SpendLimit)
+	if spendLimit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Negative spend limit given")
+	}
+
+	minConf := int32(req.RequiredConfirmations)
+	params := s.wallet.ChainParams()
+
+	var ticketAddr abcutil.Address
+	var err error
+	if req.TicketAddress != "" {
+		ticketAddr, err = decodeAddress(req.TicketAddress, params)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var poolAddr abcutil.Address
+	if req.PoolAddress != "" {
+		poolAddr, err = decodeAddress(req.PoolAddress, params)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if req.PoolFees > 0 {
+		err = txrules.IsValidPoolFeeRate(req.PoolFees)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"Pool fees amount invalid: %v", err)
+		}
+	}
+
+	if req.PoolFees > 0 && poolAddr == nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Pool fees set but no pool address given")
+	}
+
+	if req.PoolFees <= 0 && poolAddr != nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Pool fees negative or unset but pool address given")
+	}
+
+	numTickets := int(req.NumTickets)
+	if numTickets < 1 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Zero or negative number of tickets given")
+	}
+
+	expiry := int32(req.Expiry)
+	txFee := abcutil.Amount(req.TxFee)
+	ticketFee := abcutil.Amount(req.TicketFee)
+
+	if txFee < 0 || ticketFee < 0 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Negative fees per KB given")
+	}
+
+	if req.DryRun {
+		preview, err := s.wallet.PurchaseTicketsDryRun(0, spendLimit, minConf,
+			ticketAddr, req.Account, numTickets, poolAddr, req.PoolFees,
+			expiry, txFee, ticketFee)
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition,
+				"Unable to preview ticket purchase: %v", err)
+		}
+		var splitTxBuf bytes.Buffer
+		splitTxBuf.Grow(preview.SplitTx.Tx.SerializeSize())
+		err = preview.SplitTx.Tx.Serialize(&splitTxBuf)
+		if err != nil {
+			return nil, translateError(err)
+		}
+		return &pb.PurchaseTicketsResponse{
+			Preview: &pb.PurchaseTicketsResponse_Preview{
+				UnsignedSplitTransaction:       splitTxBuf.Bytes(),
+				SplitTotalPreviousOutputAmount: int64(preview.SplitTx.TotalInput),
+				SplitEstimatedSignedSize:       uint32(preview.SplitTx.EstimatedSignedSerializeSize),
+				SplitFee:                       int64(preview.SplitTx.Fee),
+				SplitFeeRate:                   int64(preview.SplitTx.FeeRate),
+				NumTickets:                     uint32(preview.NumTickets),
+				TicketPrice:                    int64(preview.TicketPrice),
+				TicketFee:                      int64(preview.TicketFee),
+				TicketFeeRate:                  int64(preview.TicketFeeRate),
+				EstimatedTicketSize:            uint32(preview.EstimatedTicketSize),
+				PoolFee:                        int64(preview.PoolFee),
+			},
+		}, nil
+	}
+
+	lock := make(chan time.Time, 1)
+	defer func() {
+		lock <- time.Time{} // send matters, not the value
+	}()
+	err = s.wallet.Unlock(req.Passphrase, lock)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	resp, err := s.wallet.PurchaseTickets(0, spendLimit, minConf,
+		ticketAddr, req.Account, numTickets, poolAddr, req.PoolFees,
+		expiry, txFee, ticketFee)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"Unable to purchase tickets: %v", err)
+	}
+
+	hashes := marshalHashes(resp)
+
+	return &pb.PurchaseTicketsResponse{TicketHashes: hashes}, nil
 }
 
 func (s *walletServer) RevokeTickets(ctx context.Context, req *pb.RevokeTicketsRequest) (*pb.RevokeTicketsResponse, error) {
@@ -1095,6 +2293,168 @@ func (s *walletServer) LoadActiveDataFilters(ctx context.Context, req *pb.LoadAc
 	return &pb.LoadActiveDataFiltersResponse{}, nil
 }
 
+// decodeOutPoint creates an outpoint from the transaction hash, output index,
+// and tree fields of a request.
+func decodeOutPoint(txHash []byte, index uint32, tree int32) (*wire.OutPoint, error) {
+	hash, err := chainhash.NewHash(txHash)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "transaction hash: %v", err)
+	}
+	if tree != int32(wire.TxTreeRegular) && tree != int32(wire.TxTreeStake) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tree %v", tree)
+	}
+	return wire.NewOutPoint(hash, index, int8(tree)), nil
+}
+
+func (s *walletServer) LockOutpoint(ctx context.Context, req *pb.LockOutpointRequest) (
+	*pb.LockOutpointResponse, error) {
+
+	op, err := decodeOutPoint(req.TransactionHash, req.OutputIndex, req.Tree)
+	if err != nil {
+		return nil, err
+	}
+	if req.ExpiryHeight < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "negative expiry height")
+	}
+
+	err = s.wallet.LockOutpoint(*op, req.Reason, req.ExpiryHeight)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return &pb.LockOutpointResponse{}, nil
+}
+
+func (s *walletServer) UnlockOutpoint(ctx context.Context, req *pb.UnlockOutpointRequest) (
+	*pb.UnlockOutpointResponse, error) {
+
+	op, err := decodeOutPoint(req.TransactionHash, req.OutputIndex, req.Tree)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.wallet.UnlockOutpoint(*op)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return &pb.UnlockOutpointResponse{}, nil
+}
+
+func (s *walletServer) SetTransactionLabel(ctx context.Context, req *pb.SetTransactionLabelRequest) (
+	*pb.SetTransactionLabelResponse, error) {
+
+	txHash, err := chainhash.NewHash(req.TransactionHash)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "transaction hash: %v", err)
+	}
+
+	err = s.wallet.SetTransactionLabel(txHash, req.Label)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return &pb.SetTransactionLabelResponse{}, nil
+}
+
+func (s *walletServer) SetAddressLabel(ctx context.Context, req *pb.SetAddressLabelRequest) (
+	*pb.SetAddressLabelResponse, error) {
+
+	addr, err := decodeAddress(req.Address, s.wallet.ChainParams())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.wallet.SetAddressLabel(addr, req.Label)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return &pb.SetAddressLabelResponse{}, nil
+}
+
+func (s *walletServer) SetPayee(ctx context.Context, req *pb.SetPayeeRequest) (
+	*pb.SetPayeeResponse, error) {
+
+	addr, err := decodeAddress(req.Address, s.wallet.ChainParams())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.wallet.SetPayee(req.Name, addr, req.Note)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return &pb.SetPayeeResponse{}, nil
+}
+
+func (s *walletServer) RemovePayee(ctx context.Context, req *pb.RemovePayeeRequest) (
+	*pb.RemovePayeeResponse, error) {
+
+	err := s.wallet.RemovePayee(req.Name)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return &pb.RemovePayeeResponse{}, nil
+}
+
+func (s *walletServer) BackupWallet(ctx context.Context, req *pb.BackupWalletRequest) (
+	*pb.BackupWalletResponse, error) {
+
+	if req.Path == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "backup path may not be empty")
+	}
+
+	err := s.wallet.BackupWallet(req.Path)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return &pb.BackupWalletResponse{}, nil
+}
+
+func (s *walletServer) CheckConsistency(ctx context.Context, req *pb.CheckConsistencyRequest) (
+	*pb.CheckConsistencyResponse, error) {
+
+	// Repairs are not reflected by the state of the running wallet, so they
+	// may only be performed when the wallet is opened or offline.
+	if req.Repair {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"repairs can not be performed by a running wallet; "+
+				"use --automaticrepair or checkwalletdb instead")
+	}
+
+	report, err := s.wallet.CheckConsistency(false)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	problems := make([]*pb.CheckConsistencyResponse_Problem, 0, len(report.Problems))
+	for _, p := range report.Problems {
+		problems = append(problems, &pb.CheckConsistencyResponse_Problem{
+			Check:       p.Check,
+			Description: p.Description,
+			Fixable:     p.Fixable,
+			Fixed:       p.Fixed,
+		})
+	}
+	return &pb.CheckConsistencyResponse{
+		Consistent: report.Consistent(),
+		Problems:   problems,
+		Blocks:     uint32(report.Blocks),
+		TxRecords:  uint32(report.TxRecords),
+		Credits:    uint32(report.Credits),
+		Debits:     uint32(report.Debits),
+		Unspent:    uint32(report.Unspent),
+		Unmined:    uint32(report.Unmined),
+		Tickets:    uint32(report.Tickets),
+		Accounts:   uint32(report.Accounts),
+		Addresses:  uint32(report.Addresses),
+	}, nil
+}
+
 func marshalTransactionInputs(v []wallet.TransactionSummaryInput) []*pb.TransactionDetails_Input {
 	inputs := make([]*pb.TransactionDetails_Input, len(v))
 	for i := range v {
@@ -1123,6 +2483,21 @@ func marshalTransactionOutputs(v []wallet.TransactionSummaryOutput) []*pb.Transa
 			Amount:       int64(output.Amount),
 			Address:      address,
 			OutputScript: output.OutputScript,
+			AddressLabel: output.Label,
+		}
+	}
+	return outputs
+}
+
+func marshalTransactionDataOutputs(v []wallet.TransactionSummaryDataOutput) []*pb.TransactionDetails_DataOutput {
+	if len(v) == 0 {
+		return nil
+	}
+	outputs := make([]*pb.TransactionDetails_DataOutput, len(v))
+	for i := range v {
+		outputs[i] = &pb.TransactionDetails_DataOutput{
+			Index: v[i].Index,
+			Data:  v[i].Data,
 		}
 	}
 	return outputs
@@ -1148,6 +2523,8 @@ func marshalTransactionDetails(tx *wallet.TransactionSummary) *pb.TransactionDet
 		Fee:             int64(tx.Fee),
 		Timestamp:       tx.Timestamp,
 		TransactionType: txType,
+		Label:           tx.Label,
+		DataOutputs:     marshalTransactionDataOutputs(tx.DataOutputs),
 	}
 }
 
@@ -1199,6 +2576,7 @@ func (s *walletServer) TransactionNotifications(req *pb.TransactionNotifications
 				DetachedBlocks:           marshalHashes(v.DetachedBlocks),
 				UnminedTransactions:      marshalTransactionDetailsSlice(v.UnminedTransactions),
 				UnminedTransactionHashes: marshalHashes(v.UnminedTransactionHashes),
+				ExpiredTransactionHashes: marshalHashes(v.ExpiredTransactionHashes),
 			}
 			err := svr.Send(&resp)
 			if err != nil {
@@ -0,0 +1,10 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+/*
+Package walletjson provides wallet-specific JSON-RPC commands and result types
+that are not defined by the abcjson package.  Commands defined by this package
+are registered with abcjson when the package is initialized.
+*/
+package walletjson
@@ -0,0 +1,601 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package walletjson
+
+import "github.com/abcsuite/abcd/abcjson"
+
+// AbandonTransactionCmd defines the abandontransaction JSON-RPC command.
+type AbandonTransactionCmd struct {
+	Txid string
+}
+
+// NewAbandonTransactionCmd returns a new instance which can be used to issue
+// an abandontransaction JSON-RPC command.
+func NewAbandonTransactionCmd(txid string) *AbandonTransactionCmd {
+	return &AbandonTransactionCmd{
+		Txid: txid,
+	}
+}
+
+// BumpFeeCmd defines the bumpfee JSON-RPC command.
+type BumpFeeCmd struct {
+	Txid    string
+	FeeRate float64
+}
+
+// NewBumpFeeCmd returns a new instance which can be used to issue a bumpfee
+// JSON-RPC command.
+func NewBumpFeeCmd(txid string, feeRate float64) *BumpFeeCmd {
+	return &BumpFeeCmd{
+		Txid:    txid,
+		FeeRate: feeRate,
+	}
+}
+
+// CheckConsistencyCmd defines the checkconsistency JSON-RPC command.
+type CheckConsistencyCmd struct {
+	Repair *bool `jsonrpcdefault:"false"`
+}
+
+// NewCheckConsistencyCmd returns a new instance which can be used to issue a
+// checkconsistency JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewCheckConsistencyCmd(repair *bool) *CheckConsistencyCmd {
+	return &CheckConsistencyCmd{
+		Repair: repair,
+	}
+}
+
+// CreateMultisigAccountCmd defines the createmultisigaccount JSON-RPC command.
+type CreateMultisigAccountCmd struct {
+	Account   string
+	NRequired int
+	Keys      []string
+}
+
+// NewCreateMultisigAccountCmd returns a new instance which can be used to issue
+// a createmultisigaccount JSON-RPC command.
+func NewCreateMultisigAccountCmd(account string, nRequired int, keys []string) *CreateMultisigAccountCmd {
+	return &CreateMultisigAccountCmd{
+		Account:   account,
+		NRequired: nRequired,
+		Keys:      keys,
+	}
+}
+
+// CreatePartialTransactionCmd defines the createpartialtransaction JSON-RPC
+// command.
+type CreatePartialTransactionCmd struct {
+	FromAccount string
+	Amounts     map[string]float64 `jsonrpcusage:"{\"address\":amount,...}"`
+	MinConf     *int               `jsonrpcdefault:"1"`
+}
+
+// NewCreatePartialTransactionCmd returns a new instance which can be used to
+// issue a createpartialtransaction JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewCreatePartialTransactionCmd(fromAccount string, amounts map[string]float64,
+	minConf *int) *CreatePartialTransactionCmd {
+
+	return &CreatePartialTransactionCmd{
+		FromAccount: fromAccount,
+		Amounts:     amounts,
+		MinConf:     minConf,
+	}
+}
+
+// CreateVaultCmd defines the createvault JSON-RPC command.
+type CreateVaultCmd struct {
+	Account   string
+	Amount    float64
+	LockType  string
+	LockValue int64
+}
+
+// NewCreateVaultCmd returns a new instance which can be used to issue a
+// createvault JSON-RPC command.
+func NewCreateVaultCmd(account string, amount float64, lockType string,
+	lockValue int64) *CreateVaultCmd {
+
+	return &CreateVaultCmd{
+		Account:   account,
+		Amount:    amount,
+		LockType:  lockType,
+		LockValue: lockValue,
+	}
+}
+
+// DescribePartialTransactionCmd defines the describepartialtransaction
+// JSON-RPC command.
+type DescribePartialTransactionCmd struct {
+	Hex string
+}
+
+// NewDescribePartialTransactionCmd returns a new instance which can be used to
+// issue a describepartialtransaction JSON-RPC command.
+func NewDescribePartialTransactionCmd(hexStr string) *DescribePartialTransactionCmd {
+	return &DescribePartialTransactionCmd{
+		Hex: hexStr,
+	}
+}
+
+// GetAddressLabelCmd defines the getaddresslabel JSON-RPC command.
+type GetAddressLabelCmd struct {
+	Address string
+}
+
+// NewGetAddressLabelCmd returns a new instance which can be used to issue a
+// getaddresslabel JSON-RPC command.
+func NewGetAddressLabelCmd(address string) *GetAddressLabelCmd {
+	return &GetAddressLabelCmd{
+		Address: address,
+	}
+}
+
+// GetTxLabelCmd defines the gettxlabel JSON-RPC command.
+type GetTxLabelCmd struct {
+	Txid string
+}
+
+// NewGetTxLabelCmd returns a new instance which can be used to issue a
+// gettxlabel JSON-RPC command.
+func NewGetTxLabelCmd(txid string) *GetTxLabelCmd {
+	return &GetTxLabelCmd{
+		Txid: txid,
+	}
+}
+
+// ListAddressLabelsCmd defines the listaddresslabels JSON-RPC command.
+type ListAddressLabelsCmd struct{}
+
+// NewListAddressLabelsCmd returns a new instance which can be used to issue a
+// listaddresslabels JSON-RPC command.
+func NewListAddressLabelsCmd() *ListAddressLabelsCmd {
+	return &ListAddressLabelsCmd{}
+}
+
+// ListPayeesCmd defines the listpayees JSON-RPC command.
+type ListPayeesCmd struct{}
+
+// NewListPayeesCmd returns a new instance which can be used to issue a
+// listpayees JSON-RPC command.
+func NewListPayeesCmd() *ListPayeesCmd {
+	return &ListPayeesCmd{}
+}
+
+// ListUnminedTransactionsCmd defines the listunminedtransactions JSON-RPC
+// command.
+type ListUnminedTransactionsCmd struct{}
+
+// NewListUnminedTransactionsCmd returns a new instance which can be used to
+// issue a listunminedtransactions JSON-RPC command.
+func NewListUnminedTransactionsCmd() *ListUnminedTransactionsCmd {
+	return &ListUnminedTransactionsCmd{}
+}
+
+// ListVaultsCmd defines the listvaults JSON-RPC command.
+type ListVaultsCmd struct{}
+
+// NewListVaultsCmd returns a new instance which can be used to issue a
+// listvaults JSON-RPC command.
+func NewListVaultsCmd() *ListVaultsCmd {
+	return &ListVaultsCmd{}
+}
+
+// PreviewBumpFeeCmd defines the previewbumpfee JSON-RPC command.
+type PreviewBumpFeeCmd struct {
+	Txid    string
+	FeeRate float64
+}
+
+// NewPreviewBumpFeeCmd returns a new instance which can be used to issue a
+// previewbumpfee JSON-RPC command.
+func NewPreviewBumpFeeCmd(txid string, feeRate float64) *PreviewBumpFeeCmd {
+	return &PreviewBumpFeeCmd{
+		Txid:    txid,
+		FeeRate: feeRate,
+	}
+}
+
+// PreviewConsolidateCmd defines the previewconsolidate JSON-RPC command.
+type PreviewConsolidateCmd struct {
+	Inputs  int
+	Account *string
+	Address *string
+}
+
+// NewPreviewConsolidateCmd returns a new instance which can be used to issue a
+// previewconsolidate JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewPreviewConsolidateCmd(inputs int, account, address *string) *PreviewConsolidateCmd {
+	return &PreviewConsolidateCmd{
+		Inputs:  inputs,
+		Account: account,
+		Address: address,
+	}
+}
+
+// PreviewPurchaseTicketCmd defines the previewpurchaseticket JSON-RPC command.
+type PreviewPurchaseTicketCmd struct {
+	FromAccount   string
+	SpendLimit    float64
+	MinConf       *int `jsonrpcdefault:"1"`
+	TicketAddress *string
+	NumTickets    *int
+	PoolAddress   *string
+	PoolFees      *float64
+	Expiry        *int
+}
+
+// NewPreviewPurchaseTicketCmd returns a new instance which can be used to
+// issue a previewpurchaseticket JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewPreviewPurchaseTicketCmd(fromAccount string, spendLimit float64,
+	minConf *int, ticketAddress *string, numTickets *int,
+	poolAddress *string, poolFees *float64, expiry *int) *PreviewPurchaseTicketCmd {
+
+	return &PreviewPurchaseTicketCmd{
+		FromAccount:   fromAccount,
+		SpendLimit:    spendLimit,
+		MinConf:       minConf,
+		TicketAddress: ticketAddress,
+		NumTickets:    numTickets,
+		PoolAddress:   poolAddress,
+		PoolFees:      poolFees,
+		Expiry:        expiry,
+	}
+}
+
+// PreviewSendManyCmd defines the previewsendmany JSON-RPC command.
+type PreviewSendManyCmd struct {
+	FromAccount     string
+	Amounts         map[string]float64 `jsonrpcusage:"{\"address\":amount,...}"`
+	MinConf         *int               `jsonrpcdefault:"1"`
+	SubtractFeeFrom *[]string
+	CoinSelection   *string
+	Expiry          *int
+	RelativeExpiry  *bool `jsonrpcdefault:"true"`
+}
+
+// NewPreviewSendManyCmd returns a new instance which can be used to issue a
+// previewsendmany JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewPreviewSendManyCmd(fromAccount string, amounts map[string]float64,
+	minConf *int, subtractFeeFrom *[]string, coinSelection *string,
+	expiry *int, relativeExpiry *bool) *PreviewSendManyCmd {
+
+	return &PreviewSendManyCmd{
+		FromAccount:     fromAccount,
+		Amounts:         amounts,
+		MinConf:         minConf,
+		SubtractFeeFrom: subtractFeeFrom,
+		CoinSelection:   coinSelection,
+		Expiry:          expiry,
+		RelativeExpiry:  relativeExpiry,
+	}
+}
+
+// PreviewSendToMultiSigCmd defines the previewsendtomultisig JSON-RPC command.
+type PreviewSendToMultiSigCmd struct {
+	Amount    float64
+	Pubkeys   []string
+	NRequired *int `jsonrpcdefault:"1"`
+	MinConf   *int `jsonrpcdefault:"1"`
+}
+
+// NewPreviewSendToMultiSigCmd returns a new instance which can be used to
+// issue a previewsendtomultisig JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewPreviewSendToMultiSigCmd(amount float64, pubkeys []string,
+	nRequired, minConf *int) *PreviewSendToMultiSigCmd {
+
+	return &PreviewSendToMultiSigCmd{
+		Amount:    amount,
+		Pubkeys:   pubkeys,
+		NRequired: nRequired,
+		MinConf:   minConf,
+	}
+}
+
+// PreviewSendToSStxCmd defines the previewsendtosstx JSON-RPC command.
+type PreviewSendToSStxCmd struct {
+	Amounts map[string]int64
+	Inputs  []abcjson.SStxInput
+	COuts   []abcjson.SStxCommitOut
+}
+
+// NewPreviewSendToSStxCmd returns a new instance which can be used to issue a
+// previewsendtosstx JSON-RPC command.
+func NewPreviewSendToSStxCmd(amounts map[string]int64, inputs []abcjson.SStxInput,
+	couts []abcjson.SStxCommitOut) *PreviewSendToSStxCmd {
+
+	return &PreviewSendToSStxCmd{
+		Amounts: amounts,
+		Inputs:  inputs,
+		COuts:   couts,
+	}
+}
+
+// RemovePayeeCmd defines the removepayee JSON-RPC command.
+type RemovePayeeCmd struct {
+	Name string
+}
+
+// NewRemovePayeeCmd returns a new instance which can be used to issue a
+// removepayee JSON-RPC command.
+func NewRemovePayeeCmd(name string) *RemovePayeeCmd {
+	return &RemovePayeeCmd{
+		Name: name,
+	}
+}
+
+// SearchTxLabelsCmd defines the searchtxlabels JSON-RPC command.
+type SearchTxLabelsCmd struct {
+	Query *string
+}
+
+// NewSearchTxLabelsCmd returns a new instance which can be used to issue a
+// searchtxlabels JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewSearchTxLabelsCmd(query *string) *SearchTxLabelsCmd {
+	return &SearchTxLabelsCmd{
+		Query: query,
+	}
+}
+
+// SendManySubtractFeeCmd defines the sendmanysubtractfee JSON-RPC command.
+type SendManySubtractFeeCmd struct {
+	FromAccount     string
+	Amounts         map[string]float64 `jsonrpcusage:"{\"address\":amount,...}"`
+	SubtractFeeFrom []string
+	MinConf         *int `jsonrpcdefault:"1"`
+	Expiry          *int
+	RelativeExpiry  *bool `jsonrpcdefault:"true"`
+}
+
+// NewSendManySubtractFeeCmd returns a new instance which can be used to issue a
+// sendmanysubtractfee JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewSendManySubtractFeeCmd(fromAccount string, amounts map[string]float64,
+	subtractFeeFrom []string, minConf *int, expiry *int,
+	relativeExpiry *bool) *SendManySubtractFeeCmd {
+
+	return &SendManySubtractFeeCmd{
+		FromAccount:     fromAccount,
+		Amounts:         amounts,
+		SubtractFeeFrom: subtractFeeFrom,
+		MinConf:         minConf,
+		Expiry:          expiry,
+		RelativeExpiry:  relativeExpiry,
+	}
+}
+
+// SendToAddressSubtractFeeCmd defines the sendtoaddresssubtractfee JSON-RPC
+// command.
+type SendToAddressSubtractFeeCmd struct {
+	Address        string
+	Amount         float64
+	Expiry         *int
+	RelativeExpiry *bool `jsonrpcdefault:"true"`
+}
+
+// NewSendToAddressSubtractFeeCmd returns a new instance which can be used to
+// issue a sendtoaddresssubtractfee JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewSendToAddressSubtractFeeCmd(address string, amount float64,
+	expiry *int, relativeExpiry *bool) *SendToAddressSubtractFeeCmd {
+
+	return &SendToAddressSubtractFeeCmd{
+		Address:        address,
+		Amount:         amount,
+		Expiry:         expiry,
+		RelativeExpiry: relativeExpiry,
+	}
+}
+
+// SendWithCoinControlCmd defines the sendwithcoincontrol JSON-RPC command.
+type SendWithCoinControlCmd struct {
+	FromAccount    string
+	Amounts        map[string]float64 `jsonrpcusage:"{\"address\":amount,...}"`
+	MinConf        *int               `jsonrpcdefault:"1"`
+	Inputs         *[]abcjson.TransactionInput
+	Include        *[]abcjson.TransactionInput
+	Exclude        *[]abcjson.TransactionInput
+	CoinSelection  *string
+	Expiry         *int
+	RelativeExpiry *bool `jsonrpcdefault:"true"`
+}
+
+// NewSendWithCoinControlCmd returns a new instance which can be used to issue a
+// sendwithcoincontrol JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewSendWithCoinControlCmd(fromAccount string, amounts map[string]float64,
+	minConf *int, inputs, include, exclude *[]abcjson.TransactionInput,
+	coinSelection *string, expiry *int,
+	relativeExpiry *bool) *SendWithCoinControlCmd {
+
+	return &SendWithCoinControlCmd{
+		FromAccount:    fromAccount,
+		Amounts:        amounts,
+		MinConf:        minConf,
+		Inputs:         inputs,
+		Include:        include,
+		Exclude:        exclude,
+		CoinSelection:  coinSelection,
+		Expiry:         expiry,
+		RelativeExpiry: relativeExpiry,
+	}
+}
+
+// SetAddressLabelCmd defines the setaddresslabel JSON-RPC command.
+type SetAddressLabelCmd struct {
+	Address string
+	Label   string
+}
+
+// NewSetAddressLabelCmd returns a new instance which can be used to issue a
+// setaddresslabel JSON-RPC command.
+func NewSetAddressLabelCmd(address, label string) *SetAddressLabelCmd {
+	return &SetAddressLabelCmd{
+		Address: address,
+		Label:   label,
+	}
+}
+
+// SetCoinSelectionCmd defines the setcoinselection JSON-RPC command.
+type SetCoinSelectionCmd struct {
+	Strategy string
+}
+
+// NewSetCoinSelectionCmd returns a new instance which can be used to issue a
+// setcoinselection JSON-RPC command.
+func NewSetCoinSelectionCmd(strategy string) *SetCoinSelectionCmd {
+	return &SetCoinSelectionCmd{
+		Strategy: strategy,
+	}
+}
+
+// SetPayeeCmd defines the setpayee JSON-RPC command.
+type SetPayeeCmd struct {
+	Name    string
+	Address string
+	Note    *string
+}
+
+// NewSetPayeeCmd returns a new instance which can be used to issue a setpayee
+// JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewSetPayeeCmd(name, address string, note *string) *SetPayeeCmd {
+	return &SetPayeeCmd{
+		Name:    name,
+		Address: address,
+		Note:    note,
+	}
+}
+
+// SetTxExpiryCmd defines the settxexpiry JSON-RPC command.
+type SetTxExpiryCmd struct {
+	Blocks int64
+}
+
+// NewSetTxExpiryCmd returns a new instance which can be used to issue a
+// settxexpiry JSON-RPC command.
+func NewSetTxExpiryCmd(blocks int64) *SetTxExpiryCmd {
+	return &SetTxExpiryCmd{
+		Blocks: blocks,
+	}
+}
+
+// SetTxLabelCmd defines the settxlabel JSON-RPC command.
+type SetTxLabelCmd struct {
+	Txid  string
+	Label string
+}
+
+// NewSetTxLabelCmd returns a new instance which can be used to issue a
+// settxlabel JSON-RPC command.
+func NewSetTxLabelCmd(txid, label string) *SetTxLabelCmd {
+	return &SetTxLabelCmd{
+		Txid:  txid,
+		Label: label,
+	}
+}
+
+// SignPartialTransactionCmd defines the signpartialtransaction JSON-RPC
+// command.
+type SignPartialTransactionCmd struct {
+	Hex string
+}
+
+// NewSignPartialTransactionCmd returns a new instance which can be used to
+// issue a signpartialtransaction JSON-RPC command.
+func NewSignPartialTransactionCmd(hexStr string) *SignPartialTransactionCmd {
+	return &SignPartialTransactionCmd{
+		Hex: hexStr,
+	}
+}
+
+// SweepAccountCmd defines the sweepaccount JSON-RPC command.
+type SweepAccountCmd struct {
+	SourceAccount string
+	Destinations  map[string]float64 `jsonrpcusage:"{\"address\":ratio,...}"`
+	MinConf       *int               `jsonrpcdefault:"1"`
+	MinValue      *float64           `jsonrpcdefault:"0"`
+}
+
+// NewSweepAccountCmd returns a new instance which can be used to issue a
+// sweepaccount JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewSweepAccountCmd(sourceAccount string, destinations map[string]float64,
+	minConf *int, minValue *float64) *SweepAccountCmd {
+
+	return &SweepAccountCmd{
+		SourceAccount: sourceAccount,
+		Destinations:  destinations,
+		MinConf:       minConf,
+		MinValue:      minValue,
+	}
+}
+
+func init() {
+	// The commands in this file are only usable with a wallet server.
+	flags := abcjson.UFWalletOnly
+
+	abcjson.MustRegisterCmd("abandontransaction", (*AbandonTransactionCmd)(nil), flags)
+	abcjson.MustRegisterCmd("bumpfee", (*BumpFeeCmd)(nil), flags)
+	abcjson.MustRegisterCmd("checkconsistency", (*CheckConsistencyCmd)(nil), flags)
+	abcjson.MustRegisterCmd("createmultisigaccount", (*CreateMultisigAccountCmd)(nil), flags)
+	abcjson.MustRegisterCmd("createpartialtransaction", (*CreatePartialTransactionCmd)(nil), flags)
+	abcjson.MustRegisterCmd("createvault", (*CreateVaultCmd)(nil), flags)
+	abcjson.MustRegisterCmd("describepartialtransaction", (*DescribePartialTransactionCmd)(nil), flags)
+	abcjson.MustRegisterCmd("getaddresslabel", (*GetAddressLabelCmd)(nil), flags)
+	abcjson.MustRegisterCmd("gettxlabel", (*GetTxLabelCmd)(nil), flags)
+	abcjson.MustRegisterCmd("listaddresslabels", (*ListAddressLabelsCmd)(nil), flags)
+	abcjson.MustRegisterCmd("listpayees", (*ListPayeesCmd)(nil), flags)
+	abcjson.MustRegisterCmd("listunminedtransactions", (*ListUnminedTransactionsCmd)(nil), flags)
+	abcjson.MustRegisterCmd("listvaults", (*ListVaultsCmd)(nil), flags)
+	abcjson.MustRegisterCmd("previewbumpfee", (*PreviewBumpFeeCmd)(nil), flags)
+	abcjson.MustRegisterCmd("previewconsolidate", (*PreviewConsolidateCmd)(nil), flags)
+	abcjson.MustRegisterCmd("previewpurchaseticket", (*PreviewPurchaseTicketCmd)(nil), flags)
+	abcjson.MustRegisterCmd("previewsendmany", (*PreviewSendManyCmd)(nil), flags)
+	abcjson.MustRegisterCmd("previewsendtomultisig", (*PreviewSendToMultiSigCmd)(nil), flags)
+	abcjson.MustRegisterCmd("previewsendtosstx", (*PreviewSendToSStxCmd)(nil), flags)
+	abcjson.MustRegisterCmd("removepayee", (*RemovePayeeCmd)(nil), flags)
+	abcjson.MustRegisterCmd("searchtxlabels", (*SearchTxLabelsCmd)(nil), flags)
+	abcjson.MustRegisterCmd("sendmanysubtractfee", (*SendManySubtractFeeCmd)(nil), flags)
+	abcjson.MustRegisterCmd("sendtoaddresssubtractfee", (*SendToAddressSubtractFeeCmd)(nil), flags)
+	abcjson.MustRegisterCmd("sendwithcoincontrol", (*SendWithCoinControlCmd)(nil), flags)
+	abcjson.MustRegisterCmd("setaddresslabel", (*SetAddressLabelCmd)(nil), flags)
+	abcjson.MustRegisterCmd("setcoinselection", (*SetCoinSelectionCmd)(nil), flags)
+	abcjson.MustRegisterCmd("setpayee", (*SetPayeeCmd)(nil), flags)
+	abcjson.MustRegisterCmd("settxexpiry", (*SetTxExpiryCmd)(nil), flags)
+	abcjson.MustRegisterCmd("settxlabel", (*SetTxLabelCmd)(nil), flags)
+	abcjson.MustRegisterCmd("signpartialtransaction", (*SignPartialTransactionCmd)(nil), flags)
+	abcjson.MustRegisterCmd("sweepaccount", (*SweepAccountCmd)(nil), flags)
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package walletjson
+
+import "github.com/abcsuite/abcd/abcjson"
+
+// BumpFeeResult models the data returned by the bumpfee and previewbumpfee
+// commands.
+type BumpFeeResult struct {
+	Txid           string  `json:"txid"`
+	Hex            string  `json:"hex"`
+	Fee            float64 `json:"fee"`
+	EstimatedSize  int     `json:"estimatedsize"`
+	ParentFee      float64 `json:"parentfee"`
+	ParentSize     int     `json:"parentsize"`
+	PackageFeeRate float64 `json:"packagefeerate"`
+}
+
+// CheckConsistencyResult models the data returned by the checkconsistency
+// command.
+type CheckConsistencyResult struct {
+	Consistent bool                       `json:"consistent"`
+	Blocks     int                        `json:"blocks"`
+	TxRecords  int                        `json:"txrecords"`
+	Credits    int                        `json:"credits"`
+	Debits     int                        `json:"debits"`
+	Unspent    int                        `json:"unspent"`
+	Unmined    int                        `json:"unmined"`
+	Tickets    int                        `json:"tickets"`
+	Accounts   int                        `json:"accounts"`
+	Addresses  int                        `json:"addresses"`
+	Problems   []ConsistencyProblemResult `json:"problems"`
+}
+
+// ConsistencyProblemResult models the problems returned by the
+// checkconsistency command.
+type ConsistencyProblemResult struct {
+	Check       string `json:"check"`
+	Description string `json:"description"`
+	Fixable     bool   `json:"fixable"`
+	Fixed       bool   `json:"fixed"`
+}
+
+// DescribePartialTransactionResult models the data returned by the
+// describepartialtransaction command.
+type DescribePartialTransactionResult struct {
+	Txid          string                           `json:"txid"`
+	Inputs        []PartialTransactionInputResult  `json:"inputs"`
+	Outputs       []PartialTransactionOutputResult `json:"outputs"`
+	TotalInput    float64                          `json:"totalinput"`
+	TotalOutput   float64                          `json:"totaloutput"`
+	Fee           float64                          `json:"fee"`
+	EstimatedSize int                              `json:"estimatedsize"`
+	FeeRate       float64                          `json:"feerate"`
+	Complete      bool                             `json:"complete"`
+}
+
+// LockedOutpointResult models the objects returned by the listlockunspent
+// command.
+type LockedOutpointResult struct {
+	Txid         string `json:"txid"`
+	Vout         uint32 `json:"vout"`
+	Tree         int8   `json:"tree"`
+	Reason       string `json:"reason,omitempty"`
+	ExpiryHeight int32  `json:"expiryheight,omitempty"`
+}
+
+// PartialTransactionInputResult models the inputs returned by the
+// describepartialtransaction command.
+type PartialTransactionInputResult struct {
+	Txid     string  `json:"txid"`
+	Vout     uint32  `json:"vout"`
+	Tree     int8    `json:"tree"`
+	Amount   float64 `json:"amount"`
+	Final    bool    `json:"final"`
+	Signable bool    `json:"signable"`
+}
+
+// PartialTransactionOutputResult models the outputs returned by the
+// describepartialtransaction command.
+type PartialTransactionOutputResult struct {
+	Amount       float64  `json:"amount"`
+	ScriptPubKey string   `json:"scriptpubkey"`
+	Addresses    []string `json:"addresses,omitempty"`
+	Owned        bool     `json:"owned"`
+}
+
+// SignPartialTransactionResult models the data returned by the
+// signpartialtransaction command.
+type SignPartialTransactionResult struct {
+	Hex          string `json:"hex"`
+	SignedInputs []int  `json:"signedinputs"`
+	Complete     bool   `json:"complete"`
+	SignedTx     string `json:"signedtx,omitempty"`
+}
+
+// TicketPurchasePreviewResult models the data returned by the
+// previewpurchaseticket command.
+type TicketPurchasePreviewResult struct {
+	SplitTx             TxPreviewResult `json:"splittx"`
+	NumTickets          int             `json:"numtickets"`
+	TicketPrice         float64         `json:"ticketprice"`
+	TicketFee           float64         `json:"ticketfee"`
+	TicketFeeRate       float64         `json:"ticketfeerate"`
+	EstimatedTicketSize int             `json:"estimatedticketsize"`
+	PoolFee             float64         `json:"poolfee"`
+}
+
+// TxLabelResult models the objects returned by the searchtxlabels command.
+type TxLabelResult struct {
+	Txid  string `json:"txid"`
+	Label string `json:"label"`
+}
+
+// AddressLabelResult models the objects returned by the listaddresslabels
+// command.
+type AddressLabelResult struct {
+	Address string `json:"address"`
+	Label   string `json:"label"`
+}
+
+// PayeeResult models the objects returned by the listpayees command.
+type PayeeResult struct {
+	Name    string `json:"name"`
+	Address string `json:"address"`
+	Note    string `json:"note,omitempty"`
+}
+
+// TxPreviewResult models the data returned by the commands previewing
+// transactions without creating them.
+type TxPreviewResult struct {
+	Hex           string                     `json:"hex"`
+	Inputs        []abcjson.TransactionInput `json:"inputs"`
+	TotalInput    float64                    `json:"totalinput"`
+	TotalOutput   float64                    `json:"totaloutput"`
+	ChangeIndex   int                        `json:"changeindex"`
+	EstimatedSize int                        `json:"estimatedsize"`
+	Fee           float64                    `json:"fee"`
+	FeeRate       float64                    `json:"feerate"`
+}
+
+// UnminedTransactionResult models the objects returned by the
+// listunminedtransactions command.
+type UnminedTransactionResult struct {
+	Txid        string `json:"txid"`
+	FirstSeen   int64  `json:"firstseen"`
+	LastSeen    int64  `json:"lastseen"`
+	Attempts    uint32 `json:"attempts"`
+	LastAttempt int64  `json:"lastattempt,omitempty"`
+	LastError   string `json:"lasterror,omitempty"`
+	NextAttempt int64  `json:"nextattempt,omitempty"`
+}
+
+// VaultResult models the objects returned by the createvault and listvaults
+// commands.
+type VaultResult struct {
+	Txid         string  `json:"txid"`
+	Vout         uint32  `json:"vout"`
+	Account      string  `json:"account"`
+	Amount       float64 `json:"amount"`
+	Address      string  `json:"address"`
+	KeyAddress   string  `json:"keyaddress"`
+	RedeemScript string  `json:"redeemscript"`
+	LockType     string  `json:"locktype"`
+	LockValue    int64   `json:"lockvalue"`
+	Unlocked     bool    `json:"unlocked"`
+	Created      int64   `json:"created"`
+	SpendTxid    string  `json:"spendtxid,omitempty"`
+}
+
+// ValidateAddressResult models the data returned by the validateaddress
+// command.  It extends the abcjson result with address book details.
+type ValidateAddressResult struct {
+	IsValid      bool     `json:"isvalid"`
+	Address      string   `json:"address,omitempty"`
+	IsMine       bool     `json:"ismine,omitempty"`
+	IsWatchOnly  bool     `json:"iswatchonly,omitempty"`
+	IsScript     bool     `json:"isscript,omitempty"`
+	PubKeyAddr   string   `json:"pubkeyaddr,omitempty"`
+	PubKey       string   `json:"pubkey,omitempty"`
+	IsCompressed bool     `json:"iscompressed,omitempty"`
+	Account      string   `json:"account,omitempty"`
+	Addresses    []string `json:"addresses,omitempty"`
+	Hex          string   `json:"hex,omitempty"`
+	Script       string   `json:"script,omitempty"`
+	SigsRequired int32    `json:"sigsrequired,omitempty"`
+	Label        string   `json:"label,omitempty"`
+	Payees       []string `json:"payees,omitempty"`
+}
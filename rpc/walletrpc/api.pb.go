@@ -5,9 +5,11 @@
 Package walletrpc is a generated protocol buffer package.
 
 It is generated from these files:
+
 	api.proto
 
 It has these top-level messages:
+
 	VersionRequest
 	VersionResponse
 	TransactionDetails
@@ -27,6 +29,8 @@ It has these top-level messages:
 	RescanResponse
 	NextAccountRequest
 	NextAccountResponse
+	NextMultisigAccountRequest
+	NextMultisigAccountResponse
 	NextAddressRequest
 	NextAddressResponse
 	ImportPrivateKeyRequest
@@ -55,12 +59,89 @@ It has these top-level messages:
 	SignTransactionResponse
 	PublishTransactionRequest
 	PublishTransactionResponse
+	SweepAccountRequest
+	SweepAccountResponse
+	AbandonTransactionRequest
+	AbandonTransactionResponse
+	BumpFeeRequest
+	BumpFeeResponse
+	CreatePartialTransactionRequest
+	CreatePartialTransactionResponse
+	UpdatePartialTransactionRequest
+	UpdatePartialTransactionResponse
+	SignPartialTransactionRequest
+	SignPartialTransactionResponse
+	CombinePartialTransactionsRequest
+	CombinePartialTransactionsResponse
+	FinalizePartialTransactionRequest
+	FinalizePartialTransactionResponse
+	SigningSession
+	CreateSigningSessionRequest
+	CreateSigningSessionResponse
+	SigningSessionsRequest
+	SigningSessionsResponse
+	ImportSigningSessionSignaturesRequest
+	ImportSigningSessionSignaturesResponse
+	SignSigningSessionRequest
+	SignSigningSessionResponse
+	AbandonSigningSessionRequest
+	AbandonSigningSessionResponse
+	SwapContract
+	InitiateSwapRequest
+	InitiateSwapResponse
+	ParticipateSwapRequest
+	ParticipateSwapResponse
+	RedeemSwapRequest
+	RedeemSwapResponse
+	RefundSwapRequest
+	RefundSwapResponse
+	ExtractSwapSecretRequest
+	ExtractSwapSecretResponse
+	AuditSwapContractRequest
+	AuditSwapContractResponse
+	SwapContractsRequest
+	SwapContractsResponse
+	Vault
+	CreateVaultRequest
+	CreateVaultResponse
+	VaultsRequest
+	VaultsResponse
 	PurchaseTicketsRequest
 	PurchaseTicketsResponse
 	RevokeTicketsRequest
 	RevokeTicketsResponse
 	LoadActiveDataFiltersRequest
 	LoadActiveDataFiltersResponse
+	LockedOutpointsRequest
+	LockedOutpointsResponse
+	LockOutpointRequest
+	LockOutpointResponse
+	UnlockOutpointRequest
+	UnlockOutpointResponse
+	TransactionLabelRequest
+	TransactionLabelResponse
+	SearchTransactionLabelsRequest
+	SearchTransactionLabelsResponse
+	SetTransactionLabelRequest
+	SetTransactionLabelResponse
+	AddressLabelsRequest
+	AddressLabelsResponse
+	SetAddressLabelRequest
+	SetAddressLabelResponse
+	PayeesRequest
+	PayeesResponse
+	SetPayeeRequest
+	SetPayeeResponse
+	RemovePayeeRequest
+	RemovePayeeResponse
+	UnminedTransactionsRequest
+	UnminedTransactionsResponse
+	AddressTransactionsRequest
+	AddressTransactionsResponse
+	BackupWalletRequest
+	BackupWalletResponse
+	CheckConsistencyRequest
+	CheckConsistencyResponse
 	TransactionNotificationsRequest
 	TransactionNotificationsResponse
 	AccountNotificationsRequest
@@ -191,7 +272,7 @@ var NextAddressRequest_Kind_value = map[string]int32{
 func (x NextAddressRequest_Kind) String() string {
 	return proto.EnumName(NextAddressRequest_Kind_name, int32(x))
 }
-func (NextAddressRequest_Kind) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{19, 0} }
+func (NextAddressRequest_Kind) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{21, 0} }
 
 type NextAddressRequest_GapPolicy int32
 
@@ -219,7 +300,7 @@ func (x NextAddressRequest_GapPolicy) String() string {
 	return proto.EnumName(NextAddressRequest_GapPolicy_name, int32(x))
 }
 func (NextAddressRequest_GapPolicy) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{19, 1}
+	return fileDescriptor0, []int{21, 1}
 }
 
 type ChangePassphraseRequest_Key int32
@@ -242,7 +323,7 @@ func (x ChangePassphraseRequest_Key) String() string {
 	return proto.EnumName(ChangePassphraseRequest_Key_name, int32(x))
 }
 func (ChangePassphraseRequest_Key) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{37, 0}
+	return fileDescriptor0, []int{39, 0}
 }
 
 type ConstructTransactionRequest_OutputSelectionAlgorithm int32
@@ -265,7 +346,97 @@ func (x ConstructTransactionRequest_OutputSelectionAlgorithm) String() string {
 	return proto.EnumName(ConstructTransactionRequest_OutputSelectionAlgorithm_name, int32(x))
 }
 func (ConstructTransactionRequest_OutputSelectionAlgorithm) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{41, 0}
+	return fileDescriptor0, []int{43, 0}
+}
+
+type ConstructTransactionRequest_CoinSelectionStrategy int32
+
+const (
+	ConstructTransactionRequest_WALLET_DEFAULT   ConstructTransactionRequest_CoinSelectionStrategy = 0
+	ConstructTransactionRequest_BRANCH_AND_BOUND ConstructTransactionRequest_CoinSelectionStrategy = 1
+	ConstructTransactionRequest_LARGEST_FIRST    ConstructTransactionRequest_CoinSelectionStrategy = 2
+	ConstructTransactionRequest_SMALLEST_FIRST   ConstructTransactionRequest_CoinSelectionStrategy = 3
+	ConstructTransactionRequest_OLDEST_FIRST     ConstructTransactionRequest_CoinSelectionStrategy = 4
+	ConstructTransactionRequest_PRIVACY          ConstructTransactionRequest_CoinSelectionStrategy = 5
+)
+
+var ConstructTransactionRequest_CoinSelectionStrategy_name = map[int32]string{
+	0: "WALLET_DEFAULT",
+	1: "BRANCH_AND_BOUND",
+	2: "LARGEST_FIRST",
+	3: "SMALLEST_FIRST",
+	4: "OLDEST_FIRST",
+	5: "PRIVACY",
+}
+var ConstructTransactionRequest_CoinSelectionStrategy_value = map[string]int32{
+	"WALLET_DEFAULT":   0,
+	"BRANCH_AND_BOUND": 1,
+	"LARGEST_FIRST":    2,
+	"SMALLEST_FIRST":   3,
+	"OLDEST_FIRST":     4,
+	"PRIVACY":          5,
+}
+
+func (x ConstructTransactionRequest_CoinSelectionStrategy) String() string {
+	return proto.EnumName(ConstructTransactionRequest_CoinSelectionStrategy_name, int32(x))
+}
+func (ConstructTransactionRequest_CoinSelectionStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{43, 1}
+}
+
+type SwapContract_Role int32
+
+const (
+	SwapContract_INITIATOR    SwapContract_Role = 0
+	SwapContract_PARTICIPANT  SwapContract_Role = 1
+	SwapContract_COUNTERPARTY SwapContract_Role = 2
+)
+
+var SwapContract_Role_name = map[int32]string{
+	0: "INITIATOR",
+	1: "PARTICIPANT",
+	2: "COUNTERPARTY",
+}
+var SwapContract_Role_value = map[string]int32{
+	"INITIATOR":    0,
+	"PARTICIPANT":  1,
+	"COUNTERPARTY": 2,
+}
+
+func (x SwapContract_Role) String() string {
+	return proto.EnumName(SwapContract_Role_name, int32(x))
+}
+func (SwapContract_Role) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{76, 0}
+}
+
+type Vault_LockType int32
+
+const (
+	Vault_HEIGHT  Vault_LockType = 0
+	Vault_TIME    Vault_LockType = 1
+	Vault_BLOCKS  Vault_LockType = 2
+	Vault_SECONDS Vault_LockType = 3
+)
+
+var Vault_LockType_name = map[int32]string{
+	0: "HEIGHT",
+	1: "TIME",
+	2: "BLOCKS",
+	3: "SECONDS",
+}
+var Vault_LockType_value = map[string]int32{
+	"HEIGHT":  0,
+	"TIME":    1,
+	"BLOCKS":  2,
+	"SECONDS": 3,
+}
+
+func (x Vault_LockType) String() string {
+	return proto.EnumName(Vault_LockType_name, int32(x))
+}
+func (Vault_LockType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{91, 0}
 }
 
 type VersionRequest struct {
@@ -340,6 +511,8 @@ type TransactionDetails struct {
 	Fee             int64                              `protobuf:"varint,5,opt,name=fee" json:"fee,omitempty"`
 	Timestamp       int64                              `protobuf:"varint,6,opt,name=timestamp" json:"timestamp,omitempty"`
 	TransactionType TransactionDetails_TransactionType `protobuf:"varint,7,opt,name=transaction_type,json=transactionType,enum=walletrpc.TransactionDetails_TransactionType" json:"transaction_type,omitempty"`
+	Label           string                             `protobuf:"bytes,8,opt,name=label" json:"label,omitempty"`
+	DataOutputs     []*TransactionDetails_DataOutput   `protobuf:"bytes,9,rep,name=data_outputs,json=dataOutputs" json:"data_outputs,omitempty"`
 }
 
 func (m *TransactionDetails) Reset()                    { *m = TransactionDetails{} }
@@ -396,6 +569,20 @@ func (m *TransactionDetails) GetTransactionType() TransactionDetails_Transaction
 	return TransactionDetails_REGULAR
 }
 
+func (m *TransactionDetails) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *TransactionDetails) GetDataOutputs() []*TransactionDetails_DataOutput {
+	if m != nil {
+		return m.DataOutputs
+	}
+	return nil
+}
+
 type TransactionDetails_Input struct {
 	Index           uint32 `protobuf:"varint,1,opt,name=index" json:"index,omitempty"`
 	PreviousAccount uint32 `protobuf:"varint,2,opt,name=previous_account,json=previousAccount" json:"previous_account,omitempty"`
@@ -435,6 +622,7 @@ type TransactionDetails_Output struct {
 	Amount       int64  `protobuf:"varint,4,opt,name=amount" json:"amount,omitempty"`
 	Address      string `protobuf:"bytes,5,opt,name=address" json:"address,omitempty"`
 	OutputScript []byte `protobuf:"bytes,6,opt,name=output_script,json=outputScript,proto3" json:"output_script,omitempty"`
+	AddressLabel string `protobuf:"bytes,7,opt,name=address_label,json=addressLabel" json:"address_label,omitempty"`
 }
 
 func (m *TransactionDetails_Output) Reset()                    { *m = TransactionDetails_Output{} }
@@ -484,6 +672,39 @@ func (m *TransactionDetails_Output) GetOutputScript() []byte {
 	return nil
 }
 
+func (m *TransactionDetails_Output) GetAddressLabel() string {
+	if m != nil {
+		return m.AddressLabel
+	}
+	return ""
+}
+
+type TransactionDetails_DataOutput struct {
+	Index uint32 `protobuf:"varint,1,opt,name=index" json:"index,omitempty"`
+	Data  []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *TransactionDetails_DataOutput) Reset()         { *m = TransactionDetails_DataOutput{} }
+func (m *TransactionDetails_DataOutput) String() string { return proto.CompactTextString(m) }
+func (*TransactionDetails_DataOutput) ProtoMessage()    {}
+func (*TransactionDetails_DataOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{2, 2}
+}
+
+func (m *TransactionDetails_DataOutput) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *TransactionDetails_DataOutput) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 type BlockDetails struct {
 	Hash         []byte                `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 	Height       int32                 `protobuf:"varint,2,opt,name=height" json:"height,omitempty"`
@@ -820,6 +1041,62 @@ func (m *NextAccountResponse) GetAccountNumber() uint32 {
 	return 0
 }
 
+type NextMultisigAccountRequest struct {
+	Passphrase              []byte   `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	AccountName             string   `protobuf:"bytes,2,opt,name=account_name,json=accountName" json:"account_name,omitempty"`
+	RequiredSignatures      uint32   `protobuf:"varint,3,opt,name=required_signatures,json=requiredSignatures" json:"required_signatures,omitempty"`
+	CosignerExtendedPubKeys []string `protobuf:"bytes,4,rep,name=cosigner_extended_pub_keys,json=cosignerExtendedPubKeys" json:"cosigner_extended_pub_keys,omitempty"`
+}
+
+func (m *NextMultisigAccountRequest) Reset()                    { *m = NextMultisigAccountRequest{} }
+func (m *NextMultisigAccountRequest) String() string            { return proto.CompactTextString(m) }
+func (*NextMultisigAccountRequest) ProtoMessage()               {}
+func (*NextMultisigAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+
+func (m *NextMultisigAccountRequest) GetPassphrase() []byte {
+	if m != nil {
+		return m.Passphrase
+	}
+	return nil
+}
+
+func (m *NextMultisigAccountRequest) GetAccountName() string {
+	if m != nil {
+		return m.AccountName
+	}
+	return ""
+}
+
+func (m *NextMultisigAccountRequest) GetRequiredSignatures() uint32 {
+	if m != nil {
+		return m.RequiredSignatures
+	}
+	return 0
+}
+
+func (m *NextMultisigAccountRequest) GetCosignerExtendedPubKeys() []string {
+	if m != nil {
+		return m.CosignerExtendedPubKeys
+	}
+	return nil
+}
+
+type NextMultisigAccountResponse struct {
+	AccountNumber uint32 `protobuf:"varint,1,opt,name=account_number,json=accountNumber" json:"account_number,omitempty"`
+}
+
+func (m *NextMultisigAccountResponse) Reset()                    { *m = NextMultisigAccountResponse{} }
+func (m *NextMultisigAccountResponse) String() string            { return proto.CompactTextString(m) }
+func (*NextMultisigAccountResponse) ProtoMessage()               {}
+func (*NextMultisigAccountResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+
+func (m *NextMultisigAccountResponse) GetAccountNumber() uint32 {
+	if m != nil {
+		return m.AccountNumber
+	}
+	return 0
+}
+
 type NextAddressRequest struct {
 	Account   uint32                       `protobuf:"varint,1,opt,name=account" json:"account,omitempty"`
 	Kind      NextAddressRequest_Kind      `protobuf:"varint,2,opt,name=kind,enum=walletrpc.NextAddressRequest_Kind" json:"kind,omitempty"`
@@ -829,7 +1106,7 @@ type NextAddressRequest struct {
 func (m *NextAddressRequest) Reset()                    { *m = NextAddressRequest{} }
 func (m *NextAddressRequest) String() string            { return proto.CompactTextString(m) }
 func (*NextAddressRequest) ProtoMessage()               {}
-func (*NextAddressRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+func (*NextAddressRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
 
 func (m *NextAddressRequest) GetAccount() uint32 {
 	if m != nil {
@@ -860,7 +1137,7 @@ type NextAddressResponse struct {
 func (m *NextAddressResponse) Reset()                    { *m = NextAddressResponse{} }
 func (m *NextAddressResponse) String() string            { return proto.CompactTextString(m) }
 func (*NextAddressResponse) ProtoMessage()               {}
-func (*NextAddressResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+func (*NextAddressResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
 
 func (m *NextAddressResponse) GetAddress() string {
 	if m != nil {
@@ -887,7 +1164,7 @@ type ImportPrivateKeyRequest struct {
 func (m *ImportPrivateKeyRequest) Reset()                    { *m = ImportPrivateKeyRequest{} }
 func (m *ImportPrivateKeyRequest) String() string            { return proto.CompactTextString(m) }
 func (*ImportPrivateKeyRequest) ProtoMessage()               {}
-func (*ImportPrivateKeyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+func (*ImportPrivateKeyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
 
 func (m *ImportPrivateKeyRequest) GetPassphrase() []byte {
 	if m != nil {
@@ -930,7 +1207,7 @@ type ImportPrivateKeyResponse struct {
 func (m *ImportPrivateKeyResponse) Reset()                    { *m = ImportPrivateKeyResponse{} }
 func (m *ImportPrivateKeyResponse) String() string            { return proto.CompactTextString(m) }
 func (*ImportPrivateKeyResponse) ProtoMessage()               {}
-func (*ImportPrivateKeyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+func (*ImportPrivateKeyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
 
 type ImportScriptRequest struct {
 	Passphrase        []byte `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
@@ -943,7 +1220,7 @@ type ImportScriptRequest struct {
 func (m *ImportScriptRequest) Reset()                    { *m = ImportScriptRequest{} }
 func (m *ImportScriptRequest) String() string            { return proto.CompactTextString(m) }
 func (*ImportScriptRequest) ProtoMessage()               {}
-func (*ImportScriptRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+func (*ImportScriptRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
 
 func (m *ImportScriptRequest) GetPassphrase() []byte {
 	if m != nil {
@@ -988,7 +1265,7 @@ type ImportScriptResponse struct {
 func (m *ImportScriptResponse) Reset()                    { *m = ImportScriptResponse{} }
 func (m *ImportScriptResponse) String() string            { return proto.CompactTextString(m) }
 func (*ImportScriptResponse) ProtoMessage()               {}
-func (*ImportScriptResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+func (*ImportScriptResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
 
 func (m *ImportScriptResponse) GetP2ShAddress() string {
 	if m != nil {
@@ -1012,7 +1289,7 @@ type BalanceRequest struct {
 func (m *BalanceRequest) Reset()                    { *m = BalanceRequest{} }
 func (m *BalanceRequest) String() string            { return proto.CompactTextString(m) }
 func (*BalanceRequest) ProtoMessage()               {}
-func (*BalanceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+func (*BalanceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
 
 func (m *BalanceRequest) GetAccountNumber() uint32 {
 	if m != nil {
@@ -1035,12 +1312,13 @@ type BalanceResponse struct {
 	ImmatureStakeGeneration int64 `protobuf:"varint,4,opt,name=immature_stake_generation,json=immatureStakeGeneration" json:"immature_stake_generation,omitempty"`
 	LockedByTickets         int64 `protobuf:"varint,5,opt,name=locked_by_tickets,json=lockedByTickets" json:"locked_by_tickets,omitempty"`
 	VotingAuthority         int64 `protobuf:"varint,6,opt,name=voting_authority,json=votingAuthority" json:"voting_authority,omitempty"`
+	LockedByVaults          int64 `protobuf:"varint,7,opt,name=locked_by_vaults,json=lockedByVaults" json:"locked_by_vaults,omitempty"`
 }
 
 func (m *BalanceResponse) Reset()                    { *m = BalanceResponse{} }
 func (m *BalanceResponse) String() string            { return proto.CompactTextString(m) }
 func (*BalanceResponse) ProtoMessage()               {}
-func (*BalanceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+func (*BalanceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
 
 func (m *BalanceResponse) GetTotal() int64 {
 	if m != nil {
@@ -1084,6 +1362,13 @@ func (m *BalanceResponse) GetVotingAuthority() int64 {
 	return 0
 }
 
+func (m *BalanceResponse) GetLockedByVaults() int64 {
+	if m != nil {
+		return m.LockedByVaults
+	}
+	return 0
+}
+
 type GetTransactionRequest struct {
 	TransactionHash []byte `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
 }
@@ -1091,7 +1376,7 @@ type GetTransactionRequest struct {
 func (m *GetTransactionRequest) Reset()                    { *m = GetTransactionRequest{} }
 func (m *GetTransactionRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetTransactionRequest) ProtoMessage()               {}
-func (*GetTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+func (*GetTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
 
 func (m *GetTransactionRequest) GetTransactionHash() []byte {
 	if m != nil {
@@ -1107,7 +1392,7 @@ type GetTransactionResponse struct {
 func (m *GetTransactionResponse) Reset()                    { *m = GetTransactionResponse{} }
 func (m *GetTransactionResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetTransactionResponse) ProtoMessage()               {}
-func (*GetTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+func (*GetTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
 
 func (m *GetTransactionResponse) GetTransaction() *TransactionDetails {
 	if m != nil {
@@ -1142,7 +1427,7 @@ type GetTransactionsRequest struct {
 func (m *GetTransactionsRequest) Reset()                    { *m = GetTransactionsRequest{} }
 func (m *GetTransactionsRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetTransactionsRequest) ProtoMessage()               {}
-func (*GetTransactionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+func (*GetTransactionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
 
 func (m *GetTransactionsRequest) GetStartingBlockHash() []byte {
 	if m != nil {
@@ -1187,7 +1472,7 @@ type GetTransactionsResponse struct {
 func (m *GetTransactionsResponse) Reset()                    { *m = GetTransactionsResponse{} }
 func (m *GetTransactionsResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetTransactionsResponse) ProtoMessage()               {}
-func (*GetTransactionsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+func (*GetTransactionsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
 
 func (m *GetTransactionsResponse) GetMinedTransactions() *BlockDetails {
 	if m != nil {
@@ -1209,7 +1494,7 @@ type TicketPriceRequest struct {
 func (m *TicketPriceRequest) Reset()                    { *m = TicketPriceRequest{} }
 func (m *TicketPriceRequest) String() string            { return proto.CompactTextString(m) }
 func (*TicketPriceRequest) ProtoMessage()               {}
-func (*TicketPriceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+func (*TicketPriceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
 
 type TicketPriceResponse struct {
 	TicketPrice int64 `protobuf:"varint,1,opt,name=ticket_price,json=ticketPrice" json:"ticket_price,omitempty"`
@@ -1219,7 +1504,7 @@ type TicketPriceResponse struct {
 func (m *TicketPriceResponse) Reset()                    { *m = TicketPriceResponse{} }
 func (m *TicketPriceResponse) String() string            { return proto.CompactTextString(m) }
 func (*TicketPriceResponse) ProtoMessage()               {}
-func (*TicketPriceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+func (*TicketPriceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
 
 func (m *TicketPriceResponse) GetTicketPrice() int64 {
 	if m != nil {
@@ -1241,7 +1526,7 @@ type StakeInfoRequest struct {
 func (m *StakeInfoRequest) Reset()                    { *m = StakeInfoRequest{} }
 func (m *StakeInfoRequest) String() string            { return proto.CompactTextString(m) }
 func (*StakeInfoRequest) ProtoMessage()               {}
-func (*StakeInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+func (*StakeInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
 
 type StakeInfoResponse struct {
 	PoolSize      uint32 `protobuf:"varint,1,opt,name=pool_size,json=poolSize" json:"pool_size,omitempty"`
@@ -1259,7 +1544,7 @@ type StakeInfoResponse struct {
 func (m *StakeInfoResponse) Reset()                    { *m = StakeInfoResponse{} }
 func (m *StakeInfoResponse) String() string            { return proto.CompactTextString(m) }
 func (*StakeInfoResponse) ProtoMessage()               {}
-func (*StakeInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+func (*StakeInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
 
 func (m *StakeInfoResponse) GetPoolSize() uint32 {
 	if m != nil {
@@ -1339,7 +1624,7 @@ type BlockInfoRequest struct {
 func (m *BlockInfoRequest) Reset()                    { *m = BlockInfoRequest{} }
 func (m *BlockInfoRequest) String() string            { return proto.CompactTextString(m) }
 func (*BlockInfoRequest) ProtoMessage()               {}
-func (*BlockInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+func (*BlockInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
 
 func (m *BlockInfoRequest) GetBlockHash() []byte {
 	if m != nil {
@@ -1367,7 +1652,7 @@ type BlockInfoResponse struct {
 func (m *BlockInfoResponse) Reset()                    { *m = BlockInfoResponse{} }
 func (m *BlockInfoResponse) String() string            { return proto.CompactTextString(m) }
 func (*BlockInfoResponse) ProtoMessage()               {}
-func (*BlockInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+func (*BlockInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
 
 func (m *BlockInfoResponse) GetBlockHash() []byte {
 	if m != nil {
@@ -1420,7 +1705,7 @@ type ChangePassphraseRequest struct {
 func (m *ChangePassphraseRequest) Reset()                    { *m = ChangePassphraseRequest{} }
 func (m *ChangePassphraseRequest) String() string            { return proto.CompactTextString(m) }
 func (*ChangePassphraseRequest) ProtoMessage()               {}
-func (*ChangePassphraseRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+func (*ChangePassphraseRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
 
 func (m *ChangePassphraseRequest) GetKey() ChangePassphraseRequest_Key {
 	if m != nil {
@@ -1449,7 +1734,7 @@ type ChangePassphraseResponse struct {
 func (m *ChangePassphraseResponse) Reset()                    { *m = ChangePassphraseResponse{} }
 func (m *ChangePassphraseResponse) String() string            { return proto.CompactTextString(m) }
 func (*ChangePassphraseResponse) ProtoMessage()               {}
-func (*ChangePassphraseResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+func (*ChangePassphraseResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
 
 type FundTransactionRequest struct {
 	Account                  uint32 `protobuf:"varint,1,opt,name=account" json:"account,omitempty"`
@@ -1462,7 +1747,7 @@ type FundTransactionRequest struct {
 func (m *FundTransactionRequest) Reset()                    { *m = FundTransactionRequest{} }
 func (m *FundTransactionRequest) String() string            { return proto.CompactTextString(m) }
 func (*FundTransactionRequest) ProtoMessage()               {}
-func (*FundTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+func (*FundTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
 
 func (m *FundTransactionRequest) GetAccount() uint32 {
 	if m != nil {
@@ -1508,7 +1793,7 @@ type FundTransactionResponse struct {
 func (m *FundTransactionResponse) Reset()                    { *m = FundTransactionResponse{} }
 func (m *FundTransactionResponse) String() string            { return proto.CompactTextString(m) }
 func (*FundTransactionResponse) ProtoMessage()               {}
-func (*FundTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+func (*FundTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
 
 func (m *FundTransactionResponse) GetSelectedOutputs() []*FundTransactionResponse_PreviousOutput {
 	if m != nil {
@@ -1547,7 +1832,7 @@ func (m *FundTransactionResponse_PreviousOutput) Reset() {
 func (m *FundTransactionResponse_PreviousOutput) String() string { return proto.CompactTextString(m) }
 func (*FundTransactionResponse_PreviousOutput) ProtoMessage()    {}
 func (*FundTransactionResponse_PreviousOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{40, 0}
+	return fileDescriptor0, []int{42, 0}
 }
 
 func (m *FundTransactionResponse_PreviousOutput) GetTransactionHash() []byte {
@@ -1606,12 +1891,20 @@ type ConstructTransactionRequest struct {
 	OutputSelectionAlgorithm ConstructTransactionRequest_OutputSelectionAlgorithm `protobuf:"varint,4,opt,name=output_selection_algorithm,json=outputSelectionAlgorithm,enum=walletrpc.ConstructTransactionRequest_OutputSelectionAlgorithm" json:"output_selection_algorithm,omitempty"`
 	NonChangeOutputs         []*ConstructTransactionRequest_Output                `protobuf:"bytes,5,rep,name=non_change_outputs,json=nonChangeOutputs" json:"non_change_outputs,omitempty"`
 	ChangeDestination        *ConstructTransactionRequest_OutputDestination       `protobuf:"bytes,6,opt,name=change_destination,json=changeDestination" json:"change_destination,omitempty"`
+	CoinSelectionStrategy    ConstructTransactionRequest_CoinSelectionStrategy    `protobuf:"varint,7,opt,name=coin_selection_strategy,json=coinSelectionStrategy,enum=walletrpc.ConstructTransactionRequest_CoinSelectionStrategy" json:"coin_selection_strategy,omitempty"`
+	ExplicitInputs           []*ConstructTransactionRequest_OutPoint              `protobuf:"bytes,8,rep,name=explicit_inputs,json=explicitInputs" json:"explicit_inputs,omitempty"`
+	IncludeOutpoints         []*ConstructTransactionRequest_OutPoint              `protobuf:"bytes,9,rep,name=include_outpoints,json=includeOutpoints" json:"include_outpoints,omitempty"`
+	ExcludeOutpoints         []*ConstructTransactionRequest_OutPoint              `protobuf:"bytes,10,rep,name=exclude_outpoints,json=excludeOutpoints" json:"exclude_outpoints,omitempty"`
+	SubtractFeeFrom          []uint32                                             `protobuf:"varint,11,rep,packed,name=subtract_fee_from,json=subtractFeeFrom" json:"subtract_fee_from,omitempty"`
+	DryRun                   bool                                                 `protobuf:"varint,12,opt,name=dry_run,json=dryRun" json:"dry_run,omitempty"`
+	Expiry                   uint32                                               `protobuf:"varint,13,opt,name=expiry" json:"expiry,omitempty"`
+	RelativeExpiry           bool                                                 `protobuf:"varint,14,opt,name=relative_expiry,json=relativeExpiry" json:"relative_expiry,omitempty"`
 }
 
 func (m *ConstructTransactionRequest) Reset()                    { *m = ConstructTransactionRequest{} }
 func (m *ConstructTransactionRequest) String() string            { return proto.CompactTextString(m) }
 func (*ConstructTransactionRequest) ProtoMessage()               {}
-func (*ConstructTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+func (*ConstructTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
 
 func (m *ConstructTransactionRequest) GetSourceAccount() uint32 {
 	if m != nil {
@@ -1655,10 +1948,68 @@ func (m *ConstructTransactionRequest) GetChangeDestination() *ConstructTransacti
 	return nil
 }
 
+func (m *ConstructTransactionRequest) GetCoinSelectionStrategy() ConstructTransactionRequest_CoinSelectionStrategy {
+	if m != nil {
+		return m.CoinSelectionStrategy
+	}
+	return ConstructTransactionRequest_WALLET_DEFAULT
+}
+
+func (m *ConstructTransactionRequest) GetExplicitInputs() []*ConstructTransactionRequest_OutPoint {
+	if m != nil {
+		return m.ExplicitInputs
+	}
+	return nil
+}
+
+func (m *ConstructTransactionRequest) GetIncludeOutpoints() []*ConstructTransactionRequest_OutPoint {
+	if m != nil {
+		return m.IncludeOutpoints
+	}
+	return nil
+}
+
+func (m *ConstructTransactionRequest) GetExcludeOutpoints() []*ConstructTransactionRequest_OutPoint {
+	if m != nil {
+		return m.ExcludeOutpoints
+	}
+	return nil
+}
+
+func (m *ConstructTransactionRequest) GetSubtractFeeFrom() []uint32 {
+	if m != nil {
+		return m.SubtractFeeFrom
+	}
+	return nil
+}
+
+func (m *ConstructTransactionRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *ConstructTransactionRequest) GetExpiry() uint32 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+func (m *ConstructTransactionRequest) GetRelativeExpiry() bool {
+	if m != nil {
+		return m.RelativeExpiry
+	}
+	return false
+}
+
 type ConstructTransactionRequest_OutputDestination struct {
 	Address       string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
 	Script        []byte `protobuf:"bytes,2,opt,name=script,proto3" json:"script,omitempty"`
 	ScriptVersion uint32 `protobuf:"varint,3,opt,name=script_version,json=scriptVersion" json:"script_version,omitempty"`
+	Payee         string `protobuf:"bytes,4,opt,name=payee" json:"payee,omitempty"`
+	Data          []byte `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
 }
 
 func (m *ConstructTransactionRequest_OutputDestination) Reset() {
@@ -1669,7 +2020,7 @@ func (m *ConstructTransactionRequest_OutputDestination) String() string {
 }
 func (*ConstructTransactionRequest_OutputDestination) ProtoMessage() {}
 func (*ConstructTransactionRequest_OutputDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{41, 0}
+	return fileDescriptor0, []int{43, 0}
 }
 
 func (m *ConstructTransactionRequest_OutputDestination) GetAddress() string {
@@ -1693,6 +2044,20 @@ func (m *ConstructTransactionRequest_OutputDestination) GetScriptVersion() uint3
 	return 0
 }
 
+func (m *ConstructTransactionRequest_OutputDestination) GetPayee() string {
+	if m != nil {
+		return m.Payee
+	}
+	return ""
+}
+
+func (m *ConstructTransactionRequest_OutputDestination) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 type ConstructTransactionRequest_Output struct {
 	Destination *ConstructTransactionRequest_OutputDestination `protobuf:"bytes,1,opt,name=destination" json:"destination,omitempty"`
 	Amount      int64                                          `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
@@ -1702,7 +2067,7 @@ func (m *ConstructTransactionRequest_Output) Reset()         { *m = ConstructTra
 func (m *ConstructTransactionRequest_Output) String() string { return proto.CompactTextString(m) }
 func (*ConstructTransactionRequest_Output) ProtoMessage()    {}
 func (*ConstructTransactionRequest_Output) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{41, 1}
+	return fileDescriptor0, []int{43, 1}
 }
 
 func (m *ConstructTransactionRequest_Output) GetDestination() *ConstructTransactionRequest_OutputDestination {
@@ -1719,17 +2084,54 @@ func (m *ConstructTransactionRequest_Output) GetAmount() int64 {
 	return 0
 }
 
+type ConstructTransactionRequest_OutPoint struct {
+	TransactionHash []byte `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	OutputIndex     uint32 `protobuf:"varint,2,opt,name=output_index,json=outputIndex" json:"output_index,omitempty"`
+	Tree            int32  `protobuf:"varint,3,opt,name=tree" json:"tree,omitempty"`
+}
+
+func (m *ConstructTransactionRequest_OutPoint) Reset()         { *m = ConstructTransactionRequest_OutPoint{} }
+func (m *ConstructTransactionRequest_OutPoint) String() string { return proto.CompactTextString(m) }
+func (*ConstructTransactionRequest_OutPoint) ProtoMessage()    {}
+func (*ConstructTransactionRequest_OutPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{43, 2}
+}
+
+func (m *ConstructTransactionRequest_OutPoint) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return nil
+}
+
+func (m *ConstructTransactionRequest_OutPoint) GetOutputIndex() uint32 {
+	if m != nil {
+		return m.OutputIndex
+	}
+	return 0
+}
+
+func (m *ConstructTransactionRequest_OutPoint) GetTree() int32 {
+	if m != nil {
+		return m.Tree
+	}
+	return 0
+}
+
 type ConstructTransactionResponse struct {
 	UnsignedTransaction       []byte `protobuf:"bytes,1,opt,name=unsigned_transaction,json=unsignedTransaction,proto3" json:"unsigned_transaction,omitempty"`
 	TotalPreviousOutputAmount int64  `protobuf:"varint,2,opt,name=total_previous_output_amount,json=totalPreviousOutputAmount" json:"total_previous_output_amount,omitempty"`
 	TotalOutputAmount         int64  `protobuf:"varint,3,opt,name=total_output_amount,json=totalOutputAmount" json:"total_output_amount,omitempty"`
 	EstimatedSignedSize       uint32 `protobuf:"varint,4,opt,name=estimated_signed_size,json=estimatedSignedSize" json:"estimated_signed_size,omitempty"`
+	Fee                       int64  `protobuf:"varint,5,opt,name=fee" json:"fee,omitempty"`
+	FeeRate                   int64  `protobuf:"varint,6,opt,name=fee_rate,json=feeRate" json:"fee_rate,omitempty"`
+	ChangeIndex               int32  `protobuf:"varint,7,opt,name=change_index,json=changeIndex" json:"change_index,omitempty"`
 }
 
 func (m *ConstructTransactionResponse) Reset()                    { *m = ConstructTransactionResponse{} }
 func (m *ConstructTransactionResponse) String() string            { return proto.CompactTextString(m) }
 func (*ConstructTransactionResponse) ProtoMessage()               {}
-func (*ConstructTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+func (*ConstructTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
 
 func (m *ConstructTransactionResponse) GetUnsignedTransaction() []byte {
 	if m != nil {
@@ -1759,6 +2161,27 @@ func (m *ConstructTransactionResponse) GetEstimatedSignedSize() uint32 {
 	return 0
 }
 
+func (m *ConstructTransactionResponse) GetFee() int64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *ConstructTransactionResponse) GetFeeRate() int64 {
+	if m != nil {
+		return m.FeeRate
+	}
+	return 0
+}
+
+func (m *ConstructTransactionResponse) GetChangeIndex() int32 {
+	if m != nil {
+		return m.ChangeIndex
+	}
+	return 0
+}
+
 type SignTransactionRequest struct {
 	Passphrase            []byte `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
 	SerializedTransaction []byte `protobuf:"bytes,2,opt,name=serialized_transaction,json=serializedTransaction,proto3" json:"serialized_transaction,omitempty"`
@@ -1773,7 +2196,7 @@ type SignTransactionRequest struct {
 func (m *SignTransactionRequest) Reset()                    { *m = SignTransactionRequest{} }
 func (m *SignTransactionRequest) String() string            { return proto.CompactTextString(m) }
 func (*SignTransactionRequest) ProtoMessage()               {}
-func (*SignTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
+func (*SignTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
 
 func (m *SignTransactionRequest) GetPassphrase() []byte {
 	if m != nil {
@@ -1804,7 +2227,7 @@ type SignTransactionResponse struct {
 func (m *SignTransactionResponse) Reset()                    { *m = SignTransactionResponse{} }
 func (m *SignTransactionResponse) String() string            { return proto.CompactTextString(m) }
 func (*SignTransactionResponse) ProtoMessage()               {}
-func (*SignTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+func (*SignTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
 
 func (m *SignTransactionResponse) GetTransaction() []byte {
 	if m != nil {
@@ -1827,7 +2250,7 @@ type PublishTransactionRequest struct {
 func (m *PublishTransactionRequest) Reset()                    { *m = PublishTransactionRequest{} }
 func (m *PublishTransactionRequest) String() string            { return proto.CompactTextString(m) }
 func (*PublishTransactionRequest) ProtoMessage()               {}
-func (*PublishTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
+func (*PublishTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
 
 func (m *PublishTransactionRequest) GetSignedTransaction() []byte {
 	if m != nil {
@@ -1843,7 +2266,7 @@ type PublishTransactionResponse struct {
 func (m *PublishTransactionResponse) Reset()                    { *m = PublishTransactionResponse{} }
 func (m *PublishTransactionResponse) String() string            { return proto.CompactTextString(m) }
 func (*PublishTransactionResponse) ProtoMessage()               {}
-func (*PublishTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
+func (*PublishTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
 
 func (m *PublishTransactionResponse) GetTransactionHash() []byte {
 	if m != nil {
@@ -1852,1492 +2275,3965 @@ func (m *PublishTransactionResponse) GetTransactionHash() []byte {
 	return nil
 }
 
-type PurchaseTicketsRequest struct {
-	Passphrase            []byte  `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
-	Account               uint32  `protobuf:"varint,2,opt,name=account" json:"account,omitempty"`
-	SpendLimit            int64   `protobuf:"varint,3,opt,name=spend_limit,json=spendLimit" json:"spend_limit,omitempty"`
-	RequiredConfirmations uint32  `protobuf:"varint,4,opt,name=required_confirmations,json=requiredConfirmations" json:"required_confirmations,omitempty"`
-	TicketAddress         string  `protobuf:"bytes,5,opt,name=ticket_address,json=ticketAddress" json:"ticket_address,omitempty"`
-	NumTickets            uint32  `protobuf:"varint,6,opt,name=num_tickets,json=numTickets" json:"num_tickets,omitempty"`
-	PoolAddress           string  `protobuf:"bytes,7,opt,name=pool_address,json=poolAddress" json:"pool_address,omitempty"`
-	PoolFees              float64 `protobuf:"fixed64,8,opt,name=pool_fees,json=poolFees" json:"pool_fees,omitempty"`
-	Expiry                uint32  `protobuf:"varint,9,opt,name=expiry" json:"expiry,omitempty"`
-	TxFee                 int64   `protobuf:"varint,10,opt,name=tx_fee,json=txFee" json:"tx_fee,omitempty"`
-	TicketFee             int64   `protobuf:"varint,11,opt,name=ticket_fee,json=ticketFee" json:"ticket_fee,omitempty"`
+type SweepAccountRequest struct {
+	Passphrase            []byte                             `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	SourceAccount         uint32                             `protobuf:"varint,2,opt,name=source_account,json=sourceAccount" json:"source_account,omitempty"`
+	RequiredConfirmations int32                              `protobuf:"varint,3,opt,name=required_confirmations,json=requiredConfirmations" json:"required_confirmations,omitempty"`
+	MinValue              int64                              `protobuf:"varint,4,opt,name=min_value,json=minValue" json:"min_value,omitempty"`
+	Destinations          []*SweepAccountRequest_Destination `protobuf:"bytes,5,rep,name=destinations" json:"destinations,omitempty"`
 }
 
-func (m *PurchaseTicketsRequest) Reset()                    { *m = PurchaseTicketsRequest{} }
-func (m *PurchaseTicketsRequest) String() string            { return proto.CompactTextString(m) }
-func (*PurchaseTicketsRequest) ProtoMessage()               {}
-func (*PurchaseTicketsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
+func (m *SweepAccountRequest) Reset()                    { *m = SweepAccountRequest{} }
+func (m *SweepAccountRequest) String() string            { return proto.CompactTextString(m) }
+func (*SweepAccountRequest) ProtoMessage()               {}
+func (*SweepAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
 
-func (m *PurchaseTicketsRequest) GetPassphrase() []byte {
+func (m *SweepAccountRequest) GetPassphrase() []byte {
 	if m != nil {
 		return m.Passphrase
 	}
 	return nil
 }
 
-func (m *PurchaseTicketsRequest) GetAccount() uint32 {
+func (m *SweepAccountRequest) GetSourceAccount() uint32 {
 	if m != nil {
-		return m.Account
+		return m.SourceAccount
 	}
 	return 0
 }
 
-func (m *PurchaseTicketsRequest) GetSpendLimit() int64 {
+func (m *SweepAccountRequest) GetRequiredConfirmations() int32 {
 	if m != nil {
-		return m.SpendLimit
+		return m.RequiredConfirmations
 	}
 	return 0
 }
 
-func (m *PurchaseTicketsRequest) GetRequiredConfirmations() uint32 {
+func (m *SweepAccountRequest) GetMinValue() int64 {
 	if m != nil {
-		return m.RequiredConfirmations
+		return m.MinValue
 	}
 	return 0
 }
 
-func (m *PurchaseTicketsRequest) GetTicketAddress() string {
+func (m *SweepAccountRequest) GetDestinations() []*SweepAccountRequest_Destination {
 	if m != nil {
-		return m.TicketAddress
+		return m.Destinations
 	}
-	return ""
+	return nil
 }
 
-func (m *PurchaseTicketsRequest) GetNumTickets() uint32 {
+type SweepAccountRequest_Destination struct {
+	Destination *ConstructTransactionRequest_OutputDestination `protobuf:"bytes,1,opt,name=destination" json:"destination,omitempty"`
+	Ratio       float64                                        `protobuf:"fixed64,2,opt,name=ratio" json:"ratio,omitempty"`
+}
+
+func (m *SweepAccountRequest_Destination) Reset()         { *m = SweepAccountRequest_Destination{} }
+func (m *SweepAccountRequest_Destination) String() string { return proto.CompactTextString(m) }
+func (*SweepAccountRequest_Destination) ProtoMessage()    {}
+func (*SweepAccountRequest_Destination) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{49, 0}
+}
+
+func (m *SweepAccountRequest_Destination) GetDestination() *ConstructTransactionRequest_OutputDestination {
 	if m != nil {
-		return m.NumTickets
+		return m.Destination
 	}
-	return 0
+	return nil
 }
 
-func (m *PurchaseTicketsRequest) GetPoolAddress() string {
+func (m *SweepAccountRequest_Destination) GetRatio() float64 {
 	if m != nil {
-		return m.PoolAddress
+		return m.Ratio
 	}
-	return ""
+	return 0
 }
 
-func (m *PurchaseTicketsRequest) GetPoolFees() float64 {
+type SweepAccountResponse struct {
+	TransactionHash           []byte `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	SignedTransaction         []byte `protobuf:"bytes,2,opt,name=signed_transaction,json=signedTransaction,proto3" json:"signed_transaction,omitempty"`
+	TotalPreviousOutputAmount int64  `protobuf:"varint,3,opt,name=total_previous_output_amount,json=totalPreviousOutputAmount" json:"total_previous_output_amount,omitempty"`
+	TotalOutputAmount         int64  `protobuf:"varint,4,opt,name=total_output_amount,json=totalOutputAmount" json:"total_output_amount,omitempty"`
+}
+
+func (m *SweepAccountResponse) Reset()                    { *m = SweepAccountResponse{} }
+func (m *SweepAccountResponse) String() string            { return proto.CompactTextString(m) }
+func (*SweepAccountResponse) ProtoMessage()               {}
+func (*SweepAccountResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
+
+func (m *SweepAccountResponse) GetTransactionHash() []byte {
 	if m != nil {
-		return m.PoolFees
+		return m.TransactionHash
 	}
-	return 0
+	return nil
 }
 
-func (m *PurchaseTicketsRequest) GetExpiry() uint32 {
+func (m *SweepAccountResponse) GetSignedTransaction() []byte {
 	if m != nil {
-		return m.Expiry
+		return m.SignedTransaction
 	}
-	return 0
+	return nil
 }
 
-func (m *PurchaseTicketsRequest) GetTxFee() int64 {
+func (m *SweepAccountResponse) GetTotalPreviousOutputAmount() int64 {
 	if m != nil {
-		return m.TxFee
+		return m.TotalPreviousOutputAmount
 	}
 	return 0
 }
 
-func (m *PurchaseTicketsRequest) GetTicketFee() int64 {
+func (m *SweepAccountResponse) GetTotalOutputAmount() int64 {
 	if m != nil {
-		return m.TicketFee
+		return m.TotalOutputAmount
 	}
 	return 0
 }
 
-type PurchaseTicketsResponse struct {
-	TicketHashes [][]byte `protobuf:"bytes,1,rep,name=ticket_hashes,json=ticketHashes,proto3" json:"ticket_hashes,omitempty"`
+type AbandonTransactionRequest struct {
+	TransactionHash []byte `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
 }
 
-func (m *PurchaseTicketsResponse) Reset()                    { *m = PurchaseTicketsResponse{} }
-func (m *PurchaseTicketsResponse) String() string            { return proto.CompactTextString(m) }
-func (*PurchaseTicketsResponse) ProtoMessage()               {}
-func (*PurchaseTicketsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
+func (m *AbandonTransactionRequest) Reset()                    { *m = AbandonTransactionRequest{} }
+func (m *AbandonTransactionRequest) String() string            { return proto.CompactTextString(m) }
+func (*AbandonTransactionRequest) ProtoMessage()               {}
+func (*AbandonTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
 
-func (m *PurchaseTicketsResponse) GetTicketHashes() [][]byte {
+func (m *AbandonTransactionRequest) GetTransactionHash() []byte {
 	if m != nil {
-		return m.TicketHashes
+		return m.TransactionHash
 	}
 	return nil
 }
 
-type RevokeTicketsRequest struct {
-	Passphrase []byte `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+type AbandonTransactionResponse struct {
+	RemovedTransactionHashes [][]byte `protobuf:"bytes,1,rep,name=removed_transaction_hashes,json=removedTransactionHashes,proto3" json:"removed_transaction_hashes,omitempty"`
 }
 
-func (m *RevokeTicketsRequest) Reset()                    { *m = RevokeTicketsRequest{} }
-func (m *RevokeTicketsRequest) String() string            { return proto.CompactTextString(m) }
-func (*RevokeTicketsRequest) ProtoMessage()               {}
-func (*RevokeTicketsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
+func (m *AbandonTransactionResponse) Reset()                    { *m = AbandonTransactionResponse{} }
+func (m *AbandonTransactionResponse) String() string            { return proto.CompactTextString(m) }
+func (*AbandonTransactionResponse) ProtoMessage()               {}
+func (*AbandonTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
 
-func (m *RevokeTicketsRequest) GetPassphrase() []byte {
+func (m *AbandonTransactionResponse) GetRemovedTransactionHashes() [][]byte {
 	if m != nil {
-		return m.Passphrase
+		return m.RemovedTransactionHashes
 	}
 	return nil
 }
 
-type RevokeTicketsResponse struct {
-}
-
-func (m *RevokeTicketsResponse) Reset()                    { *m = RevokeTicketsResponse{} }
-func (m *RevokeTicketsResponse) String() string            { return proto.CompactTextString(m) }
-func (*RevokeTicketsResponse) ProtoMessage()               {}
-func (*RevokeTicketsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
-
-type LoadActiveDataFiltersRequest struct {
-}
-
-func (m *LoadActiveDataFiltersRequest) Reset()                    { *m = LoadActiveDataFiltersRequest{} }
-func (m *LoadActiveDataFiltersRequest) String() string            { return proto.CompactTextString(m) }
-func (*LoadActiveDataFiltersRequest) ProtoMessage()               {}
-func (*LoadActiveDataFiltersRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
-
-type LoadActiveDataFiltersResponse struct {
-}
-
-func (m *LoadActiveDataFiltersResponse) Reset()                    { *m = LoadActiveDataFiltersResponse{} }
-func (m *LoadActiveDataFiltersResponse) String() string            { return proto.CompactTextString(m) }
-func (*LoadActiveDataFiltersResponse) ProtoMessage()               {}
-func (*LoadActiveDataFiltersResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
-
-type TransactionNotificationsRequest struct {
-}
-
-func (m *TransactionNotificationsRequest) Reset()         { *m = TransactionNotificationsRequest{} }
-func (m *TransactionNotificationsRequest) String() string { return proto.CompactTextString(m) }
-func (*TransactionNotificationsRequest) ProtoMessage()    {}
-func (*TransactionNotificationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{53}
-}
-
-type TransactionNotificationsResponse struct {
-	// Sorted by increasing height.  This is a repeated field so many new blocks
-	// in a new best chain can be notified at once during a reorganize.
-	AttachedBlocks []*BlockDetails `protobuf:"bytes,1,rep,name=attached_blocks,json=attachedBlocks" json:"attached_blocks,omitempty"`
-	// If there was a chain reorganize, there may have been blocks with wallet
-	// transactions that are no longer in the best chain.  These are those
-	// block's hashes.
-	DetachedBlocks [][]byte `protobuf:"bytes,2,rep,name=detached_blocks,json=detachedBlocks,proto3" json:"detached_blocks,omitempty"`
-	// Any new unmined transactions are included here.  These unmined transactions
-	// refer to the current best chain, so transactions from detached blocks may
-	// be moved to mempool and included here if they are not mined or double spent
-	// in the new chain.  Additonally, if no new blocks were attached but a relevant
-	// unmined transaction is seen by the wallet, it will be reported here.
-	UnminedTransactions []*TransactionDetails `protobuf:"bytes,3,rep,name=unmined_transactions,json=unminedTransactions" json:"unmined_transactions,omitempty"`
-	// Instead of notifying all of the removed unmined transactions,
-	// just send all of the current hashes.
-	UnminedTransactionHashes [][]byte `protobuf:"bytes,4,rep,name=unmined_transaction_hashes,json=unminedTransactionHashes,proto3" json:"unmined_transaction_hashes,omitempty"`
+type BumpFeeRequest struct {
+	Passphrase      []byte `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	TransactionHash []byte `protobuf:"bytes,2,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	FeeRate         int64  `protobuf:"varint,3,opt,name=fee_rate,json=feeRate" json:"fee_rate,omitempty"`
+	DryRun          bool   `protobuf:"varint,4,opt,name=dry_run,json=dryRun" json:"dry_run,omitempty"`
 }
 
-func (m *TransactionNotificationsResponse) Reset()         { *m = TransactionNotificationsResponse{} }
-func (m *TransactionNotificationsResponse) String() string { return proto.CompactTextString(m) }
-func (*TransactionNotificationsResponse) ProtoMessage()    {}
-func (*TransactionNotificationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{54}
-}
+func (m *BumpFeeRequest) Reset()                    { *m = BumpFeeRequest{} }
+func (m *BumpFeeRequest) String() string            { return proto.CompactTextString(m) }
+func (*BumpFeeRequest) ProtoMessage()               {}
+func (*BumpFeeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
 
-func (m *TransactionNotificationsResponse) GetAttachedBlocks() []*BlockDetails {
+func (m *BumpFeeRequest) GetPassphrase() []byte {
 	if m != nil {
-		return m.AttachedBlocks
+		return m.Passphrase
 	}
 	return nil
 }
 
-func (m *TransactionNotificationsResponse) GetDetachedBlocks() [][]byte {
+func (m *BumpFeeRequest) GetTransactionHash() []byte {
 	if m != nil {
-		return m.DetachedBlocks
+		return m.TransactionHash
 	}
 	return nil
 }
 
-func (m *TransactionNotificationsResponse) GetUnminedTransactions() []*TransactionDetails {
+func (m *BumpFeeRequest) GetFeeRate() int64 {
 	if m != nil {
-		return m.UnminedTransactions
+		return m.FeeRate
 	}
-	return nil
+	return 0
 }
 
-func (m *TransactionNotificationsResponse) GetUnminedTransactionHashes() [][]byte {
+func (m *BumpFeeRequest) GetDryRun() bool {
 	if m != nil {
-		return m.UnminedTransactionHashes
+		return m.DryRun
 	}
-	return nil
+	return false
 }
 
-type AccountNotificationsRequest struct {
+type BumpFeeResponse struct {
+	TransactionHash     []byte `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	Transaction         []byte `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	Fee                 int64  `protobuf:"varint,3,opt,name=fee" json:"fee,omitempty"`
+	EstimatedSignedSize uint32 `protobuf:"varint,4,opt,name=estimated_signed_size,json=estimatedSignedSize" json:"estimated_signed_size,omitempty"`
+	ParentFee           int64  `protobuf:"varint,5,opt,name=parent_fee,json=parentFee" json:"parent_fee,omitempty"`
+	ParentSize          uint32 `protobuf:"varint,6,opt,name=parent_size,json=parentSize" json:"parent_size,omitempty"`
+	PackageFeeRate      int64  `protobuf:"varint,7,opt,name=package_fee_rate,json=packageFeeRate" json:"package_fee_rate,omitempty"`
 }
 
-func (m *AccountNotificationsRequest) Reset()                    { *m = AccountNotificationsRequest{} }
-func (m *AccountNotificationsRequest) String() string            { return proto.CompactTextString(m) }
-func (*AccountNotificationsRequest) ProtoMessage()               {}
-func (*AccountNotificationsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
+func (m *BumpFeeResponse) Reset()                    { *m = BumpFeeResponse{} }
+func (m *BumpFeeResponse) String() string            { return proto.CompactTextString(m) }
+func (*BumpFeeResponse) ProtoMessage()               {}
+func (*BumpFeeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
 
-type AccountNotificationsResponse struct {
-	AccountNumber    uint32 `protobuf:"varint,1,opt,name=account_number,json=accountNumber" json:"account_number,omitempty"`
-	AccountName      string `protobuf:"bytes,2,opt,name=account_name,json=accountName" json:"account_name,omitempty"`
-	ExternalKeyCount uint32 `protobuf:"varint,3,opt,name=external_key_count,json=externalKeyCount" json:"external_key_count,omitempty"`
-	InternalKeyCount uint32 `protobuf:"varint,4,opt,name=internal_key_count,json=internalKeyCount" json:"internal_key_count,omitempty"`
-	ImportedKeyCount uint32 `protobuf:"varint,5,opt,name=imported_key_count,json=importedKeyCount" json:"imported_key_count,omitempty"`
+func (m *BumpFeeResponse) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return nil
 }
 
-func (m *AccountNotificationsResponse) Reset()                    { *m = AccountNotificationsResponse{} }
-func (m *AccountNotificationsResponse) String() string            { return proto.CompactTextString(m) }
-func (*AccountNotificationsResponse) ProtoMessage()               {}
-func (*AccountNotificationsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
+func (m *BumpFeeResponse) GetTransaction() []byte {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
 
-func (m *AccountNotificationsResponse) GetAccountNumber() uint32 {
+func (m *BumpFeeResponse) GetFee() int64 {
 	if m != nil {
-		return m.AccountNumber
+		return m.Fee
 	}
 	return 0
 }
 
-func (m *AccountNotificationsResponse) GetAccountName() string {
+func (m *BumpFeeResponse) GetEstimatedSignedSize() uint32 {
 	if m != nil {
-		return m.AccountName
+		return m.EstimatedSignedSize
 	}
-	return ""
+	return 0
 }
 
-func (m *AccountNotificationsResponse) GetExternalKeyCount() uint32 {
+func (m *BumpFeeResponse) GetParentFee() int64 {
 	if m != nil {
-		return m.ExternalKeyCount
+		return m.ParentFee
 	}
 	return 0
 }
 
-func (m *AccountNotificationsResponse) GetInternalKeyCount() uint32 {
+func (m *BumpFeeResponse) GetParentSize() uint32 {
 	if m != nil {
-		return m.InternalKeyCount
+		return m.ParentSize
 	}
 	return 0
 }
 
-func (m *AccountNotificationsResponse) GetImportedKeyCount() uint32 {
+func (m *BumpFeeResponse) GetPackageFeeRate() int64 {
 	if m != nil {
-		return m.ImportedKeyCount
+		return m.PackageFeeRate
 	}
 	return 0
 }
 
-type ConfirmationNotificationsRequest struct {
-	TxHashes  [][]byte `protobuf:"bytes,1,rep,name=tx_hashes,json=txHashes,proto3" json:"tx_hashes,omitempty"`
-	StopAfter int32    `protobuf:"varint,2,opt,name=stop_after,json=stopAfter" json:"stop_after,omitempty"`
+type CreatePartialTransactionRequest struct {
+	UnsignedTransaction []byte `protobuf:"bytes,1,opt,name=unsigned_transaction,json=unsignedTransaction,proto3" json:"unsigned_transaction,omitempty"`
 }
 
-func (m *ConfirmationNotificationsRequest) Reset()         { *m = ConfirmationNotificationsRequest{} }
-func (m *ConfirmationNotificationsRequest) String() string { return proto.CompactTextString(m) }
-func (*ConfirmationNotificationsRequest) ProtoMessage()    {}
-func (*ConfirmationNotificationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{57}
+func (m *CreatePartialTransactionRequest) Reset()         { *m = CreatePartialTransactionRequest{} }
+func (m *CreatePartialTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*CreatePartialTransactionRequest) ProtoMessage()    {}
+func (*CreatePartialTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{55}
 }
 
-func (m *ConfirmationNotificationsRequest) GetTxHashes() [][]byte {
+func (m *CreatePartialTransactionRequest) GetUnsignedTransaction() []byte {
 	if m != nil {
-		return m.TxHashes
+		return m.UnsignedTransaction
 	}
 	return nil
 }
 
-func (m *ConfirmationNotificationsRequest) GetStopAfter() int32 {
+type CreatePartialTransactionResponse struct {
+	PartialTransaction []byte `protobuf:"bytes,1,opt,name=partial_transaction,json=partialTransaction,proto3" json:"partial_transaction,omitempty"`
+}
+
+func (m *CreatePartialTransactionResponse) Reset()         { *m = CreatePartialTransactionResponse{} }
+func (m *CreatePartialTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*CreatePartialTransactionResponse) ProtoMessage()    {}
+func (*CreatePartialTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{56}
+}
+
+func (m *CreatePartialTransactionResponse) GetPartialTransaction() []byte {
 	if m != nil {
-		return m.StopAfter
+		return m.PartialTransaction
 	}
-	return 0
+	return nil
 }
 
-type ConfirmationNotificationsResponse struct {
-	Confirmations []*ConfirmationNotificationsResponse_TransactionConfirmations `protobuf:"bytes,1,rep,name=confirmations" json:"confirmations,omitempty"`
+type UpdatePartialTransactionRequest struct {
+	PartialTransaction []byte `protobuf:"bytes,1,opt,name=partial_transaction,json=partialTransaction,proto3" json:"partial_transaction,omitempty"`
 }
 
-func (m *ConfirmationNotificationsResponse) Reset()         { *m = ConfirmationNotificationsResponse{} }
-func (m *ConfirmationNotificationsResponse) String() string { return proto.CompactTextString(m) }
-func (*ConfirmationNotificationsResponse) ProtoMessage()    {}
-func (*ConfirmationNotificationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{58}
+func (m *UpdatePartialTransactionRequest) Reset()         { *m = UpdatePartialTransactionRequest{} }
+func (m *UpdatePartialTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdatePartialTransactionRequest) ProtoMessage()    {}
+func (*UpdatePartialTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{57}
 }
 
-func (m *ConfirmationNotificationsResponse) GetConfirmations() []*ConfirmationNotificationsResponse_TransactionConfirmations {
+func (m *UpdatePartialTransactionRequest) GetPartialTransaction() []byte {
 	if m != nil {
-		return m.Confirmations
+		return m.PartialTransaction
 	}
 	return nil
 }
 
-type ConfirmationNotificationsResponse_TransactionConfirmations struct {
-	TxHash        []byte `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
-	Confirmations int32  `protobuf:"varint,2,opt,name=confirmations" json:"confirmations,omitempty"`
-	BlockHash     []byte `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
-	BlockHeight   int32  `protobuf:"varint,4,opt,name=block_height,json=blockHeight" json:"block_height,omitempty"`
+type UpdatePartialTransactionResponse struct {
+	PartialTransaction []byte `protobuf:"bytes,1,opt,name=partial_transaction,json=partialTransaction,proto3" json:"partial_transaction,omitempty"`
 }
 
-func (m *ConfirmationNotificationsResponse_TransactionConfirmations) Reset() {
-	*m = ConfirmationNotificationsResponse_TransactionConfirmations{}
-}
-func (m *ConfirmationNotificationsResponse_TransactionConfirmations) String() string {
-	return proto.CompactTextString(m)
-}
-func (*ConfirmationNotificationsResponse_TransactionConfirmations) ProtoMessage() {}
-func (*ConfirmationNotificationsResponse_TransactionConfirmations) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{58, 0}
+func (m *UpdatePartialTransactionResponse) Reset()         { *m = UpdatePartialTransactionResponse{} }
+func (m *UpdatePartialTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdatePartialTransactionResponse) ProtoMessage()    {}
+func (*UpdatePartialTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{58}
 }
 
-func (m *ConfirmationNotificationsResponse_TransactionConfirmations) GetTxHash() []byte {
+func (m *UpdatePartialTransactionResponse) GetPartialTransaction() []byte {
 	if m != nil {
-		return m.TxHash
+		return m.PartialTransaction
 	}
 	return nil
 }
 
-func (m *ConfirmationNotificationsResponse_TransactionConfirmations) GetConfirmations() int32 {
-	if m != nil {
-		return m.Confirmations
-	}
-	return 0
+type SignPartialTransactionRequest struct {
+	Passphrase         []byte `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	PartialTransaction []byte `protobuf:"bytes,2,opt,name=partial_transaction,json=partialTransaction,proto3" json:"partial_transaction,omitempty"`
 }
 
-func (m *ConfirmationNotificationsResponse_TransactionConfirmations) GetBlockHash() []byte {
+func (m *SignPartialTransactionRequest) Reset()                    { *m = SignPartialTransactionRequest{} }
+func (m *SignPartialTransactionRequest) String() string            { return proto.CompactTextString(m) }
+func (*SignPartialTransactionRequest) ProtoMessage()               {}
+func (*SignPartialTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
+
+func (m *SignPartialTransactionRequest) GetPassphrase() []byte {
 	if m != nil {
-		return m.BlockHash
+		return m.Passphrase
 	}
 	return nil
 }
 
-func (m *ConfirmationNotificationsResponse_TransactionConfirmations) GetBlockHeight() int32 {
+func (m *SignPartialTransactionRequest) GetPartialTransaction() []byte {
 	if m != nil {
-		return m.BlockHeight
+		return m.PartialTransaction
 	}
-	return 0
+	return nil
 }
 
-type CreateWalletRequest struct {
-	PublicPassphrase  []byte `protobuf:"bytes,1,opt,name=public_passphrase,json=publicPassphrase,proto3" json:"public_passphrase,omitempty"`
-	PrivatePassphrase []byte `protobuf:"bytes,2,opt,name=private_passphrase,json=privatePassphrase,proto3" json:"private_passphrase,omitempty"`
-	Seed              []byte `protobuf:"bytes,3,opt,name=seed,proto3" json:"seed,omitempty"`
+type SignPartialTransactionResponse struct {
+	PartialTransaction []byte   `protobuf:"bytes,1,opt,name=partial_transaction,json=partialTransaction,proto3" json:"partial_transaction,omitempty"`
+	SignedInputIndexes []uint32 `protobuf:"varint,2,rep,packed,name=signed_input_indexes,json=signedInputIndexes" json:"signed_input_indexes,omitempty"`
 }
 
-func (m *CreateWalletRequest) Reset()                    { *m = CreateWalletRequest{} }
-func (m *CreateWalletRequest) String() string            { return proto.CompactTextString(m) }
-func (*CreateWalletRequest) ProtoMessage()               {}
-func (*CreateWalletRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
-
-func (m *CreateWalletRequest) GetPublicPassphrase() []byte {
-	if m != nil {
-		return m.PublicPassphrase
-	}
-	return nil
+func (m *SignPartialTransactionResponse) Reset()         { *m = SignPartialTransactionResponse{} }
+func (m *SignPartialTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*SignPartialTransactionResponse) ProtoMessage()    {}
+func (*SignPartialTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{60}
 }
 
-func (m *CreateWalletRequest) GetPrivatePassphrase() []byte {
+func (m *SignPartialTransactionResponse) GetPartialTransaction() []byte {
 	if m != nil {
-		return m.PrivatePassphrase
+		return m.PartialTransaction
 	}
 	return nil
 }
 
-func (m *CreateWalletRequest) GetSeed() []byte {
+func (m *SignPartialTransactionResponse) GetSignedInputIndexes() []uint32 {
 	if m != nil {
-		return m.Seed
+		return m.SignedInputIndexes
 	}
 	return nil
 }
 
-type CreateWalletResponse struct {
+type CombinePartialTransactionsRequest struct {
+	PartialTransactions [][]byte `protobuf:"bytes,1,rep,name=partial_transactions,json=partialTransactions,proto3" json:"partial_transactions,omitempty"`
 }
 
-func (m *CreateWalletResponse) Reset()                    { *m = CreateWalletResponse{} }
-func (m *CreateWalletResponse) String() string            { return proto.CompactTextString(m) }
-func (*CreateWalletResponse) ProtoMessage()               {}
-func (*CreateWalletResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
-
-type OpenWalletRequest struct {
-	PublicPassphrase []byte `protobuf:"bytes,1,opt,name=public_passphrase,json=publicPassphrase,proto3" json:"public_passphrase,omitempty"`
+func (m *CombinePartialTransactionsRequest) Reset()         { *m = CombinePartialTransactionsRequest{} }
+func (m *CombinePartialTransactionsRequest) String() string { return proto.CompactTextString(m) }
+func (*CombinePartialTransactionsRequest) ProtoMessage()    {}
+func (*CombinePartialTransactionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{61}
 }
 
-func (m *OpenWalletRequest) Reset()                    { *m = OpenWalletRequest{} }
-func (m *OpenWalletRequest) String() string            { return proto.CompactTextString(m) }
-func (*OpenWalletRequest) ProtoMessage()               {}
-func (*OpenWalletRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
-
-func (m *OpenWalletRequest) GetPublicPassphrase() []byte {
+func (m *CombinePartialTransactionsRequest) GetPartialTransactions() [][]byte {
 	if m != nil {
-		return m.PublicPassphrase
+		return m.PartialTransactions
 	}
 	return nil
 }
 
-type OpenWalletResponse struct {
+type CombinePartialTransactionsResponse struct {
+	PartialTransaction []byte `protobuf:"bytes,1,opt,name=partial_transaction,json=partialTransaction,proto3" json:"partial_transaction,omitempty"`
 }
 
-func (m *OpenWalletResponse) Reset()                    { *m = OpenWalletResponse{} }
-func (m *OpenWalletResponse) String() string            { return proto.CompactTextString(m) }
-func (*OpenWalletResponse) ProtoMessage()               {}
-func (*OpenWalletResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
-
-type CloseWalletRequest struct {
+func (m *CombinePartialTransactionsResponse) Reset()         { *m = CombinePartialTransactionsResponse{} }
+func (m *CombinePartialTransactionsResponse) String() string { return proto.CompactTextString(m) }
+func (*CombinePartialTransactionsResponse) ProtoMessage()    {}
+func (*CombinePartialTransactionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{62}
 }
 
-func (m *CloseWalletRequest) Reset()                    { *m = CloseWalletRequest{} }
-func (m *CloseWalletRequest) String() string            { return proto.CompactTextString(m) }
-func (*CloseWalletRequest) ProtoMessage()               {}
-func (*CloseWalletRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
-
-type CloseWalletResponse struct {
+func (m *CombinePartialTransactionsResponse) GetPartialTransaction() []byte {
+	if m != nil {
+		return m.PartialTransaction
+	}
+	return nil
 }
 
-func (m *CloseWalletResponse) Reset()                    { *m = CloseWalletResponse{} }
-func (m *CloseWalletResponse) String() string            { return proto.CompactTextString(m) }
-func (*CloseWalletResponse) ProtoMessage()               {}
-func (*CloseWalletResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64} }
-
-type WalletExistsRequest struct {
+type FinalizePartialTransactionRequest struct {
+	PartialTransaction []byte `protobuf:"bytes,1,opt,name=partial_transaction,json=partialTransaction,proto3" json:"partial_transaction,omitempty"`
 }
 
-func (m *WalletExistsRequest) Reset()                    { *m = WalletExistsRequest{} }
-func (m *WalletExistsRequest) String() string            { return proto.CompactTextString(m) }
-func (*WalletExistsRequest) ProtoMessage()               {}
-func (*WalletExistsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65} }
-
-type WalletExistsResponse struct {
-	Exists bool `protobuf:"varint,1,opt,name=exists" json:"exists,omitempty"`
+func (m *FinalizePartialTransactionRequest) Reset()         { *m = FinalizePartialTransactionRequest{} }
+func (m *FinalizePartialTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*FinalizePartialTransactionRequest) ProtoMessage()    {}
+func (*FinalizePartialTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{63}
 }
 
-func (m *WalletExistsResponse) Reset()                    { *m = WalletExistsResponse{} }
-func (m *WalletExistsResponse) String() string            { return proto.CompactTextString(m) }
-func (*WalletExistsResponse) ProtoMessage()               {}
-func (*WalletExistsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
-
-func (m *WalletExistsResponse) GetExists() bool {
+func (m *FinalizePartialTransactionRequest) GetPartialTransaction() []byte {
 	if m != nil {
-		return m.Exists
+		return m.PartialTransaction
 	}
-	return false
+	return nil
 }
 
-type StartConsensusRpcRequest struct {
-	NetworkAddress string `protobuf:"bytes,1,opt,name=network_address,json=networkAddress" json:"network_address,omitempty"`
-	Username       string `protobuf:"bytes,2,opt,name=username" json:"username,omitempty"`
-	Password       []byte `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
-	Certificate    []byte `protobuf:"bytes,4,opt,name=certificate,proto3" json:"certificate,omitempty"`
+type FinalizePartialTransactionResponse struct {
+	PartialTransaction []byte `protobuf:"bytes,1,opt,name=partial_transaction,json=partialTransaction,proto3" json:"partial_transaction,omitempty"`
+	Complete           bool   `protobuf:"varint,2,opt,name=complete" json:"complete,omitempty"`
+	SignedTransaction  []byte `protobuf:"bytes,3,opt,name=signed_transaction,json=signedTransaction,proto3" json:"signed_transaction,omitempty"`
 }
 
-func (m *StartConsensusRpcRequest) Reset()                    { *m = StartConsensusRpcRequest{} }
-func (m *StartConsensusRpcRequest) String() string            { return proto.CompactTextString(m) }
-func (*StartConsensusRpcRequest) ProtoMessage()               {}
-func (*StartConsensusRpcRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
+func (m *FinalizePartialTransactionResponse) Reset()         { *m = FinalizePartialTransactionResponse{} }
+func (m *FinalizePartialTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*FinalizePartialTransactionResponse) ProtoMessage()    {}
+func (*FinalizePartialTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{64}
+}
 
-func (m *StartConsensusRpcRequest) GetNetworkAddress() string {
+func (m *FinalizePartialTransactionResponse) GetPartialTransaction() []byte {
 	if m != nil {
-		return m.NetworkAddress
+		return m.PartialTransaction
 	}
-	return ""
+	return nil
 }
 
-func (m *StartConsensusRpcRequest) GetUsername() string {
+func (m *FinalizePartialTransactionResponse) GetComplete() bool {
 	if m != nil {
-		return m.Username
+		return m.Complete
 	}
-	return ""
+	return false
 }
 
-func (m *StartConsensusRpcRequest) GetPassword() []byte {
+func (m *FinalizePartialTransactionResponse) GetSignedTransaction() []byte {
 	if m != nil {
-		return m.Password
+		return m.SignedTransaction
 	}
 	return nil
 }
 
-func (m *StartConsensusRpcRequest) GetCertificate() []byte {
+type SigningSession struct {
+	TransactionHash    []byte                  `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	Created            int64                   `protobuf:"varint,2,opt,name=created" json:"created,omitempty"`
+	PartialTransaction []byte                  `protobuf:"bytes,3,opt,name=partial_transaction,json=partialTransaction,proto3" json:"partial_transaction,omitempty"`
+	Inputs             []*SigningSession_Input `protobuf:"bytes,4,rep,name=inputs" json:"inputs,omitempty"`
+	Complete           bool                    `protobuf:"varint,5,opt,name=complete" json:"complete,omitempty"`
+	SignedTransaction  []byte                  `protobuf:"bytes,6,opt,name=signed_transaction,json=signedTransaction,proto3" json:"signed_transaction,omitempty"`
+}
+
+func (m *SigningSession) Reset()                    { *m = SigningSession{} }
+func (m *SigningSession) String() string            { return proto.CompactTextString(m) }
+func (*SigningSession) ProtoMessage()               {}
+func (*SigningSession) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65} }
+
+func (m *SigningSession) GetTransactionHash() []byte {
 	if m != nil {
-		return m.Certificate
+		return m.TransactionHash
 	}
 	return nil
 }
 
-type StartConsensusRpcResponse struct {
+func (m *SigningSession) GetCreated() int64 {
+	if m != nil {
+		return m.Created
+	}
+	return 0
 }
 
-func (m *StartConsensusRpcResponse) Reset()                    { *m = StartConsensusRpcResponse{} }
-func (m *StartConsensusRpcResponse) String() string            { return proto.CompactTextString(m) }
-func (*StartConsensusRpcResponse) ProtoMessage()               {}
-func (*StartConsensusRpcResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68} }
-
-type DiscoverAddressesRequest struct {
-	DiscoverAccounts  bool   `protobuf:"varint,1,opt,name=discover_accounts,json=discoverAccounts" json:"discover_accounts,omitempty"`
-	PrivatePassphrase []byte `protobuf:"bytes,2,opt,name=private_passphrase,json=privatePassphrase,proto3" json:"private_passphrase,omitempty"`
+func (m *SigningSession) GetPartialTransaction() []byte {
+	if m != nil {
+		return m.PartialTransaction
+	}
+	return nil
 }
 
-func (m *DiscoverAddressesRequest) Reset()                    { *m = DiscoverAddressesRequest{} }
-func (m *DiscoverAddressesRequest) String() string            { return proto.CompactTextString(m) }
-func (*DiscoverAddressesRequest) ProtoMessage()               {}
-func (*DiscoverAddressesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{69} }
+func (m *SigningSession) GetInputs() []*SigningSession_Input {
+	if m != nil {
+		return m.Inputs
+	}
+	return nil
+}
 
-func (m *DiscoverAddressesRequest) GetDiscoverAccounts() bool {
+func (m *SigningSession) GetComplete() bool {
 	if m != nil {
-		return m.DiscoverAccounts
+		return m.Complete
 	}
 	return false
 }
 
-func (m *DiscoverAddressesRequest) GetPrivatePassphrase() []byte {
+func (m *SigningSession) GetSignedTransaction() []byte {
 	if m != nil {
-		return m.PrivatePassphrase
+		return m.SignedTransaction
 	}
 	return nil
 }
 
-type DiscoverAddressesResponse struct {
+type SigningSession_Input struct {
+	PreviousTransactionHash []byte   `protobuf:"bytes,1,opt,name=previous_transaction_hash,json=previousTransactionHash,proto3" json:"previous_transaction_hash,omitempty"`
+	PreviousOutputIndex     uint32   `protobuf:"varint,2,opt,name=previous_output_index,json=previousOutputIndex" json:"previous_output_index,omitempty"`
+	Amount                  int64    `protobuf:"varint,3,opt,name=amount" json:"amount,omitempty"`
+	RequiredSignatures      uint32   `protobuf:"varint,4,opt,name=required_signatures,json=requiredSignatures" json:"required_signatures,omitempty"`
+	SignedPubKeys           [][]byte `protobuf:"bytes,5,rep,name=signed_pub_keys,json=signedPubKeys,proto3" json:"signed_pub_keys,omitempty"`
+	UnsignedPubKeys         [][]byte `protobuf:"bytes,6,rep,name=unsigned_pub_keys,json=unsignedPubKeys,proto3" json:"unsigned_pub_keys,omitempty"`
+	Final                   bool     `protobuf:"varint,7,opt,name=final" json:"final,omitempty"`
 }
 
-func (m *DiscoverAddressesResponse) Reset()                    { *m = DiscoverAddressesResponse{} }
-func (m *DiscoverAddressesResponse) String() string            { return proto.CompactTextString(m) }
-func (*DiscoverAddressesResponse) ProtoMessage()               {}
-func (*DiscoverAddressesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{70} }
+func (m *SigningSession_Input) Reset()                    { *m = SigningSession_Input{} }
+func (m *SigningSession_Input) String() string            { return proto.CompactTextString(m) }
+func (*SigningSession_Input) ProtoMessage()               {}
+func (*SigningSession_Input) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65, 0} }
 
-type SubscribeToBlockNotificationsRequest struct {
+func (m *SigningSession_Input) GetPreviousTransactionHash() []byte {
+	if m != nil {
+		return m.PreviousTransactionHash
+	}
+	return nil
 }
 
-func (m *SubscribeToBlockNotificationsRequest) Reset()         { *m = SubscribeToBlockNotificationsRequest{} }
-func (m *SubscribeToBlockNotificationsRequest) String() string { return proto.CompactTextString(m) }
-func (*SubscribeToBlockNotificationsRequest) ProtoMessage()    {}
-func (*SubscribeToBlockNotificationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{71}
+func (m *SigningSession_Input) GetPreviousOutputIndex() uint32 {
+	if m != nil {
+		return m.PreviousOutputIndex
+	}
+	return 0
 }
 
-type SubscribeToBlockNotificationsResponse struct {
+func (m *SigningSession_Input) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
 }
 
-func (m *SubscribeToBlockNotificationsResponse) Reset()         { *m = SubscribeToBlockNotificationsResponse{} }
-func (m *SubscribeToBlockNotificationsResponse) String() string { return proto.CompactTextString(m) }
-func (*SubscribeToBlockNotificationsResponse) ProtoMessage()    {}
-func (*SubscribeToBlockNotificationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{72}
+func (m *SigningSession_Input) GetRequiredSignatures() uint32 {
+	if m != nil {
+		return m.RequiredSignatures
+	}
+	return 0
 }
 
-type FetchHeadersRequest struct {
+func (m *SigningSession_Input) GetSignedPubKeys() [][]byte {
+	if m != nil {
+		return m.SignedPubKeys
+	}
+	return nil
 }
 
-func (m *FetchHeadersRequest) Reset()                    { *m = FetchHeadersRequest{} }
-func (m *FetchHeadersRequest) String() string            { return proto.CompactTextString(m) }
-func (*FetchHeadersRequest) ProtoMessage()               {}
-func (*FetchHeadersRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73} }
+func (m *SigningSession_Input) GetUnsignedPubKeys() [][]byte {
+	if m != nil {
+		return m.UnsignedPubKeys
+	}
+	return nil
+}
 
-type FetchHeadersResponse struct {
-	FetchedHeadersCount     uint32 `protobuf:"varint,1,opt,name=fetched_headers_count,json=fetchedHeadersCount" json:"fetched_headers_count,omitempty"`
-	FirstNewBlockHash       []byte `protobuf:"bytes,2,opt,name=first_new_block_hash,json=firstNewBlockHash,proto3" json:"first_new_block_hash,omitempty"`
-	FirstNewBlockHeight     int32  `protobuf:"varint,3,opt,name=first_new_block_height,json=firstNewBlockHeight" json:"first_new_block_height,omitempty"`
-	MainChainTipBlockHash   []byte `protobuf:"bytes,4,opt,name=main_chain_tip_block_hash,json=mainChainTipBlockHash,proto3" json:"main_chain_tip_block_hash,omitempty"`
-	MainChainTipBlockHeight int32  `protobuf:"varint,5,opt,name=main_chain_tip_block_height,json=mainChainTipBlockHeight" json:"main_chain_tip_block_height,omitempty"`
+func (m *SigningSession_Input) GetFinal() bool {
+	if m != nil {
+		return m.Final
+	}
+	return false
 }
 
-func (m *FetchHeadersResponse) Reset()                    { *m = FetchHeadersResponse{} }
-func (m *FetchHeadersResponse) String() string            { return proto.CompactTextString(m) }
-func (*FetchHeadersResponse) ProtoMessage()               {}
-func (*FetchHeadersResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{74} }
+type CreateSigningSessionRequest struct {
+	PartialTransaction  []byte                                          `protobuf:"bytes,1,opt,name=partial_transaction,json=partialTransaction,proto3" json:"partial_transaction,omitempty"`
+	MultisigOutpoints   []*CreateSigningSessionRequest_MultisigOutPoint `protobuf:"bytes,2,rep,name=multisig_outpoints,json=multisigOutpoints" json:"multisig_outpoints,omitempty"`
+	DestinationAddress  string                                          `protobuf:"bytes,3,opt,name=destination_address,json=destinationAddress" json:"destination_address,omitempty"`
+	FeePerKb            int64                                           `protobuf:"varint,4,opt,name=fee_per_kb,json=feePerKb" json:"fee_per_kb,omitempty"`
+	UnsignedTransaction []byte                                          `protobuf:"bytes,5,opt,name=unsigned_transaction,json=unsignedTransaction,proto3" json:"unsigned_transaction,omitempty"`
+}
 
-func (m *FetchHeadersResponse) GetFetchedHeadersCount() uint32 {
+func (m *CreateSigningSessionRequest) Reset()                    { *m = CreateSigningSessionRequest{} }
+func (m *CreateSigningSessionRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateSigningSessionRequest) ProtoMessage()               {}
+func (*CreateSigningSessionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
+
+func (m *CreateSigningSessionRequest) GetPartialTransaction() []byte {
 	if m != nil {
-		return m.FetchedHeadersCount
+		return m.PartialTransaction
 	}
-	return 0
+	return nil
 }
 
-func (m *FetchHeadersResponse) GetFirstNewBlockHash() []byte {
+func (m *CreateSigningSessionRequest) GetMultisigOutpoints() []*CreateSigningSessionRequest_MultisigOutPoint {
 	if m != nil {
-		return m.FirstNewBlockHash
+		return m.MultisigOutpoints
 	}
 	return nil
 }
 
-func (m *FetchHeadersResponse) GetFirstNewBlockHeight() int32 {
+func (m *CreateSigningSessionRequest) GetDestinationAddress() string {
 	if m != nil {
-		return m.FirstNewBlockHeight
+		return m.DestinationAddress
 	}
-	return 0
+	return ""
 }
 
-func (m *FetchHeadersResponse) GetMainChainTipBlockHash() []byte {
+func (m *CreateSigningSessionRequest) GetFeePerKb() int64 {
 	if m != nil {
-		return m.MainChainTipBlockHash
+		return m.FeePerKb
 	}
-	return nil
+	return 0
 }
 
-func (m *FetchHeadersResponse) GetMainChainTipBlockHeight() int32 {
+func (m *CreateSigningSessionRequest) GetUnsignedTransaction() []byte {
 	if m != nil {
-		return m.MainChainTipBlockHeight
+		return m.UnsignedTransaction
 	}
-	return 0
+	return nil
 }
 
-type GenerateRandomSeedRequest struct {
-	SeedLength uint32 `protobuf:"varint,1,opt,name=seed_length,json=seedLength" json:"seed_length,omitempty"`
+type CreateSigningSessionRequest_MultisigOutPoint struct {
+	TransactionHash []byte `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	OutputIndex     uint32 `protobuf:"varint,2,opt,name=output_index,json=outputIndex" json:"output_index,omitempty"`
 }
 
-func (m *GenerateRandomSeedRequest) Reset()                    { *m = GenerateRandomSeedRequest{} }
-func (m *GenerateRandomSeedRequest) String() string            { return proto.CompactTextString(m) }
-func (*GenerateRandomSeedRequest) ProtoMessage()               {}
-func (*GenerateRandomSeedRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{75} }
+func (m *CreateSigningSessionRequest_MultisigOutPoint) Reset() {
+	*m = CreateSigningSessionRequest_MultisigOutPoint{}
+}
+func (m *CreateSigningSessionRequest_MultisigOutPoint) String() string {
+	return proto.CompactTextString(m)
+}
+func (*CreateSigningSessionRequest_MultisigOutPoint) ProtoMessage() {}
+func (*CreateSigningSessionRequest_MultisigOutPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{66, 0}
+}
 
-func (m *GenerateRandomSeedRequest) GetSeedLength() uint32 {
+func (m *CreateSigningSessionRequest_MultisigOutPoint) GetTransactionHash() []byte {
 	if m != nil {
-		return m.SeedLength
+		return m.TransactionHash
+	}
+	return nil
+}
+
+func (m *CreateSigningSessionRequest_MultisigOutPoint) GetOutputIndex() uint32 {
+	if m != nil {
+		return m.OutputIndex
 	}
 	return 0
 }
 
-type GenerateRandomSeedResponse struct {
-	SeedBytes    []byte `protobuf:"bytes,1,opt,name=seed_bytes,json=seedBytes,proto3" json:"seed_bytes,omitempty"`
-	SeedHex      string `protobuf:"bytes,2,opt,name=seed_hex,json=seedHex" json:"seed_hex,omitempty"`
-	SeedMnemonic string `protobuf:"bytes,3,opt,name=seed_mnemonic,json=seedMnemonic" json:"seed_mnemonic,omitempty"`
+type CreateSigningSessionResponse struct {
+	Session *SigningSession `protobuf:"bytes,1,opt,name=session" json:"session,omitempty"`
 }
 
-func (m *GenerateRandomSeedResponse) Reset()                    { *m = GenerateRandomSeedResponse{} }
-func (m *GenerateRandomSeedResponse) String() string            { return proto.CompactTextString(m) }
-func (*GenerateRandomSeedResponse) ProtoMessage()               {}
-func (*GenerateRandomSeedResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{76} }
+func (m *CreateSigningSessionResponse) Reset()                    { *m = CreateSigningSessionResponse{} }
+func (m *CreateSigningSessionResponse) String() string            { return proto.CompactTextString(m) }
+func (*CreateSigningSessionResponse) ProtoMessage()               {}
+func (*CreateSigningSessionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
 
-func (m *GenerateRandomSeedResponse) GetSeedBytes() []byte {
+func (m *CreateSigningSessionResponse) GetSession() *SigningSession {
 	if m != nil {
-		return m.SeedBytes
+		return m.Session
 	}
 	return nil
 }
 
-func (m *GenerateRandomSeedResponse) GetSeedHex() string {
+type SigningSessionsRequest struct {
+	TransactionHash []byte `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+}
+
+func (m *SigningSessionsRequest) Reset()                    { *m = SigningSessionsRequest{} }
+func (m *SigningSessionsRequest) String() string            { return proto.CompactTextString(m) }
+func (*SigningSessionsRequest) ProtoMessage()               {}
+func (*SigningSessionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68} }
+
+func (m *SigningSessionsRequest) GetTransactionHash() []byte {
 	if m != nil {
-		return m.SeedHex
+		return m.TransactionHash
 	}
-	return ""
+	return nil
 }
 
-func (m *GenerateRandomSeedResponse) GetSeedMnemonic() string {
+type SigningSessionsResponse struct {
+	Sessions []*SigningSession `protobuf:"bytes,1,rep,name=sessions" json:"sessions,omitempty"`
+}
+
+func (m *SigningSessionsResponse) Reset()                    { *m = SigningSessionsResponse{} }
+func (m *SigningSessionsResponse) String() string            { return proto.CompactTextString(m) }
+func (*SigningSessionsResponse) ProtoMessage()               {}
+func (*SigningSessionsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{69} }
+
+func (m *SigningSessionsResponse) GetSessions() []*SigningSession {
 	if m != nil {
-		return m.SeedMnemonic
+		return m.Sessions
 	}
-	return ""
+	return nil
 }
 
-type DecodeSeedRequest struct {
-	UserInput string `protobuf:"bytes,1,opt,name=user_input,json=userInput" json:"user_input,omitempty"`
+type ImportSigningSessionSignaturesRequest struct {
+	PartialTransaction []byte `protobuf:"bytes,1,opt,name=partial_transaction,json=partialTransaction,proto3" json:"partial_transaction,omitempty"`
 }
 
-func (m *DecodeSeedRequest) Reset()                    { *m = DecodeSeedRequest{} }
-func (m *DecodeSeedRequest) String() string            { return proto.CompactTextString(m) }
-func (*DecodeSeedRequest) ProtoMessage()               {}
-func (*DecodeSeedRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{77} }
+func (m *ImportSigningSessionSignaturesRequest) Reset()         { *m = ImportSigningSessionSignaturesRequest{} }
+func (m *ImportSigningSessionSignaturesRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportSigningSessionSignaturesRequest) ProtoMessage()    {}
+func (*ImportSigningSessionSignaturesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{70}
+}
 
-func (m *DecodeSeedRequest) GetUserInput() string {
+func (m *ImportSigningSessionSignaturesRequest) GetPartialTransaction() []byte {
 	if m != nil {
-		return m.UserInput
+		return m.PartialTransaction
 	}
-	return ""
+	return nil
 }
 
-type DecodeSeedResponse struct {
-	DecodedSeed []byte `protobuf:"bytes,1,opt,name=decoded_seed,json=decodedSeed,proto3" json:"decoded_seed,omitempty"`
+type ImportSigningSessionSignaturesResponse struct {
+	Session *SigningSession `protobuf:"bytes,1,opt,name=session" json:"session,omitempty"`
 }
 
-func (m *DecodeSeedResponse) Reset()                    { *m = DecodeSeedResponse{} }
-func (m *DecodeSeedResponse) String() string            { return proto.CompactTextString(m) }
-func (*DecodeSeedResponse) ProtoMessage()               {}
-func (*DecodeSeedResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{78} }
+func (m *ImportSigningSessionSignaturesResponse) Reset() {
+	*m = ImportSigningSessionSignaturesResponse{}
+}
+func (m *ImportSigningSessionSignaturesResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportSigningSessionSignaturesResponse) ProtoMessage()    {}
+func (*ImportSigningSessionSignaturesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{71}
+}
 
-func (m *DecodeSeedResponse) GetDecodedSeed() []byte {
+func (m *ImportSigningSessionSignaturesResponse) GetSession() *SigningSession {
 	if m != nil {
-		return m.DecodedSeed
+		return m.Session
 	}
 	return nil
 }
 
-type StartAutoBuyerRequest struct {
-	Passphrase        []byte  `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
-	Account           uint32  `protobuf:"varint,2,opt,name=account" json:"account,omitempty"`
-	BalanceToMaintain int64   `protobuf:"varint,3,opt,name=balance_to_maintain,json=balanceToMaintain" json:"balance_to_maintain,omitempty"`
-	MaxFeePerKb       int64   `protobuf:"varint,4,opt,name=max_fee_per_kb,json=maxFeePerKb" json:"max_fee_per_kb,omitempty"`
-	MaxPriceRelative  float64 `protobuf:"fixed64,5,opt,name=max_price_relative,json=maxPriceRelative" json:"max_price_relative,omitempty"`
-	MaxPriceAbsolute  int64   `protobuf:"varint,6,opt,name=max_price_absolute,json=maxPriceAbsolute" json:"max_price_absolute,omitempty"`
-	VotingAddress     string  `protobuf:"bytes,7,opt,name=voting_address,json=votingAddress" json:"voting_address,omitempty"`
-	PoolAddress       string  `protobuf:"bytes,8,opt,name=pool_address,json=poolAddress" json:"pool_address,omitempty"`
-	PoolFees          float64 `protobuf:"fixed64,9,opt,name=pool_fees,json=poolFees" json:"pool_fees,omitempty"`
-	MaxPerBlock       int64   `protobuf:"varint,10,opt,name=max_per_block,json=maxPerBlock" json:"max_per_block,omitempty"`
+type SignSigningSessionRequest struct {
+	Passphrase      []byte `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	TransactionHash []byte `protobuf:"bytes,2,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
 }
 
-func (m *StartAutoBuyerRequest) Reset()                    { *m = StartAutoBuyerRequest{} }
-func (m *StartAutoBuyerRequest) String() string            { return proto.CompactTextString(m) }
-func (*StartAutoBuyerRequest) ProtoMessage()               {}
-func (*StartAutoBuyerRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{79} }
+func (m *SignSigningSessionRequest) Reset()                    { *m = SignSigningSessionRequest{} }
+func (m *SignSigningSessionRequest) String() string            { return proto.CompactTextString(m) }
+func (*SignSigningSessionRequest) ProtoMessage()               {}
+func (*SignSigningSessionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{72} }
 
-func (m *StartAutoBuyerRequest) GetPassphrase() []byte {
+func (m *SignSigningSessionRequest) GetPassphrase() []byte {
 	if m != nil {
 		return m.Passphrase
 	}
 	return nil
 }
 
-func (m *StartAutoBuyerRequest) GetAccount() uint32 {
+func (m *SignSigningSessionRequest) GetTransactionHash() []byte {
 	if m != nil {
-		return m.Account
+		return m.TransactionHash
 	}
-	return 0
+	return nil
 }
 
-func (m *StartAutoBuyerRequest) GetBalanceToMaintain() int64 {
+type SignSigningSessionResponse struct {
+	Session            *SigningSession `protobuf:"bytes,1,opt,name=session" json:"session,omitempty"`
+	SignedInputIndexes []uint32        `protobuf:"varint,2,rep,packed,name=signed_input_indexes,json=signedInputIndexes" json:"signed_input_indexes,omitempty"`
+}
+
+func (m *SignSigningSessionResponse) Reset()                    { *m = SignSigningSessionResponse{} }
+func (m *SignSigningSessionResponse) String() string            { return proto.CompactTextString(m) }
+func (*SignSigningSessionResponse) ProtoMessage()               {}
+func (*SignSigningSessionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73} }
+
+func (m *SignSigningSessionResponse) GetSession() *SigningSession {
 	if m != nil {
-		return m.BalanceToMaintain
+		return m.Session
 	}
-	return 0
+	return nil
 }
 
-func (m *StartAutoBuyerRequest) GetMaxFeePerKb() int64 {
+func (m *SignSigningSessionResponse) GetSignedInputIndexes() []uint32 {
 	if m != nil {
-		return m.MaxFeePerKb
+		return m.SignedInputIndexes
 	}
-	return 0
+	return nil
 }
 
-func (m *StartAutoBuyerRequest) GetMaxPriceRelative() float64 {
+type AbandonSigningSessionRequest struct {
+	TransactionHash []byte `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+}
+
+func (m *AbandonSigningSessionRequest) Reset()                    { *m = AbandonSigningSessionRequest{} }
+func (m *AbandonSigningSessionRequest) String() string            { return proto.CompactTextString(m) }
+func (*AbandonSigningSessionRequest) ProtoMessage()               {}
+func (*AbandonSigningSessionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{74} }
+
+func (m *AbandonSigningSessionRequest) GetTransactionHash() []byte {
 	if m != nil {
-		return m.MaxPriceRelative
+		return m.TransactionHash
 	}
-	return 0
+	return nil
 }
 
-func (m *StartAutoBuyerRequest) GetMaxPriceAbsolute() int64 {
+type AbandonSigningSessionResponse struct {
+}
+
+func (m *AbandonSigningSessionResponse) Reset()                    { *m = AbandonSigningSessionResponse{} }
+func (m *AbandonSigningSessionResponse) String() string            { return proto.CompactTextString(m) }
+func (*AbandonSigningSessionResponse) ProtoMessage()               {}
+func (*AbandonSigningSessionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{75} }
+
+type SwapContract struct {
+	TransactionHash         []byte            `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	OutputIndex             uint32            `protobuf:"varint,2,opt,name=output_index,json=outputIndex" json:"output_index,omitempty"`
+	Role                    SwapContract_Role `protobuf:"varint,3,opt,name=role,enum=walletrpc.SwapContract_Role" json:"role,omitempty"`
+	Account                 uint32            `protobuf:"varint,4,opt,name=account" json:"account,omitempty"`
+	Amount                  int64             `protobuf:"varint,5,opt,name=amount" json:"amount,omitempty"`
+	Contract                []byte            `protobuf:"bytes,6,opt,name=contract,proto3" json:"contract,omitempty"`
+	Address                 string            `protobuf:"bytes,7,opt,name=address" json:"address,omitempty"`
+	RecipientAddress        string            `protobuf:"bytes,8,opt,name=recipient_address,json=recipientAddress" json:"recipient_address,omitempty"`
+	RefundAddress           string            `protobuf:"bytes,9,opt,name=refund_address,json=refundAddress" json:"refund_address,omitempty"`
+	SecretHash              []byte            `protobuf:"bytes,10,opt,name=secret_hash,json=secretHash,proto3" json:"secret_hash,omitempty"`
+	LockTime                int64             `protobuf:"varint,11,opt,name=lock_time,json=lockTime" json:"lock_time,omitempty"`
+	Secret                  []byte            `protobuf:"bytes,12,opt,name=secret,proto3" json:"secret,omitempty"`
+	Created                 int64             `protobuf:"varint,13,opt,name=created" json:"created,omitempty"`
+	SpendingTransactionHash []byte            `protobuf:"bytes,14,opt,name=spending_transaction_hash,json=spendingTransactionHash,proto3" json:"spending_transaction_hash,omitempty"`
+	Redeemed                bool              `protobuf:"varint,15,opt,name=redeemed" json:"redeemed,omitempty"`
+}
+
+func (m *SwapContract) Reset()                    { *m = SwapContract{} }
+func (m *SwapContract) String() string            { return proto.CompactTextString(m) }
+func (*SwapContract) ProtoMessage()               {}
+func (*SwapContract) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{76} }
+
+func (m *SwapContract) GetTransactionHash() []byte {
 	if m != nil {
-		return m.MaxPriceAbsolute
+		return m.TransactionHash
 	}
-	return 0
+	return nil
 }
 
-func (m *StartAutoBuyerRequest) GetVotingAddress() string {
+func (m *SwapContract) GetOutputIndex() uint32 {
 	if m != nil {
-		return m.VotingAddress
+		return m.OutputIndex
 	}
-	return ""
+	return 0
 }
 
-func (m *StartAutoBuyerRequest) GetPoolAddress() string {
+func (m *SwapContract) GetRole() SwapContract_Role {
 	if m != nil {
-		return m.PoolAddress
+		return m.Role
 	}
-	return ""
+	return SwapContract_INITIATOR
 }
 
-func (m *StartAutoBuyerRequest) GetPoolFees() float64 {
+func (m *SwapContract) GetAccount() uint32 {
 	if m != nil {
-		return m.PoolFees
+		return m.Account
 	}
 	return 0
 }
 
-func (m *StartAutoBuyerRequest) GetMaxPerBlock() int64 {
+func (m *SwapContract) GetAmount() int64 {
 	if m != nil {
-		return m.MaxPerBlock
+		return m.Amount
 	}
 	return 0
 }
 
-type StartAutoBuyerResponse struct {
+func (m *SwapContract) GetContract() []byte {
+	if m != nil {
+		return m.Contract
+	}
+	return nil
 }
 
-func (m *StartAutoBuyerResponse) Reset()                    { *m = StartAutoBuyerResponse{} }
-func (m *StartAutoBuyerResponse) String() string            { return proto.CompactTextString(m) }
-func (*StartAutoBuyerResponse) ProtoMessage()               {}
-func (*StartAutoBuyerResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{80} }
-
-type StopAutoBuyerRequest struct {
+func (m *SwapContract) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *StopAutoBuyerRequest) Reset()                    { *m = StopAutoBuyerRequest{} }
-func (m *StopAutoBuyerRequest) String() string            { return proto.CompactTextString(m) }
-func (*StopAutoBuyerRequest) ProtoMessage()               {}
-func (*StopAutoBuyerRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{81} }
+func (m *SwapContract) GetRecipientAddress() string {
+	if m != nil {
+		return m.RecipientAddress
+	}
+	return ""
+}
 
-type StopAutoBuyerResponse struct {
+func (m *SwapContract) GetRefundAddress() string {
+	if m != nil {
+		return m.RefundAddress
+	}
+	return ""
 }
 
-func (m *StopAutoBuyerResponse) Reset()                    { *m = StopAutoBuyerResponse{} }
-func (m *StopAutoBuyerResponse) String() string            { return proto.CompactTextString(m) }
-func (*StopAutoBuyerResponse) ProtoMessage()               {}
-func (*StopAutoBuyerResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{82} }
-
-type TicketBuyerConfigRequest struct {
-}
-
-func (m *TicketBuyerConfigRequest) Reset()                    { *m = TicketBuyerConfigRequest{} }
-func (m *TicketBuyerConfigRequest) String() string            { return proto.CompactTextString(m) }
-func (*TicketBuyerConfigRequest) ProtoMessage()               {}
-func (*TicketBuyerConfigRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{83} }
-
-type TicketBuyerConfigResponse struct {
-	Account               uint32  `protobuf:"varint,1,opt,name=account" json:"account,omitempty"`
-	AvgPriceMode          string  `protobuf:"bytes,2,opt,name=avg_price_mode,json=avgPriceMode" json:"avg_price_mode,omitempty"`
-	AvgPriceVWAPDelta     int64   `protobuf:"varint,3,opt,name=avg_priceVWAP_delta,json=avgPriceVWAPDelta" json:"avg_priceVWAP_delta,omitempty"`
-	BalanceToMaintain     int64   `protobuf:"varint,4,opt,name=balance_to_maintain,json=balanceToMaintain" json:"balance_to_maintain,omitempty"`
-	BlocksToAvg           int64   `protobuf:"varint,5,opt,name=blocks_to_avg,json=blocksToAvg" json:"blocks_to_avg,omitempty"`
-	DontWaitForTickets    bool    `protobuf:"varint,6,opt,name=dont_wait_for_tickets,json=dontWaitForTickets" json:"dont_wait_for_tickets,omitempty"`
-	ExpiryDelta           int64   `protobuf:"varint,7,opt,name=expiry_delta,json=expiryDelta" json:"expiry_delta,omitempty"`
-	FeeSource             string  `protobuf:"bytes,8,opt,name=fee_source,json=feeSource" json:"fee_source,omitempty"`
-	FeeTargetScaling      float64 `protobuf:"fixed64,9,opt,name=fee_target_scaling,json=feeTargetScaling" json:"fee_target_scaling,omitempty"`
-	MinFee                int64   `protobuf:"varint,10,opt,name=min_fee,json=minFee" json:"min_fee,omitempty"`
-	MaxFee                int64   `protobuf:"varint,12,opt,name=max_fee,json=maxFee" json:"max_fee,omitempty"`
-	MaxPerBlock           int64   `protobuf:"varint,13,opt,name=max_per_block,json=maxPerBlock" json:"max_per_block,omitempty"`
-	MaxPriceAbsolute      int64   `protobuf:"varint,14,opt,name=max_price_absolute,json=maxPriceAbsolute" json:"max_price_absolute,omitempty"`
-	MaxPriceRelative      float64 `protobuf:"fixed64,15,opt,name=max_price_relative,json=maxPriceRelative" json:"max_price_relative,omitempty"`
-	MaxInMempool          int64   `protobuf:"varint,17,opt,name=max_in_mempool,json=maxInMempool" json:"max_in_mempool,omitempty"`
-	PoolAddress           string  `protobuf:"bytes,18,opt,name=pool_address,json=poolAddress" json:"pool_address,omitempty"`
-	PoolFees              float64 `protobuf:"fixed64,19,opt,name=pool_fees,json=poolFees" json:"pool_fees,omitempty"`
-	SpreadTicketPurchases bool    `protobuf:"varint,20,opt,name=spread_ticket_purchases,json=spreadTicketPurchases" json:"spread_ticket_purchases,omitempty"`
-	VotingAddress         string  `protobuf:"bytes,21,opt,name=voting_address,json=votingAddress" json:"voting_address,omitempty"`
-	TxFee                 int64   `protobuf:"varint,22,opt,name=tx_fee,json=txFee" json:"tx_fee,omitempty"`
+func (m *SwapContract) GetSecretHash() []byte {
+	if m != nil {
+		return m.SecretHash
+	}
+	return nil
 }
 
-func (m *TicketBuyerConfigResponse) Reset()                    { *m = TicketBuyerConfigResponse{} }
-func (m *TicketBuyerConfigResponse) String() string            { return proto.CompactTextString(m) }
-func (*TicketBuyerConfigResponse) ProtoMessage()               {}
-func (*TicketBuyerConfigResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{84} }
-
-func (m *TicketBuyerConfigResponse) GetAccount() uint32 {
+func (m *SwapContract) GetLockTime() int64 {
 	if m != nil {
-		return m.Account
+		return m.LockTime
 	}
 	return 0
 }
 
-func (m *TicketBuyerConfigResponse) GetAvgPriceMode() string {
+func (m *SwapContract) GetSecret() []byte {
 	if m != nil {
-		return m.AvgPriceMode
+		return m.Secret
 	}
-	return ""
+	return nil
 }
 
-func (m *TicketBuyerConfigResponse) GetAvgPriceVWAPDelta() int64 {
+func (m *SwapContract) GetCreated() int64 {
 	if m != nil {
-		return m.AvgPriceVWAPDelta
+		return m.Created
 	}
 	return 0
 }
 
-func (m *TicketBuyerConfigResponse) GetBalanceToMaintain() int64 {
+func (m *SwapContract) GetSpendingTransactionHash() []byte {
 	if m != nil {
-		return m.BalanceToMaintain
+		return m.SpendingTransactionHash
 	}
-	return 0
+	return nil
 }
 
-func (m *TicketBuyerConfigResponse) GetBlocksToAvg() int64 {
+func (m *SwapContract) GetRedeemed() bool {
 	if m != nil {
-		return m.BlocksToAvg
+		return m.Redeemed
 	}
-	return 0
+	return false
 }
 
-func (m *TicketBuyerConfigResponse) GetDontWaitForTickets() bool {
+type InitiateSwapRequest struct {
+	Passphrase       []byte `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	Account          uint32 `protobuf:"varint,2,opt,name=account" json:"account,omitempty"`
+	RecipientAddress string `protobuf:"bytes,3,opt,name=recipient_address,json=recipientAddress" json:"recipient_address,omitempty"`
+	Amount           int64  `protobuf:"varint,4,opt,name=amount" json:"amount,omitempty"`
+}
+
+func (m *InitiateSwapRequest) Reset()                    { *m = InitiateSwapRequest{} }
+func (m *InitiateSwapRequest) String() string            { return proto.CompactTextString(m) }
+func (*InitiateSwapRequest) ProtoMessage()               {}
+func (*InitiateSwapRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{77} }
+
+func (m *InitiateSwapRequest) GetPassphrase() []byte {
 	if m != nil {
-		return m.DontWaitForTickets
+		return m.Passphrase
 	}
-	return false
+	return nil
 }
 
-func (m *TicketBuyerConfigResponse) GetExpiryDelta() int64 {
+func (m *InitiateSwapRequest) GetAccount() uint32 {
 	if m != nil {
-		return m.ExpiryDelta
+		return m.Account
 	}
 	return 0
 }
 
-func (m *TicketBuyerConfigResponse) GetFeeSource() string {
+func (m *InitiateSwapRequest) GetRecipientAddress() string {
 	if m != nil {
-		return m.FeeSource
+		return m.RecipientAddress
 	}
 	return ""
 }
 
-func (m *TicketBuyerConfigResponse) GetFeeTargetScaling() float64 {
+func (m *InitiateSwapRequest) GetAmount() int64 {
 	if m != nil {
-		return m.FeeTargetScaling
+		return m.Amount
 	}
 	return 0
 }
 
-func (m *TicketBuyerConfigResponse) GetMinFee() int64 {
+type InitiateSwapResponse struct {
+	Contract            *SwapContract `protobuf:"bytes,1,opt,name=contract" json:"contract,omitempty"`
+	ContractTransaction []byte        `protobuf:"bytes,2,opt,name=contract_transaction,json=contractTransaction,proto3" json:"contract_transaction,omitempty"`
+}
+
+func (m *InitiateSwapResponse) Reset()                    { *m = InitiateSwapResponse{} }
+func (m *InitiateSwapResponse) String() string            { return proto.CompactTextString(m) }
+func (*InitiateSwapResponse) ProtoMessage()               {}
+func (*InitiateSwapResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{78} }
+
+func (m *InitiateSwapResponse) GetContract() *SwapContract {
 	if m != nil {
-		return m.MinFee
+		return m.Contract
 	}
-	return 0
+	return nil
 }
 
-func (m *TicketBuyerConfigResponse) GetMaxFee() int64 {
+func (m *InitiateSwapResponse) GetContractTransaction() []byte {
 	if m != nil {
-		return m.MaxFee
+		return m.ContractTransaction
 	}
-	return 0
+	return nil
 }
 
-func (m *TicketBuyerConfigResponse) GetMaxPerBlock() int64 {
+type ParticipateSwapRequest struct {
+	Passphrase       []byte `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	Account          uint32 `protobuf:"varint,2,opt,name=account" json:"account,omitempty"`
+	RecipientAddress string `protobuf:"bytes,3,opt,name=recipient_address,json=recipientAddress" json:"recipient_address,omitempty"`
+	Amount           int64  `protobuf:"varint,4,opt,name=amount" json:"amount,omitempty"`
+	SecretHash       []byte `protobuf:"bytes,5,opt,name=secret_hash,json=secretHash,proto3" json:"secret_hash,omitempty"`
+}
+
+func (m *ParticipateSwapRequest) Reset()                    { *m = ParticipateSwapRequest{} }
+func (m *ParticipateSwapRequest) String() string            { return proto.CompactTextString(m) }
+func (*ParticipateSwapRequest) ProtoMessage()               {}
+func (*ParticipateSwapRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{79} }
+
+func (m *ParticipateSwapRequest) GetPassphrase() []byte {
 	if m != nil {
-		return m.MaxPerBlock
+		return m.Passphrase
 	}
-	return 0
+	return nil
 }
 
-func (m *TicketBuyerConfigResponse) GetMaxPriceAbsolute() int64 {
+func (m *ParticipateSwapRequest) GetAccount() uint32 {
 	if m != nil {
-		return m.MaxPriceAbsolute
+		return m.Account
 	}
 	return 0
 }
 
-func (m *TicketBuyerConfigResponse) GetMaxPriceRelative() float64 {
+func (m *ParticipateSwapRequest) GetRecipientAddress() string {
 	if m != nil {
-		return m.MaxPriceRelative
+		return m.RecipientAddress
 	}
-	return 0
+	return ""
 }
 
-func (m *TicketBuyerConfigResponse) GetMaxInMempool() int64 {
+func (m *ParticipateSwapRequest) GetAmount() int64 {
 	if m != nil {
-		return m.MaxInMempool
+		return m.Amount
 	}
 	return 0
 }
 
-func (m *TicketBuyerConfigResponse) GetPoolAddress() string {
+func (m *ParticipateSwapRequest) GetSecretHash() []byte {
 	if m != nil {
-		return m.PoolAddress
+		return m.SecretHash
 	}
-	return ""
+	return nil
 }
 
-func (m *TicketBuyerConfigResponse) GetPoolFees() float64 {
+type ParticipateSwapResponse struct {
+	Contract            *SwapContract `protobuf:"bytes,1,opt,name=contract" json:"contract,omitempty"`
+	ContractTransaction []byte        `protobuf:"bytes,2,opt,name=contract_transaction,json=contractTransaction,proto3" json:"contract_transaction,omitempty"`
+}
+
+func (m *ParticipateSwapResponse) Reset()                    { *m = ParticipateSwapResponse{} }
+func (m *ParticipateSwapResponse) String() string            { return proto.CompactTextString(m) }
+func (*ParticipateSwapResponse) ProtoMessage()               {}
+func (*ParticipateSwapResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{80} }
+
+func (m *ParticipateSwapResponse) GetContract() *SwapContract {
 	if m != nil {
-		return m.PoolFees
+		return m.Contract
 	}
-	return 0
+	return nil
 }
 
-func (m *TicketBuyerConfigResponse) GetSpreadTicketPurchases() bool {
+func (m *ParticipateSwapResponse) GetContractTransaction() []byte {
 	if m != nil {
-		return m.SpreadTicketPurchases
+		return m.ContractTransaction
 	}
-	return false
+	return nil
 }
 
-func (m *TicketBuyerConfigResponse) GetVotingAddress() string {
+type RedeemSwapRequest struct {
+	Passphrase      []byte `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	TransactionHash []byte `protobuf:"bytes,2,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	OutputIndex     uint32 `protobuf:"varint,3,opt,name=output_index,json=outputIndex" json:"output_index,omitempty"`
+	Secret          []byte `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (m *RedeemSwapRequest) Reset()                    { *m = RedeemSwapRequest{} }
+func (m *RedeemSwapRequest) String() string            { return proto.CompactTextString(m) }
+func (*RedeemSwapRequest) ProtoMessage()               {}
+func (*RedeemSwapRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{81} }
+
+func (m *RedeemSwapRequest) GetPassphrase() []byte {
 	if m != nil {
-		return m.VotingAddress
+		return m.Passphrase
 	}
-	return ""
+	return nil
 }
 
-func (m *TicketBuyerConfigResponse) GetTxFee() int64 {
+func (m *RedeemSwapRequest) GetTransactionHash() []byte {
 	if m != nil {
-		return m.TxFee
+		return m.TransactionHash
+	}
+	return nil
+}
+
+func (m *RedeemSwapRequest) GetOutputIndex() uint32 {
+	if m != nil {
+		return m.OutputIndex
 	}
 	return 0
 }
 
-type SetAccountRequest struct {
-	Account uint32 `protobuf:"varint,1,opt,name=account" json:"account,omitempty"`
+func (m *RedeemSwapRequest) GetSecret() []byte {
+	if m != nil {
+		return m.Secret
+	}
+	return nil
 }
 
-func (m *SetAccountRequest) Reset()                    { *m = SetAccountRequest{} }
-func (m *SetAccountRequest) String() string            { return proto.CompactTextString(m) }
-func (*SetAccountRequest) ProtoMessage()               {}
-func (*SetAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{85} }
+type RedeemSwapResponse struct {
+	TransactionHash []byte `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+}
 
-func (m *SetAccountRequest) GetAccount() uint32 {
+func (m *RedeemSwapResponse) Reset()                    { *m = RedeemSwapResponse{} }
+func (m *RedeemSwapResponse) String() string            { return proto.CompactTextString(m) }
+func (*RedeemSwapResponse) ProtoMessage()               {}
+func (*RedeemSwapResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{82} }
+
+func (m *RedeemSwapResponse) GetTransactionHash() []byte {
 	if m != nil {
-		return m.Account
+		return m.TransactionHash
 	}
-	return 0
+	return nil
 }
 
-type SetAccountResponse struct {
+type RefundSwapRequest struct {
+	Passphrase      []byte `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	TransactionHash []byte `protobuf:"bytes,2,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	OutputIndex     uint32 `protobuf:"varint,3,opt,name=output_index,json=outputIndex" json:"output_index,omitempty"`
 }
 
-func (m *SetAccountResponse) Reset()                    { *m = SetAccountResponse{} }
-func (m *SetAccountResponse) String() string            { return proto.CompactTextString(m) }
-func (*SetAccountResponse) ProtoMessage()               {}
-func (*SetAccountResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{86} }
+func (m *RefundSwapRequest) Reset()                    { *m = RefundSwapRequest{} }
+func (m *RefundSwapRequest) String() string            { return proto.CompactTextString(m) }
+func (*RefundSwapRequest) ProtoMessage()               {}
+func (*RefundSwapRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{83} }
 
-type SetBalanceToMaintainRequest struct {
-	BalanceToMaintain int64 `protobuf:"varint,1,opt,name=balance_to_maintain,json=balanceToMaintain" json:"balance_to_maintain,omitempty"`
+func (m *RefundSwapRequest) GetPassphrase() []byte {
+	if m != nil {
+		return m.Passphrase
+	}
+	return nil
 }
 
-func (m *SetBalanceToMaintainRequest) Reset()                    { *m = SetBalanceToMaintainRequest{} }
-func (m *SetBalanceToMaintainRequest) String() string            { return proto.CompactTextString(m) }
-func (*SetBalanceToMaintainRequest) ProtoMessage()               {}
-func (*SetBalanceToMaintainRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{87} }
+func (m *RefundSwapRequest) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return nil
+}
 
-func (m *SetBalanceToMaintainRequest) GetBalanceToMaintain() int64 {
+func (m *RefundSwapRequest) GetOutputIndex() uint32 {
 	if m != nil {
-		return m.BalanceToMaintain
+		return m.OutputIndex
 	}
 	return 0
 }
 
-type SetBalanceToMaintainResponse struct {
+type RefundSwapResponse struct {
+	TransactionHash []byte `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
 }
 
-func (m *SetBalanceToMaintainResponse) Reset()                    { *m = SetBalanceToMaintainResponse{} }
-func (m *SetBalanceToMaintainResponse) String() string            { return proto.CompactTextString(m) }
-func (*SetBalanceToMaintainResponse) ProtoMessage()               {}
-func (*SetBalanceToMaintainResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{88} }
+func (m *RefundSwapResponse) Reset()                    { *m = RefundSwapResponse{} }
+func (m *RefundSwapResponse) String() string            { return proto.CompactTextString(m) }
+func (*RefundSwapResponse) ProtoMessage()               {}
+func (*RefundSwapResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{84} }
 
-type SetMaxFeeRequest struct {
-	MaxFeePerKb int64 `protobuf:"varint,1,opt,name=max_fee_per_kb,json=maxFeePerKb" json:"max_fee_per_kb,omitempty"`
+func (m *RefundSwapResponse) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return nil
 }
 
-func (m *SetMaxFeeRequest) Reset()                    { *m = SetMaxFeeRequest{} }
-func (m *SetMaxFeeRequest) String() string            { return proto.CompactTextString(m) }
-func (*SetMaxFeeRequest) ProtoMessage()               {}
-func (*SetMaxFeeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{89} }
+type ExtractSwapSecretRequest struct {
+	Transaction []byte `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	SecretHash  []byte `protobuf:"bytes,2,opt,name=secret_hash,json=secretHash,proto3" json:"secret_hash,omitempty"`
+}
 
-func (m *SetMaxFeeRequest) GetMaxFeePerKb() int64 {
+func (m *ExtractSwapSecretRequest) Reset()                    { *m = ExtractSwapSecretRequest{} }
+func (m *ExtractSwapSecretRequest) String() string            { return proto.CompactTextString(m) }
+func (*ExtractSwapSecretRequest) ProtoMessage()               {}
+func (*ExtractSwapSecretRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{85} }
+
+func (m *ExtractSwapSecretRequest) GetTransaction() []byte {
 	if m != nil {
-		return m.MaxFeePerKb
+		return m.Transaction
 	}
-	return 0
+	return nil
 }
 
-type SetMaxFeeResponse struct {
+func (m *ExtractSwapSecretRequest) GetSecretHash() []byte {
+	if m != nil {
+		return m.SecretHash
+	}
+	return nil
 }
 
-func (m *SetMaxFeeResponse) Reset()                    { *m = SetMaxFeeResponse{} }
-func (m *SetMaxFeeResponse) String() string            { return proto.CompactTextString(m) }
-func (*SetMaxFeeResponse) ProtoMessage()               {}
-func (*SetMaxFeeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{90} }
-
-type SetMaxPriceRelativeRequest struct {
-	MaxPriceRelative float64 `protobuf:"fixed64,1,opt,name=max_price_relative,json=maxPriceRelative" json:"max_price_relative,omitempty"`
+type ExtractSwapSecretResponse struct {
+	Secret []byte `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
 }
 
-func (m *SetMaxPriceRelativeRequest) Reset()                    { *m = SetMaxPriceRelativeRequest{} }
-func (m *SetMaxPriceRelativeRequest) String() string            { return proto.CompactTextString(m) }
-func (*SetMaxPriceRelativeRequest) ProtoMessage()               {}
-func (*SetMaxPriceRelativeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{91} }
+func (m *ExtractSwapSecretResponse) Reset()                    { *m = ExtractSwapSecretResponse{} }
+func (m *ExtractSwapSecretResponse) String() string            { return proto.CompactTextString(m) }
+func (*ExtractSwapSecretResponse) ProtoMessage()               {}
+func (*ExtractSwapSecretResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{86} }
 
-func (m *SetMaxPriceRelativeRequest) GetMaxPriceRelative() float64 {
+func (m *ExtractSwapSecretResponse) GetSecret() []byte {
 	if m != nil {
-		return m.MaxPriceRelative
+		return m.Secret
 	}
-	return 0
+	return nil
 }
 
-type SetMaxPriceRelativeResponse struct {
+type AuditSwapContractRequest struct {
+	Contract            []byte `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	ContractTransaction []byte `protobuf:"bytes,2,opt,name=contract_transaction,json=contractTransaction,proto3" json:"contract_transaction,omitempty"`
+	Watch               bool   `protobuf:"varint,3,opt,name=watch" json:"watch,omitempty"`
 }
 
-func (m *SetMaxPriceRelativeResponse) Reset()                    { *m = SetMaxPriceRelativeResponse{} }
-func (m *SetMaxPriceRelativeResponse) String() string            { return proto.CompactTextString(m) }
-func (*SetMaxPriceRelativeResponse) ProtoMessage()               {}
-func (*SetMaxPriceRelativeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{92} }
+func (m *AuditSwapContractRequest) Reset()                    { *m = AuditSwapContractRequest{} }
+func (m *AuditSwapContractRequest) String() string            { return proto.CompactTextString(m) }
+func (*AuditSwapContractRequest) ProtoMessage()               {}
+func (*AuditSwapContractRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{87} }
 
-type SetMaxPriceAbsoluteRequest struct {
-	MaxPriceAbsolute int64 `protobuf:"varint,1,opt,name=max_price_absolute,json=maxPriceAbsolute" json:"max_price_absolute,omitempty"`
+func (m *AuditSwapContractRequest) GetContract() []byte {
+	if m != nil {
+		return m.Contract
+	}
+	return nil
 }
 
-func (m *SetMaxPriceAbsoluteRequest) Reset()                    { *m = SetMaxPriceAbsoluteRequest{} }
-func (m *SetMaxPriceAbsoluteRequest) String() string            { return proto.CompactTextString(m) }
-func (*SetMaxPriceAbsoluteRequest) ProtoMessage()               {}
-func (*SetMaxPriceAbsoluteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{93} }
-
-func (m *SetMaxPriceAbsoluteRequest) GetMaxPriceAbsolute() int64 {
+func (m *AuditSwapContractRequest) GetContractTransaction() []byte {
 	if m != nil {
-		return m.MaxPriceAbsolute
+		return m.ContractTransaction
 	}
-	return 0
+	return nil
 }
 
-type SetMaxPriceAbsoluteResponse struct {
+func (m *AuditSwapContractRequest) GetWatch() bool {
+	if m != nil {
+		return m.Watch
+	}
+	return false
 }
 
-func (m *SetMaxPriceAbsoluteResponse) Reset()                    { *m = SetMaxPriceAbsoluteResponse{} }
-func (m *SetMaxPriceAbsoluteResponse) String() string            { return proto.CompactTextString(m) }
-func (*SetMaxPriceAbsoluteResponse) ProtoMessage()               {}
-func (*SetMaxPriceAbsoluteResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{94} }
-
-type SetVotingAddressRequest struct {
-	VotingAddress string `protobuf:"bytes,1,opt,name=voting_address,json=votingAddress" json:"voting_address,omitempty"`
+type AuditSwapContractResponse struct {
+	Contract *SwapContract `protobuf:"bytes,1,opt,name=contract" json:"contract,omitempty"`
 }
 
-func (m *SetVotingAddressRequest) Reset()                    { *m = SetVotingAddressRequest{} }
-func (m *SetVotingAddressRequest) String() string            { return proto.CompactTextString(m) }
-func (*SetVotingAddressRequest) ProtoMessage()               {}
-func (*SetVotingAddressRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{95} }
+func (m *AuditSwapContractResponse) Reset()                    { *m = AuditSwapContractResponse{} }
+func (m *AuditSwapContractResponse) String() string            { return proto.CompactTextString(m) }
+func (*AuditSwapContractResponse) ProtoMessage()               {}
+func (*AuditSwapContractResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{88} }
 
-func (m *SetVotingAddressRequest) GetVotingAddress() string {
+func (m *AuditSwapContractResponse) GetContract() *SwapContract {
 	if m != nil {
-		return m.VotingAddress
+		return m.Contract
 	}
-	return ""
+	return nil
 }
 
-type SetVotingAddressResponse struct {
+type SwapContractsRequest struct {
 }
 
-func (m *SetVotingAddressResponse) Reset()                    { *m = SetVotingAddressResponse{} }
-func (m *SetVotingAddressResponse) String() string            { return proto.CompactTextString(m) }
-func (*SetVotingAddressResponse) ProtoMessage()               {}
-func (*SetVotingAddressResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{96} }
+func (m *SwapContractsRequest) Reset()                    { *m = SwapContractsRequest{} }
+func (m *SwapContractsRequest) String() string            { return proto.CompactTextString(m) }
+func (*SwapContractsRequest) ProtoMessage()               {}
+func (*SwapContractsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{89} }
 
-type SetPoolAddressRequest struct {
-	PoolAddress string `protobuf:"bytes,1,opt,name=pool_address,json=poolAddress" json:"pool_address,omitempty"`
+type SwapContractsResponse struct {
+	Contracts []*SwapContract `protobuf:"bytes,1,rep,name=contracts" json:"contracts,omitempty"`
 }
 
-func (m *SetPoolAddressRequest) Reset()                    { *m = SetPoolAddressRequest{} }
-func (m *SetPoolAddressRequest) String() string            { return proto.CompactTextString(m) }
-func (*SetPoolAddressRequest) ProtoMessage()               {}
-func (*SetPoolAddressRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{97} }
+func (m *SwapContractsResponse) Reset()                    { *m = SwapContractsResponse{} }
+func (m *SwapContractsResponse) String() string            { return proto.CompactTextString(m) }
+func (*SwapContractsResponse) ProtoMessage()               {}
+func (*SwapContractsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{90} }
 
-func (m *SetPoolAddressRequest) GetPoolAddress() string {
+func (m *SwapContractsResponse) GetContracts() []*SwapContract {
 	if m != nil {
-		return m.PoolAddress
+		return m.Contracts
 	}
-	return ""
+	return nil
 }
 
-type SetPoolAddressResponse struct {
+type Vault struct {
+	TransactionHash         []byte         `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	OutputIndex             uint32         `protobuf:"varint,2,opt,name=output_index,json=outputIndex" json:"output_index,omitempty"`
+	Account                 uint32         `protobuf:"varint,3,opt,name=account" json:"account,omitempty"`
+	Amount                  int64          `protobuf:"varint,4,opt,name=amount" json:"amount,omitempty"`
+	Script                  []byte         `protobuf:"bytes,5,opt,name=script,proto3" json:"script,omitempty"`
+	Address                 string         `protobuf:"bytes,6,opt,name=address" json:"address,omitempty"`
+	KeyAddress              string         `protobuf:"bytes,7,opt,name=key_address,json=keyAddress" json:"key_address,omitempty"`
+	LockType                Vault_LockType `protobuf:"varint,8,opt,name=lock_type,json=lockType,enum=walletrpc.Vault_LockType" json:"lock_type,omitempty"`
+	LockValue               int64          `protobuf:"varint,9,opt,name=lock_value,json=lockValue" json:"lock_value,omitempty"`
+	Unlocked                bool           `protobuf:"varint,10,opt,name=unlocked" json:"unlocked,omitempty"`
+	Created                 int64          `protobuf:"varint,11,opt,name=created" json:"created,omitempty"`
+	SpendingTransactionHash []byte         `protobuf:"bytes,12,opt,name=spending_transaction_hash,json=spendingTransactionHash,proto3" json:"spending_transaction_hash,omitempty"`
 }
 
-func (m *SetPoolAddressResponse) Reset()                    { *m = SetPoolAddressResponse{} }
-func (m *SetPoolAddressResponse) String() string            { return proto.CompactTextString(m) }
-func (*SetPoolAddressResponse) ProtoMessage()               {}
-func (*SetPoolAddressResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{98} }
+func (m *Vault) Reset()                    { *m = Vault{} }
+func (m *Vault) String() string            { return proto.CompactTextString(m) }
+func (*Vault) ProtoMessage()               {}
+func (*Vault) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{91} }
 
-type SetPoolFeesRequest struct {
-	PoolFees float64 `protobuf:"fixed64,1,opt,name=pool_fees,json=poolFees" json:"pool_fees,omitempty"`
+func (m *Vault) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return nil
 }
 
-func (m *SetPoolFeesRequest) Reset()                    { *m = SetPoolFeesRequest{} }
-func (m *SetPoolFeesRequest) String() string            { return proto.CompactTextString(m) }
-func (*SetPoolFeesRequest) ProtoMessage()               {}
-func (*SetPoolFeesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{99} }
-
-func (m *SetPoolFeesRequest) GetPoolFees() float64 {
+func (m *Vault) GetOutputIndex() uint32 {
 	if m != nil {
-		return m.PoolFees
+		return m.OutputIndex
 	}
 	return 0
 }
 
-type SetPoolFeesResponse struct {
-}
-
-func (m *SetPoolFeesResponse) Reset()                    { *m = SetPoolFeesResponse{} }
-func (m *SetPoolFeesResponse) String() string            { return proto.CompactTextString(m) }
-func (*SetPoolFeesResponse) ProtoMessage()               {}
-func (*SetPoolFeesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{100} }
-
-type SetMaxPerBlockRequest struct {
-	MaxPerBlock int64 `protobuf:"varint,1,opt,name=max_per_block,json=maxPerBlock" json:"max_per_block,omitempty"`
-}
-
-func (m *SetMaxPerBlockRequest) Reset()                    { *m = SetMaxPerBlockRequest{} }
-func (m *SetMaxPerBlockRequest) String() string            { return proto.CompactTextString(m) }
-func (*SetMaxPerBlockRequest) ProtoMessage()               {}
-func (*SetMaxPerBlockRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{101} }
-
-func (m *SetMaxPerBlockRequest) GetMaxPerBlock() int64 {
+func (m *Vault) GetAccount() uint32 {
 	if m != nil {
-		return m.MaxPerBlock
+		return m.Account
 	}
 	return 0
 }
 
-type SetMaxPerBlockResponse struct {
-}
-
-func (m *SetMaxPerBlockResponse) Reset()                    { *m = SetMaxPerBlockResponse{} }
-func (m *SetMaxPerBlockResponse) String() string            { return proto.CompactTextString(m) }
-func (*SetMaxPerBlockResponse) ProtoMessage()               {}
-func (*SetMaxPerBlockResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{102} }
-
-type AgendasRequest struct {
-}
-
-func (m *AgendasRequest) Reset()                    { *m = AgendasRequest{} }
-func (m *AgendasRequest) String() string            { return proto.CompactTextString(m) }
-func (*AgendasRequest) ProtoMessage()               {}
-func (*AgendasRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{103} }
-
-type AgendasResponse struct {
-	Version uint32                    `protobuf:"varint,1,opt,name=version" json:"version,omitempty"`
-	Agendas []*AgendasResponse_Agenda `protobuf:"bytes,2,rep,name=agendas" json:"agendas,omitempty"`
-}
-
-func (m *AgendasResponse) Reset()                    { *m = AgendasResponse{} }
-func (m *AgendasResponse) String() string            { return proto.CompactTextString(m) }
-func (*AgendasResponse) ProtoMessage()               {}
-func (*AgendasResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{104} }
-
-func (m *AgendasResponse) GetVersion() uint32 {
+func (m *Vault) GetAmount() int64 {
 	if m != nil {
-		return m.Version
+		return m.Amount
 	}
 	return 0
 }
 
-func (m *AgendasResponse) GetAgendas() []*AgendasResponse_Agenda {
+func (m *Vault) GetScript() []byte {
 	if m != nil {
-		return m.Agendas
+		return m.Script
 	}
 	return nil
 }
 
-type AgendasResponse_Agenda struct {
-	Id          string                    `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
-	Description string                    `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
-	Mask        uint32                    `protobuf:"varint,3,opt,name=mask" json:"mask,omitempty"`
-	Choices     []*AgendasResponse_Choice `protobuf:"bytes,4,rep,name=choices" json:"choices,omitempty"`
-	StartTime   int64                     `protobuf:"varint,5,opt,name=start_time,json=startTime" json:"start_time,omitempty"`
-	ExpireTime  int64                     `protobuf:"varint,6,opt,name=expire_time,json=expireTime" json:"expire_time,omitempty"`
+func (m *Vault) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *AgendasResponse_Agenda) Reset()                    { *m = AgendasResponse_Agenda{} }
-func (m *AgendasResponse_Agenda) String() string            { return proto.CompactTextString(m) }
-func (*AgendasResponse_Agenda) ProtoMessage()               {}
-func (*AgendasResponse_Agenda) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{104, 0} }
-
-func (m *AgendasResponse_Agenda) GetId() string {
+func (m *Vault) GetKeyAddress() string {
 	if m != nil {
-		return m.Id
+		return m.KeyAddress
 	}
 	return ""
 }
 
-func (m *AgendasResponse_Agenda) GetDescription() string {
+func (m *Vault) GetLockType() Vault_LockType {
 	if m != nil {
-		return m.Description
+		return m.LockType
 	}
-	return ""
+	return Vault_HEIGHT
 }
 
-func (m *AgendasResponse_Agenda) GetMask() uint32 {
+func (m *Vault) GetLockValue() int64 {
 	if m != nil {
-		return m.Mask
+		return m.LockValue
 	}
 	return 0
 }
 
-func (m *AgendasResponse_Agenda) GetChoices() []*AgendasResponse_Choice {
+func (m *Vault) GetUnlocked() bool {
 	if m != nil {
-		return m.Choices
+		return m.Unlocked
 	}
-	return nil
+	return false
 }
 
-func (m *AgendasResponse_Agenda) GetStartTime() int64 {
+func (m *Vault) GetCreated() int64 {
 	if m != nil {
-		return m.StartTime
+		return m.Created
 	}
 	return 0
 }
 
-func (m *AgendasResponse_Agenda) GetExpireTime() int64 {
+func (m *Vault) GetSpendingTransactionHash() []byte {
 	if m != nil {
-		return m.ExpireTime
+		return m.SpendingTransactionHash
 	}
-	return 0
+	return nil
 }
 
-type AgendasResponse_Choice struct {
-	Id          string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
-	Description string `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
-	Bits        uint32 `protobuf:"varint,3,opt,name=bits" json:"bits,omitempty"`
-	IsAbstain   bool   `protobuf:"varint,4,opt,name=is_abstain,json=isAbstain" json:"is_abstain,omitempty"`
-	IsNo        bool   `protobuf:"varint,5,opt,name=is_no,json=isNo" json:"is_no,omitempty"`
+type CreateVaultRequest struct {
+	Passphrase []byte         `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	Account    uint32         `protobuf:"varint,2,opt,name=account" json:"account,omitempty"`
+	Amount     int64          `protobuf:"varint,3,opt,name=amount" json:"amount,omitempty"`
+	LockType   Vault_LockType `protobuf:"varint,4,opt,name=lock_type,json=lockType,enum=walletrpc.Vault_LockType" json:"lock_type,omitempty"`
+	LockValue  int64          `protobuf:"varint,5,opt,name=lock_value,json=lockValue" json:"lock_value,omitempty"`
 }
 
-func (m *AgendasResponse_Choice) Reset()                    { *m = AgendasResponse_Choice{} }
-func (m *AgendasResponse_Choice) String() string            { return proto.CompactTextString(m) }
-func (*AgendasResponse_Choice) ProtoMessage()               {}
-func (*AgendasResponse_Choice) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{104, 1} }
+func (m *CreateVaultRequest) Reset()                    { *m = CreateVaultRequest{} }
+func (m *CreateVaultRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateVaultRequest) ProtoMessage()               {}
+func (*CreateVaultRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{92} }
 
-func (m *AgendasResponse_Choice) GetId() string {
+func (m *CreateVaultRequest) GetPassphrase() []byte {
 	if m != nil {
-		return m.Id
+		return m.Passphrase
 	}
-	return ""
+	return nil
 }
 
-func (m *AgendasResponse_Choice) GetDescription() string {
+func (m *CreateVaultRequest) GetAccount() uint32 {
 	if m != nil {
-		return m.Description
+		return m.Account
 	}
-	return ""
+	return 0
 }
 
-func (m *AgendasResponse_Choice) GetBits() uint32 {
+func (m *CreateVaultRequest) GetAmount() int64 {
 	if m != nil {
-		return m.Bits
+		return m.Amount
 	}
 	return 0
 }
 
-func (m *AgendasResponse_Choice) GetIsAbstain() bool {
+func (m *CreateVaultRequest) GetLockType() Vault_LockType {
 	if m != nil {
-		return m.IsAbstain
+		return m.LockType
 	}
-	return false
+	return Vault_HEIGHT
 }
 
-func (m *AgendasResponse_Choice) GetIsNo() bool {
+func (m *CreateVaultRequest) GetLockValue() int64 {
 	if m != nil {
-		return m.IsNo
+		return m.LockValue
 	}
-	return false
-}
-
-type VoteChoicesRequest struct {
+	return 0
 }
 
-func (m *VoteChoicesRequest) Reset()                    { *m = VoteChoicesRequest{} }
-func (m *VoteChoicesRequest) String() string            { return proto.CompactTextString(m) }
-func (*VoteChoicesRequest) ProtoMessage()               {}
-func (*VoteChoicesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{105} }
-
-type VoteChoicesResponse struct {
-	Version  uint32                        `protobuf:"varint,1,opt,name=version" json:"version,omitempty"`
-	Choices  []*VoteChoicesResponse_Choice `protobuf:"bytes,2,rep,name=choices" json:"choices,omitempty"`
-	Votebits uint32                        `protobuf:"varint,3,opt,name=votebits" json:"votebits,omitempty"`
+type CreateVaultResponse struct {
+	Vault            *Vault `protobuf:"bytes,1,opt,name=vault" json:"vault,omitempty"`
+	VaultTransaction []byte `protobuf:"bytes,2,opt,name=vault_transaction,json=vaultTransaction,proto3" json:"vault_transaction,omitempty"`
 }
 
-func (m *VoteChoicesResponse) Reset()                    { *m = VoteChoicesResponse{} }
-func (m *VoteChoicesResponse) String() string            { return proto.CompactTextString(m) }
-func (*VoteChoicesResponse) ProtoMessage()               {}
-func (*VoteChoicesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{106} }
+func (m *CreateVaultResponse) Reset()                    { *m = CreateVaultResponse{} }
+func (m *CreateVaultResponse) String() string            { return proto.CompactTextString(m) }
+func (*CreateVaultResponse) ProtoMessage()               {}
+func (*CreateVaultResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{93} }
 
-func (m *VoteChoicesResponse) GetVersion() uint32 {
+func (m *CreateVaultResponse) GetVault() *Vault {
 	if m != nil {
-		return m.Version
+		return m.Vault
 	}
-	return 0
+	return nil
 }
 
-func (m *VoteChoicesResponse) GetChoices() []*VoteChoicesResponse_Choice {
+func (m *CreateVaultResponse) GetVaultTransaction() []byte {
 	if m != nil {
-		return m.Choices
+		return m.VaultTransaction
 	}
 	return nil
 }
 
-func (m *VoteChoicesResponse) GetVotebits() uint32 {
-	if m != nil {
-		return m.Votebits
-	}
-	return 0
+type VaultsRequest struct {
 }
 
-type VoteChoicesResponse_Choice struct {
-	AgendaId          string `protobuf:"bytes,1,opt,name=agenda_id,json=agendaId" json:"agenda_id,omitempty"`
-	AgendaDescription string `protobuf:"bytes,2,opt,name=agenda_description,json=agendaDescription" json:"agenda_description,omitempty"`
-	ChoiceId          string `protobuf:"bytes,3,opt,name=choice_id,json=choiceId" json:"choice_id,omitempty"`
-	ChoiceDescription string `protobuf:"bytes,4,opt,name=choice_description,json=choiceDescription" json:"choice_description,omitempty"`
+func (m *VaultsRequest) Reset()                    { *m = VaultsRequest{} }
+func (m *VaultsRequest) String() string            { return proto.CompactTextString(m) }
+func (*VaultsRequest) ProtoMessage()               {}
+func (*VaultsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{94} }
+
+type VaultsResponse struct {
+	Vaults []*Vault `protobuf:"bytes,1,rep,name=vaults" json:"vaults,omitempty"`
 }
 
-func (m *VoteChoicesResponse_Choice) Reset()                    { *m = VoteChoicesResponse_Choice{} }
-func (m *VoteChoicesResponse_Choice) String() string            { return proto.CompactTextString(m) }
-func (*VoteChoicesResponse_Choice) ProtoMessage()               {}
-func (*VoteChoicesResponse_Choice) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{106, 0} }
+func (m *VaultsResponse) Reset()                    { *m = VaultsResponse{} }
+func (m *VaultsResponse) String() string            { return proto.CompactTextString(m) }
+func (*VaultsResponse) ProtoMessage()               {}
+func (*VaultsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{95} }
 
-func (m *VoteChoicesResponse_Choice) GetAgendaId() string {
+func (m *VaultsResponse) GetVaults() []*Vault {
 	if m != nil {
-		return m.AgendaId
+		return m.Vaults
 	}
-	return ""
+	return nil
 }
 
-func (m *VoteChoicesResponse_Choice) GetAgendaDescription() string {
-	if m != nil {
-		return m.AgendaDescription
-	}
-	return ""
+type PurchaseTicketsRequest struct {
+	Passphrase            []byte  `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	Account               uint32  `protobuf:"varint,2,opt,name=account" json:"account,omitempty"`
+	SpendLimit            int64   `protobuf:"varint,3,opt,name=spend_limit,json=spendLimit" json:"spend_limit,omitempty"`
+	RequiredConfirmations uint32  `protobuf:"varint,4,opt,name=required_confirmations,json=requiredConfirmations" json:"required_confirmations,omitempty"`
+	TicketAddress         string  `protobuf:"bytes,5,opt,name=ticket_address,json=ticketAddress" json:"ticket_address,omitempty"`
+	NumTickets            uint32  `protobuf:"varint,6,opt,name=num_tickets,json=numTickets" json:"num_tickets,omitempty"`
+	PoolAddress           string  `protobuf:"bytes,7,opt,name=pool_address,json=poolAddress" json:"pool_address,omitempty"`
+	PoolFees              float64 `protobuf:"fixed64,8,opt,name=pool_fees,json=poolFees" json:"pool_fees,omitempty"`
+	Expiry                uint32  `protobuf:"varint,9,opt,name=expiry" json:"expiry,omitempty"`
+	TxFee                 int64   `protobuf:"varint,10,opt,name=tx_fee,json=txFee" json:"tx_fee,omitempty"`
+	TicketFee             int64   `protobuf:"varint,11,opt,name=ticket_fee,json=ticketFee" json:"ticket_fee,omitempty"`
+	DryRun                bool    `protobuf:"varint,12,opt,name=dry_run,json=dryRun" json:"dry_run,omitempty"`
 }
 
-func (m *VoteChoicesResponse_Choice) GetChoiceId() string {
+func (m *PurchaseTicketsRequest) Reset()                    { *m = PurchaseTicketsRequest{} }
+func (m *PurchaseTicketsRequest) String() string            { return proto.CompactTextString(m) }
+func (*PurchaseTicketsRequest) ProtoMessage()               {}
+func (*PurchaseTicketsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{96} }
+
+func (m *PurchaseTicketsRequest) GetPassphrase() []byte {
 	if m != nil {
-		return m.ChoiceId
+		return m.Passphrase
 	}
-	return ""
+	return nil
 }
 
-func (m *VoteChoicesResponse_Choice) GetChoiceDescription() string {
+func (m *PurchaseTicketsRequest) GetAccount() uint32 {
 	if m != nil {
-		return m.ChoiceDescription
+		return m.Account
 	}
-	return ""
+	return 0
 }
 
-type SetVoteChoicesRequest struct {
-	Choices []*SetVoteChoicesRequest_Choice `protobuf:"bytes,1,rep,name=choices" json:"choices,omitempty"`
+func (m *PurchaseTicketsRequest) GetSpendLimit() int64 {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return 0
 }
 
-func (m *SetVoteChoicesRequest) Reset()                    { *m = SetVoteChoicesRequest{} }
-func (m *SetVoteChoicesRequest) String() string            { return proto.CompactTextString(m) }
-func (*SetVoteChoicesRequest) ProtoMessage()               {}
-func (*SetVoteChoicesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{107} }
-
-func (m *SetVoteChoicesRequest) GetChoices() []*SetVoteChoicesRequest_Choice {
+func (m *PurchaseTicketsRequest) GetRequiredConfirmations() uint32 {
 	if m != nil {
-		return m.Choices
+		return m.RequiredConfirmations
 	}
-	return nil
+	return 0
 }
 
-type SetVoteChoicesRequest_Choice struct {
-	AgendaId string `protobuf:"bytes,1,opt,name=agenda_id,json=agendaId" json:"agenda_id,omitempty"`
-	ChoiceId string `protobuf:"bytes,2,opt,name=choice_id,json=choiceId" json:"choice_id,omitempty"`
+func (m *PurchaseTicketsRequest) GetTicketAddress() string {
+	if m != nil {
+		return m.TicketAddress
+	}
+	return ""
 }
 
-func (m *SetVoteChoicesRequest_Choice) Reset()         { *m = SetVoteChoicesRequest_Choice{} }
-func (m *SetVoteChoicesRequest_Choice) String() string { return proto.CompactTextString(m) }
-func (*SetVoteChoicesRequest_Choice) ProtoMessage()    {}
-func (*SetVoteChoicesRequest_Choice) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{107, 0}
+func (m *PurchaseTicketsRequest) GetNumTickets() uint32 {
+	if m != nil {
+		return m.NumTickets
+	}
+	return 0
 }
 
-func (m *SetVoteChoicesRequest_Choice) GetAgendaId() string {
+func (m *PurchaseTicketsRequest) GetPoolAddress() string {
 	if m != nil {
-		return m.AgendaId
+		return m.PoolAddress
 	}
 	return ""
 }
 
-func (m *SetVoteChoicesRequest_Choice) GetChoiceId() string {
+func (m *PurchaseTicketsRequest) GetPoolFees() float64 {
 	if m != nil {
-		return m.ChoiceId
+		return m.PoolFees
 	}
-	return ""
+	return 0
 }
 
-type SetVoteChoicesResponse struct {
-	Votebits uint32 `protobuf:"varint,1,opt,name=votebits" json:"votebits,omitempty"`
+func (m *PurchaseTicketsRequest) GetExpiry() uint32 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
 }
 
-func (m *SetVoteChoicesResponse) Reset()                    { *m = SetVoteChoicesResponse{} }
-func (m *SetVoteChoicesResponse) String() string            { return proto.CompactTextString(m) }
-func (*SetVoteChoicesResponse) ProtoMessage()               {}
-func (*SetVoteChoicesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{108} }
+func (m *PurchaseTicketsRequest) GetTxFee() int64 {
+	if m != nil {
+		return m.TxFee
+	}
+	return 0
+}
 
-func (m *SetVoteChoicesResponse) GetVotebits() uint32 {
+func (m *PurchaseTicketsRequest) GetTicketFee() int64 {
 	if m != nil {
-		return m.Votebits
+		return m.TicketFee
 	}
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*VersionRequest)(nil), "walletrpc.VersionRequest")
+func (m *PurchaseTicketsRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type PurchaseTicketsResponse struct {
+	TicketHashes [][]byte                         `protobuf:"bytes,1,rep,name=ticket_hashes,json=ticketHashes,proto3" json:"ticket_hashes,omitempty"`
+	Preview      *PurchaseTicketsResponse_Preview `protobuf:"bytes,2,opt,name=preview" json:"preview,omitempty"`
+}
+
+func (m *PurchaseTicketsResponse) Reset()                    { *m = PurchaseTicketsResponse{} }
+func (m *PurchaseTicketsResponse) String() string            { return proto.CompactTextString(m) }
+func (*PurchaseTicketsResponse) ProtoMessage()               {}
+func (*PurchaseTicketsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{97} }
+
+func (m *PurchaseTicketsResponse) GetTicketHashes() [][]byte {
+	if m != nil {
+		return m.TicketHashes
+	}
+	return nil
+}
+
+func (m *PurchaseTicketsResponse) GetPreview() *PurchaseTicketsResponse_Preview {
+	if m != nil {
+		return m.Preview
+	}
+	return nil
+}
+
+type PurchaseTicketsResponse_Preview struct {
+	UnsignedSplitTransaction       []byte `protobuf:"bytes,1,opt,name=unsigned_split_transaction,json=unsignedSplitTransaction,proto3" json:"unsigned_split_transaction,omitempty"`
+	SplitTotalPreviousOutputAmount int64  `protobuf:"varint,2,opt,name=split_total_previous_output_amount,json=splitTotalPreviousOutputAmount" json:"split_total_previous_output_amount,omitempty"`
+	SplitEstimatedSignedSize       uint32 `protobuf:"varint,3,opt,name=split_estimated_signed_size,json=splitEstimatedSignedSize" json:"split_estimated_signed_size,omitempty"`
+	SplitFee                       int64  `protobuf:"varint,4,opt,name=split_fee,json=splitFee" json:"split_fee,omitempty"`
+	SplitFeeRate                   int64  `protobuf:"varint,5,opt,name=split_fee_rate,json=splitFeeRate" json:"split_fee_rate,omitempty"`
+	NumTickets                     uint32 `protobuf:"varint,6,opt,name=num_tickets,json=numTickets" json:"num_tickets,omitempty"`
+	TicketPrice                    int64  `protobuf:"varint,7,opt,name=ticket_price,json=ticketPrice" json:"ticket_price,omitempty"`
+	TicketFee                      int64  `protobuf:"varint,8,opt,name=ticket_fee,json=ticketFee" json:"ticket_fee,omitempty"`
+	TicketFeeRate                  int64  `protobuf:"varint,9,opt,name=ticket_fee_rate,json=ticketFeeRate" json:"ticket_fee_rate,omitempty"`
+	EstimatedTicketSize            uint32 `protobuf:"varint,10,opt,name=estimated_ticket_size,json=estimatedTicketSize" json:"estimated_ticket_size,omitempty"`
+	PoolFee                        int64  `protobuf:"varint,11,opt,name=pool_fee,json=poolFee" json:"pool_fee,omitempty"`
+}
+
+func (m *PurchaseTicketsResponse_Preview) Reset()         { *m = PurchaseTicketsResponse_Preview{} }
+func (m *PurchaseTicketsResponse_Preview) String() string { return proto.CompactTextString(m) }
+func (*PurchaseTicketsResponse_Preview) ProtoMessage()    {}
+func (*PurchaseTicketsResponse_Preview) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{97, 0}
+}
+
+func (m *PurchaseTicketsResponse_Preview) GetUnsignedSplitTransaction() []byte {
+	if m != nil {
+		return m.UnsignedSplitTransaction
+	}
+	return nil
+}
+
+func (m *PurchaseTicketsResponse_Preview) GetSplitTotalPreviousOutputAmount() int64 {
+	if m != nil {
+		return m.SplitTotalPreviousOutputAmount
+	}
+	return 0
+}
+
+func (m *PurchaseTicketsResponse_Preview) GetSplitEstimatedSignedSize() uint32 {
+	if m != nil {
+		return m.SplitEstimatedSignedSize
+	}
+	return 0
+}
+
+func (m *PurchaseTicketsResponse_Preview) GetSplitFee() int64 {
+	if m != nil {
+		return m.SplitFee
+	}
+	return 0
+}
+
+func (m *PurchaseTicketsResponse_Preview) GetSplitFeeRate() int64 {
+	if m != nil {
+		return m.SplitFeeRate
+	}
+	return 0
+}
+
+func (m *PurchaseTicketsResponse_Preview) GetNumTickets() uint32 {
+	if m != nil {
+		return m.NumTickets
+	}
+	return 0
+}
+
+func (m *PurchaseTicketsResponse_Preview) GetTicketPrice() int64 {
+	if m != nil {
+		return m.TicketPrice
+	}
+	return 0
+}
+
+func (m *PurchaseTicketsResponse_Preview) GetTicketFee() int64 {
+	if m != nil {
+		return m.TicketFee
+	}
+	return 0
+}
+
+func (m *PurchaseTicketsResponse_Preview) GetTicketFeeRate() int64 {
+	if m != nil {
+		return m.TicketFeeRate
+	}
+	return 0
+}
+
+func (m *PurchaseTicketsResponse_Preview) GetEstimatedTicketSize() uint32 {
+	if m != nil {
+		return m.EstimatedTicketSize
+	}
+	return 0
+}
+
+func (m *PurchaseTicketsResponse_Preview) GetPoolFee() int64 {
+	if m != nil {
+		return m.PoolFee
+	}
+	return 0
+}
+
+type RevokeTicketsRequest struct {
+	Passphrase []byte `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+}
+
+func (m *RevokeTicketsRequest) Reset()                    { *m = RevokeTicketsRequest{} }
+func (m *RevokeTicketsRequest) String() string            { return proto.CompactTextString(m) }
+func (*RevokeTicketsRequest) ProtoMessage()               {}
+func (*RevokeTicketsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{98} }
+
+func (m *RevokeTicketsRequest) GetPassphrase() []byte {
+	if m != nil {
+		return m.Passphrase
+	}
+	return nil
+}
+
+type RevokeTicketsResponse struct {
+}
+
+func (m *RevokeTicketsResponse) Reset()                    { *m = RevokeTicketsResponse{} }
+func (m *RevokeTicketsResponse) String() string            { return proto.CompactTextString(m) }
+func (*RevokeTicketsResponse) ProtoMessage()               {}
+func (*RevokeTicketsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{99} }
+
+type LoadActiveDataFiltersRequest struct {
+}
+
+func (m *LoadActiveDataFiltersRequest) Reset()                    { *m = LoadActiveDataFiltersRequest{} }
+func (m *LoadActiveDataFiltersRequest) String() string            { return proto.CompactTextString(m) }
+func (*LoadActiveDataFiltersRequest) ProtoMessage()               {}
+func (*LoadActiveDataFiltersRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{100} }
+
+type LoadActiveDataFiltersResponse struct {
+}
+
+func (m *LoadActiveDataFiltersResponse) Reset()         { *m = LoadActiveDataFiltersResponse{} }
+func (m *LoadActiveDataFiltersResponse) String() string { return proto.CompactTextString(m) }
+func (*LoadActiveDataFiltersResponse) ProtoMessage()    {}
+func (*LoadActiveDataFiltersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{101}
+}
+
+type LockedOutpointsRequest struct {
+}
+
+func (m *LockedOutpointsRequest) Reset()                    { *m = LockedOutpointsRequest{} }
+func (m *LockedOutpointsRequest) String() string            { return proto.CompactTextString(m) }
+func (*LockedOutpointsRequest) ProtoMessage()               {}
+func (*LockedOutpointsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{102} }
+
+type LockedOutpointsResponse struct {
+	LockedOutpoints []*LockedOutpointsResponse_LockedOutpoint `protobuf:"bytes,1,rep,name=locked_outpoints,json=lockedOutpoints" json:"locked_outpoints,omitempty"`
+}
+
+func (m *LockedOutpointsResponse) Reset()                    { *m = LockedOutpointsResponse{} }
+func (m *LockedOutpointsResponse) String() string            { return proto.CompactTextString(m) }
+func (*LockedOutpointsResponse) ProtoMessage()               {}
+func (*LockedOutpointsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{103} }
+
+func (m *LockedOutpointsResponse) GetLockedOutpoints() []*LockedOutpointsResponse_LockedOutpoint {
+	if m != nil {
+		return m.LockedOutpoints
+	}
+	return nil
+}
+
+type LockedOutpointsResponse_LockedOutpoint struct {
+	TransactionHash []byte `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	OutputIndex     uint32 `protobuf:"varint,2,opt,name=output_index,json=outputIndex" json:"output_index,omitempty"`
+	Tree            int32  `protobuf:"varint,3,opt,name=tree" json:"tree,omitempty"`
+	Reason          string `protobuf:"bytes,4,opt,name=reason" json:"reason,omitempty"`
+	ExpiryHeight    int32  `protobuf:"varint,5,opt,name=expiry_height,json=expiryHeight" json:"expiry_height,omitempty"`
+}
+
+func (m *LockedOutpointsResponse_LockedOutpoint) Reset() {
+	*m = LockedOutpointsResponse_LockedOutpoint{}
+}
+func (m *LockedOutpointsResponse_LockedOutpoint) String() string { return proto.CompactTextString(m) }
+func (*LockedOutpointsResponse_LockedOutpoint) ProtoMessage()    {}
+func (*LockedOutpointsResponse_LockedOutpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{103, 0}
+}
+
+func (m *LockedOutpointsResponse_LockedOutpoint) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return nil
+}
+
+func (m *LockedOutpointsResponse_LockedOutpoint) GetOutputIndex() uint32 {
+	if m != nil {
+		return m.OutputIndex
+	}
+	return 0
+}
+
+func (m *LockedOutpointsResponse_LockedOutpoint) GetTree() int32 {
+	if m != nil {
+		return m.Tree
+	}
+	return 0
+}
+
+func (m *LockedOutpointsResponse_LockedOutpoint) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *LockedOutpointsResponse_LockedOutpoint) GetExpiryHeight() int32 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+type LockOutpointRequest struct {
+	TransactionHash []byte `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	OutputIndex     uint32 `protobuf:"varint,2,opt,name=output_index,json=outputIndex" json:"output_index,omitempty"`
+	Tree            int32  `protobuf:"varint,3,opt,name=tree" json:"tree,omitempty"`
+	Reason          string `protobuf:"bytes,4,opt,name=reason" json:"reason,omitempty"`
+	ExpiryHeight    int32  `protobuf:"varint,5,opt,name=expiry_height,json=expiryHeight" json:"expiry_height,omitempty"`
+}
+
+func (m *LockOutpointRequest) Reset()                    { *m = LockOutpointRequest{} }
+func (m *LockOutpointRequest) String() string            { return proto.CompactTextString(m) }
+func (*LockOutpointRequest) ProtoMessage()               {}
+func (*LockOutpointRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{104} }
+
+func (m *LockOutpointRequest) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return nil
+}
+
+func (m *LockOutpointRequest) GetOutputIndex() uint32 {
+	if m != nil {
+		return m.OutputIndex
+	}
+	return 0
+}
+
+func (m *LockOutpointRequest) GetTree() int32 {
+	if m != nil {
+		return m.Tree
+	}
+	return 0
+}
+
+func (m *LockOutpointRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *LockOutpointRequest) GetExpiryHeight() int32 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+type LockOutpointResponse struct {
+}
+
+func (m *LockOutpointResponse) Reset()                    { *m = LockOutpointResponse{} }
+func (m *LockOutpointResponse) String() string            { return proto.CompactTextString(m) }
+func (*LockOutpointResponse) ProtoMessage()               {}
+func (*LockOutpointResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{105} }
+
+type UnlockOutpointRequest struct {
+	TransactionHash []byte `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	OutputIndex     uint32 `protobuf:"varint,2,opt,name=output_index,json=outputIndex" json:"output_index,omitempty"`
+	Tree            int32  `protobuf:"varint,3,opt,name=tree" json:"tree,omitempty"`
+}
+
+func (m *UnlockOutpointRequest) Reset()                    { *m = UnlockOutpointRequest{} }
+func (m *UnlockOutpointRequest) String() string            { return proto.CompactTextString(m) }
+func (*UnlockOutpointRequest) ProtoMessage()               {}
+func (*UnlockOutpointRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{106} }
+
+func (m *UnlockOutpointRequest) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return nil
+}
+
+func (m *UnlockOutpointRequest) GetOutputIndex() uint32 {
+	if m != nil {
+		return m.OutputIndex
+	}
+	return 0
+}
+
+func (m *UnlockOutpointRequest) GetTree() int32 {
+	if m != nil {
+		return m.Tree
+	}
+	return 0
+}
+
+type UnlockOutpointResponse struct {
+}
+
+func (m *UnlockOutpointResponse) Reset()                    { *m = UnlockOutpointResponse{} }
+func (m *UnlockOutpointResponse) String() string            { return proto.CompactTextString(m) }
+func (*UnlockOutpointResponse) ProtoMessage()               {}
+func (*UnlockOutpointResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{107} }
+
+type TransactionLabelRequest struct {
+	TransactionHash []byte `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+}
+
+func (m *TransactionLabelRequest) Reset()                    { *m = TransactionLabelRequest{} }
+func (m *TransactionLabelRequest) String() string            { return proto.CompactTextString(m) }
+func (*TransactionLabelRequest) ProtoMessage()               {}
+func (*TransactionLabelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{108} }
+
+func (m *TransactionLabelRequest) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return nil
+}
+
+type TransactionLabelResponse struct {
+	Label string `protobuf:"bytes,1,opt,name=label" json:"label,omitempty"`
+}
+
+func (m *TransactionLabelResponse) Reset()                    { *m = TransactionLabelResponse{} }
+func (m *TransactionLabelResponse) String() string            { return proto.CompactTextString(m) }
+func (*TransactionLabelResponse) ProtoMessage()               {}
+func (*TransactionLabelResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{109} }
+
+func (m *TransactionLabelResponse) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+type SearchTransactionLabelsRequest struct {
+	Query string `protobuf:"bytes,1,opt,name=query" json:"query,omitempty"`
+}
+
+func (m *SearchTransactionLabelsRequest) Reset()         { *m = SearchTransactionLabelsRequest{} }
+func (m *SearchTransactionLabelsRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchTransactionLabelsRequest) ProtoMessage()    {}
+func (*SearchTransactionLabelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{110}
+}
+
+func (m *SearchTransactionLabelsRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+type SearchTransactionLabelsResponse struct {
+	Labels []*SearchTransactionLabelsResponse_TransactionLabel `protobuf:"bytes,1,rep,name=labels" json:"labels,omitempty"`
+}
+
+func (m *SearchTransactionLabelsResponse) Reset()         { *m = SearchTransactionLabelsResponse{} }
+func (m *SearchTransactionLabelsResponse) String() string { return proto.CompactTextString(m) }
+func (*SearchTransactionLabelsResponse) ProtoMessage()    {}
+func (*SearchTransactionLabelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{111}
+}
+
+func (m *SearchTransactionLabelsResponse) GetLabels() []*SearchTransactionLabelsResponse_TransactionLabel {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+type SearchTransactionLabelsResponse_TransactionLabel struct {
+	TransactionHash []byte `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	Label           string `protobuf:"bytes,2,opt,name=label" json:"label,omitempty"`
+}
+
+func (m *SearchTransactionLabelsResponse_TransactionLabel) Reset() {
+	*m = SearchTransactionLabelsResponse_TransactionLabel{}
+}
+func (m *SearchTransactionLabelsResponse_TransactionLabel) String() string {
+	return proto.CompactTextString(m)
+}
+func (*SearchTransactionLabelsResponse_TransactionLabel) ProtoMessage() {}
+func (*SearchTransactionLabelsResponse_TransactionLabel) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{111, 0}
+}
+
+func (m *SearchTransactionLabelsResponse_TransactionLabel) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return nil
+}
+
+func (m *SearchTransactionLabelsResponse_TransactionLabel) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+type SetTransactionLabelRequest struct {
+	TransactionHash []byte `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	Label           string `protobuf:"bytes,2,opt,name=label" json:"label,omitempty"`
+}
+
+func (m *SetTransactionLabelRequest) Reset()                    { *m = SetTransactionLabelRequest{} }
+func (m *SetTransactionLabelRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetTransactionLabelRequest) ProtoMessage()               {}
+func (*SetTransactionLabelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{112} }
+
+func (m *SetTransactionLabelRequest) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return nil
+}
+
+func (m *SetTransactionLabelRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+type SetTransactionLabelResponse struct {
+}
+
+func (m *SetTransactionLabelResponse) Reset()                    { *m = SetTransactionLabelResponse{} }
+func (m *SetTransactionLabelResponse) String() string            { return proto.CompactTextString(m) }
+func (*SetTransactionLabelResponse) ProtoMessage()               {}
+func (*SetTransactionLabelResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{113} }
+
+type AddressLabelsRequest struct {
+}
+
+func (m *AddressLabelsRequest) Reset()                    { *m = AddressLabelsRequest{} }
+func (m *AddressLabelsRequest) String() string            { return proto.CompactTextString(m) }
+func (*AddressLabelsRequest) ProtoMessage()               {}
+func (*AddressLabelsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{114} }
+
+type AddressLabelsResponse struct {
+	Labels []*AddressLabelsResponse_AddressLabel `protobuf:"bytes,1,rep,name=labels" json:"labels,omitempty"`
+}
+
+func (m *AddressLabelsResponse) Reset()                    { *m = AddressLabelsResponse{} }
+func (m *AddressLabelsResponse) String() string            { return proto.CompactTextString(m) }
+func (*AddressLabelsResponse) ProtoMessage()               {}
+func (*AddressLabelsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{115} }
+
+func (m *AddressLabelsResponse) GetLabels() []*AddressLabelsResponse_AddressLabel {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+type AddressLabelsResponse_AddressLabel struct {
+	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	Label   string `protobuf:"bytes,2,opt,name=label" json:"label,omitempty"`
+}
+
+func (m *AddressLabelsResponse_AddressLabel) Reset()         { *m = AddressLabelsResponse_AddressLabel{} }
+func (m *AddressLabelsResponse_AddressLabel) String() string { return proto.CompactTextString(m) }
+func (*AddressLabelsResponse_AddressLabel) ProtoMessage()    {}
+func (*AddressLabelsResponse_AddressLabel) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{115, 0}
+}
+
+func (m *AddressLabelsResponse_AddressLabel) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AddressLabelsResponse_AddressLabel) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+type SetAddressLabelRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	Label   string `protobuf:"bytes,2,opt,name=label" json:"label,omitempty"`
+}
+
+func (m *SetAddressLabelRequest) Reset()                    { *m = SetAddressLabelRequest{} }
+func (m *SetAddressLabelRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetAddressLabelRequest) ProtoMessage()               {}
+func (*SetAddressLabelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{116} }
+
+func (m *SetAddressLabelRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SetAddressLabelRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+type SetAddressLabelResponse struct {
+}
+
+func (m *SetAddressLabelResponse) Reset()                    { *m = SetAddressLabelResponse{} }
+func (m *SetAddressLabelResponse) String() string            { return proto.CompactTextString(m) }
+func (*SetAddressLabelResponse) ProtoMessage()               {}
+func (*SetAddressLabelResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{117} }
+
+type PayeesRequest struct {
+}
+
+func (m *PayeesRequest) Reset()                    { *m = PayeesRequest{} }
+func (m *PayeesRequest) String() string            { return proto.CompactTextString(m) }
+func (*PayeesRequest) ProtoMessage()               {}
+func (*PayeesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{118} }
+
+type PayeesResponse struct {
+	Payees []*PayeesResponse_Payee `protobuf:"bytes,1,rep,name=payees" json:"payees,omitempty"`
+}
+
+func (m *PayeesResponse) Reset()                    { *m = PayeesResponse{} }
+func (m *PayeesResponse) String() string            { return proto.CompactTextString(m) }
+func (*PayeesResponse) ProtoMessage()               {}
+func (*PayeesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{119} }
+
+func (m *PayeesResponse) GetPayees() []*PayeesResponse_Payee {
+	if m != nil {
+		return m.Payees
+	}
+	return nil
+}
+
+type PayeesResponse_Payee struct {
+	Name    string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address" json:"address,omitempty"`
+	Note    string `protobuf:"bytes,3,opt,name=note" json:"note,omitempty"`
+}
+
+func (m *PayeesResponse_Payee) Reset()                    { *m = PayeesResponse_Payee{} }
+func (m *PayeesResponse_Payee) String() string            { return proto.CompactTextString(m) }
+func (*PayeesResponse_Payee) ProtoMessage()               {}
+func (*PayeesResponse_Payee) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{119, 0} }
+
+func (m *PayeesResponse_Payee) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PayeesResponse_Payee) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PayeesResponse_Payee) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+type SetPayeeRequest struct {
+	Name    string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address" json:"address,omitempty"`
+	Note    string `protobuf:"bytes,3,opt,name=note" json:"note,omitempty"`
+}
+
+func (m *SetPayeeRequest) Reset()                    { *m = SetPayeeRequest{} }
+func (m *SetPayeeRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetPayeeRequest) ProtoMessage()               {}
+func (*SetPayeeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{120} }
+
+func (m *SetPayeeRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SetPayeeRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SetPayeeRequest) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+type SetPayeeResponse struct {
+}
+
+func (m *SetPayeeResponse) Reset()                    { *m = SetPayeeResponse{} }
+func (m *SetPayeeResponse) String() string            { return proto.CompactTextString(m) }
+func (*SetPayeeResponse) ProtoMessage()               {}
+func (*SetPayeeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{121} }
+
+type RemovePayeeRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+}
+
+func (m *RemovePayeeRequest) Reset()                    { *m = RemovePayeeRequest{} }
+func (m *RemovePayeeRequest) String() string            { return proto.CompactTextString(m) }
+func (*RemovePayeeRequest) ProtoMessage()               {}
+func (*RemovePayeeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{122} }
+
+func (m *RemovePayeeRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type RemovePayeeResponse struct {
+}
+
+func (m *RemovePayeeResponse) Reset()                    { *m = RemovePayeeResponse{} }
+func (m *RemovePayeeResponse) String() string            { return proto.CompactTextString(m) }
+func (*RemovePayeeResponse) ProtoMessage()               {}
+func (*RemovePayeeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{123} }
+
+type UnminedTransactionsRequest struct {
+}
+
+func (m *UnminedTransactionsRequest) Reset()                    { *m = UnminedTransactionsRequest{} }
+func (m *UnminedTransactionsRequest) String() string            { return proto.CompactTextString(m) }
+func (*UnminedTransactionsRequest) ProtoMessage()               {}
+func (*UnminedTransactionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{124} }
+
+type UnminedTransactionsResponse struct {
+	Transactions []*UnminedTransactionsResponse_UnminedTransaction `protobuf:"bytes,1,rep,name=transactions" json:"transactions,omitempty"`
+}
+
+func (m *UnminedTransactionsResponse) Reset()                    { *m = UnminedTransactionsResponse{} }
+func (m *UnminedTransactionsResponse) String() string            { return proto.CompactTextString(m) }
+func (*UnminedTransactionsResponse) ProtoMessage()               {}
+func (*UnminedTransactionsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{125} }
+
+func (m *UnminedTransactionsResponse) GetTransactions() []*UnminedTransactionsResponse_UnminedTransaction {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+type UnminedTransactionsResponse_UnminedTransaction struct {
+	TransactionHash   []byte `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	FirstSeen         int64  `protobuf:"varint,2,opt,name=first_seen,json=firstSeen" json:"first_seen,omitempty"`
+	LastSeen          int64  `protobuf:"varint,3,opt,name=last_seen,json=lastSeen" json:"last_seen,omitempty"`
+	BroadcastAttempts uint32 `protobuf:"varint,4,opt,name=broadcast_attempts,json=broadcastAttempts" json:"broadcast_attempts,omitempty"`
+	LastAttempt       int64  `protobuf:"varint,5,opt,name=last_attempt,json=lastAttempt" json:"last_attempt,omitempty"`
+	LastError         string `protobuf:"bytes,6,opt,name=last_error,json=lastError" json:"last_error,omitempty"`
+	NextAttempt       int64  `protobuf:"varint,7,opt,name=next_attempt,json=nextAttempt" json:"next_attempt,omitempty"`
+}
+
+func (m *UnminedTransactionsResponse_UnminedTransaction) Reset() {
+	*m = UnminedTransactionsResponse_UnminedTransaction{}
+}
+func (m *UnminedTransactionsResponse_UnminedTransaction) String() string {
+	return proto.CompactTextString(m)
+}
+func (*UnminedTransactionsResponse_UnminedTransaction) ProtoMessage() {}
+func (*UnminedTransactionsResponse_UnminedTransaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{125, 0}
+}
+
+func (m *UnminedTransactionsResponse_UnminedTransaction) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return nil
+}
+
+func (m *UnminedTransactionsResponse_UnminedTransaction) GetFirstSeen() int64 {
+	if m != nil {
+		return m.FirstSeen
+	}
+	return 0
+}
+
+func (m *UnminedTransactionsResponse_UnminedTransaction) GetLastSeen() int64 {
+	if m != nil {
+		return m.LastSeen
+	}
+	return 0
+}
+
+func (m *UnminedTransactionsResponse_UnminedTransaction) GetBroadcastAttempts() uint32 {
+	if m != nil {
+		return m.BroadcastAttempts
+	}
+	return 0
+}
+
+func (m *UnminedTransactionsResponse_UnminedTransaction) GetLastAttempt() int64 {
+	if m != nil {
+		return m.LastAttempt
+	}
+	return 0
+}
+
+func (m *UnminedTransactionsResponse_UnminedTransaction) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *UnminedTransactionsResponse_UnminedTransaction) GetNextAttempt() int64 {
+	if m != nil {
+		return m.NextAttempt
+	}
+	return 0
+}
+
+type AddressTransactionsRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	// The first block height from which transactions are included.
+	StartingBlockHeight int32 `protobuf:"varint,2,opt,name=starting_block_height,json=startingBlockHeight" json:"starting_block_height,omitempty"`
+	// Optionally specify the last block height that transactions may appear in.
+	// If zero, transactions are included through the best block, followed by
+	// all unmined transactions.
+	EndingBlockHeight int32 `protobuf:"varint,3,opt,name=ending_block_height,json=endingBlockHeight" json:"ending_block_height,omitempty"`
+	// Optionally limit the number of returned transactions.  Transactions of a
+	// single block are never split, so more transactions than this may be
+	// returned.  The following page begins at the height after the last
+	// returned block.
+	MaxTransactions uint32 `protobuf:"varint,4,opt,name=max_transactions,json=maxTransactions" json:"max_transactions,omitempty"`
+}
+
+func (m *AddressTransactionsRequest) Reset()                    { *m = AddressTransactionsRequest{} }
+func (m *AddressTransactionsRequest) String() string            { return proto.CompactTextString(m) }
+func (*AddressTransactionsRequest) ProtoMessage()               {}
+func (*AddressTransactionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{126} }
+
+func (m *AddressTransactionsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AddressTransactionsRequest) GetStartingBlockHeight() int32 {
+	if m != nil {
+		return m.StartingBlockHeight
+	}
+	return 0
+}
+
+func (m *AddressTransactionsRequest) GetEndingBlockHeight() int32 {
+	if m != nil {
+		return m.EndingBlockHeight
+	}
+	return 0
+}
+
+func (m *AddressTransactionsRequest) GetMaxTransactions() uint32 {
+	if m != nil {
+		return m.MaxTransactions
+	}
+	return 0
+}
+
+type AddressTransactionsResponse struct {
+	MinedTransactions   *BlockDetails         `protobuf:"bytes,1,opt,name=mined_transactions,json=minedTransactions" json:"mined_transactions,omitempty"`
+	UnminedTransactions []*TransactionDetails `protobuf:"bytes,2,rep,name=unmined_transactions,json=unminedTransactions" json:"unmined_transactions,omitempty"`
+}
+
+func (m *AddressTransactionsResponse) Reset()                    { *m = AddressTransactionsResponse{} }
+func (m *AddressTransactionsResponse) String() string            { return proto.CompactTextString(m) }
+func (*AddressTransactionsResponse) ProtoMessage()               {}
+func (*AddressTransactionsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{127} }
+
+func (m *AddressTransactionsResponse) GetMinedTransactions() *BlockDetails {
+	if m != nil {
+		return m.MinedTransactions
+	}
+	return nil
+}
+
+func (m *AddressTransactionsResponse) GetUnminedTransactions() []*TransactionDetails {
+	if m != nil {
+		return m.UnminedTransactions
+	}
+	return nil
+}
+
+type BackupWalletRequest struct {
+	Path string `protobuf:"bytes,1,opt,name=path" json:"path,omitempty"`
+}
+
+func (m *BackupWalletRequest) Reset()                    { *m = BackupWalletRequest{} }
+func (m *BackupWalletRequest) String() string            { return proto.CompactTextString(m) }
+func (*BackupWalletRequest) ProtoMessage()               {}
+func (*BackupWalletRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{128} }
+
+func (m *BackupWalletRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+type BackupWalletResponse struct {
+}
+
+func (m *BackupWalletResponse) Reset()                    { *m = BackupWalletResponse{} }
+func (m *BackupWalletResponse) String() string            { return proto.CompactTextString(m) }
+func (*BackupWalletResponse) ProtoMessage()               {}
+func (*BackupWalletResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{129} }
+
+type CheckConsistencyRequest struct {
+	Repair bool `protobuf:"varint,1,opt,name=repair" json:"repair,omitempty"`
+}
+
+func (m *CheckConsistencyRequest) Reset()                    { *m = CheckConsistencyRequest{} }
+func (m *CheckConsistencyRequest) String() string            { return proto.CompactTextString(m) }
+func (*CheckConsistencyRequest) ProtoMessage()               {}
+func (*CheckConsistencyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{130} }
+
+func (m *CheckConsistencyRequest) GetRepair() bool {
+	if m != nil {
+		return m.Repair
+	}
+	return false
+}
+
+type CheckConsistencyResponse struct {
+	Consistent bool                                `protobuf:"varint,1,opt,name=consistent" json:"consistent,omitempty"`
+	Problems   []*CheckConsistencyResponse_Problem `protobuf:"bytes,2,rep,name=problems" json:"problems,omitempty"`
+	Blocks     uint32                              `protobuf:"varint,3,opt,name=blocks" json:"blocks,omitempty"`
+	TxRecords  uint32                              `protobuf:"varint,4,opt,name=tx_records,json=txRecords" json:"tx_records,omitempty"`
+	Credits    uint32                              `protobuf:"varint,5,opt,name=credits" json:"credits,omitempty"`
+	Debits     uint32                              `protobuf:"varint,6,opt,name=debits" json:"debits,omitempty"`
+	Unspent    uint32                              `protobuf:"varint,7,opt,name=unspent" json:"unspent,omitempty"`
+	Unmined    uint32                              `protobuf:"varint,8,opt,name=unmined" json:"unmined,omitempty"`
+	Tickets    uint32                              `protobuf:"varint,9,opt,name=tickets" json:"tickets,omitempty"`
+	Accounts   uint32                              `protobuf:"varint,10,opt,name=accounts" json:"accounts,omitempty"`
+	Addresses  uint32                              `protobuf:"varint,11,opt,name=addresses" json:"addresses,omitempty"`
+}
+
+func (m *CheckConsistencyResponse) Reset()                    { *m = CheckConsistencyResponse{} }
+func (m *CheckConsistencyResponse) String() string            { return proto.CompactTextString(m) }
+func (*CheckConsistencyResponse) ProtoMessage()               {}
+func (*CheckConsistencyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{131} }
+
+func (m *CheckConsistencyResponse) GetConsistent() bool {
+	if m != nil {
+		return m.Consistent
+	}
+	return false
+}
+
+func (m *CheckConsistencyResponse) GetProblems() []*CheckConsistencyResponse_Problem {
+	if m != nil {
+		return m.Problems
+	}
+	return nil
+}
+
+func (m *CheckConsistencyResponse) GetBlocks() uint32 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func (m *CheckConsistencyResponse) GetTxRecords() uint32 {
+	if m != nil {
+		return m.TxRecords
+	}
+	return 0
+}
+
+func (m *CheckConsistencyResponse) GetCredits() uint32 {
+	if m != nil {
+		return m.Credits
+	}
+	return 0
+}
+
+func (m *CheckConsistencyResponse) GetDebits() uint32 {
+	if m != nil {
+		return m.Debits
+	}
+	return 0
+}
+
+func (m *CheckConsistencyResponse) GetUnspent() uint32 {
+	if m != nil {
+		return m.Unspent
+	}
+	return 0
+}
+
+func (m *CheckConsistencyResponse) GetUnmined() uint32 {
+	if m != nil {
+		return m.Unmined
+	}
+	return 0
+}
+
+func (m *CheckConsistencyResponse) GetTickets() uint32 {
+	if m != nil {
+		return m.Tickets
+	}
+	return 0
+}
+
+func (m *CheckConsistencyResponse) GetAccounts() uint32 {
+	if m != nil {
+		return m.Accounts
+	}
+	return 0
+}
+
+func (m *CheckConsistencyResponse) GetAddresses() uint32 {
+	if m != nil {
+		return m.Addresses
+	}
+	return 0
+}
+
+type CheckConsistencyResponse_Problem struct {
+	Check       string `protobuf:"bytes,1,opt,name=check" json:"check,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+	Fixable     bool   `protobuf:"varint,3,opt,name=fixable" json:"fixable,omitempty"`
+	Fixed       bool   `protobuf:"varint,4,opt,name=fixed" json:"fixed,omitempty"`
+}
+
+func (m *CheckConsistencyResponse_Problem) Reset()         { *m = CheckConsistencyResponse_Problem{} }
+func (m *CheckConsistencyResponse_Problem) String() string { return proto.CompactTextString(m) }
+func (*CheckConsistencyResponse_Problem) ProtoMessage()    {}
+func (*CheckConsistencyResponse_Problem) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{131, 0}
+}
+
+func (m *CheckConsistencyResponse_Problem) GetCheck() string {
+	if m != nil {
+		return m.Check
+	}
+	return ""
+}
+
+func (m *CheckConsistencyResponse_Problem) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *CheckConsistencyResponse_Problem) GetFixable() bool {
+	if m != nil {
+		return m.Fixable
+	}
+	return false
+}
+
+func (m *CheckConsistencyResponse_Problem) GetFixed() bool {
+	if m != nil {
+		return m.Fixed
+	}
+	return false
+}
+
+type TransactionNotificationsRequest struct {
+}
+
+func (m *TransactionNotificationsRequest) Reset()         { *m = TransactionNotificationsRequest{} }
+func (m *TransactionNotificationsRequest) String() string { return proto.CompactTextString(m) }
+func (*TransactionNotificationsRequest) ProtoMessage()    {}
+func (*TransactionNotificationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{132}
+}
+
+type TransactionNotificationsResponse struct {
+	// Sorted by increasing height.  This is a repeated field so many new blocks
+	// in a new best chain can be notified at once during a reorganize.
+	AttachedBlocks []*BlockDetails `protobuf:"bytes,1,rep,name=attached_blocks,json=attachedBlocks" json:"attached_blocks,omitempty"`
+	// If there was a chain reorganize, there may have been blocks with wallet
+	// transactions that are no longer in the best chain.  These are those
+	// block's hashes.
+	DetachedBlocks [][]byte `protobuf:"bytes,2,rep,name=detached_blocks,json=detachedBlocks,proto3" json:"detached_blocks,omitempty"`
+	// Any new unmined transactions are included here.  These unmined transactions
+	// refer to the current best chain, so transactions from detached blocks may
+	// be moved to mempool and included here if they are not mined or double spent
+	// in the new chain.  Additonally, if no new blocks were attached but a relevant
+	// unmined transaction is seen by the wallet, it will be reported here.
+	UnminedTransactions []*TransactionDetails `protobuf:"bytes,3,rep,name=unmined_transactions,json=unminedTransactions" json:"unmined_transactions,omitempty"`
+	// Instead of notifying all of the removed unmined transactions,
+	// just send all of the current hashes.
+	UnminedTransactionHashes [][]byte `protobuf:"bytes,4,rep,name=unmined_transaction_hashes,json=unminedTransactionHashes,proto3" json:"unmined_transaction_hashes,omitempty"`
+	// Unmined transactions which expired at an attached block, and their
+	// unmined spenders, which were removed from the wallet.
+	ExpiredTransactionHashes [][]byte `protobuf:"bytes,5,rep,name=expired_transaction_hashes,json=expiredTransactionHashes,proto3" json:"expired_transaction_hashes,omitempty"`
+}
+
+func (m *TransactionNotificationsResponse) Reset()         { *m = TransactionNotificationsResponse{} }
+func (m *TransactionNotificationsResponse) String() string { return proto.CompactTextString(m) }
+func (*TransactionNotificationsResponse) ProtoMessage()    {}
+func (*TransactionNotificationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{133}
+}
+
+func (m *TransactionNotificationsResponse) GetAttachedBlocks() []*BlockDetails {
+	if m != nil {
+		return m.AttachedBlocks
+	}
+	return nil
+}
+
+func (m *TransactionNotificationsResponse) GetDetachedBlocks() [][]byte {
+	if m != nil {
+		return m.DetachedBlocks
+	}
+	return nil
+}
+
+func (m *TransactionNotificationsResponse) GetUnminedTransactions() []*TransactionDetails {
+	if m != nil {
+		return m.UnminedTransactions
+	}
+	return nil
+}
+
+func (m *TransactionNotificationsResponse) GetUnminedTransactionHashes() [][]byte {
+	if m != nil {
+		return m.UnminedTransactionHashes
+	}
+	return nil
+}
+
+func (m *TransactionNotificationsResponse) GetExpiredTransactionHashes() [][]byte {
+	if m != nil {
+		return m.ExpiredTransactionHashes
+	}
+	return nil
+}
+
+type AccountNotificationsRequest struct {
+}
+
+func (m *AccountNotificationsRequest) Reset()                    { *m = AccountNotificationsRequest{} }
+func (m *AccountNotificationsRequest) String() string            { return proto.CompactTextString(m) }
+func (*AccountNotificationsRequest) ProtoMessage()               {}
+func (*AccountNotificationsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{134} }
+
+type AccountNotificationsResponse struct {
+	AccountNumber    uint32 `protobuf:"varint,1,opt,name=account_number,json=accountNumber" json:"account_number,omitempty"`
+	AccountName      string `protobuf:"bytes,2,opt,name=account_name,json=accountName" json:"account_name,omitempty"`
+	ExternalKeyCount uint32 `protobuf:"varint,3,opt,name=external_key_count,json=externalKeyCount" json:"external_key_count,omitempty"`
+	InternalKeyCount uint32 `protobuf:"varint,4,opt,name=internal_key_count,json=internalKeyCount" json:"internal_key_count,omitempty"`
+	ImportedKeyCount uint32 `protobuf:"varint,5,opt,name=imported_key_count,json=importedKeyCount" json:"imported_key_count,omitempty"`
+}
+
+func (m *AccountNotificationsResponse) Reset()                    { *m = AccountNotificationsResponse{} }
+func (m *AccountNotificationsResponse) String() string            { return proto.CompactTextString(m) }
+func (*AccountNotificationsResponse) ProtoMessage()               {}
+func (*AccountNotificationsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{135} }
+
+func (m *AccountNotificationsResponse) GetAccountNumber() uint32 {
+	if m != nil {
+		return m.AccountNumber
+	}
+	return 0
+}
+
+func (m *AccountNotificationsResponse) GetAccountName() string {
+	if m != nil {
+		return m.AccountName
+	}
+	return ""
+}
+
+func (m *AccountNotificationsResponse) GetExternalKeyCount() uint32 {
+	if m != nil {
+		return m.ExternalKeyCount
+	}
+	return 0
+}
+
+func (m *AccountNotificationsResponse) GetInternalKeyCount() uint32 {
+	if m != nil {
+		return m.InternalKeyCount
+	}
+	return 0
+}
+
+func (m *AccountNotificationsResponse) GetImportedKeyCount() uint32 {
+	if m != nil {
+		return m.ImportedKeyCount
+	}
+	return 0
+}
+
+type ConfirmationNotificationsRequest struct {
+	TxHashes  [][]byte `protobuf:"bytes,1,rep,name=tx_hashes,json=txHashes,proto3" json:"tx_hashes,omitempty"`
+	StopAfter int32    `protobuf:"varint,2,opt,name=stop_after,json=stopAfter" json:"stop_after,omitempty"`
+}
+
+func (m *ConfirmationNotificationsRequest) Reset()         { *m = ConfirmationNotificationsRequest{} }
+func (m *ConfirmationNotificationsRequest) String() string { return proto.CompactTextString(m) }
+func (*ConfirmationNotificationsRequest) ProtoMessage()    {}
+func (*ConfirmationNotificationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{136}
+}
+
+func (m *ConfirmationNotificationsRequest) GetTxHashes() [][]byte {
+	if m != nil {
+		return m.TxHashes
+	}
+	return nil
+}
+
+func (m *ConfirmationNotificationsRequest) GetStopAfter() int32 {
+	if m != nil {
+		return m.StopAfter
+	}
+	return 0
+}
+
+type ConfirmationNotificationsResponse struct {
+	Confirmations []*ConfirmationNotificationsResponse_TransactionConfirmations `protobuf:"bytes,1,rep,name=confirmations" json:"confirmations,omitempty"`
+}
+
+func (m *ConfirmationNotificationsResponse) Reset()         { *m = ConfirmationNotificationsResponse{} }
+func (m *ConfirmationNotificationsResponse) String() string { return proto.CompactTextString(m) }
+func (*ConfirmationNotificationsResponse) ProtoMessage()    {}
+func (*ConfirmationNotificationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{137}
+}
+
+func (m *ConfirmationNotificationsResponse) GetConfirmations() []*ConfirmationNotificationsResponse_TransactionConfirmations {
+	if m != nil {
+		return m.Confirmations
+	}
+	return nil
+}
+
+type ConfirmationNotificationsResponse_TransactionConfirmations struct {
+	TxHash        []byte `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Confirmations int32  `protobuf:"varint,2,opt,name=confirmations" json:"confirmations,omitempty"`
+	BlockHash     []byte `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	BlockHeight   int32  `protobuf:"varint,4,opt,name=block_height,json=blockHeight" json:"block_height,omitempty"`
+}
+
+func (m *ConfirmationNotificationsResponse_TransactionConfirmations) Reset() {
+	*m = ConfirmationNotificationsResponse_TransactionConfirmations{}
+}
+func (m *ConfirmationNotificationsResponse_TransactionConfirmations) String() string {
+	return proto.CompactTextString(m)
+}
+func (*ConfirmationNotificationsResponse_TransactionConfirmations) ProtoMessage() {}
+func (*ConfirmationNotificationsResponse_TransactionConfirmations) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{137, 0}
+}
+
+func (m *ConfirmationNotificationsResponse_TransactionConfirmations) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *ConfirmationNotificationsResponse_TransactionConfirmations) GetConfirmations() int32 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
+}
+
+func (m *ConfirmationNotificationsResponse_TransactionConfirmations) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *ConfirmationNotificationsResponse_TransactionConfirmations) GetBlockHeight() int32 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+type CreateWalletRequest struct {
+	PublicPassphrase  []byte `protobuf:"bytes,1,opt,name=public_passphrase,json=publicPassphrase,proto3" json:"public_passphrase,omitempty"`
+	PrivatePassphrase []byte `protobuf:"bytes,2,opt,name=private_passphrase,json=privatePassphrase,proto3" json:"private_passphrase,omitempty"`
+	Seed              []byte `protobuf:"bytes,3,opt,name=seed,proto3" json:"seed,omitempty"`
+}
+
+func (m *CreateWalletRequest) Reset()                    { *m = CreateWalletRequest{} }
+func (m *CreateWalletRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateWalletRequest) ProtoMessage()               {}
+func (*CreateWalletRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{138} }
+
+func (m *CreateWalletRequest) GetPublicPassphrase() []byte {
+	if m != nil {
+		return m.PublicPassphrase
+	}
+	return nil
+}
+
+func (m *CreateWalletRequest) GetPrivatePassphrase() []byte {
+	if m != nil {
+		return m.PrivatePassphrase
+	}
+	return nil
+}
+
+func (m *CreateWalletRequest) GetSeed() []byte {
+	if m != nil {
+		return m.Seed
+	}
+	return nil
+}
+
+type CreateWalletResponse struct {
+}
+
+func (m *CreateWalletResponse) Reset()                    { *m = CreateWalletResponse{} }
+func (m *CreateWalletResponse) String() string            { return proto.CompactTextString(m) }
+func (*CreateWalletResponse) ProtoMessage()               {}
+func (*CreateWalletResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{139} }
+
+type OpenWalletRequest struct {
+	PublicPassphrase []byte `protobuf:"bytes,1,opt,name=public_passphrase,json=publicPassphrase,proto3" json:"public_passphrase,omitempty"`
+}
+
+func (m *OpenWalletRequest) Reset()                    { *m = OpenWalletRequest{} }
+func (m *OpenWalletRequest) String() string            { return proto.CompactTextString(m) }
+func (*OpenWalletRequest) ProtoMessage()               {}
+func (*OpenWalletRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{140} }
+
+func (m *OpenWalletRequest) GetPublicPassphrase() []byte {
+	if m != nil {
+		return m.PublicPassphrase
+	}
+	return nil
+}
+
+type OpenWalletResponse struct {
+}
+
+func (m *OpenWalletResponse) Reset()                    { *m = OpenWalletResponse{} }
+func (m *OpenWalletResponse) String() string            { return proto.CompactTextString(m) }
+func (*OpenWalletResponse) ProtoMessage()               {}
+func (*OpenWalletResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{141} }
+
+type CloseWalletRequest struct {
+}
+
+func (m *CloseWalletRequest) Reset()                    { *m = CloseWalletRequest{} }
+func (m *CloseWalletRequest) String() string            { return proto.CompactTextString(m) }
+func (*CloseWalletRequest) ProtoMessage()               {}
+func (*CloseWalletRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{142} }
+
+type CloseWalletResponse struct {
+}
+
+func (m *CloseWalletResponse) Reset()                    { *m = CloseWalletResponse{} }
+func (m *CloseWalletResponse) String() string            { return proto.CompactTextString(m) }
+func (*CloseWalletResponse) ProtoMessage()               {}
+func (*CloseWalletResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{143} }
+
+type WalletExistsRequest struct {
+}
+
+func (m *WalletExistsRequest) Reset()                    { *m = WalletExistsRequest{} }
+func (m *WalletExistsRequest) String() string            { return proto.CompactTextString(m) }
+func (*WalletExistsRequest) ProtoMessage()               {}
+func (*WalletExistsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{144} }
+
+type WalletExistsResponse struct {
+	Exists bool `protobuf:"varint,1,opt,name=exists" json:"exists,omitempty"`
+}
+
+func (m *WalletExistsResponse) Reset()                    { *m = WalletExistsResponse{} }
+func (m *WalletExistsResponse) String() string            { return proto.CompactTextString(m) }
+func (*WalletExistsResponse) ProtoMessage()               {}
+func (*WalletExistsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{145} }
+
+func (m *WalletExistsResponse) GetExists() bool {
+	if m != nil {
+		return m.Exists
+	}
+	return false
+}
+
+type StartConsensusRpcRequest struct {
+	NetworkAddress string `protobuf:"bytes,1,opt,name=network_address,json=networkAddress" json:"network_address,omitempty"`
+	Username       string `protobuf:"bytes,2,opt,name=username" json:"username,omitempty"`
+	Password       []byte `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	Certificate    []byte `protobuf:"bytes,4,opt,name=certificate,proto3" json:"certificate,omitempty"`
+}
+
+func (m *StartConsensusRpcRequest) Reset()                    { *m = StartConsensusRpcRequest{} }
+func (m *StartConsensusRpcRequest) String() string            { return proto.CompactTextString(m) }
+func (*StartConsensusRpcRequest) ProtoMessage()               {}
+func (*StartConsensusRpcRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{146} }
+
+func (m *StartConsensusRpcRequest) GetNetworkAddress() string {
+	if m != nil {
+		return m.NetworkAddress
+	}
+	return ""
+}
+
+func (m *StartConsensusRpcRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *StartConsensusRpcRequest) GetPassword() []byte {
+	if m != nil {
+		return m.Password
+	}
+	return nil
+}
+
+func (m *StartConsensusRpcRequest) GetCertificate() []byte {
+	if m != nil {
+		return m.Certificate
+	}
+	return nil
+}
+
+type StartConsensusRpcResponse struct {
+}
+
+func (m *StartConsensusRpcResponse) Reset()                    { *m = StartConsensusRpcResponse{} }
+func (m *StartConsensusRpcResponse) String() string            { return proto.CompactTextString(m) }
+func (*StartConsensusRpcResponse) ProtoMessage()               {}
+func (*StartConsensusRpcResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{147} }
+
+type DiscoverAddressesRequest struct {
+	DiscoverAccounts  bool   `protobuf:"varint,1,opt,name=discover_accounts,json=discoverAccounts" json:"discover_accounts,omitempty"`
+	PrivatePassphrase []byte `protobuf:"bytes,2,opt,name=private_passphrase,json=privatePassphrase,proto3" json:"private_passphrase,omitempty"`
+}
+
+func (m *DiscoverAddressesRequest) Reset()                    { *m = DiscoverAddressesRequest{} }
+func (m *DiscoverAddressesRequest) String() string            { return proto.CompactTextString(m) }
+func (*DiscoverAddressesRequest) ProtoMessage()               {}
+func (*DiscoverAddressesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{148} }
+
+func (m *DiscoverAddressesRequest) GetDiscoverAccounts() bool {
+	if m != nil {
+		return m.DiscoverAccounts
+	}
+	return false
+}
+
+func (m *DiscoverAddressesRequest) GetPrivatePassphrase() []byte {
+	if m != nil {
+		return m.PrivatePassphrase
+	}
+	return nil
+}
+
+type DiscoverAddressesResponse struct {
+}
+
+func (m *DiscoverAddressesResponse) Reset()                    { *m = DiscoverAddressesResponse{} }
+func (m *DiscoverAddressesResponse) String() string            { return proto.CompactTextString(m) }
+func (*DiscoverAddressesResponse) ProtoMessage()               {}
+func (*DiscoverAddressesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{149} }
+
+type SubscribeToBlockNotificationsRequest struct {
+}
+
+func (m *SubscribeToBlockNotificationsRequest) Reset()         { *m = SubscribeToBlockNotificationsRequest{} }
+func (m *SubscribeToBlockNotificationsRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeToBlockNotificationsRequest) ProtoMessage()    {}
+func (*SubscribeToBlockNotificationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{150}
+}
+
+type SubscribeToBlockNotificationsResponse struct {
+}
+
+func (m *SubscribeToBlockNotificationsResponse) Reset()         { *m = SubscribeToBlockNotificationsResponse{} }
+func (m *SubscribeToBlockNotificationsResponse) String() string { return proto.CompactTextString(m) }
+func (*SubscribeToBlockNotificationsResponse) ProtoMessage()    {}
+func (*SubscribeToBlockNotificationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{151}
+}
+
+type FetchHeadersRequest struct {
+}
+
+func (m *FetchHeadersRequest) Reset()                    { *m = FetchHeadersRequest{} }
+func (m *FetchHeadersRequest) String() string            { return proto.CompactTextString(m) }
+func (*FetchHeadersRequest) ProtoMessage()               {}
+func (*FetchHeadersRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{152} }
+
+type FetchHeadersResponse struct {
+	FetchedHeadersCount     uint32 `protobuf:"varint,1,opt,name=fetched_headers_count,json=fetchedHeadersCount" json:"fetched_headers_count,omitempty"`
+	FirstNewBlockHash       []byte `protobuf:"bytes,2,opt,name=first_new_block_hash,json=firstNewBlockHash,proto3" json:"first_new_block_hash,omitempty"`
+	FirstNewBlockHeight     int32  `protobuf:"varint,3,opt,name=first_new_block_height,json=firstNewBlockHeight" json:"first_new_block_height,omitempty"`
+	MainChainTipBlockHash   []byte `protobuf:"bytes,4,opt,name=main_chain_tip_block_hash,json=mainChainTipBlockHash,proto3" json:"main_chain_tip_block_hash,omitempty"`
+	MainChainTipBlockHeight int32  `protobuf:"varint,5,opt,name=main_chain_tip_block_height,json=mainChainTipBlockHeight" json:"main_chain_tip_block_height,omitempty"`
+}
+
+func (m *FetchHeadersResponse) Reset()                    { *m = FetchHeadersResponse{} }
+func (m *FetchHeadersResponse) String() string            { return proto.CompactTextString(m) }
+func (*FetchHeadersResponse) ProtoMessage()               {}
+func (*FetchHeadersResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{153} }
+
+func (m *FetchHeadersResponse) GetFetchedHeadersCount() uint32 {
+	if m != nil {
+		return m.FetchedHeadersCount
+	}
+	return 0
+}
+
+func (m *FetchHeadersResponse) GetFirstNewBlockHash() []byte {
+	if m != nil {
+		return m.FirstNewBlockHash
+	}
+	return nil
+}
+
+func (m *FetchHeadersResponse) GetFirstNewBlockHeight() int32 {
+	if m != nil {
+		return m.FirstNewBlockHeight
+	}
+	return 0
+}
+
+func (m *FetchHeadersResponse) GetMainChainTipBlockHash() []byte {
+	if m != nil {
+		return m.MainChainTipBlockHash
+	}
+	return nil
+}
+
+func (m *FetchHeadersResponse) GetMainChainTipBlockHeight() int32 {
+	if m != nil {
+		return m.MainChainTipBlockHeight
+	}
+	return 0
+}
+
+type GenerateRandomSeedRequest struct {
+	SeedLength uint32 `protobuf:"varint,1,opt,name=seed_length,json=seedLength" json:"seed_length,omitempty"`
+}
+
+func (m *GenerateRandomSeedRequest) Reset()                    { *m = GenerateRandomSeedRequest{} }
+func (m *GenerateRandomSeedRequest) String() string            { return proto.CompactTextString(m) }
+func (*GenerateRandomSeedRequest) ProtoMessage()               {}
+func (*GenerateRandomSeedRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{154} }
+
+func (m *GenerateRandomSeedRequest) GetSeedLength() uint32 {
+	if m != nil {
+		return m.SeedLength
+	}
+	return 0
+}
+
+type GenerateRandomSeedResponse struct {
+	SeedBytes    []byte `protobuf:"bytes,1,opt,name=seed_bytes,json=seedBytes,proto3" json:"seed_bytes,omitempty"`
+	SeedHex      string `protobuf:"bytes,2,opt,name=seed_hex,json=seedHex" json:"seed_hex,omitempty"`
+	SeedMnemonic string `protobuf:"bytes,3,opt,name=seed_mnemonic,json=seedMnemonic" json:"seed_mnemonic,omitempty"`
+}
+
+func (m *GenerateRandomSeedResponse) Reset()                    { *m = GenerateRandomSeedResponse{} }
+func (m *GenerateRandomSeedResponse) String() string            { return proto.CompactTextString(m) }
+func (*GenerateRandomSeedResponse) ProtoMessage()               {}
+func (*GenerateRandomSeedResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{155} }
+
+func (m *GenerateRandomSeedResponse) GetSeedBytes() []byte {
+	if m != nil {
+		return m.SeedBytes
+	}
+	return nil
+}
+
+func (m *GenerateRandomSeedResponse) GetSeedHex() string {
+	if m != nil {
+		return m.SeedHex
+	}
+	return ""
+}
+
+func (m *GenerateRandomSeedResponse) GetSeedMnemonic() string {
+	if m != nil {
+		return m.SeedMnemonic
+	}
+	return ""
+}
+
+type DecodeSeedRequest struct {
+	UserInput string `protobuf:"bytes,1,opt,name=user_input,json=userInput" json:"user_input,omitempty"`
+}
+
+func (m *DecodeSeedRequest) Reset()                    { *m = DecodeSeedRequest{} }
+func (m *DecodeSeedRequest) String() string            { return proto.CompactTextString(m) }
+func (*DecodeSeedRequest) ProtoMessage()               {}
+func (*DecodeSeedRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{156} }
+
+func (m *DecodeSeedRequest) GetUserInput() string {
+	if m != nil {
+		return m.UserInput
+	}
+	return ""
+}
+
+type DecodeSeedResponse struct {
+	DecodedSeed []byte `protobuf:"bytes,1,opt,name=decoded_seed,json=decodedSeed,proto3" json:"decoded_seed,omitempty"`
+}
+
+func (m *DecodeSeedResponse) Reset()                    { *m = DecodeSeedResponse{} }
+func (m *DecodeSeedResponse) String() string            { return proto.CompactTextString(m) }
+func (*DecodeSeedResponse) ProtoMessage()               {}
+func (*DecodeSeedResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{157} }
+
+func (m *DecodeSeedResponse) GetDecodedSeed() []byte {
+	if m != nil {
+		return m.DecodedSeed
+	}
+	return nil
+}
+
+type StartAutoBuyerRequest struct {
+	Passphrase        []byte  `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	Account           uint32  `protobuf:"varint,2,opt,name=account" json:"account,omitempty"`
+	BalanceToMaintain int64   `protobuf:"varint,3,opt,name=balance_to_maintain,json=balanceToMaintain" json:"balance_to_maintain,omitempty"`
+	MaxFeePerKb       int64   `protobuf:"varint,4,opt,name=max_fee_per_kb,json=maxFeePerKb" json:"max_fee_per_kb,omitempty"`
+	MaxPriceRelative  float64 `protobuf:"fixed64,5,opt,name=max_price_relative,json=maxPriceRelative" json:"max_price_relative,omitempty"`
+	MaxPriceAbsolute  int64   `protobuf:"varint,6,opt,name=max_price_absolute,json=maxPriceAbsolute" json:"max_price_absolute,omitempty"`
+	VotingAddress     string  `protobuf:"bytes,7,opt,name=voting_address,json=votingAddress" json:"voting_address,omitempty"`
+	PoolAddress       string  `protobuf:"bytes,8,opt,name=pool_address,json=poolAddress" json:"pool_address,omitempty"`
+	PoolFees          float64 `protobuf:"fixed64,9,opt,name=pool_fees,json=poolFees" json:"pool_fees,omitempty"`
+	MaxPerBlock       int64   `protobuf:"varint,10,opt,name=max_per_block,json=maxPerBlock" json:"max_per_block,omitempty"`
+}
+
+func (m *StartAutoBuyerRequest) Reset()                    { *m = StartAutoBuyerRequest{} }
+func (m *StartAutoBuyerRequest) String() string            { return proto.CompactTextString(m) }
+func (*StartAutoBuyerRequest) ProtoMessage()               {}
+func (*StartAutoBuyerRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{158} }
+
+func (m *StartAutoBuyerRequest) GetPassphrase() []byte {
+	if m != nil {
+		return m.Passphrase
+	}
+	return nil
+}
+
+func (m *StartAutoBuyerRequest) GetAccount() uint32 {
+	if m != nil {
+		return m.Account
+	}
+	return 0
+}
+
+func (m *StartAutoBuyerRequest) GetBalanceToMaintain() int64 {
+	if m != nil {
+		return m.BalanceToMaintain
+	}
+	return 0
+}
+
+func (m *StartAutoBuyerRequest) GetMaxFeePerKb() int64 {
+	if m != nil {
+		return m.MaxFeePerKb
+	}
+	return 0
+}
+
+func (m *StartAutoBuyerRequest) GetMaxPriceRelative() float64 {
+	if m != nil {
+		return m.MaxPriceRelative
+	}
+	return 0
+}
+
+func (m *StartAutoBuyerRequest) GetMaxPriceAbsolute() int64 {
+	if m != nil {
+		return m.MaxPriceAbsolute
+	}
+	return 0
+}
+
+func (m *StartAutoBuyerRequest) GetVotingAddress() string {
+	if m != nil {
+		return m.VotingAddress
+	}
+	return ""
+}
+
+func (m *StartAutoBuyerRequest) GetPoolAddress() string {
+	if m != nil {
+		return m.PoolAddress
+	}
+	return ""
+}
+
+func (m *StartAutoBuyerRequest) GetPoolFees() float64 {
+	if m != nil {
+		return m.PoolFees
+	}
+	return 0
+}
+
+func (m *StartAutoBuyerRequest) GetMaxPerBlock() int64 {
+	if m != nil {
+		return m.MaxPerBlock
+	}
+	return 0
+}
+
+type StartAutoBuyerResponse struct {
+}
+
+func (m *StartAutoBuyerResponse) Reset()                    { *m = StartAutoBuyerResponse{} }
+func (m *StartAutoBuyerResponse) String() string            { return proto.CompactTextString(m) }
+func (*StartAutoBuyerResponse) ProtoMessage()               {}
+func (*StartAutoBuyerResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{159} }
+
+type StopAutoBuyerRequest struct {
+}
+
+func (m *StopAutoBuyerRequest) Reset()                    { *m = StopAutoBuyerRequest{} }
+func (m *StopAutoBuyerRequest) String() string            { return proto.CompactTextString(m) }
+func (*StopAutoBuyerRequest) ProtoMessage()               {}
+func (*StopAutoBuyerRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{160} }
+
+type StopAutoBuyerResponse struct {
+}
+
+func (m *StopAutoBuyerResponse) Reset()                    { *m = StopAutoBuyerResponse{} }
+func (m *StopAutoBuyerResponse) String() string            { return proto.CompactTextString(m) }
+func (*StopAutoBuyerResponse) ProtoMessage()               {}
+func (*StopAutoBuyerResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{161} }
+
+type TicketBuyerConfigRequest struct {
+}
+
+func (m *TicketBuyerConfigRequest) Reset()                    { *m = TicketBuyerConfigRequest{} }
+func (m *TicketBuyerConfigRequest) String() string            { return proto.CompactTextString(m) }
+func (*TicketBuyerConfigRequest) ProtoMessage()               {}
+func (*TicketBuyerConfigRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{162} }
+
+type TicketBuyerConfigResponse struct {
+	Account               uint32  `protobuf:"varint,1,opt,name=account" json:"account,omitempty"`
+	AvgPriceMode          string  `protobuf:"bytes,2,opt,name=avg_price_mode,json=avgPriceMode" json:"avg_price_mode,omitempty"`
+	AvgPriceVWAPDelta     int64   `protobuf:"varint,3,opt,name=avg_priceVWAP_delta,json=avgPriceVWAPDelta" json:"avg_priceVWAP_delta,omitempty"`
+	BalanceToMaintain     int64   `protobuf:"varint,4,opt,name=balance_to_maintain,json=balanceToMaintain" json:"balance_to_maintain,omitempty"`
+	BlocksToAvg           int64   `protobuf:"varint,5,opt,name=blocks_to_avg,json=blocksToAvg" json:"blocks_to_avg,omitempty"`
+	DontWaitForTickets    bool    `protobuf:"varint,6,opt,name=dont_wait_for_tickets,json=dontWaitForTickets" json:"dont_wait_for_tickets,omitempty"`
+	ExpiryDelta           int64   `protobuf:"varint,7,opt,name=expiry_delta,json=expiryDelta" json:"expiry_delta,omitempty"`
+	FeeSource             string  `protobuf:"bytes,8,opt,name=fee_source,json=feeSource" json:"fee_source,omitempty"`
+	FeeTargetScaling      float64 `protobuf:"fixed64,9,opt,name=fee_target_scaling,json=feeTargetScaling" json:"fee_target_scaling,omitempty"`
+	MinFee                int64   `protobuf:"varint,10,opt,name=min_fee,json=minFee" json:"min_fee,omitempty"`
+	MaxFee                int64   `protobuf:"varint,12,opt,name=max_fee,json=maxFee" json:"max_fee,omitempty"`
+	MaxPerBlock           int64   `protobuf:"varint,13,opt,name=max_per_block,json=maxPerBlock" json:"max_per_block,omitempty"`
+	MaxPriceAbsolute      int64   `protobuf:"varint,14,opt,name=max_price_absolute,json=maxPriceAbsolute" json:"max_price_absolute,omitempty"`
+	MaxPriceRelative      float64 `protobuf:"fixed64,15,opt,name=max_price_relative,json=maxPriceRelative" json:"max_price_relative,omitempty"`
+	MaxInMempool          int64   `protobuf:"varint,17,opt,name=max_in_mempool,json=maxInMempool" json:"max_in_mempool,omitempty"`
+	PoolAddress           string  `protobuf:"bytes,18,opt,name=pool_address,json=poolAddress" json:"pool_address,omitempty"`
+	PoolFees              float64 `protobuf:"fixed64,19,opt,name=pool_fees,json=poolFees" json:"pool_fees,omitempty"`
+	SpreadTicketPurchases bool    `protobuf:"varint,20,opt,name=spread_ticket_purchases,json=spreadTicketPurchases" json:"spread_ticket_purchases,omitempty"`
+	VotingAddress         string  `protobuf:"bytes,21,opt,name=voting_address,json=votingAddress" json:"voting_address,omitempty"`
+	TxFee                 int64   `protobuf:"varint,22,opt,name=tx_fee,json=txFee" json:"tx_fee,omitempty"`
+}
+
+func (m *TicketBuyerConfigResponse) Reset()                    { *m = TicketBuyerConfigResponse{} }
+func (m *TicketBuyerConfigResponse) String() string            { return proto.CompactTextString(m) }
+func (*TicketBuyerConfigResponse) ProtoMessage()               {}
+func (*TicketBuyerConfigResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{163} }
+
+func (m *TicketBuyerConfigResponse) GetAccount() uint32 {
+	if m != nil {
+		return m.Account
+	}
+	return 0
+}
+
+func (m *TicketBuyerConfigResponse) GetAvgPriceMode() string {
+	if m != nil {
+		return m.AvgPriceMode
+	}
+	return ""
+}
+
+func (m *TicketBuyerConfigResponse) GetAvgPriceVWAPDelta() int64 {
+	if m != nil {
+		return m.AvgPriceVWAPDelta
+	}
+	return 0
+}
+
+func (m *TicketBuyerConfigResponse) GetBalanceToMaintain() int64 {
+	if m != nil {
+		return m.BalanceToMaintain
+	}
+	return 0
+}
+
+func (m *TicketBuyerConfigResponse) GetBlocksToAvg() int64 {
+	if m != nil {
+		return m.BlocksToAvg
+	}
+	return 0
+}
+
+func (m *TicketBuyerConfigResponse) GetDontWaitForTickets() bool {
+	if m != nil {
+		return m.DontWaitForTickets
+	}
+	return false
+}
+
+func (m *TicketBuyerConfigResponse) GetExpiryDelta() int64 {
+	if m != nil {
+		return m.ExpiryDelta
+	}
+	return 0
+}
+
+func (m *TicketBuyerConfigResponse) GetFeeSource() string {
+	if m != nil {
+		return m.FeeSource
+	}
+	return ""
+}
+
+func (m *TicketBuyerConfigResponse) GetFeeTargetScaling() float64 {
+	if m != nil {
+		return m.FeeTargetScaling
+	}
+	return 0
+}
+
+func (m *TicketBuyerConfigResponse) GetMinFee() int64 {
+	if m != nil {
+		return m.MinFee
+	}
+	return 0
+}
+
+func (m *TicketBuyerConfigResponse) GetMaxFee() int64 {
+	if m != nil {
+		return m.MaxFee
+	}
+	return 0
+}
+
+func (m *TicketBuyerConfigResponse) GetMaxPerBlock() int64 {
+	if m != nil {
+		return m.MaxPerBlock
+	}
+	return 0
+}
+
+func (m *TicketBuyerConfigResponse) GetMaxPriceAbsolute() int64 {
+	if m != nil {
+		return m.MaxPriceAbsolute
+	}
+	return 0
+}
+
+func (m *TicketBuyerConfigResponse) GetMaxPriceRelative() float64 {
+	if m != nil {
+		return m.MaxPriceRelative
+	}
+	return 0
+}
+
+func (m *TicketBuyerConfigResponse) GetMaxInMempool() int64 {
+	if m != nil {
+		return m.MaxInMempool
+	}
+	return 0
+}
+
+func (m *TicketBuyerConfigResponse) GetPoolAddress() string {
+	if m != nil {
+		return m.PoolAddress
+	}
+	return ""
+}
+
+func (m *TicketBuyerConfigResponse) GetPoolFees() float64 {
+	if m != nil {
+		return m.PoolFees
+	}
+	return 0
+}
+
+func (m *TicketBuyerConfigResponse) GetSpreadTicketPurchases() bool {
+	if m != nil {
+		return m.SpreadTicketPurchases
+	}
+	return false
+}
+
+func (m *TicketBuyerConfigResponse) GetVotingAddress() string {
+	if m != nil {
+		return m.VotingAddress
+	}
+	return ""
+}
+
+func (m *TicketBuyerConfigResponse) GetTxFee() int64 {
+	if m != nil {
+		return m.TxFee
+	}
+	return 0
+}
+
+type SetAccountRequest struct {
+	Account uint32 `protobuf:"varint,1,opt,name=account" json:"account,omitempty"`
+}
+
+func (m *SetAccountRequest) Reset()                    { *m = SetAccountRequest{} }
+func (m *SetAccountRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetAccountRequest) ProtoMessage()               {}
+func (*SetAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{164} }
+
+func (m *SetAccountRequest) GetAccount() uint32 {
+	if m != nil {
+		return m.Account
+	}
+	return 0
+}
+
+type SetAccountResponse struct {
+}
+
+func (m *SetAccountResponse) Reset()                    { *m = SetAccountResponse{} }
+func (m *SetAccountResponse) String() string            { return proto.CompactTextString(m) }
+func (*SetAccountResponse) ProtoMessage()               {}
+func (*SetAccountResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{165} }
+
+type SetBalanceToMaintainRequest struct {
+	BalanceToMaintain int64 `protobuf:"varint,1,opt,name=balance_to_maintain,json=balanceToMaintain" json:"balance_to_maintain,omitempty"`
+}
+
+func (m *SetBalanceToMaintainRequest) Reset()                    { *m = SetBalanceToMaintainRequest{} }
+func (m *SetBalanceToMaintainRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetBalanceToMaintainRequest) ProtoMessage()               {}
+func (*SetBalanceToMaintainRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{166} }
+
+func (m *SetBalanceToMaintainRequest) GetBalanceToMaintain() int64 {
+	if m != nil {
+		return m.BalanceToMaintain
+	}
+	return 0
+}
+
+type SetBalanceToMaintainResponse struct {
+}
+
+func (m *SetBalanceToMaintainResponse) Reset()                    { *m = SetBalanceToMaintainResponse{} }
+func (m *SetBalanceToMaintainResponse) String() string            { return proto.CompactTextString(m) }
+func (*SetBalanceToMaintainResponse) ProtoMessage()               {}
+func (*SetBalanceToMaintainResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{167} }
+
+type SetMaxFeeRequest struct {
+	MaxFeePerKb int64 `protobuf:"varint,1,opt,name=max_fee_per_kb,json=maxFeePerKb" json:"max_fee_per_kb,omitempty"`
+}
+
+func (m *SetMaxFeeRequest) Reset()                    { *m = SetMaxFeeRequest{} }
+func (m *SetMaxFeeRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetMaxFeeRequest) ProtoMessage()               {}
+func (*SetMaxFeeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{168} }
+
+func (m *SetMaxFeeRequest) GetMaxFeePerKb() int64 {
+	if m != nil {
+		return m.MaxFeePerKb
+	}
+	return 0
+}
+
+type SetMaxFeeResponse struct {
+}
+
+func (m *SetMaxFeeResponse) Reset()                    { *m = SetMaxFeeResponse{} }
+func (m *SetMaxFeeResponse) String() string            { return proto.CompactTextString(m) }
+func (*SetMaxFeeResponse) ProtoMessage()               {}
+func (*SetMaxFeeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{169} }
+
+type SetMaxPriceRelativeRequest struct {
+	MaxPriceRelative float64 `protobuf:"fixed64,1,opt,name=max_price_relative,json=maxPriceRelative" json:"max_price_relative,omitempty"`
+}
+
+func (m *SetMaxPriceRelativeRequest) Reset()                    { *m = SetMaxPriceRelativeRequest{} }
+func (m *SetMaxPriceRelativeRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetMaxPriceRelativeRequest) ProtoMessage()               {}
+func (*SetMaxPriceRelativeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{170} }
+
+func (m *SetMaxPriceRelativeRequest) GetMaxPriceRelative() float64 {
+	if m != nil {
+		return m.MaxPriceRelative
+	}
+	return 0
+}
+
+type SetMaxPriceRelativeResponse struct {
+}
+
+func (m *SetMaxPriceRelativeResponse) Reset()                    { *m = SetMaxPriceRelativeResponse{} }
+func (m *SetMaxPriceRelativeResponse) String() string            { return proto.CompactTextString(m) }
+func (*SetMaxPriceRelativeResponse) ProtoMessage()               {}
+func (*SetMaxPriceRelativeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{171} }
+
+type SetMaxPriceAbsoluteRequest struct {
+	MaxPriceAbsolute int64 `protobuf:"varint,1,opt,name=max_price_absolute,json=maxPriceAbsolute" json:"max_price_absolute,omitempty"`
+}
+
+func (m *SetMaxPriceAbsoluteRequest) Reset()                    { *m = SetMaxPriceAbsoluteRequest{} }
+func (m *SetMaxPriceAbsoluteRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetMaxPriceAbsoluteRequest) ProtoMessage()               {}
+func (*SetMaxPriceAbsoluteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{172} }
+
+func (m *SetMaxPriceAbsoluteRequest) GetMaxPriceAbsolute() int64 {
+	if m != nil {
+		return m.MaxPriceAbsolute
+	}
+	return 0
+}
+
+type SetMaxPriceAbsoluteResponse struct {
+}
+
+func (m *SetMaxPriceAbsoluteResponse) Reset()                    { *m = SetMaxPriceAbsoluteResponse{} }
+func (m *SetMaxPriceAbsoluteResponse) String() string            { return proto.CompactTextString(m) }
+func (*SetMaxPriceAbsoluteResponse) ProtoMessage()               {}
+func (*SetMaxPriceAbsoluteResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{173} }
+
+type SetVotingAddressRequest struct {
+	VotingAddress string `protobuf:"bytes,1,opt,name=voting_address,json=votingAddress" json:"voting_address,omitempty"`
+}
+
+func (m *SetVotingAddressRequest) Reset()                    { *m = SetVotingAddressRequest{} }
+func (m *SetVotingAddressRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetVotingAddressRequest) ProtoMessage()               {}
+func (*SetVotingAddressRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{174} }
+
+func (m *SetVotingAddressRequest) GetVotingAddress() string {
+	if m != nil {
+		return m.VotingAddress
+	}
+	return ""
+}
+
+type SetVotingAddressResponse struct {
+}
+
+func (m *SetVotingAddressResponse) Reset()                    { *m = SetVotingAddressResponse{} }
+func (m *SetVotingAddressResponse) String() string            { return proto.CompactTextString(m) }
+func (*SetVotingAddressResponse) ProtoMessage()               {}
+func (*SetVotingAddressResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{175} }
+
+type SetPoolAddressRequest struct {
+	PoolAddress string `protobuf:"bytes,1,opt,name=pool_address,json=poolAddress" json:"pool_address,omitempty"`
+}
+
+func (m *SetPoolAddressRequest) Reset()                    { *m = SetPoolAddressRequest{} }
+func (m *SetPoolAddressRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetPoolAddressRequest) ProtoMessage()               {}
+func (*SetPoolAddressRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{176} }
+
+func (m *SetPoolAddressRequest) GetPoolAddress() string {
+	if m != nil {
+		return m.PoolAddress
+	}
+	return ""
+}
+
+type SetPoolAddressResponse struct {
+}
+
+func (m *SetPoolAddressResponse) Reset()                    { *m = SetPoolAddressResponse{} }
+func (m *SetPoolAddressResponse) String() string            { return proto.CompactTextString(m) }
+func (*SetPoolAddressResponse) ProtoMessage()               {}
+func (*SetPoolAddressResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{177} }
+
+type SetPoolFeesRequest struct {
+	PoolFees float64 `protobuf:"fixed64,1,opt,name=pool_fees,json=poolFees" json:"pool_fees,omitempty"`
+}
+
+func (m *SetPoolFeesRequest) Reset()                    { *m = SetPoolFeesRequest{} }
+func (m *SetPoolFeesRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetPoolFeesRequest) ProtoMessage()               {}
+func (*SetPoolFeesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{178} }
+
+func (m *SetPoolFeesRequest) GetPoolFees() float64 {
+	if m != nil {
+		return m.PoolFees
+	}
+	return 0
+}
+
+type SetPoolFeesResponse struct {
+}
+
+func (m *SetPoolFeesResponse) Reset()                    { *m = SetPoolFeesResponse{} }
+func (m *SetPoolFeesResponse) String() string            { return proto.CompactTextString(m) }
+func (*SetPoolFeesResponse) ProtoMessage()               {}
+func (*SetPoolFeesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{179} }
+
+type SetMaxPerBlockRequest struct {
+	MaxPerBlock int64 `protobuf:"varint,1,opt,name=max_per_block,json=maxPerBlock" json:"max_per_block,omitempty"`
+}
+
+func (m *SetMaxPerBlockRequest) Reset()                    { *m = SetMaxPerBlockRequest{} }
+func (m *SetMaxPerBlockRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetMaxPerBlockRequest) ProtoMessage()               {}
+func (*SetMaxPerBlockRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{180} }
+
+func (m *SetMaxPerBlockRequest) GetMaxPerBlock() int64 {
+	if m != nil {
+		return m.MaxPerBlock
+	}
+	return 0
+}
+
+type SetMaxPerBlockResponse struct {
+}
+
+func (m *SetMaxPerBlockResponse) Reset()                    { *m = SetMaxPerBlockResponse{} }
+func (m *SetMaxPerBlockResponse) String() string            { return proto.CompactTextString(m) }
+func (*SetMaxPerBlockResponse) ProtoMessage()               {}
+func (*SetMaxPerBlockResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{181} }
+
+type AgendasRequest struct {
+}
+
+func (m *AgendasRequest) Reset()                    { *m = AgendasRequest{} }
+func (m *AgendasRequest) String() string            { return proto.CompactTextString(m) }
+func (*AgendasRequest) ProtoMessage()               {}
+func (*AgendasRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{182} }
+
+type AgendasResponse struct {
+	Version uint32                    `protobuf:"varint,1,opt,name=version" json:"version,omitempty"`
+	Agendas []*AgendasResponse_Agenda `protobuf:"bytes,2,rep,name=agendas" json:"agendas,omitempty"`
+}
+
+func (m *AgendasResponse) Reset()                    { *m = AgendasResponse{} }
+func (m *AgendasResponse) String() string            { return proto.CompactTextString(m) }
+func (*AgendasResponse) ProtoMessage()               {}
+func (*AgendasResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{183} }
+
+func (m *AgendasResponse) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *AgendasResponse) GetAgendas() []*AgendasResponse_Agenda {
+	if m != nil {
+		return m.Agendas
+	}
+	return nil
+}
+
+type AgendasResponse_Agenda struct {
+	Id          string                    `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Description string                    `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+	Mask        uint32                    `protobuf:"varint,3,opt,name=mask" json:"mask,omitempty"`
+	Choices     []*AgendasResponse_Choice `protobuf:"bytes,4,rep,name=choices" json:"choices,omitempty"`
+	StartTime   int64                     `protobuf:"varint,5,opt,name=start_time,json=startTime" json:"start_time,omitempty"`
+	ExpireTime  int64                     `protobuf:"varint,6,opt,name=expire_time,json=expireTime" json:"expire_time,omitempty"`
+}
+
+func (m *AgendasResponse_Agenda) Reset()                    { *m = AgendasResponse_Agenda{} }
+func (m *AgendasResponse_Agenda) String() string            { return proto.CompactTextString(m) }
+func (*AgendasResponse_Agenda) ProtoMessage()               {}
+func (*AgendasResponse_Agenda) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{183, 0} }
+
+func (m *AgendasResponse_Agenda) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *AgendasResponse_Agenda) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *AgendasResponse_Agenda) GetMask() uint32 {
+	if m != nil {
+		return m.Mask
+	}
+	return 0
+}
+
+func (m *AgendasResponse_Agenda) GetChoices() []*AgendasResponse_Choice {
+	if m != nil {
+		return m.Choices
+	}
+	return nil
+}
+
+func (m *AgendasResponse_Agenda) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *AgendasResponse_Agenda) GetExpireTime() int64 {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return 0
+}
+
+type AgendasResponse_Choice struct {
+	Id          string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+	Bits        uint32 `protobuf:"varint,3,opt,name=bits" json:"bits,omitempty"`
+	IsAbstain   bool   `protobuf:"varint,4,opt,name=is_abstain,json=isAbstain" json:"is_abstain,omitempty"`
+	IsNo        bool   `protobuf:"varint,5,opt,name=is_no,json=isNo" json:"is_no,omitempty"`
+}
+
+func (m *AgendasResponse_Choice) Reset()                    { *m = AgendasResponse_Choice{} }
+func (m *AgendasResponse_Choice) String() string            { return proto.CompactTextString(m) }
+func (*AgendasResponse_Choice) ProtoMessage()               {}
+func (*AgendasResponse_Choice) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{183, 1} }
+
+func (m *AgendasResponse_Choice) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *AgendasResponse_Choice) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *AgendasResponse_Choice) GetBits() uint32 {
+	if m != nil {
+		return m.Bits
+	}
+	return 0
+}
+
+func (m *AgendasResponse_Choice) GetIsAbstain() bool {
+	if m != nil {
+		return m.IsAbstain
+	}
+	return false
+}
+
+func (m *AgendasResponse_Choice) GetIsNo() bool {
+	if m != nil {
+		return m.IsNo
+	}
+	return false
+}
+
+type VoteChoicesRequest struct {
+}
+
+func (m *VoteChoicesRequest) Reset()                    { *m = VoteChoicesRequest{} }
+func (m *VoteChoicesRequest) String() string            { return proto.CompactTextString(m) }
+func (*VoteChoicesRequest) ProtoMessage()               {}
+func (*VoteChoicesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{184} }
+
+type VoteChoicesResponse struct {
+	Version  uint32                        `protobuf:"varint,1,opt,name=version" json:"version,omitempty"`
+	Choices  []*VoteChoicesResponse_Choice `protobuf:"bytes,2,rep,name=choices" json:"choices,omitempty"`
+	Votebits uint32                        `protobuf:"varint,3,opt,name=votebits" json:"votebits,omitempty"`
+}
+
+func (m *VoteChoicesResponse) Reset()                    { *m = VoteChoicesResponse{} }
+func (m *VoteChoicesResponse) String() string            { return proto.CompactTextString(m) }
+func (*VoteChoicesResponse) ProtoMessage()               {}
+func (*VoteChoicesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{185} }
+
+func (m *VoteChoicesResponse) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *VoteChoicesResponse) GetChoices() []*VoteChoicesResponse_Choice {
+	if m != nil {
+		return m.Choices
+	}
+	return nil
+}
+
+func (m *VoteChoicesResponse) GetVotebits() uint32 {
+	if m != nil {
+		return m.Votebits
+	}
+	return 0
+}
+
+type VoteChoicesResponse_Choice struct {
+	AgendaId          string `protobuf:"bytes,1,opt,name=agenda_id,json=agendaId" json:"agenda_id,omitempty"`
+	AgendaDescription string `protobuf:"bytes,2,opt,name=agenda_description,json=agendaDescription" json:"agenda_description,omitempty"`
+	ChoiceId          string `protobuf:"bytes,3,opt,name=choice_id,json=choiceId" json:"choice_id,omitempty"`
+	ChoiceDescription string `protobuf:"bytes,4,opt,name=choice_description,json=choiceDescription" json:"choice_description,omitempty"`
+}
+
+func (m *VoteChoicesResponse_Choice) Reset()         { *m = VoteChoicesResponse_Choice{} }
+func (m *VoteChoicesResponse_Choice) String() string { return proto.CompactTextString(m) }
+func (*VoteChoicesResponse_Choice) ProtoMessage()    {}
+func (*VoteChoicesResponse_Choice) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{185, 0}
+}
+
+func (m *VoteChoicesResponse_Choice) GetAgendaId() string {
+	if m != nil {
+		return m.AgendaId
+	}
+	return ""
+}
+
+func (m *VoteChoicesResponse_Choice) GetAgendaDescription() string {
+	if m != nil {
+		return m.AgendaDescription
+	}
+	return ""
+}
+
+func (m *VoteChoicesResponse_Choice) GetChoiceId() string {
+	if m != nil {
+		return m.ChoiceId
+	}
+	return ""
+}
+
+func (m *VoteChoicesResponse_Choice) GetChoiceDescription() string {
+	if m != nil {
+		return m.ChoiceDescription
+	}
+	return ""
+}
+
+type SetVoteChoicesRequest struct {
+	Choices []*SetVoteChoicesRequest_Choice `protobuf:"bytes,1,rep,name=choices" json:"choices,omitempty"`
+}
+
+func (m *SetVoteChoicesRequest) Reset()                    { *m = SetVoteChoicesRequest{} }
+func (m *SetVoteChoicesRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetVoteChoicesRequest) ProtoMessage()               {}
+func (*SetVoteChoicesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{186} }
+
+func (m *SetVoteChoicesRequest) GetChoices() []*SetVoteChoicesRequest_Choice {
+	if m != nil {
+		return m.Choices
+	}
+	return nil
+}
+
+type SetVoteChoicesRequest_Choice struct {
+	AgendaId string `protobuf:"bytes,1,opt,name=agenda_id,json=agendaId" json:"agenda_id,omitempty"`
+	ChoiceId string `protobuf:"bytes,2,opt,name=choice_id,json=choiceId" json:"choice_id,omitempty"`
+}
+
+func (m *SetVoteChoicesRequest_Choice) Reset()         { *m = SetVoteChoicesRequest_Choice{} }
+func (m *SetVoteChoicesRequest_Choice) String() string { return proto.CompactTextString(m) }
+func (*SetVoteChoicesRequest_Choice) ProtoMessage()    {}
+func (*SetVoteChoicesRequest_Choice) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{186, 0}
+}
+
+func (m *SetVoteChoicesRequest_Choice) GetAgendaId() string {
+	if m != nil {
+		return m.AgendaId
+	}
+	return ""
+}
+
+func (m *SetVoteChoicesRequest_Choice) GetChoiceId() string {
+	if m != nil {
+		return m.ChoiceId
+	}
+	return ""
+}
+
+type SetVoteChoicesResponse struct {
+	Votebits uint32 `protobuf:"varint,1,opt,name=votebits" json:"votebits,omitempty"`
+}
+
+func (m *SetVoteChoicesResponse) Reset()                    { *m = SetVoteChoicesResponse{} }
+func (m *SetVoteChoicesResponse) String() string            { return proto.CompactTextString(m) }
+func (*SetVoteChoicesResponse) ProtoMessage()               {}
+func (*SetVoteChoicesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{187} }
+
+func (m *SetVoteChoicesResponse) GetVotebits() uint32 {
+	if m != nil {
+		return m.Votebits
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*VersionRequest)(nil), "walletrpc.VersionRequest")
 	proto.RegisterType((*VersionResponse)(nil), "walletrpc.VersionResponse")
 	proto.RegisterType((*TransactionDetails)(nil), "walletrpc.TransactionDetails")
 	proto.RegisterType((*TransactionDetails_Input)(nil), "walletrpc.TransactionDetails.Input")
 	proto.RegisterType((*TransactionDetails_Output)(nil), "walletrpc.TransactionDetails.Output")
+	proto.RegisterType((*TransactionDetails_DataOutput)(nil), "walletrpc.TransactionDetails.DataOutput")
 	proto.RegisterType((*BlockDetails)(nil), "walletrpc.BlockDetails")
 	proto.RegisterType((*AccountBalance)(nil), "walletrpc.AccountBalance")
 	proto.RegisterType((*PingRequest)(nil), "walletrpc.PingRequest")
@@ -3355,6 +6251,8 @@ func init() {
 	proto.RegisterType((*RescanResponse)(nil), "walletrpc.RescanResponse")
 	proto.RegisterType((*NextAccountRequest)(nil), "walletrpc.NextAccountRequest")
 	proto.RegisterType((*NextAccountResponse)(nil), "walletrpc.NextAccountResponse")
+	proto.RegisterType((*NextMultisigAccountRequest)(nil), "walletrpc.NextMultisigAccountRequest")
+	proto.RegisterType((*NextMultisigAccountResponse)(nil), "walletrpc.NextMultisigAccountResponse")
 	proto.RegisterType((*NextAddressRequest)(nil), "walletrpc.NextAddressRequest")
 	proto.RegisterType((*NextAddressResponse)(nil), "walletrpc.NextAddressResponse")
 	proto.RegisterType((*ImportPrivateKeyRequest)(nil), "walletrpc.ImportPrivateKeyRequest")
@@ -3381,17 +6279,105 @@ func init() {
 	proto.RegisterType((*ConstructTransactionRequest)(nil), "walletrpc.ConstructTransactionRequest")
 	proto.RegisterType((*ConstructTransactionRequest_OutputDestination)(nil), "walletrpc.ConstructTransactionRequest.OutputDestination")
 	proto.RegisterType((*ConstructTransactionRequest_Output)(nil), "walletrpc.ConstructTransactionRequest.Output")
+	proto.RegisterType((*ConstructTransactionRequest_OutPoint)(nil), "walletrpc.ConstructTransactionRequest.OutPoint")
 	proto.RegisterType((*ConstructTransactionResponse)(nil), "walletrpc.ConstructTransactionResponse")
 	proto.RegisterType((*SignTransactionRequest)(nil), "walletrpc.SignTransactionRequest")
 	proto.RegisterType((*SignTransactionResponse)(nil), "walletrpc.SignTransactionResponse")
 	proto.RegisterType((*PublishTransactionRequest)(nil), "walletrpc.PublishTransactionRequest")
 	proto.RegisterType((*PublishTransactionResponse)(nil), "walletrpc.PublishTransactionResponse")
+	proto.RegisterType((*SweepAccountRequest)(nil), "walletrpc.SweepAccountRequest")
+	proto.RegisterType((*SweepAccountRequest_Destination)(nil), "walletrpc.SweepAccountRequest.Destination")
+	proto.RegisterType((*SweepAccountResponse)(nil), "walletrpc.SweepAccountResponse")
+	proto.RegisterType((*AbandonTransactionRequest)(nil), "walletrpc.AbandonTransactionRequest")
+	proto.RegisterType((*AbandonTransactionResponse)(nil), "walletrpc.AbandonTransactionResponse")
+	proto.RegisterType((*BumpFeeRequest)(nil), "walletrpc.BumpFeeRequest")
+	proto.RegisterType((*BumpFeeResponse)(nil), "walletrpc.BumpFeeResponse")
+	proto.RegisterType((*CreatePartialTransactionRequest)(nil), "walletrpc.CreatePartialTransactionRequest")
+	proto.RegisterType((*CreatePartialTransactionResponse)(nil), "walletrpc.CreatePartialTransactionResponse")
+	proto.RegisterType((*UpdatePartialTransactionRequest)(nil), "walletrpc.UpdatePartialTransactionRequest")
+	proto.RegisterType((*UpdatePartialTransactionResponse)(nil), "walletrpc.UpdatePartialTransactionResponse")
+	proto.RegisterType((*SignPartialTransactionRequest)(nil), "walletrpc.SignPartialTransactionRequest")
+	proto.RegisterType((*SignPartialTransactionResponse)(nil), "walletrpc.SignPartialTransactionResponse")
+	proto.RegisterType((*CombinePartialTransactionsRequest)(nil), "walletrpc.CombinePartialTransactionsRequest")
+	proto.RegisterType((*CombinePartialTransactionsResponse)(nil), "walletrpc.CombinePartialTransactionsResponse")
+	proto.RegisterType((*FinalizePartialTransactionRequest)(nil), "walletrpc.FinalizePartialTransactionRequest")
+	proto.RegisterType((*FinalizePartialTransactionResponse)(nil), "walletrpc.FinalizePartialTransactionResponse")
+	proto.RegisterType((*SigningSession)(nil), "walletrpc.SigningSession")
+	proto.RegisterType((*SigningSession_Input)(nil), "walletrpc.SigningSession.Input")
+	proto.RegisterType((*CreateSigningSessionRequest)(nil), "walletrpc.CreateSigningSessionRequest")
+	proto.RegisterType((*CreateSigningSessionRequest_MultisigOutPoint)(nil), "walletrpc.CreateSigningSessionRequest.MultisigOutPoint")
+	proto.RegisterType((*CreateSigningSessionResponse)(nil), "walletrpc.CreateSigningSessionResponse")
+	proto.RegisterType((*SigningSessionsRequest)(nil), "walletrpc.SigningSessionsRequest")
+	proto.RegisterType((*SigningSessionsResponse)(nil), "walletrpc.SigningSessionsResponse")
+	proto.RegisterType((*ImportSigningSessionSignaturesRequest)(nil), "walletrpc.ImportSigningSessionSignaturesRequest")
+	proto.RegisterType((*ImportSigningSessionSignaturesResponse)(nil), "walletrpc.ImportSigningSessionSignaturesResponse")
+	proto.RegisterType((*SignSigningSessionRequest)(nil), "walletrpc.SignSigningSessionRequest")
+	proto.RegisterType((*SignSigningSessionResponse)(nil), "walletrpc.SignSigningSessionResponse")
+	proto.RegisterType((*AbandonSigningSessionRequest)(nil), "walletrpc.AbandonSigningSessionRequest")
+	proto.RegisterType((*AbandonSigningSessionResponse)(nil), "walletrpc.AbandonSigningSessionResponse")
+	proto.RegisterType((*SwapContract)(nil), "walletrpc.SwapContract")
+	proto.RegisterType((*InitiateSwapRequest)(nil), "walletrpc.InitiateSwapRequest")
+	proto.RegisterType((*InitiateSwapResponse)(nil), "walletrpc.InitiateSwapResponse")
+	proto.RegisterType((*ParticipateSwapRequest)(nil), "walletrpc.ParticipateSwapRequest")
+	proto.RegisterType((*ParticipateSwapResponse)(nil), "walletrpc.ParticipateSwapResponse")
+	proto.RegisterType((*RedeemSwapRequest)(nil), "walletrpc.RedeemSwapRequest")
+	proto.RegisterType((*RedeemSwapResponse)(nil), "walletrpc.RedeemSwapResponse")
+	proto.RegisterType((*RefundSwapRequest)(nil), "walletrpc.RefundSwapRequest")
+	proto.RegisterType((*RefundSwapResponse)(nil), "walletrpc.RefundSwapResponse")
+	proto.RegisterType((*ExtractSwapSecretRequest)(nil), "walletrpc.ExtractSwapSecretRequest")
+	proto.RegisterType((*ExtractSwapSecretResponse)(nil), "walletrpc.ExtractSwapSecretResponse")
+	proto.RegisterType((*AuditSwapContractRequest)(nil), "walletrpc.AuditSwapContractRequest")
+	proto.RegisterType((*AuditSwapContractResponse)(nil), "walletrpc.AuditSwapContractResponse")
+	proto.RegisterType((*SwapContractsRequest)(nil), "walletrpc.SwapContractsRequest")
+	proto.RegisterType((*SwapContractsResponse)(nil), "walletrpc.SwapContractsResponse")
+	proto.RegisterType((*Vault)(nil), "walletrpc.Vault")
+	proto.RegisterType((*CreateVaultRequest)(nil), "walletrpc.CreateVaultRequest")
+	proto.RegisterType((*CreateVaultResponse)(nil), "walletrpc.CreateVaultResponse")
+	proto.RegisterType((*VaultsRequest)(nil), "walletrpc.VaultsRequest")
+	proto.RegisterType((*VaultsResponse)(nil), "walletrpc.VaultsResponse")
 	proto.RegisterType((*PurchaseTicketsRequest)(nil), "walletrpc.PurchaseTicketsRequest")
 	proto.RegisterType((*PurchaseTicketsResponse)(nil), "walletrpc.PurchaseTicketsResponse")
+	proto.RegisterType((*PurchaseTicketsResponse_Preview)(nil), "walletrpc.PurchaseTicketsResponse.Preview")
 	proto.RegisterType((*RevokeTicketsRequest)(nil), "walletrpc.RevokeTicketsRequest")
 	proto.RegisterType((*RevokeTicketsResponse)(nil), "walletrpc.RevokeTicketsResponse")
 	proto.RegisterType((*LoadActiveDataFiltersRequest)(nil), "walletrpc.LoadActiveDataFiltersRequest")
 	proto.RegisterType((*LoadActiveDataFiltersResponse)(nil), "walletrpc.LoadActiveDataFiltersResponse")
+	proto.RegisterType((*LockedOutpointsRequest)(nil), "walletrpc.LockedOutpointsRequest")
+	proto.RegisterType((*LockedOutpointsResponse)(nil), "walletrpc.LockedOutpointsResponse")
+	proto.RegisterType((*LockedOutpointsResponse_LockedOutpoint)(nil), "walletrpc.LockedOutpointsResponse.LockedOutpoint")
+	proto.RegisterType((*LockOutpointRequest)(nil), "walletrpc.LockOutpointRequest")
+	proto.RegisterType((*LockOutpointResponse)(nil), "walletrpc.LockOutpointResponse")
+	proto.RegisterType((*UnlockOutpointRequest)(nil), "walletrpc.UnlockOutpointRequest")
+	proto.RegisterType((*UnlockOutpointResponse)(nil), "walletrpc.UnlockOutpointResponse")
+	proto.RegisterType((*TransactionLabelRequest)(nil), "walletrpc.TransactionLabelRequest")
+	proto.RegisterType((*TransactionLabelResponse)(nil), "walletrpc.TransactionLabelResponse")
+	proto.RegisterType((*SearchTransactionLabelsRequest)(nil), "walletrpc.SearchTransactionLabelsRequest")
+	proto.RegisterType((*SearchTransactionLabelsResponse)(nil), "walletrpc.SearchTransactionLabelsResponse")
+	proto.RegisterType((*SearchTransactionLabelsResponse_TransactionLabel)(nil), "walletrpc.SearchTransactionLabelsResponse.TransactionLabel")
+	proto.RegisterType((*SetTransactionLabelRequest)(nil), "walletrpc.SetTransactionLabelRequest")
+	proto.RegisterType((*SetTransactionLabelResponse)(nil), "walletrpc.SetTransactionLabelResponse")
+	proto.RegisterType((*AddressLabelsRequest)(nil), "walletrpc.AddressLabelsRequest")
+	proto.RegisterType((*AddressLabelsResponse)(nil), "walletrpc.AddressLabelsResponse")
+	proto.RegisterType((*AddressLabelsResponse_AddressLabel)(nil), "walletrpc.AddressLabelsResponse.AddressLabel")
+	proto.RegisterType((*SetAddressLabelRequest)(nil), "walletrpc.SetAddressLabelRequest")
+	proto.RegisterType((*SetAddressLabelResponse)(nil), "walletrpc.SetAddressLabelResponse")
+	proto.RegisterType((*PayeesRequest)(nil), "walletrpc.PayeesRequest")
+	proto.RegisterType((*PayeesResponse)(nil), "walletrpc.PayeesResponse")
+	proto.RegisterType((*PayeesResponse_Payee)(nil), "walletrpc.PayeesResponse.Payee")
+	proto.RegisterType((*SetPayeeRequest)(nil), "walletrpc.SetPayeeRequest")
+	proto.RegisterType((*SetPayeeResponse)(nil), "walletrpc.SetPayeeResponse")
+	proto.RegisterType((*RemovePayeeRequest)(nil), "walletrpc.RemovePayeeRequest")
+	proto.RegisterType((*RemovePayeeResponse)(nil), "walletrpc.RemovePayeeResponse")
+	proto.RegisterType((*UnminedTransactionsRequest)(nil), "walletrpc.UnminedTransactionsRequest")
+	proto.RegisterType((*UnminedTransactionsResponse)(nil), "walletrpc.UnminedTransactionsResponse")
+	proto.RegisterType((*UnminedTransactionsResponse_UnminedTransaction)(nil), "walletrpc.UnminedTransactionsResponse.UnminedTransaction")
+	proto.RegisterType((*AddressTransactionsRequest)(nil), "walletrpc.AddressTransactionsRequest")
+	proto.RegisterType((*AddressTransactionsResponse)(nil), "walletrpc.AddressTransactionsResponse")
+	proto.RegisterType((*BackupWalletRequest)(nil), "walletrpc.BackupWalletRequest")
+	proto.RegisterType((*BackupWalletResponse)(nil), "walletrpc.BackupWalletResponse")
+	proto.RegisterType((*CheckConsistencyRequest)(nil), "walletrpc.CheckConsistencyRequest")
+	proto.RegisterType((*CheckConsistencyResponse)(nil), "walletrpc.CheckConsistencyResponse")
+	proto.RegisterType((*CheckConsistencyResponse_Problem)(nil), "walletrpc.CheckConsistencyResponse.Problem")
 	proto.RegisterType((*TransactionNotificationsRequest)(nil), "walletrpc.TransactionNotificationsRequest")
 	proto.RegisterType((*TransactionNotificationsResponse)(nil), "walletrpc.TransactionNotificationsResponse")
 	proto.RegisterType((*AccountNotificationsRequest)(nil), "walletrpc.AccountNotificationsRequest")
@@ -3458,1021 +6444,2152 @@ func init() {
 	proto.RegisterEnum("walletrpc.NextAddressRequest_GapPolicy", NextAddressRequest_GapPolicy_name, NextAddressRequest_GapPolicy_value)
 	proto.RegisterEnum("walletrpc.ChangePassphraseRequest_Key", ChangePassphraseRequest_Key_name, ChangePassphraseRequest_Key_value)
 	proto.RegisterEnum("walletrpc.ConstructTransactionRequest_OutputSelectionAlgorithm", ConstructTransactionRequest_OutputSelectionAlgorithm_name, ConstructTransactionRequest_OutputSelectionAlgorithm_value)
+	proto.RegisterEnum("walletrpc.ConstructTransactionRequest_CoinSelectionStrategy", ConstructTransactionRequest_CoinSelectionStrategy_name, ConstructTransactionRequest_CoinSelectionStrategy_value)
+	proto.RegisterEnum("walletrpc.SwapContract_Role", SwapContract_Role_name, SwapContract_Role_value)
+	proto.RegisterEnum("walletrpc.Vault_LockType", Vault_LockType_name, Vault_LockType_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for VersionService service
+
+type VersionServiceClient interface {
+	Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error)
+}
+
+type versionServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewVersionServiceClient(cc *grpc.ClientConn) VersionServiceClient {
+	return &versionServiceClient{cc}
+}
+
+func (c *versionServiceClient) Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error) {
+	out := new(VersionResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.VersionService/Version", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for VersionService service
+
+type VersionServiceServer interface {
+	Version(context.Context, *VersionRequest) (*VersionResponse, error)
+}
+
+func RegisterVersionServiceServer(s *grpc.Server, srv VersionServiceServer) {
+	s.RegisterService(&_VersionService_serviceDesc, srv)
+}
+
+func _VersionService_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VersionServiceServer).Version(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.VersionService/Version",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VersionServiceServer).Version(ctx, req.(*VersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _VersionService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "walletrpc.VersionService",
+	HandlerType: (*VersionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Version",
+			Handler:    _VersionService_Version_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api.proto",
+}
+
+// Client API for WalletService service
+
+type WalletServiceClient interface {
+	// Queries
+	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
+	Network(ctx context.Context, in *NetworkRequest, opts ...grpc.CallOption) (*NetworkResponse, error)
+	AccountNumber(ctx context.Context, in *AccountNumberRequest, opts ...grpc.CallOption) (*AccountNumberResponse, error)
+	Accounts(ctx context.Context, in *AccountsRequest, opts ...grpc.CallOption) (*AccountsResponse, error)
+	Balance(ctx context.Context, in *BalanceRequest, opts ...grpc.CallOption) (*BalanceResponse, error)
+	GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionResponse, error)
+	GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (WalletService_GetTransactionsClient, error)
+	TicketPrice(ctx context.Context, in *TicketPriceRequest, opts ...grpc.CallOption) (*TicketPriceResponse, error)
+	StakeInfo(ctx context.Context, in *StakeInfoRequest, opts ...grpc.CallOption) (*StakeInfoResponse, error)
+	BlockInfo(ctx context.Context, in *BlockInfoRequest, opts ...grpc.CallOption) (*BlockInfoResponse, error)
+	LockedOutpoints(ctx context.Context, in *LockedOutpointsRequest, opts ...grpc.CallOption) (*LockedOutpointsResponse, error)
+	TransactionLabel(ctx context.Context, in *TransactionLabelRequest, opts ...grpc.CallOption) (*TransactionLabelResponse, error)
+	SearchTransactionLabels(ctx context.Context, in *SearchTransactionLabelsRequest, opts ...grpc.CallOption) (*SearchTransactionLabelsResponse, error)
+	AddressLabels(ctx context.Context, in *AddressLabelsRequest, opts ...grpc.CallOption) (*AddressLabelsResponse, error)
+	Payees(ctx context.Context, in *PayeesRequest, opts ...grpc.CallOption) (*PayeesResponse, error)
+	UnminedTransactions(ctx context.Context, in *UnminedTransactionsRequest, opts ...grpc.CallOption) (*UnminedTransactionsResponse, error)
+	AddressTransactions(ctx context.Context, in *AddressTransactionsRequest, opts ...grpc.CallOption) (WalletService_AddressTransactionsClient, error)
+	// Notifications
+	TransactionNotifications(ctx context.Context, in *TransactionNotificationsRequest, opts ...grpc.CallOption) (WalletService_TransactionNotificationsClient, error)
+	AccountNotifications(ctx context.Context, in *AccountNotificationsRequest, opts ...grpc.CallOption) (WalletService_AccountNotificationsClient, error)
+	ConfirmationNotifications(ctx context.Context, opts ...grpc.CallOption) (WalletService_ConfirmationNotificationsClient, error)
+	// Control
+	ChangePassphrase(ctx context.Context, in *ChangePassphraseRequest, opts ...grpc.CallOption) (*ChangePassphraseResponse, error)
+	RenameAccount(ctx context.Context, in *RenameAccountRequest, opts ...grpc.CallOption) (*RenameAccountResponse, error)
+	Rescan(ctx context.Context, in *RescanRequest, opts ...grpc.CallOption) (WalletService_RescanClient, error)
+	NextAccount(ctx context.Context, in *NextAccountRequest, opts ...grpc.CallOption) (*NextAccountResponse, error)
+	NextMultisigAccount(ctx context.Context, in *NextMultisigAccountRequest, opts ...grpc.CallOption) (*NextMultisigAccountResponse, error)
+	NextAddress(ctx context.Context, in *NextAddressRequest, opts ...grpc.CallOption) (*NextAddressResponse, error)
+	ImportPrivateKey(ctx context.Context, in *ImportPrivateKeyRequest, opts ...grpc.CallOption) (*ImportPrivateKeyResponse, error)
+	ImportScript(ctx context.Context, in *ImportScriptRequest, opts ...grpc.CallOption) (*ImportScriptResponse, error)
+	FundTransaction(ctx context.Context, in *FundTransactionRequest, opts ...grpc.CallOption) (*FundTransactionResponse, error)
+	ConstructTransaction(ctx context.Context, in *ConstructTransactionRequest, opts ...grpc.CallOption) (*ConstructTransactionResponse, error)
+	SignTransaction(ctx context.Context, in *SignTransactionRequest, opts ...grpc.CallOption) (*SignTransactionResponse, error)
+	PublishTransaction(ctx context.Context, in *PublishTransactionRequest, opts ...grpc.CallOption) (*PublishTransactionResponse, error)
+	SweepAccount(ctx context.Context, in *SweepAccountRequest, opts ...grpc.CallOption) (*SweepAccountResponse, error)
+	AbandonTransaction(ctx context.Context, in *AbandonTransactionRequest, opts ...grpc.CallOption) (*AbandonTransactionResponse, error)
+	BumpFee(ctx context.Context, in *BumpFeeRequest, opts ...grpc.CallOption) (*BumpFeeResponse, error)
+	CreatePartialTransaction(ctx context.Context, in *CreatePartialTransactionRequest, opts ...grpc.CallOption) (*CreatePartialTransactionResponse, error)
+	UpdatePartialTransaction(ctx context.Context, in *UpdatePartialTransactionRequest, opts ...grpc.CallOption) (*UpdatePartialTransactionResponse, error)
+	SignPartialTransaction(ctx context.Context, in *SignPartialTransactionRequest, opts ...grpc.CallOption) (*SignPartialTransactionResponse, error)
+	CombinePartialTransactions(ctx context.Context, in *CombinePartialTransactionsRequest, opts ...grpc.CallOption) (*CombinePartialTransactionsResponse, error)
+	FinalizePartialTransaction(ctx context.Context, in *FinalizePartialTransactionRequest, opts ...grpc.CallOption) (*FinalizePartialTransactionResponse, error)
+	CreateSigningSession(ctx context.Context, in *CreateSigningSessionRequest, opts ...grpc.CallOption) (*CreateSigningSessionResponse, error)
+	SigningSessions(ctx context.Context, in *SigningSessionsRequest, opts ...grpc.CallOption) (*SigningSessionsResponse, error)
+	ImportSigningSessionSignatures(ctx context.Context, in *ImportSigningSessionSignaturesRequest, opts ...grpc.CallOption) (*ImportSigningSessionSignaturesResponse, error)
+	SignSigningSession(ctx context.Context, in *SignSigningSessionRequest, opts ...grpc.CallOption) (*SignSigningSessionResponse, error)
+	AbandonSigningSession(ctx context.Context, in *AbandonSigningSessionRequest, opts ...grpc.CallOption) (*AbandonSigningSessionResponse, error)
+	InitiateSwap(ctx context.Context, in *InitiateSwapRequest, opts ...grpc.CallOption) (*InitiateSwapResponse, error)
+	ParticipateSwap(ctx context.Context, in *ParticipateSwapRequest, opts ...grpc.CallOption) (*ParticipateSwapResponse, error)
+	RedeemSwap(ctx context.Context, in *RedeemSwapRequest, opts ...grpc.CallOption) (*RedeemSwapResponse, error)
+	RefundSwap(ctx context.Context, in *RefundSwapRequest, opts ...grpc.CallOption) (*RefundSwapResponse, error)
+	ExtractSwapSecret(ctx context.Context, in *ExtractSwapSecretRequest, opts ...grpc.CallOption) (*ExtractSwapSecretResponse, error)
+	AuditSwapContract(ctx context.Context, in *AuditSwapContractRequest, opts ...grpc.CallOption) (*AuditSwapContractResponse, error)
+	SwapContracts(ctx context.Context, in *SwapContractsRequest, opts ...grpc.CallOption) (*SwapContractsResponse, error)
+	CreateVault(ctx context.Context, in *CreateVaultRequest, opts ...grpc.CallOption) (*CreateVaultResponse, error)
+	Vaults(ctx context.Context, in *VaultsRequest, opts ...grpc.CallOption) (*VaultsResponse, error)
+	PurchaseTickets(ctx context.Context, in *PurchaseTicketsRequest, opts ...grpc.CallOption) (*PurchaseTicketsResponse, error)
+	RevokeTickets(ctx context.Context, in *RevokeTicketsRequest, opts ...grpc.CallOption) (*RevokeTicketsResponse, error)
+	LoadActiveDataFilters(ctx context.Context, in *LoadActiveDataFiltersRequest, opts ...grpc.CallOption) (*LoadActiveDataFiltersResponse, error)
+	LockOutpoint(ctx context.Context, in *LockOutpointRequest, opts ...grpc.CallOption) (*LockOutpointResponse, error)
+	UnlockOutpoint(ctx context.Context, in *UnlockOutpointRequest, opts ...grpc.CallOption) (*UnlockOutpointResponse, error)
+	SetTransactionLabel(ctx context.Context, in *SetTransactionLabelRequest, opts ...grpc.CallOption) (*SetTransactionLabelResponse, error)
+	SetAddressLabel(ctx context.Context, in *SetAddressLabelRequest, opts ...grpc.CallOption) (*SetAddressLabelResponse, error)
+	SetPayee(ctx context.Context, in *SetPayeeRequest, opts ...grpc.CallOption) (*SetPayeeResponse, error)
+	RemovePayee(ctx context.Context, in *RemovePayeeRequest, opts ...grpc.CallOption) (*RemovePayeeResponse, error)
+	BackupWallet(ctx context.Context, in *BackupWalletRequest, opts ...grpc.CallOption) (*BackupWalletResponse, error)
+	CheckConsistency(ctx context.Context, in *CheckConsistencyRequest, opts ...grpc.CallOption) (*CheckConsistencyResponse, error)
+}
+
+type walletServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewWalletServiceClient(cc *grpc.ClientConn) WalletServiceClient {
+	return &walletServiceClient{cc}
+}
+
+func (c *walletServiceClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error) {
+	out := new(PingResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/Ping", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) Network(ctx context.Context, in *NetworkRequest, opts ...grpc.CallOption) (*NetworkResponse, error) {
+	out := new(NetworkResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/Network", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) AccountNumber(ctx context.Context, in *AccountNumberRequest, opts ...grpc.CallOption) (*AccountNumberResponse, error) {
+	out := new(AccountNumberResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/AccountNumber", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) Accounts(ctx context.Context, in *AccountsRequest, opts ...grpc.CallOption) (*AccountsResponse, error) {
+	out := new(AccountsResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/Accounts", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) Balance(ctx context.Context, in *BalanceRequest, opts ...grpc.CallOption) (*BalanceResponse, error) {
+	out := new(BalanceResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/Balance", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionResponse, error) {
+	out := new(GetTransactionResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/GetTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (WalletService_GetTransactionsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_WalletService_serviceDesc.Streams[0], c.cc, "/walletrpc.WalletService/GetTransactions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &walletServiceGetTransactionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WalletService_GetTransactionsClient interface {
+	Recv() (*GetTransactionsResponse, error)
+	grpc.ClientStream
+}
+
+type walletServiceGetTransactionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *walletServiceGetTransactionsClient) Recv() (*GetTransactionsResponse, error) {
+	m := new(GetTransactionsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *walletServiceClient) TicketPrice(ctx context.Context, in *TicketPriceRequest, opts ...grpc.CallOption) (*TicketPriceResponse, error) {
+	out := new(TicketPriceResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/TicketPrice", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) StakeInfo(ctx context.Context, in *StakeInfoRequest, opts ...grpc.CallOption) (*StakeInfoResponse, error) {
+	out := new(StakeInfoResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/StakeInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) BlockInfo(ctx context.Context, in *BlockInfoRequest, opts ...grpc.CallOption) (*BlockInfoResponse, error) {
+	out := new(BlockInfoResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/BlockInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) LockedOutpoints(ctx context.Context, in *LockedOutpointsRequest, opts ...grpc.CallOption) (*LockedOutpointsResponse, error) {
+	out := new(LockedOutpointsResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/LockedOutpoints", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) TransactionLabel(ctx context.Context, in *TransactionLabelRequest, opts ...grpc.CallOption) (*TransactionLabelResponse, error) {
+	out := new(TransactionLabelResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/TransactionLabel", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SearchTransactionLabels(ctx context.Context, in *SearchTransactionLabelsRequest, opts ...grpc.CallOption) (*SearchTransactionLabelsResponse, error) {
+	out := new(SearchTransactionLabelsResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/SearchTransactionLabels", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) AddressLabels(ctx context.Context, in *AddressLabelsRequest, opts ...grpc.CallOption) (*AddressLabelsResponse, error) {
+	out := new(AddressLabelsResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/AddressLabels", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) Payees(ctx context.Context, in *PayeesRequest, opts ...grpc.CallOption) (*PayeesResponse, error) {
+	out := new(PayeesResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/Payees", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) UnminedTransactions(ctx context.Context, in *UnminedTransactionsRequest, opts ...grpc.CallOption) (*UnminedTransactionsResponse, error) {
+	out := new(UnminedTransactionsResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/UnminedTransactions", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) AddressTransactions(ctx context.Context, in *AddressTransactionsRequest, opts ...grpc.CallOption) (WalletService_AddressTransactionsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_WalletService_serviceDesc.Streams[1], c.cc, "/walletrpc.WalletService/AddressTransactions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &walletServiceAddressTransactionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WalletService_AddressTransactionsClient interface {
+	Recv() (*AddressTransactionsResponse, error)
+	grpc.ClientStream
+}
+
+type walletServiceAddressTransactionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *walletServiceAddressTransactionsClient) Recv() (*AddressTransactionsResponse, error) {
+	m := new(AddressTransactionsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *walletServiceClient) TransactionNotifications(ctx context.Context, in *TransactionNotificationsRequest, opts ...grpc.CallOption) (WalletService_TransactionNotificationsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_WalletService_serviceDesc.Streams[2], c.cc, "/walletrpc.WalletService/TransactionNotifications", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &walletServiceTransactionNotificationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WalletService_TransactionNotificationsClient interface {
+	Recv() (*TransactionNotificationsResponse, error)
+	grpc.ClientStream
+}
+
+type walletServiceTransactionNotificationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *walletServiceTransactionNotificationsClient) Recv() (*TransactionNotificationsResponse, error) {
+	m := new(TransactionNotificationsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *walletServiceClient) AccountNotifications(ctx context.Context, in *AccountNotificationsRequest, opts ...grpc.CallOption) (WalletService_AccountNotificationsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_WalletService_serviceDesc.Streams[3], c.cc, "/walletrpc.WalletService/AccountNotifications", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &walletServiceAccountNotificationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WalletService_AccountNotificationsClient interface {
+	Recv() (*AccountNotificationsResponse, error)
+	grpc.ClientStream
+}
+
+type walletServiceAccountNotificationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *walletServiceAccountNotificationsClient) Recv() (*AccountNotificationsResponse, error) {
+	m := new(AccountNotificationsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *walletServiceClient) ConfirmationNotifications(ctx context.Context, opts ...grpc.CallOption) (WalletService_ConfirmationNotificationsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_WalletService_serviceDesc.Streams[4], c.cc, "/walletrpc.WalletService/ConfirmationNotifications", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &walletServiceConfirmationNotificationsClient{stream}
+	return x, nil
+}
+
+type WalletService_ConfirmationNotificationsClient interface {
+	Send(*ConfirmationNotificationsRequest) error
+	Recv() (*ConfirmationNotificationsResponse, error)
+	grpc.ClientStream
+}
+
+type walletServiceConfirmationNotificationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *walletServiceConfirmationNotificationsClient) Send(m *ConfirmationNotificationsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *walletServiceConfirmationNotificationsClient) Recv() (*ConfirmationNotificationsResponse, error) {
+	m := new(ConfirmationNotificationsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *walletServiceClient) ChangePassphrase(ctx context.Context, in *ChangePassphraseRequest, opts ...grpc.CallOption) (*ChangePassphraseResponse, error) {
+	out := new(ChangePassphraseResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/ChangePassphrase", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) RenameAccount(ctx context.Context, in *RenameAccountRequest, opts ...grpc.CallOption) (*RenameAccountResponse, error) {
+	out := new(RenameAccountResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/RenameAccount", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) Rescan(ctx context.Context, in *RescanRequest, opts ...grpc.CallOption) (WalletService_RescanClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_WalletService_serviceDesc.Streams[5], c.cc, "/walletrpc.WalletService/Rescan", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &walletServiceRescanClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WalletService_RescanClient interface {
+	Recv() (*RescanResponse, error)
+	grpc.ClientStream
+}
+
+type walletServiceRescanClient struct {
+	grpc.ClientStream
+}
+
+func (x *walletServiceRescanClient) Recv() (*RescanResponse, error) {
+	m := new(RescanResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *walletServiceClient) NextAccount(ctx context.Context, in *NextAccountRequest, opts ...grpc.CallOption) (*NextAccountResponse, error) {
+	out := new(NextAccountResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/NextAccount", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) NextMultisigAccount(ctx context.Context, in *NextMultisigAccountRequest, opts ...grpc.CallOption) (*NextMultisigAccountResponse, error) {
+	out := new(NextMultisigAccountResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/NextMultisigAccount", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) NextAddress(ctx context.Context, in *NextAddressRequest, opts ...grpc.CallOption) (*NextAddressResponse, error) {
+	out := new(NextAddressResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/NextAddress", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ImportPrivateKey(ctx context.Context, in *ImportPrivateKeyRequest, opts ...grpc.CallOption) (*ImportPrivateKeyResponse, error) {
+	out := new(ImportPrivateKeyResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/ImportPrivateKey", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ImportScript(ctx context.Context, in *ImportScriptRequest, opts ...grpc.CallOption) (*ImportScriptResponse, error) {
+	out := new(ImportScriptResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/ImportScript", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) FundTransaction(ctx context.Context, in *FundTransactionRequest, opts ...grpc.CallOption) (*FundTransactionResponse, error) {
+	out := new(FundTransactionResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/FundTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ConstructTransaction(ctx context.Context, in *ConstructTransactionRequest, opts ...grpc.CallOption) (*ConstructTransactionResponse, error) {
+	out := new(ConstructTransactionResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/ConstructTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SignTransaction(ctx context.Context, in *SignTransactionRequest, opts ...grpc.CallOption) (*SignTransactionResponse, error) {
+	out := new(SignTransactionResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/SignTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) PublishTransaction(ctx context.Context, in *PublishTransactionRequest, opts ...grpc.CallOption) (*PublishTransactionResponse, error) {
+	out := new(PublishTransactionResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/PublishTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SweepAccount(ctx context.Context, in *SweepAccountRequest, opts ...grpc.CallOption) (*SweepAccountResponse, error) {
+	out := new(SweepAccountResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/SweepAccount", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) AbandonTransaction(ctx context.Context, in *AbandonTransactionRequest, opts ...grpc.CallOption) (*AbandonTransactionResponse, error) {
+	out := new(AbandonTransactionResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/AbandonTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) BumpFee(ctx context.Context, in *BumpFeeRequest, opts ...grpc.CallOption) (*BumpFeeResponse, error) {
+	out := new(BumpFeeResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/BumpFee", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) CreatePartialTransaction(ctx context.Context, in *CreatePartialTransactionRequest, opts ...grpc.CallOption) (*CreatePartialTransactionResponse, error) {
+	out := new(CreatePartialTransactionResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/CreatePartialTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) UpdatePartialTransaction(ctx context.Context, in *UpdatePartialTransactionRequest, opts ...grpc.CallOption) (*UpdatePartialTransactionResponse, error) {
+	out := new(UpdatePartialTransactionResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/UpdatePartialTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SignPartialTransaction(ctx context.Context, in *SignPartialTransactionRequest, opts ...grpc.CallOption) (*SignPartialTransactionResponse, error) {
+	out := new(SignPartialTransactionResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/SignPartialTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) CombinePartialTransactions(ctx context.Context, in *CombinePartialTransactionsRequest, opts ...grpc.CallOption) (*CombinePartialTransactionsResponse, error) {
+	out := new(CombinePartialTransactionsResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/CombinePartialTransactions", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) FinalizePartialTransaction(ctx context.Context, in *FinalizePartialTransactionRequest, opts ...grpc.CallOption) (*FinalizePartialTransactionResponse, error) {
+	out := new(FinalizePartialTransactionResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/FinalizePartialTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) CreateSigningSession(ctx context.Context, in *CreateSigningSessionRequest, opts ...grpc.CallOption) (*CreateSigningSessionResponse, error) {
+	out := new(CreateSigningSessionResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/CreateSigningSession", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SigningSessions(ctx context.Context, in *SigningSessionsRequest, opts ...grpc.CallOption) (*SigningSessionsResponse, error) {
+	out := new(SigningSessionsResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/SigningSessions", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ImportSigningSessionSignatures(ctx context.Context, in *ImportSigningSessionSignaturesRequest, opts ...grpc.CallOption) (*ImportSigningSessionSignaturesResponse, error) {
+	out := new(ImportSigningSessionSignaturesResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/ImportSigningSessionSignatures", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SignSigningSession(ctx context.Context, in *SignSigningSessionRequest, opts ...grpc.CallOption) (*SignSigningSessionResponse, error) {
+	out := new(SignSigningSessionResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/SignSigningSession", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) AbandonSigningSession(ctx context.Context, in *AbandonSigningSessionRequest, opts ...grpc.CallOption) (*AbandonSigningSessionResponse, error) {
+	out := new(AbandonSigningSessionResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/AbandonSigningSession", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) InitiateSwap(ctx context.Context, in *InitiateSwapRequest, opts ...grpc.CallOption) (*InitiateSwapResponse, error) {
+	out := new(InitiateSwapResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/InitiateSwap", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ParticipateSwap(ctx context.Context, in *ParticipateSwapRequest, opts ...grpc.CallOption) (*ParticipateSwapResponse, error) {
+	out := new(ParticipateSwapResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/ParticipateSwap", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) RedeemSwap(ctx context.Context, in *RedeemSwapRequest, opts ...grpc.CallOption) (*RedeemSwapResponse, error) {
+	out := new(RedeemSwapResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/RedeemSwap", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) RefundSwap(ctx context.Context, in *RefundSwapRequest, opts ...grpc.CallOption) (*RefundSwapResponse, error) {
+	out := new(RefundSwapResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/RefundSwap", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ExtractSwapSecret(ctx context.Context, in *ExtractSwapSecretRequest, opts ...grpc.CallOption) (*ExtractSwapSecretResponse, error) {
+	out := new(ExtractSwapSecretResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/ExtractSwapSecret", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) AuditSwapContract(ctx context.Context, in *AuditSwapContractRequest, opts ...grpc.CallOption) (*AuditSwapContractResponse, error) {
+	out := new(AuditSwapContractResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/AuditSwapContract", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SwapContracts(ctx context.Context, in *SwapContractsRequest, opts ...grpc.CallOption) (*SwapContractsResponse, error) {
+	out := new(SwapContractsResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/SwapContracts", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (c *walletServiceClient) CreateVault(ctx context.Context, in *CreateVaultRequest, opts ...grpc.CallOption) (*CreateVaultResponse, error) {
+	out := new(CreateVaultResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/CreateVault", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (c *walletServiceClient) Vaults(ctx context.Context, in *VaultsRequest, opts ...grpc.CallOption) (*VaultsResponse, error) {
+	out := new(VaultsResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/Vaults", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-// Client API for VersionService service
+func (c *walletServiceClient) PurchaseTickets(ctx context.Context, in *PurchaseTicketsRequest, opts ...grpc.CallOption) (*PurchaseTicketsResponse, error) {
+	out := new(PurchaseTicketsResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/PurchaseTickets", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-type VersionServiceClient interface {
-	Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error)
+func (c *walletServiceClient) RevokeTickets(ctx context.Context, in *RevokeTicketsRequest, opts ...grpc.CallOption) (*RevokeTicketsResponse, error) {
+	out := new(RevokeTicketsResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/RevokeTickets", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type versionServiceClient struct {
-	cc *grpc.ClientConn
+func (c *walletServiceClient) LoadActiveDataFilters(ctx context.Context, in *LoadActiveDataFiltersRequest, opts ...grpc.CallOption) (*LoadActiveDataFiltersResponse, error) {
+	out := new(LoadActiveDataFiltersResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/LoadActiveDataFilters", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func NewVersionServiceClient(cc *grpc.ClientConn) VersionServiceClient {
-	return &versionServiceClient{cc}
+func (c *walletServiceClient) LockOutpoint(ctx context.Context, in *LockOutpointRequest, opts ...grpc.CallOption) (*LockOutpointResponse, error) {
+	out := new(LockOutpointResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/LockOutpoint", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *versionServiceClient) Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error) {
-	out := new(VersionResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.VersionService/Version", in, out, c.cc, opts...)
+func (c *walletServiceClient) UnlockOutpoint(ctx context.Context, in *UnlockOutpointRequest, opts ...grpc.CallOption) (*UnlockOutpointResponse, error) {
+	out := new(UnlockOutpointResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/UnlockOutpoint", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// Server API for VersionService service
+func (c *walletServiceClient) SetTransactionLabel(ctx context.Context, in *SetTransactionLabelRequest, opts ...grpc.CallOption) (*SetTransactionLabelResponse, error) {
+	out := new(SetTransactionLabelResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/SetTransactionLabel", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-type VersionServiceServer interface {
-	Version(context.Context, *VersionRequest) (*VersionResponse, error)
+func (c *walletServiceClient) SetAddressLabel(ctx context.Context, in *SetAddressLabelRequest, opts ...grpc.CallOption) (*SetAddressLabelResponse, error) {
+	out := new(SetAddressLabelResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/SetAddressLabel", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func RegisterVersionServiceServer(s *grpc.Server, srv VersionServiceServer) {
-	s.RegisterService(&_VersionService_serviceDesc, srv)
+func (c *walletServiceClient) SetPayee(ctx context.Context, in *SetPayeeRequest, opts ...grpc.CallOption) (*SetPayeeResponse, error) {
+	out := new(SetPayeeResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/SetPayee", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) RemovePayee(ctx context.Context, in *RemovePayeeRequest, opts ...grpc.CallOption) (*RemovePayeeResponse, error) {
+	out := new(RemovePayeeResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/RemovePayee", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) BackupWallet(ctx context.Context, in *BackupWalletRequest, opts ...grpc.CallOption) (*BackupWalletResponse, error) {
+	out := new(BackupWalletResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/BackupWallet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) CheckConsistency(ctx context.Context, in *CheckConsistencyRequest, opts ...grpc.CallOption) (*CheckConsistencyResponse, error) {
+	out := new(CheckConsistencyResponse)
+	err := grpc.Invoke(ctx, "/walletrpc.WalletService/CheckConsistency", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for WalletService service
+
+type WalletServiceServer interface {
+	// Queries
+	Ping(context.Context, *PingRequest) (*PingResponse, error)
+	Network(context.Context, *NetworkRequest) (*NetworkResponse, error)
+	AccountNumber(context.Context, *AccountNumberRequest) (*AccountNumberResponse, error)
+	Accounts(context.Context, *AccountsRequest) (*AccountsResponse, error)
+	Balance(context.Context, *BalanceRequest) (*BalanceResponse, error)
+	GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error)
+	GetTransactions(*GetTransactionsRequest, WalletService_GetTransactionsServer) error
+	TicketPrice(context.Context, *TicketPriceRequest) (*TicketPriceResponse, error)
+	StakeInfo(context.Context, *StakeInfoRequest) (*StakeInfoResponse, error)
+	BlockInfo(context.Context, *BlockInfoRequest) (*BlockInfoResponse, error)
+	LockedOutpoints(context.Context, *LockedOutpointsRequest) (*LockedOutpointsResponse, error)
+	TransactionLabel(context.Context, *TransactionLabelRequest) (*TransactionLabelResponse, error)
+	SearchTransactionLabels(context.Context, *SearchTransactionLabelsRequest) (*SearchTransactionLabelsResponse, error)
+	AddressLabels(context.Context, *AddressLabelsRequest) (*AddressLabelsResponse, error)
+	Payees(context.Context, *PayeesRequest) (*PayeesResponse, error)
+	UnminedTransactions(context.Context, *UnminedTransactionsRequest) (*UnminedTransactionsResponse, error)
+	AddressTransactions(*AddressTransactionsRequest, WalletService_AddressTransactionsServer) error
+	// Notifications
+	TransactionNotifications(*TransactionNotificationsRequest, WalletService_TransactionNotificationsServer) error
+	AccountNotifications(*AccountNotificationsRequest, WalletService_AccountNotificationsServer) error
+	ConfirmationNotifications(WalletService_ConfirmationNotificationsServer) error
+	// Control
+	ChangePassphrase(context.Context, *ChangePassphraseRequest) (*ChangePassphraseResponse, error)
+	RenameAccount(context.Context, *RenameAccountRequest) (*RenameAccountResponse, error)
+	Rescan(*RescanRequest, WalletService_RescanServer) error
+	NextAccount(context.Context, *NextAccountRequest) (*NextAccountResponse, error)
+	NextMultisigAccount(context.Context, *NextMultisigAccountRequest) (*NextMultisigAccountResponse, error)
+	NextAddress(context.Context, *NextAddressRequest) (*NextAddressResponse, error)
+	ImportPrivateKey(context.Context, *ImportPrivateKeyRequest) (*ImportPrivateKeyResponse, error)
+	ImportScript(context.Context, *ImportScriptRequest) (*ImportScriptResponse, error)
+	FundTransaction(context.Context, *FundTransactionRequest) (*FundTransactionResponse, error)
+	ConstructTransaction(context.Context, *ConstructTransactionRequest) (*ConstructTransactionResponse, error)
+	SignTransaction(context.Context, *SignTransactionRequest) (*SignTransactionResponse, error)
+	PublishTransaction(context.Context, *PublishTransactionRequest) (*PublishTransactionResponse, error)
+	SweepAccount(context.Context, *SweepAccountRequest) (*SweepAccountResponse, error)
+	AbandonTransaction(context.Context, *AbandonTransactionRequest) (*AbandonTransactionResponse, error)
+	BumpFee(context.Context, *BumpFeeRequest) (*BumpFeeResponse, error)
+	CreatePartialTransaction(context.Context, *CreatePartialTransactionRequest) (*CreatePartialTransactionResponse, error)
+	UpdatePartialTransaction(context.Context, *UpdatePartialTransactionRequest) (*UpdatePartialTransactionResponse, error)
+	SignPartialTransaction(context.Context, *SignPartialTransactionRequest) (*SignPartialTransactionResponse, error)
+	CombinePartialTransactions(context.Context, *CombinePartialTransactionsRequest) (*CombinePartialTransactionsResponse, error)
+	FinalizePartialTransaction(context.Context, *FinalizePartialTransactionRequest) (*FinalizePartialTransactionResponse, error)
+	CreateSigningSession(context.Context, *CreateSigningSessionRequest) (*CreateSigningSessionResponse, error)
+	SigningSessions(context.Context, *SigningSessionsRequest) (*SigningSessionsResponse, error)
+	ImportSigningSessionSignatures(context.Context, *ImportSigningSessionSignaturesRequest) (*ImportSigningSessionSignaturesResponse, error)
+	SignSigningSession(context.Context, *SignSigningSessionRequest) (*SignSigningSessionResponse, error)
+	AbandonSigningSession(context.Context, *AbandonSigningSessionRequest) (*AbandonSigningSessionResponse, error)
+	InitiateSwap(context.Context, *InitiateSwapRequest) (*InitiateSwapResponse, error)
+	ParticipateSwap(context.Context, *ParticipateSwapRequest) (*ParticipateSwapResponse, error)
+	RedeemSwap(context.Context, *RedeemSwapRequest) (*RedeemSwapResponse, error)
+	RefundSwap(context.Context, *RefundSwapRequest) (*RefundSwapResponse, error)
+	ExtractSwapSecret(context.Context, *ExtractSwapSecretRequest) (*ExtractSwapSecretResponse, error)
+	AuditSwapContract(context.Context, *AuditSwapContractRequest) (*AuditSwapContractResponse, error)
+	SwapContracts(context.Context, *SwapContractsRequest) (*SwapContractsResponse, error)
+	CreateVault(context.Context, *CreateVaultRequest) (*CreateVaultResponse, error)
+	Vaults(context.Context, *VaultsRequest) (*VaultsResponse, error)
+	PurchaseTickets(context.Context, *PurchaseTicketsRequest) (*PurchaseTicketsResponse, error)
+	RevokeTickets(context.Context, *RevokeTicketsRequest) (*RevokeTicketsResponse, error)
+	LoadActiveDataFilters(context.Context, *LoadActiveDataFiltersRequest) (*LoadActiveDataFiltersResponse, error)
+	LockOutpoint(context.Context, *LockOutpointRequest) (*LockOutpointResponse, error)
+	UnlockOutpoint(context.Context, *UnlockOutpointRequest) (*UnlockOutpointResponse, error)
+	SetTransactionLabel(context.Context, *SetTransactionLabelRequest) (*SetTransactionLabelResponse, error)
+	SetAddressLabel(context.Context, *SetAddressLabelRequest) (*SetAddressLabelResponse, error)
+	SetPayee(context.Context, *SetPayeeRequest) (*SetPayeeResponse, error)
+	RemovePayee(context.Context, *RemovePayeeRequest) (*RemovePayeeResponse, error)
+	BackupWallet(context.Context, *BackupWalletRequest) (*BackupWalletResponse, error)
+	CheckConsistency(context.Context, *CheckConsistencyRequest) (*CheckConsistencyResponse, error)
+}
+
+func RegisterWalletServiceServer(s *grpc.Server, srv WalletServiceServer) {
+	s.RegisterService(&_WalletService_serviceDesc, srv)
+}
+
+func _WalletService_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/Ping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).Ping(ctx, req.(*PingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_Network_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).Network(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/Network",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).Network(ctx, req.(*NetworkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_AccountNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountNumberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).AccountNumber(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/AccountNumber",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).AccountNumber(ctx, req.(*AccountNumberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_Accounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).Accounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/Accounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).Accounts(ctx, req.(*AccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_Balance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).Balance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/Balance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).Balance(ctx, req.(*BalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/GetTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetTransaction(ctx, req.(*GetTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetTransactionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WalletServiceServer).GetTransactions(m, &walletServiceGetTransactionsServer{stream})
+}
+
+type WalletService_GetTransactionsServer interface {
+	Send(*GetTransactionsResponse) error
+	grpc.ServerStream
+}
+
+type walletServiceGetTransactionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *walletServiceGetTransactionsServer) Send(m *GetTransactionsResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func _VersionService_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VersionRequest)
+func _WalletService_TicketPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TicketPriceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VersionServiceServer).Version(ctx, in)
+		return srv.(WalletServiceServer).TicketPrice(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.VersionService/Version",
+		FullMethod: "/walletrpc.WalletService/TicketPrice",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VersionServiceServer).Version(ctx, req.(*VersionRequest))
+		return srv.(WalletServiceServer).TicketPrice(ctx, req.(*TicketPriceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _VersionService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "walletrpc.VersionService",
-	HandlerType: (*VersionServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Version",
-			Handler:    _VersionService_Version_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "api.proto",
-}
-
-// Client API for WalletService service
-
-type WalletServiceClient interface {
-	// Queries
-	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
-	Network(ctx context.Context, in *NetworkRequest, opts ...grpc.CallOption) (*NetworkResponse, error)
-	AccountNumber(ctx context.Context, in *AccountNumberRequest, opts ...grpc.CallOption) (*AccountNumberResponse, error)
-	Accounts(ctx context.Context, in *AccountsRequest, opts ...grpc.CallOption) (*AccountsResponse, error)
-	Balance(ctx context.Context, in *BalanceRequest, opts ...grpc.CallOption) (*BalanceResponse, error)
-	GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionResponse, error)
-	GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (WalletService_GetTransactionsClient, error)
-	TicketPrice(ctx context.Context, in *TicketPriceRequest, opts ...grpc.CallOption) (*TicketPriceResponse, error)
-	StakeInfo(ctx context.Context, in *StakeInfoRequest, opts ...grpc.CallOption) (*StakeInfoResponse, error)
-	BlockInfo(ctx context.Context, in *BlockInfoRequest, opts ...grpc.CallOption) (*BlockInfoResponse, error)
-	// Notifications
-	TransactionNotifications(ctx context.Context, in *TransactionNotificationsRequest, opts ...grpc.CallOption) (WalletService_TransactionNotificationsClient, error)
-	AccountNotifications(ctx context.Context, in *AccountNotificationsRequest, opts ...grpc.CallOption) (WalletService_AccountNotificationsClient, error)
-	ConfirmationNotifications(ctx context.Context, opts ...grpc.CallOption) (WalletService_ConfirmationNotificationsClient, error)
-	// Control
-	ChangePassphrase(ctx context.Context, in *ChangePassphraseRequest, opts ...grpc.CallOption) (*ChangePassphraseResponse, error)
-	RenameAccount(ctx context.Context, in *RenameAccountRequest, opts ...grpc.CallOption) (*RenameAccountResponse, error)
-	Rescan(ctx context.Context, in *RescanRequest, opts ...grpc.CallOption) (WalletService_RescanClient, error)
-	NextAccount(ctx context.Context, in *NextAccountRequest, opts ...grpc.CallOption) (*NextAccountResponse, error)
-	NextAddress(ctx context.Context, in *NextAddressRequest, opts ...grpc.CallOption) (*NextAddressResponse, error)
-	ImportPrivateKey(ctx context.Context, in *ImportPrivateKeyRequest, opts ...grpc.CallOption) (*ImportPrivateKeyResponse, error)
-	ImportScript(ctx context.Context, in *ImportScriptRequest, opts ...grpc.CallOption) (*ImportScriptResponse, error)
-	FundTransaction(ctx context.Context, in *FundTransactionRequest, opts ...grpc.CallOption) (*FundTransactionResponse, error)
-	ConstructTransaction(ctx context.Context, in *ConstructTransactionRequest, opts ...grpc.CallOption) (*ConstructTransactionResponse, error)
-	SignTransaction(ctx context.Context, in *SignTransactionRequest, opts ...grpc.CallOption) (*SignTransactionResponse, error)
-	PublishTransaction(ctx context.Context, in *PublishTransactionRequest, opts ...grpc.CallOption) (*PublishTransactionResponse, error)
-	PurchaseTickets(ctx context.Context, in *PurchaseTicketsRequest, opts ...grpc.CallOption) (*PurchaseTicketsResponse, error)
-	RevokeTickets(ctx context.Context, in *RevokeTicketsRequest, opts ...grpc.CallOption) (*RevokeTicketsResponse, error)
-	LoadActiveDataFilters(ctx context.Context, in *LoadActiveDataFiltersRequest, opts ...grpc.CallOption) (*LoadActiveDataFiltersResponse, error)
-}
-
-type walletServiceClient struct {
-	cc *grpc.ClientConn
-}
-
-func NewWalletServiceClient(cc *grpc.ClientConn) WalletServiceClient {
-	return &walletServiceClient{cc}
+func _WalletService_StakeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StakeInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).StakeInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/StakeInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).StakeInfo(ctx, req.(*StakeInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *walletServiceClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error) {
-	out := new(PingResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletService/Ping", in, out, c.cc, opts...)
-	if err != nil {
+func _WalletService_BlockInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockInfoRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WalletServiceServer).BlockInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/BlockInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).BlockInfo(ctx, req.(*BlockInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *walletServiceClient) Network(ctx context.Context, in *NetworkRequest, opts ...grpc.CallOption) (*NetworkResponse, error) {
-	out := new(NetworkResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletService/Network", in, out, c.cc, opts...)
-	if err != nil {
+func _WalletService_LockedOutpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockedOutpointsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WalletServiceServer).LockedOutpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/LockedOutpoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).LockedOutpoints(ctx, req.(*LockedOutpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *walletServiceClient) AccountNumber(ctx context.Context, in *AccountNumberRequest, opts ...grpc.CallOption) (*AccountNumberResponse, error) {
-	out := new(AccountNumberResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletService/AccountNumber", in, out, c.cc, opts...)
-	if err != nil {
+func _WalletService_TransactionLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionLabelRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WalletServiceServer).TransactionLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/TransactionLabel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).TransactionLabel(ctx, req.(*TransactionLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *walletServiceClient) Accounts(ctx context.Context, in *AccountsRequest, opts ...grpc.CallOption) (*AccountsResponse, error) {
-	out := new(AccountsResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletService/Accounts", in, out, c.cc, opts...)
-	if err != nil {
+func _WalletService_SearchTransactionLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchTransactionLabelsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SearchTransactionLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/SearchTransactionLabels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SearchTransactionLabels(ctx, req.(*SearchTransactionLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *walletServiceClient) Balance(ctx context.Context, in *BalanceRequest, opts ...grpc.CallOption) (*BalanceResponse, error) {
-	out := new(BalanceResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletService/Balance", in, out, c.cc, opts...)
-	if err != nil {
+func _WalletService_AddressLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressLabelsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WalletServiceServer).AddressLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/AddressLabels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).AddressLabels(ctx, req.(*AddressLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *walletServiceClient) GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionResponse, error) {
-	out := new(GetTransactionResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletService/GetTransaction", in, out, c.cc, opts...)
-	if err != nil {
+func _WalletService_Payees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayeesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WalletServiceServer).Payees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/Payees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).Payees(ctx, req.(*PayeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *walletServiceClient) GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (WalletService_GetTransactionsClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_WalletService_serviceDesc.Streams[0], c.cc, "/walletrpc.WalletService/GetTransactions", opts...)
-	if err != nil {
+func _WalletService_UnminedTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnminedTransactionsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	x := &walletServiceGetTransactionsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(WalletServiceServer).UnminedTransactions(ctx, in)
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/UnminedTransactions",
 	}
-	return x, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).UnminedTransactions(ctx, req.(*UnminedTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type WalletService_GetTransactionsClient interface {
-	Recv() (*GetTransactionsResponse, error)
-	grpc.ClientStream
+func _WalletService_AddressTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AddressTransactionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WalletServiceServer).AddressTransactions(m, &walletServiceAddressTransactionsServer{stream})
 }
 
-type walletServiceGetTransactionsClient struct {
-	grpc.ClientStream
+type WalletService_AddressTransactionsServer interface {
+	Send(*AddressTransactionsResponse) error
+	grpc.ServerStream
 }
 
-func (x *walletServiceGetTransactionsClient) Recv() (*GetTransactionsResponse, error) {
-	m := new(GetTransactionsResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+type walletServiceAddressTransactionsServer struct {
+	grpc.ServerStream
 }
 
-func (c *walletServiceClient) TicketPrice(ctx context.Context, in *TicketPriceRequest, opts ...grpc.CallOption) (*TicketPriceResponse, error) {
-	out := new(TicketPriceResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletService/TicketPrice", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (x *walletServiceAddressTransactionsServer) Send(m *AddressTransactionsResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (c *walletServiceClient) StakeInfo(ctx context.Context, in *StakeInfoRequest, opts ...grpc.CallOption) (*StakeInfoResponse, error) {
-	out := new(StakeInfoResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletService/StakeInfo", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func _WalletService_TransactionNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TransactionNotificationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return out, nil
+	return srv.(WalletServiceServer).TransactionNotifications(m, &walletServiceTransactionNotificationsServer{stream})
 }
 
-func (c *walletServiceClient) BlockInfo(ctx context.Context, in *BlockInfoRequest, opts ...grpc.CallOption) (*BlockInfoResponse, error) {
-	out := new(BlockInfoResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletService/BlockInfo", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+type WalletService_TransactionNotificationsServer interface {
+	Send(*TransactionNotificationsResponse) error
+	grpc.ServerStream
+}
+
+type walletServiceTransactionNotificationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *walletServiceTransactionNotificationsServer) Send(m *TransactionNotificationsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WalletService_AccountNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AccountNotificationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return out, nil
+	return srv.(WalletServiceServer).AccountNotifications(m, &walletServiceAccountNotificationsServer{stream})
+}
+
+type WalletService_AccountNotificationsServer interface {
+	Send(*AccountNotificationsResponse) error
+	grpc.ServerStream
+}
+
+type walletServiceAccountNotificationsServer struct {
+	grpc.ServerStream
 }
 
-func (c *walletServiceClient) TransactionNotifications(ctx context.Context, in *TransactionNotificationsRequest, opts ...grpc.CallOption) (WalletService_TransactionNotificationsClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_WalletService_serviceDesc.Streams[1], c.cc, "/walletrpc.WalletService/TransactionNotifications", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &walletServiceTransactionNotificationsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
+func (x *walletServiceAccountNotificationsServer) Send(m *AccountNotificationsResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-type WalletService_TransactionNotificationsClient interface {
-	Recv() (*TransactionNotificationsResponse, error)
-	grpc.ClientStream
+func _WalletService_ConfirmationNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(WalletServiceServer).ConfirmationNotifications(&walletServiceConfirmationNotificationsServer{stream})
 }
 
-type walletServiceTransactionNotificationsClient struct {
-	grpc.ClientStream
+type WalletService_ConfirmationNotificationsServer interface {
+	Send(*ConfirmationNotificationsResponse) error
+	Recv() (*ConfirmationNotificationsRequest, error)
+	grpc.ServerStream
 }
 
-func (x *walletServiceTransactionNotificationsClient) Recv() (*TransactionNotificationsResponse, error) {
-	m := new(TransactionNotificationsResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+type walletServiceConfirmationNotificationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *walletServiceConfirmationNotificationsServer) Send(m *ConfirmationNotificationsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *walletServiceConfirmationNotificationsServer) Recv() (*ConfirmationNotificationsRequest, error) {
+	m := new(ConfirmationNotificationsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *walletServiceClient) AccountNotifications(ctx context.Context, in *AccountNotificationsRequest, opts ...grpc.CallOption) (WalletService_AccountNotificationsClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_WalletService_serviceDesc.Streams[2], c.cc, "/walletrpc.WalletService/AccountNotifications", opts...)
-	if err != nil {
+func _WalletService_ChangePassphrase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePassphraseRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	x := &walletServiceAccountNotificationsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ChangePassphrase(ctx, in)
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/ChangePassphrase",
 	}
-	return x, nil
-}
-
-type WalletService_AccountNotificationsClient interface {
-	Recv() (*AccountNotificationsResponse, error)
-	grpc.ClientStream
-}
-
-type walletServiceAccountNotificationsClient struct {
-	grpc.ClientStream
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ChangePassphrase(ctx, req.(*ChangePassphraseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *walletServiceAccountNotificationsClient) Recv() (*AccountNotificationsResponse, error) {
-	m := new(AccountNotificationsResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func _WalletService_RenameAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameAccountRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
+	if interceptor == nil {
+		return srv.(WalletServiceServer).RenameAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/RenameAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).RenameAccount(ctx, req.(*RenameAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *walletServiceClient) ConfirmationNotifications(ctx context.Context, opts ...grpc.CallOption) (WalletService_ConfirmationNotificationsClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_WalletService_serviceDesc.Streams[3], c.cc, "/walletrpc.WalletService/ConfirmationNotifications", opts...)
-	if err != nil {
-		return nil, err
+func _WalletService_Rescan_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RescanRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	x := &walletServiceConfirmationNotificationsClient{stream}
-	return x, nil
+	return srv.(WalletServiceServer).Rescan(m, &walletServiceRescanServer{stream})
 }
 
-type WalletService_ConfirmationNotificationsClient interface {
-	Send(*ConfirmationNotificationsRequest) error
-	Recv() (*ConfirmationNotificationsResponse, error)
-	grpc.ClientStream
+type WalletService_RescanServer interface {
+	Send(*RescanResponse) error
+	grpc.ServerStream
 }
 
-type walletServiceConfirmationNotificationsClient struct {
-	grpc.ClientStream
+type walletServiceRescanServer struct {
+	grpc.ServerStream
 }
 
-func (x *walletServiceConfirmationNotificationsClient) Send(m *ConfirmationNotificationsRequest) error {
-	return x.ClientStream.SendMsg(m)
+func (x *walletServiceRescanServer) Send(m *RescanResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (x *walletServiceConfirmationNotificationsClient) Recv() (*ConfirmationNotificationsResponse, error) {
-	m := new(ConfirmationNotificationsResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func _WalletService_NextAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NextAccountRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
+	if interceptor == nil {
+		return srv.(WalletServiceServer).NextAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/NextAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).NextAccount(ctx, req.(*NextAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *walletServiceClient) ChangePassphrase(ctx context.Context, in *ChangePassphraseRequest, opts ...grpc.CallOption) (*ChangePassphraseResponse, error) {
-	out := new(ChangePassphraseResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletService/ChangePassphrase", in, out, c.cc, opts...)
-	if err != nil {
+func _WalletService_NextMultisigAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NextMultisigAccountRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WalletServiceServer).NextMultisigAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/NextMultisigAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).NextMultisigAccount(ctx, req.(*NextMultisigAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *walletServiceClient) RenameAccount(ctx context.Context, in *RenameAccountRequest, opts ...grpc.CallOption) (*RenameAccountResponse, error) {
-	out := new(RenameAccountResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletService/RenameAccount", in, out, c.cc, opts...)
-	if err != nil {
+func _WalletService_NextAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NextAddressRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WalletServiceServer).NextAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/NextAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).NextAddress(ctx, req.(*NextAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *walletServiceClient) Rescan(ctx context.Context, in *RescanRequest, opts ...grpc.CallOption) (WalletService_RescanClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_WalletService_serviceDesc.Streams[4], c.cc, "/walletrpc.WalletService/Rescan", opts...)
-	if err != nil {
+func _WalletService_ImportPrivateKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportPrivateKeyRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	x := &walletServiceRescanClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ImportPrivateKey(ctx, in)
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/ImportPrivateKey",
 	}
-	return x, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ImportPrivateKey(ctx, req.(*ImportPrivateKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type WalletService_RescanClient interface {
-	Recv() (*RescanResponse, error)
-	grpc.ClientStream
+func _WalletService_ImportScript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportScriptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ImportScript(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/ImportScript",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ImportScript(ctx, req.(*ImportScriptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type walletServiceRescanClient struct {
-	grpc.ClientStream
+func _WalletService_FundTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FundTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).FundTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/FundTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).FundTransaction(ctx, req.(*FundTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *walletServiceRescanClient) Recv() (*RescanResponse, error) {
-	m := new(RescanResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func _WalletService_ConstructTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConstructTransactionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ConstructTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/ConstructTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ConstructTransaction(ctx, req.(*ConstructTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *walletServiceClient) NextAccount(ctx context.Context, in *NextAccountRequest, opts ...grpc.CallOption) (*NextAccountResponse, error) {
-	out := new(NextAccountResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletService/NextAccount", in, out, c.cc, opts...)
-	if err != nil {
+func _WalletService_SignTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignTransactionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SignTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/SignTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SignTransaction(ctx, req.(*SignTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *walletServiceClient) NextAddress(ctx context.Context, in *NextAddressRequest, opts ...grpc.CallOption) (*NextAddressResponse, error) {
-	out := new(NextAddressResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletService/NextAddress", in, out, c.cc, opts...)
-	if err != nil {
+func _WalletService_PublishTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishTransactionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WalletServiceServer).PublishTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/PublishTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).PublishTransaction(ctx, req.(*PublishTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *walletServiceClient) ImportPrivateKey(ctx context.Context, in *ImportPrivateKeyRequest, opts ...grpc.CallOption) (*ImportPrivateKeyResponse, error) {
-	out := new(ImportPrivateKeyResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletService/ImportPrivateKey", in, out, c.cc, opts...)
-	if err != nil {
+func _WalletService_SweepAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SweepAccountRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SweepAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/SweepAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SweepAccount(ctx, req.(*SweepAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *walletServiceClient) ImportScript(ctx context.Context, in *ImportScriptRequest, opts ...grpc.CallOption) (*ImportScriptResponse, error) {
-	out := new(ImportScriptResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletService/ImportScript", in, out, c.cc, opts...)
-	if err != nil {
+func _WalletService_AbandonTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbandonTransactionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WalletServiceServer).AbandonTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/AbandonTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).AbandonTransaction(ctx, req.(*AbandonTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *walletServiceClient) FundTransaction(ctx context.Context, in *FundTransactionRequest, opts ...grpc.CallOption) (*FundTransactionResponse, error) {
-	out := new(FundTransactionResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletService/FundTransaction", in, out, c.cc, opts...)
-	if err != nil {
+func _WalletService_BumpFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BumpFeeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WalletServiceServer).BumpFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/BumpFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).BumpFee(ctx, req.(*BumpFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *walletServiceClient) ConstructTransaction(ctx context.Context, in *ConstructTransactionRequest, opts ...grpc.CallOption) (*ConstructTransactionResponse, error) {
-	out := new(ConstructTransactionResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletService/ConstructTransaction", in, out, c.cc, opts...)
-	if err != nil {
+func _WalletService_CreatePartialTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePartialTransactionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CreatePartialTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/CreatePartialTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CreatePartialTransaction(ctx, req.(*CreatePartialTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *walletServiceClient) SignTransaction(ctx context.Context, in *SignTransactionRequest, opts ...grpc.CallOption) (*SignTransactionResponse, error) {
-	out := new(SignTransactionResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletService/SignTransaction", in, out, c.cc, opts...)
-	if err != nil {
+func _WalletService_UpdatePartialTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePartialTransactionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WalletServiceServer).UpdatePartialTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/UpdatePartialTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).UpdatePartialTransaction(ctx, req.(*UpdatePartialTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *walletServiceClient) PublishTransaction(ctx context.Context, in *PublishTransactionRequest, opts ...grpc.CallOption) (*PublishTransactionResponse, error) {
-	out := new(PublishTransactionResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletService/PublishTransaction", in, out, c.cc, opts...)
-	if err != nil {
+func _WalletService_SignPartialTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignPartialTransactionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SignPartialTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/SignPartialTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SignPartialTransaction(ctx, req.(*SignPartialTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *walletServiceClient) PurchaseTickets(ctx context.Context, in *PurchaseTicketsRequest, opts ...grpc.CallOption) (*PurchaseTicketsResponse, error) {
-	out := new(PurchaseTicketsResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletService/PurchaseTickets", in, out, c.cc, opts...)
-	if err != nil {
+func _WalletService_CombinePartialTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CombinePartialTransactionsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CombinePartialTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/CombinePartialTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CombinePartialTransactions(ctx, req.(*CombinePartialTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *walletServiceClient) RevokeTickets(ctx context.Context, in *RevokeTicketsRequest, opts ...grpc.CallOption) (*RevokeTicketsResponse, error) {
-	out := new(RevokeTicketsResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletService/RevokeTickets", in, out, c.cc, opts...)
-	if err != nil {
+func _WalletService_FinalizePartialTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinalizePartialTransactionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WalletServiceServer).FinalizePartialTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/FinalizePartialTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).FinalizePartialTransaction(ctx, req.(*FinalizePartialTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *walletServiceClient) LoadActiveDataFilters(ctx context.Context, in *LoadActiveDataFiltersRequest, opts ...grpc.CallOption) (*LoadActiveDataFiltersResponse, error) {
-	out := new(LoadActiveDataFiltersResponse)
-	err := grpc.Invoke(ctx, "/walletrpc.WalletService/LoadActiveDataFilters", in, out, c.cc, opts...)
-	if err != nil {
+func _WalletService_CreateSigningSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSigningSessionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// Server API for WalletService service
-
-type WalletServiceServer interface {
-	// Queries
-	Ping(context.Context, *PingRequest) (*PingResponse, error)
-	Network(context.Context, *NetworkRequest) (*NetworkResponse, error)
-	AccountNumber(context.Context, *AccountNumberRequest) (*AccountNumberResponse, error)
-	Accounts(context.Context, *AccountsRequest) (*AccountsResponse, error)
-	Balance(context.Context, *BalanceRequest) (*BalanceResponse, error)
-	GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error)
-	GetTransactions(*GetTransactionsRequest, WalletService_GetTransactionsServer) error
-	TicketPrice(context.Context, *TicketPriceRequest) (*TicketPriceResponse, error)
-	StakeInfo(context.Context, *StakeInfoRequest) (*StakeInfoResponse, error)
-	BlockInfo(context.Context, *BlockInfoRequest) (*BlockInfoResponse, error)
-	// Notifications
-	TransactionNotifications(*TransactionNotificationsRequest, WalletService_TransactionNotificationsServer) error
-	AccountNotifications(*AccountNotificationsRequest, WalletService_AccountNotificationsServer) error
-	ConfirmationNotifications(WalletService_ConfirmationNotificationsServer) error
-	// Control
-	ChangePassphrase(context.Context, *ChangePassphraseRequest) (*ChangePassphraseResponse, error)
-	RenameAccount(context.Context, *RenameAccountRequest) (*RenameAccountResponse, error)
-	Rescan(*RescanRequest, WalletService_RescanServer) error
-	NextAccount(context.Context, *NextAccountRequest) (*NextAccountResponse, error)
-	NextAddress(context.Context, *NextAddressRequest) (*NextAddressResponse, error)
-	ImportPrivateKey(context.Context, *ImportPrivateKeyRequest) (*ImportPrivateKeyResponse, error)
-	ImportScript(context.Context, *ImportScriptRequest) (*ImportScriptResponse, error)
-	FundTransaction(context.Context, *FundTransactionRequest) (*FundTransactionResponse, error)
-	ConstructTransaction(context.Context, *ConstructTransactionRequest) (*ConstructTransactionResponse, error)
-	SignTransaction(context.Context, *SignTransactionRequest) (*SignTransactionResponse, error)
-	PublishTransaction(context.Context, *PublishTransactionRequest) (*PublishTransactionResponse, error)
-	PurchaseTickets(context.Context, *PurchaseTicketsRequest) (*PurchaseTicketsResponse, error)
-	RevokeTickets(context.Context, *RevokeTicketsRequest) (*RevokeTicketsResponse, error)
-	LoadActiveDataFilters(context.Context, *LoadActiveDataFiltersRequest) (*LoadActiveDataFiltersResponse, error)
-}
-
-func RegisterWalletServiceServer(s *grpc.Server, srv WalletServiceServer) {
-	s.RegisterService(&_WalletService_serviceDesc, srv)
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CreateSigningSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/CreateSigningSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CreateSigningSession(ctx, req.(*CreateSigningSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PingRequest)
+func _WalletService_SigningSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SigningSessionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).Ping(ctx, in)
+		return srv.(WalletServiceServer).SigningSessions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/Ping",
+		FullMethod: "/walletrpc.WalletService/SigningSessions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).Ping(ctx, req.(*PingRequest))
+		return srv.(WalletServiceServer).SigningSessions(ctx, req.(*SigningSessionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_Network_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NetworkRequest)
+func _WalletService_ImportSigningSessionSignatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportSigningSessionSignaturesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).Network(ctx, in)
+		return srv.(WalletServiceServer).ImportSigningSessionSignatures(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/Network",
+		FullMethod: "/walletrpc.WalletService/ImportSigningSessionSignatures",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).Network(ctx, req.(*NetworkRequest))
+		return srv.(WalletServiceServer).ImportSigningSessionSignatures(ctx, req.(*ImportSigningSessionSignaturesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_AccountNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AccountNumberRequest)
+func _WalletService_SignSigningSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignSigningSessionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).AccountNumber(ctx, in)
+		return srv.(WalletServiceServer).SignSigningSession(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/AccountNumber",
+		FullMethod: "/walletrpc.WalletService/SignSigningSession",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).AccountNumber(ctx, req.(*AccountNumberRequest))
+		return srv.(WalletServiceServer).SignSigningSession(ctx, req.(*SignSigningSessionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_Accounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AccountsRequest)
+func _WalletService_AbandonSigningSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbandonSigningSessionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).Accounts(ctx, in)
+		return srv.(WalletServiceServer).AbandonSigningSession(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/Accounts",
+		FullMethod: "/walletrpc.WalletService/AbandonSigningSession",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).Accounts(ctx, req.(*AccountsRequest))
+		return srv.(WalletServiceServer).AbandonSigningSession(ctx, req.(*AbandonSigningSessionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_Balance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BalanceRequest)
+func _WalletService_InitiateSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitiateSwapRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).Balance(ctx, in)
+		return srv.(WalletServiceServer).InitiateSwap(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/Balance",
+		FullMethod: "/walletrpc.WalletService/InitiateSwap",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).Balance(ctx, req.(*BalanceRequest))
+		return srv.(WalletServiceServer).InitiateSwap(ctx, req.(*InitiateSwapRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTransactionRequest)
+func _WalletService_ParticipateSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParticipateSwapRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).GetTransaction(ctx, in)
+		return srv.(WalletServiceServer).ParticipateSwap(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/GetTransaction",
+		FullMethod: "/walletrpc.WalletService/ParticipateSwap",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).GetTransaction(ctx, req.(*GetTransactionRequest))
+		return srv.(WalletServiceServer).ParticipateSwap(ctx, req.(*ParticipateSwapRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_GetTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetTransactionsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _WalletService_RedeemSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeemSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(WalletServiceServer).GetTransactions(m, &walletServiceGetTransactionsServer{stream})
-}
-
-type WalletService_GetTransactionsServer interface {
-	Send(*GetTransactionsResponse) error
-	grpc.ServerStream
-}
-
-type walletServiceGetTransactionsServer struct {
-	grpc.ServerStream
+	if interceptor == nil {
+		return srv.(WalletServiceServer).RedeemSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/RedeemSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).RedeemSwap(ctx, req.(*RedeemSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *walletServiceGetTransactionsServer) Send(m *GetTransactionsResponse) error {
-	return x.ServerStream.SendMsg(m)
+func _WalletService_RefundSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).RefundSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/RefundSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).RefundSwap(ctx, req.(*RefundSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_TicketPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TicketPriceRequest)
+func _WalletService_ExtractSwapSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtractSwapSecretRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).TicketPrice(ctx, in)
+		return srv.(WalletServiceServer).ExtractSwapSecret(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/TicketPrice",
+		FullMethod: "/walletrpc.WalletService/ExtractSwapSecret",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).TicketPrice(ctx, req.(*TicketPriceRequest))
+		return srv.(WalletServiceServer).ExtractSwapSecret(ctx, req.(*ExtractSwapSecretRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_StakeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StakeInfoRequest)
+func _WalletService_AuditSwapContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditSwapContractRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).StakeInfo(ctx, in)
+		return srv.(WalletServiceServer).AuditSwapContract(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/StakeInfo",
+		FullMethod: "/walletrpc.WalletService/AuditSwapContract",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).StakeInfo(ctx, req.(*StakeInfoRequest))
+		return srv.(WalletServiceServer).AuditSwapContract(ctx, req.(*AuditSwapContractRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_BlockInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BlockInfoRequest)
+func _WalletService_SwapContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwapContractsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).BlockInfo(ctx, in)
+		return srv.(WalletServiceServer).SwapContracts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/BlockInfo",
+		FullMethod: "/walletrpc.WalletService/SwapContracts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).BlockInfo(ctx, req.(*BlockInfoRequest))
+		return srv.(WalletServiceServer).SwapContracts(ctx, req.(*SwapContractsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_TransactionNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(TransactionNotificationsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(WalletServiceServer).TransactionNotifications(m, &walletServiceTransactionNotificationsServer{stream})
-}
-
-type WalletService_TransactionNotificationsServer interface {
-	Send(*TransactionNotificationsResponse) error
-	grpc.ServerStream
-}
-
-type walletServiceTransactionNotificationsServer struct {
-	grpc.ServerStream
-}
-
-func (x *walletServiceTransactionNotificationsServer) Send(m *TransactionNotificationsResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _WalletService_AccountNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(AccountNotificationsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(WalletServiceServer).AccountNotifications(m, &walletServiceAccountNotificationsServer{stream})
-}
-
-type WalletService_AccountNotificationsServer interface {
-	Send(*AccountNotificationsResponse) error
-	grpc.ServerStream
-}
-
-type walletServiceAccountNotificationsServer struct {
-	grpc.ServerStream
-}
-
-func (x *walletServiceAccountNotificationsServer) Send(m *AccountNotificationsResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _WalletService_ConfirmationNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(WalletServiceServer).ConfirmationNotifications(&walletServiceConfirmationNotificationsServer{stream})
-}
-
-type WalletService_ConfirmationNotificationsServer interface {
-	Send(*ConfirmationNotificationsResponse) error
-	Recv() (*ConfirmationNotificationsRequest, error)
-	grpc.ServerStream
-}
-
-type walletServiceConfirmationNotificationsServer struct {
-	grpc.ServerStream
-}
-
-func (x *walletServiceConfirmationNotificationsServer) Send(m *ConfirmationNotificationsResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func (x *walletServiceConfirmationNotificationsServer) Recv() (*ConfirmationNotificationsRequest, error) {
-	m := new(ConfirmationNotificationsRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
-
-func _WalletService_ChangePassphrase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChangePassphraseRequest)
+func _WalletService_CreateVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVaultRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).ChangePassphrase(ctx, in)
+		return srv.(WalletServiceServer).CreateVault(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/ChangePassphrase",
+		FullMethod: "/walletrpc.WalletService/CreateVault",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).ChangePassphrase(ctx, req.(*ChangePassphraseRequest))
+		return srv.(WalletServiceServer).CreateVault(ctx, req.(*CreateVaultRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_RenameAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RenameAccountRequest)
+func _WalletService_Vaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VaultsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).RenameAccount(ctx, in)
+		return srv.(WalletServiceServer).Vaults(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/RenameAccount",
+		FullMethod: "/walletrpc.WalletService/Vaults",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).RenameAccount(ctx, req.(*RenameAccountRequest))
+		return srv.(WalletServiceServer).Vaults(ctx, req.(*VaultsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_Rescan_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(RescanRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(WalletServiceServer).Rescan(m, &walletServiceRescanServer{stream})
-}
-
-type WalletService_RescanServer interface {
-	Send(*RescanResponse) error
-	grpc.ServerStream
-}
-
-type walletServiceRescanServer struct {
-	grpc.ServerStream
-}
-
-func (x *walletServiceRescanServer) Send(m *RescanResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _WalletService_NextAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NextAccountRequest)
+func _WalletService_PurchaseTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseTicketsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).NextAccount(ctx, in)
+		return srv.(WalletServiceServer).PurchaseTickets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/NextAccount",
+		FullMethod: "/walletrpc.WalletService/PurchaseTickets",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).NextAccount(ctx, req.(*NextAccountRequest))
+		return srv.(WalletServiceServer).PurchaseTickets(ctx, req.(*PurchaseTicketsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_NextAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NextAddressRequest)
+func _WalletService_RevokeTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeTicketsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).NextAddress(ctx, in)
+		return srv.(WalletServiceServer).RevokeTickets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/NextAddress",
+		FullMethod: "/walletrpc.WalletService/RevokeTickets",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).NextAddress(ctx, req.(*NextAddressRequest))
+		return srv.(WalletServiceServer).RevokeTickets(ctx, req.(*RevokeTicketsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_ImportPrivateKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImportPrivateKeyRequest)
+func _WalletService_LoadActiveDataFilters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadActiveDataFiltersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).ImportPrivateKey(ctx, in)
+		return srv.(WalletServiceServer).LoadActiveDataFilters(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/ImportPrivateKey",
+		FullMethod: "/walletrpc.WalletService/LoadActiveDataFilters",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).ImportPrivateKey(ctx, req.(*ImportPrivateKeyRequest))
+		return srv.(WalletServiceServer).LoadActiveDataFilters(ctx, req.(*LoadActiveDataFiltersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_ImportScript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImportScriptRequest)
+func _WalletService_LockOutpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockOutpointRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).ImportScript(ctx, in)
+		return srv.(WalletServiceServer).LockOutpoint(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/ImportScript",
+		FullMethod: "/walletrpc.WalletService/LockOutpoint",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).ImportScript(ctx, req.(*ImportScriptRequest))
+		return srv.(WalletServiceServer).LockOutpoint(ctx, req.(*LockOutpointRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_FundTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FundTransactionRequest)
+func _WalletService_UnlockOutpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockOutpointRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).FundTransaction(ctx, in)
+		return srv.(WalletServiceServer).UnlockOutpoint(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/FundTransaction",
+		FullMethod: "/walletrpc.WalletService/UnlockOutpoint",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).FundTransaction(ctx, req.(*FundTransactionRequest))
+		return srv.(WalletServiceServer).UnlockOutpoint(ctx, req.(*UnlockOutpointRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_ConstructTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConstructTransactionRequest)
+func _WalletService_SetTransactionLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTransactionLabelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).ConstructTransaction(ctx, in)
+		return srv.(WalletServiceServer).SetTransactionLabel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/ConstructTransaction",
+		FullMethod: "/walletrpc.WalletService/SetTransactionLabel",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).ConstructTransaction(ctx, req.(*ConstructTransactionRequest))
+		return srv.(WalletServiceServer).SetTransactionLabel(ctx, req.(*SetTransactionLabelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_SignTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignTransactionRequest)
+func _WalletService_SetAddressLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAddressLabelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).SignTransaction(ctx, in)
+		return srv.(WalletServiceServer).SetAddressLabel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/SignTransaction",
+		FullMethod: "/walletrpc.WalletService/SetAddressLabel",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).SignTransaction(ctx, req.(*SignTransactionRequest))
+		return srv.(WalletServiceServer).SetAddressLabel(ctx, req.(*SetAddressLabelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_PublishTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PublishTransactionRequest)
+func _WalletService_SetPayee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPayeeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).PublishTransaction(ctx, in)
+		return srv.(WalletServiceServer).SetPayee(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/PublishTransaction",
+		FullMethod: "/walletrpc.WalletService/SetPayee",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).PublishTransaction(ctx, req.(*PublishTransactionRequest))
+		return srv.(WalletServiceServer).SetPayee(ctx, req.(*SetPayeeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_PurchaseTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PurchaseTicketsRequest)
+func _WalletService_RemovePayee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePayeeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).PurchaseTickets(ctx, in)
+		return srv.(WalletServiceServer).RemovePayee(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/PurchaseTickets",
+		FullMethod: "/walletrpc.WalletService/RemovePayee",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).PurchaseTickets(ctx, req.(*PurchaseTicketsRequest))
+		return srv.(WalletServiceServer).RemovePayee(ctx, req.(*RemovePayeeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_RevokeTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RevokeTicketsRequest)
+func _WalletService_BackupWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupWalletRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).RevokeTickets(ctx, in)
+		return srv.(WalletServiceServer).BackupWallet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/RevokeTickets",
+		FullMethod: "/walletrpc.WalletService/BackupWallet",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).RevokeTickets(ctx, req.(*RevokeTicketsRequest))
+		return srv.(WalletServiceServer).BackupWallet(ctx, req.(*BackupWalletRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_LoadActiveDataFilters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoadActiveDataFiltersRequest)
+func _WalletService_CheckConsistency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckConsistencyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).LoadActiveDataFilters(ctx, in)
+		return srv.(WalletServiceServer).CheckConsistency(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/LoadActiveDataFilters",
+		FullMethod: "/walletrpc.WalletService/CheckConsistency",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).LoadActiveDataFilters(ctx, req.(*LoadActiveDataFiltersRequest))
+		return srv.(WalletServiceServer).CheckConsistency(ctx, req.(*CheckConsistencyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -4517,6 +8634,30 @@ var _WalletService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BlockInfo",
 			Handler:    _WalletService_BlockInfo_Handler,
 		},
+		{
+			MethodName: "LockedOutpoints",
+			Handler:    _WalletService_LockedOutpoints_Handler,
+		},
+		{
+			MethodName: "TransactionLabel",
+			Handler:    _WalletService_TransactionLabel_Handler,
+		},
+		{
+			MethodName: "SearchTransactionLabels",
+			Handler:    _WalletService_SearchTransactionLabels_Handler,
+		},
+		{
+			MethodName: "AddressLabels",
+			Handler:    _WalletService_AddressLabels_Handler,
+		},
+		{
+			MethodName: "Payees",
+			Handler:    _WalletService_Payees_Handler,
+		},
+		{
+			MethodName: "UnminedTransactions",
+			Handler:    _WalletService_UnminedTransactions_Handler,
+		},
 		{
 			MethodName: "ChangePassphrase",
 			Handler:    _WalletService_ChangePassphrase_Handler,
@@ -4529,6 +8670,10 @@ var _WalletService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "NextAccount",
 			Handler:    _WalletService_NextAccount_Handler,
 		},
+		{
+			MethodName: "NextMultisigAccount",
+			Handler:    _WalletService_NextMultisigAccount_Handler,
+		},
 		{
 			MethodName: "NextAddress",
 			Handler:    _WalletService_NextAddress_Handler,
@@ -4557,6 +8702,94 @@ var _WalletService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PublishTransaction",
 			Handler:    _WalletService_PublishTransaction_Handler,
 		},
+		{
+			MethodName: "SweepAccount",
+			Handler:    _WalletService_SweepAccount_Handler,
+		},
+		{
+			MethodName: "AbandonTransaction",
+			Handler:    _WalletService_AbandonTransaction_Handler,
+		},
+		{
+			MethodName: "BumpFee",
+			Handler:    _WalletService_BumpFee_Handler,
+		},
+		{
+			MethodName: "CreatePartialTransaction",
+			Handler:    _WalletService_CreatePartialTransaction_Handler,
+		},
+		{
+			MethodName: "UpdatePartialTransaction",
+			Handler:    _WalletService_UpdatePartialTransaction_Handler,
+		},
+		{
+			MethodName: "SignPartialTransaction",
+			Handler:    _WalletService_SignPartialTransaction_Handler,
+		},
+		{
+			MethodName: "CombinePartialTransactions",
+			Handler:    _WalletService_CombinePartialTransactions_Handler,
+		},
+		{
+			MethodName: "FinalizePartialTransaction",
+			Handler:    _WalletService_FinalizePartialTransaction_Handler,
+		},
+		{
+			MethodName: "CreateSigningSession",
+			Handler:    _WalletService_CreateSigningSession_Handler,
+		},
+		{
+			MethodName: "SigningSessions",
+			Handler:    _WalletService_SigningSessions_Handler,
+		},
+		{
+			MethodName: "ImportSigningSessionSignatures",
+			Handler:    _WalletService_ImportSigningSessionSignatures_Handler,
+		},
+		{
+			MethodName: "SignSigningSession",
+			Handler:    _WalletService_SignSigningSession_Handler,
+		},
+		{
+			MethodName: "AbandonSigningSession",
+			Handler:    _WalletService_AbandonSigningSession_Handler,
+		},
+		{
+			MethodName: "InitiateSwap",
+			Handler:    _WalletService_InitiateSwap_Handler,
+		},
+		{
+			MethodName: "ParticipateSwap",
+			Handler:    _WalletService_ParticipateSwap_Handler,
+		},
+		{
+			MethodName: "RedeemSwap",
+			Handler:    _WalletService_RedeemSwap_Handler,
+		},
+		{
+			MethodName: "RefundSwap",
+			Handler:    _WalletService_RefundSwap_Handler,
+		},
+		{
+			MethodName: "ExtractSwapSecret",
+			Handler:    _WalletService_ExtractSwapSecret_Handler,
+		},
+		{
+			MethodName: "AuditSwapContract",
+			Handler:    _WalletService_AuditSwapContract_Handler,
+		},
+		{
+			MethodName: "SwapContracts",
+			Handler:    _WalletService_SwapContracts_Handler,
+		},
+		{
+			MethodName: "CreateVault",
+			Handler:    _WalletService_CreateVault_Handler,
+		},
+		{
+			MethodName: "Vaults",
+			Handler:    _WalletService_Vaults_Handler,
+		},
 		{
 			MethodName: "PurchaseTickets",
 			Handler:    _WalletService_PurchaseTickets_Handler,
@@ -4569,6 +8802,38 @@ var _WalletService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LoadActiveDataFilters",
 			Handler:    _WalletService_LoadActiveDataFilters_Handler,
 		},
+		{
+			MethodName: "LockOutpoint",
+			Handler:    _WalletService_LockOutpoint_Handler,
+		},
+		{
+			MethodName: "UnlockOutpoint",
+			Handler:    _WalletService_UnlockOutpoint_Handler,
+		},
+		{
+			MethodName: "SetTransactionLabel",
+			Handler:    _WalletService_SetTransactionLabel_Handler,
+		},
+		{
+			MethodName: "SetAddressLabel",
+			Handler:    _WalletService_SetAddressLabel_Handler,
+		},
+		{
+			MethodName: "SetPayee",
+			Handler:    _WalletService_SetPayee_Handler,
+		},
+		{
+			MethodName: "RemovePayee",
+			Handler:    _WalletService_RemovePayee_Handler,
+		},
+		{
+			MethodName: "BackupWallet",
+			Handler:    _WalletService_BackupWallet_Handler,
+		},
+		{
+			MethodName: "CheckConsistency",
+			Handler:    _WalletService_CheckConsistency_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -4576,6 +8841,11 @@ var _WalletService_serviceDesc = grpc.ServiceDesc{
 			Handler:       _WalletService_GetTransactions_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "AddressTransactions",
+			Handler:       _WalletService_AddressTransactions_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "TransactionNotifications",
 			Handler:       _WalletService_TransactionNotifications_Handler,
@@ -5584,342 +9854,555 @@ var _VotingService_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("api.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 5392 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x7c, 0xdd, 0x6f, 0x1c, 0xc9,
-	0x71, 0xb8, 0x87, 0xcb, 0x8f, 0x65, 0x91, 0xbb, 0xdc, 0x6d, 0x7e, 0x2d, 0x57, 0xd2, 0x49, 0x1a,
-	0x49, 0x27, 0xd9, 0x77, 0xa2, 0x75, 0xf4, 0xd9, 0xbe, 0x9f, 0x7d, 0xbf, 0x3b, 0x53, 0x14, 0xa5,
-	0xa3, 0x25, 0x91, 0x9b, 0x21, 0x25, 0x9d, 0x7d, 0x81, 0x07, 0xc3, 0x9d, 0x26, 0x39, 0xe6, 0xee,
-	0xcc, 0xde, 0xcc, 0x2c, 0x3f, 0x2e, 0x09, 0x70, 0x39, 0x20, 0x8f, 0x01, 0xf2, 0x90, 0x87, 0x00,
-	0x86, 0x83, 0x3c, 0x06, 0x09, 0x90, 0x0f, 0x24, 0x48, 0x02, 0xf8, 0x25, 0x7e, 0x0e, 0x82, 0xfc,
-	0x17, 0x01, 0xf2, 0x14, 0x20, 0x0f, 0x79, 0x0e, 0xba, 0xbb, 0x7a, 0xa6, 0x7b, 0x3e, 0x96, 0xe4,
-	0xdd, 0x93, 0x38, 0x55, 0xd5, 0xd5, 0xd5, 0xdd, 0x55, 0xd5, 0xf5, 0xd1, 0x2b, 0x98, 0x76, 0x06,
-	0xde, 0xea, 0x20, 0x0c, 0xe2, 0x80, 0x4c, 0x9f, 0x3a, 0xbd, 0x1e, 0x8d, 0xc3, 0x41, 0xd7, 0x6c,
-	0x40, 0xfd, 0x35, 0x0d, 0x23, 0x2f, 0xf0, 0x2d, 0xfa, 0xf9, 0x90, 0x46, 0xb1, 0xf9, 0x5b, 0x03,
-	0xe6, 0x12, 0x50, 0x34, 0x08, 0xfc, 0x88, 0x92, 0x7b, 0x50, 0x3f, 0x11, 0x20, 0x3b, 0x8a, 0x43,
-	0xcf, 0x3f, 0x6c, 0x19, 0xb7, 0x8c, 0x07, 0xd3, 0x56, 0x0d, 0xa1, 0xbb, 0x1c, 0x48, 0x16, 0x60,
-	0xa2, 0xef, 0xfc, 0x32, 0x08, 0x5b, 0x63, 0xb7, 0x8c, 0x07, 0x35, 0x4b, 0x7c, 0x70, 0xa8, 0xe7,
-	0x07, 0x61, 0xab, 0x82, 0x50, 0xf6, 0xc1, 0xa0, 0x03, 0x27, 0xee, 0x1e, 0xb5, 0xc6, 0x05, 0x94,
-	0x7f, 0x90, 0xb7, 0x00, 0x06, 0x21, 0x0d, 0x69, 0x8f, 0x3a, 0x11, 0x6d, 0x4d, 0xf0, 0x49, 0x14,
-	0x08, 0x13, 0x64, 0x7f, 0xe8, 0xf5, 0x5c, 0xbb, 0x4f, 0x63, 0xc7, 0x75, 0x62, 0xa7, 0x35, 0x29,
-	0x04, 0xe1, 0xd0, 0x97, 0x08, 0x34, 0xff, 0x63, 0x02, 0xc8, 0x5e, 0xe8, 0xf8, 0x91, 0xd3, 0x8d,
-	0xbd, 0xc0, 0x7f, 0x42, 0x63, 0xc7, 0xeb, 0x45, 0x84, 0xc0, 0xf8, 0x91, 0x13, 0x1d, 0x71, 0xe1,
-	0x67, 0x2d, 0xfe, 0x37, 0xb9, 0x05, 0x33, 0x71, 0x4a, 0xc9, 0x25, 0x9f, 0xb5, 0x54, 0x10, 0xf9,
-	0x31, 0x4c, 0xba, 0x74, 0xdf, 0x8b, 0xa3, 0x56, 0xe5, 0x56, 0xe5, 0xc1, 0xcc, 0xda, 0x9d, 0xd5,
-	0x64, 0xfb, 0x56, 0xf3, 0x93, 0xac, 0x6e, 0xf9, 0x83, 0x61, 0x6c, 0xe1, 0x10, 0xf2, 0x11, 0x4c,
-	0x75, 0x43, 0xea, 0xb2, 0xd1, 0xe3, 0x7c, 0xf4, 0xdd, 0xd1, 0xa3, 0x77, 0x86, 0x31, 0x1b, 0x2e,
-	0x07, 0x91, 0x06, 0x54, 0x0e, 0xa8, 0xd8, 0x89, 0x8a, 0xc5, 0xfe, 0x24, 0xd7, 0x61, 0x3a, 0xf6,
-	0xfa, 0x34, 0x8a, 0x9d, 0xfe, 0x80, 0xaf, 0xbe, 0x62, 0xa5, 0x00, 0xf2, 0x29, 0x34, 0x14, 0xd9,
-	0xed, 0xf8, 0x7c, 0x40, 0x5b, 0x53, 0xb7, 0x8c, 0x07, 0xf5, 0xb5, 0x87, 0xa3, 0x27, 0x56, 0x40,
-	0x7b, 0xe7, 0x03, 0x6a, 0xcd, 0xc5, 0x3a, 0xa0, 0xfd, 0x39, 0x4c, 0xf0, 0xa5, 0xb1, 0x93, 0xf3,
-	0x7c, 0x97, 0x9e, 0xf1, 0x6d, 0xac, 0x59, 0xe2, 0x83, 0x7c, 0x1b, 0x1a, 0x83, 0x90, 0x9e, 0x78,
-	0xc1, 0x30, 0xb2, 0x9d, 0x6e, 0x37, 0x18, 0xfa, 0x31, 0xaa, 0xc1, 0x9c, 0x84, 0xaf, 0x0b, 0x30,
-	0xb9, 0x0f, 0x73, 0x29, 0x69, 0x9f, 0x53, 0x56, 0xf8, 0x3a, 0xea, 0x09, 0x25, 0x87, 0xb6, 0xff,
-	0xda, 0x80, 0x49, 0xb1, 0x21, 0x25, 0x93, 0xb6, 0x60, 0x4a, 0x9f, 0x4b, 0x7e, 0x92, 0x36, 0x54,
-	0x3d, 0x3f, 0xa6, 0xa1, 0xef, 0xf4, 0x38, 0xf3, 0xaa, 0x95, 0x7c, 0x93, 0x25, 0x98, 0xc4, 0x69,
-	0xc7, 0xf9, 0xb4, 0xf8, 0xc5, 0xb9, 0xb9, 0x6e, 0x48, 0xa3, 0x08, 0x35, 0x4f, 0x7e, 0x92, 0x3b,
-	0x50, 0x0b, 0xb8, 0x1c, 0x76, 0xd4, 0x0d, 0xbd, 0x41, 0xcc, 0xf7, 0x7d, 0xd6, 0x9a, 0x15, 0xc0,
-	0x5d, 0x0e, 0x33, 0x3f, 0x83, 0xb9, 0xcc, 0x26, 0x92, 0x19, 0x98, 0xb2, 0x36, 0x9f, 0xbd, 0x7a,
-	0xb1, 0x6e, 0x35, 0xbe, 0x45, 0x66, 0xa1, 0xba, 0xb1, 0xb3, 0xb5, 0xfd, 0x78, 0x7d, 0x77, 0xb3,
-	0x31, 0x4e, 0xe6, 0x61, 0x6e, 0x6f, 0x6b, 0xe3, 0xf9, 0xe6, 0x9e, 0xdd, 0x79, 0x65, 0x6d, 0x7c,
-	0xc2, 0x80, 0x06, 0xa9, 0xc2, 0xf8, 0xeb, 0x9d, 0xbd, 0xcd, 0xc6, 0x18, 0xa9, 0x03, 0x58, 0x9b,
-	0xaf, 0x77, 0x36, 0xd6, 0xf7, 0xb6, 0x76, 0xb6, 0x1b, 0x15, 0xf3, 0x57, 0x06, 0xcc, 0x3e, 0xee,
-	0x05, 0xdd, 0xe3, 0x51, 0xba, 0xbc, 0x04, 0x93, 0x47, 0xd4, 0x3b, 0x3c, 0x12, 0xbb, 0x31, 0x61,
-	0xe1, 0x97, 0xae, 0x32, 0x95, 0xac, 0xca, 0xac, 0xc3, 0xac, 0x72, 0xd6, 0x52, 0x4f, 0x6f, 0x8c,
-	0x54, 0x17, 0x4b, 0x1b, 0x62, 0xee, 0x40, 0x1d, 0x0f, 0xf7, 0xb1, 0xd3, 0x73, 0xfc, 0x2e, 0x55,
-	0x4f, 0xc6, 0xd0, 0x4f, 0xe6, 0x0e, 0xd4, 0xe2, 0x20, 0x76, 0x7a, 0xf6, 0xbe, 0x20, 0xe5, 0xb2,
-	0x56, 0xac, 0x59, 0x0e, 0xc4, 0xe1, 0x66, 0x0d, 0x66, 0x3a, 0x9e, 0x7f, 0x28, 0x7d, 0x52, 0x1d,
-	0x66, 0xc5, 0xa7, 0xf0, 0x47, 0xcc, 0x6b, 0x6d, 0xd3, 0xf8, 0x34, 0x08, 0x8f, 0x25, 0xc5, 0x07,
-	0x30, 0x97, 0x40, 0x52, 0xa7, 0xc5, 0xe4, 0x3b, 0xa1, 0xb6, 0x2f, 0x30, 0x28, 0x49, 0x4d, 0x40,
-	0x91, 0xdc, 0xfc, 0x7f, 0xb0, 0x80, 0xb2, 0x6f, 0x0f, 0xfb, 0xfb, 0x34, 0x44, 0x8e, 0xe4, 0x36,
-	0xcc, 0xa2, 0xc8, 0xb6, 0xef, 0xf4, 0x29, 0x7a, 0xbc, 0x19, 0x84, 0x6d, 0x3b, 0x7d, 0x6a, 0x7e,
-	0x04, 0x8b, 0x99, 0xa1, 0xea, 0xd4, 0x38, 0x96, 0x63, 0xd2, 0xa9, 0x15, 0x72, 0xb3, 0x09, 0x73,
-	0x38, 0x3e, 0x92, 0xeb, 0xf8, 0x97, 0x0a, 0x34, 0x52, 0x18, 0xb2, 0xfb, 0x18, 0xaa, 0x38, 0x30,
-	0x6a, 0x19, 0x39, 0x1f, 0x94, 0x25, 0x97, 0x00, 0x2b, 0x19, 0x44, 0xde, 0x05, 0xd2, 0x1d, 0x86,
-	0x21, 0xf5, 0x63, 0x7b, 0x9f, 0x29, 0x91, 0xcd, 0x55, 0x47, 0xf8, 0xba, 0x06, 0x62, 0xb8, 0x76,
-	0x7d, 0xc2, 0xd4, 0xe8, 0x11, 0x2c, 0x64, 0xa8, 0x85, 0x52, 0x55, 0xb8, 0x52, 0x11, 0x8d, 0x9e,
-	0x63, 0xda, 0x5f, 0x8d, 0xc1, 0x94, 0xb4, 0xee, 0xcb, 0xad, 0x3d, 0xb7, 0xbd, 0x63, 0xb9, 0xed,
-	0xcd, 0x6b, 0x4a, 0x25, 0xaf, 0x29, 0x6c, 0x69, 0xf4, 0x4c, 0x18, 0xb6, 0x7d, 0x4c, 0xcf, 0xed,
-	0x6e, 0x62, 0xd8, 0x35, 0xab, 0x21, 0x31, 0xcf, 0xe9, 0xf9, 0x06, 0x17, 0xee, 0x5d, 0x20, 0xd2,
-	0x0d, 0x28, 0xd4, 0x13, 0x82, 0x5a, 0x62, 0x34, 0xea, 0xfe, 0x20, 0x08, 0x63, 0xea, 0x2a, 0xd4,
-	0x93, 0x48, 0x8d, 0x18, 0x49, 0x6d, 0x7e, 0x0a, 0x0b, 0x16, 0x65, 0x6b, 0x91, 0xfb, 0x8f, 0x8a,
-	0x74, 0xc9, 0x0d, 0x59, 0x81, 0xaa, 0x4f, 0x4f, 0xd5, 0xcd, 0x98, 0xf2, 0xe9, 0x29, 0xd7, 0xb3,
-	0x65, 0x58, 0xcc, 0x70, 0x46, 0x3b, 0x58, 0x83, 0x9a, 0x45, 0xa3, 0xae, 0xe3, 0x2b, 0x4a, 0xbb,
-	0x4f, 0x0f, 0x3d, 0x5f, 0x1e, 0x99, 0xc1, 0x8f, 0x6c, 0x86, 0xc3, 0xc4, 0x59, 0x99, 0xff, 0x1f,
-	0xea, 0x72, 0x0c, 0xaa, 0xd7, 0x3b, 0xd0, 0x0c, 0x39, 0xc4, 0xa7, 0xae, 0x1d, 0x1f, 0x85, 0xc1,
-	0xf0, 0xf0, 0x08, 0x47, 0x36, 0x12, 0xc4, 0x9e, 0x80, 0x9b, 0x6f, 0x80, 0x6c, 0xd3, 0xb3, 0x38,
-	0xb3, 0x46, 0x76, 0x6f, 0x3b, 0x51, 0x34, 0x38, 0x0a, 0xd9, 0xbd, 0x2d, 0x7c, 0x92, 0x02, 0xb9,
-	0xc4, 0x69, 0x9b, 0x1f, 0xc2, 0xbc, 0xc6, 0xf8, 0x6a, 0xa6, 0xf4, 0xef, 0x63, 0x28, 0x97, 0xf0,
-	0xd8, 0x52, 0xae, 0x72, 0x37, 0xf4, 0x03, 0x18, 0x3f, 0xf6, 0x7c, 0x97, 0x4b, 0x52, 0x5f, 0x33,
-	0x15, 0x7b, 0xca, 0xb3, 0x59, 0x7d, 0xee, 0xf9, 0xae, 0xc5, 0xe9, 0xc9, 0x53, 0x80, 0x43, 0x67,
-	0x60, 0x0f, 0x82, 0x9e, 0xd7, 0x3d, 0xe7, 0x1a, 0x59, 0x5f, 0xbb, 0x3f, 0x7a, 0xf4, 0x33, 0x67,
-	0xd0, 0xe1, 0xe4, 0xd6, 0xf4, 0xa1, 0xfc, 0xd3, 0x5c, 0x83, 0x71, 0xc6, 0x95, 0x2c, 0x40, 0xe3,
-	0xf1, 0x56, 0xe7, 0xd1, 0xa3, 0xf7, 0xdf, 0xb7, 0x37, 0x3f, 0xdd, 0xdb, 0xb4, 0xb6, 0xd7, 0x5f,
-	0x34, 0xbe, 0xa5, 0x42, 0xb7, 0xb6, 0x11, 0x6a, 0x98, 0x1e, 0x4c, 0x27, 0xbc, 0x48, 0x1b, 0x96,
-	0x9e, 0xad, 0x77, 0xec, 0xce, 0xce, 0x8b, 0xad, 0x8d, 0x9f, 0xd9, 0xaf, 0xb6, 0x77, 0x3b, 0x9b,
-	0x1b, 0x5b, 0x4f, 0xb7, 0x36, 0x9f, 0x88, 0xe1, 0x0a, 0x6e, 0xd3, 0xb2, 0x76, 0xac, 0x86, 0x41,
-	0x16, 0xa1, 0xa9, 0x40, 0xb7, 0x9e, 0x6d, 0xef, 0x58, 0xec, 0xaa, 0x99, 0x87, 0x39, 0x05, 0xfc,
-	0xc6, 0x5a, 0xef, 0x34, 0x2a, 0xe6, 0x36, 0x9e, 0x86, 0x5c, 0x09, 0x9e, 0x86, 0x72, 0x45, 0x1a,
-	0xfa, 0x15, 0x79, 0x03, 0x60, 0x30, 0xdc, 0xef, 0x79, 0x5d, 0x66, 0x29, 0x78, 0xbe, 0xd3, 0x02,
-	0xf2, 0x9c, 0x9e, 0x9b, 0x7f, 0x67, 0xc0, 0xf2, 0x16, 0xb7, 0x98, 0x4e, 0xe8, 0x9d, 0x38, 0x31,
-	0x7d, 0x4e, 0xcf, 0x2f, 0xab, 0x3c, 0xe5, 0xb7, 0xfc, 0xdb, 0x2c, 0x92, 0xe0, 0xec, 0xb8, 0x7d,
-	0x9e, 0x7a, 0x07, 0xfc, 0x44, 0xa6, 0xad, 0xda, 0x20, 0x99, 0xe5, 0x8d, 0x77, 0xc0, 0x2e, 0x46,
-	0xa1, 0xc8, 0xdc, 0x31, 0x54, 0x2d, 0xfc, 0x22, 0xd7, 0x60, 0x9a, 0xfd, 0x6b, 0x1f, 0x84, 0x41,
-	0x9f, 0x7b, 0x81, 0x09, 0xab, 0xca, 0x00, 0x4f, 0xc3, 0xa0, 0x6f, 0xb6, 0xa1, 0x95, 0x97, 0x18,
-	0x0d, 0xef, 0xef, 0x0d, 0x98, 0x17, 0x48, 0x71, 0xf9, 0x5f, 0x76, 0x29, 0x4b, 0x30, 0x89, 0x11,
-	0x84, 0x70, 0xbe, 0xf8, 0xa5, 0x08, 0x58, 0x29, 0x17, 0x70, 0x5c, 0x17, 0x90, 0x3c, 0x04, 0x12,
-	0xd2, 0xcf, 0x87, 0x5e, 0x48, 0xed, 0x90, 0xba, 0x94, 0xf6, 0x9d, 0xfd, 0x9e, 0x08, 0x15, 0xab,
-	0x56, 0x13, 0x31, 0x56, 0x82, 0x30, 0x7f, 0x06, 0x0b, 0xba, 0xc8, 0x78, 0xa6, 0xb7, 0x61, 0x76,
-	0xb0, 0x16, 0x1d, 0xd9, 0xfa, 0xc1, 0xce, 0x30, 0x18, 0x1e, 0x3f, 0x5b, 0x96, 0x32, 0xc3, 0x18,
-	0x9f, 0x41, 0x81, 0x98, 0x3e, 0xd4, 0xd1, 0x1f, 0x5f, 0xd1, 0xe9, 0x7d, 0x1f, 0x96, 0x50, 0x50,
-	0xd7, 0xee, 0x06, 0xfe, 0x81, 0x17, 0xf6, 0x1d, 0x11, 0x85, 0x88, 0x08, 0x66, 0x51, 0x62, 0x37,
-	0x54, 0xa4, 0xf9, 0x87, 0x63, 0x30, 0x97, 0x4c, 0x88, 0xcb, 0x58, 0x80, 0x09, 0x7e, 0x31, 0xf0,
-	0x89, 0x2a, 0x96, 0xf8, 0x60, 0xa1, 0x4f, 0x34, 0xa0, 0xbe, 0x9b, 0x08, 0x5e, 0xb1, 0x52, 0x00,
-	0x8b, 0x44, 0xbd, 0x7e, 0xdf, 0x89, 0x87, 0x7c, 0x0b, 0x4f, 0x9d, 0xd0, 0x95, 0x91, 0xa8, 0x04,
-	0x5b, 0x1c, 0x4a, 0x7e, 0x04, 0x2b, 0x09, 0x61, 0x14, 0x3b, 0xc7, 0xd4, 0x3e, 0xa4, 0x3e, 0x0d,
-	0xb9, 0x38, 0x18, 0x45, 0x2e, 0x4b, 0x82, 0x5d, 0x86, 0x7f, 0x96, 0xa0, 0xc9, 0x77, 0xa0, 0xc9,
-	0xae, 0x4a, 0xea, 0xda, 0xfb, 0xe7, 0x76, 0xec, 0x75, 0x8f, 0x69, 0x1c, 0x61, 0x40, 0x3f, 0x27,
-	0x10, 0x8f, 0xcf, 0xf7, 0x04, 0x98, 0x45, 0xd1, 0x27, 0x41, 0xec, 0xf9, 0x87, 0xb6, 0x33, 0x8c,
-	0x8f, 0x82, 0xd0, 0x8b, 0xcf, 0x31, 0xc6, 0x9f, 0x13, 0xf0, 0x75, 0x09, 0x36, 0x1f, 0xc3, 0xe2,
-	0x33, 0x1a, 0x2b, 0xa1, 0x99, 0xdc, 0xfa, 0x6f, 0xeb, 0x29, 0x80, 0x12, 0x25, 0xaa, 0x31, 0x3d,
-	0xbb, 0xe9, 0xcd, 0x9f, 0xc1, 0x52, 0x96, 0x47, 0x12, 0x72, 0x68, 0x69, 0x11, 0x1b, 0x7f, 0x61,
-	0x4c, 0xa8, 0x8e, 0x30, 0xff, 0x6c, 0x2c, 0xcb, 0x3b, 0x71, 0xca, 0xab, 0x30, 0x1f, 0xc5, 0x4e,
-	0xc8, 0x97, 0xa9, 0x84, 0x23, 0x42, 0xc6, 0xa6, 0x44, 0xa5, 0xf1, 0xc8, 0x1a, 0x2c, 0x66, 0xe9,
-	0xd3, 0x28, 0xb7, 0x69, 0xcd, 0xeb, 0x23, 0x44, 0xc8, 0xfb, 0x1d, 0x68, 0x52, 0xdf, 0xcd, 0xcc,
-	0x50, 0x11, 0xbb, 0x20, 0x10, 0x29, 0xff, 0x55, 0x98, 0xd7, 0x69, 0x05, 0x77, 0x61, 0x6e, 0x4d,
-	0x95, 0x5a, 0xf0, 0xfe, 0x08, 0xae, 0xf5, 0x3d, 0xdf, 0xeb, 0x0f, 0xfb, 0x76, 0x48, 0xbb, 0x2c,
-	0x4c, 0xd2, 0xe2, 0x67, 0xe1, 0x47, 0x56, 0x90, 0xc4, 0xe2, 0x14, 0xea, 0x36, 0x98, 0xff, 0x60,
-	0xc0, 0x72, 0x6e, 0x6b, 0x70, 0xdf, 0x9f, 0x02, 0xe9, 0x7b, 0xfc, 0x1e, 0x56, 0x59, 0x8a, 0xed,
-	0x5f, 0x56, 0xb6, 0x5f, 0xcd, 0x05, 0xac, 0x26, 0x1f, 0xa2, 0xf2, 0x23, 0x1d, 0x58, 0x18, 0xfa,
-	0x05, 0x9c, 0xc6, 0x2e, 0x13, 0xdc, 0xcf, 0xe3, 0x50, 0x4d, 0xea, 0x05, 0x20, 0x42, 0x4b, 0x3b,
-	0xa1, 0x97, 0xd8, 0xb9, 0xd9, 0x81, 0x79, 0x0d, 0x9a, 0xfa, 0x14, 0xa1, 0xe9, 0xf6, 0x80, 0xc1,
-	0xd1, 0x26, 0x67, 0xe2, 0x94, 0xb4, 0x2c, 0x59, 0x31, 0x09, 0x34, 0xb8, 0x05, 0x6d, 0xf9, 0x07,
-	0x81, 0x9c, 0xe5, 0x9f, 0xc7, 0xa0, 0xa9, 0x00, 0x71, 0x92, 0x6b, 0x30, 0x3d, 0x08, 0x82, 0x9e,
-	0x1d, 0x79, 0x5f, 0x50, 0x74, 0x2f, 0x55, 0x06, 0xd8, 0xf5, 0xbe, 0xa0, 0xec, 0x6a, 0x70, 0x7a,
-	0x3d, 0xbb, 0x4f, 0xfb, 0x9c, 0x26, 0xf6, 0xce, 0xf0, 0xf2, 0xa8, 0x39, 0xbd, 0xde, 0x4b, 0x01,
-	0xdd, 0xf3, 0xce, 0x18, 0x5d, 0x70, 0xea, 0x6b, 0x74, 0xa2, 0x4e, 0x51, 0x0b, 0x4e, 0x7d, 0x85,
-	0x8e, 0x25, 0x94, 0x68, 0xe0, 0x18, 0x5d, 0x26, 0xdf, 0x2c, 0x17, 0xeb, 0x79, 0x27, 0x14, 0xe3,
-	0x48, 0xfe, 0x37, 0x73, 0x47, 0x27, 0x41, 0x4c, 0x5d, 0x0c, 0x17, 0xc5, 0x07, 0x5b, 0x74, 0xdf,
-	0x8b, 0x22, 0xea, 0xf2, 0xa4, 0xbc, 0x66, 0xe1, 0x17, 0xbb, 0xe2, 0x42, 0x7a, 0x12, 0x1c, 0x53,
-	0xb7, 0x55, 0x15, 0x57, 0x1c, 0x7e, 0x32, 0x0c, 0x3d, 0x1b, 0x30, 0x17, 0xd8, 0x9a, 0x16, 0x18,
-	0xfc, 0x4c, 0xc3, 0xe3, 0x68, 0xb8, 0x1f, 0x79, 0xee, 0x79, 0x0b, 0x94, 0xf0, 0x78, 0x57, 0xc0,
-	0xcc, 0x3d, 0x68, 0x70, 0x55, 0x51, 0x76, 0x93, 0x5d, 0xd5, 0x39, 0xb3, 0x9b, 0xde, 0x4f, 0xcc,
-	0x81, 0xc5, 0x90, 0x59, 0x2b, 0x63, 0x31, 0x64, 0x6a, 0x01, 0xe6, 0x7f, 0x19, 0xd0, 0x54, 0xd8,
-	0xe2, 0x79, 0x7c, 0x63, 0xbe, 0xe4, 0x2e, 0xd4, 0xf4, 0x5b, 0x40, 0xa4, 0x1c, 0x3a, 0x50, 0x4f,
-	0x67, 0xc7, 0xb3, 0xe9, 0xac, 0x32, 0x8d, 0xe3, 0xd2, 0x90, 0x1f, 0xca, 0x6c, 0x32, 0x0d, 0x03,
-	0xb1, 0x80, 0x57, 0x38, 0x71, 0xcf, 0x3f, 0x71, 0x7a, 0x9e, 0xeb, 0xc8, 0x73, 0xaa, 0x5a, 0x8d,
-	0x48, 0xa8, 0x59, 0x02, 0x37, 0x7f, 0x6b, 0xc0, 0xf2, 0xc6, 0x91, 0xe3, 0x1f, 0xd2, 0x4e, 0x72,
-	0x8f, 0xcb, 0x9d, 0xfc, 0x00, 0x2a, 0x2c, 0xda, 0x31, 0x78, 0x14, 0xf8, 0xb6, 0x62, 0x54, 0x25,
-	0x03, 0x56, 0x59, 0x0c, 0xc1, 0x86, 0xb0, 0xfb, 0x31, 0xe8, 0xb9, 0xb6, 0x12, 0x2c, 0x88, 0x80,
-	0xa0, 0x16, 0xf4, 0xdc, 0x74, 0x18, 0x23, 0x63, 0x49, 0x81, 0x42, 0x26, 0x7c, 0x58, 0xcd, 0xa7,
-	0xa7, 0x29, 0x99, 0xf9, 0x16, 0x54, 0x9e, 0xd3, 0x73, 0x32, 0x03, 0x53, 0x1d, 0x6b, 0xeb, 0xf5,
-	0xfa, 0xde, 0x66, 0xe3, 0x5b, 0x04, 0x60, 0xb2, 0xf3, 0xea, 0xf1, 0x8b, 0xad, 0x8d, 0x86, 0xc1,
-	0x42, 0x99, 0xbc, 0x44, 0x18, 0xca, 0x7c, 0x39, 0x06, 0x4b, 0x4f, 0x87, 0xbe, 0x5b, 0x70, 0x93,
-	0x8c, 0x4e, 0xe2, 0x9d, 0xf0, 0x90, 0xc6, 0xb2, 0x80, 0x23, 0x93, 0x78, 0x0e, 0x14, 0xe5, 0x9b,
-	0x11, 0x97, 0x7b, 0x65, 0xc4, 0xe5, 0x4e, 0x3e, 0x84, 0xb6, 0xe7, 0x77, 0x7b, 0x43, 0x97, 0xda,
-	0xc9, 0x9d, 0xdb, 0x0d, 0x3c, 0x7f, 0xdf, 0x89, 0x68, 0x84, 0x01, 0x5c, 0x0b, 0x29, 0xb6, 0x90,
-	0x60, 0x43, 0xe2, 0xd9, 0x65, 0x21, 0x47, 0x77, 0xf9, 0x92, 0x65, 0xc9, 0x46, 0xc4, 0x45, 0xf3,
-	0x88, 0x14, 0xdb, 0x81, 0x95, 0x9b, 0x7f, 0xaa, 0xc0, 0x72, 0x6e, 0x0b, 0x50, 0xa9, 0x7f, 0x17,
-	0x1a, 0x11, 0xed, 0xd1, 0x2e, 0xcb, 0x01, 0x45, 0xb9, 0x47, 0xe6, 0xe0, 0xef, 0x29, 0xe7, 0x5d,
-	0x32, 0x7a, 0xb5, 0x83, 0x05, 0x2d, 0x2c, 0xeb, 0xcd, 0x49, 0x56, 0xe2, 0x3b, 0xe2, 0x7e, 0x92,
-	0xdb, 0xb0, 0xb6, 0x8d, 0x33, 0x1c, 0x86, 0xbb, 0xf8, 0x00, 0x1a, 0xb8, 0x90, 0xc1, 0xb1, 0x5c,
-	0x8b, 0x50, 0x82, 0xba, 0x80, 0x77, 0x8e, 0xc5, 0x32, 0xda, 0xff, 0x6d, 0x40, 0x5d, 0x9f, 0xf0,
-	0x0a, 0xb1, 0x00, 0x13, 0x05, 0x6b, 0x5c, 0xa2, 0xd0, 0x26, 0xbc, 0xe5, 0x8c, 0x80, 0x6d, 0xf1,
-	0x72, 0x5b, 0x5a, 0x38, 0xab, 0x68, 0x85, 0x33, 0xe6, 0x88, 0x13, 0xd9, 0xc6, 0x39, 0xfb, 0xea,
-	0x00, 0xa5, 0x62, 0x7c, 0xd9, 0x2d, 0xe9, 0x9d, 0x50, 0x9b, 0x19, 0x29, 0x46, 0x3e, 0x33, 0x08,
-	0xdb, 0xf3, 0x44, 0xa2, 0xcf, 0x02, 0xdc, 0xe4, 0x94, 0xd1, 0x16, 0x67, 0x19, 0x50, 0x9e, 0x2c,
-	0x73, 0xb2, 0x71, 0x48, 0x45, 0x35, 0x73, 0xc2, 0xe2, 0x7f, 0x9b, 0x5f, 0x4e, 0xc2, 0xb5, 0x8d,
-	0xc0, 0x8f, 0xe2, 0x70, 0xd8, 0x2d, 0x0a, 0x85, 0xee, 0x41, 0x3d, 0x0a, 0x86, 0x61, 0x97, 0xda,
-	0xba, 0x1e, 0xd7, 0x04, 0x54, 0x96, 0x2c, 0xbe, 0x5e, 0x14, 0x4a, 0xae, 0x03, 0x1c, 0x50, 0x6a,
-	0x0f, 0x68, 0x68, 0x1f, 0xef, 0xa3, 0x4e, 0x57, 0x0f, 0x28, 0xed, 0xd0, 0xf0, 0xf9, 0x3e, 0xf9,
-	0x03, 0x68, 0xcb, 0x9a, 0x21, 0x3f, 0x74, 0xb6, 0xff, 0x4e, 0xef, 0x90, 0x05, 0x6f, 0x47, 0x22,
-	0x96, 0xaf, 0xaf, 0x7d, 0xac, 0xba, 0x8c, 0xf2, 0x75, 0x60, 0x55, 0x78, 0x57, 0xf2, 0x59, 0x97,
-	0x6c, 0xac, 0x56, 0x50, 0x82, 0x21, 0x9f, 0x01, 0xf1, 0x03, 0x5f, 0xda, 0x80, 0xd4, 0xdc, 0x09,
-	0xae, 0xb9, 0x0f, 0xaf, 0x34, 0xad, 0xd5, 0xf0, 0x03, 0x5f, 0xd8, 0x8b, 0x54, 0xdb, 0x43, 0x20,
-	0xc8, 0xd8, 0xa5, 0x51, 0xec, 0xf9, 0x22, 0x0e, 0x9e, 0xe4, 0x51, 0xca, 0x07, 0x57, 0x62, 0xfe,
-	0x24, 0x1d, 0x6f, 0x35, 0x05, 0x4f, 0x05, 0xd4, 0xee, 0x41, 0x33, 0x47, 0x37, 0x22, 0x09, 0x2d,
-	0x4b, 0xaf, 0x98, 0x1e, 0xf0, 0xbf, 0x6c, 0x6c, 0x58, 0xc8, 0x3b, 0x5e, 0x40, 0xb1, 0xdd, 0xd1,
-	0xfe, 0xfd, 0xa4, 0xdc, 0xfc, 0x73, 0x98, 0x51, 0x57, 0x66, 0x7c, 0xc3, 0x95, 0xa9, 0xcc, 0x14,
-	0x2b, 0x1a, 0x53, 0xad, 0xc8, 0x7c, 0x1f, 0x5a, 0x65, 0xe7, 0x4c, 0xe6, 0x60, 0x46, 0xcf, 0xf0,
-	0xa7, 0xa0, 0xb2, 0xfe, 0xe2, 0x45, 0xc3, 0x30, 0xff, 0xd7, 0x80, 0xeb, 0xc5, 0xc2, 0xa0, 0x03,
-	0x7b, 0x8f, 0x45, 0x82, 0x91, 0x77, 0x98, 0x09, 0x05, 0xd1, 0x0d, 0xcc, 0x4b, 0x9c, 0x32, 0x94,
-	0x7c, 0x0c, 0xd7, 0x85, 0x57, 0x4a, 0xca, 0xf4, 0xa8, 0xc9, 0x9a, 0xdc, 0x2b, 0x9c, 0x46, 0x77,
-	0x38, 0xe8, 0xb3, 0x56, 0x61, 0x5e, 0x30, 0xd0, 0xc7, 0x09, 0xaf, 0xd1, 0xe4, 0x28, 0x8d, 0x7e,
-	0x0d, 0x16, 0xd9, 0x06, 0xf5, 0xd9, 0x85, 0x6b, 0xa3, 0xac, 0x3c, 0xaa, 0x13, 0x91, 0xd6, 0x7c,
-	0x82, 0xdc, 0xe5, 0x38, 0x16, 0xe0, 0x99, 0x7f, 0x6a, 0xc0, 0x12, 0xfb, 0x2c, 0x30, 0xfb, 0x8b,
-	0xb2, 0xf0, 0xef, 0xc3, 0x52, 0x44, 0x43, 0xcf, 0xe9, 0x79, 0x5f, 0x64, 0x36, 0x45, 0xa8, 0xcd,
-	0x62, 0x8a, 0x55, 0xb7, 0xe5, 0x0e, 0xd4, 0x3c, 0x3f, 0x71, 0x90, 0x54, 0xf4, 0x83, 0x6a, 0xd6,
-	0x2c, 0x07, 0x6e, 0x09, 0x98, 0xf9, 0x39, 0x2c, 0xe7, 0xa4, 0xc2, 0x93, 0xb8, 0x95, 0xcf, 0xa9,
-	0x32, 0xad, 0xa6, 0xf7, 0x61, 0x29, 0x39, 0x2b, 0x7d, 0xaa, 0x31, 0x3e, 0x55, 0x72, 0x92, 0x5b,
-	0xea, 0x94, 0x3f, 0x85, 0x95, 0xce, 0x70, 0xbf, 0xe7, 0x45, 0x47, 0x05, 0x7b, 0xf1, 0x10, 0x48,
-	0xe9, 0xe1, 0x37, 0x73, 0x47, 0x6f, 0x3e, 0x83, 0x76, 0x11, 0x2f, 0x5c, 0xc1, 0x15, 0x52, 0xcb,
-	0x2f, 0x2b, 0xb0, 0xd4, 0x19, 0x86, 0xdd, 0x23, 0x27, 0xa2, 0x98, 0xdd, 0x7e, 0xf3, 0x7a, 0xcf,
-	0x4d, 0x98, 0xe1, 0xc9, 0xbb, 0xdd, 0xf3, 0xfa, 0x9e, 0xd4, 0x27, 0xe0, 0xa0, 0x17, 0x0c, 0x32,
-	0xc2, 0x93, 0x0b, 0x4d, 0x2a, 0xf1, 0xe4, 0xf7, 0xa0, 0x8e, 0xe9, 0x8a, 0xde, 0x00, 0xaa, 0x09,
-	0xa8, 0x2c, 0x83, 0xdc, 0x84, 0x19, 0x7f, 0xd8, 0x4f, 0x72, 0x78, 0x11, 0xd9, 0x83, 0x3f, 0xec,
-	0xcb, 0xf4, 0xfd, 0x36, 0xcc, 0xf2, 0x2c, 0x42, 0x72, 0x99, 0xc2, 0x52, 0x4a, 0x10, 0xf4, 0x24,
-	0x0f, 0x99, 0xb4, 0x1c, 0x50, 0x1a, 0xf1, 0x58, 0xdf, 0x10, 0x49, 0xcb, 0x53, 0x4a, 0xb9, 0xff,
-	0xe2, 0xd1, 0xfd, 0x39, 0xc6, 0xfa, 0xf8, 0x45, 0x16, 0x61, 0x32, 0x3e, 0x63, 0x43, 0x30, 0xc6,
-	0x9f, 0x88, 0xcf, 0x9e, 0x52, 0x1e, 0x70, 0xa3, 0xd8, 0x0c, 0x35, 0x23, 0x23, 0x61, 0x06, 0x79,
-	0x4a, 0xa9, 0xf9, 0x11, 0x2c, 0xe7, 0x4e, 0x00, 0x0f, 0x92, 0xc5, 0x6f, 0x62, 0x24, 0x3b, 0x43,
-	0x2a, 0x42, 0x9a, 0x59, 0x0b, 0x93, 0xb6, 0x4f, 0x38, 0xcc, 0xfc, 0x01, 0x2c, 0x58, 0x3c, 0x0b,
-	0xb9, 0xda, 0xf9, 0x89, 0x72, 0xb5, 0x36, 0x0e, 0x43, 0xcd, 0xb7, 0xe0, 0xfa, 0x8b, 0xc0, 0x71,
-	0xd7, 0x79, 0xff, 0xe5, 0x89, 0x13, 0x3b, 0x4f, 0xbd, 0x5e, 0x4c, 0xc3, 0xa4, 0xf9, 0x71, 0x13,
-	0x6e, 0x94, 0xe0, 0x91, 0xc1, 0x6d, 0xb8, 0xa9, 0xa8, 0xe5, 0x76, 0x10, 0x7b, 0x07, 0x5e, 0xd7,
-	0x51, 0x8b, 0x0b, 0xe6, 0xaf, 0xc7, 0xe0, 0x56, 0x39, 0x0d, 0x2e, 0xff, 0x27, 0x30, 0xe7, 0xc4,
-	0xb1, 0xd3, 0x3d, 0xa2, 0xae, 0xc8, 0xf9, 0x65, 0x4c, 0x57, 0x9a, 0x62, 0xd7, 0x25, 0x3d, 0x87,
-	0x46, 0xe4, 0x3e, 0xcc, 0xb9, 0x54, 0xe7, 0x30, 0xc6, 0xb7, 0xb0, 0x2e, 0xc1, 0x48, 0x58, 0x96,
-	0x88, 0x57, 0xbe, 0x6e, 0x22, 0xce, 0xe2, 0xe3, 0x02, 0x8e, 0xf2, 0x20, 0xc7, 0xb9, 0x14, 0xad,
-	0xfc, 0x40, 0x3c, 0xd4, 0x1b, 0x70, 0x4d, 0xf6, 0xac, 0x8a, 0xb6, 0xef, 0x7f, 0x0c, 0xb8, 0x5e,
-	0x8c, 0xbf, 0x52, 0x3d, 0xfe, 0x32, 0xed, 0x9d, 0xe2, 0xce, 0x4d, 0xe5, 0x4a, 0x9d, 0x9b, 0xf1,
-	0x2b, 0x75, 0x6e, 0x26, 0x4a, 0x3a, 0x37, 0xbf, 0x80, 0x5b, 0xaa, 0x3f, 0x28, 0xda, 0x18, 0x66,
-	0xb7, 0xf1, 0x99, 0x6e, 0x2d, 0xd5, 0xf8, 0x4c, 0x6c, 0x2a, 0x33, 0xc4, 0x28, 0x0e, 0x06, 0xb6,
-	0x73, 0x10, 0xd3, 0x10, 0x83, 0xc6, 0x69, 0x06, 0x59, 0x67, 0x00, 0xf3, 0x6f, 0xc6, 0xe0, 0xf6,
-	0x88, 0x09, 0x70, 0x67, 0x8f, 0xb3, 0xc9, 0xaf, 0x50, 0xc9, 0x4d, 0x3d, 0xea, 0x18, 0xcd, 0x44,
-	0x55, 0x22, 0xcd, 0xc5, 0x65, 0x72, 0xe8, 0xf6, 0xaf, 0x0c, 0x68, 0x95, 0xd1, 0x92, 0x65, 0x98,
-	0xc2, 0xb5, 0xa2, 0x75, 0x4f, 0x8a, 0x95, 0xe6, 0xf3, 0xf3, 0xb1, 0xa2, 0xfc, 0x5c, 0xaf, 0x03,
-	0x54, 0x2e, 0xaa, 0x03, 0x8c, 0xe7, 0xeb, 0x0b, 0x7f, 0x64, 0xc0, 0xfc, 0x46, 0x48, 0x9d, 0x98,
-	0xbe, 0xe1, 0x6b, 0x97, 0x87, 0xf0, 0x0e, 0x34, 0xb1, 0xc9, 0x90, 0x73, 0x40, 0x0d, 0x81, 0x50,
-	0x72, 0xe7, 0x87, 0x40, 0x64, 0x73, 0x20, 0x97, 0x66, 0x37, 0x11, 0xa3, 0x90, 0x13, 0x18, 0x8f,
-	0x28, 0x75, 0x51, 0x5e, 0xfe, 0xb7, 0xb9, 0x04, 0x0b, 0xba, 0x18, 0xe8, 0x87, 0x7e, 0x02, 0xcd,
-	0x9d, 0x01, 0xf5, 0xbf, 0xbe, 0x70, 0xe6, 0x02, 0x10, 0x95, 0x03, 0xf2, 0x5d, 0x00, 0xb2, 0xd1,
-	0x0b, 0x22, 0x7d, 0xd5, 0xe6, 0x22, 0xcc, 0x6b, 0x50, 0x24, 0x5e, 0x84, 0x79, 0x01, 0xd9, 0x3c,
-	0xf3, 0xa2, 0xb4, 0x83, 0xbc, 0x0a, 0x0b, 0x3a, 0x18, 0xd5, 0x8b, 0xdf, 0x2d, 0x0c, 0xc2, 0x65,
-	0xaa, 0x5a, 0xf8, 0x65, 0xfe, 0xda, 0x80, 0xd6, 0x6e, 0xec, 0x84, 0x31, 0x8b, 0x22, 0xa9, 0x1f,
-	0x0d, 0x23, 0x6b, 0xd0, 0x95, 0x6b, 0xba, 0x0f, 0x73, 0xd8, 0x3c, 0xcf, 0xb4, 0x07, 0xea, 0x08,
-	0x96, 0xd7, 0x5a, 0x1b, 0xaa, 0xc3, 0x88, 0xd9, 0x60, 0x62, 0xeb, 0xc9, 0x37, 0xc3, 0xb1, 0x1d,
-	0x39, 0x0d, 0x42, 0xb9, 0xbb, 0xc9, 0x37, 0x8b, 0x89, 0xba, 0x34, 0x44, 0x4d, 0xa6, 0x98, 0x3c,
-	0xaa, 0x20, 0xf3, 0x1a, 0xac, 0x14, 0x88, 0x87, 0x7b, 0x70, 0x02, 0xad, 0x27, 0x5e, 0xd4, 0x0d,
-	0x4e, 0x68, 0x88, 0x92, 0xd0, 0x48, 0x39, 0x0f, 0x17, 0x71, 0xb6, 0xd2, 0x3e, 0xe7, 0x55, 0x1e,
-	0x89, 0x90, 0xbd, 0xf3, 0x2b, 0x2a, 0x0b, 0x13, 0xaa, 0x60, 0x5e, 0x14, 0xea, 0x6d, 0xb8, 0xbb,
-	0x3b, 0xdc, 0x67, 0xa9, 0xc5, 0x3e, 0xdd, 0x0b, 0xf8, 0x3d, 0x50, 0xe8, 0x6b, 0xef, 0xc3, 0xbd,
-	0x0b, 0xe8, 0xd2, 0x93, 0x7e, 0x4a, 0xe3, 0xee, 0x91, 0x28, 0x5f, 0x25, 0xe3, 0xff, 0x72, 0x0c,
-	0x16, 0x74, 0x38, 0x1e, 0xf5, 0x1a, 0x2c, 0x1e, 0x30, 0x38, 0x75, 0xb1, 0x08, 0x16, 0xd9, 0x6a,
-	0xf6, 0x3b, 0x8f, 0x48, 0x1c, 0x26, 0x3c, 0xe6, 0x77, 0x61, 0xe1, 0xc0, 0x0b, 0xa3, 0xd8, 0xf6,
-	0xe9, 0x69, 0xfe, 0x91, 0x40, 0x93, 0xe3, 0xb6, 0xe9, 0x69, 0x5a, 0x35, 0xff, 0x1e, 0x2c, 0xe5,
-	0x06, 0xa8, 0xef, 0x04, 0xe6, 0xf5, 0x21, 0xa2, 0xc0, 0xf7, 0x01, 0xac, 0xf4, 0x1d, 0x8f, 0xa7,
-	0xa5, 0x9e, 0x6f, 0xc7, 0xde, 0x40, 0x9d, 0x4a, 0x1c, 0xfe, 0x22, 0x23, 0xd8, 0x60, 0xf8, 0x3d,
-	0x6f, 0x90, 0x4e, 0xf7, 0x21, 0x5c, 0x2b, 0x1e, 0x29, 0xe6, 0x14, 0x45, 0xf7, 0xe5, 0xfc, 0x58,
-	0xe1, 0x50, 0x3e, 0x84, 0x15, 0xec, 0xc8, 0x50, 0xcb, 0xf1, 0xdd, 0xa0, 0xbf, 0x4b, 0xa9, 0x2b,
-	0x15, 0x85, 0x45, 0x95, 0x94, 0xba, 0x76, 0x8f, 0xfa, 0x87, 0xf1, 0x11, 0x6e, 0x12, 0x30, 0xd0,
-	0x0b, 0x0e, 0x31, 0x7f, 0x0f, 0xda, 0x45, 0xa3, 0xd3, 0xb2, 0x27, 0x1f, 0xbe, 0x7f, 0x1e, 0xd3,
-	0x48, 0x96, 0x3d, 0x19, 0xe4, 0x31, 0x03, 0x90, 0x15, 0xa8, 0x72, 0xf4, 0x11, 0xd6, 0x54, 0xa6,
-	0xad, 0x29, 0xf6, 0xfd, 0x09, 0x3d, 0x63, 0x51, 0x18, 0x47, 0xf5, 0x7d, 0xda, 0x0f, 0x7c, 0xaf,
-	0x8b, 0xcd, 0xcb, 0x59, 0x06, 0x7c, 0x89, 0x30, 0x73, 0x0d, 0x9a, 0x4f, 0x68, 0x37, 0x70, 0xa9,
-	0x2a, 0xf2, 0x0d, 0x00, 0x66, 0x5e, 0x22, 0x49, 0x40, 0x93, 0x9c, 0x66, 0x10, 0x9e, 0x18, 0x98,
-	0x3f, 0x04, 0xa2, 0x8e, 0x49, 0x8b, 0xf2, 0x2e, 0x87, 0xba, 0x36, 0xf7, 0x74, 0x98, 0x80, 0x20,
-	0x8c, 0x91, 0x9a, 0x7f, 0x5c, 0x81, 0x45, 0x6e, 0x6d, 0xeb, 0xc3, 0x38, 0x78, 0x3c, 0x3c, 0x4f,
-	0x9f, 0xc3, 0x7c, 0xfd, 0xa0, 0x7d, 0x15, 0xe6, 0xf1, 0x01, 0x87, 0x1d, 0x07, 0x36, 0x3b, 0xa1,
-	0xd8, 0xf1, 0x7c, 0x99, 0x0c, 0x22, 0x6a, 0x2f, 0x78, 0x89, 0x08, 0x72, 0x07, 0xea, 0x7d, 0x87,
-	0x47, 0xbb, 0xb2, 0xb4, 0x22, 0x6a, 0xbc, 0x33, 0x7d, 0x87, 0x45, 0xbd, 0xa2, 0xba, 0xf2, 0x2e,
-	0x10, 0x46, 0xc4, 0xbb, 0x0b, 0x76, 0x48, 0x7b, 0x4e, 0x2c, 0x0b, 0xf0, 0x86, 0xd5, 0xe8, 0x3b,
-	0x67, 0xd8, 0x8e, 0x10, 0x70, 0x9d, 0xda, 0xd9, 0x8f, 0x82, 0xde, 0x30, 0xa6, 0xd8, 0x58, 0x4b,
-	0xa8, 0xd7, 0x11, 0xce, 0x5f, 0x3b, 0x62, 0x13, 0x4e, 0x8b, 0xe3, 0x6b, 0xd8, 0x82, 0x43, 0x97,
-	0x97, 0x0d, 0xf6, 0xab, 0x17, 0x04, 0xfb, 0xd3, 0x99, 0x60, 0xdf, 0x84, 0x1a, 0x17, 0x8a, 0x86,
-	0x42, 0x95, 0x31, 0xb6, 0x67, 0xcb, 0xec, 0xd0, 0x90, 0x6b, 0xaf, 0xd9, 0x82, 0xa5, 0xec, 0x71,
-	0xa0, 0x4f, 0x58, 0x82, 0x85, 0x5d, 0x16, 0x60, 0x64, 0xce, 0x89, 0x05, 0xdf, 0x19, 0x38, 0x0e,
-	0x68, 0x43, 0x4b, 0xc4, 0xe3, 0x1c, 0xcc, 0x2f, 0xfc, 0xe4, 0x7d, 0xd5, 0x9f, 0x4c, 0xc2, 0x4a,
-	0x01, 0x52, 0x69, 0xfa, 0x17, 0x97, 0x81, 0xef, 0x42, 0xdd, 0x39, 0x39, 0xc4, 0x7d, 0xed, 0x07,
-	0xae, 0xf4, 0xfd, 0xb3, 0xce, 0xc9, 0x21, 0xdf, 0xd3, 0x97, 0x81, 0x4b, 0x99, 0x02, 0x24, 0x54,
-	0xaf, 0xdf, 0xac, 0x77, 0x6c, 0x97, 0xf6, 0x62, 0x47, 0x2a, 0x80, 0x24, 0x65, 0x98, 0x27, 0x0c,
-	0x51, 0xa6, 0x30, 0xe3, 0x65, 0x0a, 0x63, 0x42, 0x4d, 0x84, 0xe0, 0x8c, 0xdc, 0x39, 0x39, 0x94,
-	0x25, 0x46, 0x01, 0xdc, 0x0b, 0xd6, 0x4f, 0x0e, 0xc9, 0x7b, 0xb0, 0xe8, 0x06, 0x7e, 0x6c, 0x9f,
-	0x3a, 0x5e, 0x6c, 0x1f, 0x04, 0xa1, 0x96, 0xc4, 0x55, 0x2d, 0xc2, 0x90, 0x6f, 0x1c, 0x2f, 0x7e,
-	0x1a, 0x84, 0x4a, 0x32, 0x27, 0xd2, 0x2f, 0x94, 0x77, 0x4a, 0x70, 0x15, 0x30, 0x21, 0xe9, 0x0d,
-	0x51, 0x01, 0x14, 0xd5, 0x44, 0x54, 0x80, 0xe9, 0x03, 0x4a, 0x77, 0x39, 0x80, 0xa9, 0x1d, 0x43,
-	0x63, 0xa5, 0x3c, 0xea, 0x3a, 0x3d, 0xcf, 0x3f, 0x44, 0x3d, 0x68, 0x1c, 0x50, 0xba, 0xc7, 0x11,
-	0xbb, 0x02, 0xce, 0xa2, 0xae, 0xbe, 0xe7, 0x2b, 0x59, 0xde, 0x64, 0xdf, 0xf3, 0x59, 0x9a, 0xc7,
-	0x10, 0xc2, 0x20, 0x5a, 0xb3, 0x88, 0xe0, 0x96, 0x90, 0xd7, 0xa0, 0x5a, 0x4e, 0x83, 0x4a, 0x54,
-	0xbf, 0x5e, 0xa2, 0xfa, 0xc5, 0x66, 0x35, 0x57, 0x62, 0x56, 0x77, 0x85, 0xa5, 0x7a, 0x49, 0xfb,
-	0xac, 0xd5, 0x14, 0x6d, 0x80, 0xbe, 0x73, 0xb6, 0x25, 0x9b, 0x67, 0x39, 0x3b, 0x21, 0x17, 0xd8,
-	0xc9, 0x7c, 0xc6, 0x4e, 0x7e, 0x00, 0xcb, 0xd1, 0x20, 0xa4, 0x8e, 0x6b, 0xcb, 0x96, 0x22, 0x26,
-	0xb5, 0x51, 0x6b, 0x81, 0x1f, 0xde, 0xa2, 0x40, 0x63, 0x1f, 0x52, 0x22, 0x0b, 0xcc, 0x78, 0xb1,
-	0xc8, 0x8c, 0xd3, 0xdc, 0x7a, 0x49, 0xc9, 0xad, 0xcd, 0x87, 0xd0, 0xdc, 0xa5, 0xd9, 0x67, 0x4e,
-	0xa5, 0x96, 0xc0, 0x22, 0x37, 0x95, 0x1c, 0x6d, 0xee, 0x25, 0x5c, 0xdb, 0xa5, 0xf2, 0x4d, 0x64,
-	0xaa, 0xb1, 0x4a, 0x23, 0xbc, 0x48, 0xd1, 0x8d, 0x12, 0x45, 0x67, 0xf9, 0x73, 0x31, 0x3b, 0x9c,
-	0xee, 0x87, 0xd0, 0xd8, 0xa5, 0xf1, 0x4b, 0xae, 0x1c, 0x72, 0x8e, 0xbc, 0x37, 0x35, 0x72, 0xde,
-	0xd4, 0x9c, 0xe7, 0x8b, 0x95, 0x03, 0x91, 0xdb, 0x4f, 0xa1, 0x2d, 0x80, 0xda, 0xa1, 0x4b, 0xbe,
-	0xc5, 0x9a, 0x62, 0x14, 0x6b, 0x0a, 0xcb, 0x3a, 0x0b, 0x79, 0x15, 0x4e, 0x25, 0xb5, 0xb1, 0x70,
-	0xaa, 0x44, 0x85, 0x8d, 0x62, 0x15, 0xce, 0x4c, 0x95, 0xf2, 0x4a, 0x42, 0xf7, 0xe5, 0x5d, 0x1a,
-	0xbf, 0x56, 0x55, 0x40, 0xe9, 0x16, 0x64, 0x14, 0xc6, 0x28, 0x50, 0x18, 0xe6, 0x48, 0xf3, 0x1c,
-	0x90, 0xfb, 0x8f, 0x60, 0x71, 0x97, 0xc6, 0x9d, 0x54, 0xb5, 0x95, 0x87, 0x79, 0x9a, 0x11, 0x18,
-	0x39, 0x23, 0xe0, 0xbe, 0x3e, 0x33, 0x16, 0xb9, 0xbe, 0xc7, 0x95, 0xab, 0x83, 0x06, 0xa1, 0x64,
-	0xa4, 0xa9, 0xd1, 0x18, 0xba, 0xd1, 0xb0, 0x90, 0x51, 0x1b, 0x82, 0x9c, 0x7e, 0xcc, 0xe5, 0x7b,
-	0x99, 0xfa, 0x07, 0xc9, 0x2c, 0xe7, 0x4a, 0x8c, 0xe2, 0xcb, 0x28, 0x33, 0x38, 0x7d, 0x8f, 0xbb,
-	0x7e, 0x48, 0x7d, 0xd7, 0x49, 0x62, 0xd3, 0xdf, 0x54, 0x60, 0x2e, 0x01, 0xa5, 0xf7, 0x88, 0x2c,
-	0xbf, 0xa3, 0xf5, 0xe0, 0x27, 0xf9, 0x31, 0x4c, 0x39, 0x82, 0x18, 0x1f, 0x28, 0xdc, 0x56, 0xdf,
-	0xb7, 0xea, 0x6c, 0xf0, 0xdb, 0x92, 0x23, 0xda, 0xff, 0x66, 0xc0, 0xa4, 0x80, 0x91, 0x3a, 0x8c,
-	0x79, 0x2e, 0xee, 0xed, 0x98, 0xc7, 0xb3, 0x0b, 0x97, 0x8a, 0x1a, 0xbf, 0xac, 0xee, 0x4e, 0x5b,
-	0x2a, 0x88, 0x65, 0x7d, 0x7d, 0x27, 0x3a, 0xc6, 0xb2, 0x03, 0xff, 0x9b, 0x49, 0xd3, 0x3d, 0x0a,
-	0xbc, 0x2e, 0x95, 0x6f, 0xa1, 0x47, 0x49, 0xb3, 0xc1, 0x29, 0x2d, 0x39, 0x42, 0x94, 0x02, 0x9c,
-	0x30, 0x56, 0x9b, 0x5d, 0xd3, 0x1c, 0xc2, 0x5b, 0x5d, 0x37, 0x41, 0x5c, 0x20, 0xd8, 0x0c, 0x13,
-	0x21, 0x08, 0x08, 0x10, 0x23, 0x68, 0x7f, 0x65, 0xc0, 0xa4, 0xe0, 0xf9, 0xf5, 0x56, 0x83, 0x3f,
-	0x54, 0xe0, 0xab, 0xe1, 0xbf, 0x40, 0xb8, 0x01, 0xe0, 0x45, 0xcc, 0x6c, 0x92, 0x4b, 0xb4, 0x6a,
-	0x4d, 0x7b, 0xd1, 0xba, 0x00, 0x90, 0x79, 0x98, 0xf0, 0x22, 0xdb, 0x0f, 0xb0, 0x3f, 0x3a, 0xee,
-	0x45, 0xdb, 0x01, 0xf3, 0x66, 0xaf, 0x83, 0x98, 0x0a, 0x39, 0x92, 0x33, 0xfd, 0xdb, 0x31, 0x98,
-	0xd7, 0xc0, 0x17, 0x9e, 0xeb, 0xc7, 0xe9, 0x4e, 0x8a, 0x73, 0xbd, 0xa7, 0xec, 0x64, 0x01, 0xab,
-	0xdc, 0x6e, 0xb6, 0xa1, 0x7a, 0x12, 0xc4, 0x54, 0x59, 0x54, 0xf2, 0xdd, 0xfe, 0x8b, 0x74, 0xa7,
-	0xae, 0xc1, 0xb4, 0xd0, 0x06, 0x3b, 0xd9, 0xb0, 0xaa, 0x00, 0x6c, 0xb9, 0x2c, 0xb5, 0x43, 0x64,
-	0x7e, 0xf7, 0x9a, 0x02, 0xf3, 0x44, 0xd9, 0xc3, 0x6b, 0x30, 0x2d, 0x66, 0x67, 0xbc, 0x44, 0x40,
-	0x5e, 0x15, 0x00, 0xc1, 0x0b, 0x91, 0x2a, 0xaf, 0x71, 0xc1, 0x4b, 0x60, 0x14, 0x5e, 0xe6, 0x9f,
-	0x1b, 0xdc, 0xde, 0xf2, 0x7b, 0x49, 0xd6, 0xd3, 0x9d, 0x11, 0x65, 0x1e, 0xf5, 0x0d, 0x69, 0xe1,
-	0x90, 0xec, 0xde, 0xb4, 0x1f, 0x5f, 0x6e, 0xf9, 0xda, 0x7a, 0xc6, 0xf4, 0xf5, 0x98, 0xef, 0x73,
-	0x93, 0x2e, 0x3a, 0x54, 0x75, 0xe7, 0x0d, 0x7d, 0xe7, 0xd7, 0xac, 0xe4, 0x47, 0x43, 0xbb, 0x34,
-	0x3c, 0x61, 0x12, 0xfc, 0x04, 0xa6, 0x10, 0x42, 0x56, 0xd4, 0x23, 0xd6, 0x7e, 0x5a, 0xd4, 0x6e,
-	0x17, 0xa1, 0xc4, 0x7c, 0x6b, 0x7f, 0x45, 0xa0, 0x26, 0xea, 0x16, 0x92, 0xe7, 0x0f, 0x61, 0xbc,
-	0xc3, 0xe2, 0xa2, 0x25, 0x65, 0x94, 0xf2, 0xa3, 0x80, 0xf6, 0x72, 0x0e, 0x9e, 0x54, 0x77, 0xa7,
-	0xf0, 0x71, 0xbf, 0x26, 0x8c, 0xfe, 0x8b, 0x01, 0x4d, 0x98, 0xec, 0x4f, 0x07, 0x2c, 0xa8, 0x69,
-	0x0f, 0xfb, 0xc9, 0xcd, 0xfc, 0x7b, 0x7b, 0xed, 0xd7, 0x02, 0xed, 0x5b, 0xe5, 0x04, 0xc8, 0x73,
-	0x03, 0xaa, 0x49, 0xb5, 0xa1, 0x5d, 0xf8, 0x7c, 0x5f, 0x70, 0xba, 0x36, 0xe2, 0x69, 0x3f, 0x5b,
-	0x9a, 0x7c, 0xf8, 0xae, 0x2e, 0x4d, 0x7f, 0x7c, 0xa9, 0x2d, 0x2d, 0xfb, 0x4c, 0xf2, 0x15, 0xd4,
-	0xf5, 0xb7, 0x67, 0x44, 0x15, 0xbd, 0xf0, 0x45, 0x61, 0xfb, 0xf6, 0x08, 0x0a, 0x64, 0xfb, 0x73,
-	0x98, 0xcb, 0x3c, 0x69, 0x23, 0xe5, 0xa3, 0x92, 0xb5, 0x9a, 0xa3, 0x48, 0x04, 0xe7, 0x47, 0x06,
-	0x79, 0x01, 0x33, 0xca, 0x1b, 0x33, 0xa2, 0xd5, 0xcc, 0x73, 0x2f, 0xd2, 0xda, 0x6f, 0x95, 0xa1,
-	0x93, 0x17, 0x76, 0xd3, 0xc9, 0x53, 0x32, 0xa2, 0x6e, 0x76, 0xf6, 0xd5, 0x59, 0xfb, 0x7a, 0x31,
-	0x32, 0xe5, 0x93, 0x3c, 0x81, 0xd2, 0xf8, 0x64, 0xdf, 0x5b, 0x69, 0x7c, 0xf2, 0xaf, 0xa6, 0x86,
-	0x5a, 0x21, 0x56, 0x2b, 0x00, 0x91, 0xef, 0x14, 0xb7, 0x07, 0x8a, 0xaa, 0x49, 0xed, 0x77, 0x2e,
-	0x45, 0x9b, 0x6c, 0xaa, 0x97, 0xfe, 0xec, 0x45, 0x9b, 0xf2, 0xed, 0x02, 0x45, 0x2e, 0x9a, 0xee,
-	0xfe, 0x85, 0x74, 0xc9, 0x54, 0x5f, 0xc0, 0x4a, 0x69, 0xe1, 0x9a, 0xbc, 0x73, 0xb9, 0xf2, 0xb6,
-	0x98, 0xf4, 0xdd, 0xab, 0xd4, 0xc2, 0x1f, 0x18, 0x8f, 0x0c, 0xf2, 0x19, 0x34, 0xb2, 0x2f, 0x9f,
-	0x88, 0x79, 0xf1, 0x43, 0xad, 0xf6, 0x9d, 0x91, 0x34, 0xa9, 0x9b, 0xd0, 0x7e, 0x97, 0xa1, 0xb9,
-	0x89, 0xa2, 0xdf, 0x82, 0x68, 0x6e, 0xa2, 0xf0, 0x27, 0x1d, 0xe4, 0x63, 0x98, 0x14, 0x3f, 0xcf,
-	0x20, 0x2d, 0x8d, 0x56, 0xf9, 0x95, 0x47, 0x7b, 0xa5, 0x00, 0xa3, 0x5a, 0x8b, 0xf2, 0x3b, 0x0a,
-	0xcd, 0x5a, 0xf2, 0x3f, 0xdc, 0xd0, 0xac, 0xa5, 0xe8, 0xe7, 0x17, 0x92, 0x9b, 0x7c, 0xe5, 0x3f,
-	0xf2, 0x97, 0x0e, 0x79, 0x6e, 0x99, 0x9f, 0x0f, 0x7c, 0x06, 0x8d, 0xec, 0x93, 0x7a, 0xed, 0x34,
-	0x4a, 0x7e, 0x21, 0xa0, 0x9d, 0x46, 0xd9, 0x9b, 0x7c, 0xb2, 0x03, 0xb3, 0xea, 0xfb, 0x76, 0xf2,
-	0x56, 0x6e, 0x90, 0xf6, 0x56, 0xbf, 0x7d, 0xb3, 0x14, 0x8f, 0x0c, 0x3f, 0x85, 0xb9, 0xcc, 0xbb,
-	0x2e, 0xcd, 0xa7, 0x15, 0x3f, 0x9a, 0xd3, 0x7c, 0x5a, 0xd9, 0xa3, 0xb2, 0x43, 0x58, 0x28, 0x7a,
-	0xb3, 0xa1, 0x19, 0xdf, 0x88, 0x17, 0x26, 0x9a, 0xf1, 0x8d, 0x7c, 0xfc, 0xf1, 0x29, 0xcc, 0x65,
-	0x5e, 0x23, 0x68, 0x4b, 0x28, 0x7e, 0x3f, 0xa1, 0x2d, 0xa1, 0xec, 0x31, 0x83, 0x03, 0x24, 0xff,
-	0x50, 0x80, 0xa8, 0xbf, 0x6e, 0x2d, 0x7d, 0x93, 0xd0, 0xbe, 0x77, 0x01, 0x55, 0x2a, 0x7c, 0xa6,
-	0x7f, 0xad, 0x09, 0x5f, 0xfc, 0xba, 0x40, 0x13, 0xbe, 0xac, 0xfd, 0xcd, 0x0d, 0x57, 0xe9, 0x50,
-	0x67, 0x0c, 0x37, 0xdf, 0xf3, 0xce, 0x18, 0x6e, 0x41, 0x73, 0x9b, 0xfc, 0x12, 0x16, 0x0b, 0x9b,
-	0xd7, 0x44, 0x3d, 0xac, 0x51, 0xed, 0xef, 0xf6, 0x83, 0x8b, 0x09, 0x31, 0x58, 0xfa, 0xcf, 0x09,
-	0xd9, 0xfb, 0x61, 0x74, 0x34, 0x94, 0x21, 0xd3, 0x0e, 0xcc, 0xaa, 0xbd, 0x1f, 0xcd, 0x04, 0x0a,
-	0x7a, 0x45, 0x9a, 0x09, 0x14, 0x36, 0x8d, 0x76, 0x60, 0x56, 0x6d, 0x80, 0x69, 0x0c, 0x0b, 0x1a,
-	0x74, 0x1a, 0xc3, 0xa2, 0xce, 0x19, 0xd9, 0x02, 0x48, 0xfb, 0x5e, 0x44, 0xbd, 0x19, 0x73, 0x0d,
-	0xb5, 0xf6, 0x8d, 0x12, 0x6c, 0xea, 0x9a, 0x94, 0xb6, 0x98, 0xe6, 0x9a, 0xf2, 0x4d, 0x34, 0xcd,
-	0x35, 0x15, 0x74, 0xd3, 0xc8, 0x2f, 0xf8, 0x0b, 0x73, 0xbd, 0xcd, 0x44, 0xee, 0xe8, 0x11, 0x40,
-	0x61, 0x8f, 0xac, 0x7d, 0x77, 0x34, 0x51, 0xca, 0x3f, 0xd7, 0x31, 0xd2, 0xf8, 0x97, 0xf5, 0xb1,
-	0x34, 0xfe, 0xa5, 0x4d, 0x27, 0xf2, 0x95, 0x01, 0x37, 0x46, 0x76, 0x93, 0xc8, 0x77, 0x55, 0x39,
-	0x2f, 0xd1, 0x9f, 0x6a, 0x3f, 0xba, 0xfc, 0x80, 0x54, 0x5d, 0xd4, 0x86, 0x94, 0xa6, 0x2e, 0x05,
-	0x1d, 0x2c, 0x4d, 0x5d, 0x8a, 0x3a, 0x59, 0x6b, 0xff, 0x5a, 0x95, 0xbf, 0x3a, 0xe0, 0x85, 0x69,
-	0xa9, 0xe7, 0xaf, 0xa0, 0xae, 0x97, 0xc5, 0xb5, 0x20, 0xb6, 0xb0, 0x81, 0xa1, 0x05, 0xb1, 0xc5,
-	0x35, 0x75, 0xe6, 0x16, 0xb4, 0xda, 0xb9, 0xe6, 0x16, 0x8a, 0xaa, 0xed, 0xed, 0x5b, 0xe5, 0x04,
-	0xe9, 0xb9, 0xe7, 0x2a, 0xeb, 0xda, 0xb9, 0x97, 0x15, 0xe5, 0xb5, 0x73, 0x2f, 0x2f, 0xce, 0x6f,
-	0x01, 0xa4, 0x85, 0x47, 0xcd, 0xa0, 0x72, 0xe5, 0x4b, 0xcd, 0xa0, 0xf2, 0xd5, 0x4a, 0x76, 0x2b,
-	0x15, 0x95, 0x17, 0xb5, 0x5b, 0x69, 0x44, 0x39, 0xb3, 0x7d, 0xff, 0x42, 0x3a, 0x25, 0x04, 0x97,
-	0xe5, 0x46, 0x3d, 0x04, 0xcf, 0x54, 0x2f, 0xdb, 0xd7, 0x8b, 0x91, 0xc8, 0xc7, 0xe5, 0x45, 0xae,
-	0x6c, 0x55, 0x91, 0xdc, 0xcb, 0x0d, 0x2a, 0xaa, 0x60, 0xb6, 0xdf, 0xbe, 0x88, 0xac, 0x70, 0x96,
-	0xb4, 0x4b, 0x54, 0x3c, 0x3c, 0x53, 0xbc, 0x2c, 0x9b, 0x25, 0x5b, 0x97, 0x64, 0xa1, 0x51, 0xb6,
-	0xaa, 0xa8, 0x85, 0x46, 0x25, 0x45, 0x4b, 0x2d, 0x34, 0x2a, 0x2b, 0x4b, 0x72, 0x7b, 0xd1, 0x4a,
-	0x8b, 0xba, 0xbd, 0x14, 0x55, 0x2c, 0x75, 0x7b, 0x29, 0xac, 0x4b, 0x32, 0x0f, 0xac, 0x14, 0x19,
-	0xc9, 0x8d, 0xfc, 0x08, 0xa5, 0x5e, 0xa9, 0x79, 0xe0, 0x82, 0xda, 0x24, 0x0a, 0xa9, 0x94, 0x17,
-	0xb3, 0x42, 0xe6, 0xcb, 0x96, 0x59, 0x21, 0x0b, 0x6a, 0x93, 0x6b, 0xbf, 0x31, 0x98, 0x94, 0xd4,
-	0x95, 0xbe, 0xc3, 0x01, 0x92, 0x6f, 0xe6, 0x6a, 0x81, 0x4b, 0x69, 0xa7, 0x58, 0x0b, 0x5c, 0x46,
-	0x74, 0x84, 0xb7, 0x00, 0xd2, 0xf6, 0xab, 0x66, 0x93, 0xb9, 0x4e, 0xae, 0x66, 0x93, 0xf9, 0x9e,
-	0xed, 0xda, 0xef, 0x40, 0x4d, 0x54, 0x1c, 0x95, 0x4a, 0x0b, 0x96, 0x20, 0xb5, 0x0a, 0x80, 0x5e,
-	0x7e, 0xd5, 0x2a, 0x00, 0x99, 0x8a, 0xe5, 0xda, 0x3f, 0x1a, 0x50, 0x13, 0x6a, 0x22, 0x79, 0xbe,
-	0x80, 0x19, 0xa5, 0x04, 0xa4, 0x9d, 0x63, 0xbe, 0x0e, 0xa5, 0x9d, 0x63, 0x51, 0xe5, 0x48, 0x9c,
-	0xa3, 0xca, 0xf0, 0xd6, 0x45, 0xb5, 0xad, 0xec, 0x39, 0x16, 0xb0, 0xdd, 0x9f, 0xe4, 0xff, 0x77,
-	0xcd, 0xf7, 0xfe, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x54, 0x60, 0x04, 0x78, 0xc8, 0x46, 0x00, 0x00,
+	// 8798 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x7d, 0x49, 0x6c, 0x24, 0x49,
+	0x92, 0xd8, 0x44, 0x26, 0x8f, 0x4c, 0x23, 0x33, 0x99, 0x8c, 0xe4, 0x91, 0x8c, 0x2a, 0x1e, 0x15,
+	0x75, 0x76, 0xf7, 0x14, 0xa7, 0xba, 0xa6, 0xa7, 0xbb, 0xa7, 0xbb, 0x67, 0x7a, 0x58, 0x2c, 0xb2,
+	0x9a, 0x53, 0x2c, 0x92, 0x8a, 0x64, 0x55, 0x77, 0xcf, 0xec, 0x6c, 0x2a, 0x98, 0xe9, 0x24, 0x63,
+	0x2a, 0x33, 0x22, 0x3b, 0x22, 0x92, 0x47, 0xeb, 0x58, 0xed, 0x48, 0x0b, 0xbd, 0xb4, 0xa3, 0xc7,
+	0x42, 0xd0, 0x6a, 0x21, 0x61, 0x9f, 0x3a, 0x00, 0xad, 0x04, 0xad, 0x34, 0x0b, 0x2c, 0x04, 0x48,
+	0x0f, 0xe9, 0xb3, 0x12, 0xa0, 0xbf, 0x00, 0x01, 0xfa, 0x48, 0xd0, 0x6b, 0x01, 0x3d, 0xf4, 0x16,
+	0xdc, 0xdd, 0x3c, 0xc2, 0x3d, 0x8e, 0xe4, 0x51, 0xbd, 0xc7, 0x8b, 0x0c, 0x33, 0x73, 0xf3, 0xcb,
+	0xdc, 0xdc, 0xdc, 0xdc, 0xdc, 0x12, 0xca, 0x76, 0xdf, 0x59, 0xed, 0xfb, 0x5e, 0xe8, 0xe9, 0xe5,
+	0x53, 0xbb, 0xdb, 0x25, 0xa1, 0xdf, 0x6f, 0x9b, 0x35, 0xa8, 0xbe, 0x22, 0x7e, 0xe0, 0x78, 0xae,
+	0x45, 0xbe, 0x1a, 0x90, 0x20, 0x34, 0xff, 0xa3, 0x06, 0x53, 0x11, 0x28, 0xe8, 0x7b, 0x6e, 0x40,
+	0xf4, 0xbb, 0x50, 0x3d, 0xe1, 0xa0, 0x56, 0x10, 0xfa, 0x8e, 0x7b, 0xd4, 0xd0, 0x56, 0xb4, 0x07,
+	0x65, 0xab, 0x82, 0xd0, 0x26, 0x03, 0xea, 0x33, 0x30, 0xda, 0xb3, 0x7f, 0xee, 0xf9, 0x8d, 0xc2,
+	0x8a, 0xf6, 0xa0, 0x62, 0xf1, 0x0f, 0x06, 0x75, 0x5c, 0xcf, 0x6f, 0x14, 0x11, 0xea, 0xb8, 0x1c,
+	0xda, 0xb7, 0xc3, 0xf6, 0x71, 0x63, 0x84, 0x43, 0xd9, 0x87, 0xbe, 0x04, 0xd0, 0xf7, 0x89, 0x4f,
+	0xba, 0xc4, 0x0e, 0x48, 0x63, 0x94, 0x55, 0x22, 0x41, 0x68, 0x43, 0x0e, 0x06, 0x4e, 0xb7, 0xd3,
+	0xea, 0x91, 0xd0, 0xee, 0xd8, 0xa1, 0xdd, 0x18, 0xe3, 0x0d, 0x61, 0xd0, 0x17, 0x08, 0x34, 0x7f,
+	0x39, 0x0e, 0xfa, 0xbe, 0x6f, 0xbb, 0x81, 0xdd, 0x0e, 0x1d, 0xcf, 0x7d, 0x4a, 0x42, 0xdb, 0xe9,
+	0x06, 0xba, 0x0e, 0x23, 0xc7, 0x76, 0x70, 0xcc, 0x1a, 0x3f, 0x69, 0xb1, 0xff, 0xf5, 0x15, 0x98,
+	0x08, 0x63, 0x4a, 0xd6, 0xf2, 0x49, 0x4b, 0x06, 0xe9, 0x1f, 0xc3, 0x58, 0x87, 0x1c, 0x38, 0x61,
+	0xd0, 0x28, 0xae, 0x14, 0x1f, 0x4c, 0x3c, 0xbe, 0xbd, 0x1a, 0x0d, 0xdf, 0x6a, 0xba, 0x92, 0xd5,
+	0x2d, 0xb7, 0x3f, 0x08, 0x2d, 0x2c, 0xa2, 0xff, 0x10, 0xc6, 0xdb, 0x3e, 0xe9, 0xd0, 0xd2, 0x23,
+	0xac, 0xf4, 0x9d, 0xe1, 0xa5, 0x77, 0x07, 0x21, 0x2d, 0x2e, 0x0a, 0xe9, 0x35, 0x28, 0x1e, 0x12,
+	0x3e, 0x12, 0x45, 0x8b, 0xfe, 0xab, 0xdf, 0x84, 0x72, 0xe8, 0xf4, 0x48, 0x10, 0xda, 0xbd, 0x3e,
+	0xeb, 0x7d, 0xd1, 0x8a, 0x01, 0xfa, 0x17, 0x50, 0x93, 0xda, 0xde, 0x0a, 0xcf, 0xfb, 0xa4, 0x31,
+	0xbe, 0xa2, 0x3d, 0xa8, 0x3e, 0x7e, 0x38, 0xbc, 0x62, 0x09, 0xb4, 0x7f, 0xde, 0x27, 0xd6, 0x54,
+	0xa8, 0x02, 0xe8, 0x84, 0x75, 0xed, 0x03, 0xd2, 0x6d, 0x94, 0xd8, 0x88, 0xf3, 0x0f, 0xfd, 0x39,
+	0x4c, 0xd2, 0x11, 0x6f, 0x79, 0xac, 0xdd, 0x41, 0xa3, 0xcc, 0x3a, 0xf9, 0x60, 0x78, 0x5d, 0x4f,
+	0xed, 0xd0, 0xc6, 0x8e, 0x4e, 0x74, 0xa2, 0xff, 0x03, 0xe3, 0x2b, 0x18, 0x65, 0xa3, 0x47, 0xeb,
+	0x72, 0xdc, 0x0e, 0x39, 0x63, 0x33, 0x55, 0xb1, 0xf8, 0x87, 0xfe, 0x16, 0xd4, 0xfa, 0x3e, 0x39,
+	0x71, 0xbc, 0x41, 0xd0, 0xb2, 0xdb, 0x6d, 0x6f, 0xe0, 0x86, 0x28, 0x69, 0x53, 0x02, 0xbe, 0xc6,
+	0xc1, 0xfa, 0x7d, 0x98, 0x8a, 0x49, 0x7b, 0x8c, 0xb2, 0xc8, 0x86, 0xaa, 0x1a, 0x51, 0x32, 0xa8,
+	0xf1, 0xdf, 0x34, 0x18, 0xe3, 0xd5, 0xe7, 0x54, 0xda, 0x80, 0x71, 0xb5, 0x2e, 0xf1, 0xa9, 0x1b,
+	0x50, 0x72, 0xdc, 0x90, 0xf8, 0xae, 0xdd, 0x65, 0xcc, 0x4b, 0x56, 0xf4, 0xad, 0xcf, 0xc1, 0x18,
+	0x56, 0x3b, 0xc2, 0xaa, 0xc5, 0x2f, 0xc6, 0xad, 0xd3, 0xf1, 0x49, 0x10, 0xa0, 0x70, 0x8b, 0x4f,
+	0xfd, 0x36, 0x54, 0xf8, 0x18, 0xb6, 0x82, 0xb6, 0xef, 0xf4, 0x43, 0x36, 0xb5, 0x93, 0xd6, 0x24,
+	0x07, 0x36, 0x19, 0x8c, 0x12, 0x21, 0x7d, 0x8b, 0xcf, 0xc5, 0x38, 0x63, 0x32, 0x89, 0xc0, 0x6d,
+	0x0a, 0x33, 0xde, 0x07, 0x88, 0x07, 0x38, 0xa7, 0x57, 0x3a, 0x8c, 0xb0, 0xd5, 0xc3, 0xc5, 0x9d,
+	0xfd, 0x6f, 0xfe, 0x14, 0xa6, 0x12, 0x42, 0xa0, 0x4f, 0xc0, 0xb8, 0xb5, 0xf1, 0xec, 0xe5, 0xf6,
+	0x9a, 0x55, 0xfb, 0x96, 0x3e, 0x09, 0xa5, 0xf5, 0xdd, 0xad, 0x9d, 0x27, 0x6b, 0xcd, 0x8d, 0xda,
+	0x88, 0x5e, 0x87, 0xa9, 0xfd, 0xad, 0xf5, 0xe7, 0x1b, 0xfb, 0xad, 0xbd, 0x97, 0xd6, 0xfa, 0x67,
+	0x14, 0xa8, 0xe9, 0x25, 0x18, 0x79, 0xb5, 0xbb, 0xbf, 0x51, 0x2b, 0xe8, 0x55, 0x00, 0x6b, 0xe3,
+	0xd5, 0xee, 0xfa, 0xda, 0xfe, 0xd6, 0xee, 0x4e, 0xad, 0x68, 0xfe, 0x9e, 0x06, 0x93, 0x4f, 0xba,
+	0x5e, 0xfb, 0xf5, 0xb0, 0xb5, 0x38, 0x07, 0x63, 0xc7, 0xc4, 0x39, 0x3a, 0xe6, 0x43, 0x3d, 0x6a,
+	0xe1, 0x97, 0x2a, 0xf2, 0xc5, 0xa4, 0xc8, 0xaf, 0xc1, 0xa4, 0x24, 0xab, 0x62, 0x9d, 0x2d, 0x0e,
+	0x15, 0x41, 0x4b, 0x29, 0x62, 0xee, 0x42, 0x15, 0x25, 0xe7, 0x89, 0xdd, 0xb5, 0xdd, 0x36, 0x91,
+	0xa7, 0x5d, 0x53, 0xa7, 0xfd, 0x36, 0x54, 0x42, 0x2f, 0xb4, 0xbb, 0xad, 0x03, 0x4e, 0xca, 0xda,
+	0x5a, 0xb4, 0x26, 0x19, 0x10, 0x8b, 0x9b, 0x15, 0x98, 0xd8, 0x73, 0xdc, 0x23, 0xa1, 0x53, 0xab,
+	0x30, 0xc9, 0x3f, 0xb9, 0x3e, 0xa5, 0x5a, 0x77, 0x87, 0x84, 0xa7, 0x9e, 0xff, 0x5a, 0x50, 0x7c,
+	0x08, 0x53, 0x11, 0x24, 0x56, 0xba, 0xb4, 0x7d, 0x27, 0xa4, 0xe5, 0x72, 0x0c, 0xb6, 0xa4, 0xc2,
+	0xa1, 0x48, 0x6e, 0x7e, 0x1f, 0x66, 0xb0, 0xed, 0x3b, 0x83, 0xde, 0x01, 0xf1, 0x91, 0xa3, 0x7e,
+	0x0b, 0x26, 0xb1, 0xc9, 0x2d, 0xd7, 0xee, 0x11, 0xd4, 0xd8, 0x13, 0x08, 0xdb, 0xb1, 0x7b, 0xc4,
+	0xfc, 0x21, 0xcc, 0x26, 0x8a, 0xca, 0x55, 0x63, 0x59, 0x86, 0x89, 0xab, 0x96, 0xc8, 0xcd, 0x69,
+	0x98, 0xc2, 0xf2, 0x81, 0xe8, 0xc7, 0x1f, 0x15, 0xa1, 0x16, 0xc3, 0x90, 0xdd, 0xa7, 0x50, 0xc2,
+	0x82, 0x41, 0x43, 0x4b, 0xe9, 0xd0, 0x24, 0xb9, 0x00, 0x58, 0x51, 0x21, 0xfd, 0xdb, 0xa0, 0xb7,
+	0x07, 0xbe, 0x4f, 0xdc, 0xb0, 0x75, 0x40, 0x85, 0xa8, 0xc5, 0x44, 0x87, 0x0b, 0x6f, 0x0d, 0x31,
+	0x4c, 0xba, 0x3e, 0xa3, 0x62, 0xf4, 0x08, 0x66, 0x12, 0xd4, 0x5c, 0xa8, 0x8a, 0x4c, 0xa8, 0x74,
+	0x85, 0x9e, 0x61, 0x8c, 0x5f, 0x14, 0x60, 0x5c, 0xa8, 0x8e, 0xcb, 0xf5, 0x3d, 0x35, 0xbc, 0x85,
+	0xd4, 0xf0, 0xa6, 0x25, 0xa5, 0x98, 0x96, 0x14, 0xda, 0x35, 0x72, 0xc6, 0xb5, 0x46, 0xeb, 0x35,
+	0x39, 0x6f, 0xb5, 0x23, 0xad, 0x51, 0xb1, 0x6a, 0x02, 0xf3, 0x9c, 0x9c, 0xaf, 0xb3, 0xc6, 0x7d,
+	0x1b, 0x74, 0xc7, 0x4d, 0x51, 0x8f, 0x72, 0x6a, 0xc7, 0xcd, 0xa0, 0xee, 0xf5, 0x3d, 0x3f, 0x24,
+	0x1d, 0x89, 0x7a, 0x0c, 0xa9, 0x11, 0x23, 0xa8, 0xcd, 0x2f, 0x60, 0xc6, 0x22, 0xb4, 0x2f, 0x62,
+	0xfc, 0x51, 0x90, 0x2e, 0x39, 0x20, 0x0b, 0x50, 0x72, 0xc9, 0xa9, 0x3c, 0x18, 0xe3, 0x2e, 0x39,
+	0x65, 0x72, 0x36, 0x0f, 0xb3, 0x09, 0xce, 0xb8, 0x0e, 0x1e, 0x43, 0xc5, 0x22, 0x41, 0xdb, 0x76,
+	0x25, 0xa1, 0x3d, 0x20, 0x47, 0x8e, 0x2b, 0xa6, 0x4c, 0x63, 0x53, 0x36, 0xc1, 0x60, 0x7c, 0xae,
+	0xcc, 0x1f, 0x40, 0x55, 0x94, 0x41, 0xf1, 0x7a, 0x07, 0xa6, 0x7d, 0x06, 0x71, 0x49, 0xa7, 0x15,
+	0x1e, 0xfb, 0xde, 0xe0, 0xe8, 0x18, 0x4b, 0xd6, 0x22, 0xc4, 0x3e, 0x87, 0x9b, 0x9f, 0x83, 0xbe,
+	0x43, 0xce, 0xc2, 0x44, 0x1f, 0xa9, 0xdd, 0x61, 0x07, 0x41, 0xff, 0xd8, 0xa7, 0x76, 0x07, 0xd7,
+	0x49, 0x12, 0xe4, 0x12, 0xb3, 0x6d, 0x7e, 0x02, 0x75, 0x85, 0xf1, 0xd5, 0x96, 0xd2, 0x7f, 0xd5,
+	0xc0, 0xa0, 0xc5, 0x5f, 0x0c, 0xba, 0xa1, 0x13, 0x38, 0x47, 0xdf, 0x78, 0xfb, 0xf4, 0xef, 0x40,
+	0xdd, 0x27, 0x5f, 0x0d, 0x1c, 0x9f, 0x74, 0x5a, 0x81, 0x73, 0xe4, 0xda, 0xe1, 0xc0, 0x27, 0x01,
+	0x1a, 0x65, 0xba, 0x40, 0x35, 0x23, 0x8c, 0xfe, 0x31, 0x18, 0x6d, 0x8f, 0x52, 0x12, 0xbf, 0x45,
+	0x05, 0xd1, 0xed, 0x90, 0x4e, 0xab, 0x3f, 0x38, 0xa0, 0xa2, 0xc4, 0xb5, 0x6c, 0xd9, 0x9a, 0x17,
+	0x14, 0x1b, 0x48, 0xb0, 0x37, 0x38, 0x78, 0x4e, 0xce, 0x03, 0xf3, 0x29, 0xdc, 0xc8, 0xec, 0xce,
+	0x15, 0x47, 0xa5, 0x80, 0xb3, 0xc5, 0xf7, 0x37, 0x31, 0x1a, 0xf9, 0xca, 0xf9, 0x7d, 0x18, 0x79,
+	0xed, 0xb8, 0x1d, 0xd6, 0xff, 0xea, 0x63, 0x53, 0xd2, 0x32, 0x69, 0x36, 0xab, 0xcf, 0x1d, 0xb7,
+	0x63, 0x31, 0x7a, 0x7d, 0x13, 0xe0, 0xc8, 0xee, 0xb7, 0xfa, 0x5e, 0xd7, 0x69, 0x9f, 0xb3, 0x31,
+	0xa9, 0x3e, 0xbe, 0x3f, 0xbc, 0xf4, 0x33, 0xbb, 0xbf, 0xc7, 0xc8, 0xad, 0xf2, 0x91, 0xf8, 0xd7,
+	0x7c, 0x0c, 0x23, 0x94, 0xab, 0x3e, 0x03, 0xb5, 0x27, 0x5b, 0x7b, 0x8f, 0x1e, 0xbd, 0xf7, 0x5e,
+	0x6b, 0xe3, 0x8b, 0xfd, 0x0d, 0x6b, 0x67, 0x6d, 0xbb, 0xf6, 0x2d, 0x19, 0xba, 0xb5, 0x83, 0x50,
+	0xcd, 0x74, 0xa0, 0x1c, 0xf1, 0xd2, 0x0d, 0x98, 0x7b, 0xb6, 0xb6, 0xd7, 0xda, 0xdb, 0xdd, 0xde,
+	0x5a, 0xff, 0xb2, 0xf5, 0x72, 0xa7, 0xb9, 0xb7, 0xb1, 0xbe, 0xb5, 0xb9, 0xb5, 0xf1, 0x94, 0x17,
+	0x97, 0x70, 0x1b, 0x96, 0xb5, 0x6b, 0xd5, 0x34, 0x7d, 0x16, 0xa6, 0x25, 0xe8, 0xd6, 0xb3, 0x9d,
+	0x5d, 0x8b, 0x6e, 0xc0, 0x75, 0x98, 0x92, 0xc0, 0x9f, 0x5b, 0x6b, 0x7b, 0xb5, 0xa2, 0xb9, 0x03,
+	0x75, 0xa5, 0x27, 0x38, 0x1b, 0x92, 0x55, 0xa2, 0xa9, 0x56, 0xc9, 0x22, 0x40, 0x7f, 0x70, 0xd0,
+	0x75, 0xda, 0x74, 0xd2, 0x51, 0xaa, 0xca, 0x1c, 0xf2, 0x9c, 0x9c, 0x9b, 0xff, 0x4a, 0x83, 0xf9,
+	0x2d, 0xa6, 0x47, 0xf6, 0x7c, 0xe7, 0xc4, 0x0e, 0xc9, 0x73, 0x72, 0x7e, 0x59, 0x91, 0xcd, 0x37,
+	0xac, 0xee, 0x51, 0xe3, 0x8d, 0xb1, 0x63, 0x5a, 0xeb, 0xd4, 0x39, 0x64, 0x33, 0x52, 0xb6, 0x2a,
+	0xfd, 0xa8, 0x96, 0xcf, 0x9d, 0x43, 0x6a, 0x2e, 0xf0, 0xe5, 0xcd, 0xd4, 0x65, 0xc9, 0xc2, 0x2f,
+	0xfd, 0x06, 0x94, 0xe9, 0xdf, 0xd6, 0xa1, 0xef, 0xf5, 0x98, 0x6e, 0x1c, 0xb5, 0x4a, 0x14, 0xb0,
+	0xe9, 0x7b, 0x3d, 0xd3, 0x80, 0x46, 0xba, 0xc5, 0xa8, 0x8e, 0xfe, 0xb5, 0x06, 0x75, 0x8e, 0xe4,
+	0xf6, 0xd6, 0x65, 0xbb, 0x32, 0x07, 0x63, 0x68, 0xb4, 0xf1, 0x2d, 0x09, 0xbf, 0xa4, 0x06, 0x16,
+	0xf3, 0x1b, 0x38, 0xa2, 0x36, 0x50, 0x7f, 0x08, 0x62, 0x31, 0xb6, 0x7c, 0xd2, 0x21, 0xa4, 0x67,
+	0x1f, 0x74, 0xf9, 0x01, 0xa0, 0x64, 0x4d, 0x23, 0xc6, 0x8a, 0x10, 0xe6, 0x97, 0x30, 0xa3, 0x36,
+	0x19, 0xe7, 0xf4, 0x16, 0x4c, 0xf6, 0x1f, 0x07, 0xc7, 0x2d, 0x75, 0x62, 0x27, 0x28, 0x0c, 0xa7,
+	0x9f, 0x76, 0x4b, 0xaa, 0xa1, 0xc0, 0x6a, 0x90, 0x20, 0xa6, 0x0b, 0x55, 0xdc, 0xa5, 0xae, 0xb8,
+	0x15, 0x7c, 0x0f, 0xe6, 0x22, 0x55, 0xd3, 0xf6, 0xdc, 0x43, 0xc7, 0xef, 0xd9, 0xdc, 0x36, 0xe3,
+	0x76, 0xdd, 0xac, 0xc0, 0xae, 0xcb, 0x48, 0xf3, 0x5f, 0x14, 0x60, 0x2a, 0xaa, 0x10, 0xbb, 0x31,
+	0x03, 0xa3, 0x6c, 0xbb, 0x64, 0x15, 0x15, 0x2d, 0xfe, 0x41, 0x0d, 0xc2, 0xa0, 0x4f, 0xdc, 0x4e,
+	0xd4, 0xf0, 0xa2, 0x15, 0x03, 0xa8, 0xf1, 0xef, 0xf4, 0x7a, 0x4c, 0x8b, 0xb5, 0x7c, 0x72, 0x6a,
+	0xfb, 0x1d, 0x61, 0xfc, 0x0b, 0xb0, 0xc5, 0xa0, 0xfa, 0x47, 0xb0, 0x10, 0x11, 0x06, 0xa1, 0xfd,
+	0x9a, 0xb4, 0x8e, 0x88, 0x4b, 0x7c, 0xd6, 0x1c, 0x34, 0xdc, 0xe7, 0x05, 0x41, 0x93, 0xe2, 0x9f,
+	0x45, 0x68, 0xfd, 0x6d, 0x98, 0xa6, 0x06, 0x04, 0xe9, 0xb4, 0x0e, 0xce, 0x5b, 0xa1, 0xd3, 0x7e,
+	0x4d, 0xc2, 0x00, 0x8f, 0x69, 0x53, 0x1c, 0xf1, 0xe4, 0x7c, 0x9f, 0x83, 0xe9, 0xc1, 0xe5, 0xc4,
+	0x0b, 0x1d, 0xf7, 0xa8, 0x65, 0x0f, 0xc2, 0x63, 0xcf, 0x77, 0xc2, 0x73, 0x3c, 0xb9, 0x4d, 0x71,
+	0xf8, 0x9a, 0x00, 0xeb, 0x0f, 0xa0, 0x16, 0xb3, 0x3d, 0xb1, 0x07, 0xdd, 0x30, 0x60, 0x46, 0x7e,
+	0xd1, 0xaa, 0x0a, 0xae, 0xaf, 0x18, 0xd4, 0x7c, 0x02, 0xb3, 0xcf, 0x48, 0x28, 0x99, 0xb6, 0x62,
+	0x92, 0xde, 0x52, 0x8f, 0x80, 0x92, 0x95, 0x2d, 0x9f, 0xe9, 0xa8, 0xa5, 0x64, 0x7e, 0x09, 0x73,
+	0x49, 0x1e, 0x91, 0xc9, 0xa6, 0x1c, 0x8b, 0x69, 0xf9, 0x0b, 0x6d, 0x6a, 0xb9, 0x84, 0xf9, 0x0f,
+	0x0b, 0x49, 0xde, 0x91, 0xfa, 0x5e, 0x85, 0x7a, 0x10, 0xda, 0x3e, 0x1b, 0x10, 0xc9, 0x9c, 0xe3,
+	0x6d, 0x9c, 0x16, 0xa8, 0xd8, 0x9e, 0x7b, 0x0c, 0xb3, 0x49, 0xfa, 0xf8, 0x94, 0x30, 0x6d, 0xd5,
+	0xd5, 0x12, 0x0c, 0x45, 0xa7, 0x87, 0xb8, 0x9d, 0x44, 0x0d, 0x45, 0x3e, 0x0a, 0x1c, 0x11, 0xf3,
+	0x5f, 0x85, 0xba, 0x4a, 0xcb, 0xb9, 0xf3, 0x85, 0x39, 0x2d, 0x53, 0x73, 0xde, 0x3f, 0x84, 0x1b,
+	0x3d, 0xc7, 0x75, 0x7a, 0x83, 0x5e, 0xcb, 0x27, 0x6d, 0x6a, 0x66, 0x2a, 0xe7, 0x0f, 0xae, 0x71,
+	0x16, 0x90, 0xc4, 0x62, 0x14, 0xf2, 0x30, 0x98, 0xff, 0x46, 0x83, 0xf9, 0xd4, 0xd0, 0xe0, 0xb8,
+	0x6f, 0x82, 0xde, 0x73, 0x98, 0x1d, 0x23, 0xb3, 0xe4, 0xc3, 0x3f, 0x2f, 0x0d, 0xbf, 0x7c, 0x96,
+	0xb2, 0xa6, 0x59, 0x11, 0x99, 0x9f, 0xbe, 0x07, 0x33, 0x03, 0x37, 0x83, 0x53, 0xe1, 0x32, 0x87,
+	0xa3, 0x3a, 0x16, 0x55, 0x5a, 0x3d, 0x03, 0x3a, 0x97, 0xe7, 0x3d, 0xdf, 0x89, 0x34, 0x82, 0xb9,
+	0x07, 0x75, 0x05, 0x1a, 0x6b, 0x1f, 0xbe, 0x26, 0x5a, 0x7d, 0x0a, 0xc7, 0xd5, 0x3b, 0x11, 0xc6,
+	0xa4, 0x79, 0x87, 0x3d, 0x53, 0x87, 0x1a, 0x5b, 0x6b, 0x5b, 0xee, 0xa1, 0x27, 0x6a, 0xf9, 0x55,
+	0x01, 0xa6, 0x25, 0x20, 0x56, 0x72, 0x03, 0xca, 0x7d, 0xcf, 0xeb, 0xb6, 0x02, 0xe7, 0x6b, 0x82,
+	0x8a, 0xa8, 0x44, 0x01, 0x4d, 0xe7, 0x6b, 0x42, 0x37, 0x11, 0xbb, 0xdb, 0x6d, 0xf5, 0x48, 0x8f,
+	0xd1, 0x84, 0xce, 0x19, 0x6e, 0x33, 0x15, 0xbb, 0xdb, 0x7d, 0xc1, 0xa1, 0xfb, 0xce, 0x19, 0xa5,
+	0xf3, 0x4e, 0x5d, 0x85, 0x8e, 0x9b, 0x44, 0x15, 0xef, 0xd4, 0x95, 0xe8, 0xe8, 0x69, 0x1f, 0x55,
+	0x01, 0x5a, 0xe7, 0xd1, 0x37, 0x3d, 0xcb, 0x76, 0x9d, 0x13, 0x82, 0x76, 0x38, 0xfb, 0x9f, 0x2a,
+	0xae, 0x13, 0x2f, 0x24, 0x1d, 0x34, 0xb7, 0xf9, 0x07, 0xed, 0x74, 0xcf, 0x09, 0x02, 0xd2, 0x61,
+	0x8b, 0xba, 0x62, 0xe1, 0x17, 0xdd, 0x0c, 0x7d, 0x72, 0xe2, 0xbd, 0x26, 0x1d, 0xe6, 0x5e, 0xa9,
+	0x58, 0xe2, 0x93, 0x62, 0xc8, 0x59, 0x9f, 0x2a, 0xcb, 0x46, 0x99, 0x63, 0xf0, 0x33, 0x3e, 0x5e,
+	0x04, 0x83, 0x83, 0xc0, 0xe9, 0x9c, 0x37, 0x40, 0x3a, 0x5e, 0x34, 0x39, 0xcc, 0xdc, 0x87, 0x1a,
+	0x13, 0x15, 0x69, 0x34, 0xe9, 0xa6, 0x9e, 0x5a, 0x76, 0xe5, 0x83, 0x68, 0x39, 0x50, 0x1b, 0x3c,
+	0xb9, 0xca, 0xa8, 0x0d, 0x1e, 0xaf, 0x00, 0xf3, 0xff, 0x68, 0x30, 0x2d, 0xb1, 0xc5, 0xf9, 0x78,
+	0x63, 0xbe, 0xfa, 0x1d, 0xa8, 0xa8, 0xfb, 0x05, 0x3f, 0xb2, 0xa9, 0x40, 0xd5, 0x1d, 0x30, 0x92,
+	0x74, 0x07, 0x48, 0xd5, 0xd8, 0x1d, 0xe2, 0xb3, 0x49, 0x99, 0x8c, 0xaa, 0xa1, 0x20, 0x7a, 0x60,
+	0xe0, 0xea, 0xde, 0x71, 0x4f, 0xec, 0xae, 0xd3, 0xb1, 0xc5, 0x3c, 0x95, 0xac, 0x5a, 0xc0, 0xc5,
+	0x2c, 0x82, 0x53, 0x7f, 0xe8, 0xfc, 0xfa, 0xb1, 0xed, 0x1e, 0x91, 0xbd, 0x68, 0xc7, 0x17, 0x23,
+	0xf9, 0x21, 0x14, 0xa9, 0x5d, 0xa4, 0x31, 0x7b, 0xf1, 0x9e, 0xb4, 0xa8, 0x72, 0x0a, 0xac, 0x52,
+	0x6b, 0x83, 0x16, 0xa1, 0x3b, 0xa9, 0xd7, 0xed, 0xb4, 0x24, 0xb3, 0x82, 0x9b, 0x0e, 0x15, 0xaf,
+	0xdb, 0x89, 0x8b, 0x51, 0x32, 0x7a, 0xa8, 0x92, 0xc8, 0xb8, 0x0e, 0xab, 0xb8, 0xe4, 0x34, 0x26,
+	0x33, 0x97, 0xa0, 0xf8, 0x9c, 0x9c, 0x53, 0x77, 0xcd, 0x9e, 0xb5, 0xf5, 0x6a, 0x6d, 0x7f, 0xa3,
+	0xf6, 0x2d, 0x1d, 0x60, 0x6c, 0xef, 0xe5, 0x93, 0xed, 0xad, 0xf5, 0x9a, 0x46, 0x8d, 0x9e, 0x74,
+	0x8b, 0xd0, 0xe8, 0xf9, 0x5b, 0x05, 0x98, 0xdb, 0x1c, 0xb8, 0x9d, 0x8c, 0x9d, 0x64, 0xb8, 0x13,
+	0xc4, 0xf6, 0x8f, 0x48, 0x28, 0xbc, 0x6b, 0xc2, 0x09, 0xc2, 0x80, 0xdc, 0xb7, 0x36, 0xc4, 0x0c,
+	0x28, 0x0e, 0x31, 0x03, 0xf4, 0x4f, 0xc0, 0x70, 0xdc, 0x76, 0x77, 0xd0, 0x21, 0xad, 0x68, 0x77,
+	0x6e, 0x7b, 0x8e, 0x7b, 0x60, 0x07, 0x24, 0x40, 0x53, 0xaf, 0x81, 0x14, 0x5b, 0x48, 0xb0, 0x2e,
+	0xf0, 0x74, 0xb3, 0x10, 0xa5, 0xdb, 0xac, 0xcb, 0xc2, 0x9f, 0xc6, 0x2d, 0xa8, 0x3a, 0x22, 0xf9,
+	0x70, 0x70, 0x9b, 0xc9, 0xfc, 0x77, 0x45, 0x98, 0x4f, 0x0d, 0x01, 0x0a, 0xf5, 0xaf, 0x41, 0x2d,
+	0x20, 0x5d, 0xd2, 0xa6, 0x67, 0x68, 0xe1, 0xe4, 0xe4, 0x3e, 0x8c, 0x77, 0xa5, 0xf9, 0xce, 0x29,
+	0xbd, 0xba, 0x87, 0xde, 0x46, 0xf4, 0x76, 0x4e, 0x09, 0x56, 0xfc, 0x3b, 0x60, 0x7a, 0x92, 0xad,
+	0x61, 0x65, 0x18, 0x27, 0x18, 0x0c, 0x47, 0xf1, 0x01, 0xd4, 0xb0, 0x23, 0xfd, 0xd7, 0xa2, 0x2f,
+	0x5c, 0x08, 0xaa, 0x1c, 0xbe, 0xf7, 0x9a, 0x77, 0xc3, 0xf8, 0x53, 0x0d, 0xaa, 0x6a, 0x85, 0x57,
+	0xb0, 0x05, 0x68, 0x53, 0xd0, 0x01, 0xc9, 0xfd, 0x85, 0x5c, 0x5b, 0x4e, 0x70, 0xd8, 0x16, 0x05,
+	0x49, 0x5e, 0xcd, 0xa2, 0xe2, 0xd5, 0xa4, 0x8a, 0x38, 0x6a, 0xdb, 0x08, 0x63, 0x5f, 0xea, 0x63,
+	0xab, 0x28, 0x5f, 0xba, 0x4b, 0x52, 0x3f, 0x16, 0x5d, 0xa4, 0x68, 0x23, 0x4d, 0x20, 0x6c, 0xdf,
+	0xe1, 0x8e, 0x12, 0x6a, 0x0a, 0x47, 0xb3, 0x8c, 0x6b, 0x71, 0x92, 0x02, 0xc5, 0xcc, 0x52, 0x25,
+	0x1b, 0xfa, 0x84, 0x7b, 0xb3, 0x47, 0x2d, 0xf6, 0xbf, 0xf9, 0x6f, 0x27, 0xe1, 0xc6, 0xba, 0xe7,
+	0x06, 0xa1, 0x3f, 0x68, 0x67, 0x99, 0x42, 0x77, 0xa1, 0x1a, 0x78, 0x03, 0xbf, 0x4d, 0x5a, 0xaa,
+	0x1c, 0x57, 0x38, 0x54, 0xb8, 0x7c, 0xae, 0x67, 0xaf, 0xea, 0x37, 0x01, 0x0e, 0x09, 0x69, 0xf5,
+	0x89, 0xdf, 0x7a, 0x7d, 0x80, 0x32, 0x5d, 0x3a, 0x24, 0x64, 0x8f, 0xf8, 0xcf, 0x0f, 0xf4, 0xbf,
+	0x01, 0x06, 0x8e, 0x27, 0x9f, 0x74, 0x3a, 0xfe, 0x76, 0xf7, 0x88, 0x9a, 0x79, 0xc7, 0xdc, 0xea,
+	0xaf, 0x3e, 0xfe, 0x54, 0x56, 0x19, 0xf9, 0xfd, 0xc0, 0x5b, 0x81, 0xa6, 0xe0, 0xb3, 0x26, 0xd8,
+	0x58, 0x0d, 0x2f, 0x07, 0xa3, 0xff, 0x14, 0x74, 0xd7, 0x73, 0xc5, 0x1a, 0x10, 0x92, 0x3b, 0xca,
+	0x24, 0xf7, 0xe1, 0x95, 0xaa, 0xb5, 0x6a, 0xae, 0xe7, 0xf2, 0xf5, 0x22, 0xc4, 0xf6, 0x08, 0x74,
+	0x64, 0xdc, 0x21, 0x41, 0xe8, 0xb8, 0xdc, 0x62, 0x1e, 0x63, 0x56, 0xca, 0x87, 0x57, 0x62, 0xfe,
+	0x34, 0x2e, 0x6f, 0x4d, 0x73, 0x9e, 0x12, 0x48, 0x0f, 0x61, 0x9e, 0x0a, 0x85, 0x34, 0x84, 0x41,
+	0xe8, 0xdb, 0x21, 0x39, 0x3a, 0xc7, 0x5b, 0x8d, 0x4f, 0x2e, 0x59, 0x1b, 0x15, 0xa3, 0x68, 0x94,
+	0x9a, 0xc8, 0xc3, 0x9a, 0x6d, 0x67, 0x81, 0xf5, 0x2f, 0x60, 0x8a, 0x9c, 0xf5, 0xbb, 0x4e, 0xdb,
+	0xa1, 0x8b, 0x81, 0x0d, 0x5c, 0x89, 0x0d, 0xdc, 0x77, 0x2e, 0xdf, 0xb7, 0x3d, 0xcf, 0x71, 0x43,
+	0xab, 0x2a, 0xf8, 0xb0, 0x7b, 0x8d, 0x40, 0xff, 0x35, 0x98, 0x16, 0xda, 0x89, 0x4e, 0x09, 0xa5,
+	0x11, 0x77, 0x26, 0x57, 0xe6, 0x5d, 0x43, 0x4e, 0xbb, 0x82, 0x11, 0xe5, 0x4e, 0xce, 0x92, 0xdc,
+	0xe1, 0x9a, 0xdc, 0xc9, 0x59, 0x82, 0xfb, 0xdb, 0x30, 0x1d, 0x0c, 0x0e, 0x42, 0xdf, 0x6e, 0x87,
+	0x2d, 0x2a, 0xf7, 0xec, 0xf4, 0x3a, 0xb1, 0x52, 0xa4, 0xf7, 0x2f, 0x02, 0xb1, 0x49, 0x08, 0x3b,
+	0xc4, 0xce, 0xc3, 0x78, 0xc7, 0x3f, 0x6f, 0xf9, 0x03, 0xb7, 0x31, 0xc9, 0x8f, 0xbe, 0x1d, 0xff,
+	0xdc, 0x1a, 0xb8, 0x54, 0x85, 0x30, 0xfb, 0xe5, 0xbc, 0x51, 0xe1, 0x06, 0x10, 0xff, 0xa2, 0x67,
+	0x36, 0x9f, 0x74, 0x6d, 0xe6, 0xee, 0x46, 0x82, 0x2a, 0x2b, 0x58, 0x15, 0xe0, 0x0d, 0x06, 0x35,
+	0xfe, 0x81, 0x06, 0xd3, 0x29, 0xd1, 0x19, 0xe2, 0xc1, 0xc8, 0x3b, 0x9b, 0x53, 0xd5, 0xc0, 0xfe,
+	0x6b, 0xe1, 0x1d, 0xa6, 0x30, 0xfb, 0x38, 0x14, 0x6f, 0x40, 0xf9, 0x35, 0xe5, 0x39, 0xe1, 0x36,
+	0x5f, 0xd9, 0xe2, 0x1f, 0xd1, 0xf5, 0xc9, 0x68, 0x7c, 0x7d, 0x62, 0xfc, 0xf5, 0xe8, 0x22, 0xe9,
+	0x27, 0x30, 0x21, 0x2f, 0x0b, 0xed, 0x0d, 0x97, 0x85, 0xcc, 0x4c, 0x52, 0xc1, 0x05, 0x59, 0x05,
+	0x1b, 0x5d, 0x28, 0x89, 0xa9, 0xfb, 0x86, 0x95, 0xbe, 0xd0, 0xbb, 0x45, 0x49, 0xef, 0xbe, 0x07,
+	0x8d, 0x3c, 0x95, 0xa4, 0x4f, 0xc1, 0x84, 0xea, 0xb6, 0x1a, 0x87, 0xe2, 0xda, 0x36, 0x75, 0x74,
+	0xfd, 0x5d, 0x0d, 0x66, 0x33, 0xd7, 0xa1, 0xae, 0x43, 0xf5, 0xf3, 0xb5, 0xed, 0xed, 0x8d, 0xfd,
+	0xd6, 0xd3, 0x8d, 0xcd, 0xb5, 0x97, 0xdb, 0xfb, 0xe8, 0x2c, 0xb3, 0xd6, 0x76, 0xd6, 0x3f, 0x6b,
+	0xad, 0xed, 0x3c, 0x6d, 0x3d, 0xd9, 0x7d, 0xb9, 0xf3, 0xb4, 0xa6, 0xe9, 0xd3, 0x50, 0xd9, 0x5e,
+	0xb3, 0x9e, 0x6d, 0x34, 0xf7, 0x5b, 0x9b, 0x5b, 0x56, 0x73, 0xbf, 0x56, 0xa0, 0x85, 0x9b, 0x2f,
+	0x68, 0xe9, 0x08, 0x56, 0xd4, 0x6b, 0x30, 0xb9, 0xbb, 0xfd, 0x34, 0x86, 0x8c, 0x44, 0xb6, 0xd1,
+	0xfa, 0x97, 0xb5, 0x51, 0xf3, 0x3f, 0x17, 0xe0, 0x66, 0xf6, 0x24, 0xe0, 0xae, 0xff, 0x2e, 0x3d,
+	0x3e, 0x31, 0xcf, 0xa6, 0x72, 0x7e, 0xc2, 0x61, 0xac, 0x0b, 0x9c, 0x54, 0x54, 0xff, 0x14, 0x6e,
+	0xf2, 0xad, 0x3c, 0xba, 0x78, 0xc4, 0x91, 0x55, 0xe6, 0x6b, 0x81, 0xd1, 0xa8, 0xbb, 0x34, 0x6e,
+	0xf4, 0xab, 0x50, 0xe7, 0x0c, 0xd4, 0x72, 0x7c, 0xab, 0x9d, 0x66, 0x28, 0x85, 0xfe, 0x31, 0xcc,
+	0x52, 0xc1, 0xe8, 0xd9, 0x21, 0x7a, 0x74, 0xd9, 0x9f, 0xaf, 0xc5, 0xf1, 0xa4, 0x1e, 0x21, 0x9b,
+	0x0c, 0xc7, 0x4e, 0x45, 0xe9, 0xeb, 0xe4, 0x05, 0xa0, 0x5b, 0x56, 0x8b, 0x4e, 0x04, 0xfa, 0x24,
+	0xc6, 0x0f, 0x09, 0xb1, 0xec, 0x90, 0x1d, 0xe2, 0x50, 0xcb, 0x73, 0xe1, 0xe0, 0x3b, 0xef, 0x04,
+	0x87, 0x31, 0xe1, 0x30, 0x7f, 0x47, 0x83, 0x39, 0xca, 0x3e, 0x63, 0xef, 0xbd, 0xc8, 0x69, 0xf6,
+	0x3d, 0x98, 0x0b, 0x88, 0xef, 0xd8, 0x5d, 0xe7, 0xeb, 0xc4, 0x20, 0xf3, 0x85, 0x3a, 0x1b, 0x63,
+	0xe5, 0x61, 0xbe, 0x0d, 0x15, 0xa6, 0x92, 0x79, 0x9b, 0x08, 0xbf, 0x94, 0xaf, 0x58, 0x93, 0x0c,
+	0xb8, 0xc5, 0x61, 0xe6, 0x57, 0x30, 0x9f, 0x6a, 0x15, 0xce, 0xec, 0x4a, 0xda, 0xb1, 0x91, 0xb8,
+	0xef, 0x7f, 0x0f, 0xe6, 0xa2, 0xb9, 0x57, 0xab, 0x2a, 0xb0, 0xaa, 0x22, 0xc9, 0xd8, 0x92, 0xab,
+	0xfc, 0x31, 0x2c, 0xec, 0x51, 0xbf, 0x68, 0x70, 0x9c, 0x31, 0x16, 0x0f, 0x41, 0xcf, 0x15, 0xa6,
+	0xe9, 0x94, 0x28, 0x99, 0xcf, 0xc0, 0xc8, 0xe2, 0x85, 0x3d, 0xb8, 0x82, 0x7f, 0xe7, 0x37, 0x8b,
+	0x50, 0x6f, 0x9e, 0x12, 0xd2, 0xbf, 0xe2, 0x75, 0x42, 0xda, 0x6e, 0x2a, 0x5c, 0xcd, 0x6e, 0x1a,
+	0x6a, 0xe0, 0xdf, 0x80, 0x72, 0xcf, 0x71, 0x5b, 0x27, 0x76, 0x77, 0x40, 0xf0, 0xfc, 0x56, 0xea,
+	0x39, 0xee, 0x2b, 0xfa, 0xad, 0xef, 0xc0, 0xa4, 0xa4, 0xef, 0x84, 0xc5, 0xf2, 0xb6, 0xa4, 0x3d,
+	0x33, 0x3a, 0xb4, 0x2a, 0xeb, 0x4b, 0xa5, 0xbc, 0xf1, 0x1b, 0x30, 0x21, 0x21, 0xff, 0x4c, 0x75,
+	0xf3, 0x0c, 0x8c, 0x32, 0xe7, 0x20, 0x1b, 0x2c, 0xcd, 0xe2, 0x1f, 0xe6, 0xff, 0xd4, 0x60, 0x46,
+	0x6d, 0xf2, 0x95, 0xe7, 0x31, 0x47, 0x7e, 0x0a, 0x39, 0xf2, 0x73, 0xa1, 0x2a, 0x2a, 0x5e, 0x53,
+	0x15, 0x8d, 0xe4, 0xa8, 0x22, 0x73, 0x13, 0x16, 0xd6, 0x0e, 0x6c, 0xb7, 0xe3, 0xb9, 0x6f, 0xe6,
+	0x8f, 0xfc, 0x09, 0x18, 0x59, 0x7c, 0x70, 0xc0, 0x3e, 0x01, 0xc3, 0x27, 0x3d, 0xef, 0x44, 0x1d,
+	0x06, 0xc6, 0x90, 0xf0, 0x43, 0xd9, 0xa4, 0xd5, 0x40, 0x8a, 0x7d, 0x95, 0x33, 0x09, 0xcc, 0x5f,
+	0x6a, 0x50, 0x7d, 0x32, 0xe8, 0xf5, 0x37, 0x09, 0xb9, 0xec, 0x32, 0xc8, 0x6a, 0x79, 0x21, 0x7b,
+	0x86, 0x64, 0x35, 0x5a, 0x54, 0xd5, 0xa8, 0x64, 0x0b, 0x8d, 0xc8, 0xb6, 0x90, 0xf9, 0xdb, 0xd4,
+	0xdf, 0x2d, 0x5a, 0x74, 0x75, 0xa1, 0xb8, 0x38, 0x72, 0x09, 0xb5, 0x7d, 0x31, 0xd6, 0xf6, 0xd7,
+	0xd9, 0x33, 0xe8, 0x1d, 0x90, 0xcd, 0x6e, 0xd3, 0xe3, 0xad, 0xa3, 0xcc, 0x21, 0x9b, 0x84, 0xe8,
+	0xcb, 0x30, 0x81, 0x68, 0xc6, 0x88, 0xbb, 0xbb, 0xb0, 0x04, 0x2b, 0xff, 0x00, 0x6a, 0x7d, 0xbb,
+	0xfd, 0xda, 0x3e, 0x22, 0xad, 0x68, 0x88, 0xd0, 0xa5, 0x8d, 0xf0, 0x4d, 0x3e, 0x52, 0xe6, 0x3e,
+	0x2c, 0xaf, 0xfb, 0xc4, 0x0e, 0xc9, 0x9e, 0xed, 0x87, 0x8e, 0xdd, 0xcd, 0x10, 0xa6, 0xab, 0x6f,
+	0xcc, 0x66, 0x13, 0x56, 0xf2, 0xb9, 0xe2, 0xb0, 0x7f, 0x07, 0xea, 0x7d, 0x8e, 0xcd, 0xe0, 0xaa,
+	0xf7, 0x53, 0x05, 0x4d, 0x0b, 0x96, 0x5f, 0xf6, 0x3b, 0x43, 0x9b, 0x7a, 0x65, 0x9e, 0x4d, 0x58,
+	0xc9, 0xe7, 0x79, 0xdd, 0x86, 0xf6, 0x61, 0x91, 0xce, 0x65, 0x7e, 0x33, 0x2f, 0x5a, 0x04, 0x39,
+	0x35, 0x16, 0x72, 0x6b, 0xfc, 0xdb, 0x1a, 0x2c, 0xe5, 0x55, 0x79, 0xcd, 0x5e, 0xd0, 0x90, 0x8e,
+	0x21, 0x3b, 0xb2, 0x9e, 0xb1, 0x1f, 0xbf, 0x82, 0x5b, 0xeb, 0x5e, 0xef, 0xc0, 0x71, 0x33, 0x46,
+	0x33, 0x90, 0xa4, 0x29, 0xa3, 0x1d, 0x42, 0x97, 0xd4, 0xd3, 0x0d, 0x09, 0xcc, 0x97, 0x60, 0x0e,
+	0xe3, 0x7b, 0xdd, 0x69, 0xda, 0x87, 0x5b, 0x9b, 0x8e, 0xcb, 0xcc, 0x9d, 0x6f, 0x50, 0xa2, 0x7e,
+	0x5f, 0x03, 0x73, 0x18, 0xdb, 0xeb, 0x4e, 0x87, 0x01, 0xa5, 0xb6, 0xd7, 0xeb, 0x77, 0x49, 0x28,
+	0xee, 0x0d, 0xa3, 0xef, 0x9c, 0xbd, 0xaa, 0x98, 0x67, 0xeb, 0xfc, 0x6a, 0x04, 0xaa, 0x54, 0x5a,
+	0x1c, 0xf7, 0xa8, 0x49, 0x02, 0x76, 0xe6, 0xba, 0x82, 0x0e, 0x6c, 0xb0, 0xf0, 0x4a, 0xe6, 0xbf,
+	0xe5, 0xf6, 0xb5, 0xf8, 0xcc, 0xeb, 0x53, 0x31, 0xb7, 0x4f, 0x1f, 0xc0, 0x18, 0x9e, 0xf5, 0x79,
+	0x00, 0xd9, 0xb2, 0x6c, 0x72, 0x28, 0x0d, 0x14, 0x21, 0x9e, 0x9c, 0x5c, 0x19, 0x8c, 0xd1, 0x4b,
+	0x0d, 0xc6, 0x58, 0xce, 0x60, 0x18, 0xff, 0xbc, 0x20, 0x22, 0x20, 0x3f, 0x82, 0x85, 0x68, 0xf3,
+	0xce, 0x19, 0x8c, 0x79, 0x41, 0x90, 0xd8, 0xeb, 0xa8, 0x92, 0x4f, 0x6e, 0xfc, 0xf2, 0xe9, 0xae,
+	0xde, 0x57, 0xb6, 0xfc, 0xe1, 0xae, 0xbd, 0x9c, 0xa8, 0x91, 0x91, 0xdc, 0xa8, 0x91, 0x7b, 0x30,
+	0x85, 0x3d, 0x8e, 0x42, 0x45, 0x46, 0xd9, 0x6a, 0xaa, 0x70, 0x30, 0x06, 0x88, 0x50, 0x6f, 0xc2,
+	0xc0, 0x4d, 0x52, 0x8e, 0x31, 0xca, 0xa9, 0x81, 0xab, 0xd2, 0xce, 0xc0, 0xe8, 0x21, 0x95, 0x62,
+	0xb6, 0x6d, 0x94, 0x2c, 0xfe, 0x61, 0xfe, 0xa3, 0x22, 0xdc, 0xe0, 0x8a, 0x5d, 0x9d, 0x9e, 0xeb,
+	0xae, 0x16, 0xfd, 0x10, 0xf4, 0x1e, 0xc6, 0xab, 0x48, 0xfe, 0x13, 0x7e, 0x63, 0xf6, 0x81, 0x6c,
+	0x22, 0xe6, 0x57, 0xba, 0x2a, 0x02, 0x5e, 0x22, 0x3f, 0xca, 0x74, 0x2f, 0x86, 0x70, 0x8e, 0xb4,
+	0x61, 0x92, 0xd9, 0x18, 0xdd, 0xd0, 0xf3, 0x18, 0x07, 0x5d, 0x42, 0x89, 0x8b, 0x7a, 0xd5, 0xd1,
+	0x88, 0x16, 0x73, 0xe4, 0x68, 0xcc, 0xdb, 0x12, 0x47, 0x73, 0xb7, 0x44, 0xe3, 0xaf, 0x42, 0x2d,
+	0xd9, 0xd0, 0x6f, 0xd6, 0x6b, 0x60, 0x36, 0xe1, 0x66, 0xf6, 0x30, 0xa1, 0xca, 0xf9, 0x2e, 0x8c,
+	0x07, 0x1c, 0x84, 0x36, 0xf8, 0x42, 0xee, 0x72, 0xb3, 0x04, 0xa5, 0xb9, 0x0e, 0x73, 0x2a, 0x2a,
+	0xb8, 0x86, 0x8d, 0xb9, 0x07, 0xf3, 0x29, 0x26, 0xd8, 0xa8, 0xef, 0x41, 0x09, 0xab, 0x12, 0x3e,
+	0xfe, 0x21, 0xad, 0x8a, 0x48, 0xcd, 0x2f, 0xe0, 0x2e, 0x86, 0x60, 0x28, 0x14, 0xf1, 0xa2, 0xb8,
+	0xb6, 0xfe, 0xfe, 0x19, 0xdc, 0xbb, 0x88, 0xf3, 0x9b, 0x8c, 0xe7, 0x21, 0x2c, 0x50, 0x54, 0xf6,
+	0xf2, 0xf9, 0xe6, 0x8c, 0x63, 0x6a, 0x11, 0x18, 0x59, 0x15, 0xbd, 0x41, 0xdb, 0xaf, 0x61, 0x11,
+	0x6c, 0xc1, 0x4d, 0x3c, 0x5c, 0x64, 0x77, 0xf8, 0x0a, 0x32, 0xb4, 0x0c, 0x8b, 0x39, 0xac, 0xf0,
+	0x52, 0xed, 0xbf, 0x8c, 0xc0, 0x64, 0xf3, 0xd4, 0xee, 0xaf, 0x7b, 0x2e, 0xf3, 0x8b, 0x7e, 0xc3,
+	0x3e, 0xb9, 0x47, 0x30, 0xe2, 0x7b, 0x5d, 0x82, 0x81, 0x6a, 0x37, 0x95, 0xc3, 0x71, 0x5c, 0xe9,
+	0xaa, 0xe5, 0x75, 0x89, 0xc5, 0x28, 0xe5, 0xab, 0xbc, 0x11, 0xf5, 0x2a, 0x2f, 0xd6, 0xfc, 0xa3,
+	0x8a, 0xe6, 0x67, 0xdb, 0x1a, 0x67, 0x84, 0x1b, 0x56, 0xf4, 0x2d, 0xbb, 0x5b, 0xc7, 0x55, 0x77,
+	0x2b, 0x8b, 0xc5, 0x6c, 0x3b, 0x7d, 0x87, 0x1e, 0x08, 0x04, 0x0d, 0x7f, 0x31, 0x50, 0x8b, 0x10,
+	0x42, 0xaf, 0xdd, 0x85, 0xaa, 0x4f, 0x0e, 0x07, 0x6e, 0x27, 0xa2, 0x2c, 0x33, 0xca, 0x0a, 0x87,
+	0x0a, 0xb2, 0x65, 0x98, 0x08, 0x48, 0xdb, 0x27, 0x21, 0x1f, 0x36, 0xe0, 0xa2, 0xc8, 0x41, 0x6c,
+	0xc4, 0x6e, 0x40, 0x99, 0xdd, 0xf8, 0xb2, 0xfb, 0xa5, 0x09, 0xae, 0x1e, 0x29, 0x80, 0x5d, 0x2e,
+	0x51, 0x07, 0x30, 0x23, 0x6d, 0x4c, 0xa2, 0x03, 0x98, 0x7d, 0xc9, 0xa6, 0x43, 0x45, 0x35, 0x1d,
+	0x3e, 0x82, 0x85, 0xa0, 0x8f, 0x11, 0x21, 0xa9, 0x49, 0xab, 0xf2, 0xbd, 0x57, 0x10, 0x24, 0xf7,
+	0x5e, 0x03, 0x4a, 0x3c, 0x82, 0x8a, 0x74, 0x1a, 0x53, 0xdc, 0x18, 0x10, 0xdf, 0xe6, 0x87, 0x30,
+	0x42, 0x67, 0x44, 0xaf, 0x40, 0x79, 0x6b, 0x67, 0x6b, 0x7f, 0x6b, 0x6d, 0x7f, 0x97, 0xc6, 0xd5,
+	0x4f, 0xc1, 0xc4, 0xde, 0x9a, 0xb5, 0xbf, 0xb5, 0xbe, 0xb5, 0xb7, 0xb6, 0xb3, 0x5f, 0xd3, 0xa8,
+	0xf3, 0x72, 0x7d, 0xf7, 0x25, 0x0d, 0x10, 0xa4, 0xf0, 0x2f, 0x6b, 0x05, 0xea, 0x66, 0xab, 0x6f,
+	0xb9, 0x4e, 0xe8, 0x50, 0x85, 0x7a, 0x6a, 0xf7, 0xdf, 0x3c, 0xc6, 0x2e, 0x73, 0x9e, 0x8a, 0x39,
+	0xf3, 0x94, 0xf3, 0x9a, 0xc1, 0xfc, 0x9b, 0x30, 0xa3, 0xb6, 0x2a, 0x5a, 0xd0, 0xb1, 0xe8, 0xa4,
+	0x43, 0x57, 0x64, 0x11, 0x95, 0x64, 0xea, 0x5d, 0x98, 0x11, 0xff, 0x67, 0x1c, 0x34, 0xea, 0x02,
+	0x27, 0xab, 0xc7, 0x3f, 0xd2, 0x60, 0x8e, 0x99, 0xb5, 0x6d, 0xa7, 0xff, 0x97, 0x6a, 0x64, 0x92,
+	0x22, 0x3b, 0x9a, 0x14, 0x59, 0xf3, 0x37, 0x35, 0x98, 0x4f, 0x35, 0xfd, 0xcf, 0x79, 0xf8, 0x7e,
+	0x57, 0x83, 0x69, 0x1e, 0x49, 0x78, 0x95, 0x91, 0xbb, 0x82, 0x53, 0x24, 0xa9, 0xc9, 0x8a, 0x99,
+	0x57, 0xca, 0xb8, 0x3a, 0x47, 0xe4, 0xd5, 0x69, 0x7e, 0x0a, 0xba, 0xdc, 0xb4, 0x6b, 0xb8, 0x3e,
+	0x59, 0xe7, 0xa8, 0x1a, 0xf9, 0x0b, 0xeb, 0x1c, 0xef, 0x44, 0xdc, 0x84, 0xab, 0x77, 0xe2, 0x67,
+	0xd0, 0xd8, 0x38, 0x63, 0xf3, 0x46, 0x39, 0x34, 0xd9, 0xd0, 0x88, 0xae, 0x5c, 0xec, 0xc8, 0x4e,
+	0x08, 0x61, 0x21, 0x25, 0x84, 0xdf, 0x85, 0x85, 0x0c, 0xf6, 0xd8, 0xcc, 0x78, 0x66, 0x34, 0x65,
+	0x66, 0x7e, 0x03, 0x1a, 0x6b, 0x83, 0x8e, 0x13, 0x2a, 0x72, 0x88, 0x6d, 0x32, 0x12, 0x92, 0x3b,
+	0xf9, 0x46, 0x02, 0x4a, 0xed, 0xfe, 0x53, 0xf6, 0x46, 0x90, 0x87, 0xcf, 0xf2, 0x0f, 0x73, 0x0f,
+	0x16, 0x32, 0x1a, 0xf0, 0x06, 0x6b, 0xc7, 0x9c, 0x83, 0x19, 0x19, 0x13, 0x3d, 0x66, 0xd9, 0x81,
+	0xd9, 0x04, 0x3c, 0x32, 0x14, 0xcb, 0xa2, 0xb0, 0xb0, 0x14, 0x73, 0xab, 0x89, 0x29, 0xcd, 0xff,
+	0x51, 0x84, 0x51, 0x16, 0xbd, 0xf9, 0x0d, 0x9b, 0x03, 0x92, 0x32, 0x2b, 0xe6, 0x6d, 0xee, 0xaa,
+	0x7e, 0x8a, 0x6f, 0x45, 0x47, 0x95, 0x5b, 0x51, 0x69, 0x63, 0x1f, 0x53, 0x37, 0xf6, 0x65, 0x98,
+	0xa0, 0xc1, 0xd8, 0xea, 0xb6, 0x0f, 0xaf, 0xc9, 0xb9, 0x50, 0x85, 0xef, 0x8b, 0x4d, 0x98, 0x3e,
+	0x39, 0x2c, 0x31, 0xc3, 0x44, 0xb6, 0xe3, 0x58, 0xbf, 0x57, 0xb7, 0xe9, 0xae, 0x4c, 0x9f, 0x17,
+	0xf2, 0xfd, 0x99, 0xbe, 0x31, 0x5b, 0x04, 0x60, 0xe5, 0xf8, 0x75, 0x40, 0x99, 0xbb, 0x17, 0x29,
+	0x84, 0xdf, 0x07, 0x18, 0x50, 0x1a, 0xb8, 0x5d, 0x16, 0xfa, 0xca, 0x76, 0xfe, 0x92, 0x15, 0x7d,
+	0xcb, 0x5b, 0xf8, 0xc4, 0x15, 0xb6, 0xf0, 0xc9, 0xa1, 0x5b, 0xb8, 0xf9, 0x7d, 0x28, 0x89, 0x66,
+	0xd2, 0x20, 0xaa, 0xcf, 0x36, 0xb6, 0x9e, 0x7d, 0x46, 0x2f, 0x24, 0x4b, 0x30, 0xb2, 0xbf, 0xf5,
+	0x82, 0x3e, 0x73, 0x03, 0x18, 0x7b, 0xb2, 0xbd, 0xbb, 0xfe, 0xbc, 0x59, 0x2b, 0xd0, 0x7b, 0xc5,
+	0xe6, 0xc6, 0xfa, 0xee, 0xce, 0xd3, 0x66, 0xad, 0x68, 0xfe, 0x7b, 0x0d, 0x74, 0x7e, 0xec, 0x61,
+	0xdd, 0x7d, 0xf3, 0xcd, 0x28, 0xef, 0x58, 0xae, 0x0c, 0xf6, 0xc8, 0x75, 0x07, 0x7b, 0x34, 0x31,
+	0xd8, 0xe6, 0xcf, 0xa1, 0xae, 0x34, 0x1f, 0xc5, 0xfd, 0x1e, 0x8c, 0xb2, 0x50, 0x64, 0x5c, 0x51,
+	0xb5, 0x64, 0x4d, 0x16, 0x47, 0xd3, 0xad, 0x93, 0xfd, 0x93, 0xb1, 0xbe, 0x6b, 0x0c, 0x21, 0xef,
+	0x3e, 0x53, 0x50, 0x61, 0x85, 0xa3, 0xd5, 0xf6, 0x11, 0x54, 0x05, 0x00, 0xeb, 0x7d, 0x00, 0x63,
+	0x18, 0x02, 0xcd, 0xd7, 0x58, 0xba, 0x62, 0xc4, 0x9b, 0xbf, 0x5b, 0x84, 0xb9, 0xbd, 0x81, 0xdf,
+	0x3e, 0xb6, 0x03, 0x82, 0x51, 0xd7, 0x6f, 0x3e, 0xf8, 0x54, 0x7f, 0x52, 0x19, 0x69, 0x75, 0x9d,
+	0x9e, 0x23, 0x66, 0x00, 0x18, 0x68, 0x9b, 0x42, 0x86, 0xdc, 0x7f, 0x71, 0x1b, 0x3b, 0xe7, 0xfe,
+	0xeb, 0x2e, 0x54, 0x31, 0x38, 0x56, 0x7d, 0x0b, 0x5a, 0xe1, 0x50, 0xc9, 0xec, 0x75, 0x07, 0xbd,
+	0x28, 0xb6, 0x1c, 0x1d, 0xeb, 0xee, 0xa0, 0x87, 0x1d, 0x64, 0x21, 0xfe, 0x9e, 0xd7, 0x4d, 0xac,
+	0xc9, 0x09, 0x0a, 0x13, 0x3c, 0x44, 0x88, 0xec, 0x21, 0x21, 0xdc, 0x0c, 0xd7, 0x78, 0x88, 0xec,
+	0x26, 0x21, 0x81, 0x14, 0x8b, 0x51, 0x56, 0x62, 0x31, 0x66, 0x61, 0x2c, 0x3c, 0xa3, 0x45, 0x30,
+	0xa2, 0x74, 0x34, 0x3c, 0xa3, 0x8e, 0xfe, 0x45, 0x00, 0x6c, 0x36, 0x45, 0x4d, 0x88, 0xb8, 0x4b,
+	0x0a, 0xd9, 0x24, 0xca, 0x35, 0x87, 0x12, 0xf2, 0x61, 0xfe, 0xbf, 0x11, 0x98, 0x4f, 0xcd, 0x0d,
+	0xce, 0x30, 0x8d, 0x23, 0xe4, 0x3c, 0x95, 0x5b, 0x1c, 0x0c, 0x1e, 0xe6, 0x37, 0x37, 0xfa, 0x53,
+	0x18, 0x67, 0x2e, 0x2b, 0x72, 0xca, 0x66, 0x48, 0xbd, 0x0d, 0xcc, 0xe1, 0xcc, 0x23, 0xef, 0xc8,
+	0xa9, 0x25, 0x8a, 0x1a, 0x7f, 0x5a, 0x84, 0x71, 0x04, 0xd2, 0x9b, 0xa4, 0xc8, 0x65, 0x12, 0xf4,
+	0xbb, 0x4e, 0x98, 0x71, 0x1e, 0x6f, 0x08, 0x8a, 0x26, 0x25, 0x90, 0xb7, 0xa5, 0x1f, 0x83, 0x89,
+	0x85, 0x2e, 0xbe, 0xef, 0x5f, 0x62, 0x94, 0xfb, 0xb9, 0x37, 0x6d, 0x3f, 0x80, 0x1b, 0x9c, 0x57,
+	0xf6, 0xb5, 0x0c, 0x57, 0xe7, 0x0d, 0x46, 0xb2, 0x91, 0x71, 0x37, 0x43, 0x5f, 0x92, 0xb0, 0xe2,
+	0x87, 0x24, 0xba, 0x4a, 0x65, 0x00, 0x3a, 0x23, 0x77, 0xa0, 0x1a, 0x21, 0xf9, 0xb5, 0x0b, 0x5f,
+	0xf0, 0x93, 0x82, 0x82, 0x5d, 0x4f, 0x5d, 0x46, 0xcc, 0x94, 0x58, 0xee, 0xf1, 0x74, 0x2c, 0xb7,
+	0x2a, 0x1a, 0xa5, 0xa4, 0x68, 0xdc, 0x83, 0xa9, 0x18, 0xcd, 0x5b, 0xc2, 0xf5, 0x7c, 0x25, 0xa2,
+	0x61, 0x4d, 0x51, 0x6e, 0xa7, 0xb0, 0x04, 0x1b, 0x06, 0x48, 0xdc, 0x4e, 0xf1, 0xa6, 0xb1, 0x11,
+	0x58, 0x80, 0x92, 0x90, 0x70, 0xb1, 0x09, 0xa0, 0x80, 0x9b, 0xef, 0xd3, 0x07, 0x8d, 0x34, 0x8a,
+	0xfa, 0x6a, 0x1a, 0x81, 0x3f, 0x57, 0x54, 0xca, 0xe1, 0xa9, 0x7e, 0x09, 0x6e, 0x6e, 0x7b, 0x76,
+	0x67, 0x8d, 0xbd, 0xbf, 0xa5, 0x6f, 0xac, 0x37, 0x9d, 0x6e, 0x48, 0xfc, 0x48, 0x83, 0x2d, 0xc3,
+	0x62, 0x0e, 0x1e, 0x19, 0x34, 0x60, 0x6e, 0x9b, 0x6d, 0x5d, 0x91, 0x33, 0x50, 0x14, 0xfd, 0x67,
+	0x05, 0x98, 0x4f, 0xa1, 0xe2, 0x10, 0x54, 0x7c, 0x13, 0x12, 0x7b, 0x25, 0xd3, 0x21, 0xa8, 0x39,
+	0xa5, 0x13, 0x70, 0xf1, 0x38, 0x25, 0xa2, 0x33, 0xfe, 0x40, 0x83, 0xaa, 0x4a, 0xf3, 0x67, 0x1f,
+	0x40, 0xc4, 0x5f, 0x46, 0xd9, 0x01, 0x3e, 0xb3, 0x29, 0x5b, 0xf8, 0x45, 0xf5, 0x01, 0x57, 0x42,
+	0x22, 0x48, 0x9c, 0x3f, 0xa6, 0x98, 0xe4, 0x40, 0x8c, 0x3e, 0xff, 0x43, 0x0d, 0xea, 0xb4, 0xc5,
+	0x51, 0x9f, 0xae, 0xec, 0xc0, 0xf9, 0x0b, 0x69, 0xf6, 0x1c, 0xcc, 0xa8, 0xad, 0x46, 0xa1, 0x38,
+	0x87, 0xd9, 0x97, 0x6e, 0x57, 0xc1, 0xfc, 0x39, 0xf5, 0x87, 0xca, 0x63, 0xb2, 0x6a, 0x6c, 0xd4,
+	0x53, 0x98, 0x97, 0x54, 0x1e, 0x4b, 0x2c, 0x70, 0x0d, 0x3f, 0xd9, 0x23, 0x68, 0xa4, 0xb9, 0xc4,
+	0x2f, 0xbb, 0x78, 0x0e, 0x03, 0x4d, 0xca, 0x27, 0x61, 0xbe, 0x0f, 0x4b, 0x4d, 0x62, 0xfb, 0xed,
+	0xe3, 0x64, 0xb9, 0x68, 0xf5, 0xce, 0xc0, 0xe8, 0x57, 0x03, 0xe2, 0x9f, 0x8b, 0x72, 0xec, 0xc3,
+	0xfc, 0x13, 0x0d, 0x96, 0x73, 0x0b, 0x62, 0x8d, 0x4d, 0x18, 0x63, 0x95, 0x88, 0xd5, 0xf3, 0xb1,
+	0x6c, 0xb2, 0x0f, 0x2f, 0xbb, 0x9a, 0xea, 0x06, 0xb2, 0x32, 0x9a, 0x50, 0x4b, 0xe2, 0xae, 0x32,
+	0x71, 0xd1, 0x28, 0x14, 0xe4, 0x51, 0xf8, 0x19, 0x18, 0x4d, 0x12, 0x26, 0xf9, 0x5e, 0x43, 0x2e,
+	0xb2, 0xd9, 0x2f, 0xc2, 0x8d, 0x4c, 0xf6, 0x38, 0xf7, 0x73, 0x30, 0xb3, 0x26, 0x25, 0x94, 0x88,
+	0x74, 0xd4, 0x3f, 0xd1, 0x60, 0x36, 0x81, 0xc0, 0x91, 0xdd, 0x48, 0x8c, 0xac, 0x1c, 0x60, 0x9c,
+	0x59, 0x42, 0x81, 0x46, 0x63, 0xf9, 0x43, 0x98, 0x94, 0xe1, 0x43, 0xa2, 0x3a, 0xb3, 0xfb, 0xf5,
+	0x19, 0xcc, 0x35, 0x49, 0x28, 0xb3, 0x90, 0x5f, 0x32, 0x5c, 0x85, 0xd3, 0x02, 0xcc, 0xa7, 0x38,
+	0xe1, 0xe8, 0x4c, 0x41, 0x65, 0x8f, 0x06, 0x81, 0x46, 0xc3, 0xf2, 0x3b, 0x34, 0xec, 0x1e, 0x21,
+	0x38, 0x1e, 0x1f, 0xc0, 0x18, 0x0b, 0x14, 0x15, 0xe3, 0x21, 0xdf, 0x25, 0xaa, 0xa4, 0xfc, 0xd3,
+	0x42, 0x72, 0x63, 0x0b, 0x46, 0xf7, 0x44, 0x84, 0xa9, 0x94, 0xb5, 0x81, 0xfd, 0x2f, 0x77, 0xa2,
+	0xa0, 0x76, 0x82, 0x52, 0x7b, 0x18, 0x79, 0x42, 0xa9, 0xbd, 0x90, 0x98, 0x4d, 0x98, 0x6a, 0x92,
+	0x90, 0xb3, 0xc7, 0x51, 0x78, 0x73, 0xa6, 0xf4, 0x71, 0x56, 0xc4, 0x14, 0x07, 0xe4, 0x01, 0xf5,
+	0x72, 0xd0, 0xa0, 0x9b, 0x8b, 0xea, 0x32, 0x67, 0xa1, 0xae, 0x50, 0x22, 0x83, 0x9b, 0x60, 0xbc,
+	0x4c, 0x3f, 0x38, 0x13, 0xc3, 0xfb, 0xdb, 0x45, 0xb8, 0x91, 0x89, 0xc6, 0xb1, 0xfe, 0x59, 0x22,
+	0xfd, 0x07, 0x1f, 0xf1, 0xef, 0x4b, 0x23, 0x3e, 0xa4, 0x74, 0x06, 0x4e, 0x4d, 0x0d, 0x62, 0xfc,
+	0xb2, 0x00, 0x7a, 0x9a, 0xe8, 0x2a, 0x6b, 0x70, 0x11, 0xe0, 0xd0, 0xf1, 0x83, 0xb0, 0x15, 0x10,
+	0xe2, 0x8a, 0xd7, 0xaa, 0x0c, 0xd2, 0x24, 0x84, 0x3d, 0x06, 0xee, 0xda, 0x02, 0x5b, 0x44, 0xe7,
+	0xb5, 0x8d, 0xc8, 0x87, 0xa0, 0x1f, 0xf8, 0x9e, 0xdd, 0x69, 0x53, 0x0a, 0x3b, 0x0c, 0x49, 0xaf,
+	0x1f, 0x8a, 0xd3, 0xc5, 0x74, 0x84, 0x59, 0x43, 0x04, 0xdd, 0x06, 0xba, 0x12, 0xa5, 0x78, 0x6b,
+	0xd1, 0x8d, 0x69, 0xd8, 0x09, 0x90, 0x92, 0x10, 0xdf, 0xf7, 0x7c, 0x3c, 0xe4, 0xb3, 0x06, 0x6c,
+	0x50, 0x00, 0xe5, 0xe0, 0x92, 0xb3, 0x98, 0x03, 0x1a, 0x7b, 0x14, 0x86, 0x1c, 0xcc, 0xff, 0xa4,
+	0x81, 0x81, 0x2b, 0x23, 0x63, 0xbe, 0x86, 0x2c, 0xb5, 0xa1, 0xef, 0x38, 0x47, 0xb3, 0xdf, 0x71,
+	0xe6, 0xbc, 0xcd, 0x2c, 0xe6, 0xbd, 0xcd, 0x7c, 0x0b, 0x6a, 0x3d, 0xfb, 0xac, 0x95, 0x48, 0x08,
+	0xc3, 0x72, 0x04, 0xf5, 0xec, 0x33, 0x25, 0x92, 0xe3, 0x57, 0x1a, 0xdc, 0xc8, 0xec, 0xc7, 0x5f,
+	0xfa, 0xa7, 0x98, 0x6f, 0x41, 0xfd, 0x89, 0xdd, 0x7e, 0x3d, 0xe8, 0x7f, 0xce, 0x8a, 0x4a, 0x4b,
+	0xae, 0x6f, 0x87, 0xc7, 0x62, 0xc9, 0xd1, 0xff, 0xa9, 0x2e, 0x57, 0x49, 0x71, 0xcd, 0xbd, 0x4b,
+	0x1f, 0xb5, 0x91, 0xf6, 0x6b, 0x1a, 0xee, 0xe8, 0x04, 0x21, 0x71, 0xdb, 0xd1, 0xc3, 0x7d, 0x66,
+	0xe4, 0xf4, 0x6d, 0x87, 0x3f, 0xee, 0x2e, 0x59, 0xf8, 0x65, 0xfe, 0xef, 0x22, 0x34, 0xd2, 0x65,
+	0x70, 0xb0, 0x96, 0x00, 0xda, 0x02, 0x1c, 0x62, 0x41, 0x09, 0xa2, 0x3f, 0x83, 0x52, 0xdf, 0xf7,
+	0x0e, 0xba, 0xa4, 0x27, 0x3a, 0xfe, 0x8e, 0xf2, 0x5c, 0x2e, 0x9b, 0xed, 0xea, 0x1e, 0x2f, 0x63,
+	0x45, 0x85, 0x69, 0xeb, 0x98, 0x24, 0x88, 0xcc, 0x15, 0xf8, 0xc5, 0x8e, 0x20, 0x67, 0xf4, 0x3d,
+	0xae, 0xe7, 0x77, 0xc4, 0x94, 0x97, 0xc3, 0x33, 0x8b, 0x03, 0xd0, 0x55, 0xc4, 0xf2, 0x70, 0xf1,
+	0x57, 0x9a, 0xe2, 0x93, 0x32, 0xc4, 0xf4, 0x5e, 0xfc, 0xe8, 0x83, 0x5f, 0xb4, 0xc4, 0xc0, 0x0d,
+	0xfa, 0xc4, 0xe5, 0x8b, 0xa0, 0x62, 0x89, 0x4f, 0x8e, 0x61, 0xb3, 0x22, 0x1e, 0x6b, 0xe2, 0x27,
+	0xc5, 0x88, 0x73, 0x14, 0x3e, 0xd6, 0xc4, 0x4f, 0xea, 0xc6, 0x8a, 0x52, 0xe0, 0xf0, 0xd3, 0x4c,
+	0xf4, 0x4d, 0xdf, 0x33, 0xe2, 0x12, 0x21, 0x01, 0x3b, 0xc3, 0x54, 0xac, 0x18, 0x60, 0x7c, 0x45,
+	0x8f, 0xad, 0xac, 0xf3, 0x74, 0xaf, 0x6a, 0xd3, 0x91, 0x12, 0xa6, 0x0f, 0xfb, 0xa0, 0x8e, 0xe0,
+	0x0e, 0xe1, 0xfe, 0x3b, 0xe1, 0x6f, 0x29, 0x5b, 0x32, 0x88, 0x36, 0xeb, 0xd0, 0x39, 0x63, 0x8f,
+	0xe5, 0xb9, 0x27, 0x55, 0x7c, 0xf2, 0xc8, 0x8a, 0x33, 0xd2, 0xc1, 0xc8, 0x44, 0xfe, 0x61, 0xde,
+	0x82, 0x65, 0x49, 0xde, 0x76, 0xbc, 0xd0, 0x39, 0x74, 0xda, 0xb6, 0xa2, 0x95, 0xff, 0x7b, 0x01,
+	0x56, 0xf2, 0x69, 0x50, 0x28, 0x7e, 0x04, 0x53, 0x76, 0x18, 0xda, 0xed, 0x63, 0xfa, 0x9c, 0x9d,
+	0x4f, 0x5a, 0xda, 0x59, 0xaa, 0x2c, 0x9f, 0xaa, 0xa0, 0x7f, 0xc2, 0x67, 0xf5, 0x3e, 0x4c, 0x75,
+	0x88, 0xca, 0xa1, 0xc0, 0x3c, 0x04, 0xd5, 0x0e, 0x51, 0x08, 0xf3, 0x16, 0x59, 0xf1, 0xba, 0x8b,
+	0x8c, 0xfb, 0x08, 0x52, 0x1c, 0x85, 0x9f, 0x62, 0x84, 0xb5, 0xa2, 0x91, 0x2e, 0x88, 0x3e, 0x8b,
+	0x4f, 0xc0, 0xc0, 0x77, 0xba, 0x59, 0xa5, 0x79, 0x44, 0x4c, 0x03, 0x29, 0x52, 0xa5, 0xa9, 0x81,
+	0x26, 0x12, 0x33, 0x65, 0x0d, 0xfe, 0xff, 0xd5, 0xe0, 0x66, 0x36, 0xfe, 0x4a, 0xe9, 0x55, 0x2e,
+	0x93, 0x35, 0x26, 0x3b, 0x3d, 0x51, 0xf1, 0x4a, 0xe9, 0x89, 0x46, 0xae, 0x94, 0x9e, 0x68, 0x34,
+	0x27, 0x3d, 0xd1, 0xaf, 0xc3, 0x8a, 0xec, 0x46, 0xcb, 0x1a, 0x18, 0xba, 0x97, 0x86, 0x67, 0xaa,
+	0x2b, 0xa9, 0x14, 0x9e, 0xe1, 0x94, 0x2c, 0x02, 0x04, 0xa1, 0xd7, 0x6f, 0xd9, 0x87, 0x21, 0xf1,
+	0x71, 0xcf, 0x29, 0x53, 0xc8, 0x1a, 0x05, 0x98, 0xff, 0xb2, 0x00, 0xb7, 0x86, 0x54, 0x80, 0x23,
+	0xfb, 0x3a, 0xf9, 0x42, 0x99, 0x0b, 0xf4, 0x86, 0x1a, 0x41, 0x3e, 0x9c, 0x89, 0x2c, 0x82, 0x32,
+	0x71, 0x90, 0x78, 0xe8, 0x6c, 0xfc, 0x9e, 0x06, 0x8d, 0x3c, 0x5a, 0xea, 0x6f, 0xc3, 0xbe, 0x8a,
+	0x0b, 0x1a, 0xde, 0xd3, 0xf4, 0x23, 0xea, 0x42, 0xd6, 0x23, 0x6a, 0xf5, 0xb1, 0x76, 0xf1, 0xa2,
+	0xc7, 0xda, 0x23, 0xe9, 0x47, 0xe0, 0xbf, 0xa5, 0x09, 0x6f, 0xb1, 0xba, 0x0d, 0xbd, 0x03, 0xd3,
+	0x98, 0x33, 0x26, 0xe5, 0x65, 0xa9, 0x71, 0x84, 0xf4, 0xc0, 0xf9, 0x21, 0xe8, 0x22, 0xd7, 0x4b,
+	0xea, 0x2d, 0xf4, 0x34, 0x62, 0x24, 0x72, 0x1d, 0x46, 0x02, 0x42, 0x3a, 0xd8, 0x5e, 0xf6, 0x3f,
+	0xdd, 0xe2, 0xd4, 0x66, 0xe0, 0x16, 0xf7, 0x23, 0x98, 0xde, 0xed, 0x13, 0xf7, 0xfa, 0x8d, 0xa3,
+	0x29, 0x0f, 0x64, 0x0e, 0xc8, 0x77, 0x06, 0xf4, 0xf5, 0xae, 0x17, 0xa8, 0xbd, 0xa6, 0xb6, 0xad,
+	0x02, 0x45, 0xe2, 0x59, 0xa8, 0x73, 0xc8, 0xc6, 0x99, 0x13, 0xc4, 0xee, 0x9e, 0x55, 0x98, 0x51,
+	0xc1, 0xf1, 0xa5, 0x1b, 0x61, 0x10, 0xb1, 0xf7, 0xf2, 0x2f, 0xf3, 0x1f, 0x6b, 0xd0, 0x68, 0x86,
+	0xb6, 0x1f, 0xd2, 0x4d, 0x92, 0xb8, 0xc1, 0x20, 0xb0, 0xfa, 0x6d, 0xd1, 0xa7, 0xfb, 0x30, 0x85,
+	0x19, 0xe2, 0x12, 0xd9, 0x5e, 0xaa, 0x08, 0x16, 0xde, 0x60, 0x7a, 0x97, 0x12, 0x10, 0x5f, 0x5a,
+	0xeb, 0xd1, 0x37, 0xc5, 0xd1, 0x11, 0x39, 0xf5, 0x7c, 0x31, 0xba, 0xd1, 0x37, 0xdd, 0x61, 0xda,
+	0xc4, 0x47, 0x49, 0x26, 0x78, 0x53, 0x2b, 0x83, 0xcc, 0x1b, 0xb0, 0x90, 0xd1, 0x3c, 0x1c, 0x83,
+	0x13, 0x68, 0x3c, 0x75, 0x82, 0xb6, 0x77, 0x42, 0xfc, 0x35, 0xb1, 0xad, 0x49, 0xf3, 0xd1, 0x41,
+	0x5c, 0x4b, 0xca, 0x11, 0xc7, 0x9e, 0xe2, 0x0b, 0x04, 0xaa, 0xba, 0xe0, 0x8a, 0xc2, 0x42, 0x1b,
+	0x95, 0x51, 0x2f, 0x36, 0xea, 0x1e, 0xdc, 0xa1, 0x39, 0x12, 0xda, 0xbe, 0x73, 0x40, 0xf6, 0x3d,
+	0xb6, 0x8b, 0x64, 0xea, 0xda, 0xfb, 0x70, 0xf7, 0x02, 0xba, 0x78, 0xa6, 0x37, 0x49, 0xd8, 0x3e,
+	0xe6, 0x39, 0x06, 0xa2, 0xf2, 0xff, 0xb4, 0x00, 0x33, 0x2a, 0x1c, 0xa7, 0xfa, 0x31, 0xcc, 0x1e,
+	0x52, 0x38, 0xe9, 0x60, 0xa6, 0x82, 0xa0, 0x25, 0x3f, 0x51, 0xae, 0x23, 0x12, 0x8b, 0xad, 0x63,
+	0x34, 0xe6, 0x0c, 0x3f, 0x4a, 0xd0, 0xa4, 0x00, 0xa9, 0x4c, 0x78, 0xd3, 0x0c, 0xb7, 0x43, 0x4e,
+	0xe3, 0xd4, 0x26, 0xdf, 0x85, 0xb9, 0x54, 0x01, 0xd9, 0x82, 0xae, 0xab, 0x45, 0x18, 0x4a, 0xff,
+	0x10, 0x16, 0x7a, 0xb6, 0xc3, 0xde, 0x0e, 0x3b, 0x6e, 0x2b, 0x74, 0xfa, 0x72, 0x55, 0x7c, 0xf2,
+	0x67, 0x29, 0xc1, 0x3a, 0xc5, 0xef, 0x3b, 0xfd, 0xb8, 0xba, 0x4f, 0xe0, 0x46, 0x76, 0x49, 0xd9,
+	0x2b, 0x36, 0x9f, 0x2e, 0xcb, 0x15, 0xca, 0x27, 0xb0, 0x80, 0x09, 0x76, 0x88, 0x65, 0xbb, 0x1d,
+	0xaf, 0xd7, 0x24, 0xa4, 0x23, 0x04, 0x85, 0x5d, 0x66, 0x93, 0x4e, 0xab, 0x4b, 0xdc, 0x23, 0xb4,
+	0x71, 0x2b, 0x16, 0x50, 0xd0, 0x36, 0x83, 0x98, 0x7f, 0x0d, 0x8c, 0xac, 0xd2, 0x71, 0x6e, 0x0a,
+	0x56, 0xfc, 0xe0, 0x3c, 0x24, 0x81, 0xc8, 0x4d, 0x41, 0x21, 0x4f, 0x28, 0x80, 0x7a, 0x91, 0x19,
+	0xfa, 0x18, 0x7d, 0x67, 0x65, 0x1a, 0x68, 0x46, 0x07, 0xff, 0x8c, 0xfa, 0xf6, 0x18, 0xaa, 0xe7,
+	0x92, 0x9e, 0xe7, 0x3a, 0x6d, 0x3c, 0x0f, 0x4f, 0x52, 0xe0, 0x0b, 0x84, 0x99, 0x8f, 0x61, 0xfa,
+	0x29, 0x69, 0x7b, 0x1d, 0x22, 0x37, 0x79, 0x11, 0x80, 0x2e, 0x2f, 0x1e, 0xa0, 0x86, 0x4b, 0xb2,
+	0x4c, 0x21, 0x2c, 0x2c, 0xcd, 0xfc, 0x00, 0x74, 0xb9, 0x4c, 0x9c, 0x39, 0xa5, 0xc3, 0xa0, 0x9d,
+	0x16, 0xd3, 0x74, 0x78, 0xaf, 0x8f, 0x30, 0x4a, 0x6a, 0xfe, 0xbd, 0x22, 0xcc, 0xb2, 0xd5, 0xb6,
+	0x36, 0x08, 0xbd, 0x27, 0x83, 0x73, 0xe2, 0x5f, 0xd2, 0xb3, 0x3d, 0xe4, 0xae, 0x6b, 0x15, 0xea,
+	0x98, 0xa5, 0xb0, 0x15, 0x7a, 0x2d, 0x3a, 0x43, 0xa1, 0xed, 0x88, 0xf3, 0xe8, 0x34, 0xa2, 0xf6,
+	0xbd, 0x17, 0x88, 0xd0, 0x6f, 0x43, 0x95, 0x9e, 0xb3, 0x52, 0x61, 0xa9, 0x13, 0x3d, 0xfb, 0x6c,
+	0x53, 0x44, 0xa6, 0x7e, 0x1b, 0x74, 0x4a, 0xc4, 0xae, 0x0d, 0x5a, 0xe2, 0x1d, 0x2f, 0x93, 0x02,
+	0xcd, 0xa2, 0xc7, 0x34, 0xcc, 0x19, 0xc3, 0xe1, 0x2a, 0xb5, 0x7d, 0x10, 0x78, 0xdd, 0x41, 0xf4,
+	0x26, 0x31, 0xa2, 0x5e, 0x43, 0x38, 0x4b, 0x49, 0x8c, 0x39, 0x95, 0x94, 0xeb, 0xaf, 0x0a, 0x87,
+	0x0a, 0x95, 0x97, 0xbc, 0x23, 0x2b, 0x5d, 0x70, 0x47, 0x56, 0x4e, 0xdc, 0x91, 0x99, 0x50, 0x61,
+	0x8d, 0x22, 0x3e, 0x17, 0xe5, 0x06, 0x44, 0xdd, 0xdc, 0x23, 0x3e, 0x93, 0x5e, 0xea, 0x45, 0x4d,
+	0x4e, 0x47, 0xec, 0x49, 0x6b, 0x52, 0x03, 0x23, 0x31, 0x4f, 0xf4, 0x86, 0x21, 0x01, 0xc7, 0x02,
+	0x06, 0x34, 0xf8, 0xa5, 0x03, 0x03, 0xb3, 0x0d, 0x3f, 0x4a, 0x22, 0xfa, 0xf7, 0xc7, 0x60, 0x21,
+	0x03, 0x29, 0xe5, 0x70, 0xcb, 0xce, 0xd5, 0x71, 0x07, 0xaa, 0xf6, 0xc9, 0x11, 0x8e, 0x6b, 0xcf,
+	0xeb, 0x08, 0xdd, 0x3f, 0x69, 0x9f, 0x1c, 0xb1, 0x31, 0x7d, 0xe1, 0x75, 0x08, 0x15, 0x80, 0x88,
+	0xea, 0xd5, 0xe7, 0x6b, 0x7b, 0xad, 0x0e, 0xe9, 0x86, 0xb6, 0x10, 0x00, 0x41, 0x4a, 0x31, 0x4f,
+	0x29, 0x22, 0x4f, 0x60, 0x46, 0xf2, 0x04, 0xc6, 0x84, 0x0a, 0x37, 0xe0, 0x29, 0xb9, 0x7d, 0x72,
+	0x24, 0x7c, 0x13, 0x1c, 0xb8, 0xef, 0xad, 0x9d, 0x1c, 0xe9, 0xef, 0xc2, 0x6c, 0xc7, 0x73, 0xc3,
+	0xd6, 0xa9, 0x4d, 0x2f, 0xad, 0x3c, 0x5f, 0xb9, 0x94, 0x2a, 0x59, 0x3a, 0x45, 0x7e, 0x6e, 0x3b,
+	0xe1, 0xa6, 0xe7, 0x4b, 0x97, 0x53, 0xe8, 0x79, 0xe7, 0xed, 0x45, 0x7f, 0x05, 0x87, 0xf1, 0x96,
+	0x2e, 0xf2, 0xe8, 0x69, 0xfe, 0x74, 0x11, 0x05, 0xa0, 0x7c, 0x48, 0x48, 0x93, 0x01, 0xa8, 0xd8,
+	0x51, 0x34, 0xa6, 0x33, 0x09, 0xda, 0x76, 0x97, 0xe6, 0xb7, 0xe6, 0x72, 0x50, 0x3b, 0x24, 0x64,
+	0x9f, 0x21, 0x9a, 0x1c, 0x4e, 0xad, 0x2e, 0xfa, 0x76, 0x31, 0xbe, 0x1c, 0x1d, 0xeb, 0x39, 0x2e,
+	0x5e, 0x7f, 0xe2, 0x82, 0x68, 0x4c, 0x22, 0x82, 0xad, 0x84, 0xb4, 0x04, 0x55, 0x52, 0x12, 0x94,
+	0x23, 0xfa, 0xd5, 0x1c, 0xd1, 0xcf, 0x5e, 0x56, 0x53, 0x39, 0xcb, 0xea, 0x0e, 0x5f, 0xa9, 0x4e,
+	0x94, 0xe3, 0xa8, 0x31, 0xcd, 0x6f, 0x01, 0x7b, 0xf6, 0xd9, 0x96, 0xc8, 0x70, 0x94, 0x5a, 0x27,
+	0xfa, 0x05, 0xeb, 0xa4, 0x9e, 0x58, 0x27, 0xef, 0xc3, 0x7c, 0xd0, 0xf7, 0x89, 0x1d, 0xdd, 0xdb,
+	0xf5, 0xf1, 0x5e, 0x36, 0x68, 0xcc, 0xb0, 0xc9, 0x9b, 0xe5, 0x68, 0x4c, 0x16, 0x25, 0x90, 0x19,
+	0xcb, 0x78, 0x36, 0x6b, 0x19, 0xc7, 0x57, 0xd2, 0x73, 0xd2, 0x95, 0xb4, 0xf9, 0x10, 0xa6, 0xa9,
+	0x9b, 0x56, 0x7d, 0xdc, 0x9a, 0xbb, 0x12, 0xa8, 0xe5, 0x26, 0x93, 0xe3, 0x9a, 0x7b, 0xc1, 0xbc,
+	0xe1, 0x4f, 0x92, 0x12, 0x2b, 0x65, 0x2b, 0xcb, 0x12, 0x74, 0x2d, 0x47, 0xd0, 0xe9, 0x25, 0x61,
+	0x36, 0x3b, 0xac, 0xee, 0x03, 0xe6, 0x42, 0x7d, 0xc1, 0x84, 0x43, 0xd4, 0x91, 0xd6, 0xa6, 0x5a,
+	0x4a, 0x9b, 0x9a, 0x75, 0x98, 0x96, 0x0a, 0x22, 0xb7, 0x1f, 0xb3, 0x9b, 0x82, 0x17, 0x89, 0x49,
+	0x17, 0x7c, 0xb3, 0x25, 0x45, 0xcb, 0x96, 0x14, 0xbc, 0x16, 0x48, 0xf3, 0xca, 0xac, 0x4a, 0x48,
+	0x63, 0x66, 0x55, 0x91, 0x08, 0x6b, 0xd9, 0x22, 0x9c, 0xa8, 0x2a, 0xe6, 0x15, 0x99, 0xee, 0xd4,
+	0xfd, 0xfe, 0x4a, 0x16, 0x01, 0x29, 0xa5, 0x4b, 0x42, 0x60, 0xb4, 0x0c, 0x81, 0xa1, 0x8a, 0x34,
+	0xcd, 0x01, 0xb9, 0x7f, 0x04, 0xb3, 0xd4, 0x89, 0x1d, 0x8b, 0xb6, 0x94, 0x7d, 0x56, 0x59, 0x04,
+	0x5a, 0x6a, 0x11, 0x30, 0x5d, 0x9f, 0x28, 0x1b, 0x79, 0xd4, 0x74, 0xc4, 0x6c, 0xc6, 0x97, 0x03,
+	0xea, 0xa2, 0xd1, 0xd4, 0x45, 0x43, 0x4d, 0x46, 0xa5, 0x08, 0x72, 0xfa, 0x18, 0x66, 0x71, 0x70,
+	0x50, 0x3f, 0x08, 0x66, 0x29, 0x55, 0xa2, 0x65, 0x6f, 0x46, 0x89, 0xc2, 0x71, 0xd2, 0xe9, 0xb5,
+	0x23, 0xe2, 0x76, 0xec, 0xc8, 0x36, 0xfd, 0xe3, 0x22, 0x4c, 0x45, 0xa0, 0x78, 0x1f, 0x11, 0x09,
+	0x31, 0x70, 0xf5, 0xe0, 0xa7, 0xfe, 0x31, 0x8c, 0xdb, 0x9c, 0x18, 0x3d, 0x78, 0xb7, 0xe4, 0x5b,
+	0x1e, 0x95, 0x0d, 0x7e, 0x5b, 0xa2, 0x84, 0xf1, 0x27, 0x1a, 0x8c, 0x71, 0x98, 0x5e, 0x85, 0x82,
+	0xd3, 0xc1, 0xb1, 0x2d, 0x38, 0x9d, 0x4b, 0xf8, 0xaf, 0x74, 0x18, 0xe9, 0xd9, 0xc1, 0x6b, 0x74,
+	0x3b, 0xb0, 0xff, 0x69, 0x6b, 0xda, 0xc7, 0x9e, 0xd3, 0x26, 0xe2, 0xbd, 0xd6, 0xb0, 0xd6, 0xac,
+	0x33, 0x4a, 0x4b, 0x94, 0xe0, 0xae, 0x00, 0xdb, 0x0f, 0xe5, 0x8c, 0x44, 0x65, 0x06, 0x61, 0x21,
+	0xe3, 0xcb, 0xc0, 0x37, 0x10, 0xcc, 0x58, 0xc4, 0x4d, 0x10, 0xe0, 0x20, 0x4a, 0x60, 0xfc, 0x42,
+	0x83, 0x31, 0xce, 0xf3, 0x7a, 0xbd, 0xc1, 0x5f, 0x13, 0x60, 0xbd, 0xa1, 0xff, 0xd3, 0x06, 0x39,
+	0x01, 0x5d, 0x36, 0xd1, 0x26, 0x5a, 0xb2, 0xca, 0x4e, 0xb0, 0xc6, 0x01, 0x7a, 0x1d, 0x46, 0x9d,
+	0xa0, 0xe5, 0x7a, 0xf8, 0xbe, 0x6c, 0xc4, 0x09, 0x76, 0x3c, 0xaa, 0xcd, 0x5e, 0x79, 0x21, 0xe1,
+	0xed, 0x88, 0xe6, 0xf4, 0x0f, 0x0a, 0x50, 0x57, 0xc0, 0x17, 0xce, 0xeb, 0xa7, 0xf1, 0x48, 0xf2,
+	0x79, 0xbd, 0x2b, 0x87, 0x59, 0xa5, 0x59, 0xa5, 0x46, 0xd3, 0x80, 0xd2, 0x89, 0x17, 0x12, 0xa9,
+	0x53, 0xd1, 0xb7, 0xf1, 0xfb, 0xf1, 0x48, 0xdd, 0x80, 0x32, 0x97, 0x86, 0x56, 0x34, 0x60, 0x25,
+	0x0e, 0xd8, 0xea, 0xd0, 0xa3, 0x1d, 0x22, 0xd3, 0xa3, 0x37, 0xcd, 0x31, 0x4f, 0x63, 0x04, 0xe5,
+	0xc5, 0x6b, 0xa7, 0xbc, 0xb8, 0x41, 0x5e, 0xe2, 0x00, 0xce, 0x0b, 0x91, 0x32, 0x2f, 0x7e, 0x63,
+	0x3f, 0xcd, 0x31, 0x12, 0x2f, 0x76, 0xad, 0xc9, 0x75, 0x45, 0x62, 0x2c, 0xf5, 0xb5, 0x78, 0x64,
+	0xb8, 0x9b, 0xe7, 0xbe, 0x72, 0x63, 0x9c, 0x51, 0x24, 0x39, 0x36, 0xc6, 0x93, 0xcb, 0x75, 0x5f,
+	0xe9, 0x4f, 0x41, 0xed, 0x8f, 0xf9, 0x1e, 0xcc, 0x25, 0x2b, 0xc3, 0x49, 0x95, 0x47, 0x5e, 0x53,
+	0x47, 0xfe, 0xb1, 0x15, 0xfd, 0xb2, 0x47, 0x93, 0xf8, 0x27, 0xb4, 0x05, 0x3f, 0x82, 0x71, 0x84,
+	0xe8, 0x4a, 0xb0, 0xa0, 0xf2, 0xfb, 0x1f, 0x86, 0x91, 0x85, 0xe2, 0xf5, 0x3d, 0xfe, 0x3b, 0xab,
+	0x50, 0xe1, 0x7e, 0x0b, 0xc1, 0xf3, 0x03, 0x18, 0xa1, 0x99, 0xed, 0xf5, 0x39, 0xf9, 0x86, 0x33,
+	0xce, 0x7c, 0x6f, 0xcc, 0xa7, 0xe0, 0x91, 0x6f, 0x78, 0x1c, 0x33, 0xd8, 0x2b, 0x8d, 0x51, 0xd3,
+	0xe2, 0x1b, 0x46, 0x16, 0x0a, 0x39, 0x58, 0x50, 0x51, 0xb2, 0xd7, 0xeb, 0xcb, 0xe9, 0xa4, 0xf2,
+	0x4a, 0x4a, 0x7c, 0x63, 0x25, 0x9f, 0x00, 0x79, 0xae, 0x43, 0x29, 0xf2, 0x36, 0x18, 0x99, 0x39,
+	0xea, 0x39, 0xa7, 0x1b, 0x43, 0xf2, 0xd7, 0xd3, 0xae, 0x89, 0xec, 0xee, 0x72, 0xd7, 0xd4, 0x5c,
+	0xba, 0x86, 0x91, 0x85, 0x42, 0x0e, 0x2f, 0xa1, 0xaa, 0x26, 0x08, 0xd5, 0xe5, 0xa6, 0x67, 0xa6,
+	0x7d, 0x35, 0x6e, 0x0d, 0xa1, 0x40, 0xb6, 0x3f, 0x81, 0x29, 0x15, 0x13, 0xe8, 0xf9, 0xa5, 0xa2,
+	0xbe, 0x9a, 0xc3, 0x48, 0x38, 0xe7, 0x47, 0x9a, 0xbe, 0x0d, 0x13, 0xfb, 0x72, 0x44, 0x98, 0x54,
+	0x28, 0x9d, 0x36, 0xd4, 0x58, 0xca, 0x43, 0x47, 0x77, 0x6f, 0xe5, 0x28, 0xdf, 0xa7, 0x2e, 0x0f,
+	0x76, 0x32, 0x35, 0xa8, 0x71, 0x33, 0x1b, 0x19, 0xf3, 0x89, 0xf2, 0x54, 0x2a, 0x7c, 0x92, 0x49,
+	0x31, 0x8d, 0x9b, 0xd9, 0x48, 0xe4, 0xf3, 0x05, 0x4c, 0x25, 0xe2, 0xab, 0x94, 0x91, 0xcb, 0x0e,
+	0xea, 0x32, 0xcc, 0x61, 0x24, 0xc8, 0xf9, 0xa7, 0x19, 0xf1, 0x23, 0x66, 0xf6, 0x75, 0x85, 0x1c,
+	0xd1, 0x60, 0xdc, 0x1e, 0x4a, 0x83, 0xcc, 0xfb, 0x30, 0x9f, 0x13, 0xd8, 0xa2, 0xbf, 0x75, 0x99,
+	0xe0, 0x17, 0x5e, 0xd5, 0xdb, 0x97, 0x8f, 0x93, 0x61, 0x8b, 0x52, 0x0e, 0xf8, 0x50, 0x17, 0x65,
+	0x46, 0x54, 0x89, 0xb1, 0x92, 0x4f, 0x80, 0x3c, 0x7f, 0x00, 0x63, 0x3c, 0x68, 0x42, 0x6f, 0x64,
+	0xc4, 0x51, 0x70, 0x2e, 0x0b, 0xb9, 0x11, 0x16, 0x7a, 0x07, 0xea, 0x19, 0x11, 0x00, 0xfa, 0xdd,
+	0x8b, 0x22, 0x04, 0x38, 0xe3, 0x7b, 0x97, 0x0b, 0x24, 0xd0, 0x0f, 0xa1, 0x9e, 0x71, 0x99, 0xac,
+	0xd4, 0x92, 0x7f, 0x69, 0x6e, 0xdc, 0xbb, 0x88, 0x2c, 0x5a, 0x67, 0x03, 0xe5, 0x4a, 0x40, 0x71,
+	0x45, 0xea, 0x6f, 0x67, 0xcb, 0x44, 0x96, 0x5f, 0xd3, 0x78, 0xe7, 0x52, 0xb4, 0x51, 0xb5, 0x4e,
+	0xfc, 0x2b, 0x23, 0x4a, 0x95, 0xf7, 0x32, 0x54, 0x6a, 0x56, 0x75, 0xf7, 0x2f, 0xa4, 0x8b, 0xaa,
+	0xfa, 0x1a, 0x16, 0x72, 0xaf, 0x50, 0xf4, 0x77, 0x2e, 0x77, 0xd1, 0xc2, 0x2b, 0xfd, 0xf6, 0x55,
+	0x6e, 0x65, 0x1e, 0x68, 0x8f, 0x34, 0xba, 0x1a, 0x93, 0x89, 0x52, 0x95, 0xd5, 0x98, 0x93, 0xd7,
+	0xd5, 0xb8, 0x3d, 0x94, 0x26, 0x5e, 0x1b, 0xca, 0xcf, 0x60, 0x28, 0x6b, 0x23, 0xeb, 0xa7, 0x37,
+	0x8c, 0x95, 0x7c, 0x82, 0x28, 0x4f, 0xf7, 0x18, 0xff, 0x35, 0x0c, 0x65, 0x6d, 0x28, 0x3f, 0xaa,
+	0x61, 0x2c, 0x64, 0x60, 0x64, 0xbd, 0x2d, 0xfd, 0x6c, 0x85, 0xa2, 0xb7, 0xd3, 0xbf, 0x93, 0x61,
+	0x2c, 0xe5, 0xa1, 0xe3, 0xb5, 0x96, 0xf1, 0xb3, 0x0f, 0xca, 0x2a, 0xc8, 0xff, 0x95, 0x0b, 0xe3,
+	0xde, 0x45, 0x64, 0x58, 0x8b, 0x68, 0xb3, 0xf8, 0x91, 0x82, 0xa1, 0x3f, 0xd4, 0x60, 0x2c, 0xe5,
+	0xa1, 0x63, 0x0d, 0x9c, 0xfc, 0x45, 0x00, 0x65, 0xce, 0x73, 0x7e, 0xe0, 0xc0, 0xb8, 0x3d, 0x94,
+	0x06, 0x99, 0xef, 0xc2, 0xa4, 0x9c, 0x9e, 0x5f, 0x5f, 0x4a, 0x15, 0x52, 0x7e, 0x6a, 0xc0, 0x58,
+	0xce, 0xc5, 0xc7, 0x3b, 0x51, 0x22, 0xd9, 0xac, 0xb2, 0x13, 0x65, 0x67, 0xf2, 0x35, 0xcc, 0x61,
+	0x24, 0xc8, 0xf9, 0x08, 0x66, 0xb2, 0x92, 0x5f, 0x29, 0x4b, 0x7c, 0x48, 0x76, 0x2c, 0xe3, 0xfe,
+	0x85, 0x74, 0x71, 0x17, 0x12, 0xd9, 0xd9, 0x94, 0x2e, 0x64, 0xe7, 0x93, 0x33, 0xcc, 0x61, 0x24,
+	0xc8, 0xd9, 0x06, 0x3d, 0x9d, 0x38, 0x4d, 0xbf, 0xa3, 0x3c, 0x17, 0xc8, 0xc9, 0xd1, 0x66, 0xdc,
+	0xbd, 0x80, 0x2a, 0x9e, 0x50, 0x39, 0x9b, 0x97, 0x32, 0xa1, 0x19, 0x99, 0xc9, 0x8c, 0xe5, 0x5c,
+	0x7c, 0xdc, 0xe6, 0x74, 0xce, 0x2b, 0xa5, 0xcd, 0xb9, 0xa9, 0xb5, 0x8c, 0xbb, 0x17, 0x50, 0x49,
+	0x06, 0x29, 0xcf, 0x33, 0xa5, 0x1a, 0xa4, 0x4a, 0x36, 0x2c, 0xc3, 0xc8, 0x42, 0x21, 0x87, 0x00,
+	0x1a, 0x79, 0x39, 0x94, 0x94, 0x5d, 0xe7, 0x82, 0xf4, 0x4d, 0xc6, 0x3b, 0x97, 0xa2, 0x8d, 0x2b,
+	0xcd, 0xcb, 0x87, 0xa4, 0x54, 0x7a, 0x41, 0x22, 0x26, 0xe3, 0x9d, 0x4b, 0xd1, 0x62, 0xa5, 0x3d,
+	0x9e, 0x63, 0x22, 0xa3, 0xca, 0x07, 0x09, 0x01, 0xcc, 0xaf, 0xf0, 0xad, 0x4b, 0x50, 0x62, 0x75,
+	0xe7, 0x60, 0xe4, 0xa7, 0x13, 0xd2, 0xd5, 0x0d, 0xec, 0x82, 0x6c, 0x46, 0xc6, 0xc3, 0x4b, 0x52,
+	0xc7, 0x55, 0xe7, 0xe7, 0x06, 0x52, 0xaa, 0xbe, 0x30, 0x33, 0x91, 0xf1, 0xf0, 0x92, 0xd4, 0x92,
+	0xaa, 0xc9, 0xc8, 0x0e, 0xa2, 0xaa, 0x9a, 0xfc, 0x2c, 0x2b, 0xc6, 0xfd, 0x0b, 0xe9, 0x54, 0x55,
+	0x13, 0x63, 0x82, 0x94, 0xaa, 0x49, 0x67, 0x13, 0x31, 0xcc, 0x61, 0x24, 0xc8, 0xf9, 0xb7, 0x34,
+	0x58, 0x1a, 0x9e, 0x9b, 0x43, 0x7f, 0x94, 0xd6, 0xe5, 0xc3, 0x13, 0x84, 0x18, 0xef, 0x5e, 0xa1,
+	0x44, 0xac, 0x3e, 0xd2, 0xa9, 0x35, 0x14, 0xf5, 0x91, 0x9b, 0xe2, 0x43, 0x51, 0x1f, 0x43, 0xf2,
+	0x73, 0xfc, 0x1c, 0x66, 0x33, 0xb3, 0x5d, 0xe8, 0xf7, 0xd3, 0xea, 0x27, 0xbb, 0xa2, 0x07, 0x17,
+	0x13, 0x4a, 0xfb, 0xa5, 0x94, 0x52, 0x40, 0xdd, 0x2f, 0xd3, 0x19, 0x10, 0x8c, 0xe5, 0x5c, 0x7c,
+	0x2c, 0x01, 0x89, 0x77, 0xf6, 0x8a, 0x04, 0x64, 0xa7, 0x0f, 0x30, 0xcc, 0x61, 0x24, 0xc8, 0x79,
+	0x0b, 0x20, 0x7e, 0xa2, 0xae, 0xdf, 0x54, 0x8c, 0xac, 0xc4, 0xa3, 0x7a, 0x63, 0x31, 0x07, 0x2b,
+	0xb3, 0x12, 0x0f, 0xc5, 0x13, 0xac, 0x12, 0x4f, 0xd8, 0x8d, 0xc5, 0x1c, 0x2c, 0xb2, 0xfa, 0x75,
+	0x98, 0x4e, 0xbd, 0xe9, 0xd6, 0x65, 0x53, 0x25, 0xef, 0x41, 0xb9, 0x71, 0x67, 0x38, 0x51, 0xcc,
+	0x3f, 0xf5, 0xfa, 0x5a, 0xe1, 0x9f, 0xf7, 0x38, 0xdc, 0xb8, 0x33, 0x9c, 0x28, 0x36, 0x92, 0x65,
+	0xb8, 0x7a, 0x80, 0xcc, 0x7a, 0xa5, 0x6d, 0xac, 0xe4, 0x13, 0xc4, 0xf6, 0xa2, 0xf4, 0xac, 0x55,
+	0xb1, 0x17, 0xd3, 0xaf, 0x75, 0x8d, 0xa5, 0x3c, 0x74, 0x7c, 0x1c, 0x65, 0x00, 0xf5, 0x38, 0xaa,
+	0xbc, 0x65, 0x35, 0x16, 0x32, 0x30, 0x92, 0x40, 0xaa, 0x6f, 0x16, 0x55, 0x81, 0xcc, 0x7c, 0xc5,
+	0x6a, 0x98, 0xc3, 0x48, 0xe4, 0xf3, 0x85, 0xf4, 0x6e, 0x2d, 0x71, 0xbe, 0x48, 0xbf, 0x84, 0x33,
+	0x56, 0xf2, 0x09, 0xe2, 0xb5, 0x9f, 0xf9, 0xa4, 0x4d, 0x59, 0xfb, 0xc3, 0x1e, 0xc5, 0x19, 0x0f,
+	0x2e, 0x26, 0x8c, 0xd7, 0xbe, 0xfc, 0x40, 0x4a, 0x59, 0xfb, 0x19, 0xef, 0xbd, 0x8c, 0xe5, 0x5c,
+	0x7c, 0xec, 0x46, 0x53, 0x9f, 0x37, 0x29, 0x6e, 0xb4, 0xcc, 0x47, 0x57, 0xc6, 0xad, 0x21, 0x14,
+	0xf1, 0x21, 0x27, 0xe3, 0xf9, 0x8c, 0x72, 0xc8, 0xc9, 0x7f, 0xbd, 0x63, 0xdc, 0xbb, 0x88, 0x4c,
+	0xda, 0xba, 0xd4, 0x27, 0x28, 0xea, 0xd6, 0x95, 0xf9, 0xd0, 0xc5, 0x30, 0x87, 0x91, 0xc4, 0x4e,
+	0x4e, 0xf1, 0x88, 0x43, 0x71, 0x72, 0x26, 0x9e, 0x8b, 0x18, 0x37, 0x32, 0x71, 0xf1, 0x9a, 0x92,
+	0xde, 0x72, 0xe8, 0xaa, 0x56, 0x4a, 0xbe, 0x06, 0x31, 0x96, 0xf2, 0xd0, 0xf1, 0xd4, 0xcb, 0x61,
+	0xea, 0xca, 0xd4, 0x67, 0x84, 0xba, 0x1b, 0xcb, 0xb9, 0xf8, 0xf8, 0x50, 0x97, 0x0c, 0x2a, 0x4f,
+	0x1c, 0xe4, 0x33, 0x83, 0xdf, 0x8d, 0xdb, 0x43, 0x69, 0xd0, 0x0d, 0xfe, 0xbf, 0x46, 0x45, 0x54,
+	0x1f, 0x15, 0x68, 0xe2, 0x0b, 0x67, 0xf8, 0x2e, 0x4c, 0xca, 0x51, 0x7d, 0x4a, 0x2f, 0x32, 0xa2,
+	0x00, 0x8d, 0xe5, 0x5c, 0x7c, 0x3c, 0x2c, 0x72, 0x68, 0xa3, 0x9e, 0x56, 0x4d, 0xf9, 0xc3, 0x92,
+	0x15, 0x13, 0x49, 0x37, 0x9a, 0x38, 0xa2, 0x51, 0xd9, 0x68, 0x52, 0xa1, 0x92, 0xc6, 0x62, 0x0e,
+	0x56, 0x52, 0xaa, 0x71, 0xc0, 0xa3, 0xaa, 0x54, 0x53, 0xe1, 0x91, 0xc6, 0x52, 0x1e, 0x3a, 0xde,
+	0x56, 0x52, 0x01, 0x84, 0xca, 0xb6, 0x92, 0x17, 0xfd, 0x68, 0xdc, 0x19, 0x4e, 0x14, 0xf3, 0x4f,
+	0xc5, 0x02, 0x2a, 0xfc, 0xf3, 0x22, 0x14, 0x8d, 0x3b, 0xc3, 0x89, 0x90, 0xff, 0x2f, 0x34, 0x58,
+	0x1c, 0x1a, 0x27, 0xa8, 0xcb, 0xbf, 0xc0, 0x70, 0x99, 0xc8, 0x43, 0xe3, 0xd1, 0xe5, 0x0b, 0xc4,
+	0xe2, 0x22, 0x87, 0x1a, 0x2a, 0xe2, 0x92, 0x11, 0x9b, 0x68, 0x2c, 0xe7, 0xe2, 0x51, 0xd0, 0xff,
+	0x43, 0x09, 0x74, 0x29, 0xe4, 0x48, 0xc8, 0xf9, 0x4b, 0xa8, 0xaa, 0x01, 0x4f, 0x8a, 0x5e, 0xcd,
+	0x0c, 0x4d, 0x33, 0x6e, 0x0d, 0xa1, 0x90, 0xb6, 0x7e, 0x39, 0x2a, 0x4a, 0xdd, 0xfa, 0x33, 0xe2,
+	0xa8, 0x8c, 0x95, 0x7c, 0x82, 0x78, 0xde, 0x53, 0x31, 0x53, 0xca, 0xbc, 0xe7, 0x85, 0x5b, 0x19,
+	0x77, 0x86, 0x13, 0xc5, 0x0b, 0x2a, 0x0e, 0x29, 0x51, 0x16, 0x54, 0x2a, 0x30, 0xc5, 0x58, 0xcc,
+	0xc1, 0xc6, 0x87, 0xa2, 0xac, 0xc0, 0x11, 0x3d, 0xb1, 0x61, 0xe4, 0x05, 0xaa, 0x18, 0xf7, 0x2f,
+	0xa4, 0x93, 0x2e, 0x57, 0x44, 0x20, 0x89, 0x9e, 0x50, 0xf2, 0x4a, 0x5c, 0x8a, 0x71, 0x33, 0x1b,
+	0xa9, 0xec, 0x83, 0xc9, 0x78, 0x91, 0xe4, 0x3e, 0x98, 0x13, 0x9b, 0x62, 0xdc, 0xbb, 0x88, 0x2c,
+	0xb3, 0x96, 0x38, 0xfe, 0x2f, 0xbb, 0x78, 0x22, 0x2c, 0xc5, 0xb8, 0x77, 0x11, 0x59, 0xbc, 0x5f,
+	0x24, 0xe3, 0x45, 0x74, 0x33, 0x75, 0xdb, 0x9b, 0x0a, 0x47, 0x31, 0x6e, 0x0f, 0xa5, 0x89, 0xed,
+	0x10, 0x35, 0x68, 0x44, 0x5d, 0x2f, 0x59, 0xb1, 0x28, 0xc6, 0xad, 0x21, 0x14, 0xb1, 0x06, 0x96,
+	0xc2, 0x47, 0xf4, 0xc5, 0x74, 0x09, 0x29, 0x12, 0xc5, 0x58, 0xca, 0x43, 0x2b, 0x8d, 0x94, 0x02,
+	0x47, 0x92, 0x8d, 0x4c, 0x07, 0xa4, 0x18, 0xb7, 0x86, 0x50, 0xa0, 0x0a, 0xf9, 0x63, 0x8d, 0xb6,
+	0x92, 0x74, 0x84, 0xee, 0xb0, 0x41, 0x4f, 0x87, 0xe9, 0x2a, 0xe7, 0xd5, 0xdc, 0x18, 0x60, 0xe3,
+	0xee, 0x05, 0x54, 0xf1, 0x9a, 0x8c, 0x03, 0x6b, 0x95, 0x35, 0x99, 0x8a, 0xd1, 0x35, 0x16, 0x73,
+	0xb0, 0xd8, 0xfa, 0xbf, 0x02, 0x15, 0x1e, 0x4b, 0x22, 0xdd, 0xa1, 0x73, 0x40, 0xa0, 0xb8, 0xd2,
+	0xd4, 0xc0, 0x1a, 0xc3, 0xc8, 0x42, 0x21, 0xcb, 0x3f, 0xd4, 0xa0, 0xc2, 0xc5, 0x44, 0xf0, 0xdc,
+	0x86, 0x09, 0xe9, 0x72, 0x5f, 0x99, 0xc7, 0x74, 0x84, 0x81, 0xb1, 0x94, 0x87, 0x56, 0xe6, 0x51,
+	0x66, 0xb8, 0x72, 0x51, 0xd4, 0x82, 0x71, 0x6b, 0x08, 0x05, 0x67, 0x7b, 0x30, 0xd6, 0xf7, 0xbd,
+	0xd0, 0xfb, 0xee, 0xff, 0x1f, 0x00, 0x22, 0x64, 0xd8, 0xa7, 0x47, 0x84, 0x00, 0x00,
 }
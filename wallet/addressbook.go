@@ -0,0 +1,127 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"github.com/abcsuite/abcutil"
+	"github.com/abcsuite/abcwallet/apperrors"
+	"github.com/abcsuite/abcwallet/wallet/udb"
+	"github.com/abcsuite/abcwallet/walletdb"
+)
+
+// SetAddressLabel attaches a label to an address controlled by the wallet,
+// replacing any previous label.  Setting an empty label removes the label from
+// the address.
+func (w *Wallet) SetAddressLabel(addr abcutil.Address, label string) error {
+	return walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		if label != "" {
+			addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+			_, err := w.Manager.Address(addrmgrNs, addr)
+			if err != nil {
+				return err
+			}
+		}
+		return udb.PutAddressLabel(dbtx, addr.EncodeAddress(), label)
+	})
+}
+
+// AddressLabel returns the label of a wallet address, or the empty string if
+// the address is not labeled.
+func (w *Wallet) AddressLabel(addr abcutil.Address) (string, error) {
+	var label string
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		label = udb.FetchAddressLabel(dbtx, addr.EncodeAddress())
+		return nil
+	})
+	return label, err
+}
+
+// AddressLabels returns the labels of all labeled wallet addresses.
+func (w *Wallet) AddressLabels() ([]udb.AddressLabel, error) {
+	var labels []udb.AddressLabel
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		labels, err = udb.AddressLabels(dbtx)
+		return err
+	})
+	return labels, err
+}
+
+// SetPayee saves a named external address with an optional note to the
+// wallet's address book, replacing any previous payee with the same name.
+func (w *Wallet) SetPayee(name string, addr abcutil.Address, note string) error {
+	if !addr.IsForNet(w.chainParams) {
+		const str = "payee address is for the wrong network"
+		return apperrors.E{ErrorCode: apperrors.ErrWrongNet, Description: str, Err: nil}
+	}
+	p := &udb.Payee{
+		Name:    name,
+		Address: addr.EncodeAddress(),
+		Note:    note,
+	}
+	return walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return udb.PutPayee(dbtx, p)
+	})
+}
+
+// RemovePayee removes a named payee from the wallet's address book.
+func (w *Wallet) RemovePayee(name string) error {
+	return walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return udb.DeletePayee(dbtx, name)
+	})
+}
+
+// Payee returns the payee saved in the wallet's address book with a name.
+func (w *Wallet) Payee(name string) (*udb.Payee, error) {
+	var p *udb.Payee
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		p, err = udb.FetchPayee(dbtx, name)
+		return err
+	})
+	return p, err
+}
+
+// Payees returns all payees saved in the wallet's address book.
+func (w *Wallet) Payees() ([]udb.Payee, error) {
+	var all []udb.Payee
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		all, err = udb.Payees(dbtx)
+		return err
+	})
+	return all, err
+}
+
+// PayeeNames returns the names of all saved payees with an address.
+func (w *Wallet) PayeeNames(addr abcutil.Address) ([]string, error) {
+	all, err := w.Payees()
+	if err != nil {
+		return nil, err
+	}
+	encoded := addr.EncodeAddress()
+	var names []string
+	for i := range all {
+		if all[i].Address == encoded {
+			names = append(names, all[i].Name)
+		}
+	}
+	return names, nil
+}
+
+// CheckPayeeAddress verifies that addr is the address saved for the named
+// payee.  This should be used before sending to a payee to detect addresses
+// that have been mistyped or tampered with.
+func (w *Wallet) CheckPayeeAddress(name string, addr abcutil.Address) error {
+	p, err := w.Payee(name)
+	if err != nil {
+		return err
+	}
+	if p.Address != addr.EncodeAddress() {
+		const str = "address does not match the saved payee address"
+		return apperrors.E{ErrorCode: apperrors.ErrInput, Description: str, Err: nil}
+	}
+	return nil
+}
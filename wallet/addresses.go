@@ -74,7 +74,7 @@ func WithGapPolicyWrap() NextAddressCallOption {
 }
 
 type addressBuffer struct {
-	branchXpub *hdkeychain.ExtendedKey
+	branchKeys *branchKeys
 	lastUsed   uint32
 	// cursor is added to lastUsed to derive child index
 	// warning: this is not decremented after errors, and therefore may refer
@@ -202,7 +202,7 @@ func (w *Wallet) nextAddress(persist persistReturnedChildFunc, account, branch u
 				if chainClient == nil {
 					break
 				}
-				addrs, err := deriveChildAddresses(alb.branchXpub,
+				addrs, err := alb.branchKeys.deriveChildAddresses(
 					alb.lastUsed+1+alb.cursor, gapLimit, w.chainParams)
 				if err != nil {
 					return nil, err
@@ -223,11 +223,7 @@ func (w *Wallet) nextAddress(persist persistReturnedChildFunc, account, branch u
 			err := apperrors.E{ErrorCode: apperrors.ErrExhaustedAccount, Description: str, Err: nil}
 			return nil, err
 		}
-		child, err := alb.branchXpub.Child(childIndex)
-		if err != nil {
-			return nil, err
-		}
-		addr, err := child.Address(w.chainParams)
+		addr, err := alb.branchKeys.deriveChildAddress(childIndex, w.chainParams)
 		switch err {
 		case nil:
 			// Write the returned child index to the database.
@@ -350,8 +346,8 @@ func (w *Wallet) watchFutureAddresses(dbtx walletdb.ReadTx) error {
 			childInt = a.albInternal.lastUsed + 1 + a.albInternal.cursor
 		}
 
-		xpubBranchExt := a.albExternal.branchXpub
-		xpubBranchInt := a.albInternal.branchXpub
+		keysExt := a.albExternal.branchKeys
+		keysInt := a.albInternal.branchKeys
 
 		addrs := make([]abcutil.Address, 0, deriveExt+deriveInt)
 		for i := uint32(0); i < deriveExt; i, childExt = i+1, childExt+1 {
@@ -359,7 +355,7 @@ func (w *Wallet) watchFutureAddresses(dbtx walletdb.ReadTx) error {
 			if childExt >= hdkeychain.HardenedKeyStart {
 				break
 			}
-			childXpub, err := xpubBranchExt.Child(childExt)
+			addr, err := keysExt.deriveChildAddress(childExt, w.chainParams)
 			switch err {
 			case nil:
 			case hdkeychain.ErrInvalidChild:
@@ -368,10 +364,6 @@ func (w *Wallet) watchFutureAddresses(dbtx walletdb.ReadTx) error {
 			default:
 				return err
 			}
-			addr, err := childXpub.Address(w.chainParams)
-			if err != nil {
-				return err
-			}
 			addrs = append(addrs, addr)
 		}
 		for i := uint32(0); i < deriveInt; i, childInt = i+1, childInt+1 {
@@ -379,7 +371,7 @@ func (w *Wallet) watchFutureAddresses(dbtx walletdb.ReadTx) error {
 			if childInt >= hdkeychain.HardenedKeyStart {
 				break
 			}
-			childXpub, err := xpubBranchInt.Child(childInt)
+			addr, err := keysInt.deriveChildAddress(childInt, w.chainParams)
 			switch err {
 			case nil:
 			case hdkeychain.ErrInvalidChild:
@@ -387,10 +379,6 @@ func (w *Wallet) watchFutureAddresses(dbtx walletdb.ReadTx) error {
 			default:
 				return err
 			}
-			addr, err := childXpub.Address(w.chainParams)
-			if err != nil {
-				return err
-			}
 			addrs = append(addrs, addr)
 		}
 
@@ -458,8 +446,8 @@ func (w *Wallet) BIP0044BranchNextIndexes(account uint32) (extChild, intChild ui
 // generated address for the branch.
 func (w *Wallet) ExtendWatchedAddresses(account, branch, child uint32) error {
 	var (
-		branchXpub *hdkeychain.ExtendedKey
-		lastUsed   uint32
+		keys     *branchKeys
+		lastUsed uint32
 	)
 	err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
 		defer w.addressBuffersMu.Unlock()
@@ -481,7 +469,7 @@ func (w *Wallet) ExtendWatchedAddresses(account, branch, child uint32) error {
 			return apperrors.E{ErrorCode: apperrors.ErrBranch, Description: str, Err: nil}
 		}
 
-		branchXpub = alb.branchXpub
+		keys = alb.branchKeys
 		lastUsed = alb.lastUsed
 
 		if child < lastUsed+uint32(w.gapLimit) {
@@ -502,7 +490,7 @@ func (w *Wallet) ExtendWatchedAddresses(account, branch, child uint32) error {
 			return nil
 		}
 		additionalAddrs := child - lastWatched
-		addrs, err := deriveChildAddresses(branchXpub, lastUsed+1+gapLimit,
+		addrs, err := keys.deriveChildAddresses(lastUsed+1+gapLimit,
 			additionalAddrs, w.chainParams)
 		if err != nil {
 			return err
@@ -543,7 +531,7 @@ func (w *Wallet) AccountBranchAddressRange(account, branch, start, end uint32) (
 		const str = "unknown branch"
 		return nil, apperrors.E{ErrorCode: apperrors.ErrBranch, Description: str, Err: nil}
 	}
-	return deriveChildAddresses(buf.branchXpub, start, end-start, w.chainParams)
+	return buf.branchKeys.deriveChildAddresses(start, end-start, w.chainParams)
 }
 
 func (w *Wallet) changeSource(persist persistReturnedChildFunc, account uint32) txauthor.ChangeSource {
@@ -557,6 +545,60 @@ func (w *Wallet) changeSource(persist persistReturnedChildFunc, account uint32)
 	}
 }
 
+// peekChangeAddress returns the address that the next call to newChangeAddress
+// is expected to return for an account.  The address buffer is not advanced and
+// no child index is persisted, so this is suitable for previewing transactions
+// without modifying the wallet.
+func (w *Wallet) peekChangeAddress(account uint32) (abcutil.Address, error) {
+	if account == udb.ImportedAddrAccount {
+		account = udb.DefaultAccountNum
+	}
+
+	w.addressBuffersMu.Lock()
+	ad, ok := w.addressBuffers[account]
+	var alb addressBuffer
+	if ok {
+		alb = ad.albInternal
+	}
+	w.addressBuffersMu.Unlock()
+	if !ok {
+		const str = "account not found"
+		return nil, apperrors.E{ErrorCode: apperrors.ErrAccountNotFound, Description: str, Err: nil}
+	}
+
+	// Mirror the wrapping gap policy used by newChangeAddress.
+	if alb.cursor >= uint32(w.gapLimit) {
+		alb.cursor = 0
+	}
+	for {
+		childIndex := alb.lastUsed + 1 + alb.cursor
+		if childIndex >= hdkeychain.HardenedKeyStart {
+			const str = "no more addresses can be derived for the account"
+			err := apperrors.E{ErrorCode: apperrors.ErrExhaustedAccount, Description: str, Err: nil}
+			return nil, err
+		}
+		addr, err := alb.branchKeys.deriveChildAddress(childIndex, w.chainParams)
+		if err == hdkeychain.ErrInvalidChild {
+			alb.cursor++
+			continue
+		}
+		return addr, err
+	}
+}
+
+// peekChangeSource returns a change source paying to the address returned by
+// peekChangeAddress.
+func (w *Wallet) peekChangeSource(account uint32) txauthor.ChangeSource {
+	return func() ([]byte, uint16, error) {
+		changeAddress, err := w.peekChangeAddress(account)
+		if err != nil {
+			return nil, 0, err
+		}
+		script, err := txscript.PayToAddrScript(changeAddress)
+		return script, txscript.DefaultScriptVersion, err
+	}
+}
+
 func deriveChildAddresses(key *hdkeychain.ExtendedKey, startIndex, count uint32, params *chaincfg.Params) ([]abcutil.Address, error) {
 	addresses := make([]abcutil.Address, 0, count)
 	for i := uint32(0); i < count; i++ {
@@ -592,3 +634,102 @@ func deriveBranches(acctXpub *hdkeychain.ExtendedKey) (extKey, intKey *hdkeychai
 	intKey, err = acctXpub.Child(udb.InternalBranch)
 	return
 }
+
+// branchKeys are the extended public keys which derive the addresses of an
+// account branch.  BIP0044 account branches derive P2PKH addresses of the
+// wallet's branch key.  Multisig account branches derive P2SH addresses of
+// multisig scripts paying to the child keys of the wallet's branch key and the
+// branch keys of every cosigner.
+type branchKeys struct {
+	xpub         *hdkeychain.ExtendedKey
+	cosigners    []*hdkeychain.ExtendedKey
+	requiredSigs int
+}
+
+// deriveChildAddress derives the address of a branch child.
+// hdkeychain.ErrInvalidChild is returned if the child must be skipped.
+func (k *branchKeys) deriveChildAddress(child uint32, params *chaincfg.Params) (abcutil.Address, error) {
+	if k.cosigners == nil {
+		return deriveChildAddress(k.xpub, child, params)
+	}
+	keys := make([]*hdkeychain.ExtendedKey, 0, 1+len(k.cosigners))
+	keys = append(keys, k.xpub)
+	keys = append(keys, k.cosigners...)
+	script, err := udb.MultisigScript(keys, child, k.requiredSigs, params)
+	if err != nil {
+		return nil, err
+	}
+	return abcutil.NewAddressScriptHash(script, params)
+}
+
+// deriveChildAddresses derives the addresses of count branch children
+// beginning at startIndex, skipping invalid children.
+func (k *branchKeys) deriveChildAddresses(startIndex, count uint32, params *chaincfg.Params) ([]abcutil.Address, error) {
+	if k.cosigners == nil {
+		return deriveChildAddresses(k.xpub, startIndex, count, params)
+	}
+	addresses := make([]abcutil.Address, 0, count)
+	for i := uint32(0); i < count; i++ {
+		addr, err := k.deriveChildAddress(startIndex+i, params)
+		if err == hdkeychain.ErrInvalidChild {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, addr)
+	}
+	return addresses, nil
+}
+
+// accountBranchKeys returns the keys of an account's external and internal
+// branches.
+func (w *Wallet) accountBranchKeys(dbtx walletdb.ReadTx, account uint32) (extKeys, intKeys *branchKeys, err error) {
+	acctXpub, err := w.Manager.AccountExtendedPubKey(dbtx, account)
+	if err != nil {
+		return nil, nil, err
+	}
+	extKey, intKey, err := deriveBranches(acctXpub)
+	if err != nil {
+		return nil, nil, err
+	}
+	extKeys = &branchKeys{xpub: extKey}
+	intKeys = &branchKeys{xpub: intKey}
+
+	ms, err := w.Manager.MultisigAccount(dbtx, account)
+	if err != nil {
+		return nil, nil, err
+	}
+	if ms == nil {
+		return extKeys, intKeys, nil
+	}
+	extKeys.requiredSigs = ms.RequiredSigs
+	intKeys.requiredSigs = ms.RequiredSigs
+	extKeys.cosigners = make([]*hdkeychain.ExtendedKey, len(ms.CosignerKeys))
+	intKeys.cosigners = make([]*hdkeychain.ExtendedKey, len(ms.CosignerKeys))
+	for i, cosignerKey := range ms.CosignerKeys {
+		extKeys.cosigners[i], intKeys.cosigners[i], err = deriveBranches(cosignerKey)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return extKeys, intKeys, nil
+}
+
+// accountBranchKeysFor returns the keys of either the external or internal
+// branch of an account.
+func (w *Wallet) accountBranchKeysFor(dbtx walletdb.ReadTx, account, branch uint32) (*branchKeys, error) {
+	extKeys, intKeys, err := w.accountBranchKeys(dbtx, account)
+	if err != nil {
+		return nil, err
+	}
+	switch branch {
+	case udb.ExternalBranch:
+		return extKeys, nil
+	case udb.InternalBranch:
+		return intKeys, nil
+	default:
+		const str = "branch must be external (0) or internal (1)"
+		return nil, apperrors.E{ErrorCode: apperrors.ErrBranch, Description: str, Err: nil}
+	}
+}
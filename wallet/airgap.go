@@ -0,0 +1,233 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"fmt"
+
+	"github.com/abcsuite/abcd/chaincfg/chainhash"
+	"github.com/abcsuite/abcd/txscript"
+	"github.com/abcsuite/abcd/wire"
+	"github.com/abcsuite/abcutil"
+	"github.com/abcsuite/abcwallet/apperrors"
+	"github.com/abcsuite/abcwallet/wallet/pstx"
+	"github.com/abcsuite/abcwallet/wallet/txauthor"
+	"github.com/abcsuite/abcwallet/walletdb"
+
+	"github.com/abcsuite/abcwallet/wallet/internal/txsizes"
+)
+
+// CreateUnsignedPartialTx creates an unsigned transaction paying to outputs
+// from the unspent outputs of an account, and returns it as a partially signed
+// transaction describing every input and change output.  Unlike sending to
+// outputs, the wallet may be watching-only, since the transaction is signed
+// elsewhere, such as by an offline wallet created from the same seed.  The
+// spent outputs are not locked.
+func (w *Wallet) CreateUnsignedPartialTx(outputs []*wire.TxOut, account uint32,
+	minConf int32, feeRate abcutil.Amount) (*pstx.Tx, error) {
+
+	atx, err := w.NewUnsignedTransaction(outputs, feeRate, account, minConf,
+		OutputSelectionAlgorithmDefault, txauthor.CoinSelectionDefault, nil,
+		nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return w.CreatePartialTx(atx.Tx)
+}
+
+// PartialTxInput describes an input of a partially signed transaction.
+type PartialTxInput struct {
+	PreviousOutPoint wire.OutPoint
+	Amount           abcutil.Amount
+
+	// Final is whether the input has a signature script.  Signable is
+	// whether a wallet key which has not yet signed the input may sign it.
+	Final    bool
+	Signable bool
+}
+
+// PartialTxOutput describes an output of a partially signed transaction.
+// Owned is whether the output pays to a wallet key, either by an address
+// recorded by the wallet or by a derivation path verified against the wallet's
+// account keys.
+type PartialTxOutput struct {
+	Amount    abcutil.Amount
+	Version   uint16
+	PkScript  []byte
+	Addresses []abcutil.Address
+	Owned     bool
+}
+
+// PartialTxSummary describes the amounts and destinations of a partially
+// signed transaction so they may be verified before signing.
+type PartialTxSummary struct {
+	Hash    chainhash.Hash
+	Inputs  []PartialTxInput
+	Outputs []PartialTxOutput
+
+	TotalInput  abcutil.Amount
+	TotalOutput abcutil.Amount
+	Fee         abcutil.Amount
+
+	// EstimatedSignedSerializeSize is the serialized size of the
+	// transaction once signed, estimated as if every unsigned input
+	// redeems a P2PKH output.  FeeRate is the fee per kB of this size.
+	EstimatedSignedSerializeSize int
+	FeeRate                      abcutil.Amount
+
+	Complete bool
+}
+
+// DescribePartialTx verifies a partially signed transaction and describes its
+// inputs and outputs.  Input amounts are those of the previous outputs of the
+// previous transactions carried by the container, which are verified against
+// the outpoints spent by the inputs, since previous transactions may be
+// unknown to an offline wallet.  An error with the ErrInput code is returned if
+// a previous transaction of any input is missing or does not verify, if an
+// input value differs from its previous output, or if the outputs pay more
+// than the inputs.
+func (w *Wallet) DescribePartialTx(p *pstx.Tx) (*PartialTxSummary, error) {
+	inputErr := func(format string, args ...interface{}) error {
+		return apperrors.E{
+			ErrorCode:   apperrors.ErrInput,
+			Description: fmt.Sprintf(format, args...),
+		}
+	}
+
+	prevOuts, err := verifiedPrevOutputs(p)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &PartialTxSummary{
+		Hash:     p.Tx.TxHash(),
+		Inputs:   make([]PartialTxInput, len(p.Tx.TxIn)),
+		Outputs:  make([]PartialTxOutput, len(p.Tx.TxOut)),
+		Complete: p.Complete(),
+	}
+	err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+
+		// The signed size adds the estimated signature script of every
+		// unsigned input.
+		size := p.Tx.SerializeSize()
+		for i, txIn := range p.Tx.TxIn {
+			in := &p.Inputs[i]
+			prevOut := prevOuts[i]
+			input := &summary.Inputs[i]
+			input.PreviousOutPoint = txIn.PreviousOutPoint
+			input.Final = p.Final(i)
+			if !input.Final {
+				sigScriptSize := w.estimateSigScriptSize(prevOut, in)
+				size += wire.VarIntSerializeSize(uint64(sigScriptSize)) - 1 +
+					sigScriptSize
+			}
+
+			if txIn.ValueIn != 0 && txIn.ValueIn != prevOut.Value {
+				return inputErr("value of input %d does not match its "+
+					"previous output", i)
+			}
+			input.Amount = abcutil.Amount(prevOut.Value)
+			summary.TotalInput += input.Amount
+
+			if input.Final {
+				continue
+			}
+			script, err := w.inputSubScript(i, prevOut, in)
+			if err != nil {
+				return err
+			}
+			if script == nil {
+				continue
+			}
+			signers, err := w.inputSigners(dbtx, script, in.Derivations)
+			if err != nil {
+				return err
+			}
+			for _, s := range signers {
+				if in.Signature(s.pubKey) == nil {
+					input.Signable = true
+					break
+				}
+			}
+		}
+
+		for i, txOut := range p.Tx.TxOut {
+			output := &summary.Outputs[i]
+			output.Amount = abcutil.Amount(txOut.Value)
+			output.Version = txOut.Version
+			output.PkScript = txOut.PkScript
+			summary.TotalOutput += output.Amount
+
+			class, addrs, _, err := txscript.ExtractPkScriptAddrs(txOut.Version,
+				txOut.PkScript, w.chainParams)
+			if err != nil {
+				continue
+			}
+			output.Addresses = addrs
+			for _, addr := range addrs {
+				if w.Manager.ExistsAddress(addrmgrNs, addr.Hash160()[:]) {
+					output.Owned = true
+				}
+			}
+			for j := 0; !output.Owned && j < len(p.Outputs[i].Derivations); j++ {
+				d := &p.Outputs[i].Derivations[j]
+				if !commitsToKey(class, addrs, d.PubKey) {
+					continue
+				}
+				_, ok, err := w.walletKeyPath(dbtx, d)
+				if err != nil {
+					return err
+				}
+				output.Owned = ok
+			}
+		}
+
+		if summary.TotalOutput > summary.TotalInput {
+			return inputErr("outputs pay %v, more than the %v spent by inputs",
+				summary.TotalOutput, summary.TotalInput)
+		}
+		summary.Fee = summary.TotalInput - summary.TotalOutput
+
+		summary.EstimatedSignedSerializeSize = size
+		summary.FeeRate = summary.Fee * 1000 / abcutil.Amount(size)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
+// estimateSigScriptSize returns the worst case size of the signature script of
+// an unsigned input spending prevOut.  Inputs redeeming P2SH multisig outputs
+// are estimated from the redeem script, and every other input is estimated as
+// redeeming a compressed P2PKH output.
+func (w *Wallet) estimateSigScriptSize(prevOut *wire.TxOut, in *pstx.Input) int {
+	if in.RedeemScript == nil ||
+		txscript.GetScriptClass(prevOut.Version, prevOut.PkScript) != txscript.ScriptHashTy {
+		return txsizes.RedeemP2PKHSigScriptSize
+	}
+	m, n, err := w.multisigScriptMN(in.RedeemScript)
+	if err != nil {
+		return txsizes.RedeemP2PKHSigScriptSize
+	}
+	return txsizes.RedeemP2SHMultisigSigScriptSize(m, n)
+}
+
+// multisigScriptMN returns the required signatures and number of public keys of
+// a multisig script.
+func (w *Wallet) multisigScriptMN(script []byte) (m, n int, err error) {
+	class, addrs, reqSigs, err := txscript.ExtractPkScriptAddrs(
+		txscript.DefaultScriptVersion, script, w.chainParams)
+	if err != nil {
+		return 0, 0, err
+	}
+	if class != txscript.MultiSigTy {
+		return 0, 0, fmt.Errorf("script has class %v, not %v", class,
+			txscript.MultiSigTy)
+	}
+	return reqSigs, len(addrs), nil
+}
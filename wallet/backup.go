@@ -0,0 +1,113 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/abcsuite/abcwallet/apperrors"
+	"github.com/abcsuite/abcwallet/wallet/udb"
+	"github.com/abcsuite/abcwallet/walletdb"
+	_ "github.com/abcsuite/abcwallet/walletdb/bdb" // backups are bolt databases
+	"github.com/abcsuite/abcwallet/walletdb/encdb"
+)
+
+// BackupWallet writes a consistent snapshot of the wallet database to a new
+// file at path while the wallet continues running.  The snapshot is first
+// written to a temporary file in the destination directory and verified by
+// opening it read-only.  Only a verified backup is moved to path.
+//
+// Existing files are never replaced.  A apperrors.E with the error code
+// ErrAlreadyExists is returned if a file already exists at path.
+func (w *Wallet) BackupWallet(path string) (err error) {
+	if _, err := os.Lstat(path); err == nil {
+		const str = "backup destination already exists"
+		return apperrors.E{ErrorCode: apperrors.ErrAlreadyExists, Description: str, Err: nil}
+	}
+
+	f, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		const str = "failed to create backup file"
+		return apperrors.E{ErrorCode: apperrors.ErrInput, Description: str, Err: err}
+	}
+	tmpPath := f.Name()
+	defer os.Remove(tmpPath)
+
+	// The public passphrase must not change between copying the database
+	// and verifying the copy, since it decrypts copies of encrypted
+	// databases.
+	w.publicPassphraseMu.Lock()
+	defer w.publicPassphraseMu.Unlock()
+
+	err = w.db.Copy(f)
+	if err == nil {
+		err = f.Sync()
+	}
+	closeErr := f.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		const str = "failed to write backup"
+		return apperrors.E{ErrorCode: apperrors.ErrDatabase, Description: str, Err: err}
+	}
+
+	err = verifyBackup(tmpPath, w.publicPassphrase)
+	if err != nil {
+		return err
+	}
+
+	// Linking the verified copy to the destination fails rather than
+	// replacing a file created at path since it was checked above.  The
+	// temporary name is removed by the deferred cleanup.
+	err = os.Link(tmpPath, path)
+	if err != nil {
+		if os.IsExist(err) {
+			const str = "backup destination already exists"
+			return apperrors.E{ErrorCode: apperrors.ErrAlreadyExists, Description: str, Err: err}
+		}
+		const str = "failed to move backup to destination"
+		return apperrors.E{ErrorCode: apperrors.ErrDatabase, Description: str, Err: err}
+	}
+	return nil
+}
+
+// verifyBackup opens the database backup at path read-only and checks that it
+// is a wallet database that can be opened by this software.  Backups of
+// encrypted databases remain encrypted and are decrypted by the public
+// passphrase.
+func verifyBackup(path string, pubPassphrase []byte) error {
+	db, err := walletdb.Open("bdb", path, true)
+	if err != nil {
+		const str = "failed to open backup"
+		return apperrors.E{ErrorCode: apperrors.ErrDatabase, Description: str, Err: err}
+	}
+	defer func() {
+		db.Close()
+	}()
+
+	encrypted, err := encdb.Detect(db)
+	if err != nil {
+		const str = "failed to read backup"
+		return apperrors.E{ErrorCode: apperrors.ErrDatabase, Description: str, Err: err}
+	}
+	if encrypted {
+		encDB, err := encdb.Wrap(db, pubPassphrase)
+		if err != nil {
+			const str = "failed to decrypt backup"
+			return apperrors.E{ErrorCode: apperrors.ErrData, Description: str, Err: err}
+		}
+		db = encDB
+	}
+
+	err = udb.VerifyDB(db)
+	if err != nil {
+		const str = "backup verification failed"
+		return apperrors.E{ErrorCode: apperrors.ErrData, Description: str, Err: err}
+	}
+	return nil
+}
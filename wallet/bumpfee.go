@@ -0,0 +1,225 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"fmt"
+
+	"github.com/abcsuite/abcd/blockchain/stake"
+	"github.com/abcsuite/abcd/chaincfg/chainhash"
+	"github.com/abcsuite/abcd/txscript"
+	"github.com/abcsuite/abcd/wire"
+	"github.com/abcsuite/abcutil"
+	"github.com/abcsuite/abcwallet/apperrors"
+	"github.com/abcsuite/abcwallet/wallet/txauthor"
+	"github.com/abcsuite/abcwallet/wallet/txrules"
+	"github.com/abcsuite/abcwallet/wallet/udb"
+	"github.com/abcsuite/abcwallet/walletdb"
+
+	"github.com/abcsuite/abcwallet/wallet/internal/txsizes"
+)
+
+// FeeBump describes a child-pays-for-parent transaction which spends the change
+// output of an unmined parent transaction.  The child pays a fee large enough
+// for the parent and child together to pay the target fee rate.
+type FeeBump struct {
+	// Tx is the child transaction.  It is unsigned when returned by a dry
+	// run.  The child pays back to a change address of the account which
+	// controls the spent output.
+	Tx *wire.MsgTx
+
+	ParentHash chainhash.Hash
+	ParentFee  abcutil.Amount
+	ParentSize int
+
+	Fee                          abcutil.Amount
+	EstimatedSignedSerializeSize int
+	PackageFeeRate               abcutil.Amount // per kB of parent and child
+}
+
+// feeBumpTx creates an unsigned child transaction spending the largest unspent
+// and unlocked change output of the unmined parent transaction to a single
+// output returned by the change source of the output's account.  The fee of the
+// child is chosen so that the parent and child together pay feeRate per kB,
+// and is never less than the relay fee required for the child alone.
+//
+// The parent must be a regular transaction spending only wallet outputs, since
+// the parent fee can not be determined otherwise.  An error with the ErrInput
+// code is returned if the parent can not be bumped or already pays the fee
+// rate.
+func (w *Wallet) feeBumpTx(dbtx walletdb.ReadTx, parentHash *chainhash.Hash,
+	feeRate abcutil.Amount, changeSource func(account uint32) txauthor.ChangeSource) (
+	*txauthor.AuthoredTx, *FeeBump, error) {
+
+	addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+	txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+
+	inputErr := func(format string, args ...interface{}) error {
+		return apperrors.E{
+			ErrorCode:   apperrors.ErrInput,
+			Description: fmt.Sprintf(format, args...),
+		}
+	}
+
+	if feeRate <= 0 {
+		return nil, nil, inputErr("fee rate must be positive")
+	}
+
+	details, err := w.TxStore.TxDetails(txmgrNs, parentHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	if details == nil {
+		return nil, nil, apperrors.E{
+			ErrorCode:   apperrors.ErrValueNoExists,
+			Description: fmt.Sprintf("no transaction %v", parentHash),
+		}
+	}
+	if details.Block.Height != -1 {
+		return nil, nil, inputErr("transaction %v is mined", parentHash)
+	}
+	if details.TxType != stake.TxTypeRegular {
+		return nil, nil, inputErr("transaction %v is not a regular "+
+			"transaction", parentHash)
+	}
+	if len(details.Debits) != len(details.MsgTx.TxIn) {
+		return nil, nil, inputErr("fee of transaction %v is unknown since it "+
+			"spends outputs not controlled by the wallet", parentHash)
+	}
+	var parentFee abcutil.Amount
+	for _, debit := range details.Debits {
+		parentFee += debit.Amount
+	}
+	for _, output := range details.MsgTx.TxOut {
+		parentFee -= abcutil.Amount(output.Value)
+	}
+
+	var credit *udb.CreditRecord
+	for i := range details.Credits {
+		c := &details.Credits[i]
+		if !c.Change || c.Spent {
+			continue
+		}
+		op := wire.OutPoint{Hash: *parentHash, Index: c.Index, Tree: wire.TxTreeRegular}
+		if w.LockedOutpoint(op) {
+			continue
+		}
+		if credit == nil || c.Amount > credit.Amount {
+			credit = c
+		}
+	}
+	if credit == nil {
+		return nil, nil, inputErr("transaction %v has no unspent and unlocked "+
+			"change output", parentHash)
+	}
+	prevOut := details.MsgTx.TxOut[credit.Index]
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(prevOut.Version,
+		prevOut.PkScript, w.chainParams)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(addrs) != 1 {
+		return nil, nil, inputErr("change output %v:%d does not pay to a "+
+			"single address", parentHash, credit.Index)
+	}
+	account, err := w.Manager.AddrAccount(addrmgrNs, addrs[0])
+	if err != nil {
+		return nil, nil, err
+	}
+	changeScript, changeVersion, err := changeSource(account)()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(parentHash, credit.Index,
+		wire.TxTreeRegular), nil))
+	tx.AddTxOut(&wire.TxOut{Version: changeVersion, PkScript: changeScript})
+	_, tipHeight := w.TxStore.MainChainTip(txmgrNs)
+	tx.Expiry, err = w.expiryHeight(nil, tipHeight)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	parentSize := details.MsgTx.SerializeSize()
+	size := txsizes.EstimateSerializeSize(1, tx.TxOut, false)
+	fee := txrules.FeeForSerializeSize(feeRate, parentSize+size) - parentFee
+	if fee <= 0 {
+		return nil, nil, inputErr("transaction %v already pays a fee rate of "+
+			"at least %v/kB", parentHash, feeRate)
+	}
+	relayFee := w.RelayFee()
+	if minFee := txrules.FeeForSerializeSize(relayFee, size); fee < minFee {
+		fee = minFee
+	}
+	tx.TxOut[0].Value = int64(credit.Amount - fee)
+	if fee >= credit.Amount || txrules.IsDustOutput(tx.TxOut[0], relayFee) {
+		return nil, nil, inputErr("change output %v:%d is too small to pay "+
+			"a fee of %v", parentHash, credit.Index, fee)
+	}
+
+	atx := &txauthor.AuthoredTx{
+		Tx:                           tx,
+		PrevScripts:                  [][]byte{prevOut.PkScript},
+		TotalInput:                   credit.Amount,
+		ChangeIndex:                  0,
+		EstimatedSignedSerializeSize: size,
+	}
+	bump := &FeeBump{
+		Tx:                           tx,
+		ParentHash:                   *parentHash,
+		ParentFee:                    parentFee,
+		ParentSize:                   parentSize,
+		Fee:                          fee,
+		EstimatedSignedSerializeSize: size,
+		PackageFeeRate:               (parentFee + fee) * 1000 / abcutil.Amount(parentSize+size),
+	}
+	return atx, bump, nil
+}
+
+// bumpFee creates, signs, and publishes a child transaction paying for the fee
+// of an unmined parent transaction.  See feeBumpTx for how the child is
+// created.
+func (w *Wallet) bumpFee(parentHash *chainhash.Hash, feeRate abcutil.Amount) (*FeeBump, error) {
+	chainClient, err := w.requireChainClient()
+	if err != nil {
+		return nil, err
+	}
+
+	var atx *txauthor.AuthoredTx
+	var bump *FeeBump
+	var changeSourceUpdates []func(walletdb.ReadWriteTx) error
+	err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+
+		persist := w.deferPersistReturnedChild(&changeSourceUpdates)
+		changeSource := func(account uint32) txauthor.ChangeSource {
+			return w.changeSource(persist, account)
+		}
+		var err error
+		atx, bump, err = w.feeBumpTx(dbtx, parentHash, feeRate, changeSource)
+		if err != nil {
+			return err
+		}
+
+		secrets := &secretSource{Manager: w.Manager, addrmgrNs: addrmgrNs}
+		err = atx.AddAllInputScripts(secrets)
+		for _, done := range secrets.doneFuncs {
+			done()
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = w.publishAuthoredTx(atx, changeSourceUpdates, chainClient)
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("Published transaction %v paying a fee of %v for unmined "+
+		"transaction %v", atx.Tx.TxHash(), bump.Fee, parentHash)
+	return bump, nil
+}
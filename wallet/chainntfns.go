@@ -39,6 +39,9 @@ func (w *Wallet) handleConsensusRPCNotifications(chainClient *chain.RPCClient) {
 					return w.watchFutureAddresses(tx)
 				})
 			}
+			if err == nil {
+				w.rebroadcastUnminedTxs(chainClient)
+			}
 		case chain.Reorganization:
 			notificationName = "reorganizing"
 			err = w.handleReorganizing(n.OldHash, n.NewHash, n.OldHeight, n.NewHeight)
@@ -48,6 +51,7 @@ func (w *Wallet) handleConsensusRPCNotifications(chainClient *chain.RPCClient) {
 				return w.processTransaction(dbtx, n.Transaction, nil, nil)
 			})
 			if err == nil {
+				w.forgetReleasedOutpointLocks()
 				err = walletdb.View(w.db, func(tx walletdb.ReadTx) error {
 					return w.watchFutureAddresses(tx)
 				})
@@ -276,23 +280,47 @@ func (w *Wallet) onBlockConnected(serializedBlockHeader []byte, transactions [][
 		}
 	}
 
+	w.forgetReleasedOutpointLocks()
+
 	height := int32(blockHeader.Height)
 	chainTipChanges.NewHeight = height
 
 	// Prune all expired transactions and all stake tickets that no longer
-	// meet the minimum stake difficulty.
+	// meet the minimum stake difficulty, along with their unmined spenders.
+	// Pruning releases the previous outputs spent by the removed
+	// transactions.
+	var pruned []*chainhash.Hash
 	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
 		txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
-		return w.TxStore.PruneUnconfirmed(txmgrNs, height, blockHeader.SBits)
+		var err error
+		pruned, err = w.TxStore.PruneUnconfirmed(txmgrNs, height, blockHeader.SBits)
+		return err
 	})
 	if err != nil {
 		log.Errorf("Failed to prune unconfirmed transactions when "+
 			"connecting block height %v: %s", height, err.Error())
 	}
+	for _, hash := range pruned {
+		log.Infof("Removed pruned unmined transaction %v", hash)
+	}
+	w.NtfnServer.notifyExpiredTransactions(pruned)
+
+	// Release any outpoint locks that expire at this height.
+	err = w.releaseExpiredOutpointLocks(height)
+	if err != nil {
+		log.Errorf("Failed to release expired outpoint locks when "+
+			"connecting block height %v: %v", height, err)
+	}
 
 	w.NtfnServer.notifyMainChainTipChanged(chainTipChanges)
 	w.NtfnServer.sendAttachedBlockNotification()
 
+	// Redeem or refund swap contracts which can be spent now.
+	w.handleSwapContracts(height)
+
+	// Spend vaults whose locks have expired back to their accounts.
+	w.handleVaults()
+
 	if voteVersion(w.chainParams) < blockHeader.StakeVersion {
 		log.Warnf("Old vote version detected (v%v), please update your "+
 			"wallet to the latest version.", voteVersion(w.chainParams))
@@ -615,6 +643,9 @@ func (w *Wallet) processTransaction(dbtx walletdb.ReadWriteTx, serializedTx []by
 
 	if serializedHeader == nil {
 		err = w.TxStore.InsertMemPoolTx(txmgrNs, rec)
+		if err == nil {
+			w.recordUnminedTxSeen(&rec.Hash, rec.Received)
+		}
 	} else {
 		err = w.TxStore.InsertMinedTx(txmgrNs, addrmgrNs, rec, &blockMeta.Hash)
 	}
@@ -622,6 +653,25 @@ func (w *Wallet) processTransaction(dbtx walletdb.ReadWriteTx, serializedTx []by
 		return err
 	}
 
+	// Outputs spent by the transaction no longer need to remain locked.
+	err = w.releaseSpentOutpointLocks(dbtx, &rec.MsgTx)
+	if err != nil {
+		return err
+	}
+
+	// Record spends of saved swap contracts and the secrets revealed by
+	// their redemptions.
+	err = w.processSwapContractSpends(dbtx, rec)
+	if err != nil {
+		return err
+	}
+
+	// Record spends of saved vaults.
+	err = w.processVaultSpends(dbtx, rec)
+	if err != nil {
+		return err
+	}
+
 	// Handle input scripts that contain P2PKs that we care about.
 	for i, input := range rec.MsgTx.TxIn {
 		if txscript.IsMultisigSigScript(input.SignatureScript) {
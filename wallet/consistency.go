@@ -0,0 +1,20 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"github.com/abcsuite/abcwallet/wallet/udb"
+)
+
+// CheckConsistency checks that the records of the wallet database refer to one
+// another correctly and returns a report of all found problems.  If repair is
+// true, problems which can be fixed are repaired.
+//
+// Repairs modify database records without updating any state of the running
+// wallet, so repairing should only be performed before the wallet begins
+// syncing, or when the wallet is restarted afterwards.
+func (w *Wallet) CheckConsistency(repair bool) (*udb.ConsistencyReport, error) {
+	return udb.CheckConsistency(w.db, w.chainParams, repair)
+}
@@ -173,14 +173,173 @@ const (
 	OutputSelectionAlgorithmAll
 )
 
+// CoinControl describes the previous outputs which may be spent by a new
+// transaction when the caller, rather than the wallet, chooses them.  Every
+// outpoint which must be spent is checked to be an unspent and unlocked output
+// of the account which meets the confirmation requirement.
+type CoinControl struct {
+	// Inputs lists the only previous outputs to spend.  When non-empty,
+	// every input is spent and no other outputs are selected.  Include
+	// must be empty when Inputs is set.
+	Inputs []wire.OutPoint
+
+	// Include lists previous outputs which must be spent.  Additional
+	// outputs are selected by the coin selection strategy if they do not
+	// pay for the transaction.
+	Include []wire.OutPoint
+
+	// Exclude lists previous outputs which must never be spent.
+	Exclude []wire.OutPoint
+}
+
+// makeInputSource creates an input source selecting unspent outputs of an
+// account using the coin selection strategy.  The wallet's default strategy is
+// used when the strategy is txauthor.CoinSelectionDefault.  The coin control
+// is optional and may be nil to let the strategy choose from every unspent
+// output.  The relay fee must be the fee rate used to author the transaction.
+func (w *Wallet) makeInputSource(txmgrNs, addrmgrNs walletdb.ReadBucket, account uint32,
+	minConf, tipHeight int32, strategy txauthor.CoinSelectionStrategy,
+	coinControl *CoinControl, relayFeePerKb abcutil.Amount) (txauthor.InputSource, error) {
+
+	if strategy == txauthor.CoinSelectionDefault {
+		strategy = w.CoinSelectionStrategy()
+	}
+	if strategy == txauthor.CoinSelectionDefault && coinControl == nil {
+		sourceImpl := w.TxStore.MakeInputSource(txmgrNs, addrmgrNs, account,
+			minConf, tipHeight)
+		return sourceImpl.SelectInputs, nil
+	}
+
+	candidates, err := w.TxStore.InputCandidates(txmgrNs, addrmgrNs, account,
+		minConf, tipHeight)
+	if err != nil {
+		return nil, err
+	}
+	coins := make([]txauthor.Coin, 0, len(candidates))
+	for i := range candidates {
+		c := &candidates[i]
+		coins = append(coins, txauthor.Coin{
+			OutPoint: c.Input.PreviousOutPoint,
+			Amount:   c.Amount,
+			PkScript: c.PkScript,
+			Height:   c.Height,
+		})
+	}
+	if coinControl == nil {
+		return txauthor.MakeCoinSelectionSource(strategy, coins, relayFeePerKb), nil
+	}
+	return w.coinControlSource(coins, account, minConf, strategy, coinControl,
+		relayFeePerKb)
+}
+
+// coinControlSource creates an input source which spends the outpoints required
+// by the coin control and selects any additional inputs from the remaining
+// coins using the coin selection strategy.  Excluded and locked outpoints are
+// never selected.  An error with the ErrInput code is returned if the coin
+// control is invalid or a required outpoint is not one of the coins, or is
+// locked.
+func (w *Wallet) coinControlSource(coins []txauthor.Coin, account uint32, minConf int32,
+	strategy txauthor.CoinSelectionStrategy, coinControl *CoinControl,
+	relayFeePerKb abcutil.Amount) (txauthor.InputSource, error) {
+
+	inputErr := func(format string, args ...interface{}) error {
+		return apperrors.E{
+			ErrorCode:   apperrors.ErrInput,
+			Description: fmt.Sprintf(format, args...),
+		}
+	}
+
+	if len(coinControl.Inputs) != 0 && len(coinControl.Include) != 0 {
+		return nil, inputErr("explicit inputs may not be combined with " +
+			"included outpoints")
+	}
+	required := coinControl.Inputs
+	if len(required) == 0 {
+		required = coinControl.Include
+	}
+
+	// Outpoints are matched regardless of their tree, as is done for
+	// locked outpoints.
+	excluded := make(map[wire.OutPoint]struct{}, len(coinControl.Exclude))
+	for i := range coinControl.Exclude {
+		excluded[outpointLockKey(&coinControl.Exclude[i])] = struct{}{}
+	}
+	coinIndex := make(map[wire.OutPoint]int, len(coins))
+	for i := range coins {
+		coinIndex[outpointLockKey(&coins[i].OutPoint)] = i
+	}
+
+	requiredCoins := make([]txauthor.Coin, 0, len(required))
+	selected := make(map[wire.OutPoint]struct{}, len(required))
+	for i := range required {
+		op := &required[i]
+		key := outpointLockKey(op)
+		if _, ok := excluded[key]; ok {
+			return nil, inputErr("outpoint %v is both required and excluded", op)
+		}
+		if _, ok := selected[key]; ok {
+			return nil, inputErr("duplicate outpoint %v", op)
+		}
+		j, ok := coinIndex[key]
+		if !ok {
+			return nil, inputErr("outpoint %v is not an unspent output of "+
+				"account %d with at least %d confirmations", op, account, minConf)
+		}
+		if w.LockedOutpoint(*op) {
+			return nil, inputErr("outpoint %v is locked", op)
+		}
+		requiredCoins = append(requiredCoins, coins[j])
+		selected[key] = struct{}{}
+	}
+
+	if len(coinControl.Inputs) != 0 {
+		return txauthor.MakeRequiredCoinsSource(requiredCoins, nil), nil
+	}
+
+	additional := make([]txauthor.Coin, 0, len(coins))
+	for i := range coins {
+		op := &coins[i].OutPoint
+		key := outpointLockKey(op)
+		if _, ok := selected[key]; ok {
+			continue
+		}
+		if _, ok := excluded[key]; ok {
+			continue
+		}
+		if w.LockedOutpoint(*op) {
+			continue
+		}
+		additional = append(additional, coins[i])
+	}
+	additionalSource := txauthor.MakeCoinSelectionSource(strategy, additional,
+		relayFeePerKb)
+	return txauthor.MakeRequiredCoinsSource(requiredCoins, additionalSource), nil
+}
+
 // NewUnsignedTransaction constructs an unsigned transaction using unspent
-// account outputs.
+// account outputs.  With the default output selection algorithm, outputs are
+// chosen using the coin selection strategy, or the wallet's default strategy
+// if the strategy is txauthor.CoinSelectionDefault.  The optional coin control
+// restricts which outputs are spent, and may only be used with the default
+// output selection algorithm.  If any output indexes are specified by
+// subtractFeeFrom, the fee is subtracted from these outputs rather than being
+// paid in addition to the output amounts.  The transaction expires according
+// to the expiry, or the wallet's default expiry if the expiry is nil.
 //
 // The changeSource parameter is optional and can be nil.  When nil, and if a
 // change output should be added, an internal change address is created for the
 // account.
 func (w *Wallet) NewUnsignedTransaction(outputs []*wire.TxOut, relayFeePerKb abcutil.Amount, account uint32, minConf int32,
-	algo OutputSelectionAlgorithm, changeSource txauthor.ChangeSource) (*txauthor.AuthoredTx, error) {
+	algo OutputSelectionAlgorithm, strategy txauthor.CoinSelectionStrategy,
+	coinControl *CoinControl, subtractFeeFrom []int, expiry *TxExpiry,
+	changeSource txauthor.ChangeSource) (*txauthor.AuthoredTx, error) {
+
+	if coinControl != nil && algo != OutputSelectionAlgorithmDefault {
+		return nil, apperrors.E{
+			ErrorCode:   apperrors.ErrInput,
+			Description: "coin control requires the default output selection algorithm",
+		}
+	}
 
 	var authoredTx *txauthor.AuthoredTx
 	var changeSourceUpdates []func(walletdb.ReadWriteTx) error
@@ -202,13 +361,18 @@ func (w *Wallet) NewUnsignedTransaction(outputs []*wire.TxOut, relayFeePerKb abc
 			}
 		}
 
-		sourceImpl := w.TxStore.MakeInputSource(txmgrNs, addrmgrNs, account,
-			minConf, tipHeight)
 		var inputSource txauthor.InputSource
 		switch algo {
 		case OutputSelectionAlgorithmDefault:
-			inputSource = sourceImpl.SelectInputs
+			var err error
+			inputSource, err = w.makeInputSource(txmgrNs, addrmgrNs, account,
+				minConf, tipHeight, strategy, coinControl, relayFeePerKb)
+			if err != nil {
+				return err
+			}
 		case OutputSelectionAlgorithmAll:
+			sourceImpl := w.TxStore.MakeInputSource(txmgrNs, addrmgrNs, account,
+				minConf, tipHeight)
 			// Wrap the source with one that always fetches the max amount
 			// available and ignores any returned InputSourceErrors.
 			inputSource = func(abcutil.Amount) (abcutil.Amount, []*wire.TxIn, [][]byte, error) {
@@ -228,9 +392,22 @@ func (w *Wallet) NewUnsignedTransaction(outputs []*wire.TxOut, relayFeePerKb abc
 			changeSource = w.changeSource(persist, account)
 		}
 
-		var err error
-		authoredTx, err = txauthor.NewUnsignedTransaction(outputs, relayFeePerKb,
-			inputSource, changeSource)
+		expiryHeight, err := w.expiryHeight(expiry, tipHeight)
+		if err != nil {
+			return err
+		}
+		ms, err := w.Manager.MultisigAccount(dbtx, account)
+		if err != nil {
+			return err
+		}
+		if ms != nil {
+			authoredTx, err = newUnsignedMultisigTransaction(outputs,
+				relayFeePerKb, ms, inputSource, changeSource,
+				subtractFeeFrom, expiryHeight)
+			return err
+		}
+		authoredTx, err = newUnsignedTransaction(outputs, relayFeePerKb,
+			inputSource, changeSource, subtractFeeFrom, expiryHeight)
 		return err
 	})
 	if err != nil {
@@ -250,6 +427,99 @@ func (w *Wallet) NewUnsignedTransaction(outputs []*wire.TxOut, relayFeePerKb abc
 	return authoredTx, err
 }
 
+// newUnsignedTransaction creates an unsigned transaction using the input and
+// change sources.  The fee is paid in addition to the output amounts unless
+// subtractFeeFrom specifies the indexes of outputs to subtract the fee from.
+// The transaction expires at the expiry height, or never expires if the expiry
+// is wire.NoExpiryValue.
+func newUnsignedTransaction(outputs []*wire.TxOut, relayFeePerKb abcutil.Amount,
+	inputSource txauthor.InputSource, changeSource txauthor.ChangeSource,
+	subtractFeeFrom []int, expiry uint32) (*txauthor.AuthoredTx, error) {
+
+	var atx *txauthor.AuthoredTx
+	var err error
+	if len(subtractFeeFrom) != 0 {
+		atx, err = txauthor.NewUnsignedTransactionSubtractFee(outputs,
+			relayFeePerKb, inputSource, changeSource, subtractFeeFrom)
+	} else {
+		atx, err = txauthor.NewUnsignedTransaction(outputs, relayFeePerKb,
+			inputSource, changeSource)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The expiry is a fixed size field, so setting it does not change the
+	// estimated size or the fee.
+	atx.Tx.Expiry = expiry
+	return atx, nil
+}
+
+// newUnsignedMultisigTransaction creates an unsigned transaction spending
+// outputs of a multisig account like newUnsignedTransaction, estimating the fee
+// for the multisig signature scripts of the account's inputs.  Subtracting the
+// fee from outputs is not supported.
+func newUnsignedMultisigTransaction(outputs []*wire.TxOut, relayFeePerKb abcutil.Amount,
+	ms *udb.MultisigAccount, inputSource txauthor.InputSource,
+	changeSource txauthor.ChangeSource, subtractFeeFrom []int,
+	expiry uint32) (*txauthor.AuthoredTx, error) {
+
+	if len(subtractFeeFrom) != 0 {
+		return nil, apperrors.E{
+			ErrorCode:   apperrors.ErrInput,
+			Description: "fees can not be subtracted from outputs when spending from multisig accounts",
+		}
+	}
+	atx, err := txauthor.NewUnsignedMultisigTransaction(outputs, relayFeePerKb,
+		ms.RequiredSigs, 1+len(ms.CosignerKeys), inputSource, changeSource)
+	if err != nil {
+		return nil, err
+	}
+	atx.Tx.Expiry = expiry
+	return atx, nil
+}
+
+// TxExpiry describes the block height at which a created transaction expires
+// and may no longer be mined.  A relative expiry counts blocks from the next
+// block, so a transaction with a relative expiry of N blocks may be mined in
+// any of the next N blocks.  An absolute expiry must be beyond the next block
+// height.  A zero height creates a transaction which never expires.
+type TxExpiry struct {
+	Height   uint32
+	Relative bool
+}
+
+// expiryHeight returns the expiry height of a transaction created with the
+// expiry when the main chain tip is at tipHeight.  A nil expiry uses the
+// wallet's default relative expiry.
+func (w *Wallet) expiryHeight(expiry *TxExpiry, tipHeight int32) (uint32, error) {
+	if expiry == nil {
+		expiry = &TxExpiry{Height: w.DefaultTxExpiry(), Relative: true}
+	}
+
+	nextHeight := uint32(tipHeight) + 1
+	switch {
+	case expiry.Height == 0:
+		return wire.NoExpiryValue, nil
+	case expiry.Relative:
+		height := nextHeight + expiry.Height
+		if height < nextHeight {
+			return 0, apperrors.E{
+				ErrorCode:   apperrors.ErrInput,
+				Description: "relative expiry overflows the block height",
+			}
+		}
+		return height, nil
+	case expiry.Height <= nextHeight:
+		return 0, apperrors.E{
+			ErrorCode: apperrors.ErrInput,
+			Description: fmt.Sprintf("expiry %d is not beyond the next "+
+				"block height %d", expiry.Height, nextHeight),
+		}
+	}
+	return expiry.Height, nil
+}
+
 // secretSource is an implementation of txauthor.SecretSource for the wallet's
 // address manager.
 type secretSource struct {
@@ -357,24 +627,54 @@ func (w *Wallet) insertMultisigOutIntoTxMgr(ns walletdb.ReadWriteBucket, msgTx *
 }
 
 // txToOutputs creates a transaction, selecting previous outputs from an account
-// with no less than minconf confirmations, and creates a signed transaction
-// that pays to each of the outputs.
+// with no less than minconf confirmations using the coin selection strategy
+// and optional coin control, and creates a signed transaction that pays to each
+// of the outputs.  The fee is subtracted from the outputs at the indexes in
+// subtractFeeFrom, if any.  The transaction expires according to the expiry, or
+// the wallet's default expiry if the expiry is nil.
 func (w *Wallet) txToOutputs(outputs []*wire.TxOut, account uint32, minconf int32,
-	randomizeChangeIdx bool) (*txauthor.AuthoredTx, error) {
+	strategy txauthor.CoinSelectionStrategy, coinControl *CoinControl,
+	subtractFeeFrom []int, expiry *TxExpiry, randomizeChangeIdx bool) (*txauthor.AuthoredTx, error) {
 
 	chainClient, err := w.requireChainClient()
 	if err != nil {
 		return nil, err
 	}
 
-	return w.txToOutputsInternal(outputs, account, minconf, chainClient,
-		randomizeChangeIdx, w.RelayFee())
+	return w.txToOutputsInternal(outputs, account, minconf, strategy,
+		coinControl, subtractFeeFrom, expiry, chainClient, randomizeChangeIdx,
+		w.RelayFee())
+}
+
+// checkSoleSignerAccount returns an error with the ErrInput code if account is
+// a multisig account.  Outputs of multisig accounts require signatures by
+// cosigners, so they can not be spent by a transaction signed only by this
+// wallet.
+func (w *Wallet) checkSoleSignerAccount(dbtx walletdb.ReadTx, account uint32) error {
+	ms, err := w.Manager.MultisigAccount(dbtx, account)
+	if err != nil {
+		return err
+	}
+	if ms != nil {
+		return apperrors.E{
+			ErrorCode: apperrors.ErrInput,
+			Description: "outputs of multisig accounts must be spent " +
+				"with partially signed transactions",
+		}
+	}
+	return nil
 }
 
 // txToOutputsInternal creates a signed transaction which includes each output
 // from outputs.  Previous outputs to reedeem are chosen from the passed
-// account's UTXO set and minconf policy. An additional output may be added to
-// return change to the wallet.  An appropriate fee is included based on the
+// account's UTXO set and minconf policy using the coin selection strategy, or
+// the wallet's default strategy if the strategy is
+// txauthor.CoinSelectionDefault.  The optional coin control restricts which
+// previous outputs are redeemed.  The fee is subtracted from the outputs at the
+// indexes in subtractFeeFrom, or paid in addition to the output amounts if no
+// indexes are specified.  The transaction expires according to the expiry, or
+// the wallet's default expiry if the expiry is nil.  An additional output may
+// be added to return change to the wallet.  An appropriate fee is included based on the
 // wallet's current relay fee.  The wallet must be unlocked to create the
 // transaction.  The address pool passed must be locked and engaged in an
 // address pool batch call.
@@ -382,7 +682,9 @@ func (w *Wallet) txToOutputs(outputs []*wire.TxOut, account uint32, minconf int3
 // Aero: This func also sends the transaction, and if successful, inserts it
 // into the database, rather than delegating this work to the caller as
 // btcwallet does.
-func (w *Wallet) txToOutputsInternal(outputs []*wire.TxOut, account uint32, minconf int32, chainClient *chain.RPCClient,
+func (w *Wallet) txToOutputsInternal(outputs []*wire.TxOut, account uint32, minconf int32,
+	strategy txauthor.CoinSelectionStrategy, coinControl *CoinControl,
+	subtractFeeFrom []int, expiry *TxExpiry, chainClient *chain.RPCClient,
 	randomizeChangeIdx bool, txFee abcutil.Amount) (*txauthor.AuthoredTx, error) {
 
 	var atx *txauthor.AuthoredTx
@@ -391,15 +693,26 @@ func (w *Wallet) txToOutputsInternal(outputs []*wire.TxOut, account uint32, minc
 		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
 		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
 
+		err := w.checkSoleSignerAccount(dbtx, account)
+		if err != nil {
+			return err
+		}
+
 		// Create the unsigned transaction.
 		_, tipHeight := w.TxStore.MainChainTip(txmgrNs)
-		inputSource := w.TxStore.MakeInputSource(txmgrNs, addrmgrNs, account,
-			minconf, tipHeight)
+		inputSource, err := w.makeInputSource(txmgrNs, addrmgrNs, account,
+			minconf, tipHeight, strategy, coinControl, txFee)
+		if err != nil {
+			return err
+		}
+		expiryHeight, err := w.expiryHeight(expiry, tipHeight)
+		if err != nil {
+			return err
+		}
 		persist := w.deferPersistReturnedChild(&changeSourceUpdates)
 		changeSource := w.changeSource(persist, account)
-		var err error
-		atx, err = txauthor.NewUnsignedTransaction(outputs, txFee,
-			inputSource.SelectInputs, changeSource)
+		atx, err = newUnsignedTransaction(outputs, txFee, inputSource,
+			changeSource, subtractFeeFrom, expiryHeight)
 		if err != nil {
 			return err
 		}
@@ -423,12 +736,6 @@ func (w *Wallet) txToOutputsInternal(outputs []*wire.TxOut, account uint32, minc
 		return nil, err
 	}
 
-	// Ensure valid signatures were created.
-	err = validateMsgTx(atx.Tx, atx.PrevScripts)
-	if err != nil {
-		return nil, err
-	}
-
 	// Warn when spending UTXOs controlled by imported keys created change for
 	// the default account.
 	if atx.ChangeIndex >= 0 && account == udb.ImportedAddrAccount {
@@ -437,13 +744,34 @@ func (w *Wallet) txToOutputsInternal(outputs []*wire.TxOut, account uint32, minc
 			" %v from imported account into default account.", changeAmount)
 	}
 
+	err = w.publishAuthoredTx(atx, changeSourceUpdates, chainClient)
+	if err != nil {
+		return nil, err
+	}
+	return atx, nil
+}
+
+// publishAuthoredTx validates the input signatures of a signed transaction,
+// records it in the wallet, and publishes it to the network.  Any deferred
+// change source updates are applied in the same database transaction, which is
+// rolled back if the transaction is rejected.
+func (w *Wallet) publishAuthoredTx(atx *txauthor.AuthoredTx,
+	changeSourceUpdates []func(walletdb.ReadWriteTx) error,
+	chainClient *chain.RPCClient) error {
+
+	// Ensure valid signatures were created.
+	err := validateMsgTx(atx.Tx, atx.PrevScripts)
+	if err != nil {
+		return err
+	}
+
 	// The update below uses the same codepath as notified relevant transactions
 	// and requires a serialized transaction.
 	var buf bytes.Buffer
 	buf.Grow(atx.Tx.SerializeSize())
 	err = atx.Tx.Serialize(&buf)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// Use a single DB update to store and publish the transaction.  If the
@@ -467,8 +795,11 @@ func (w *Wallet) txToOutputsInternal(outputs []*wire.TxOut, account uint32, minc
 		return err
 	})
 	if err != nil {
-		return nil, err
+		return err
 	}
+	w.forgetReleasedOutpointLocks()
+	txHash := atx.Tx.TxHash()
+	w.recordBroadcast(&txHash, time.Now(), nil)
 
 	// Watch for future address usage.
 	err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
@@ -478,6 +809,77 @@ func (w *Wallet) txToOutputsInternal(outputs []*wire.TxOut, account uint32, minc
 		log.Errorf("Failed to watch for future address usage after publishing "+
 			"transaction: %v", err)
 	}
+	return nil
+}
+
+// sweepAccount creates, signs, and publishes a transaction spending every
+// spendable output of an account with at least minconf confirmations and a
+// value of at least minValue to the sweep destinations.  Locked outputs are
+// not spent.
+func (w *Wallet) sweepAccount(account uint32, minconf int32, minValue abcutil.Amount,
+	destinations []txauthor.SweepDestination) (*txauthor.AuthoredTx, error) {
+
+	chainClient, err := w.requireChainClient()
+	if err != nil {
+		return nil, err
+	}
+
+	var atx *txauthor.AuthoredTx
+	err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+
+		_, tipHeight := w.TxStore.MainChainTip(txmgrNs)
+		candidates, err := w.TxStore.InputCandidates(txmgrNs, addrmgrNs,
+			account, minconf, tipHeight)
+		if err != nil {
+			return err
+		}
+		coins := make([]txauthor.Coin, 0, len(candidates))
+		for i := range candidates {
+			c := &candidates[i]
+			if c.Amount < minValue || w.LockedOutpoint(c.Input.PreviousOutPoint) {
+				continue
+			}
+			coins = append(coins, txauthor.Coin{
+				OutPoint: c.Input.PreviousOutPoint,
+				Amount:   c.Amount,
+				PkScript: c.PkScript,
+				Height:   c.Height,
+			})
+		}
+
+		expiry, err := w.expiryHeight(nil, tipHeight)
+		if err != nil {
+			return err
+		}
+		atx, err = txauthor.NewUnsignedSweepTransaction(coins, destinations,
+			w.RelayFee())
+		if err != nil {
+			return err
+		}
+		atx.Tx.Expiry = expiry
+		if atx.EstimatedSignedSerializeSize > maxStandardTxSize {
+			return fmt.Errorf("sweep transaction spending %d outputs "+
+				"exceeds the maximum standard transaction size",
+				len(coins))
+		}
+
+		secrets := &secretSource{Manager: w.Manager, addrmgrNs: addrmgrNs}
+		err = atx.AddAllInputScripts(secrets)
+		for _, done := range secrets.doneFuncs {
+			done()
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = w.publishAuthoredTx(atx, nil, chainClient)
+	if err != nil {
+		return nil, err
+	}
 	return atx, nil
 }
 
@@ -525,6 +927,84 @@ func (w *Wallet) txToMultisigInternal(dbtx walletdb.ReadWriteTx, account uint32,
 		return txToMultisigError(ErrBlockchainReorganizing)
 	}
 
+	// Create the multi-signature script and the P2SH output paying to it.
+	msScript, err := txscript.MultiSigScript(pubkeys, int(nRequired))
+	if err != nil {
+		return txToMultisigError(err)
+	}
+	scAddr, err := abcutil.NewAddressScriptHash(msScript, w.chainParams)
+	if err != nil {
+		return txToMultisigError(err)
+	}
+	p2shScript, err := txscript.PayToAddrScript(scAddr)
+	if err != nil {
+		return txToMultisigError(err)
+	}
+
+	changeSource := w.changeSource(w.persistReturnedChild(dbtx), account)
+	msgtx, forSigning, _, err := w.multisigTx(dbtx, account, amount,
+		p2shScript, minconf, changeSource)
+	if err != nil {
+		return txToMultisigError(err)
+	}
+
+	// Insert the multi-signature script into the address manager and the
+	// transaction manager.
+	_, err = w.Manager.ImportScript(addrmgrNs, msScript)
+	if err != nil {
+		// We don't care if we've already used this address.
+		if err.(apperrors.E).ErrorCode != apperrors.ErrDuplicateAddress {
+			return txToMultisigError(err)
+		}
+	}
+	err = w.TxStore.InsertTxScript(txmgrNs, msScript)
+	if err != nil {
+		return txToMultisigError(err)
+	}
+
+	if err = signMsgTx(msgtx, forSigning, w.Manager, addrmgrNs,
+		w.chainParams); err != nil {
+		return txToMultisigError(err)
+	}
+
+	_, err = chainClient.SendRawTransaction(msgtx, w.AllowHighFees)
+	if err != nil {
+		return txToMultisigError(err)
+	}
+
+	// Request updates from abcd for new transactions sent to this
+	// script hash address.
+	utilAddrs := make([]abcutil.Address, 1)
+	utilAddrs[0] = scAddr
+	err = chainClient.LoadTxFilter(false, []abcutil.Address{scAddr}, nil)
+	if err != nil {
+		return txToMultisigError(err)
+	}
+
+	err = w.insertMultisigOutIntoTxMgr(txmgrNs, msgtx, 0)
+	if err != nil {
+		return txToMultisigError(err)
+	}
+
+	ctx := &CreatedTx{
+		MsgTx:       msgtx,
+		ChangeAddr:  nil,
+		ChangeIndex: -1,
+	}
+
+	return ctx, scAddr, msScript, nil
+}
+
+// multisigTx creates an unsigned transaction paying amount to the output script
+// p2shScript, spending eligible outputs of an account.  Any change is paid to
+// the change source.  The credits spent by the transaction and the index of
+// the change output (negative if no change was added) are returned with it.
+func (w *Wallet) multisigTx(dbtx walletdb.ReadTx, account uint32,
+	amount abcutil.Amount, p2shScript []byte, minconf int32,
+	changeSource txauthor.ChangeSource) (*wire.MsgTx, []udb.Credit, int, error) {
+
+	txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+
 	// Get current block's height and hash.
 	_, topHeight := w.TxStore.MainChainTip(txmgrNs)
 
@@ -546,11 +1026,11 @@ func (w *Wallet) txToMultisigInternal(dbtx walletdb.ReadWriteTx, account uint32,
 	eligible, err := w.findEligibleOutputsAmount(dbtx, account, minconf,
 		amountRequired, topHeight)
 	if err != nil {
-		return txToMultisigError(err)
+		return nil, nil, 0, err
 	}
 	if eligible == nil {
-		return txToMultisigError(
-			fmt.Errorf("Not enough funds to send to multisig address"))
+		return nil, nil, 0,
+			fmt.Errorf("Not enough funds to send to multisig address")
 	}
 
 	msgtx := wire.NewMsgTx()
@@ -564,35 +1044,9 @@ func (w *Wallet) txToMultisigInternal(dbtx walletdb.ReadWriteTx, account uint32,
 		totalInput += e.Amount
 		forSigning = append(forSigning, e)
 
-		numInputs++
-	}
-
-	// Insert a multi-signature output, then insert this P2SH
-	// hash160 into the address manager and the transaction
-	// manager.
-	msScript, err := txscript.MultiSigScript(pubkeys, int(nRequired))
-	if err != nil {
-		return txToMultisigError(err)
-	}
-	_, err = w.Manager.ImportScript(addrmgrNs, msScript)
-	if err != nil {
-		// We don't care if we've already used this address.
-		if err.(apperrors.E).ErrorCode != apperrors.ErrDuplicateAddress {
-			return txToMultisigError(err)
-		}
-	}
-	err = w.TxStore.InsertTxScript(txmgrNs, msScript)
-	if err != nil {
-		return txToMultisigError(err)
-	}
-	scAddr, err := abcutil.NewAddressScriptHash(msScript, w.chainParams)
-	if err != nil {
-		return txToMultisigError(err)
-	}
-	p2shScript, err := txscript.PayToAddrScript(scAddr)
-	if err != nil {
-		return txToMultisigError(err)
+		numInputs++
 	}
+
 	txout := wire.NewTxOut(int64(amount), p2shScript)
 	msgtx.AddTxOut(txout)
 
@@ -606,49 +1060,21 @@ func (w *Wallet) txToMultisigInternal(dbtx walletdb.ReadWriteTx, account uint32,
 	feeEst := feeForSize(feeIncrement, feeSize)
 
 	if totalInput < amount+feeEst {
-		return txToMultisigError(fmt.Errorf("Not enough funds to send to " +
-			"multisig address after accounting for fees"))
+		return nil, nil, 0, fmt.Errorf("Not enough funds to send to " +
+			"multisig address after accounting for fees")
 	}
+	changeIndex := -1
 	if totalInput > amount+feeEst {
-		pkScript, _, err := w.changeSource(w.persistReturnedChild(dbtx), account)()
+		pkScript, _, err := changeSource()
 		if err != nil {
-			return txToMultisigError(err)
+			return nil, nil, 0, err
 		}
 		change := totalInput - (amount + feeEst)
 		msgtx.AddTxOut(wire.NewTxOut(int64(change), pkScript))
+		changeIndex = 1
 	}
 
-	if err = signMsgTx(msgtx, forSigning, w.Manager, addrmgrNs,
-		w.chainParams); err != nil {
-		return txToMultisigError(err)
-	}
-
-	_, err = chainClient.SendRawTransaction(msgtx, w.AllowHighFees)
-	if err != nil {
-		return txToMultisigError(err)
-	}
-
-	// Request updates from abcd for new transactions sent to this
-	// script hash address.
-	utilAddrs := make([]abcutil.Address, 1)
-	utilAddrs[0] = scAddr
-	err = chainClient.LoadTxFilter(false, []abcutil.Address{scAddr}, nil)
-	if err != nil {
-		return txToMultisigError(err)
-	}
-
-	err = w.insertMultisigOutIntoTxMgr(txmgrNs, msgtx, 0)
-	if err != nil {
-		return txToMultisigError(err)
-	}
-
-	ctx := &CreatedTx{
-		MsgTx:       msgtx,
-		ChangeAddr:  nil,
-		ChangeIndex: -1,
-	}
-
-	return ctx, scAddr, msScript, nil
+	return msgtx, forSigning, changeIndex, nil
 }
 
 // validateMsgTx verifies transaction input scripts for tx.  All previous output
@@ -720,6 +1146,53 @@ func (w *Wallet) compressWalletInternal(dbtx walletdb.ReadWriteTx, maxNumIns int
 		return nil, ErrNoOutsToConsolidate
 	}
 
+	// Check if output address is default, and generate a new adress if needed
+	if changeAddr == nil {
+		changeAddr, err = w.newChangeAddress(w.persistReturnedChild(dbtx), account)
+		if err != nil {
+			return nil, err
+		}
+	}
+	pkScript, err := txscript.PayToAddrScript(changeAddr)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create txout script: %s", err)
+	}
+	msgtx, forSigning := w.consolidationTx(eligible, maxNumIns, pkScript)
+
+	if err = signMsgTx(msgtx, forSigning, w.Manager, addrmgrNs,
+		w.chainParams); err != nil {
+		return nil, err
+	}
+	if err := validateMsgTxCredits(msgtx, forSigning); err != nil {
+		return nil, err
+	}
+
+	txSha, err := chainClient.SendRawTransaction(msgtx, w.AllowHighFees)
+	if err != nil {
+		return nil, err
+	}
+
+	// Insert the transaction and credits into the transaction manager.
+	rec, err := w.insertIntoTxMgr(txmgrNs, msgtx)
+	if err != nil {
+		return nil, err
+	}
+	err = w.insertCreditsIntoTxMgr(dbtx, msgtx, rec)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Successfully consolidated funds in transaction %v", txSha)
+
+	return txSha, nil
+}
+
+// consolidationTx creates an unsigned transaction spending up to maxNumIns of
+// the eligible outputs to a single output paying to pkScript, less the fee.
+// The credits spent by the transaction are returned with it.
+func (w *Wallet) consolidationTx(eligible []udb.Credit, maxNumIns int,
+	pkScript []byte) (*wire.MsgTx, []udb.Credit) {
+
 	txInCount := len(eligible)
 	if maxNumIns < txInCount {
 		txInCount = maxNumIns
@@ -732,17 +1205,6 @@ func (w *Wallet) compressWalletInternal(dbtx walletdb.ReadWriteTx, maxNumIns int
 
 	feeEst := feeForSize(feeIncrement, szEst)
 
-	// Check if output address is default, and generate a new adress if needed
-	if changeAddr == nil {
-		changeAddr, err = w.newChangeAddress(w.persistReturnedChild(dbtx), account)
-		if err != nil {
-			return nil, err
-		}
-	}
-	pkScript, err := txscript.PayToAddrScript(changeAddr)
-	if err != nil {
-		return nil, fmt.Errorf("cannot create txout script: %s", err)
-	}
 	msgtx := wire.NewMsgTx()
 	msgtx.AddTxOut(wire.NewTxOut(0, pkScript))
 	msgTxSize := msgtx.SerializeSize()
@@ -773,32 +1235,7 @@ func (w *Wallet) compressWalletInternal(dbtx walletdb.ReadWriteTx, maxNumIns int
 
 	msgtx.TxOut[0].Value = int64(totalAdded - feeEst)
 
-	if err = signMsgTx(msgtx, forSigning, w.Manager, addrmgrNs,
-		w.chainParams); err != nil {
-		return nil, err
-	}
-	if err := validateMsgTxCredits(msgtx, forSigning); err != nil {
-		return nil, err
-	}
-
-	txSha, err := chainClient.SendRawTransaction(msgtx, w.AllowHighFees)
-	if err != nil {
-		return nil, err
-	}
-
-	// Insert the transaction and credits into the transaction manager.
-	rec, err := w.insertIntoTxMgr(txmgrNs, msgtx)
-	if err != nil {
-		return nil, err
-	}
-	err = w.insertCreditsIntoTxMgr(dbtx, msgtx, rec)
-	if err != nil {
-		return nil, err
-	}
-
-	log.Infof("Successfully consolidated funds in transaction %v", txSha)
-
-	return txSha, nil
+	return msgtx, forSigning
 }
 
 // makeTicket creates a ticket from a split transaction output. It can optionally
@@ -910,18 +1347,22 @@ func makeTicket(params *chaincfg.Params, inputPool *extendedOutPoint,
 	return mtx, nil
 }
 
-// purchaseTickets indicates to the wallet that a ticket should be purchased
-// using all currently available funds.  The ticket address parameter in the
-// request can be nil in which case the ticket address associated with the
-// wallet instance will be used.  Also, when the spend limit in the request is
-// greater than or equal to 0, tickets that cost more than that limit will
-// return an error that not enough funds are available.
-func (w *Wallet) purchaseTickets(req purchaseTicketRequest) ([]*chainhash.Hash, error) {
-	chainClient, err := w.requireChainClient()
-	if err != nil {
-		return nil, err
-	}
+// ticketPurchase describes the tickets created by a ticket purchase request
+// and the split transaction outputs which fund them.
+type ticketPurchase struct {
+	ticketPrice     abcutil.Amount
+	ticketFee       abcutil.Amount
+	ticketFeeRate   abcutil.Amount
+	ticketSize      int
+	neededPerTicket abcutil.Amount
+	poolAddress     abcutil.Address
+	poolFeeAmt      abcutil.Amount
+	txFeeIncrement  abcutil.Amount
+}
 
+// prepareTicketPurchase checks a ticket purchase request and determines the
+// ticket price and fees of each purchased ticket.  The wallet is not modified.
+func (w *Wallet) prepareTicketPurchase(req purchaseTicketRequest) (*ticketPurchase, error) {
 	// Ensure the minimum number of required confirmations is positive.
 	if req.minConf < 0 {
 		return nil, fmt.Errorf("need positive minconf")
@@ -934,7 +1375,7 @@ func (w *Wallet) purchaseTickets(req purchaseTicketRequest) ([]*chainhash.Hash,
 
 	// Perform a sanity check on expiry.
 	var tipHeight int32
-	err = walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
 		ns := tx.ReadBucket(wtxmgrNamespaceKey)
 		_, tipHeight = w.TxStore.MainChainTip(ns)
 		return nil
@@ -947,26 +1388,6 @@ func (w *Wallet) purchaseTickets(req purchaseTicketRequest) ([]*chainhash.Hash,
 			"given: %v, next height %v)", req.expiry, tipHeight+1)
 	}
 
-	// addrFunc returns a change address.
-	addrFunc := w.newChangeAddress
-	if w.addressReuse {
-		xpub := w.addressBuffers[udb.DefaultAccountNum].albExternal.branchXpub
-		addr, err := deriveChildAddress(xpub, 0, w.chainParams)
-		addrFunc = func(persistReturnedChildFunc, uint32) (abcutil.Address, error) {
-			return addr, err
-		}
-	}
-
-	// Fetch a new address for creating a split transaction. Then,
-	// make a split transaction that contains exact outputs for use
-	// in ticket generation. Cache its hash to use below when
-	// generating a ticket. The account balance is checked first
-	// in case there is not enough money to generate the split
-	// even without fees.
-	// TODO This can still sometimes fail if the split amount
-	// required plus fees for the split is larger than the
-	// balance we have, wasting an address. In the future,
-	// address this better and prevent address burning.
 	account := req.account
 
 	// Get the current ticket price from the daemon.
@@ -1004,15 +1425,18 @@ func (w *Wallet) purchaseTickets(req purchaseTicketRequest) ([]*chainhash.Hash,
 	// pool output is needed. If the ticket fee increment is
 	// unset in the request, use the global ticket fee increment.
 	var neededPerTicket, ticketFee abcutil.Amount
+	var ticketSize int
 	ticketFeeIncrement := req.ticketFee
 	if ticketFeeIncrement == 0 {
 		ticketFeeIncrement = w.TicketFeeIncrement()
 	}
 	if poolAddress == nil {
+		ticketSize = singleInputTicketSize
 		ticketFee = (ticketFeeIncrement * singleInputTicketSize) /
 			1000
 		neededPerTicket = ticketFee + ticketPrice
 	} else {
+		ticketSize = doubleInputTicketSize
 		ticketFee = (ticketFeeIncrement * doubleInputTicketSize) /
 			1000
 		neededPerTicket = ticketFee + ticketPrice
@@ -1053,35 +1477,45 @@ func (w *Wallet) purchaseTickets(req purchaseTicketRequest) ([]*chainhash.Hash,
 		}
 	}
 
-	// Fetch the single use split address to break tickets into, to
-	// immediately be consumed as tickets.
-	//
-	// This opens a write transaction.
-	splitTxAddr, err := w.NewInternalAddress(req.account, WithGapPolicyWrap())
-	if err != nil {
-		return nil, err
+	txFeeIncrement := req.txFee
+	if txFeeIncrement == 0 {
+		txFeeIncrement = w.RelayFee()
 	}
 
-	// Create the split transaction by using txToOutputs. This varies
-	// based upon whether or not the user is using a stake pool or not.
-	// For the default stake pool implementation, the user pays out the
-	// first ticket commitment of a smaller amount to the pool, while
+	return &ticketPurchase{
+		ticketPrice:     ticketPrice,
+		ticketFee:       ticketFee,
+		ticketFeeRate:   ticketFeeIncrement,
+		ticketSize:      ticketSize,
+		neededPerTicket: neededPerTicket,
+		poolAddress:     poolAddress,
+		poolFeeAmt:      poolFeeAmt,
+		txFeeIncrement:  txFeeIncrement,
+	}, nil
+}
+
+// splitOutputs returns the outputs of the split transaction funding each
+// ticket, paying to splitTxAddr.
+func (p *ticketPurchase) splitOutputs(numTickets int, splitTxAddr abcutil.Address) ([]*wire.TxOut, error) {
+	// The outputs vary based upon whether or not the user is using a stake
+	// pool or not.  For the default stake pool implementation, the user pays
+	// out the first ticket commitment of a smaller amount to the pool, while
 	// paying themselves with the larger ticket commitment.
 	var splitOuts []*wire.TxOut
-	for i := 0; i < req.numTickets; i++ {
+	for i := 0; i < numTickets; i++ {
 		// No pool used.
-		if poolAddress == nil {
+		if p.poolAddress == nil {
 			pkScript, err := txscript.PayToAddrScript(splitTxAddr)
 			if err != nil {
 				return nil, fmt.Errorf("cannot create txout script: %s", err)
 			}
 
 			splitOuts = append(splitOuts,
-				wire.NewTxOut(int64(neededPerTicket), pkScript))
+				wire.NewTxOut(int64(p.neededPerTicket), pkScript))
 		} else {
 			// Stake pool used.
-			userAmt := neededPerTicket - poolFeeAmt
-			poolAmt := poolFeeAmt
+			userAmt := p.neededPerTicket - p.poolFeeAmt
+			poolAmt := p.poolFeeAmt
 
 			// Pool amount.
 			pkScript, err := txscript.PayToAddrScript(splitTxAddr)
@@ -1101,13 +1535,72 @@ func (w *Wallet) purchaseTickets(req purchaseTicketRequest) ([]*chainhash.Hash,
 		}
 
 	}
+	return splitOuts, nil
+}
 
-	txFeeIncrement := req.txFee
-	if txFeeIncrement == 0 {
-		txFeeIncrement = w.RelayFee()
+// purchaseTickets indicates to the wallet that a ticket should be purchased
+// using all currently available funds.  The ticket address parameter in the
+// request can be nil in which case the ticket address associated with the
+// wallet instance will be used.  Also, when the spend limit in the request is
+// greater than or equal to 0, tickets that cost more than that limit will
+// return an error that not enough funds are available.
+func (w *Wallet) purchaseTickets(req purchaseTicketRequest) ([]*chainhash.Hash, error) {
+	chainClient, err := w.requireChainClient()
+	if err != nil {
+		return nil, err
+	}
+
+	purchase, err := w.prepareTicketPurchase(req)
+	if err != nil {
+		return nil, err
+	}
+	ticketPrice := purchase.ticketPrice
+	poolAddress := purchase.poolAddress
+
+	// addrFunc returns a change address.
+	addrFunc := w.newChangeAddress
+	if w.addressReuse {
+		keys := w.addressBuffers[udb.DefaultAccountNum].albExternal.branchKeys
+		addr, err := keys.deriveChildAddress(0, w.chainParams)
+		addrFunc = func(persistReturnedChildFunc, uint32) (abcutil.Address, error) {
+			return addr, err
+		}
+	}
+
+	// Fetch a new address for creating a split transaction. Then,
+	// make a split transaction that contains exact outputs for use
+	// in ticket generation. Cache its hash to use below when
+	// generating a ticket. The account balance is checked first
+	// in case there is not enough money to generate the split
+	// even without fees.
+	// TODO This can still sometimes fail if the split amount
+	// required plus fees for the split is larger than the
+	// balance we have, wasting an address. In the future,
+	// address this better and prevent address burning.
+	account := req.account
+
+	// Fetch the single use split address to break tickets into, to
+	// immediately be consumed as tickets.
+	//
+	// This opens a write transaction.
+	splitTxAddr, err := w.NewInternalAddress(req.account, WithGapPolicyWrap())
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the split transaction by using txToOutputs. It contains exact
+	// outputs for use in ticket generation.  It expires with the tickets that
+	// spend it.
+	splitOuts, err := purchase.splitOutputs(req.numTickets, splitTxAddr)
+	if err != nil {
+		return nil, err
 	}
+
+	txFeeIncrement := purchase.txFeeIncrement
+	splitExpiry := &TxExpiry{Height: uint32(req.expiry)}
 	splitTx, err := w.txToOutputsInternal(splitOuts, account, req.minConf,
-		chainClient, false, txFeeIncrement)
+		txauthor.CoinSelectionDefault, nil, nil, splitExpiry, chainClient,
+		false, txFeeIncrement)
 	if err != nil {
 		return nil, fmt.Errorf("failed to send split transaction: %v", err)
 	}
@@ -1323,8 +1816,45 @@ func (w *Wallet) txToSStxInternal(dbtx walletdb.ReadWriteTx, pair map[string]abc
 		return nil, ErrBlockchainReorganizing
 	}
 
+	newAddr := func() (abcutil.Address, error) {
+		return w.newChangeAddress(w.persistReturnedChild(dbtx),
+			udb.DefaultAccountNum)
+	}
+	msgtx, _, err := w.sstxMsgTx(pair, inputs, payouts, newAddr)
+	if err != nil {
+		return nil, err
+	}
+	err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		return signMsgTx(msgtx, inputCredits, w.Manager, addrmgrNs, w.chainParams)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := validateMsgTxCredits(msgtx, inputCredits); err != nil {
+		return nil, err
+	}
+	info := &CreatedTx{
+		MsgTx:       msgtx,
+		ChangeAddr:  nil,
+		ChangeIndex: -1,
+	}
+
+	// TODO: Add to the stake manager
+
+	return info, nil
+}
+
+// sstxMsgTx creates an unsigned SStx spending the inputs to the ticket
+// address/amount pairs and commitment payouts.  Payouts without an address or
+// change address use an address returned by newAddr.  The total value of the
+// inputs is returned with the transaction.
+func (w *Wallet) sstxMsgTx(pair map[string]abcutil.Amount, inputs []abcjson.SStxInput,
+	payouts []abcjson.SStxCommitOut,
+	newAddr func() (abcutil.Address, error)) (*wire.MsgTx, abcutil.Amount, error) {
+
 	if len(inputs) != len(payouts) {
-		return nil, fmt.Errorf("input and payout must have the same length")
+		return nil, 0, fmt.Errorf("input and payout must have the same length")
 	}
 
 	// create new empty msgTx
@@ -1333,18 +1863,18 @@ func (w *Wallet) txToSStxInternal(dbtx walletdb.ReadWriteTx, pair map[string]abc
 	// create tx output from pair addr given
 	for addrStr, amt := range pair {
 		if amt <= 0 {
-			return nil, ErrNonPositiveAmount
+			return nil, 0, ErrNonPositiveAmount
 		}
 		minAmount += amt
 		addr, err := abcutil.DecodeAddress(addrStr, w.chainParams)
 		if err != nil {
-			return nil, fmt.Errorf("cannot decode address: %s", err)
+			return nil, 0, fmt.Errorf("cannot decode address: %s", err)
 		}
 
 		// Add output to spend amt to addr.
 		pkScript, err := txscript.PayToSStx(addr)
 		if err != nil {
-			return nil, fmt.Errorf("cannot create txout script: %s", err)
+			return nil, 0, fmt.Errorf("cannot create txout script: %s", err)
 		}
 		txout := wire.NewTxOut(int64(amt), pkScript)
 
@@ -1357,11 +1887,11 @@ func (w *Wallet) txToSStxInternal(dbtx walletdb.ReadWriteTx, pair map[string]abc
 	for _, input := range inputs {
 		txHash, err := chainhash.NewHashFromStr(input.Txid)
 		if err != nil {
-			return nil, abcjson.ErrDecodeHexString
+			return nil, 0, abcjson.ErrDecodeHexString
 		}
 
 		if input.Vout < 0 {
-			return nil, abcjson.Error{
+			return nil, 0, abcjson.Error{
 				Code:    abcjson.ErrInvalidParameter.Code,
 				Message: "Invalid parameter, vout must be positive",
 			}
@@ -1369,7 +1899,7 @@ func (w *Wallet) txToSStxInternal(dbtx walletdb.ReadWriteTx, pair map[string]abc
 
 		if !(input.Tree == wire.TxTreeRegular ||
 			input.Tree == wire.TxTreeStake) {
-			return nil, abcjson.Error{
+			return nil, 0, abcjson.Error{
 				Code:    abcjson.ErrInvalidParameter.Code,
 				Message: "Invalid parameter, tx tree must be regular or stake",
 			}
@@ -1381,7 +1911,7 @@ func (w *Wallet) txToSStxInternal(dbtx walletdb.ReadWriteTx, pair map[string]abc
 	}
 
 	if totalAdded < minAmount {
-		return nil, ErrSStxNotEnoughFunds
+		return nil, 0, ErrSStxNotEnoughFunds
 	}
 	rewards := []string{}
 	for _, value := range payouts {
@@ -1389,6 +1919,7 @@ func (w *Wallet) txToSStxInternal(dbtx walletdb.ReadWriteTx, pair map[string]abc
 	}
 
 	var changeAddr abcutil.Address
+	var err error
 
 	for i := range inputs {
 		// Add the OP_RETURN commitment amounts and payout to
@@ -1396,17 +1927,15 @@ func (w *Wallet) txToSStxInternal(dbtx walletdb.ReadWriteTx, pair map[string]abc
 		var addr abcutil.Address
 
 		if payouts[i].Addr == "" {
-			var err error
-			addr, err = w.newChangeAddress(w.persistReturnedChild(dbtx),
-				udb.DefaultAccountNum)
+			addr, err = newAddr()
 			if err != nil {
-				return nil, err
+				return nil, 0, err
 			}
 		} else {
 			addr, err = abcutil.DecodeAddress(payouts[i].Addr,
 				w.chainParams)
 			if err != nil {
-				return nil, fmt.Errorf("cannot decode address: %s", err)
+				return nil, 0, fmt.Errorf("cannot decode address: %s", err)
 			}
 
 			// Ensure the address is one of the supported types and that
@@ -1415,7 +1944,7 @@ func (w *Wallet) txToSStxInternal(dbtx walletdb.ReadWriteTx, pair map[string]abc
 			switch addr.(type) {
 			case *abcutil.AddressPubKeyHash:
 			default:
-				return nil, abcjson.ErrInvalidAddressOrKey
+				return nil, 0, abcjson.ErrInvalidAddressOrKey
 			}
 		}
 
@@ -1432,23 +1961,21 @@ func (w *Wallet) txToSStxInternal(dbtx walletdb.ReadWriteTx, pair map[string]abc
 		pkScript, err := txscript.GenerateSStxAddrPush(addr,
 			abcutil.Amount(payouts[i].CommitAmt), limits)
 		if err != nil {
-			return nil, fmt.Errorf("cannot create txout script: %s", err)
+			return nil, 0, fmt.Errorf("cannot create txout script: %s", err)
 		}
 		txout := wire.NewTxOut(int64(0), pkScript)
 		msgtx.AddTxOut(txout)
 
 		// Add change to txouts.
 		if payouts[i].ChangeAddr == "" {
-			var err error
-			changeAddr, err = w.newChangeAddress(w.persistReturnedChild(dbtx),
-				udb.DefaultAccountNum)
+			changeAddr, err = newAddr()
 			if err != nil {
-				return nil, err
+				return nil, 0, err
 			}
 		} else {
 			a, err := abcutil.DecodeAddress(payouts[i].ChangeAddr, w.chainParams)
 			if err != nil {
-				return nil, err
+				return nil, 0, err
 			}
 			// Ensure the address is one of the supported types and that
 			// the network encoded with the address matches the network the
@@ -1457,7 +1984,7 @@ func (w *Wallet) txToSStxInternal(dbtx walletdb.ReadWriteTx, pair map[string]abc
 			case *abcutil.AddressPubKeyHash:
 			case *abcutil.AddressScriptHash:
 			default:
-				return nil, abcjson.ErrInvalidAddressOrKey
+				return nil, 0, abcjson.ErrInvalidAddressOrKey
 			}
 			changeAddr = a
 		}
@@ -1466,32 +1993,15 @@ func (w *Wallet) txToSStxInternal(dbtx walletdb.ReadWriteTx, pair map[string]abc
 			abcutil.Amount(payouts[i].ChangeAmt),
 			changeAddr)
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
 
 	}
 	if _, err := stake.IsSStx(msgtx); err != nil {
-		return nil, err
-	}
-	err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
-		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
-		return signMsgTx(msgtx, inputCredits, w.Manager, addrmgrNs, w.chainParams)
-	})
-	if err != nil {
-		return nil, err
-	}
-	if err := validateMsgTxCredits(msgtx, inputCredits); err != nil {
-		return nil, err
+		return nil, 0, err
 	}
-	info := &CreatedTx{
-		MsgTx:       msgtx,
-		ChangeAddr:  nil,
-		ChangeIndex: -1,
-	}
-
-	// TODO: Add to the stake manager
 
-	return info, nil
+	return msgtx, totalAdded, nil
 }
 
 // txToSSGen ...
@@ -0,0 +1,301 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"github.com/abcsuite/abcd/abcjson"
+	"github.com/abcsuite/abcd/chaincfg/chainhash"
+	"github.com/abcsuite/abcd/txscript"
+	"github.com/abcsuite/abcd/wire"
+	"github.com/abcsuite/abcutil"
+	"github.com/abcsuite/abcwallet/wallet/txauthor"
+	"github.com/abcsuite/abcwallet/wallet/txrules"
+	"github.com/abcsuite/abcwallet/wallet/udb"
+	"github.com/abcsuite/abcwallet/walletdb"
+
+	"github.com/abcsuite/abcwallet/wallet/internal/txsizes"
+)
+
+// TxPreview describes the transaction a send would create.  Previews are
+// returned by the dry run variants of the transaction creation methods, which
+// do not sign, record, or publish the transaction, and do not derive new
+// addresses or lock any outputs.
+type TxPreview struct {
+	// Tx is the unsigned transaction.  Change and other outputs paying back
+	// to the wallet use the next address that would be returned, but the
+	// address is not marked as returned until a transaction is created.
+	Tx *wire.MsgTx
+
+	TotalInput                   abcutil.Amount
+	ChangeIndex                  int // negative if no change
+	EstimatedSignedSerializeSize int
+	Fee                          abcutil.Amount
+	FeeRate                      abcutil.Amount // per kB of estimated size
+}
+
+func newTxPreview(tx *wire.MsgTx, totalInput abcutil.Amount, changeIndex,
+	estimatedSize int) *TxPreview {
+
+	var totalOutput abcutil.Amount
+	for _, output := range tx.TxOut {
+		totalOutput += abcutil.Amount(output.Value)
+	}
+	fee := totalInput - totalOutput
+	var feeRate abcutil.Amount
+	if estimatedSize > 0 {
+		feeRate = fee * 1000 / abcutil.Amount(estimatedSize)
+	}
+	return &TxPreview{
+		Tx:                           tx,
+		TotalInput:                   totalInput,
+		ChangeIndex:                  changeIndex,
+		EstimatedSignedSerializeSize: estimatedSize,
+		Fee:                          fee,
+		FeeRate:                      feeRate,
+	}
+}
+
+func creditsTotal(credits []udb.Credit) abcutil.Amount {
+	var total abcutil.Amount
+	for i := range credits {
+		total += credits[i].Amount
+	}
+	return total
+}
+
+// TicketPurchasePreview describes the split transaction and tickets a ticket
+// purchase would create.
+type TicketPurchasePreview struct {
+	SplitTx *TxPreview
+
+	NumTickets          int
+	TicketPrice         abcutil.Amount
+	TicketFee           abcutil.Amount // per ticket
+	TicketFeeRate       abcutil.Amount // per kB
+	EstimatedTicketSize int
+	PoolFee             abcutil.Amount // per ticket, zero without a pool
+}
+
+// SendOutputsDryRun previews the transaction that SendOutputs would create with
+// the same arguments.
+func (w *Wallet) SendOutputsDryRun(outputs []*wire.TxOut, account uint32,
+	minconf int32, strategy txauthor.CoinSelectionStrategy,
+	coinControl *CoinControl, subtractFeeFrom []int,
+	expiry *TxExpiry) (*TxPreview, error) {
+
+	relayFee := w.RelayFee()
+	for _, output := range outputs {
+		err := txrules.CheckOutput(output, relayFee)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return w.txToOutputsDryRun(outputs, account, minconf, strategy,
+		coinControl, subtractFeeFrom, expiry, relayFee)
+}
+
+// txToOutputsDryRun previews the transaction created by txToOutputsInternal.
+func (w *Wallet) txToOutputsDryRun(outputs []*wire.TxOut, account uint32, minconf int32,
+	strategy txauthor.CoinSelectionStrategy, coinControl *CoinControl,
+	subtractFeeFrom []int, expiry *TxExpiry, txFee abcutil.Amount) (*TxPreview, error) {
+
+	var atx *txauthor.AuthoredTx
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+
+		err := w.checkSoleSignerAccount(dbtx, account)
+		if err != nil {
+			return err
+		}
+
+		_, tipHeight := w.TxStore.MainChainTip(txmgrNs)
+		inputSource, err := w.makeInputSource(txmgrNs, addrmgrNs, account,
+			minconf, tipHeight, strategy, coinControl, txFee)
+		if err != nil {
+			return err
+		}
+		expiryHeight, err := w.expiryHeight(expiry, tipHeight)
+		if err != nil {
+			return err
+		}
+		atx, err = newUnsignedTransaction(outputs, txFee, inputSource,
+			w.peekChangeSource(account), subtractFeeFrom, expiryHeight)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newTxPreview(atx.Tx, atx.TotalInput, atx.ChangeIndex,
+		atx.EstimatedSignedSerializeSize), nil
+}
+
+// BumpFeeDryRun previews the child transaction that BumpFee would create with
+// the same arguments.  The returned child transaction is not signed.
+func (w *Wallet) BumpFeeDryRun(parentHash *chainhash.Hash, feeRate abcutil.Amount) (*FeeBump, error) {
+	var bump *FeeBump
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		_, bump, err = w.feeBumpTx(dbtx, parentHash, feeRate, w.peekChangeSource)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bump, nil
+}
+
+// ConsolidateDryRun previews the transaction that Consolidate would create with
+// the same arguments.
+func (w *Wallet) ConsolidateDryRun(inputs int, account uint32,
+	address abcutil.Address) (*TxPreview, error) {
+
+	var preview *TxPreview
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+
+		_, tipHeight := w.TxStore.MainChainTip(txmgrNs)
+		eligible, err := w.findEligibleOutputs(dbtx, account, 1, tipHeight)
+		if err != nil {
+			return err
+		}
+		if len(eligible) == 0 {
+			return ErrNoOutsToConsolidate
+		}
+
+		if address == nil {
+			address, err = w.peekChangeAddress(account)
+			if err != nil {
+				return err
+			}
+		}
+		pkScript, err := txscript.PayToAddrScript(address)
+		if err != nil {
+			return err
+		}
+		msgtx, credits := w.consolidationTx(eligible, inputs, pkScript)
+		preview = newTxPreview(msgtx, creditsTotal(credits), -1,
+			estimateTxSize(len(credits), 1))
+		return nil
+	})
+	return preview, err
+}
+
+// CreateMultisigTxDryRun previews the transaction that CreateMultisigTx would
+// create with the same arguments.  The multisig script is not imported.
+func (w *Wallet) CreateMultisigTxDryRun(account uint32, amount abcutil.Amount,
+	pubkeys []*abcutil.AddressSecpPubKey, nrequired int8,
+	minconf int32) (*TxPreview, error) {
+
+	msScript, err := txscript.MultiSigScript(pubkeys, int(nrequired))
+	if err != nil {
+		return nil, err
+	}
+	scAddr, err := abcutil.NewAddressScriptHash(msScript, w.chainParams)
+	if err != nil {
+		return nil, err
+	}
+	p2shScript, err := txscript.PayToAddrScript(scAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	var preview *TxPreview
+	err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		msgtx, credits, changeIndex, err := w.multisigTx(dbtx, account,
+			amount, p2shScript, minconf, w.peekChangeSource(account))
+		if err != nil {
+			return err
+		}
+		preview = newTxPreview(msgtx, creditsTotal(credits), changeIndex,
+			estimateTxSize(len(credits), len(msgtx.TxOut)))
+		return nil
+	})
+	return preview, err
+}
+
+// CreateSStxTxDryRun previews the ticket that CreateSStxTx would create with
+// the same arguments.  Every input is assumed to redeem a P2PKH output when
+// estimating the signed size.
+func (w *Wallet) CreateSStxTxDryRun(pair map[string]abcutil.Amount,
+	inputs []abcjson.SStxInput, couts []abcjson.SStxCommitOut) (*TxPreview, error) {
+
+	newAddr := func() (abcutil.Address, error) {
+		return w.peekChangeAddress(udb.DefaultAccountNum)
+	}
+	msgtx, totalInput, err := w.sstxMsgTx(pair, inputs, couts, newAddr)
+	if err != nil {
+		return nil, err
+	}
+	size := txsizes.EstimateSerializeSize(len(msgtx.TxIn), msgtx.TxOut, false)
+	return newTxPreview(msgtx, totalInput, -1, size), nil
+}
+
+// PurchaseTicketsDryRun previews the split transaction and tickets that
+// PurchaseTickets would create with the same arguments.  The tickets
+// themselves depend on the hash of the signed split transaction and are only
+// described by their price, fees, and estimated size.
+func (w *Wallet) PurchaseTicketsDryRun(minBalance, spendLimit abcutil.Amount,
+	minConf int32, ticketAddr abcutil.Address, account uint32,
+	numTickets int, poolAddress abcutil.Address, poolFees float64,
+	expiry int32, txFee abcutil.Amount, ticketFee abcutil.Amount) (*TicketPurchasePreview, error) {
+
+	_, err := w.requireChainClient()
+	if err != nil {
+		return nil, err
+	}
+
+	req := purchaseTicketRequest{
+		minBalance:  minBalance,
+		spendLimit:  spendLimit,
+		minConf:     minConf,
+		ticketAddr:  ticketAddr,
+		account:     account,
+		numTickets:  numTickets,
+		poolAddress: poolAddress,
+		poolFees:    poolFees,
+		expiry:      expiry,
+		txFee:       txFee,
+		ticketFee:   ticketFee,
+	}
+	purchase, err := w.prepareTicketPurchase(req)
+	if err != nil {
+		return nil, err
+	}
+
+	splitTxAddr, err := w.peekChangeAddress(account)
+	if err != nil {
+		return nil, err
+	}
+	splitOuts, err := purchase.splitOutputs(numTickets, splitTxAddr)
+	if err != nil {
+		return nil, err
+	}
+	splitTx, err := w.txToOutputsDryRun(splitOuts, account, minConf,
+		txauthor.CoinSelectionDefault, nil, nil, &TxExpiry{Height: uint32(expiry)},
+		purchase.txFeeIncrement)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TicketPurchasePreview{
+		SplitTx:             splitTx,
+		NumTickets:          numTickets,
+		TicketPrice:         purchase.ticketPrice,
+		TicketFee:           purchase.ticketFee,
+		TicketFeeRate:       purchase.ticketFeeRate,
+		EstimatedTicketSize: purchase.ticketSize,
+		PoolFee:             purchase.poolFeeAmt,
+	}, nil
+}
+
+// PreviewChangeSource returns a change source for NewUnsignedTransaction that
+// pays to the account's next internal address without returning it.  Callers
+// constructing transactions that will not be signed or published use this to
+// avoid using up change addresses.
+func (w *Wallet) PreviewChangeSource(account uint32) txauthor.ChangeSource {
+	return w.peekChangeSource(account)
+}
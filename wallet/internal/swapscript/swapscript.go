@@ -0,0 +1,167 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package swapscript creates and parses the scripts of hash time locked
+// contracts used to perform cross-chain atomic swaps.
+//
+// A contract pays to the recipient's pubkey hash once the secret hashing to
+// the contract's secret hash is revealed, or refunds to the refund pubkey hash
+// after the contract's locktime:
+//
+//	OP_IF
+//	  OP_SIZE 32 OP_EQUALVERIFY OP_SHA256 <secret hash> OP_EQUALVERIFY
+//	  OP_DUP OP_HASH160 <recipient pubkey hash>
+//	OP_ELSE
+//	  <locktime> OP_CHECKLOCKTIMEVERIFY OP_DROP
+//	  OP_DUP OP_HASH160 <refund pubkey hash>
+//	OP_ENDIF
+//	OP_EQUALVERIFY OP_CHECKSIG
+//
+// Contracts are paid to using P2SH outputs.  Since the secret is revealed by
+// the signature script of the redeeming transaction, the counterparty of a swap
+// may use it to redeem the contract on the other chain.
+package swapscript
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+
+	"github.com/abcsuite/abcd/txscript"
+)
+
+// SecretSize is the size of the secret of a contract.
+const SecretSize = 32
+
+// Contract describes the details of a contract script.
+type Contract struct {
+	SecretHash    [sha256.Size]byte
+	RecipientHash [20]byte
+	RefundHash    [20]byte
+	LockTime      int64
+}
+
+// ErrNotContract is returned by Parse when the script is not a contract.
+var ErrNotContract = errors.New("script is not an atomic swap contract")
+
+// Script returns the contract script.
+func (c *Contract) Script() ([]byte, error) {
+	b := txscript.NewScriptBuilder()
+
+	b.AddOp(txscript.OP_IF)
+	{
+		b.AddOp(txscript.OP_SIZE)
+		b.AddInt64(SecretSize)
+		b.AddOp(txscript.OP_EQUALVERIFY)
+		b.AddOp(txscript.OP_SHA256)
+		b.AddData(c.SecretHash[:])
+		b.AddOp(txscript.OP_EQUALVERIFY)
+		b.AddOp(txscript.OP_DUP)
+		b.AddOp(txscript.OP_HASH160)
+		b.AddData(c.RecipientHash[:])
+	}
+	b.AddOp(txscript.OP_ELSE)
+	{
+		b.AddInt64(c.LockTime)
+		b.AddOp(txscript.OP_CHECKLOCKTIMEVERIFY)
+		b.AddOp(txscript.OP_DROP)
+		b.AddOp(txscript.OP_DUP)
+		b.AddOp(txscript.OP_HASH160)
+		b.AddData(c.RefundHash[:])
+	}
+	b.AddOp(txscript.OP_ENDIF)
+	b.AddOp(txscript.OP_EQUALVERIFY)
+	b.AddOp(txscript.OP_CHECKSIG)
+
+	return b.Script()
+}
+
+// decodeScriptNum decodes a minimally encoded script number of at most 5 bytes.
+func decodeScriptNum(b []byte) (int64, bool) {
+	if len(b) == 0 || len(b) > 5 {
+		return 0, false
+	}
+	var n int64
+	for i, v := range b {
+		n |= int64(v) << uint(8*i)
+	}
+	if b[len(b)-1]&0x80 != 0 {
+		n &^= int64(0x80) << uint(8*(len(b)-1))
+		n = -n
+	}
+	return n, true
+}
+
+// Parse returns the details of a contract script.  ErrNotContract is returned
+// if the script does not exactly match the contract template.
+func Parse(script []byte) (*Contract, error) {
+	pushes, err := txscript.PushedData(script)
+	if err != nil || len(pushes) != 5 {
+		return nil, ErrNotContract
+	}
+	if len(pushes[1]) != sha256.Size || len(pushes[2]) != 20 ||
+		len(pushes[4]) != 20 {
+		return nil, ErrNotContract
+	}
+	lockTime, ok := decodeScriptNum(pushes[3])
+	if !ok || lockTime <= 0 {
+		return nil, ErrNotContract
+	}
+
+	c := &Contract{LockTime: lockTime}
+	copy(c.SecretHash[:], pushes[1])
+	copy(c.RecipientHash[:], pushes[2])
+	copy(c.RefundHash[:], pushes[4])
+
+	// The pushes only identify the contract if the script is recreated
+	// exactly from them.
+	expected, err := c.Script()
+	if err != nil || !bytes.Equal(script, expected) {
+		return nil, ErrNotContract
+	}
+	return c, nil
+}
+
+// RedeemSigScript returns the signature script which redeems a contract by
+// revealing the secret.  The signature and public key must be of the
+// recipient's key.
+func RedeemSigScript(sig, pubKey, secret, contract []byte) ([]byte, error) {
+	b := txscript.NewScriptBuilder()
+	b.AddData(sig)
+	b.AddData(pubKey)
+	b.AddData(secret)
+	b.AddInt64(1)
+	b.AddData(contract)
+	return b.Script()
+}
+
+// RefundSigScript returns the signature script which refunds a contract after
+// its locktime.  The signature and public key must be of the refund key.
+func RefundSigScript(sig, pubKey, contract []byte) ([]byte, error) {
+	b := txscript.NewScriptBuilder()
+	b.AddData(sig)
+	b.AddData(pubKey)
+	b.AddInt64(0)
+	b.AddData(contract)
+	return b.Script()
+}
+
+// ExtractSecret returns the secret hashing to secretHash which is pushed by a
+// signature script, or nil if the script does not reveal the secret.
+func ExtractSecret(sigScript []byte, secretHash []byte) []byte {
+	pushes, err := txscript.PushedData(sigScript)
+	if err != nil {
+		return nil
+	}
+	for _, push := range pushes {
+		if len(push) != SecretSize {
+			continue
+		}
+		h := sha256.Sum256(push)
+		if bytes.Equal(h[:], secretHash) {
+			return push
+		}
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package swapscript
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+)
+
+func testContract(lockTime int64) *Contract {
+	c := &Contract{
+		SecretHash: sha256.Sum256(bytes.Repeat([]byte{1}, SecretSize)),
+		LockTime:   lockTime,
+	}
+	copy(c.RecipientHash[:], bytes.Repeat([]byte{2}, 20))
+	copy(c.RefundHash[:], bytes.Repeat([]byte{3}, 20))
+	return c
+}
+
+func TestParse(t *testing.T) {
+	for _, lockTime := range []int64{17, 0x80, 0x7fff, 1500000000, 0x7fffffff, 0xffffffff} {
+		c := testContract(lockTime)
+		script, err := c.Script()
+		if err != nil {
+			t.Fatal(err)
+		}
+		parsed, err := Parse(script)
+		if err != nil {
+			t.Errorf("locktime %d: %v", lockTime, err)
+			continue
+		}
+		if *parsed != *c {
+			t.Errorf("locktime %d: parsed contract %+v, want %+v", lockTime,
+				parsed, c)
+		}
+
+		// Any change to the template is not a contract.
+		modified := append([]byte(nil), script...)
+		modified[len(modified)-1]--
+		if _, err := Parse(modified); err != ErrNotContract {
+			t.Errorf("locktime %d: parsed modified script, error %v",
+				lockTime, err)
+		}
+	}
+}
+
+func TestExtractSecret(t *testing.T) {
+	secret := bytes.Repeat([]byte{1}, SecretSize)
+	c := testContract(1500000000)
+	contract, err := c.Script()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig := bytes.Repeat([]byte{4}, 72)
+	pubKey := bytes.Repeat([]byte{5}, 33)
+
+	redeem, err := RedeemSigScript(sig, pubKey, secret, contract)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := ExtractSecret(redeem, c.SecretHash[:]); !bytes.Equal(s, secret) {
+		t.Errorf("extracted secret %x, want %x", s, secret)
+	}
+
+	refund, err := RefundSigScript(sig, pubKey, contract)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := ExtractSecret(refund, c.SecretHash[:]); s != nil {
+		t.Errorf("extracted secret %x from refund", s)
+	}
+}
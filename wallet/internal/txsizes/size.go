@@ -57,8 +57,67 @@ const (
 	//   - 1 byte compact int encoding value 25
 	//   - 25 bytes P2PKH output script
 	P2PKHOutputSize = 8 + 2 + 1 + P2PKHPkScriptSize
+
+	// P2SHPkScriptSize is the size of a transaction output script that
+	// pays to a script hash.  It is calculated as:
+	//
+	//   - OP_HASH160
+	//   - OP_DATA_20
+	//   - 20 bytes script hash
+	//   - OP_EQUAL
+	P2SHPkScriptSize = 1 + 1 + 20 + 1
 )
 
+// MultisigScriptSize returns the size of an m-of-n multisig script paying to n
+// compressed pubkeys.  It is calculated as:
+//
+//   - OP_m
+//   - n times OP_DATA_33 and 33 bytes serialized compressed pubkey
+//   - OP_n
+//   - OP_CHECKMULTISIG
+func MultisigScriptSize(n int) int {
+	return 1 + n*(1+33) + 1 + 1
+}
+
+// pushSize returns the size of a canonical data push of l bytes.
+func pushSize(l int) int {
+	switch {
+	case l < 0x4c: // OP_DATA_1 through OP_DATA_75
+		return 1 + l
+	case l <= 0xff: // OP_PUSHDATA1
+		return 2 + l
+	default: // OP_PUSHDATA2
+		return 3 + l
+	}
+}
+
+// RedeemP2SHMultisigSigScriptSize returns the worst case (largest) serialize
+// size of a transaction input script that redeems a P2SH output with an m-of-n
+// multisig redeem script of compressed pubkeys.  It is calculated as:
+//
+//   - m times OP_DATA_73 and 72 bytes DER signature + 1 byte sighash
+//   - push of the redeem script
+func RedeemP2SHMultisigSigScriptSize(m, n int) int {
+	return m*(1+73) + pushSize(MultisigScriptSize(n))
+}
+
+// InputSize returns the serialize size of a transaction input with a signature
+// script of sigScriptSize bytes.  It is calculated as:
+//
+//   - 32 bytes previous tx
+//   - 4 bytes output index
+//   - 1 byte tree
+//   - 8 bytes amount
+//   - 4 bytes block height
+//   - 4 bytes block index
+//   - compact int encoding sigScriptSize
+//   - sigScriptSize bytes signature script
+//   - 4 bytes sequence
+func InputSize(sigScriptSize int) int {
+	return 32 + 4 + 1 + 8 + 4 + 4 +
+		wire.VarIntSerializeSize(uint64(sigScriptSize)) + sigScriptSize + 4
+}
+
 // EstimateSerializeSize returns a worst case serialize size estimate for a
 // signed transaction that spends inputCount number of compressed P2PKH outputs
 // and contains each transaction output from txOuts.  The estimated size is
@@ -78,3 +137,75 @@ func EstimateSerializeSize(inputCount int, txOuts []*wire.TxOut, addChangeOutput
 		h.SumOutputSerializeSizes(txOuts) +
 		changeSize
 }
+
+// EstimateSerializeSizeFromScriptSizes returns a worst case serialize size
+// estimate for a signed transaction with inputs redeemed by signature scripts
+// of each size in sigScriptSizes and containing each transaction output from
+// txOuts.  The estimated size is incremented for an additional change output
+// if changeScriptSize is greater than zero.
+func EstimateSerializeSizeFromScriptSizes(sigScriptSizes []int, txOuts []*wire.TxOut,
+	changeScriptSize int) int {
+
+	inputCount := len(sigScriptSizes)
+	inputsSize := 0
+	for _, size := range sigScriptSizes {
+		inputsSize += InputSize(size)
+	}
+	changeSize := 0
+	outputCount := len(txOuts)
+	if changeScriptSize > 0 {
+		changeSize = 8 + 2 + wire.VarIntSerializeSize(uint64(changeScriptSize)) +
+			changeScriptSize
+		outputCount++
+	}
+
+	// 12 additional bytes are for version, locktime and expiry.
+	return 12 + (2 * wire.VarIntSerializeSize(uint64(inputCount))) +
+		wire.VarIntSerializeSize(uint64(outputCount)) +
+		inputsSize +
+		h.SumOutputSerializeSizes(txOuts) +
+		changeSize
+}
+
+// RedeemAtomicSwapSigScriptSize returns the worst case (largest) serialize
+// size of a transaction input script that redeems a P2SH atomic swap contract
+// of contractSize bytes with the secret.  It is calculated as:
+//
+//   - OP_DATA_73
+//   - 72 bytes DER signature + 1 byte sighash
+//   - OP_DATA_33
+//   - 33 bytes serialized compressed pubkey
+//   - OP_DATA_32
+//   - 32 bytes secret
+//   - OP_TRUE
+//   - push of the contract
+func RedeemAtomicSwapSigScriptSize(contractSize int) int {
+	return 1 + 73 + 1 + 33 + 1 + 32 + 1 + pushSize(contractSize)
+}
+
+// RefundAtomicSwapSigScriptSize returns the worst case (largest) serialize
+// size of a transaction input script that refunds a P2SH atomic swap contract
+// of contractSize bytes after its locktime.  It is calculated as:
+//
+//   - OP_DATA_73
+//   - 72 bytes DER signature + 1 byte sighash
+//   - OP_DATA_33
+//   - 33 bytes serialized compressed pubkey
+//   - OP_FALSE
+//   - push of the contract
+func RefundAtomicSwapSigScriptSize(contractSize int) int {
+	return 1 + 73 + 1 + 33 + 1 + pushSize(contractSize)
+}
+
+// RedeemVaultSigScriptSize returns the worst case (largest) serialize size of
+// a transaction input script that spends a P2SH vault of scriptSize bytes
+// after its lock expires.  It is calculated as:
+//
+//   - OP_DATA_73
+//   - 72 bytes DER signature + 1 byte sighash
+//   - OP_DATA_33
+//   - 33 bytes serialized compressed pubkey
+//   - push of the vault script
+func RedeemVaultSigScriptSize(scriptSize int) int {
+	return 1 + 73 + 1 + 33 + pushSize(scriptSize)
+}
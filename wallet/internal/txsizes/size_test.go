@@ -60,3 +60,40 @@ func TestEstimateSerializeSize(t *testing.T) {
 		}
 	}
 }
+
+func TestEstimateSerializeSizeFromScriptSizes(t *testing.T) {
+	// Estimates for P2PKH inputs and change match EstimateSerializeSize.
+	for _, inputCount := range []int{1, 2, 0xfd} {
+		outputs := []*wire.TxOut{{PkScript: make([]byte, p2shScriptSize)}}
+		sizes := makeInts(RedeemP2PKHSigScriptSize, inputCount)
+		got := EstimateSerializeSizeFromScriptSizes(sizes, outputs, P2PKHPkScriptSize)
+		want := EstimateSerializeSize(inputCount, outputs, true)
+		if got != want {
+			t.Errorf("%d P2PKH inputs: Got %v: Expected %v", inputCount, got, want)
+		}
+	}
+
+	tests := []struct {
+		M, N                 int
+		SigScriptSize        int
+		ExpectedSizeEstimate int
+	}{
+		// 2 signatures + OP_PUSHDATA1 push of a 105 byte script.  The
+		// signature script length is encoded with a 3 byte compact int.
+		0: {2, 3, 255, 12 + 2 + 1 + 319 + 34},
+		// 1 signature + OP_DATA_71 push of a 71 byte script.
+		1: {1, 2, 146, 12 + 2 + 1 + 208 + 34},
+	}
+	for i, test := range tests {
+		sigScriptSize := RedeemP2SHMultisigSigScriptSize(test.M, test.N)
+		if sigScriptSize != test.SigScriptSize {
+			t.Errorf("Test %d: signature script size: Got %v: Expected %v",
+				i, sigScriptSize, test.SigScriptSize)
+		}
+		got := EstimateSerializeSizeFromScriptSizes([]int{sigScriptSize}, nil,
+			P2SHPkScriptSize)
+		if got != test.ExpectedSizeEstimate {
+			t.Errorf("Test %d: Got %v: Expected %v", i, got, test.ExpectedSizeEstimate)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package vaultscript creates and parses the scripts of time locked savings
+// outputs (vaults) which pay to a single pubkey hash once a lock expires.
+//
+// A vault is locked either until an absolute locktime, enforced with
+// OP_CHECKLOCKTIMEVERIFY:
+//
+//	<locktime> OP_CHECKLOCKTIMEVERIFY OP_DROP
+//	OP_DUP OP_HASH160 <pubkey hash> OP_EQUALVERIFY OP_CHECKSIG
+//
+// or for a relative time after the output is mined, enforced with
+// OP_CHECKSEQUENCEVERIFY:
+//
+//	<sequence> OP_CHECKSEQUENCEVERIFY OP_DROP
+//	OP_DUP OP_HASH160 <pubkey hash> OP_EQUALVERIFY OP_CHECKSIG
+//
+// Vaults are paid to using P2SH outputs.
+package vaultscript
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/abcsuite/abcd/txscript"
+	"github.com/abcsuite/abcd/wire"
+)
+
+// Vault describes the details of a vault script.  If Relative is false,
+// LockTime is an absolute block height or unix time as interpreted by
+// OP_CHECKLOCKTIMEVERIFY.  Otherwise it is a relative lock sequence number as
+// interpreted by OP_CHECKSEQUENCEVERIFY.
+type Vault struct {
+	PubKeyHash [20]byte
+	LockTime   int64
+	Relative   bool
+}
+
+// ErrNotVault is returned by Parse when the script is not a vault.
+var ErrNotVault = errors.New("script is not a vault")
+
+// RelativeBlocks returns the sequence number of a relative lock of some number
+// of blocks.
+func RelativeBlocks(blocks uint16) int64 {
+	return int64(blocks)
+}
+
+// RelativeSeconds returns the sequence number of a relative lock of at least
+// some number of seconds.  Relative time locks have a granularity of 512
+// seconds, so the lock is rounded up.  An error is returned if the lock is too
+// long to be encoded.
+func RelativeSeconds(seconds int64) (int64, error) {
+	const granularity = 1 << wire.SequenceLockTimeGranularity
+	units := (seconds + granularity - 1) / granularity
+	if seconds <= 0 || units > wire.SequenceLockTimeMask {
+		return 0, errors.New("relative lock time out of range")
+	}
+	return wire.SequenceLockTimeIsSeconds | units, nil
+}
+
+// IsSeconds returns whether a relative lock sequence number is a time rather
+// than a number of blocks.
+func IsSeconds(sequence int64) bool {
+	return sequence&wire.SequenceLockTimeIsSeconds != 0
+}
+
+// RelativeLockValue returns the number of blocks or seconds of a relative lock
+// sequence number.
+func RelativeLockValue(sequence int64) int64 {
+	v := sequence & wire.SequenceLockTimeMask
+	if IsSeconds(sequence) {
+		v <<= wire.SequenceLockTimeGranularity
+	}
+	return v
+}
+
+// Script returns the vault script.
+func (v *Vault) Script() ([]byte, error) {
+	b := txscript.NewScriptBuilder()
+	b.AddInt64(v.LockTime)
+	if v.Relative {
+		b.AddOp(txscript.OP_CHECKSEQUENCEVERIFY)
+	} else {
+		b.AddOp(txscript.OP_CHECKLOCKTIMEVERIFY)
+	}
+	b.AddOp(txscript.OP_DROP)
+	b.AddOp(txscript.OP_DUP)
+	b.AddOp(txscript.OP_HASH160)
+	b.AddData(v.PubKeyHash[:])
+	b.AddOp(txscript.OP_EQUALVERIFY)
+	b.AddOp(txscript.OP_CHECKSIG)
+	return b.Script()
+}
+
+// decodeScriptNum decodes a minimally encoded script number of at most 5 bytes.
+func decodeScriptNum(b []byte) (int64, bool) {
+	if len(b) == 0 || len(b) > 5 {
+		return 0, false
+	}
+	var n int64
+	for i, v := range b {
+		n |= int64(v) << uint(8*i)
+	}
+	if b[len(b)-1]&0x80 != 0 {
+		n &^= int64(0x80) << uint(8*(len(b)-1))
+		n = -n
+	}
+	return n, true
+}
+
+// Parse returns the details of a vault script.  ErrNotVault is returned if the
+// script does not exactly match either vault template.
+func Parse(script []byte) (*Vault, error) {
+	pushes, err := txscript.PushedData(script)
+	if err != nil || len(pushes) != 2 || len(pushes[1]) != 20 {
+		return nil, ErrNotVault
+	}
+	lockTime, ok := decodeScriptNum(pushes[0])
+	if !ok || lockTime <= 0 {
+		return nil, ErrNotVault
+	}
+
+	// The pushes only identify the vault if the script is recreated exactly
+	// from them using either lock opcode.
+	for _, relative := range []bool{false, true} {
+		v := &Vault{LockTime: lockTime, Relative: relative}
+		copy(v.PubKeyHash[:], pushes[1])
+		expected, err := v.Script()
+		if err == nil && bytes.Equal(script, expected) {
+			return v, nil
+		}
+	}
+	return nil, ErrNotVault
+}
+
+// SigScript returns the signature script which spends a vault after its lock
+// expires.  The signature and public key must be of the vault's key.
+func SigScript(sig, pubKey, script []byte) ([]byte, error) {
+	b := txscript.NewScriptBuilder()
+	b.AddData(sig)
+	b.AddData(pubKey)
+	b.AddData(script)
+	return b.Script()
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package vaultscript
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	seconds, err := RelativeSeconds(86400)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []Vault{
+		{LockTime: 17},
+		{LockTime: 1500000000},
+		{LockTime: 0xffffffff},
+		{LockTime: RelativeBlocks(144), Relative: true},
+		{LockTime: seconds, Relative: true},
+	}
+	for _, v := range tests {
+		copy(v.PubKeyHash[:], bytes.Repeat([]byte{2}, 20))
+		script, err := v.Script()
+		if err != nil {
+			t.Fatal(err)
+		}
+		parsed, err := Parse(script)
+		if err != nil {
+			t.Errorf("vault %+v: %v", v, err)
+			continue
+		}
+		if *parsed != v {
+			t.Errorf("parsed vault %+v, want %+v", parsed, v)
+		}
+
+		// Any change to the template is not a vault.
+		modified := append([]byte(nil), script...)
+		modified[len(modified)-1]--
+		if _, err := Parse(modified); err != ErrNotVault {
+			t.Errorf("vault %+v: parsed modified script, error %v", v, err)
+		}
+	}
+}
+
+func TestRelativeSeconds(t *testing.T) {
+	seq, err := RelativeSeconds(1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsSeconds(seq) {
+		t.Errorf("sequence %#x is not a time lock", seq)
+	}
+	if v := RelativeLockValue(seq); v != 1024 {
+		t.Errorf("lock of %d seconds, want 1024", v)
+	}
+	if IsSeconds(RelativeBlocks(10)) {
+		t.Error("block lock is a time lock")
+	}
+	if _, err := RelativeSeconds(1 << 30); err == nil {
+		t.Error("expected error for out of range lock")
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/abcsuite/abcd/chaincfg/chainhash"
+	"github.com/abcsuite/abcd/wire"
+	"github.com/abcsuite/abcwallet/wallet/udb"
+	"github.com/abcsuite/abcwallet/walletdb"
+	_ "github.com/abcsuite/abcwallet/walletdb/bdb"
+)
+
+// lockTestWallet returns a wallet with only the database and in-memory state
+// needed to lock outpoints.
+func lockTestWallet(t *testing.T) (w *Wallet, teardown func()) {
+	tmpDir, err := ioutil.TempDir("", "lockedoutpoints_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := walletdb.Create("bdb", filepath.Join(tmpDir, "db"))
+	if err != nil {
+		os.RemoveAll(tmpDir)
+		t.Fatal(err)
+	}
+	teardown = func() {
+		db.Close()
+		os.RemoveAll(tmpDir)
+	}
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		_, err := tx.CreateTopLevelBucket([]byte("lockedoutpoints"))
+		return err
+	})
+	if err != nil {
+		teardown()
+		t.Fatal(err)
+	}
+	w = &Wallet{
+		db:              db,
+		lockedOutpoints: map[wire.OutPoint]*udb.LockedOutpoint{},
+	}
+	return w, teardown
+}
+
+// checkLocked checks that an outpoint is locked both in memory and in the
+// database, or in neither.
+func checkLocked(t *testing.T, w *Wallet, desc string, op wire.OutPoint, locked bool) {
+	if w.LockedOutpoint(op) != locked {
+		t.Errorf("%s: outpoint %v in-memory lock is %v, expected %v",
+			desc, &op, !locked, locked)
+	}
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		if udb.ExistsLockedOutpoint(tx, &op) != locked {
+			t.Errorf("%s: outpoint %v saved lock is %v, expected %v",
+				desc, &op, !locked, locked)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReleaseExpiredOutpointLocks(t *testing.T) {
+	w, teardown := lockTestWallet(t)
+	defer teardown()
+
+	never := wire.OutPoint{Hash: chainhash.Hash{1}, Index: 0}
+	early := wire.OutPoint{Hash: chainhash.Hash{1}, Index: 1}
+	late := wire.OutPoint{Hash: chainhash.Hash{2}, Index: 0}
+	for _, l := range []udb.LockedOutpoint{
+		{OutPoint: never},
+		{OutPoint: early, ExpiryHeight: 10},
+		{OutPoint: late, ExpiryHeight: 20},
+	} {
+		err := w.LockOutpoint(l.OutPoint, l.Reason, l.ExpiryHeight)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	steps := []struct {
+		height             int32
+		never, early, late bool
+	}{
+		{9, true, true, true},
+		{10, true, false, true},
+		{19, true, false, true},
+		{25, true, false, false},
+		{1 << 30, true, false, false},
+	}
+	for _, s := range steps {
+		err := w.releaseExpiredOutpointLocks(s.height)
+		if err != nil {
+			t.Fatal(err)
+		}
+		desc := fmt.Sprintf("height %d", s.height)
+		checkLocked(t, w, desc, never, s.never)
+		checkLocked(t, w, desc, early, s.early)
+		checkLocked(t, w, desc, late, s.late)
+	}
+}
+
+func TestReleaseSpentOutpointLocks(t *testing.T) {
+	w, teardown := lockTestWallet(t)
+	defer teardown()
+
+	spent := wire.OutPoint{Hash: chainhash.Hash{1}, Index: 0, Tree: wire.TxTreeRegular}
+	unspent := wire.OutPoint{Hash: chainhash.Hash{1}, Index: 1, Tree: wire.TxTreeRegular}
+	for _, op := range []wire.OutPoint{spent, unspent} {
+		err := w.LockOutpoint(op, "", 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The spending transaction records a different tree.  Locks apply to
+	// the output regardless of the tree.
+	spendOp := spent
+	spendOp.Tree = wire.TxTreeStake
+	tx := &wire.MsgTx{
+		TxIn:  []*wire.TxIn{wire.NewTxIn(&spendOp, nil)},
+		TxOut: []*wire.TxOut{{Value: 1e8}},
+	}
+
+	// A rolled back update keeps both the saved and in-memory locks.
+	errRollback := errors.New("rollback")
+	err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		err := w.releaseSpentOutpointLocks(dbtx, tx)
+		if err != nil {
+			return err
+		}
+		return errRollback
+	})
+	if err != errRollback {
+		t.Fatalf("expected rollback, got %v", err)
+	}
+	w.forgetReleasedOutpointLocks()
+	checkLocked(t, w, "rolled back spend", spent, true)
+	checkLocked(t, w, "rolled back spend", unspent, true)
+
+	// The in-memory lock is kept until the released locks are forgotten
+	// after the update commits.
+	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return w.releaseSpentOutpointLocks(dbtx, tx)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !w.LockedOutpoint(spent) {
+		t.Error("in-memory lock released before it was forgotten")
+	}
+	w.forgetReleasedOutpointLocks()
+	checkLocked(t, w, "spend", spent, false)
+	checkLocked(t, w, "spend", unspent, true)
+}
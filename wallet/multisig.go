@@ -11,6 +11,7 @@ import (
 	"github.com/abcsuite/abcd/txscript"
 	"github.com/abcsuite/abcd/wire"
 	"github.com/abcsuite/abcutil"
+	"github.com/abcsuite/abcutil/hdkeychain"
 	"github.com/abcsuite/abcwallet/apperrors"
 	"github.com/abcsuite/abcwallet/wallet/udb"
 	"github.com/abcsuite/abcwallet/walletdb"
@@ -185,3 +186,53 @@ func (w *Wallet) FetchAllRedeemScripts() ([][]byte, error) {
 	})
 	return redeemScripts, err
 }
+
+// NextMultisigAccount creates a multisig account from the account extended
+// public keys of other cosigners and returns its account number.  Addresses of
+// the account pay to requiredSigs-of-N multisig scripts of the wallet's own
+// account key and every cosigner key.  Watching-only wallets use the first
+// cosigner key as the account key.
+//
+// Outputs of multisig accounts are spent by creating a partially signed
+// transaction which every required cosigner signs.
+func (w *Wallet) NextMultisigAccount(name string, requiredSigs int, cosignerKeys []*hdkeychain.ExtendedKey) (uint32, error) {
+	var account uint32
+	var props *udb.AccountProperties
+	var extKeys, intKeys *branchKeys
+	err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		var err error
+		account, err = w.Manager.NewMultisigAccount(addrmgrNs, name,
+			requiredSigs, cosignerKeys)
+		if err != nil {
+			return err
+		}
+
+		props, extKeys, intKeys, err = w.syncNewAccount(tx, account)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	err = w.watchNewAccount(account, extKeys, intKeys)
+	if err != nil {
+		return 0, err
+	}
+
+	w.NtfnServer.notifyAccountProperties(props)
+
+	return account, nil
+}
+
+// MultisigAccount returns the signature threshold and cosigner keys of a
+// multisig account, or nil if the account is not a multisig account.
+func (w *Wallet) MultisigAccount(account uint32) (*udb.MultisigAccount, error) {
+	var ms *udb.MultisigAccount
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		var err error
+		ms, err = w.Manager.MultisigAccount(tx, account)
+		return err
+	})
+	return ms, err
+}
@@ -107,6 +107,32 @@ func lookupOutputChain(dbtx walletdb.ReadTx, w *Wallet, details *udb.TxDetails,
 	return
 }
 
+// txDataOutputs describes the data carried by the null data outputs of a
+// transaction.  Stake transactions use null data outputs for commitments and
+// block references, so only regular transactions describe their data outputs.
+func txDataOutputs(tx *wire.MsgTx, txType TransactionType) []TransactionSummaryDataOutput {
+	if txType != TransactionTypeRegular {
+		return nil
+	}
+	var dataOutputs []TransactionSummaryDataOutput
+	for i, output := range tx.TxOut {
+		class := txscript.GetScriptClass(output.Version, output.PkScript)
+		if class != txscript.NullDataTy {
+			continue
+		}
+		pushes, err := txscript.PushedData(output.PkScript)
+		if err != nil {
+			log.Errorf("Cannot parse null data output: %v", err)
+			continue
+		}
+		dataOutputs = append(dataOutputs, TransactionSummaryDataOutput{
+			Index: uint32(i),
+			Data:  bytes.Join(pushes, nil),
+		})
+	}
+	return dataOutputs
+}
+
 func makeTxSummary(dbtx walletdb.ReadTx, w *Wallet, details *udb.TxDetails) TransactionSummary {
 	serializedTx := details.SerializedTx
 	if serializedTx == nil {
@@ -154,6 +180,9 @@ func makeTxSummary(dbtx walletdb.ReadTx, w *Wallet, details *udb.TxDetails) Tran
 			Address:      address,
 			OutputScript: outputScript,
 		}
+		if address != nil {
+			output.Label = udb.FetchAddressLabel(dbtx, address.EncodeAddress())
+		}
 		outputs = append(outputs, output)
 	}
 
@@ -180,14 +209,17 @@ func makeTxSummary(dbtx walletdb.ReadTx, w *Wallet, details *udb.TxDetails) Tran
 			break
 		}
 	}
+
 	return TransactionSummary{
 		Hash:        &details.Hash,
 		Transaction: serializedTx,
 		MyInputs:    inputs,
 		MyOutputs:   outputs,
+		DataOutputs: txDataOutputs(&details.MsgTx, transactionType),
 		Fee:         fee,
 		Timestamp:   details.Received.Unix(),
 		Type:        transactionType,
+		Label:       udb.FetchTxLabel(dbtx, &details.Hash),
 	}
 }
 
@@ -307,6 +339,17 @@ func (s *NotificationServer) notifyAttachedBlock(dbtx walletdb.ReadTx, block *wi
 	}
 }
 
+func (s *NotificationServer) notifyExpiredTransactions(hashes []*chainhash.Hash) {
+	if len(hashes) == 0 {
+		return
+	}
+	if s.currentTxNtfn == nil {
+		s.currentTxNtfn = &TransactionNotifications{}
+	}
+	s.currentTxNtfn.ExpiredTransactionHashes = append(
+		s.currentTxNtfn.ExpiredTransactionHashes, hashes...)
+}
+
 func (s *NotificationServer) sendAttachedBlockNotification() {
 	// Avoid work if possible
 	s.mu.Lock()
@@ -370,7 +413,9 @@ func (s *NotificationServer) sendAttachedBlockNotification() {
 //
 // All newly added unmined transactions are included.  Removed unmined
 // transactions are not explicitly included.  Instead, the hashes of all
-// transactions still unmined are included.
+// transactions still unmined are included.  The exception is unmined
+// transactions removed because they expired at an attached block, whose hashes
+// are also included.
 //
 // If any transactions were involved, each affected account's new total balance
 // is included.
@@ -382,6 +427,7 @@ type TransactionNotifications struct {
 	DetachedBlocks           []*chainhash.Hash
 	UnminedTransactions      []TransactionSummary
 	UnminedTransactionHashes []*chainhash.Hash
+	ExpiredTransactionHashes []*chainhash.Hash
 	NewBalances              []AccountBalance
 }
 
@@ -401,9 +447,11 @@ type TransactionSummary struct {
 	Transaction []byte
 	MyInputs    []TransactionSummaryInput
 	MyOutputs   []TransactionSummaryOutput
+	DataOutputs []TransactionSummaryDataOutput
 	Fee         abcutil.Amount
 	Timestamp   int64
 	Type        TransactionType
+	Label       string
 }
 
 // TransactionType decribes the which type of transaction is has been observed to be.
@@ -452,6 +500,15 @@ type TransactionSummaryOutput struct {
 	Amount       abcutil.Amount
 	Address      abcutil.Address
 	OutputScript []byte
+	Label        string
+}
+
+// TransactionSummaryDataOutput describes a null data (OP_RETURN) output of a
+// transaction.  The Index field marks the transaction output index of the
+// transaction (not included here), and Data is the data carried by the output.
+type TransactionSummaryDataOutput struct {
+	Index uint32
+	Data  []byte
 }
 
 // AccountBalance associates a total (zero confirmation) balance with an
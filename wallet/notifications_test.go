@@ -0,0 +1,45 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/abcsuite/abcd/wire"
+	"github.com/abcsuite/abcwallet/wallet/txrules"
+)
+
+func TestTxDataOutputs(t *testing.T) {
+	data := []byte("aero")
+	dataOutput, err := txrules.NullDataOutput(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := &wire.MsgTx{
+		TxOut: []*wire.TxOut{
+			{Value: 1e8, PkScript: []byte{0x51}},
+			dataOutput,
+		},
+	}
+
+	tests := []struct {
+		txType   TransactionType
+		expected []TransactionSummaryDataOutput
+	}{
+		{TransactionTypeRegular, []TransactionSummaryDataOutput{{Index: 1, Data: data}}},
+		{TransactionTypeCoinbase, nil},
+		{TransactionTypeTicketPurchase, nil},
+		{TransactionTypeVote, nil},
+		{TransactionTypeRevocation, nil},
+	}
+	for _, test := range tests {
+		got := txDataOutputs(tx, test.txType)
+		if !reflect.DeepEqual(got, test.expected) {
+			t.Errorf("type %v: got %+v, expected %+v", test.txType, got,
+				test.expected)
+		}
+	}
+}
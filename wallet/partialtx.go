@@ -0,0 +1,537 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/abcsuite/abcd/chaincfg/chainec"
+	"github.com/abcsuite/abcd/txscript"
+	"github.com/abcsuite/abcd/wire"
+	"github.com/abcsuite/abcutil"
+	"github.com/abcsuite/abcutil/hdkeychain"
+	"github.com/abcsuite/abcwallet/apperrors"
+	"github.com/abcsuite/abcwallet/wallet/pstx"
+	"github.com/abcsuite/abcwallet/wallet/udb"
+	"github.com/abcsuite/abcwallet/walletdb"
+)
+
+// keyPath is the BIP0044 account, branch, and child index of a wallet key.
+type keyPath struct {
+	account, branch, child uint32
+}
+
+// derivation returns the BIP0032 derivation path of the key from the master
+// key, m/44'/<coin type>'/<account>'/<branch>/<child>.
+func (k *keyPath) derivation(w *Wallet, pubKey []byte) pstx.Derivation {
+	return pstx.Derivation{
+		PubKey: pubKey,
+		Path: []uint32{
+			hdkeychain.HardenedKeyStart + 44,
+			hdkeychain.HardenedKeyStart + w.chainParams.HDCoinType,
+			hdkeychain.HardenedKeyStart + k.account,
+			k.branch,
+			k.child,
+		},
+	}
+}
+
+// walletKeyPath returns the account, branch, and child index of a derivation
+// path of a key derived from one of the wallet's account keys.  The public key
+// of the derivation is checked against the key derived by the wallet, so the
+// key need not be recorded by the address manager.  False is returned for
+// derivations of other wallets' keys.
+func (w *Wallet) walletKeyPath(dbtx walletdb.ReadTx, d *pstx.Derivation) (*keyPath, bool, error) {
+	const h = hdkeychain.HardenedKeyStart
+	path := d.Path
+	if len(path) != 5 || path[0] != h+44 || path[1] != h+w.chainParams.HDCoinType ||
+		path[2] < h || path[3] >= h || path[4] >= h {
+		return nil, false, nil
+	}
+	k := &keyPath{account: path[2] - h, branch: path[3], child: path[4]}
+	if k.branch != udb.ExternalBranch && k.branch != udb.InternalBranch {
+		return nil, false, nil
+	}
+
+	branchXpub, err := w.Manager.AccountBranchExtendedPubKey(dbtx, k.account, k.branch)
+	if apperrors.IsError(err, apperrors.ErrAccountNotFound) ||
+		apperrors.IsError(err, apperrors.ErrInvalidAccount) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	childXpub, err := branchXpub.Child(k.child)
+	if err == hdkeychain.ErrInvalidChild {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	pubKey, err := childXpub.ECPubKey()
+	if err != nil {
+		return nil, false, err
+	}
+	if !bytes.Equal(pubKey.SerializeCompressed(), d.PubKey) {
+		return nil, false, nil
+	}
+	return k, true, nil
+}
+
+// commitsToKey returns whether a script of a class paying to addrs may be
+// signed by a public key.
+func commitsToKey(class txscript.ScriptClass, addrs []abcutil.Address, pubKey []byte) bool {
+	for _, addr := range addrs {
+		switch class {
+		case txscript.PubKeyHashTy:
+			if bytes.Equal(abcutil.Hash160(pubKey), addr.ScriptAddress()) {
+				return true
+			}
+		case txscript.PubKeyTy, txscript.MultiSigTy:
+			if bytes.Equal(pubKey, addr.ScriptAddress()) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// inputSigner describes a wallet key which may sign an input.  pubKey is the
+// serialized public key in the form committed to by the signed script.  Keys of
+// addresses recorded by the address manager are described by addr, while keys
+// only found by their derivation path are described by path.
+type inputSigner struct {
+	pubKey []byte
+	addr   abcutil.Address
+	path   *keyPath
+}
+
+// inputSigners returns the wallet keys which may sign a P2PK, P2PKH, or
+// multisig script.  Besides keys of addresses recorded by the address manager,
+// keys described by derivations are returned when they are derived from the
+// wallet's account keys.  Scripts of other classes have no signers.
+func (w *Wallet) inputSigners(dbtx walletdb.ReadTx, script []byte,
+	derivations []pstx.Derivation) ([]inputSigner, error) {
+
+	addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+
+	class, addrs, _, err := txscript.ExtractPkScriptAddrs(
+		txscript.DefaultScriptVersion, script, w.chainParams)
+	if err != nil {
+		return nil, err
+	}
+	switch class {
+	case txscript.PubKeyTy, txscript.PubKeyHashTy, txscript.MultiSigTy:
+	default:
+		return nil, nil
+	}
+
+	var signers []inputSigner
+	for _, addr := range addrs {
+		ma, err := w.Manager.Address(addrmgrNs, addr)
+		if apperrors.IsError(err, apperrors.ErrAddressNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		pka, ok := ma.(udb.ManagedPubKeyAddress)
+		if !ok {
+			continue
+		}
+		var pubKey []byte
+		switch {
+		case class != txscript.PubKeyHashTy:
+			pubKey = addr.ScriptAddress()
+		case pka.Compressed():
+			pubKey = pka.PubKey().SerializeCompressed()
+		default:
+			pubKey = pka.PubKey().SerializeUncompressed()
+		}
+		signers = append(signers, inputSigner{pubKey: pubKey, addr: addr})
+	}
+
+Derivations:
+	for i := range derivations {
+		d := &derivations[i]
+		for _, s := range signers {
+			if bytes.Equal(s.pubKey, d.PubKey) {
+				continue Derivations
+			}
+		}
+		if !commitsToKey(class, addrs, d.PubKey) {
+			continue
+		}
+		path, ok, err := w.walletKeyPath(dbtx, d)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			signers = append(signers, inputSigner{pubKey: d.PubKey, path: path})
+		}
+	}
+	return signers, nil
+}
+
+// addressKeyPath returns the key path of an address recorded by the address
+// manager, or false for imported addresses.
+func (w *Wallet) addressKeyPath(addrmgrNs walletdb.ReadBucket, addr abcutil.Address) (*keyPath, bool, error) {
+	account, branch, child, err := w.Manager.AddressDerivation(addrmgrNs, addr)
+	if apperrors.IsError(err, apperrors.ErrInput) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return &keyPath{account: account, branch: branch, child: child}, true, nil
+}
+
+// redeemScript returns the redeem script of a P2SH address known by the
+// wallet, or nil if the script is unknown or can not be returned while the
+// wallet is locked.
+func (w *Wallet) redeemScript(dbtx walletdb.ReadTx, addr abcutil.Address) ([]byte, error) {
+	addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+	txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+
+	script, err := w.TxStore.GetTxScript(txmgrNs, addr.ScriptAddress())
+	if err != nil || script != nil {
+		return script, err
+	}
+	script, done, err := w.Manager.RedeemScript(addrmgrNs, addr)
+	switch {
+	case apperrors.IsError(err, apperrors.ErrAddressNotFound),
+		apperrors.IsError(err, apperrors.ErrLocked),
+		apperrors.IsError(err, apperrors.ErrWatchingOnly),
+		apperrors.IsError(err, apperrors.ErrInput):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+	script = append([]byte(nil), script...)
+	done()
+	return script, nil
+}
+
+// multisigKeyDerivation returns the derivation of the wallet's own key in the
+// script of a multisig account address, or false for any other address.
+func (w *Wallet) multisigKeyDerivation(dbtx walletdb.ReadTx, addr abcutil.Address) (*pstx.Derivation, bool, error) {
+	addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+
+	ma, err := w.Manager.Address(addrmgrNs, addr)
+	if apperrors.IsError(err, apperrors.ErrAddressNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	if !ma.Multisig() {
+		return nil, false, nil
+	}
+	path, ok, err := w.addressKeyPath(addrmgrNs, addr)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	branchXpub, err := w.Manager.AccountBranchExtendedPubKey(dbtx, path.account, path.branch)
+	if err != nil {
+		return nil, false, err
+	}
+	childXpub, err := branchXpub.Child(path.child)
+	if err != nil {
+		return nil, false, err
+	}
+	pubKey, err := childXpub.ECPubKey()
+	if err != nil {
+		return nil, false, err
+	}
+	d := path.derivation(w, pubKey.SerializeCompressed())
+	return &d, true, nil
+}
+
+// updatePartialTx adds every input and output detail known by the wallet to p.
+func (w *Wallet) updatePartialTx(dbtx walletdb.ReadTx, p *pstx.Tx) error {
+	addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+	txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+
+	for i, txIn := range p.Tx.TxIn {
+		in := &p.Inputs[i]
+
+		// Previous transactions are added to final inputs as well, so
+		// that every input amount may be verified.
+		if in.PrevTx == nil {
+			op := &txIn.PreviousOutPoint
+			details, err := w.TxStore.TxDetails(txmgrNs, &op.Hash)
+			if err != nil {
+				return err
+			}
+			if details != nil {
+				prevTx := details.MsgTx
+				in.PrevTx = &prevTx
+			}
+		}
+		if p.Final(i) {
+			continue
+		}
+
+		if in.PrevOut == nil {
+			verified, err := p.PrevOutput(i)
+			if err != nil {
+				continue
+			}
+			prevOut := *verified
+			in.PrevOut = &prevOut
+		}
+		if txIn.ValueIn == 0 {
+			txIn.ValueIn = in.PrevOut.Value
+		}
+
+		script := in.PrevOut.PkScript
+		class, addrs, _, err := txscript.ExtractPkScriptAddrs(in.PrevOut.Version,
+			script, w.chainParams)
+		if err != nil {
+			return err
+		}
+		if class == txscript.ScriptHashTy {
+			if in.RedeemScript == nil {
+				in.RedeemScript, err = w.redeemScript(dbtx, addrs[0])
+				if err != nil {
+					return err
+				}
+			}
+			script = in.RedeemScript
+
+			// The wallet's key in the scripts of multisig accounts
+			// is only recorded by its derivation.
+			d, ok, err := w.multisigKeyDerivation(dbtx, addrs[0])
+			if err != nil {
+				return err
+			}
+			if ok {
+				in.AddDerivation(*d)
+			}
+		}
+		if script == nil {
+			continue
+		}
+
+		signers, err := w.inputSigners(dbtx, script, nil)
+		if err != nil {
+			return err
+		}
+		for _, s := range signers {
+			path, ok, err := w.addressKeyPath(addrmgrNs, s.addr)
+			if err != nil {
+				return err
+			}
+			if ok {
+				in.AddDerivation(path.derivation(w, s.pubKey))
+			}
+		}
+	}
+
+	// Describe the keys of P2PKH and multisig account outputs paying back to
+	// the wallet, such as change outputs, so other signers may recognize
+	// them.
+	for i, txOut := range p.Tx.TxOut {
+		class, addrs, _, err := txscript.ExtractPkScriptAddrs(txOut.Version,
+			txOut.PkScript, w.chainParams)
+		if err != nil {
+			continue
+		}
+		if class == txscript.ScriptHashTy {
+			d, ok, err := w.multisigKeyDerivation(dbtx, addrs[0])
+			if err != nil {
+				return err
+			}
+			if ok {
+				p.Outputs[i].AddDerivation(*d)
+			}
+			continue
+		}
+		if class != txscript.PubKeyHashTy {
+			continue
+		}
+		signers, err := w.inputSigners(dbtx, txOut.PkScript, nil)
+		if err != nil {
+			return err
+		}
+		for _, s := range signers {
+			path, ok, err := w.addressKeyPath(addrmgrNs, s.addr)
+			if err != nil {
+				return err
+			}
+			if ok {
+				p.Outputs[i].AddDerivation(path.derivation(w, s.pubKey))
+			}
+		}
+	}
+	return nil
+}
+
+// CreatePartialTx creates a partially signed transaction for an unsigned
+// transaction, adding the details of every input known by the wallet.
+func (w *Wallet) CreatePartialTx(tx *wire.MsgTx) (*pstx.Tx, error) {
+	p := pstx.New(tx)
+	err := w.UpdatePartialTx(p)
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// UpdatePartialTx adds the previous outputs and transactions, redeem scripts,
+// and key derivation paths known by the wallet to the inputs of a partially
+// signed transaction, and the derivation paths of wallet keys paid by P2PKH and
+// multisig account outputs.  Details already present in the container are
+// kept.  Redeem scripts of imported P2SH addresses only recorded by the address
+// manager require the wallet to be unlocked.
+func (w *Wallet) UpdatePartialTx(p *pstx.Tx) error {
+	return walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		return w.updatePartialTx(dbtx, p)
+	})
+}
+
+// SignPartialTx updates a partially signed transaction with the details known
+// by the wallet and adds a SigHashAll signature by every wallet key which may
+// sign an input and has not yet signed it.  Keys are found either by the
+// addresses of the signed script or by the derivation paths of the input, so a
+// wallet that has not discovered the addresses, such as an offline wallet, may
+// still sign.  Inputs that are final or have no needed redeem script are not
+// signed.  The indexes of inputs signed by the wallet are returned.  Nothing is
+// signed, and an error with the ErrInput code is returned, unless the previous
+// transaction of every input is known and verifies its previous output.
+//
+// This method requires the wallet to be unlocked.
+func (w *Wallet) SignPartialTx(p *pstx.Tx) ([]int, error) {
+	var signed []int
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		signed, err = w.signPartialTx(dbtx, p)
+		return err
+	})
+	return signed, err
+}
+
+func (w *Wallet) signPartialTx(dbtx walletdb.ReadTx, p *pstx.Tx) ([]int, error) {
+	addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+
+	err := w.updatePartialTx(dbtx, p)
+	if err != nil {
+		return nil, err
+	}
+	prevOuts, err := verifiedPrevOutputs(p)
+	if err != nil {
+		return nil, err
+	}
+
+	var signed []int
+	for i := range p.Tx.TxIn {
+		in := &p.Inputs[i]
+		if p.Final(i) {
+			continue
+		}
+		subScript, err := w.inputSubScript(i, prevOuts[i], in)
+		if err != nil {
+			return nil, err
+		}
+		if subScript == nil {
+			continue
+		}
+
+		signers, err := w.inputSigners(dbtx, subScript, in.Derivations)
+		if err != nil {
+			return nil, err
+		}
+		signedInput := false
+		for _, s := range signers {
+			if in.Signature(s.pubKey) != nil {
+				continue
+			}
+			var key chainec.PrivateKey
+			var done func()
+			if s.path != nil {
+				key, done, err = w.Manager.DerivedPrivateKey(addrmgrNs,
+					s.path.account, s.path.branch, s.path.child)
+			} else {
+				key, done, err = w.Manager.PrivateKey(addrmgrNs, s.addr)
+			}
+			if err != nil {
+				return nil, err
+			}
+			sig, err := txscript.RawTxInSignature(p.Tx, i, subScript,
+				txscript.SigHashAll, key)
+			done()
+			if err != nil {
+				return nil, err
+			}
+			in.AddPartialSig(pstx.PartialSig{PubKey: s.pubKey, Signature: sig})
+			signedInput = true
+		}
+		if signedInput {
+			signed = append(signed, i)
+		}
+	}
+	return signed, nil
+}
+
+// verifiedPrevOutputs returns the previous output of every input of a
+// partially signed transaction, verified against the previous transactions
+// carried by the container.  An error with the ErrInput code is returned if
+// any previous transaction is missing or does not verify.
+func verifiedPrevOutputs(p *pstx.Tx) ([]*wire.TxOut, error) {
+	prevOuts := make([]*wire.TxOut, len(p.Tx.TxIn))
+	for i := range p.Tx.TxIn {
+		prevOut, err := p.PrevOutput(i)
+		if err != nil {
+			return nil, apperrors.E{
+				ErrorCode:   apperrors.ErrInput,
+				Description: "cannot verify previous output",
+				Err:         err,
+			}
+		}
+		prevOuts[i] = prevOut
+	}
+	return prevOuts, nil
+}
+
+// inputSubScript returns the script signed by the keys of an input spending
+// prevOut, which is the redeem script of P2SH outputs and the output script
+// otherwise.  Nil is returned if the redeem script is unknown.  Since redeem
+// scripts are carried by untrusted containers, an error with the ErrInput code
+// is returned if the redeem script does not match the P2SH output.
+func (w *Wallet) inputSubScript(i int, prevOut *wire.TxOut, in *pstx.Input) ([]byte, error) {
+	class, addrs, _, err := txscript.ExtractPkScriptAddrs(prevOut.Version,
+		prevOut.PkScript, w.chainParams)
+	if err != nil || class != txscript.ScriptHashTy {
+		return prevOut.PkScript, nil
+	}
+	if in.RedeemScript == nil {
+		return nil, nil
+	}
+	if !bytes.Equal(abcutil.Hash160(in.RedeemScript), addrs[0].ScriptAddress()) {
+		return nil, apperrors.E{
+			ErrorCode: apperrors.ErrInput,
+			Description: fmt.Sprintf("redeem script of input %d does not "+
+				"match the previous output script", i),
+		}
+	}
+	return in.RedeemScript, nil
+}
+
+// FinalizePartialTx creates the signature scripts of every input of a
+// partially signed transaction with enough signatures, and returns whether
+// every input is final.  An error with the ErrInput code is returned if a
+// created signature script is invalid.
+func (w *Wallet) FinalizePartialTx(p *pstx.Tx) (bool, error) {
+	complete, err := p.Finalize(w.chainParams)
+	if err != nil {
+		return false, apperrors.E{
+			ErrorCode:   apperrors.ErrInput,
+			Description: "cannot finalize partially signed transaction",
+			Err:         err,
+		}
+	}
+	return complete, nil
+}
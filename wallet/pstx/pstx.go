@@ -0,0 +1,713 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package pstx implements a versioned container for partially signed
+// transactions.
+//
+// Besides the transaction itself, the container carries the details about each
+// input that signers need but that are not part of a serialized transaction:
+// the previous output being spent, the previous transaction which created it,
+// the redeem script of P2SH outputs, the BIP0032 derivation paths of keys which
+// may sign the input, and the signatures created so far.  Previous
+// transactions let signers without the transaction history, such as offline
+// wallets, verify the amounts being spent.  Outputs paying to a signer's keys,
+// such as change outputs, are described by the derivation paths of their keys
+// so that signers may verify which outputs they control.  Containers for the
+// same transaction created by different signers may be combined, and are
+// finalized into a signed transaction once every input has enough signatures.
+package pstx
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/abcsuite/abcd/chaincfg"
+	"github.com/abcsuite/abcd/txscript"
+	"github.com/abcsuite/abcd/wire"
+	"github.com/abcsuite/abcutil"
+)
+
+// Version is the version of the serialization format.
+const Version = 0
+
+// magic prefixes every serialized container.
+var magic = [4]byte{'p', 's', 't', 'x'}
+
+// Limits on the sizes of deserialized input details.
+const (
+	maxPubKeySize    = 65
+	maxSignatureSize = 73
+	maxPathLength    = 255
+)
+
+var (
+	// ErrMismatchedTx describes an attempt to combine containers for
+	// different transactions.
+	ErrMismatchedTx = errors.New("partially signed transactions are for " +
+		"different transactions")
+
+	// ErrIncomplete describes an attempt to extract a transaction which has
+	// inputs without signature scripts.
+	ErrIncomplete = errors.New("partially signed transaction is not " +
+		"completely signed")
+)
+
+// Derivation describes the BIP0032 derivation path from a master key of a
+// public key which may sign an input.
+type Derivation struct {
+	PubKey []byte
+	Path   []uint32
+}
+
+// PartialSig is a signature for an input created by the private key of a public
+// key.  The signature includes the trailing signature hash type byte.
+type PartialSig struct {
+	PubKey    []byte
+	Signature []byte
+}
+
+// Input describes the details of a transaction input needed to sign it.  Any
+// details may be missing until they are added by a wallet which knows them.
+// PrevOut is trusted as given, while the previous output of PrevTx is only
+// trusted once its hash is verified by Tx.PrevOutput.
+type Input struct {
+	PrevOut      *wire.TxOut
+	PrevTx       *wire.MsgTx
+	RedeemScript []byte
+	Derivations  []Derivation
+	PartialSigs  []PartialSig
+}
+
+// addDerivation adds the derivation path of a public key to derivations,
+// replacing any path previously added for the same key.
+func addDerivation(derivations []Derivation, d Derivation) []Derivation {
+	for i := range derivations {
+		if bytes.Equal(derivations[i].PubKey, d.PubKey) {
+			derivations[i] = d
+			return derivations
+		}
+	}
+	return append(derivations, d)
+}
+
+// AddDerivation adds the derivation path of a public key, replacing any path
+// previously added for the same key.
+func (in *Input) AddDerivation(d Derivation) {
+	in.Derivations = addDerivation(in.Derivations, d)
+}
+
+// AddPartialSig adds the signature created by a public key.  If the key has
+// already signed the input, the previous signature is kept.
+func (in *Input) AddPartialSig(s PartialSig) {
+	if in.Signature(s.PubKey) == nil {
+		in.PartialSigs = append(in.PartialSigs, s)
+	}
+}
+
+// Signature returns the signature created by a public key, or nil if the key
+// has not signed the input.
+func (in *Input) Signature(pubKey []byte) []byte {
+	for i := range in.PartialSigs {
+		if bytes.Equal(in.PartialSigs[i].PubKey, pubKey) {
+			return in.PartialSigs[i].Signature
+		}
+	}
+	return nil
+}
+
+// signatureByHash returns the public key and signature of the key with a
+// hash160, or nils if the key has not signed the input.
+func (in *Input) signatureByHash(hash []byte) (pubKey, sig []byte) {
+	for i := range in.PartialSigs {
+		s := &in.PartialSigs[i]
+		if bytes.Equal(abcutil.Hash160(s.PubKey), hash) {
+			return s.PubKey, s.Signature
+		}
+	}
+	return nil, nil
+}
+
+// sameTxOut returns whether two transaction outputs are equal.
+func sameTxOut(a, b *wire.TxOut) bool {
+	return a.Value == b.Value && a.Version == b.Version &&
+		bytes.Equal(a.PkScript, b.PkScript)
+}
+
+// merge adds the details of another description of the same input.  An error
+// is returned if the previous outputs, previous transactions, or redeem
+// scripts conflict.
+func (in *Input) merge(other *Input, index int) error {
+	switch {
+	case in.PrevOut == nil && other.PrevOut != nil:
+		prevOut := *other.PrevOut
+		in.PrevOut = &prevOut
+	case in.PrevOut != nil && other.PrevOut != nil:
+		if !sameTxOut(in.PrevOut, other.PrevOut) {
+			return fmt.Errorf("conflicting previous outputs for input %d", index)
+		}
+	}
+	switch {
+	case in.PrevTx == nil && other.PrevTx != nil:
+		in.PrevTx = other.PrevTx.Copy()
+	case in.PrevTx != nil && other.PrevTx != nil:
+		if in.PrevTx.TxHash() != other.PrevTx.TxHash() {
+			return fmt.Errorf("conflicting previous transactions for "+
+				"input %d", index)
+		}
+	}
+	switch {
+	case in.RedeemScript == nil:
+		in.RedeemScript = other.RedeemScript
+	case other.RedeemScript != nil && !bytes.Equal(in.RedeemScript, other.RedeemScript):
+		return fmt.Errorf("conflicting redeem scripts for input %d", index)
+	}
+	for _, d := range other.Derivations {
+		in.AddDerivation(d)
+	}
+	for _, s := range other.PartialSigs {
+		in.AddPartialSig(s)
+	}
+	return nil
+}
+
+// Output describes the keys of a transaction output which are known by a
+// signer.  Signers use the derivation paths to recognize outputs paying back to
+// their own keys.
+type Output struct {
+	Derivations []Derivation
+}
+
+// AddDerivation adds the derivation path of a public key, replacing any path
+// previously added for the same key.
+func (out *Output) AddDerivation(d Derivation) {
+	out.Derivations = addDerivation(out.Derivations, d)
+}
+
+// Tx is a partially signed transaction.  Each transaction input and output is
+// described by the input or output with the same index.  Inputs with a
+// signature script are final and require no more signatures.
+type Tx struct {
+	Tx      *wire.MsgTx
+	Inputs  []Input
+	Outputs []Output
+}
+
+// New creates a partially signed transaction for tx without any input or output
+// details.  Inputs of tx with signature scripts are considered final.
+func New(tx *wire.MsgTx) *Tx {
+	return &Tx{
+		Tx:      tx,
+		Inputs:  make([]Input, len(tx.TxIn)),
+		Outputs: make([]Output, len(tx.TxOut)),
+	}
+}
+
+// Final returns whether the input at index i has a signature script.
+func (p *Tx) Final(i int) bool {
+	return len(p.Tx.TxIn[i].SignatureScript) != 0
+}
+
+// PrevOutput returns the output spent by the input at index i, taken from the
+// previous transaction of the input after verifying that the hash of the
+// previous transaction matches the outpoint spent by the input.  Unlike the
+// unverified PrevOut of the input, the amount of the returned output may be
+// trusted by signers that do not know the previous transaction.  An error is
+// returned if the previous transaction is missing or does not match the
+// outpoint, or if PrevOut differs from the verified output.
+func (p *Tx) PrevOutput(i int) (*wire.TxOut, error) {
+	in := &p.Inputs[i]
+	op := &p.Tx.TxIn[i].PreviousOutPoint
+	if in.PrevTx == nil {
+		return nil, fmt.Errorf("previous transaction of input %d is unknown", i)
+	}
+	if in.PrevTx.TxHash() != op.Hash {
+		return nil, fmt.Errorf("previous transaction of input %d does not "+
+			"match the spent outpoint", i)
+	}
+	if int(op.Index) >= len(in.PrevTx.TxOut) {
+		return nil, fmt.Errorf("previous transaction of input %d has no "+
+			"output %d", i, op.Index)
+	}
+	prevOut := in.PrevTx.TxOut[op.Index]
+	if in.PrevOut != nil && !sameTxOut(in.PrevOut, prevOut) {
+		return nil, fmt.Errorf("previous output of input %d does not match "+
+			"its previous transaction", i)
+	}
+	return prevOut, nil
+}
+
+// Complete returns whether every input has a signature script.
+func (p *Tx) Complete() bool {
+	for i := range p.Tx.TxIn {
+		if !p.Final(i) {
+			return false
+		}
+	}
+	return true
+}
+
+// Combine merges the input and output details of partially signed
+// transactions for the same transaction into a new partially signed
+// transaction.  Transactions are the same when their hashes match, which do
+// not commit to signature scripts.  ErrMismatchedTx is returned if the
+// transactions differ.
+func Combine(txs ...*Tx) (*Tx, error) {
+	if len(txs) == 0 {
+		return nil, errors.New("no partially signed transactions to combine")
+	}
+	txHash := txs[0].Tx.TxHash()
+	combined := New(txs[0].Tx.Copy())
+	for _, p := range txs {
+		if p.Tx.TxHash() != txHash {
+			return nil, ErrMismatchedTx
+		}
+		for i := range p.Inputs {
+			err := combined.Inputs[i].merge(&p.Inputs[i], i)
+			if err != nil {
+				return nil, err
+			}
+			txIn, otherTxIn := combined.Tx.TxIn[i], p.Tx.TxIn[i]
+			if !combined.Final(i) && p.Final(i) {
+				txIn.SignatureScript = otherTxIn.SignatureScript
+			}
+			if txIn.ValueIn == 0 {
+				txIn.ValueIn = otherTxIn.ValueIn
+			}
+		}
+		for i := range p.Outputs {
+			for _, d := range p.Outputs[i].Derivations {
+				combined.Outputs[i].AddDerivation(d)
+			}
+		}
+	}
+	return combined, nil
+}
+
+// Finalize creates the signature script of every input which is not final and
+// has enough signatures for its previous output script.  P2PK, P2PKH, and P2SH
+// multisig outputs are supported.  Each created signature script is verified
+// and the partial signatures of the input are removed.  Finalize returns
+// whether every input is final.
+func (p *Tx) Finalize(params *chaincfg.Params) (bool, error) {
+	for i := range p.Inputs {
+		if p.Final(i) {
+			continue
+		}
+		err := p.finalizeInput(i, params)
+		if err != nil {
+			return false, err
+		}
+	}
+	return p.Complete(), nil
+}
+
+func (p *Tx) finalizeInput(i int, params *chaincfg.Params) error {
+	in := &p.Inputs[i]
+	if in.PrevOut == nil {
+		return nil
+	}
+
+	class, addrs, _, err := txscript.ExtractPkScriptAddrs(in.PrevOut.Version,
+		in.PrevOut.PkScript, params)
+	if err != nil {
+		return err
+	}
+	b := txscript.NewScriptBuilder()
+	switch class {
+	case txscript.PubKeyTy:
+		sig := in.Signature(addrs[0].ScriptAddress())
+		if sig == nil {
+			return nil
+		}
+		b.AddData(sig)
+
+	case txscript.PubKeyHashTy:
+		pubKey, sig := in.signatureByHash(addrs[0].ScriptAddress())
+		if sig == nil {
+			return nil
+		}
+		b.AddData(sig).AddData(pubKey)
+
+	case txscript.ScriptHashTy:
+		if in.RedeemScript == nil {
+			return nil
+		}
+		if !bytes.Equal(abcutil.Hash160(in.RedeemScript), addrs[0].ScriptAddress()) {
+			return fmt.Errorf("redeem script of input %d does not match "+
+				"the previous output script", i)
+		}
+		class, addrs, reqSigs, err := txscript.ExtractPkScriptAddrs(
+			txscript.DefaultScriptVersion, in.RedeemScript, params)
+		if err != nil {
+			return err
+		}
+		if class != txscript.MultiSigTy {
+			return fmt.Errorf("redeem script of input %d has unsupported "+
+				"class %v", i, class)
+		}
+		// Decred multisig scripts do not require a dummy push.
+		sigs := 0
+		for _, addr := range addrs {
+			if sigs == reqSigs {
+				break
+			}
+			if sig := in.Signature(addr.ScriptAddress()); sig != nil {
+				b.AddData(sig)
+				sigs++
+			}
+		}
+		if sigs < reqSigs {
+			return nil
+		}
+		b.AddData(in.RedeemScript)
+
+	default:
+		return fmt.Errorf("previous output script of input %d has "+
+			"unsupported class %v", i, class)
+	}
+	script, err := b.Script()
+	if err != nil {
+		return err
+	}
+
+	txIn := p.Tx.TxIn[i]
+	txIn.SignatureScript = script
+	vm, err := txscript.NewEngine(in.PrevOut.PkScript, p.Tx, i,
+		txscript.StandardVerifyFlags, in.PrevOut.Version, nil)
+	if err == nil {
+		err = vm.Execute()
+	}
+	if err != nil {
+		txIn.SignatureScript = nil
+		return fmt.Errorf("signature script of input %d is invalid: %v", i, err)
+	}
+	in.PartialSigs = nil
+	return nil
+}
+
+// Extract returns the signed transaction.  ErrIncomplete is returned if any
+// input is not final.
+func (p *Tx) Extract() (*wire.MsgTx, error) {
+	if !p.Complete() {
+		return nil, ErrIncomplete
+	}
+	return p.Tx, nil
+}
+
+// Serialize writes the partially signed transaction to w.
+func (p *Tx) Serialize(w io.Writer) error {
+	if len(p.Inputs) != len(p.Tx.TxIn) || len(p.Outputs) != len(p.Tx.TxOut) {
+		return errors.New("partially signed transaction does not describe " +
+			"every input and output")
+	}
+	_, err := w.Write(append(magic[:], Version))
+	if err != nil {
+		return err
+	}
+	err = p.Tx.Serialize(w)
+	if err != nil {
+		return err
+	}
+	for i := range p.Inputs {
+		err = writeInput(w, &p.Inputs[i])
+		if err != nil {
+			return err
+		}
+	}
+	for i := range p.Outputs {
+		err = writeDerivations(w, p.Outputs[i].Derivations)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Bytes returns the serialized partially signed transaction.
+func (p *Tx) Bytes() ([]byte, error) {
+	var buf bytes.Buffer
+	err := p.Serialize(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Deserialize reads a serialized partially signed transaction from r.
+func (p *Tx) Deserialize(r io.Reader) error {
+	var header [len(magic) + 1]byte
+	_, err := io.ReadFull(r, header[:])
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(header[:len(magic)], magic[:]) {
+		return errors.New("not a partially signed transaction")
+	}
+	version := header[len(magic)]
+	if version != Version {
+		return fmt.Errorf("unknown partially signed transaction version %d",
+			version)
+	}
+
+	tx := new(wire.MsgTx)
+	err = tx.Deserialize(r)
+	if err != nil {
+		return err
+	}
+	inputs := make([]Input, len(tx.TxIn))
+	for i := range inputs {
+		err = readInput(r, &inputs[i])
+		if err != nil {
+			return err
+		}
+	}
+	outputs := make([]Output, len(tx.TxOut))
+	for i := range outputs {
+		outputs[i].Derivations, err = readDerivations(r)
+		if err != nil {
+			return err
+		}
+	}
+	p.Tx = tx
+	p.Inputs = inputs
+	p.Outputs = outputs
+	return nil
+}
+
+// Parse deserializes a partially signed transaction from b.
+func Parse(b []byte) (*Tx, error) {
+	p := new(Tx)
+	r := bytes.NewReader(b)
+	err := p.Deserialize(r)
+	if err != nil {
+		return nil, err
+	}
+	if r.Len() != 0 {
+		return nil, errors.New("trailing data after partially signed transaction")
+	}
+	return p, nil
+}
+
+func writeInput(w io.Writer, in *Input) error {
+	const pver = wire.ProtocolVersion
+
+	var buf [10]byte
+	if in.PrevOut == nil {
+		_, err := w.Write([]byte{0})
+		if err != nil {
+			return err
+		}
+	} else {
+		buf[0] = 1
+		binary.LittleEndian.PutUint64(buf[1:9], uint64(in.PrevOut.Value))
+		_, err := w.Write(buf[:9])
+		if err != nil {
+			return err
+		}
+		binary.LittleEndian.PutUint16(buf[:2], in.PrevOut.Version)
+		_, err = w.Write(buf[:2])
+		if err != nil {
+			return err
+		}
+		err = wire.WriteVarBytes(w, pver, in.PrevOut.PkScript)
+		if err != nil {
+			return err
+		}
+	}
+
+	if in.PrevTx == nil {
+		_, err := w.Write([]byte{0})
+		if err != nil {
+			return err
+		}
+	} else {
+		_, err := w.Write([]byte{1})
+		if err != nil {
+			return err
+		}
+		err = in.PrevTx.Serialize(w)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := wire.WriteVarBytes(w, pver, in.RedeemScript)
+	if err != nil {
+		return err
+	}
+
+	err = writeDerivations(w, in.Derivations)
+	if err != nil {
+		return err
+	}
+
+	err = wire.WriteVarInt(w, pver, uint64(len(in.PartialSigs)))
+	if err != nil {
+		return err
+	}
+	for _, s := range in.PartialSigs {
+		err = wire.WriteVarBytes(w, pver, s.PubKey)
+		if err != nil {
+			return err
+		}
+		err = wire.WriteVarBytes(w, pver, s.Signature)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readInput(r io.Reader, in *Input) error {
+	const pver = wire.ProtocolVersion
+
+	var buf [8]byte
+	_, err := io.ReadFull(r, buf[:1])
+	if err != nil {
+		return err
+	}
+	switch buf[0] {
+	case 0:
+	case 1:
+		_, err = io.ReadFull(r, buf[:8])
+		if err != nil {
+			return err
+		}
+		value := int64(binary.LittleEndian.Uint64(buf[:8]))
+		_, err = io.ReadFull(r, buf[:2])
+		if err != nil {
+			return err
+		}
+		version := binary.LittleEndian.Uint16(buf[:2])
+		pkScript, err := wire.ReadVarBytes(r, pver, txscript.MaxScriptSize,
+			"previous output script")
+		if err != nil {
+			return err
+		}
+		in.PrevOut = &wire.TxOut{Value: value, Version: version, PkScript: pkScript}
+	default:
+		return fmt.Errorf("invalid previous output flag %d", buf[0])
+	}
+
+	_, err = io.ReadFull(r, buf[:1])
+	if err != nil {
+		return err
+	}
+	switch buf[0] {
+	case 0:
+	case 1:
+		in.PrevTx = new(wire.MsgTx)
+		err = in.PrevTx.Deserialize(r)
+		if err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("invalid previous transaction flag %d", buf[0])
+	}
+
+	redeemScript, err := wire.ReadVarBytes(r, pver, txscript.MaxScriptSize,
+		"redeem script")
+	if err != nil {
+		return err
+	}
+	if len(redeemScript) != 0 {
+		in.RedeemScript = redeemScript
+	}
+
+	in.Derivations, err = readDerivations(r)
+	if err != nil {
+		return err
+	}
+
+	count, err := wire.ReadVarInt(r, pver)
+	if err != nil {
+		return err
+	}
+	if count > txscript.MaxPubKeysPerMultiSig {
+		return fmt.Errorf("too many partial signatures (%d)", count)
+	}
+	for ; count > 0; count-- {
+		var s PartialSig
+		s.PubKey, err = wire.ReadVarBytes(r, pver, maxPubKeySize, "public key")
+		if err != nil {
+			return err
+		}
+		s.Signature, err = wire.ReadVarBytes(r, pver, maxSignatureSize, "signature")
+		if err != nil {
+			return err
+		}
+		in.PartialSigs = append(in.PartialSigs, s)
+	}
+	return nil
+}
+
+func writeDerivations(w io.Writer, derivations []Derivation) error {
+	const pver = wire.ProtocolVersion
+
+	var buf [4]byte
+	err := wire.WriteVarInt(w, pver, uint64(len(derivations)))
+	if err != nil {
+		return err
+	}
+	for _, d := range derivations {
+		err = wire.WriteVarBytes(w, pver, d.PubKey)
+		if err != nil {
+			return err
+		}
+		err = wire.WriteVarInt(w, pver, uint64(len(d.Path)))
+		if err != nil {
+			return err
+		}
+		for _, index := range d.Path {
+			binary.LittleEndian.PutUint32(buf[:], index)
+			_, err = w.Write(buf[:])
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func readDerivations(r io.Reader) ([]Derivation, error) {
+	const pver = wire.ProtocolVersion
+
+	count, err := wire.ReadVarInt(r, pver)
+	if err != nil {
+		return nil, err
+	}
+	if count > txscript.MaxPubKeysPerMultiSig {
+		return nil, fmt.Errorf("too many derivation paths (%d)", count)
+	}
+	var derivations []Derivation
+	var buf [4]byte
+	for ; count > 0; count-- {
+		var d Derivation
+		d.PubKey, err = wire.ReadVarBytes(r, pver, maxPubKeySize, "public key")
+		if err != nil {
+			return nil, err
+		}
+		pathLen, err := wire.ReadVarInt(r, pver)
+		if err != nil {
+			return nil, err
+		}
+		if pathLen > maxPathLength {
+			return nil, fmt.Errorf("derivation path length %d is too long",
+				pathLen)
+		}
+		d.Path = make([]uint32, pathLen)
+		for i := range d.Path {
+			_, err = io.ReadFull(r, buf[:])
+			if err != nil {
+				return nil, err
+			}
+			d.Path[i] = binary.LittleEndian.Uint32(buf[:])
+		}
+		derivations = append(derivations, d)
+	}
+	return derivations, nil
+}
@@ -0,0 +1,202 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package pstx_test
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/abcsuite/abcd/chaincfg/chainhash"
+	"github.com/abcsuite/abcd/wire"
+	. "github.com/abcsuite/abcwallet/wallet/pstx"
+)
+
+func testTx() *wire.MsgTx {
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{1}, 0, wire.TxTreeRegular), nil))
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{2}, 1, wire.TxTreeRegular), nil))
+	tx.AddTxOut(wire.NewTxOut(1e8, []byte{0x51}))
+	return tx
+}
+
+// prevTx returns a transaction paying to outputs.  The transaction is
+// deserialized so that it compares equal to other deserialized copies.
+func prevTx(t *testing.T, outputs ...*wire.TxOut) *wire.MsgTx {
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{9}, 0, wire.TxTreeRegular), nil))
+	for _, out := range outputs {
+		tx.AddTxOut(out)
+	}
+	var buf bytes.Buffer
+	err := tx.Serialize(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx = new(wire.MsgTx)
+	err = tx.Deserialize(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tx
+}
+
+func TestSerialization(t *testing.T) {
+	const hardened = 1 << 31
+	p := New(testTx())
+	p.Inputs[0].PrevOut = wire.NewTxOut(2e8, []byte{0x76, 0xa9})
+	p.Inputs[0].PrevTx = prevTx(t, p.Inputs[0].PrevOut)
+	p.Inputs[0].AddDerivation(Derivation{
+		PubKey: bytes.Repeat([]byte{2}, 33),
+		Path:   []uint32{hardened + 44, hardened + 1, hardened, 0, 7},
+	})
+	p.Outputs[0].AddDerivation(Derivation{
+		PubKey: bytes.Repeat([]byte{2}, 33),
+		Path:   []uint32{hardened + 44, hardened + 1, hardened, 1, 3},
+	})
+	p.Inputs[1].RedeemScript = []byte{0x52, 0xae}
+	p.Inputs[1].AddPartialSig(PartialSig{
+		PubKey:    bytes.Repeat([]byte{3}, 33),
+		Signature: bytes.Repeat([]byte{0x30}, 72),
+	})
+
+	b, err := p.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := Parse(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.Tx.TxHash() != p.Tx.TxHash() {
+		t.Errorf("transaction hash mismatch")
+	}
+	if !reflect.DeepEqual(parsed.Inputs, p.Inputs) {
+		t.Errorf("inputs differ after round trip:\n got %+v\nwant %+v",
+			parsed.Inputs, p.Inputs)
+	}
+	if !reflect.DeepEqual(parsed.Outputs, p.Outputs) {
+		t.Errorf("outputs differ after round trip:\n got %+v\nwant %+v",
+			parsed.Outputs, p.Outputs)
+	}
+
+	_, err = Parse(append(b, 0))
+	if err == nil {
+		t.Errorf("parsed container with trailing data")
+	}
+	b[0] = 'x'
+	_, err = Parse(b)
+	if err == nil {
+		t.Errorf("parsed container with invalid magic")
+	}
+}
+
+func TestDeserializeUnknownVersion(t *testing.T) {
+	b, err := New(testTx()).Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b[4] = Version + 1
+	_, err = Parse(b)
+	if err == nil {
+		t.Errorf("parsed container with unknown version")
+	}
+}
+
+func TestCombine(t *testing.T) {
+	pubKey1 := bytes.Repeat([]byte{2}, 33)
+	pubKey2 := bytes.Repeat([]byte{3}, 33)
+	sig1 := []byte{0x30, 1}
+	sig2 := []byte{0x30, 2}
+
+	p1 := New(testTx())
+	p1.Inputs[0].RedeemScript = []byte{0x52, 0xae}
+	p1.Inputs[0].AddPartialSig(PartialSig{PubKey: pubKey1, Signature: sig1})
+	p2 := New(testTx())
+	p2.Inputs[0].AddPartialSig(PartialSig{PubKey: pubKey2, Signature: sig2})
+	p2.Inputs[0].AddPartialSig(PartialSig{PubKey: pubKey1, Signature: sig2})
+	p2.Tx.TxIn[1].SignatureScript = []byte{0x51}
+
+	combined, err := Combine(p1, p2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	in := &combined.Inputs[0]
+	if !bytes.Equal(in.RedeemScript, p1.Inputs[0].RedeemScript) {
+		t.Errorf("redeem script was not combined")
+	}
+	if !bytes.Equal(in.Signature(pubKey1), sig1) {
+		t.Errorf("first signature by a key was not kept")
+	}
+	if !bytes.Equal(in.Signature(pubKey2), sig2) {
+		t.Errorf("signature was not combined")
+	}
+	if !combined.Final(1) || p1.Final(1) {
+		t.Errorf("signature script was not combined into a new container")
+	}
+	if combined.Complete() {
+		t.Errorf("container without signature scripts for every input is complete")
+	}
+
+	p2.Inputs[0].RedeemScript = []byte{0x51, 0xae}
+	_, err = Combine(p1, p2)
+	if err == nil {
+		t.Errorf("combined conflicting redeem scripts")
+	}
+
+	other := testTx()
+	other.TxOut[0].Value++
+	_, err = Combine(p1, New(other))
+	if err != ErrMismatchedTx {
+		t.Errorf("combining different transactions: got error %v, want %v",
+			err, ErrMismatchedTx)
+	}
+}
+
+func TestPrevOutput(t *testing.T) {
+	prevOut := wire.NewTxOut(2e8, []byte{0x76, 0xa9})
+	prev := prevTx(t, wire.NewTxOut(1e8, []byte{0x51}), prevOut)
+	tx := wire.NewMsgTx()
+	prevHash := prev.TxHash()
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&prevHash, 1, wire.TxTreeRegular), nil))
+	tx.AddTxOut(wire.NewTxOut(1e8, []byte{0x51}))
+	p := New(tx)
+
+	_, err := p.PrevOutput(0)
+	if err == nil {
+		t.Errorf("verified input without a previous transaction")
+	}
+
+	p.Inputs[0].PrevTx = prev
+	out, err := p.PrevOutput(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.Value != prevOut.Value || !bytes.Equal(out.PkScript, prevOut.PkScript) {
+		t.Errorf("verified previous output %+v, want %+v", out, prevOut)
+	}
+
+	// The unverified previous output must agree with the previous
+	// transaction.
+	p.Inputs[0].PrevOut = wire.NewTxOut(3e8, prevOut.PkScript)
+	_, err = p.PrevOutput(0)
+	if err == nil {
+		t.Errorf("verified input with a conflicting previous output")
+	}
+	p.Inputs[0].PrevOut = nil
+
+	p.Inputs[0].PrevTx = prevTx(t, wire.NewTxOut(3e8, []byte{0x51}), prevOut)
+	_, err = p.PrevOutput(0)
+	if err == nil {
+		t.Errorf("verified input with a different previous transaction")
+	}
+
+	tx.TxIn[0].PreviousOutPoint.Index = 2
+	p.Inputs[0].PrevTx = prev
+	_, err = p.PrevOutput(0)
+	if err == nil {
+		t.Errorf("verified input spending a missing output")
+	}
+}
@@ -97,6 +97,7 @@ func (w *Wallet) rescan(chainClient *chain.RPCClient, startHash *chainhash.Hash,
 		if err != nil {
 			return err
 		}
+		w.forgetReleasedOutpointLocks()
 		if p != nil {
 			p <- RescanProgress{ScannedThrough: scanningThrough}
 		}
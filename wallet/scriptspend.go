@@ -0,0 +1,193 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"fmt"
+
+	"github.com/abcsuite/abcd/chaincfg/chainhash"
+	"github.com/abcsuite/abcd/txscript"
+	"github.com/abcsuite/abcd/wire"
+	"github.com/abcsuite/abcutil"
+	"github.com/abcsuite/abcwallet/apperrors"
+	"github.com/abcsuite/abcwallet/wallet/txauthor"
+	"github.com/abcsuite/abcwallet/wallet/txrules"
+	"github.com/abcsuite/abcwallet/wallet/udb"
+	"github.com/abcsuite/abcwallet/walletdb"
+
+	"github.com/abcsuite/abcwallet/wallet/internal/txsizes"
+)
+
+// scriptCredit describes a P2SH output paying to a script which is spent with
+// the signature of a single wallet key, such as a swap contract or a vault.
+type scriptCredit struct {
+	// desc names the kind of output in errors, e.g. "vault".
+	desc string
+
+	outPoint wire.OutPoint
+	amount   abcutil.Amount
+	address  abcutil.Address
+	script   []byte
+	keyAddr  abcutil.Address
+	account  uint32
+
+	// The spending transaction uses the transaction version, if not zero,
+	// locktime, and input sequence number needed to satisfy the script.
+	txVersion uint16
+	lockTime  uint32
+	sequence  uint32
+
+	// sigScriptSize is the worst case size of the signature script, and
+	// sigScript creates the signature script from the signature and
+	// serialized public key of the signing key.
+	sigScriptSize int
+	sigScript     func(sig, pubKey []byte) ([]byte, error)
+}
+
+// spendScriptCredit creates, signs, and publishes a transaction spending a
+// script credit to a change address of its account.  The change address is
+// only persisted once the transaction is published, so attempts which fail,
+// such as while the wallet is locked, do not use addresses.  The published
+// spend is remembered as pending until it is no longer known to the wallet.
+func (w *Wallet) spendScriptCredit(c *scriptCredit) (*chainhash.Hash, error) {
+	chainClient, err := w.requireChainClient()
+	if err != nil {
+		return nil, err
+	}
+	if w.Manager.IsLocked() {
+		return nil, apperrors.E{
+			ErrorCode:   apperrors.ErrLocked,
+			Description: "wallet is locked",
+		}
+	}
+	prevScript, err := txscript.PayToAddrScript(c.address)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := wire.NewMsgTx()
+	if c.txVersion != 0 {
+		tx.Version = c.txVersion
+	}
+	tx.LockTime = c.lockTime
+	txIn := wire.NewTxIn(&c.outPoint, nil)
+	txIn.ValueIn = int64(c.amount)
+	txIn.Sequence = c.sequence
+	tx.AddTxIn(txIn)
+
+	var atx *txauthor.AuthoredTx
+	var changeSourceUpdates []func(walletdb.ReadWriteTx) error
+	err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+
+		persist := w.deferPersistReturnedChild(&changeSourceUpdates)
+		pkScript, version, err := w.changeSource(persist, c.account)()
+		if err != nil {
+			return err
+		}
+		output := &wire.TxOut{Version: version, PkScript: pkScript}
+		size := txsizes.EstimateSerializeSizeFromScriptSizes(
+			[]int{c.sigScriptSize}, []*wire.TxOut{output}, 0)
+		fee := txrules.FeeForSerializeSize(w.RelayFee(), size)
+		output.Value = int64(c.amount - fee)
+		if c.amount <= fee || txrules.IsDustOutput(output, w.RelayFee()) {
+			return apperrors.E{
+				ErrorCode:   apperrors.ErrInput,
+				Description: fmt.Sprintf("%s is too small to pay the fee", c.desc),
+			}
+		}
+		tx.AddTxOut(output)
+
+		ma, err := w.Manager.Address(addrmgrNs, c.keyAddr)
+		if err != nil {
+			return err
+		}
+		pka, ok := ma.(udb.ManagedPubKeyAddress)
+		if !ok {
+			return apperrors.E{
+				ErrorCode:   apperrors.ErrInput,
+				Description: fmt.Sprintf("%s key is not a pubkey address", c.desc),
+			}
+		}
+		pubKey := pka.PubKey().SerializeUncompressed()
+		if pka.Compressed() {
+			pubKey = pka.PubKey().SerializeCompressed()
+		}
+		key, done, err := w.Manager.PrivateKey(addrmgrNs, c.keyAddr)
+		if err != nil {
+			return err
+		}
+		defer done()
+		sig, err := txscript.RawTxInSignature(tx, 0, c.script,
+			txscript.SigHashAll, key)
+		if err != nil {
+			return err
+		}
+		txIn.SignatureScript, err = c.sigScript(sig, pubKey)
+		if err != nil {
+			return err
+		}
+
+		atx = &txauthor.AuthoredTx{
+			Tx:                           tx,
+			PrevScripts:                  [][]byte{prevScript},
+			TotalInput:                   c.amount,
+			ChangeIndex:                  0,
+			EstimatedSignedSerializeSize: size,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The signature script is verified before the transaction and its
+	// change address are recorded and the transaction is published.
+	err = w.publishAuthoredTx(atx, changeSourceUpdates, chainClient)
+	if err != nil {
+		return nil, err
+	}
+	txHash := tx.TxHash()
+	w.pendingScriptSpendsMu.Lock()
+	w.pendingScriptSpends[c.outPoint] = txHash
+	w.pendingScriptSpendsMu.Unlock()
+	return &txHash, nil
+}
+
+// pendingScriptSpend returns whether a transaction published by
+// spendScriptCredit to spend an outpoint is still recorded by the wallet.
+// Spends which are no longer recorded, such as unmined transactions removed
+// after a double spend, are forgotten so the outpoint may be spent again.
+func (w *Wallet) pendingScriptSpend(op *wire.OutPoint) bool {
+	w.pendingScriptSpendsMu.Lock()
+	defer w.pendingScriptSpendsMu.Unlock()
+
+	txHash, ok := w.pendingScriptSpends[*op]
+	if !ok {
+		return false
+	}
+	var exists bool
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		exists = w.TxStore.ExistsTx(txmgrNs, &txHash)
+		return nil
+	})
+	if err != nil {
+		log.Errorf("Failed to look up transaction %v: %v", &txHash, err)
+		return true
+	}
+	if !exists {
+		delete(w.pendingScriptSpends, *op)
+	}
+	return exists
+}
+
+// forgetScriptSpend forgets the pending spend of an outpoint once the spend is
+// recorded.
+func (w *Wallet) forgetScriptSpend(op *wire.OutPoint) {
+	w.pendingScriptSpendsMu.Lock()
+	delete(w.pendingScriptSpends, *op)
+	w.pendingScriptSpendsMu.Unlock()
+}
@@ -0,0 +1,478 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/abcsuite/abcd/chaincfg/chainec"
+	"github.com/abcsuite/abcd/chaincfg/chainhash"
+	"github.com/abcsuite/abcd/txscript"
+	"github.com/abcsuite/abcd/wire"
+	"github.com/abcsuite/abcutil"
+	"github.com/abcsuite/abcwallet/apperrors"
+	"github.com/abcsuite/abcwallet/wallet/pstx"
+	"github.com/abcsuite/abcwallet/wallet/txrules"
+	"github.com/abcsuite/abcwallet/wallet/udb"
+	"github.com/abcsuite/abcwallet/walletdb"
+
+	"github.com/abcsuite/abcwallet/wallet/internal/txsizes"
+)
+
+// SigningSessionInput describes the signatures collected for an input of a
+// signing session.
+type SigningSessionInput struct {
+	PreviousOutPoint wire.OutPoint
+	Amount           abcutil.Amount
+	RequiredSigs     int
+
+	// SignedPubKeys and UnsignedPubKeys are the serialized public keys of
+	// the redeem script which have and have not signed the input.  Final
+	// inputs were signed elsewhere and record no signers.
+	SignedPubKeys   [][]byte
+	UnsignedPubKeys [][]byte
+	Final           bool
+}
+
+// SigningSession describes a session collecting the signatures of multisig
+// cosigners for a transaction spending P2SH multisig outputs.  The partially
+// signed transaction is exported to cosigners, who return it with their
+// signatures.  Once every input has the required number of signatures, the
+// session is complete and SignedTx is the finalized transaction.
+type SigningSession struct {
+	Hash      chainhash.Hash
+	Created   time.Time
+	PartialTx *pstx.Tx
+	Inputs    []SigningSessionInput
+	Complete  bool
+	SignedTx  *wire.MsgTx
+}
+
+// signingSessionErr returns an ErrInput error describing a problem with a
+// signing session or the signatures imported to it.
+func signingSessionErr(format string, args ...interface{}) error {
+	return apperrors.E{
+		ErrorCode:   apperrors.ErrInput,
+		Description: fmt.Sprintf(format, args...),
+	}
+}
+
+// multisigInput returns the redeem script, required signatures, and public
+// keys of a P2SH multisig input.  An ErrInput error is returned if the previous
+// output or redeem script are unknown or the input does not redeem a P2SH
+// multisig output.
+func (w *Wallet) multisigInput(p *pstx.Tx, i int) (script []byte, reqSigs int,
+	pubKeys [][]byte, err error) {
+
+	in := &p.Inputs[i]
+	if in.PrevOut == nil {
+		return nil, 0, nil, signingSessionErr("previous output of input %d "+
+			"is unknown", i)
+	}
+	class, addrs, _, err := txscript.ExtractPkScriptAddrs(in.PrevOut.Version,
+		in.PrevOut.PkScript, w.chainParams)
+	if err != nil || class != txscript.ScriptHashTy {
+		return nil, 0, nil, signingSessionErr("input %d does not redeem a "+
+			"P2SH output", i)
+	}
+	if in.RedeemScript == nil {
+		return nil, 0, nil, signingSessionErr("redeem script of input %d "+
+			"is unknown", i)
+	}
+	if !bytes.Equal(abcutil.Hash160(in.RedeemScript), addrs[0].ScriptAddress()) {
+		return nil, 0, nil, signingSessionErr("redeem script of input %d "+
+			"does not match the previous output script", i)
+	}
+	class, addrs, reqSigs, err = txscript.ExtractPkScriptAddrs(
+		txscript.DefaultScriptVersion, in.RedeemScript, w.chainParams)
+	if err != nil || class != txscript.MultiSigTy {
+		return nil, 0, nil, signingSessionErr("redeem script of input %d "+
+			"is not a multisig script", i)
+	}
+	pubKeys = make([][]byte, len(addrs))
+	for j, addr := range addrs {
+		pubKeys[j] = addr.ScriptAddress()
+	}
+	return in.RedeemScript, reqSigs, pubKeys, nil
+}
+
+// verifyPartialSig checks that a partial signature of an input is a valid
+// SigHashAll signature of the redeem script by one of its public keys.
+func verifyPartialSig(tx *wire.MsgTx, i int, script []byte, pubKeys [][]byte,
+	s *pstx.PartialSig) error {
+
+	found := false
+	for _, pubKey := range pubKeys {
+		if bytes.Equal(pubKey, s.PubKey) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return signingSessionErr("public key %x is not a key of the "+
+			"redeem script of input %d", s.PubKey, i)
+	}
+	if len(s.Signature) == 0 ||
+		txscript.SigHashType(s.Signature[len(s.Signature)-1]) != txscript.SigHashAll {
+		return signingSessionErr("signature of input %d by %x does not use "+
+			"SigHashAll", i, s.PubKey)
+	}
+
+	hash, err := txscript.CalcSignatureHash(script, txscript.SigHashAll, tx, i, nil)
+	if err != nil {
+		return err
+	}
+	pubKey, err := chainec.Secp256k1.ParsePubKey(s.PubKey)
+	if err != nil {
+		return signingSessionErr("invalid public key %x for input %d: %v",
+			s.PubKey, i, err)
+	}
+	sig, err := chainec.Secp256k1.ParseDERSignature(s.Signature[:len(s.Signature)-1])
+	if err != nil {
+		return signingSessionErr("invalid signature of input %d by %x: %v",
+			i, s.PubKey, err)
+	}
+	if !chainec.Secp256k1.Verify(pubKey, hash, sig.GetR(), sig.GetS()) {
+		return signingSessionErr("signature of input %d by %x does not "+
+			"verify", i, s.PubKey)
+	}
+	return nil
+}
+
+// verifySignatureScript checks that the signature script of a final input
+// redeems its previous output.
+func verifySignatureScript(p *pstx.Tx, i int) error {
+	prevOut := p.Inputs[i].PrevOut
+	vm, err := txscript.NewEngine(prevOut.PkScript, p.Tx, i,
+		txscript.StandardVerifyFlags, prevOut.Version, nil)
+	if err == nil {
+		err = vm.Execute()
+	}
+	if err != nil {
+		return signingSessionErr("signature script of input %d is "+
+			"invalid: %v", i, err)
+	}
+	return nil
+}
+
+// checkSigningSessionTx checks that every input of a partially signed
+// transaction redeems a P2SH multisig output and that every partial signature
+// and signature script is valid.
+func (w *Wallet) checkSigningSessionTx(p *pstx.Tx) error {
+	if len(p.Tx.TxIn) == 0 {
+		return signingSessionErr("transaction has no inputs")
+	}
+	for i := range p.Tx.TxIn {
+		script, _, pubKeys, err := w.multisigInput(p, i)
+		if err != nil {
+			return err
+		}
+		if p.Final(i) {
+			err = verifySignatureScript(p, i)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		for j := range p.Inputs[i].PartialSigs {
+			err = verifyPartialSig(p.Tx, i, script, pubKeys,
+				&p.Inputs[i].PartialSigs[j])
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// describeSigningSession parses a saved signing session and describes the
+// signatures collected for each input.  If every input has enough signatures,
+// the transaction is finalized.  The saved partially signed transaction is not
+// finalized so that the signers of every input remain known.
+func (w *Wallet) describeSigningSession(s *udb.SigningSession) (*SigningSession, error) {
+	p, err := pstx.Parse(s.PartialTx)
+	if err != nil {
+		return nil, err
+	}
+	session := &SigningSession{
+		Hash:      s.Hash,
+		Created:   s.Created,
+		PartialTx: p,
+		Inputs:    make([]SigningSessionInput, len(p.Tx.TxIn)),
+	}
+	enough := true
+	for i, txIn := range p.Tx.TxIn {
+		input := &session.Inputs[i]
+		input.PreviousOutPoint = txIn.PreviousOutPoint
+		input.Final = p.Final(i)
+		_, reqSigs, pubKeys, err := w.multisigInput(p, i)
+		if err != nil {
+			return nil, err
+		}
+		input.Amount = abcutil.Amount(p.Inputs[i].PrevOut.Value)
+		input.RequiredSigs = reqSigs
+		if input.Final {
+			continue
+		}
+		for _, pubKey := range pubKeys {
+			if p.Inputs[i].Signature(pubKey) != nil {
+				input.SignedPubKeys = append(input.SignedPubKeys, pubKey)
+			} else {
+				input.UnsignedPubKeys = append(input.UnsignedPubKeys, pubKey)
+			}
+		}
+		if len(input.SignedPubKeys) < reqSigs {
+			enough = false
+		}
+	}
+	if !enough {
+		return session, nil
+	}
+
+	// Finalize a copy of the partially signed transaction.
+	final, err := pstx.Combine(p)
+	if err != nil {
+		return nil, err
+	}
+	complete, err := w.FinalizePartialTx(final)
+	if err != nil {
+		return nil, err
+	}
+	if complete {
+		session.Complete = true
+		session.SignedTx, err = final.Extract()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return session, nil
+}
+
+// putSigningSession saves the partially signed transaction of a signing
+// session and returns the description of the saved session.
+func (w *Wallet) putSigningSession(dbtx walletdb.ReadWriteTx, hash *chainhash.Hash,
+	created time.Time, p *pstx.Tx) (*SigningSession, error) {
+
+	b, err := p.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	s := &udb.SigningSession{
+		Hash:      *hash,
+		Created:   created,
+		PartialTx: b,
+	}
+	err = udb.PutSigningSession(dbtx, s)
+	if err != nil {
+		return nil, err
+	}
+	return w.describeSigningSession(s)
+}
+
+// CreateSigningSession creates and saves a signing session for a transaction
+// spending P2SH multisig outputs, such as the MultisigCredits of imported
+// multisig addresses or the outputs of a multisig account.  The transaction
+// is updated with the previous outputs and redeem scripts known by the wallet,
+// and every input must redeem a P2SH multisig output.  Any signatures already
+// carried by the partially signed transaction are verified.  An error with the
+// ErrDuplicate code is returned if a session already exists for the
+// transaction.
+func (w *Wallet) CreateSigningSession(p *pstx.Tx) (*SigningSession, error) {
+	var session *SigningSession
+	err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		hash := p.Tx.TxHash()
+		if udb.ExistsSigningSession(dbtx, &hash) {
+			return apperrors.E{
+				ErrorCode:   apperrors.ErrDuplicate,
+				Description: "signing session already exists for transaction",
+			}
+		}
+
+		err := w.updatePartialTx(dbtx, p)
+		if err != nil {
+			return err
+		}
+		err = w.checkSigningSessionTx(p)
+		if err != nil {
+			return err
+		}
+
+		session, err = w.putSigningSession(dbtx, &hash, time.Now(), p)
+		return err
+	})
+	return session, err
+}
+
+// SpendMultisigCredits creates an unsigned transaction spending unspent
+// MultisigCredits to a single output paying an address.  The fee is estimated
+// for the multisig signature scripts of every input and subtracted from the
+// output.  The spend may be signed by creating a signing session.
+func (w *Wallet) SpendMultisigCredits(outpoints []wire.OutPoint, addr abcutil.Address,
+	relayFeePerKb abcutil.Amount) (*wire.MsgTx, error) {
+
+	if len(outpoints) == 0 {
+		return nil, signingSessionErr("no multisig outputs to spend")
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := wire.NewMsgTx()
+	sigScriptSizes := make([]int, 0, len(outpoints))
+	var total abcutil.Amount
+	err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		_, tipHeight := w.TxStore.MainChainTip(txmgrNs)
+
+		for i := range outpoints {
+			op := outpoints[i]
+			credit, err := w.TxStore.GetMultisigCredit(txmgrNs, &op)
+			if err != nil {
+				return err
+			}
+			txIn := wire.NewTxIn(credit.OutPoint, nil)
+			txIn.ValueIn = int64(credit.Amount)
+			tx.AddTxIn(txIn)
+			sigScriptSizes = append(sigScriptSizes,
+				txsizes.RedeemP2SHMultisigSigScriptSize(int(credit.M), int(credit.N)))
+			total += credit.Amount
+		}
+
+		expiry, err := w.expiryHeight(nil, tipHeight)
+		if err != nil {
+			return err
+		}
+		tx.Expiry = expiry
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	output := wire.NewTxOut(0, pkScript)
+	size := txsizes.EstimateSerializeSizeFromScriptSizes(sigScriptSizes,
+		[]*wire.TxOut{output}, 0)
+	fee := txrules.FeeForSerializeSize(relayFeePerKb, size)
+	output.Value = int64(total - fee)
+	if total <= fee || txrules.IsDustOutput(output, relayFeePerKb) {
+		return nil, signingSessionErr("multisig outputs of %v are too small "+
+			"to pay the %v fee", total, fee)
+	}
+	tx.AddTxOut(output)
+	return tx, nil
+}
+
+// SigningSession returns the signing session of a transaction.
+func (w *Wallet) SigningSession(hash *chainhash.Hash) (*SigningSession, error) {
+	var session *SigningSession
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		s, err := udb.FetchSigningSession(dbtx, hash)
+		if err != nil {
+			return err
+		}
+		session, err = w.describeSigningSession(s)
+		return err
+	})
+	return session, err
+}
+
+// SigningSessions returns every saved signing session.
+func (w *Wallet) SigningSessions() ([]*SigningSession, error) {
+	var sessions []*SigningSession
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		saved, err := udb.SigningSessions(dbtx)
+		if err != nil {
+			return err
+		}
+		sessions = make([]*SigningSession, 0, len(saved))
+		for i := range saved {
+			session, err := w.describeSigningSession(&saved[i])
+			if err != nil {
+				return err
+			}
+			sessions = append(sessions, session)
+		}
+		return nil
+	})
+	return sessions, err
+}
+
+// ImportSigningSessionSigs adds the signatures of cosigners carried by a
+// partially signed transaction to the signing session of the same
+// transaction.  Every new partial signature must be a valid SigHashAll
+// signature of the input's redeem script by one of its keys, and signature
+// scripts of final inputs must be valid.  Nothing is imported if any signature
+// is invalid.  The updated session is returned, and is complete if every input
+// now has enough signatures.
+func (w *Wallet) ImportSigningSessionSigs(p *pstx.Tx) (*SigningSession, error) {
+	var session *SigningSession
+	err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		hash := p.Tx.TxHash()
+		s, err := udb.FetchSigningSession(dbtx, &hash)
+		if err != nil {
+			return err
+		}
+		saved, err := pstx.Parse(s.PartialTx)
+		if err != nil {
+			return err
+		}
+
+		// Only the saved previous outputs and redeem scripts are used
+		// to verify the imported signatures.
+		imported := pstx.New(p.Tx.Copy())
+		for i := range p.Inputs {
+			imported.Inputs[i].PartialSigs = p.Inputs[i].PartialSigs
+		}
+		combined, err := pstx.Combine(saved, imported)
+		if err != nil {
+			return signingSessionErr("cannot combine signatures: %v", err)
+		}
+		err = w.checkSigningSessionTx(combined)
+		if err != nil {
+			return err
+		}
+
+		session, err = w.putSigningSession(dbtx, &hash, s.Created, combined)
+		return err
+	})
+	return session, err
+}
+
+// SignSigningSession adds the signatures of every wallet key of the redeem
+// scripts of a signing session which has not yet signed.  The indexes of the
+// inputs signed by the wallet and the updated session are returned.
+//
+// This method requires the wallet to be unlocked.
+func (w *Wallet) SignSigningSession(hash *chainhash.Hash) (*SigningSession, []int, error) {
+	var session *SigningSession
+	var signed []int
+	err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		s, err := udb.FetchSigningSession(dbtx, hash)
+		if err != nil {
+			return err
+		}
+		p, err := pstx.Parse(s.PartialTx)
+		if err != nil {
+			return err
+		}
+		signed, err = w.signPartialTx(dbtx, p)
+		if err != nil {
+			return err
+		}
+		session, err = w.putSigningSession(dbtx, hash, s.Created, p)
+		return err
+	})
+	return session, signed, err
+}
+
+// AbandonSigningSession removes the signing session of a transaction.
+func (w *Wallet) AbandonSigningSession(hash *chainhash.Hash) error {
+	return walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return udb.DeleteSigningSession(dbtx, hash)
+	})
+}
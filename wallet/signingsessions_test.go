@@ -0,0 +1,78 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+
+	"github.com/abcsuite/abcd/chaincfg/chainec"
+	"github.com/abcsuite/abcd/chaincfg/chainhash"
+	"github.com/abcsuite/abcd/txscript"
+	"github.com/abcsuite/abcd/wire"
+	"github.com/abcsuite/abcwallet/apperrors"
+	"github.com/abcsuite/abcwallet/wallet/pstx"
+)
+
+func TestVerifyPartialSig(t *testing.T) {
+	var privKeys []chainec.PrivateKey
+	var pubKeys [][]byte
+	for i := byte(1); i <= 3; i++ {
+		priv, pub := chainec.Secp256k1.PrivKeyFromBytes(chainhash.HashB([]byte{i}))
+		privKeys = append(privKeys, priv)
+		pubKeys = append(pubKeys, pub.SerializeCompressed())
+	}
+
+	// The redeem script is a 1-of-2 multisig script of the first two keys.
+	// The third key is not a key of the script.
+	script, err := txscript.NewScriptBuilder().AddOp(txscript.OP_1).
+		AddData(pubKeys[0]).AddData(pubKeys[1]).AddOp(txscript.OP_2).
+		AddOp(txscript.OP_CHECKMULTISIG).Script()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{1}, 0, wire.TxTreeRegular), nil))
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{2}, 0, wire.TxTreeRegular), nil))
+	tx.AddTxOut(wire.NewTxOut(1e8, []byte{txscript.OP_TRUE}))
+
+	sign := func(i int, hashType txscript.SigHashType, key chainec.PrivateKey) []byte {
+		sig, err := txscript.RawTxInSignature(tx, i, script, hashType, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return sig
+	}
+	corrupt := sign(1, txscript.SigHashAll, privKeys[0])
+	corrupt[len(corrupt)/2] ^= 0xff
+
+	tests := []struct {
+		name   string
+		pubKey []byte
+		sig    []byte
+		valid  bool
+	}{
+		{"first key", pubKeys[0], sign(1, txscript.SigHashAll, privKeys[0]), true},
+		{"second key", pubKeys[1], sign(1, txscript.SigHashAll, privKeys[1]), true},
+		{"key not in script", pubKeys[2], sign(1, txscript.SigHashAll, privKeys[2]), false},
+		{"signed by other key", pubKeys[0], sign(1, txscript.SigHashAll, privKeys[1]), false},
+		{"signature of other input", pubKeys[0], sign(0, txscript.SigHashAll, privKeys[0]), false},
+		{"SigHashNone", pubKeys[0], sign(1, txscript.SigHashNone, privKeys[0]), false},
+		{"SigHashAll|AnyOneCanPay", pubKeys[0],
+			sign(1, txscript.SigHashAll|txscript.SigHashAnyOneCanPay, privKeys[0]), false},
+		{"corrupted signature", pubKeys[0], corrupt, false},
+		{"empty signature", pubKeys[0], nil, false},
+		{"hash type only", pubKeys[0], []byte{byte(txscript.SigHashAll)}, false},
+	}
+	for _, test := range tests {
+		sig := &pstx.PartialSig{PubKey: test.pubKey, Signature: test.sig}
+		err := verifyPartialSig(tx, 1, script, pubKeys[:2], sig)
+		switch {
+		case test.valid && err != nil:
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		case !test.valid && !apperrors.IsError(err, apperrors.ErrInput):
+			t.Errorf("%s: expected ErrInput, got %v", test.name, err)
+		}
+	}
+}
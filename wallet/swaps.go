@@ -0,0 +1,715 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"sort"
+	"time"
+
+	"github.com/abcsuite/abcd/chaincfg/chainec"
+	"github.com/abcsuite/abcd/chaincfg/chainhash"
+	"github.com/abcsuite/abcd/txscript"
+	"github.com/abcsuite/abcd/wire"
+	"github.com/abcsuite/abcutil"
+	"github.com/abcsuite/abcwallet/apperrors"
+	"github.com/abcsuite/abcwallet/wallet/txauthor"
+	"github.com/abcsuite/abcwallet/wallet/txrules"
+	"github.com/abcsuite/abcwallet/wallet/udb"
+	"github.com/abcsuite/abcwallet/walletdb"
+
+	"github.com/abcsuite/abcwallet/wallet/internal/swapscript"
+	"github.com/abcsuite/abcwallet/wallet/internal/txsizes"
+)
+
+// Locktimes of atomic swap contracts funded by the wallet.  The initiator's
+// contract must remain redeemable for longer than the participant's, so the
+// participant has time to redeem it after the initiator reveals the secret by
+// redeeming the participant's contract.
+const (
+	InitiatorSwapLockTime   = 48 * time.Hour
+	ParticipantSwapLockTime = 24 * time.Hour
+)
+
+// SwapContract describes a hash time locked atomic swap contract.  The contract
+// script is paid to by a P2SH output.  The recipient may redeem the output by
+// revealing the secret, or the refund address may spend it after the locktime.
+type SwapContract struct {
+	OutPoint wire.OutPoint
+	Role     udb.SwapRole
+	Account  uint32
+	Amount   abcutil.Amount
+	Contract []byte
+	Address  abcutil.Address
+
+	RecipientAddress abcutil.Address
+	RefundAddress    abcutil.Address
+	SecretHash       []byte
+	LockTime         int64
+
+	// Secret is nil until it is known.  SpendTx is nil until the contract
+	// is redeemed or refunded, and Redeemed records whether the spend
+	// revealed the secret.
+	Secret   []byte
+	Created  time.Time
+	SpendTx  *chainhash.Hash
+	Redeemed bool
+}
+
+// describeSwapContract parses the contract script of a saved swap contract.
+func (w *Wallet) describeSwapContract(c *udb.SwapContract) (*SwapContract, error) {
+	details, err := swapscript.Parse(c.Contract)
+	if err != nil {
+		return nil, apperrors.E{
+			ErrorCode:   apperrors.ErrData,
+			Description: "saved swap contract is invalid",
+			Err:         err,
+		}
+	}
+	addr, err := abcutil.NewAddressScriptHash(c.Contract, w.chainParams)
+	if err != nil {
+		return nil, err
+	}
+	recipient, err := abcutil.NewAddressPubKeyHash(details.RecipientHash[:],
+		w.chainParams, chainec.ECTypeSecp256k1)
+	if err != nil {
+		return nil, err
+	}
+	refund, err := abcutil.NewAddressPubKeyHash(details.RefundHash[:],
+		w.chainParams, chainec.ECTypeSecp256k1)
+	if err != nil {
+		return nil, err
+	}
+	return &SwapContract{
+		OutPoint:         c.OutPoint,
+		Role:             c.Role,
+		Account:          c.Account,
+		Amount:           c.Amount,
+		Contract:         c.Contract,
+		Address:          addr,
+		RecipientAddress: recipient,
+		RefundAddress:    refund,
+		SecretHash:       details.SecretHash[:],
+		LockTime:         details.LockTime,
+		Secret:           c.Secret,
+		Created:          c.Created,
+		SpendTx:          c.SpendTx,
+		Redeemed:         c.Redeemed,
+	}, nil
+}
+
+// watchSwapContract loads the contract outpoint into the consensus server's
+// transaction filter so the wallet is notified when it is spent.
+func (w *Wallet) watchSwapContract(op *wire.OutPoint) {
+	chainClient := w.ChainClient()
+	if chainClient == nil {
+		return
+	}
+	err := chainClient.LoadTxFilter(false, nil, []wire.OutPoint{*op})
+	if err != nil {
+		log.Errorf("Failed to watch swap contract %v: %v", op, err)
+	}
+}
+
+// fundSwapContract creates and publishes a transaction paying to a contract
+// which pays to the recipient with the secret, or refunds to an address of the
+// account after the locktime.
+func (w *Wallet) fundSwapContract(role udb.SwapRole, account uint32,
+	recipient abcutil.Address, amount abcutil.Amount, secretHash, secret []byte,
+	lockTime time.Duration) (*SwapContract, *wire.MsgTx, error) {
+
+	recipientPKH, ok := recipient.(*abcutil.AddressPubKeyHash)
+	if !ok {
+		return nil, nil, apperrors.E{
+			ErrorCode:   apperrors.ErrInput,
+			Description: "swap recipient must be a pubkey hash address",
+		}
+	}
+	if len(secretHash) != sha256.Size {
+		return nil, nil, apperrors.E{
+			ErrorCode:   apperrors.ErrInput,
+			Description: "swap secret hash must be a SHA256 hash",
+		}
+	}
+
+	// Contracts are refunded by a signature of a pubkey hash address, so
+	// refunds can not be paid to multisig accounts.
+	var ms *udb.MultisigAccount
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		ms, err = w.Manager.MultisigAccount(dbtx, account)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if ms != nil {
+		return nil, nil, apperrors.E{
+			ErrorCode:   apperrors.ErrInput,
+			Description: "swap contracts can not be funded by multisig accounts",
+		}
+	}
+	refund, err := w.NewChangeAddress(account)
+	if err != nil {
+		return nil, nil, err
+	}
+	refundPKH, ok := refund.(*abcutil.AddressPubKeyHash)
+	if !ok {
+		return nil, nil, apperrors.E{
+			ErrorCode:   apperrors.ErrInput,
+			Description: "swap refund address must be a pubkey hash address",
+		}
+	}
+
+	details := &swapscript.Contract{
+		LockTime: time.Now().Add(lockTime).Unix(),
+	}
+	copy(details.SecretHash[:], secretHash)
+	copy(details.RecipientHash[:], recipientPKH.ScriptAddress())
+	copy(details.RefundHash[:], refundPKH.ScriptAddress())
+	contract, err := details.Script()
+	if err != nil {
+		return nil, nil, err
+	}
+	contractAddr, err := abcutil.NewAddressScriptHash(contract, w.chainParams)
+	if err != nil {
+		return nil, nil, err
+	}
+	pkScript, err := txscript.PayToAddrScript(contractAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+	output := wire.NewTxOut(int64(amount), pkScript)
+	err = txrules.CheckOutput(output, w.RelayFee())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	atx, err := w.CreateSimpleTx(account, []*wire.TxOut{output}, 1,
+		txauthor.CoinSelectionDefault, nil, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	c := &udb.SwapContract{
+		OutPoint: wire.OutPoint{Hash: atx.Tx.TxHash(), Tree: wire.TxTreeRegular},
+		Role:     role,
+		Account:  account,
+		Amount:   amount,
+		Contract: contract,
+		Secret:   secret,
+		Created:  time.Now(),
+	}
+	for i, out := range atx.Tx.TxOut {
+		if bytes.Equal(out.PkScript, pkScript) {
+			c.OutPoint.Index = uint32(i)
+			break
+		}
+	}
+	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return udb.PutSwapContract(dbtx, c)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	w.watchSwapContract(&c.OutPoint)
+
+	log.Infof("Funded swap contract %v paying %v to %v", &c.OutPoint,
+		amount, recipient)
+
+	desc, err := w.describeSwapContract(c)
+	if err != nil {
+		return nil, nil, err
+	}
+	return desc, atx.Tx, nil
+}
+
+// InitiateSwap begins an atomic swap by creating a random secret and publishing
+// a transaction from an account which pays amount to a contract redeemable by
+// the recipient with the secret.  The contract is refunded to the account if
+// it is not redeemed within InitiatorSwapLockTime.  The contract and the
+// funding transaction are returned, and must be given to the counterparty to
+// audit before they participate.
+func (w *Wallet) InitiateSwap(account uint32, recipient abcutil.Address,
+	amount abcutil.Amount) (*SwapContract, *wire.MsgTx, error) {
+
+	secret := make([]byte, swapscript.SecretSize)
+	_, err := rand.Read(secret)
+	if err != nil {
+		return nil, nil, err
+	}
+	secretHash := sha256.Sum256(secret)
+	return w.fundSwapContract(udb.SwapInitiator, account, recipient, amount,
+		secretHash[:], secret, InitiatorSwapLockTime)
+}
+
+// ParticipateSwap participates in an atomic swap by publishing a transaction
+// from an account which pays amount to a contract redeemable by the recipient
+// with the secret of the initiator's contract.  The contract is refunded to the
+// account if it is not redeemed within ParticipantSwapLockTime.  The
+// initiator's contract should be audited with AuditSwapContract before
+// participating.
+func (w *Wallet) ParticipateSwap(account uint32, recipient abcutil.Address,
+	amount abcutil.Amount, secretHash []byte) (*SwapContract, *wire.MsgTx, error) {
+
+	return w.fundSwapContract(udb.SwapParticipant, account, recipient, amount,
+		secretHash, nil, ParticipantSwapLockTime)
+}
+
+// AuditSwapContract returns the details of a contract paid to by an output of
+// a transaction, such as the contract of the counterparty of a swap.  The
+// contract is not saved.  An error with the ErrInput code is returned if the
+// script is not a contract or no output pays to it.
+func (w *Wallet) AuditSwapContract(contract []byte, tx *wire.MsgTx) (*SwapContract, error) {
+	if _, err := swapscript.Parse(contract); err != nil {
+		return nil, apperrors.E{
+			ErrorCode:   apperrors.ErrInput,
+			Description: "script is not an atomic swap contract",
+			Err:         err,
+		}
+	}
+	contractAddr, err := abcutil.NewAddressScriptHash(contract, w.chainParams)
+	if err != nil {
+		return nil, err
+	}
+	pkScript, err := txscript.PayToAddrScript(contractAddr)
+	if err != nil {
+		return nil, err
+	}
+	c := &udb.SwapContract{
+		OutPoint: wire.OutPoint{Hash: tx.TxHash(), Index: ^uint32(0)},
+		Role:     udb.SwapCounterparty,
+		Contract: contract,
+		Created:  time.Now(),
+	}
+	for i, out := range tx.TxOut {
+		if bytes.Equal(out.PkScript, pkScript) {
+			c.OutPoint.Index = uint32(i)
+			c.OutPoint.Tree = wire.TxTreeRegular
+			c.Amount = abcutil.Amount(out.Value)
+			break
+		}
+	}
+	if c.OutPoint.Index == ^uint32(0) {
+		return nil, apperrors.E{
+			ErrorCode:   apperrors.ErrInput,
+			Description: "transaction does not pay to the contract",
+		}
+	}
+	return w.describeSwapContract(c)
+}
+
+// WatchSwapContract audits and saves the contract of the counterparty of a
+// swap which is redeemable by a wallet key.  The wallet redeems the contract
+// once the secret is known, either from a contract the wallet initiated or from
+// the redemption of another saved contract with the same secret hash.
+func (w *Wallet) WatchSwapContract(contract []byte, tx *wire.MsgTx) (*SwapContract, error) {
+	desc, err := w.AuditSwapContract(contract, tx)
+	if err != nil {
+		return nil, err
+	}
+	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		if udb.ExistsSwapContract(dbtx, &desc.OutPoint) {
+			return apperrors.E{
+				ErrorCode:   apperrors.ErrDuplicate,
+				Description: "swap contract is already saved",
+			}
+		}
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		account, err := w.Manager.AddrAccount(addrmgrNs, desc.RecipientAddress)
+		if apperrors.IsError(err, apperrors.ErrAddressNotFound) {
+			return apperrors.E{
+				ErrorCode:   apperrors.ErrInput,
+				Description: "swap contract recipient is not a wallet address",
+			}
+		}
+		if err != nil {
+			return err
+		}
+		desc.Account = account
+
+		// The secret may already be known if the wallet initiated the
+		// swap.
+		contracts, err := udb.SwapContracts(dbtx)
+		if err != nil {
+			return err
+		}
+		for i := range contracts {
+			s := contracts[i].Secret
+			if s != nil && bytes.Equal(sha256Hash(s), desc.SecretHash) {
+				desc.Secret = s
+				break
+			}
+		}
+
+		return udb.PutSwapContract(dbtx, &udb.SwapContract{
+			OutPoint: desc.OutPoint,
+			Role:     desc.Role,
+			Account:  desc.Account,
+			Amount:   desc.Amount,
+			Contract: desc.Contract,
+			Secret:   desc.Secret,
+			Created:  desc.Created,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	w.watchSwapContract(&desc.OutPoint)
+	return desc, nil
+}
+
+func sha256Hash(b []byte) []byte {
+	h := sha256.Sum256(b)
+	return h[:]
+}
+
+// SwapContract returns the saved swap contract of an outpoint.
+func (w *Wallet) SwapContract(op *wire.OutPoint) (*SwapContract, error) {
+	var c *udb.SwapContract
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		c, err = udb.FetchSwapContract(dbtx, op)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return w.describeSwapContract(c)
+}
+
+// SwapContracts returns every saved swap contract.
+func (w *Wallet) SwapContracts() ([]*SwapContract, error) {
+	var contracts []udb.SwapContract
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		contracts, err = udb.SwapContracts(dbtx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	descs := make([]*SwapContract, len(contracts))
+	for i := range contracts {
+		descs[i], err = w.describeSwapContract(&contracts[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return descs, nil
+}
+
+// ExtractSwapSecret returns the secret hashing to secretHash revealed by a
+// signature script of a transaction redeeming a contract.  The secret is saved
+// for every saved contract with the same secret hash, so contracts redeemable
+// by the wallet are redeemed when the next block is attached.  An error with the
+// ErrInput code is returned if no input reveals the secret.
+func (w *Wallet) ExtractSwapSecret(tx *wire.MsgTx, secretHash []byte) ([]byte, error) {
+	var secret []byte
+	for _, in := range tx.TxIn {
+		secret = swapscript.ExtractSecret(in.SignatureScript, secretHash)
+		if secret != nil {
+			break
+		}
+	}
+	if secret == nil {
+		return nil, apperrors.E{
+			ErrorCode:   apperrors.ErrInput,
+			Description: "transaction does not reveal the secret",
+		}
+	}
+	err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return saveSwapSecret(dbtx, secret)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+// saveSwapSecret saves a secret for every saved contract with its hash.
+func saveSwapSecret(dbtx walletdb.ReadWriteTx, secret []byte) error {
+	secretHash := sha256Hash(secret)
+	contracts, err := udb.SwapContracts(dbtx)
+	if err != nil {
+		return err
+	}
+	for i := range contracts {
+		c := &contracts[i]
+		if c.Secret != nil {
+			continue
+		}
+		details, err := swapscript.Parse(c.Contract)
+		if err != nil || !bytes.Equal(details.SecretHash[:], secretHash) {
+			continue
+		}
+		c.Secret = secret
+		err = udb.PutSwapContract(dbtx, c)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// processSwapContractSpends records the spends of saved swap contracts by a
+// transaction and saves any secrets revealed by redemptions.
+func (w *Wallet) processSwapContractSpends(dbtx walletdb.ReadWriteTx, rec *udb.TxRecord) error {
+	for _, in := range rec.MsgTx.TxIn {
+		if !udb.ExistsSwapContract(dbtx, &in.PreviousOutPoint) {
+			continue
+		}
+		c, err := udb.FetchSwapContract(dbtx, &in.PreviousOutPoint)
+		if err != nil {
+			return err
+		}
+		if c.SpendTx != nil && *c.SpendTx == rec.Hash {
+			continue
+		}
+		details, err := swapscript.Parse(c.Contract)
+		if err != nil {
+			return err
+		}
+		secret := swapscript.ExtractSecret(in.SignatureScript, details.SecretHash[:])
+		spendHash := rec.Hash
+		c.SpendTx = &spendHash
+		c.Redeemed = secret != nil
+		if secret != nil && c.Secret == nil {
+			c.Secret = secret
+		}
+		err = udb.PutSwapContract(dbtx, c)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			log.Infof("Swap contract %v redeemed by transaction %v",
+				&c.OutPoint, &rec.Hash)
+			err = saveSwapSecret(dbtx, secret)
+			if err != nil {
+				return err
+			}
+		} else {
+			log.Infof("Swap contract %v refunded by transaction %v",
+				&c.OutPoint, &rec.Hash)
+		}
+	}
+	return nil
+}
+
+// spendSwapContract creates, signs, and publishes a transaction redeeming a
+// contract with the secret, or refunding it if the secret is nil.  The output
+// pays to a change address of the contract's account.
+func (w *Wallet) spendSwapContract(c *SwapContract, secret []byte) (*chainhash.Hash, error) {
+	credit := &scriptCredit{
+		desc:     "swap contract",
+		outPoint: c.OutPoint,
+		amount:   c.Amount,
+		address:  c.Address,
+		script:   c.Contract,
+		account:  c.Account,
+		sequence: wire.MaxTxInSequenceNum,
+	}
+	if secret != nil {
+		credit.keyAddr = c.RecipientAddress
+		credit.sigScriptSize = txsizes.RedeemAtomicSwapSigScriptSize(len(c.Contract))
+		credit.sigScript = func(sig, pubKey []byte) ([]byte, error) {
+			return swapscript.RedeemSigScript(sig, pubKey, secret, c.Contract)
+		}
+	} else {
+		// The locktime must be reached by the spending transaction, and
+		// is only enforced for inputs which are not final.
+		credit.keyAddr = c.RefundAddress
+		credit.lockTime = uint32(c.LockTime)
+		credit.sequence = 0
+		credit.sigScriptSize = txsizes.RefundAtomicSwapSigScriptSize(len(c.Contract))
+		credit.sigScript = func(sig, pubKey []byte) ([]byte, error) {
+			return swapscript.RefundSigScript(sig, pubKey, c.Contract)
+		}
+	}
+	return w.spendScriptCredit(credit)
+}
+
+// unspentSwapContract returns the saved swap contract of an outpoint, or an
+// error with the ErrInput code if it has already been spent.
+func (w *Wallet) unspentSwapContract(op *wire.OutPoint) (*SwapContract, error) {
+	c, err := w.SwapContract(op)
+	if err != nil {
+		return nil, err
+	}
+	if c.SpendTx != nil {
+		return nil, apperrors.E{
+			ErrorCode:   apperrors.ErrInput,
+			Description: "swap contract has already been spent",
+		}
+	}
+	return c, nil
+}
+
+// RedeemSwap redeems a saved contract of the counterparty of a swap which pays
+// to a wallet key.  If the secret is nil, the secret saved for the contract is
+// used.  The hash of the published redeeming transaction is returned.
+//
+// This method requires the wallet to be unlocked.
+func (w *Wallet) RedeemSwap(op *wire.OutPoint, secret []byte) (*chainhash.Hash, error) {
+	c, err := w.unspentSwapContract(op)
+	if err != nil {
+		return nil, err
+	}
+	if c.Role != udb.SwapCounterparty {
+		return nil, apperrors.E{
+			ErrorCode:   apperrors.ErrInput,
+			Description: "swap contracts funded by the wallet can not be redeemed by it",
+		}
+	}
+	if secret == nil {
+		secret = c.Secret
+	}
+	if secret == nil || !bytes.Equal(sha256Hash(secret), c.SecretHash) {
+		return nil, apperrors.E{
+			ErrorCode:   apperrors.ErrInput,
+			Description: "secret does not match the contract's secret hash",
+		}
+	}
+	return w.spendSwapContract(c, secret)
+}
+
+// RefundSwap refunds a contract funded by the wallet after its locktime.  The
+// hash of the published refunding transaction is returned.
+//
+// This method requires the wallet to be unlocked.
+func (w *Wallet) RefundSwap(op *wire.OutPoint) (*chainhash.Hash, error) {
+	c, err := w.unspentSwapContract(op)
+	if err != nil {
+		return nil, err
+	}
+	if c.Role == udb.SwapCounterparty {
+		return nil, apperrors.E{
+			ErrorCode:   apperrors.ErrInput,
+			Description: "swap contracts of the counterparty can not be refunded",
+		}
+	}
+	return w.spendSwapContract(c, nil)
+}
+
+// swapLockTimeReached returns whether a transaction with some locktime is final
+// when mined in the block after a block of some height and median time past.
+// Locktimes below txscript.LockTimeThreshold are block heights.  Time-based
+// locktimes must be before the median time past, not the block timestamp.
+func swapLockTimeReached(lockTime int64, height int32, medianTime time.Time) bool {
+	if lockTime < txscript.LockTimeThreshold {
+		return lockTime <= int64(height)
+	}
+	return lockTime < medianTime.Unix()
+}
+
+// medianTimeBlocks is the number of blocks whose timestamps determine the
+// median time past of a block.
+const medianTimeBlocks = 11
+
+type timestamps []int64
+
+func (t timestamps) Len() int           { return len(t) }
+func (t timestamps) Less(i, j int) bool { return t[i] < t[j] }
+func (t timestamps) Swap(i, j int)      { t[i], t[j] = t[j], t[i] }
+
+// medianTime returns the median time past of the main chain block at some
+// height, which is the median timestamp of the block and the blocks before it.
+// Blocks before the earliest block recorded by the wallet are not included.
+func (w *Wallet) medianTime(txmgrNs walletdb.ReadBucket, height int32) (time.Time, error) {
+	ts := make(timestamps, 0, medianTimeBlocks)
+	for h := height; h >= 0 && h > height-medianTimeBlocks; h-- {
+		hash, err := w.TxStore.GetMainChainBlockHashForHeight(txmgrNs, h)
+		if err != nil {
+			if h != height && apperrors.IsError(err, apperrors.ErrValueNoExists) {
+				break
+			}
+			return time.Time{}, err
+		}
+		block, err := w.TxStore.GetBlockMetaForHash(txmgrNs, &hash)
+		if err != nil {
+			return time.Time{}, err
+		}
+		ts = append(ts, block.Time.Unix())
+	}
+	sort.Sort(ts)
+	return time.Unix(ts[len(ts)/2], 0), nil
+}
+
+// handleSwapContracts redeems every unspent contract of a counterparty with a
+// known secret, and refunds every unspent contract funded by the wallet whose
+// locktime allows a refund to be mined in the block after the attached block.
+// Contracts which can not be spent now are retried when the next block is
+// attached, unless the wallet is locked, in which case nothing is attempted.
+// Contracts with a pending spend published by the wallet are not spent again.
+func (w *Wallet) handleSwapContracts(height int32) {
+	if w.Manager.IsLocked() {
+		return
+	}
+	var medianTime time.Time
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		var err error
+		medianTime, err = w.medianTime(txmgrNs, height)
+		return err
+	})
+	if err != nil {
+		log.Errorf("Failed to determine median time of block %d: %v",
+			height, err)
+		return
+	}
+	contracts, err := w.SwapContracts()
+	if err != nil {
+		log.Errorf("Failed to load swap contracts: %v", err)
+		return
+	}
+	for _, c := range contracts {
+		if c.SpendTx != nil {
+			w.forgetScriptSpend(&c.OutPoint)
+			continue
+		}
+		if w.pendingScriptSpend(&c.OutPoint) {
+			continue
+		}
+		switch {
+		case c.Role == udb.SwapCounterparty && c.Secret != nil:
+			txHash, err := w.spendSwapContract(c, c.Secret)
+			if err != nil {
+				log.Warnf("Failed to redeem swap contract %v: %v",
+					&c.OutPoint, err)
+				continue
+			}
+			log.Infof("Redeemed swap contract %v with transaction %v",
+				&c.OutPoint, txHash)
+
+		case c.Role != udb.SwapCounterparty &&
+			swapLockTimeReached(c.LockTime, height, medianTime):
+			txHash, err := w.spendSwapContract(c, nil)
+			if err != nil {
+				log.Warnf("Failed to refund swap contract %v: %v",
+					&c.OutPoint, err)
+				continue
+			}
+			log.Infof("Refunded swap contract %v with transaction %v",
+				&c.OutPoint, txHash)
+		}
+	}
+}
+
+// unspentSwapContractOutpoints returns the outpoints of every unspent saved
+// swap contract.
+func unspentSwapContractOutpoints(dbtx walletdb.ReadTx) ([]wire.OutPoint, error) {
+	contracts, err := udb.SwapContracts(dbtx)
+	if err != nil {
+		return nil, err
+	}
+	var outpoints []wire.OutPoint
+	for i := range contracts {
+		if contracts[i].SpendTx == nil {
+			outpoints = append(outpoints, contracts[i].OutPoint)
+		}
+	}
+	return outpoints, nil
+}
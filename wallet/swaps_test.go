@@ -0,0 +1,44 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/abcsuite/abcd/txscript"
+)
+
+func TestSwapLockTimeReached(t *testing.T) {
+	const lockTime = 1500000000
+	tests := []struct {
+		lockTime   int64
+		height     int32
+		medianTime int64
+		reached    bool
+	}{
+		// Height locktimes are reached by the block before the first
+		// block which may include the refund.
+		0: {100, 98, lockTime + 1, false},
+		1: {100, 99, lockTime + 1, false},
+		2: {100, 100, 0, true},
+		3: {100, 101, 0, true},
+		4: {txscript.LockTimeThreshold - 1, 1e6, lockTime, false},
+
+		// Time locktimes must be before the median time past of the
+		// previous block.
+		5: {lockTime, 1e6, lockTime - 1, false},
+		6: {lockTime, 1e6, lockTime, false},
+		7: {lockTime, 1e6, lockTime + 1, true},
+		8: {txscript.LockTimeThreshold, 0, txscript.LockTimeThreshold + 1, true},
+	}
+	for i, test := range tests {
+		reached := swapLockTimeReached(test.lockTime, test.height,
+			time.Unix(test.medianTime, 0))
+		if reached != test.reached {
+			t.Errorf("Test %d: Got %v: Want %v", i, reached, test.reached)
+		}
+	}
+}
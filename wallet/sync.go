@@ -119,9 +119,9 @@ func (w *Wallet) branchUsed(client *chain.RPCClient, branchXpub *hdkeychain.Exte
 }
 
 // findLastUsedAddress returns the child index of the last used child address
-// derived from a branch key.  If no addresses are found, ^uint32(0) is
+// derived from branch keys.  If no addresses are found, ^uint32(0) is
 // returned.
-func (w *Wallet) findLastUsedAddress(client *chain.RPCClient, xpub *hdkeychain.ExtendedKey) (uint32, error) {
+func (w *Wallet) findLastUsedAddress(client *chain.RPCClient, keys *branchKeys) (uint32, error) {
 	var (
 		lastUsed        = ^uint32(0)
 		scanLen         = uint32(w.gapLimit)
@@ -131,7 +131,7 @@ func (w *Wallet) findLastUsedAddress(client *chain.RPCClient, xpub *hdkeychain.E
 Bsearch:
 	for lo <= hi {
 		mid := (hi + lo) / 2
-		addrs, err := deriveChildAddresses(xpub, mid*scanLen, scanLen, w.chainParams)
+		addrs, err := keys.deriveChildAddresses(mid*scanLen, scanLen, w.chainParams)
 		if err != nil {
 			return 0, err
 		}
@@ -226,8 +226,8 @@ func (w *Wallet) DiscoverActiveAddresses(chainClient *chain.RPCClient, discoverA
 						return err
 					}
 					w.addressBuffers[acct] = &bip0044AccountData{
-						albExternal: addressBuffer{branchXpub: extKey},
-						albInternal: addressBuffer{branchXpub: intKey},
+						albExternal: addressBuffer{branchKeys: &branchKeys{xpub: extKey}},
+						albInternal: addressBuffer{branchKeys: &branchKeys{xpub: intKey}},
 					}
 				}
 			}
@@ -261,10 +261,10 @@ func (w *Wallet) DiscoverActiveAddresses(chainClient *chain.RPCClient, discoverA
 			defer wg.Done()
 			// Do this for both external (0) and internal (1) branches.
 			for branch := uint32(0); branch < 2; branch++ {
-				var branchXpub *hdkeychain.ExtendedKey
+				var keys *branchKeys
 				err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
 					var err error
-					branchXpub, err = w.Manager.AccountBranchExtendedPubKey(tx, acct, branch)
+					keys, err = w.accountBranchKeysFor(tx, acct, branch)
 					return err
 				})
 				if err != nil {
@@ -272,7 +272,7 @@ func (w *Wallet) DiscoverActiveAddresses(chainClient *chain.RPCClient, discoverA
 					return
 				}
 
-				lastUsed, err := w.findLastUsedAddress(chainClient, branchXpub)
+				lastUsed, err := w.findLastUsedAddress(chainClient, keys)
 				if err != nil {
 					errs <- err
 					return
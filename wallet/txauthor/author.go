@@ -87,8 +87,63 @@ type ChangeSource func() ([]byte, uint16, error)
 func NewUnsignedTransaction(outputs []*wire.TxOut, relayFeePerKb abcutil.Amount,
 	fetchInputs InputSource, fetchChange ChangeSource) (*AuthoredTx, error) {
 
+	return newUnsignedTransaction(outputs, relayFeePerKb, fetchInputs,
+		fetchChange, p2pkhSizes)
+}
+
+// NewUnsignedMultisigTransaction creates an unsigned transaction paying to one
+// or more non-change outputs like NewUnsignedTransaction, but every input must
+// redeem a P2SH output of an m-of-n multisig script, such as the outputs of a
+// multisig account.  The fee is estimated for the signature scripts of these
+// inputs, and fetchChange must return a P2SH script or smaller.
+func NewUnsignedMultisigTransaction(outputs []*wire.TxOut, relayFeePerKb abcutil.Amount,
+	m, n int, fetchInputs InputSource, fetchChange ChangeSource) (*AuthoredTx, error) {
+
+	sizes := scriptSizes{
+		sigScript:    txsizes.RedeemP2SHMultisigSigScriptSize(m, n),
+		changeScript: txsizes.P2SHPkScriptSize,
+	}
+	return newUnsignedTransaction(outputs, relayFeePerKb, fetchInputs,
+		fetchChange, sizes)
+}
+
+// scriptSizes describes the worst case signature script size of every input
+// and the largest change script size used to estimate the size of an authored
+// transaction.
+type scriptSizes struct {
+	sigScript    int
+	changeScript int
+}
+
+var p2pkhSizes = scriptSizes{
+	sigScript:    txsizes.RedeemP2PKHSigScriptSize,
+	changeScript: txsizes.P2PKHPkScriptSize,
+}
+
+// estimateSerializeSize returns the worst case serialize size of a signed
+// transaction spending inputCount inputs and paying to outputs.  The estimated
+// size is incremented for an additional change output if addChangeOutput is
+// true.
+func (s scriptSizes) estimateSerializeSize(inputCount int, outputs []*wire.TxOut,
+	addChangeOutput bool) int {
+
+	sigScriptSizes := make([]int, inputCount)
+	for i := range sigScriptSizes {
+		sigScriptSizes[i] = s.sigScript
+	}
+	changeScriptSize := 0
+	if addChangeOutput {
+		changeScriptSize = s.changeScript
+	}
+	return txsizes.EstimateSerializeSizeFromScriptSizes(sigScriptSizes, outputs,
+		changeScriptSize)
+}
+
+func newUnsignedTransaction(outputs []*wire.TxOut, relayFeePerKb abcutil.Amount,
+	fetchInputs InputSource, fetchChange ChangeSource, sizes scriptSizes) (*AuthoredTx, error) {
+
 	targetAmount := h.SumOutputValues(outputs)
-	estimatedSize := txsizes.EstimateSerializeSize(1, outputs, true)
+	estimatedSize := sizes.estimateSerializeSize(1, outputs, true)
 	targetFee := txrules.FeeForSerializeSize(relayFeePerKb, estimatedSize)
 
 	for {
@@ -100,7 +155,7 @@ func NewUnsignedTransaction(outputs []*wire.TxOut, relayFeePerKb abcutil.Amount,
 			return nil, InsufficientFundsError{}
 		}
 
-		maxSignedSize := txsizes.EstimateSerializeSize(len(inputs), outputs, true)
+		maxSignedSize := sizes.estimateSerializeSize(len(inputs), outputs, true)
 		maxRequiredFee := txrules.FeeForSerializeSize(relayFeePerKb, maxSignedSize)
 		remainingAmount := inputAmount - targetAmount
 		if remainingAmount < maxRequiredFee {
@@ -108,45 +163,132 @@ func NewUnsignedTransaction(outputs []*wire.TxOut, relayFeePerKb abcutil.Amount,
 			continue
 		}
 
-		unsignedTransaction := &wire.MsgTx{
-			Version:  wire.DefaultMsgTxVersion(),
-			TxIn:     inputs,
-			TxOut:    outputs,
-			LockTime: 0,
-			Expiry:   0,
-		}
-		changeIndex := -1
 		changeAmount := inputAmount - targetAmount - maxRequiredFee
-		if changeAmount != 0 && !txrules.IsDustAmount(changeAmount,
-			txsizes.P2PKHPkScriptSize, relayFeePerKb) {
-			changeScript, changeScriptVersion, err := fetchChange()
-			if err != nil {
-				return nil, err
-			}
-			if len(changeScript) > txsizes.P2PKHPkScriptSize {
-				return nil, errors.New("fee estimation requires change " +
-					"scripts no larger than P2PKH output scripts")
-			}
-			change := &wire.TxOut{
-				Value:    int64(changeAmount),
-				Version:  changeScriptVersion,
-				PkScript: changeScript,
-			}
-			l := len(outputs)
-			unsignedTransaction.TxOut = append(outputs[:l:l], change)
-			changeIndex = l
+		return newAuthoredTx(inputs, scripts, inputAmount, outputs,
+			changeAmount, relayFeePerKb, fetchChange, sizes)
+	}
+}
+
+// NewUnsignedTransactionSubtractFee creates an unsigned transaction paying to
+// one or more non-change outputs, like NewUnsignedTransaction.  Rather than
+// paying the fee in addition to the output amounts, the fee is subtracted from
+// the values of the outputs at the indexes in subtractFeeFrom.  The fee is
+// split evenly between these outputs and any remainder which can not be split
+// evenly is subtracted from the first of them.  The outputs slice and the
+// outputs it references are not modified.
+//
+// Transaction inputs are chosen from a single call to fetchInputs with the
+// total output amount as the target.  Any remaining input value is returned to
+// the wallet via a change output if it is not dust.  Otherwise, the remaining
+// value pays for part or all of the fee, and only the remaining fee is
+// subtracted from the outputs.
+//
+// If any output is dust after subtracting its share of the fee,
+// txrules.ErrOutputIsDust is returned.
+func NewUnsignedTransactionSubtractFee(outputs []*wire.TxOut, relayFeePerKb abcutil.Amount,
+	fetchInputs InputSource, fetchChange ChangeSource, subtractFeeFrom []int) (*AuthoredTx, error) {
+
+	if len(subtractFeeFrom) == 0 {
+		return nil, errors.New("no outputs to subtract the fee from")
+	}
+	seen := make(map[int]struct{}, len(subtractFeeFrom))
+	for _, i := range subtractFeeFrom {
+		if i < 0 || i >= len(outputs) {
+			return nil, errors.New("output index to subtract the fee from is out of range")
 		}
+		if _, ok := seen[i]; ok {
+			return nil, errors.New("duplicate output index to subtract the fee from")
+		}
+		seen[i] = struct{}{}
+	}
+
+	targetAmount := h.SumOutputValues(outputs)
+	inputAmount, inputs, scripts, err := fetchInputs(targetAmount)
+	if err != nil {
+		return nil, err
+	}
+	if inputAmount < targetAmount {
+		return nil, InsufficientFundsError{}
+	}
+
+	maxSignedSize := txsizes.EstimateSerializeSize(len(inputs), outputs, true)
+	maxRequiredFee := txrules.FeeForSerializeSize(relayFeePerKb, maxSignedSize)
+	changeAmount := inputAmount - targetAmount
+	outputsFee := maxRequiredFee
+	if txrules.IsDustAmount(changeAmount, txsizes.P2PKHPkScriptSize, relayFeePerKb) {
+		outputsFee -= changeAmount
+		if outputsFee < 0 {
+			outputsFee = 0
+		}
+		changeAmount = 0
+	}
+
+	reduced := make([]*wire.TxOut, len(outputs))
+	for i, output := range outputs {
+		o := *output
+		reduced[i] = &o
+	}
+	n := abcutil.Amount(len(subtractFeeFrom))
+	for j, i := range subtractFeeFrom {
+		share := outputsFee / n
+		if j == 0 {
+			share += outputsFee % n
+		}
+		reduced[i].Value -= int64(share)
+		if txrules.IsDustOutput(reduced[i], relayFeePerKb) {
+			return nil, txrules.ErrOutputIsDust
+		}
+	}
+
+	return newAuthoredTx(inputs, scripts, inputAmount, reduced, changeAmount,
+		relayFeePerKb, fetchChange, p2pkhSizes)
+}
+
+// newAuthoredTx creates an unsigned transaction spending the inputs and paying
+// to each output.  If the change amount is not zero or dust, a change output
+// with a script from fetchChange is appended to the outputs.  Otherwise, the
+// change amount is added to the fee.  The signed size is estimated using sizes.
+func newAuthoredTx(inputs []*wire.TxIn, scripts [][]byte, inputAmount abcutil.Amount,
+	outputs []*wire.TxOut, changeAmount, relayFeePerKb abcutil.Amount,
+	fetchChange ChangeSource, sizes scriptSizes) (*AuthoredTx, error) {
 
-		estSignedSize := txsizes.EstimateSerializeSize(len(unsignedTransaction.TxIn),
-			unsignedTransaction.TxOut, false)
-		return &AuthoredTx{
-			Tx:                           unsignedTransaction,
-			PrevScripts:                  scripts,
-			TotalInput:                   inputAmount,
-			ChangeIndex:                  changeIndex,
-			EstimatedSignedSerializeSize: estSignedSize,
-		}, nil
+	unsignedTransaction := &wire.MsgTx{
+		Version:  wire.DefaultMsgTxVersion(),
+		TxIn:     inputs,
+		TxOut:    outputs,
+		LockTime: 0,
+		Expiry:   0,
+	}
+	changeIndex := -1
+	if changeAmount != 0 && !txrules.IsDustAmount(changeAmount,
+		sizes.changeScript, relayFeePerKb) {
+		changeScript, changeScriptVersion, err := fetchChange()
+		if err != nil {
+			return nil, err
+		}
+		if len(changeScript) > sizes.changeScript {
+			return nil, errors.New("fee estimation requires change " +
+				"scripts no larger than the estimated change script")
+		}
+		change := &wire.TxOut{
+			Value:    int64(changeAmount),
+			Version:  changeScriptVersion,
+			PkScript: changeScript,
+		}
+		l := len(outputs)
+		unsignedTransaction.TxOut = append(outputs[:l:l], change)
+		changeIndex = l
 	}
+
+	estSignedSize := sizes.estimateSerializeSize(len(unsignedTransaction.TxIn),
+		unsignedTransaction.TxOut, false)
+	return &AuthoredTx{
+		Tx:                           unsignedTransaction,
+		PrevScripts:                  scripts,
+		TotalInput:                   inputAmount,
+		ChangeIndex:                  changeIndex,
+		EstimatedSignedSerializeSize: estSignedSize,
+	}, nil
 }
 
 // RandomizeOutputPosition randomizes the position of a transaction's output by
@@ -218,3 +218,125 @@ func TestNewUnsignedTransaction(t *testing.T) {
 		}
 	}
 }
+
+func TestNewUnsignedTransactionSubtractFee(t *testing.T) {
+	const relayFee abcutil.Amount = 1e3
+	fee := func(outputs []*wire.TxOut) abcutil.Amount {
+		return txrules.FeeForSerializeSize(relayFee,
+			txsizes.EstimateSerializeSize(1, outputs, true))
+	}
+	twoOutputsFee := fee(p2pkhOutputs(1e8, 0.5e8))
+
+	tests := []struct {
+		UnspentOutputs   []*wire.TxOut
+		Outputs          []*wire.TxOut
+		SubtractFeeFrom  []int
+		OutputAmounts    []abcutil.Amount
+		ChangeAmount     abcutil.Amount
+		Err              error
+		InputSourceError bool
+	}{
+		// The entire fee is paid by the single output.
+		0: {
+			UnspentOutputs:  p2pkhOutputs(1e8),
+			Outputs:         p2pkhOutputs(1e8),
+			SubtractFeeFrom: []int{0},
+			OutputAmounts:   []abcutil.Amount{1e8 - fee(p2pkhOutputs(1e8))},
+		},
+		// The fee is split between both outputs, with the remainder
+		// paid by the first, and the excess input value is returned as
+		// change.
+		1: {
+			UnspentOutputs:  p2pkhOutputs(2e8),
+			Outputs:         p2pkhOutputs(1e8, 0.5e8),
+			SubtractFeeFrom: []int{0, 1},
+			OutputAmounts: []abcutil.Amount{
+				1e8 - twoOutputsFee/2 - twoOutputsFee%2,
+				0.5e8 - twoOutputsFee/2,
+			},
+			ChangeAmount: 0.5e8,
+		},
+		// Only the second output pays the fee.
+		2: {
+			UnspentOutputs:  p2pkhOutputs(2e8),
+			Outputs:         p2pkhOutputs(1e8, 0.5e8),
+			SubtractFeeFrom: []int{1},
+			OutputAmounts:   []abcutil.Amount{1e8, 0.5e8 - twoOutputsFee},
+			ChangeAmount:    0.5e8,
+		},
+		// Remaining input value too small for a change output pays for
+		// part of the fee.
+		3: {
+			UnspentOutputs:  p2pkhOutputs(1e8),
+			Outputs:         p2pkhOutputs(1e8 - 100),
+			SubtractFeeFrom: []int{0},
+			OutputAmounts:   []abcutil.Amount{1e8 - fee(p2pkhOutputs(1e8))},
+		},
+		// The output paying the fee becomes dust.
+		4: {
+			UnspentOutputs:  p2pkhOutputs(1e8 + 700),
+			Outputs:         p2pkhOutputs(1e8, 700),
+			SubtractFeeFrom: []int{1},
+			Err:             txrules.ErrOutputIsDust,
+		},
+		5: {
+			UnspentOutputs:   p2pkhOutputs(1e8),
+			Outputs:          p2pkhOutputs(2e8),
+			SubtractFeeFrom:  []int{0},
+			InputSourceError: true,
+		},
+	}
+
+	changeSource := func() ([]byte, uint16, error) {
+		// Only length matters for these tests.
+		return make([]byte, txsizes.P2PKHPkScriptSize), 0, nil
+	}
+
+	for i, test := range tests {
+		originalAmounts := make([]int64, len(test.Outputs))
+		for j, output := range test.Outputs {
+			originalAmounts[j] = output.Value
+		}
+
+		inputSource := makeInputSource(test.UnspentOutputs)
+		tx, err := NewUnsignedTransactionSubtractFee(test.Outputs, relayFee,
+			inputSource, changeSource, test.SubtractFeeFrom)
+		for j, output := range test.Outputs {
+			if output.Value != originalAmounts[j] {
+				t.Errorf("Test %d: Output %d was modified", i, j)
+			}
+		}
+		switch e := err.(type) {
+		case nil:
+			if test.Err != nil || test.InputSourceError {
+				t.Errorf("Test %d: Expected error", i)
+				continue
+			}
+		case InputSourceError:
+			if !test.InputSourceError {
+				t.Errorf("Test %d: Unexpected InputSourceError", i)
+			}
+			continue
+		default:
+			if e != test.Err {
+				t.Errorf("Test %d: Unexpected error: %v", i, e)
+			}
+			continue
+		}
+
+		for j, amount := range test.OutputAmounts {
+			if abcutil.Amount(tx.Tx.TxOut[j].Value) != amount {
+				t.Errorf("Test %d: Output %d has amount %v, expected %v",
+					i, j, abcutil.Amount(tx.Tx.TxOut[j].Value), amount)
+			}
+		}
+		var changeAmount abcutil.Amount
+		if tx.ChangeIndex >= 0 {
+			changeAmount = abcutil.Amount(tx.Tx.TxOut[tx.ChangeIndex].Value)
+		}
+		if changeAmount != test.ChangeAmount {
+			t.Errorf("Test %d: Got change amount %v, expected %v", i,
+				changeAmount, test.ChangeAmount)
+		}
+	}
+}
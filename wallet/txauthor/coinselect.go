@@ -0,0 +1,385 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/abcsuite/abcd/wire"
+	"github.com/abcsuite/abcutil"
+	"github.com/abcsuite/abcwallet/wallet/txrules"
+
+	"github.com/abcsuite/abcwallet/wallet/internal/txsizes"
+)
+
+// CoinSelectionStrategy describes the policy used to choose which unspent
+// outputs are redeemed by the inputs of a new transaction.
+type CoinSelectionStrategy uint8
+
+const (
+	// CoinSelectionDefault selects unspent outputs in the order they are
+	// provided.  Wallets use this strategy to select their configured
+	// default strategy.
+	CoinSelectionDefault CoinSelectionStrategy = iota
+
+	// CoinSelectionBranchAndBound searches for a set of unspent outputs
+	// which exactly pays for the transaction outputs and fee, such that no
+	// change output is necessary.  Any remaining value too small to create
+	// a change output is added to the fee.  If no such set is found, the
+	// largest-first strategy is used instead.
+	CoinSelectionBranchAndBound
+
+	// CoinSelectionLargestFirst selects the unspent outputs with the
+	// highest values first, minimizing the number of inputs and the fee.
+	CoinSelectionLargestFirst
+
+	// CoinSelectionSmallestFirst selects the unspent outputs with the
+	// lowest values first.  This consolidates small outputs at the cost of
+	// a higher fee.
+	CoinSelectionSmallestFirst
+
+	// CoinSelectionOldestFirst selects the unspent outputs mined in the
+	// earliest blocks first.  Unmined outputs are selected last.
+	CoinSelectionOldestFirst
+
+	// CoinSelectionPrivacy avoids mixing the unspent outputs of different
+	// addresses.  All outputs paying to the same address are selected
+	// together, preferring the address with the smallest balance which
+	// pays for the transaction alone.  When no single address has enough
+	// value, addresses with the most value are added first to link as few
+	// addresses as possible.
+	CoinSelectionPrivacy
+)
+
+var coinSelectionStrategyStrings = [...]string{
+	CoinSelectionDefault:        "default",
+	CoinSelectionBranchAndBound: "branchandbound",
+	CoinSelectionLargestFirst:   "largestfirst",
+	CoinSelectionSmallestFirst:  "smallestfirst",
+	CoinSelectionOldestFirst:    "oldestfirst",
+	CoinSelectionPrivacy:        "privacy",
+}
+
+// String returns the name of the strategy as parsed by
+// ParseCoinSelectionStrategy.
+func (s CoinSelectionStrategy) String() string {
+	if int(s) < len(coinSelectionStrategyStrings) {
+		return coinSelectionStrategyStrings[s]
+	}
+	return fmt.Sprintf("unknown(%d)", uint8(s))
+}
+
+// ParseCoinSelectionStrategy returns the coin selection strategy with the name
+// s.
+func ParseCoinSelectionStrategy(s string) (CoinSelectionStrategy, error) {
+	for i, name := range coinSelectionStrategyStrings {
+		if s == name {
+			return CoinSelectionStrategy(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown coin selection strategy %q", s)
+}
+
+// Coin describes an unspent output which may be redeemed by a transaction
+// input.
+type Coin struct {
+	OutPoint wire.OutPoint
+	Amount   abcutil.Amount
+	PkScript []byte
+
+	// Height is the height of the block which mined the transaction
+	// creating the output, or -1 if the transaction is unmined.
+	Height int32
+}
+
+// MakeCoinSelectionSource creates an InputSource which selects from coins
+// using the coin selection strategy.  The relay fee is used to determine the
+// fee of each selected input by the branch-and-bound strategy, and must be the
+// same fee rate passed to NewUnsignedTransaction.  The coins slice is not
+// modified.
+func MakeCoinSelectionSource(strategy CoinSelectionStrategy, coins []Coin,
+	relayFeePerKb abcutil.Amount) InputSource {
+
+	sorted := make([]Coin, len(coins))
+	copy(sorted, coins)
+
+	var selectFunc func(target abcutil.Amount) []Coin
+	switch strategy {
+	case CoinSelectionBranchAndBound:
+		sort.Stable(byAmountDesc(sorted))
+		selectFunc = func(target abcutil.Amount) []Coin {
+			if sel := branchAndBound(sorted, target, relayFeePerKb); sel != nil {
+				return sel
+			}
+			return selectInOrder(sorted, target)
+		}
+	case CoinSelectionLargestFirst:
+		sort.Stable(byAmountDesc(sorted))
+	case CoinSelectionSmallestFirst:
+		sort.Stable(sort.Reverse(byAmountDesc(sorted)))
+	case CoinSelectionOldestFirst:
+		sort.Stable(byHeight(sorted))
+	case CoinSelectionPrivacy:
+		groups := groupByPkScript(sorted)
+		selectFunc = func(target abcutil.Amount) []Coin {
+			return selectGroups(groups, target)
+		}
+	}
+	if selectFunc == nil {
+		selectFunc = func(target abcutil.Amount) []Coin {
+			return selectInOrder(sorted, target)
+		}
+	}
+
+	return func(target abcutil.Amount) (abcutil.Amount, []*wire.TxIn, [][]byte, error) {
+		selected := selectFunc(target)
+		var total abcutil.Amount
+		inputs := make([]*wire.TxIn, 0, len(selected))
+		scripts := make([][]byte, 0, len(selected))
+		for i := range selected {
+			c := &selected[i]
+			op := c.OutPoint
+			total += c.Amount
+			inputs = append(inputs, wire.NewTxIn(&op, nil))
+			scripts = append(scripts, c.PkScript)
+		}
+		return total, inputs, scripts, nil
+	}
+}
+
+// MakeRequiredCoinsSource creates an InputSource which always selects every
+// required coin, regardless of the target amount.  If the required coins do
+// not pay for the target, the remaining amount is selected from the additional
+// input source.  The additional source may be nil to select no other inputs.
+func MakeRequiredCoinsSource(required []Coin, additional InputSource) InputSource {
+	var requiredTotal abcutil.Amount
+	for i := range required {
+		requiredTotal += required[i].Amount
+	}
+
+	return func(target abcutil.Amount) (abcutil.Amount, []*wire.TxIn, [][]byte, error) {
+		total := requiredTotal
+		inputs := make([]*wire.TxIn, 0, len(required))
+		scripts := make([][]byte, 0, len(required))
+		for i := range required {
+			op := required[i].OutPoint
+			inputs = append(inputs, wire.NewTxIn(&op, nil))
+			scripts = append(scripts, required[i].PkScript)
+		}
+		if total >= target || additional == nil {
+			return total, inputs, scripts, nil
+		}
+
+		addTotal, addInputs, addScripts, err := additional(target - total)
+		if err != nil {
+			return 0, nil, nil, err
+		}
+		total += addTotal
+		inputs = append(inputs, addInputs...)
+		scripts = append(scripts, addScripts...)
+		return total, inputs, scripts, nil
+	}
+}
+
+type byAmountDesc []Coin
+
+func (c byAmountDesc) Len() int           { return len(c) }
+func (c byAmountDesc) Less(i, j int) bool { return c[i].Amount > c[j].Amount }
+func (c byAmountDesc) Swap(i, j int)      { c[i], c[j] = c[j], c[i] }
+
+type byHeight []Coin
+
+func (c byHeight) Len() int { return len(c) }
+func (c byHeight) Less(i, j int) bool {
+	// Unmined outputs have height -1 and are sorted last.
+	return uint32(c[i].Height) < uint32(c[j].Height)
+}
+func (c byHeight) Swap(i, j int) { c[i], c[j] = c[j], c[i] }
+
+// selectInOrder selects coins in order until their total value reaches the
+// target.  All coins are selected if the target can not be reached.
+func selectInOrder(coins []Coin, target abcutil.Amount) []Coin {
+	var total abcutil.Amount
+	for i := range coins {
+		if total >= target {
+			return coins[:i]
+		}
+		total += coins[i].Amount
+	}
+	return coins
+}
+
+// inputFee returns the fee paid for a single P2PKH input at the relay fee,
+// rounded up so that the fee of several inputs is never underestimated.
+func inputFee(relayFeePerKb abcutil.Amount) abcutil.Amount {
+	fee := relayFeePerKb * txsizes.RedeemP2PKHInputSize
+	return (fee + 999) / 1000
+}
+
+// maxDustChange returns the largest change amount considered dust at the relay
+// fee.  Change at or below this amount is not returned to the wallet and is
+// instead added to the transaction fee.
+func maxDustChange(relayFeePerKb abcutil.Amount) abcutil.Amount {
+	// Begin with an estimate of the dust limit and correct it using the
+	// exact rule, which is not linear due to integer division.
+	amount := relayFeePerKb * 3 * (8 + 2 + 1 + txsizes.P2PKHPkScriptSize + 165) / 1000
+	for amount > 0 && !txrules.IsDustAmount(amount, txsizes.P2PKHPkScriptSize, relayFeePerKb) {
+		amount--
+	}
+	for txrules.IsDustAmount(amount+1, txsizes.P2PKHPkScriptSize, relayFeePerKb) {
+		amount++
+	}
+	return amount
+}
+
+// maxBranchAndBoundTries limits the number of selections considered by the
+// branch-and-bound search.
+const maxBranchAndBoundTries = 100000
+
+// branchAndBound searches for a selection of coins which pays for the target
+// amount without creating change.  The target must include the fee of a
+// transaction with a single input, and the fee for every additional input is
+// added to it.  Coins must be sorted by decreasing value.  Of all found
+// selections, the one with the least excess value is returned, or nil if no
+// selection was found.
+func branchAndBound(coins []Coin, target, relayFeePerKb abcutil.Amount) []Coin {
+	// The search is performed using effective values, which subtract the
+	// fee of redeeming each coin from its value.  The target is reduced by
+	// the input fee included with it.
+	inFee := inputFee(relayFeePerKb)
+	target -= inFee
+	maxExcess := maxDustChange(relayFeePerKb)
+	effValues := make([]abcutil.Amount, 0, len(coins))
+	for i := range coins {
+		eff := coins[i].Amount - inFee
+		if eff <= 0 {
+			break
+		}
+		effValues = append(effValues, eff)
+	}
+	if target <= 0 || len(effValues) == 0 {
+		return nil
+	}
+
+	// remaining[i] is the total effective value of all coins from index i.
+	remaining := make([]abcutil.Amount, len(effValues)+1)
+	for i := len(effValues) - 1; i >= 0; i-- {
+		remaining[i] = remaining[i+1] + effValues[i]
+	}
+	if remaining[0] < target {
+		return nil
+	}
+
+	var (
+		tries     int
+		included  = make([]bool, len(effValues))
+		best      []bool
+		bestWaste abcutil.Amount
+	)
+	var search func(i, n int, total abcutil.Amount) bool
+	search = func(i, n int, total abcutil.Amount) bool {
+		tries++
+		if tries > maxBranchAndBoundTries {
+			return true
+		}
+		if total+remaining[i] < target {
+			return false
+		}
+		if total >= target {
+			// Rounding of the total fee may increase the change by
+			// one atom for each input.
+			excess := total - target
+			if excess+abcutil.Amount(n) <= maxExcess &&
+				(best == nil || excess < bestWaste) {
+				best = append(best[:0], included...)
+				bestWaste = excess
+			}
+			return excess == 0
+		}
+		if i == len(effValues) {
+			return false
+		}
+		included[i] = true
+		if search(i+1, n+1, total+effValues[i]) {
+			return true
+		}
+		included[i] = false
+		return search(i+1, n, total)
+	}
+	search(0, 0, 0)
+
+	if best == nil {
+		return nil
+	}
+	var selected []Coin
+	for i, inc := range best {
+		if inc {
+			selected = append(selected, coins[i])
+		}
+	}
+	return selected
+}
+
+// coinGroup is the set of coins which pay to the same output script.
+type coinGroup struct {
+	coins []Coin
+	total abcutil.Amount
+}
+
+type byGroupTotalDesc []coinGroup
+
+func (g byGroupTotalDesc) Len() int           { return len(g) }
+func (g byGroupTotalDesc) Less(i, j int) bool { return g[i].total > g[j].total }
+func (g byGroupTotalDesc) Swap(i, j int)      { g[i], g[j] = g[j], g[i] }
+
+// groupByPkScript groups coins by their output script, sorted by decreasing
+// total value.
+func groupByPkScript(coins []Coin) []coinGroup {
+	var groups []coinGroup
+	index := make(map[string]int)
+	for i := range coins {
+		key := string(coins[i].PkScript)
+		j, ok := index[key]
+		if !ok {
+			j = len(groups)
+			index[key] = j
+			groups = append(groups, coinGroup{})
+		}
+		groups[j].coins = append(groups[j].coins, coins[i])
+		groups[j].total += coins[i].Amount
+	}
+	sort.Stable(byGroupTotalDesc(groups))
+	return groups
+}
+
+// selectGroups selects whole groups of coins.  The group with the smallest
+// total which alone reaches the target is preferred.  Otherwise, groups are
+// selected by decreasing total until the target is reached.
+func selectGroups(groups []coinGroup, target abcutil.Amount) []Coin {
+	// Groups are sorted by decreasing total, so the last group reaching
+	// the target is the smallest.
+	single := -1
+	for i := range groups {
+		if groups[i].total < target {
+			break
+		}
+		single = i
+	}
+	if single != -1 {
+		return groups[single].coins
+	}
+
+	var selected []Coin
+	var total abcutil.Amount
+	for i := range groups {
+		if total >= target {
+			break
+		}
+		selected = append(selected, groups[i].coins...)
+		total += groups[i].total
+	}
+	return selected
+}
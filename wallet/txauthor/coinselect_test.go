@@ -0,0 +1,321 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/abcsuite/abcd/wire"
+	"github.com/abcsuite/abcutil"
+	. "github.com/abcsuite/abcwallet/wallet/txauthor"
+	"github.com/abcsuite/abcwallet/wallet/txrules"
+
+	"github.com/abcsuite/abcwallet/wallet/internal/txsizes"
+)
+
+func TestCoinSelectionStrategyStrings(t *testing.T) {
+	strategies := []CoinSelectionStrategy{
+		CoinSelectionDefault,
+		CoinSelectionBranchAndBound,
+		CoinSelectionLargestFirst,
+		CoinSelectionSmallestFirst,
+		CoinSelectionOldestFirst,
+		CoinSelectionPrivacy,
+	}
+	for _, s := range strategies {
+		parsed, err := ParseCoinSelectionStrategy(s.String())
+		if err != nil {
+			t.Errorf("Cannot parse strategy %v: %v", s, err)
+			continue
+		}
+		if parsed != s {
+			t.Errorf("Parsed strategy %v from string %q", parsed, s.String())
+		}
+	}
+	_, err := ParseCoinSelectionStrategy("unknown")
+	if err == nil {
+		t.Errorf("Parsed unknown strategy")
+	}
+}
+
+func TestCoinSelection(t *testing.T) {
+	const relayFee abcutil.Amount = 1e4
+	feeForInputs := func(n int) abcutil.Amount {
+		return txrules.FeeForSerializeSize(relayFee,
+			txsizes.EstimateSerializeSize(n, p2pkhOutputs(0), true))
+	}
+	fee1, fee2, fee3 := feeForInputs(1), feeForInputs(2), feeForInputs(3)
+
+	// Coins are identified by their output index.  Coins 0 and 3 pay to
+	// one address and coins 1 and 2 pay to another.
+	scriptX := []byte{0}
+	scriptY := []byte{1}
+	coins := []Coin{
+		{OutPoint: wire.OutPoint{Index: 0}, Amount: 5e8, PkScript: scriptX, Height: 30},
+		{OutPoint: wire.OutPoint{Index: 1}, Amount: 3e8, PkScript: scriptY, Height: 10},
+		{OutPoint: wire.OutPoint{Index: 2}, Amount: 1e8, PkScript: scriptY, Height: 20},
+		{OutPoint: wire.OutPoint{Index: 3}, Amount: 0.5e8, PkScript: scriptX, Height: -1},
+	}
+
+	// Coins 1 and 2 pay for this output leaving only 100 atoms of dust,
+	// which is added to the fee.
+	exactAmount := 4e8 - fee2 - 100
+
+	tests := []struct {
+		Strategy         CoinSelectionStrategy
+		Output           abcutil.Amount
+		Inputs           []uint32
+		ChangeAmount     abcutil.Amount
+		Fee              abcutil.Amount
+		InputSourceError bool
+	}{
+		// Coins are selected in order.
+		0: {
+			Strategy:     CoinSelectionDefault,
+			Output:       exactAmount,
+			Inputs:       []uint32{0},
+			ChangeAmount: 5e8 - exactAmount - fee1,
+			Fee:          fee1,
+		},
+		// The fewest inputs are used for the lowest fee, but change is
+		// created.
+		1: {
+			Strategy:     CoinSelectionLargestFirst,
+			Output:       exactAmount,
+			Inputs:       []uint32{0},
+			ChangeAmount: 5e8 - exactAmount - fee1,
+			Fee:          fee1,
+		},
+		// An exact match is found and no change is created.
+		2: {
+			Strategy: CoinSelectionBranchAndBound,
+			Output:   exactAmount,
+			Inputs:   []uint32{1, 2},
+			Fee:      fee2 + 100,
+		},
+		// Small coins are consolidated at a higher fee.
+		3: {
+			Strategy:     CoinSelectionSmallestFirst,
+			Output:       exactAmount,
+			Inputs:       []uint32{3, 2, 1},
+			ChangeAmount: 4.5e8 - exactAmount - fee3,
+			Fee:          fee3,
+		},
+		// The oldest coins are spent first.
+		4: {
+			Strategy: CoinSelectionOldestFirst,
+			Output:   exactAmount,
+			Inputs:   []uint32{1, 2},
+			Fee:      fee2 + 100,
+		},
+		// All coins of the address with the smallest sufficient balance
+		// are spent together.
+		5: {
+			Strategy: CoinSelectionPrivacy,
+			Output:   exactAmount,
+			Inputs:   []uint32{1, 2},
+			Fee:      fee2 + 100,
+		},
+		// Without an exact match, branch-and-bound selects the largest
+		// coins first.
+		6: {
+			Strategy:     CoinSelectionBranchAndBound,
+			Output:       2e8,
+			Inputs:       []uint32{0},
+			ChangeAmount: 5e8 - 2e8 - fee1,
+			Fee:          fee1,
+		},
+		// The address with the smallest balance is not sufficient, so
+		// the coins of both addresses are spent.
+		7: {
+			Strategy:     CoinSelectionPrivacy,
+			Output:       6e8,
+			Inputs:       []uint32{0, 3, 1, 2},
+			ChangeAmount: 9.5e8 - 6e8 - feeForInputs(4),
+			Fee:          feeForInputs(4),
+		},
+		8: {
+			Strategy:         CoinSelectionBranchAndBound,
+			Output:           10e8,
+			InputSourceError: true,
+		},
+		9: {
+			Strategy:         CoinSelectionSmallestFirst,
+			Output:           10e8,
+			InputSourceError: true,
+		},
+		10: {
+			Strategy:         CoinSelectionPrivacy,
+			Output:           10e8,
+			InputSourceError: true,
+		},
+	}
+
+	changeSource := func() ([]byte, uint16, error) {
+		// Only length matters for these tests.
+		return make([]byte, txsizes.P2PKHPkScriptSize), 0, nil
+	}
+
+	for i, test := range tests {
+		inputSource := MakeCoinSelectionSource(test.Strategy, coins, relayFee)
+		tx, err := NewUnsignedTransaction(p2pkhOutputs(test.Output), relayFee,
+			inputSource, changeSource)
+		switch e := err.(type) {
+		case nil:
+			if test.InputSourceError {
+				t.Errorf("Test %d: Expected InputSourceError", i)
+				continue
+			}
+		case InputSourceError:
+			if !test.InputSourceError {
+				t.Errorf("Test %d: Unexpected InputSourceError", i)
+			}
+			continue
+		default:
+			t.Errorf("Test %d: Unexpected error: %v", i, e)
+			continue
+		}
+
+		inputs := make([]uint32, 0, len(tx.Tx.TxIn))
+		for _, in := range tx.Tx.TxIn {
+			inputs = append(inputs, in.PreviousOutPoint.Index)
+		}
+		if !reflect.DeepEqual(inputs, test.Inputs) {
+			t.Errorf("Test %d (%v): Selected inputs %v, expected %v", i,
+				test.Strategy, inputs, test.Inputs)
+			continue
+		}
+		for j, in := range tx.Tx.TxIn {
+			c := coins[in.PreviousOutPoint.Index]
+			if !reflect.DeepEqual(tx.PrevScripts[j], c.PkScript) {
+				t.Errorf("Test %d: Wrong previous script for input %d", i, j)
+			}
+		}
+
+		var changeAmount abcutil.Amount
+		if tx.ChangeIndex >= 0 {
+			changeAmount = abcutil.Amount(tx.Tx.TxOut[tx.ChangeIndex].Value)
+		}
+		if changeAmount != test.ChangeAmount {
+			t.Errorf("Test %d (%v): Got change amount %v, expected %v", i,
+				test.Strategy, changeAmount, test.ChangeAmount)
+		}
+		var outputTotal abcutil.Amount
+		for _, out := range tx.Tx.TxOut {
+			outputTotal += abcutil.Amount(out.Value)
+		}
+		if fee := tx.TotalInput - outputTotal; fee != test.Fee {
+			t.Errorf("Test %d (%v): Got fee %v, expected %v", i,
+				test.Strategy, fee, test.Fee)
+		}
+	}
+}
+
+func TestRequiredCoinsSource(t *testing.T) {
+	const relayFee abcutil.Amount = 1e4
+	feeForInputs := func(n int) abcutil.Amount {
+		return txrules.FeeForSerializeSize(relayFee,
+			txsizes.EstimateSerializeSize(n, p2pkhOutputs(0), true))
+	}
+
+	coins := []Coin{
+		{OutPoint: wire.OutPoint{Index: 0}, Amount: 5e8, PkScript: []byte{0}},
+		{OutPoint: wire.OutPoint{Index: 1}, Amount: 3e8, PkScript: []byte{1}},
+		{OutPoint: wire.OutPoint{Index: 2}, Amount: 1e8, PkScript: []byte{2}},
+		{OutPoint: wire.OutPoint{Index: 3}, Amount: 0.5e8, PkScript: []byte{3}},
+	}
+
+	tests := []struct {
+		Required         []uint32
+		Additional       []uint32
+		Output           abcutil.Amount
+		Inputs           []uint32
+		ChangeAmount     abcutil.Amount
+		InputSourceError bool
+	}{
+		// Required coins are spent even when they are not needed.
+		0: {
+			Required:     []uint32{3},
+			Additional:   []uint32{0, 1, 2},
+			Output:       2e8,
+			Inputs:       []uint32{3, 0},
+			ChangeAmount: 5.5e8 - 2e8 - feeForInputs(2),
+		},
+		// Only required coins are spent without an additional source.
+		1: {
+			Required:     []uint32{1, 2},
+			Output:       2e8,
+			Inputs:       []uint32{1, 2},
+			ChangeAmount: 4e8 - 2e8 - feeForInputs(2),
+		},
+		2: {
+			Required:         []uint32{3},
+			Output:           2e8,
+			InputSourceError: true,
+		},
+		3: {
+			Required:         []uint32{3},
+			Additional:       []uint32{2},
+			Output:           2e8,
+			InputSourceError: true,
+		},
+	}
+
+	changeSource := func() ([]byte, uint16, error) {
+		return make([]byte, txsizes.P2PKHPkScriptSize), 0, nil
+	}
+	pick := func(indexes []uint32) []Coin {
+		picked := make([]Coin, 0, len(indexes))
+		for _, i := range indexes {
+			picked = append(picked, coins[i])
+		}
+		return picked
+	}
+
+	for i, test := range tests {
+		var additional InputSource
+		if test.Additional != nil {
+			additional = MakeCoinSelectionSource(CoinSelectionLargestFirst,
+				pick(test.Additional), relayFee)
+		}
+		inputSource := MakeRequiredCoinsSource(pick(test.Required), additional)
+		tx, err := NewUnsignedTransaction(p2pkhOutputs(test.Output), relayFee,
+			inputSource, changeSource)
+		switch e := err.(type) {
+		case nil:
+			if test.InputSourceError {
+				t.Errorf("Test %d: Expected InputSourceError", i)
+				continue
+			}
+		case InputSourceError:
+			if !test.InputSourceError {
+				t.Errorf("Test %d: Unexpected InputSourceError", i)
+			}
+			continue
+		default:
+			t.Errorf("Test %d: Unexpected error: %v", i, e)
+			continue
+		}
+
+		inputs := make([]uint32, 0, len(tx.Tx.TxIn))
+		for _, in := range tx.Tx.TxIn {
+			inputs = append(inputs, in.PreviousOutPoint.Index)
+		}
+		if !reflect.DeepEqual(inputs, test.Inputs) {
+			t.Errorf("Test %d: Selected inputs %v, expected %v", i,
+				inputs, test.Inputs)
+			continue
+		}
+		var changeAmount abcutil.Amount
+		if tx.ChangeIndex >= 0 {
+			changeAmount = abcutil.Amount(tx.Tx.TxOut[tx.ChangeIndex].Value)
+		}
+		if changeAmount != test.ChangeAmount {
+			t.Errorf("Test %d: Got change amount %v, expected %v", i,
+				changeAmount, test.ChangeAmount)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor
+
+import (
+	"errors"
+	"math"
+
+	"github.com/abcsuite/abcd/wire"
+	"github.com/abcsuite/abcutil"
+	"github.com/abcsuite/abcwallet/wallet/txrules"
+
+	"github.com/abcsuite/abcwallet/wallet/internal/txsizes"
+)
+
+// SweepDestination describes an output receiving a share of the value swept by
+// a transaction.
+type SweepDestination struct {
+	PkScript []byte
+	Version  uint16
+
+	// Ratio is the share of the swept value paid to the destination,
+	// relative to the ratios of every other destination.  It must be
+	// positive.
+	Ratio float64
+}
+
+// NewUnsignedSweepTransaction creates an unsigned transaction spending every
+// coin and paying the total value, less the fee, to the destinations.  The
+// value is split between the destinations by their ratios, and any value which
+// can not be split evenly is paid to the last destination.  No change output is
+// created.  The fee is calculated from the estimated size of the signed
+// transaction, assuming every coin is redeemed by a P2PKH input.
+//
+// If no coins are provided or the total coin value does not pay for the fee,
+// an InputSourceError is returned.  If any destination would receive a dust
+// amount, txrules.ErrOutputIsDust is returned.
+func NewUnsignedSweepTransaction(coins []Coin, destinations []SweepDestination,
+	relayFeePerKb abcutil.Amount) (*AuthoredTx, error) {
+
+	if len(destinations) == 0 {
+		return nil, errors.New("no sweep destinations")
+	}
+	var ratioTotal float64
+	for i := range destinations {
+		r := destinations[i].Ratio
+		if !(r > 0) || math.IsInf(r, 1) {
+			return nil, errors.New("sweep destination ratios must be positive")
+		}
+		ratioTotal += r
+	}
+
+	outputs := make([]*wire.TxOut, 0, len(destinations))
+	for i := range destinations {
+		d := &destinations[i]
+		outputs = append(outputs, &wire.TxOut{
+			Version:  d.Version,
+			PkScript: d.PkScript,
+		})
+	}
+
+	inputs := make([]*wire.TxIn, 0, len(coins))
+	scripts := make([][]byte, 0, len(coins))
+	var inputAmount abcutil.Amount
+	for i := range coins {
+		c := &coins[i]
+		op := c.OutPoint
+		inputs = append(inputs, wire.NewTxIn(&op, nil))
+		scripts = append(scripts, c.PkScript)
+		inputAmount += c.Amount
+	}
+
+	size := txsizes.EstimateSerializeSize(len(inputs), outputs, false)
+	fee := txrules.FeeForSerializeSize(relayFeePerKb, size)
+	if len(inputs) == 0 || inputAmount <= fee {
+		return nil, InsufficientFundsError{}
+	}
+
+	sweptAmount := inputAmount - fee
+	remaining := sweptAmount
+	for i, output := range outputs {
+		amount := remaining
+		if i != len(outputs)-1 {
+			share := float64(sweptAmount) * destinations[i].Ratio / ratioTotal
+			amount = abcutil.Amount(share)
+		}
+		output.Value = int64(amount)
+		remaining -= amount
+		if txrules.IsDustOutput(output, relayFeePerKb) {
+			return nil, txrules.ErrOutputIsDust
+		}
+	}
+
+	return &AuthoredTx{
+		Tx: &wire.MsgTx{
+			Version:  wire.DefaultMsgTxVersion(),
+			TxIn:     inputs,
+			TxOut:    outputs,
+			LockTime: 0,
+			Expiry:   0,
+		},
+		PrevScripts:                  scripts,
+		TotalInput:                   inputAmount,
+		ChangeIndex:                  -1,
+		EstimatedSignedSerializeSize: size,
+	}, nil
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor_test
+
+import (
+	"testing"
+
+	"github.com/abcsuite/abcd/wire"
+	"github.com/abcsuite/abcutil"
+	. "github.com/abcsuite/abcwallet/wallet/txauthor"
+	"github.com/abcsuite/abcwallet/wallet/txrules"
+
+	"github.com/abcsuite/abcwallet/wallet/internal/txsizes"
+)
+
+func TestNewUnsignedSweepTransaction(t *testing.T) {
+	const relayFee abcutil.Amount = 1e4
+	fee := func(inputs, outputs int) abcutil.Amount {
+		amounts := make([]abcutil.Amount, outputs)
+		return txrules.FeeForSerializeSize(relayFee,
+			txsizes.EstimateSerializeSize(inputs, p2pkhOutputs(amounts...), false))
+	}
+	destination := func(ratio float64) SweepDestination {
+		return SweepDestination{
+			PkScript: make([]byte, txsizes.P2PKHOutputSize),
+			Ratio:    ratio,
+		}
+	}
+	coins := func(amounts ...abcutil.Amount) []Coin {
+		c := make([]Coin, 0, len(amounts))
+		for i, a := range amounts {
+			c = append(c, Coin{OutPoint: wire.OutPoint{Index: uint32(i)}, Amount: a})
+		}
+		return c
+	}
+
+	tests := []struct {
+		Coins            []Coin
+		Destinations     []SweepDestination
+		OutputAmounts    []abcutil.Amount
+		Err              error
+		InputSourceError bool
+	}{
+		0: {
+			Coins:         coins(1e8, 2e8),
+			Destinations:  []SweepDestination{destination(1)},
+			OutputAmounts: []abcutil.Amount{3e8 - fee(2, 1)},
+		},
+		// The last destination receives the remainder.
+		1: {
+			Coins:        coins(3e8 + 2),
+			Destinations: []SweepDestination{destination(1), destination(2)},
+			OutputAmounts: []abcutil.Amount{
+				(3e8 + 2 - fee(1, 2)) / 3,
+				3e8 + 2 - fee(1, 2) - (3e8+2-fee(1, 2))/3,
+			},
+		},
+		2: {
+			Coins:        coins(1e8),
+			Destinations: []SweepDestination{destination(0.25), destination(0.75)},
+			OutputAmounts: []abcutil.Amount{
+				(1e8 - fee(1, 2)) / 4,
+				1e8 - fee(1, 2) - (1e8-fee(1, 2))/4,
+			},
+		},
+		3: {
+			Coins:            nil,
+			Destinations:     []SweepDestination{destination(1)},
+			InputSourceError: true,
+		},
+		4: {
+			Coins:            coins(fee(1, 1)),
+			Destinations:     []SweepDestination{destination(1)},
+			InputSourceError: true,
+		},
+		// The second destination receives dust.
+		5: {
+			Coins:        coins(1e8),
+			Destinations: []SweepDestination{destination(1e8), destination(1)},
+			Err:          txrules.ErrOutputIsDust,
+		},
+	}
+
+	for i, test := range tests {
+		tx, err := NewUnsignedSweepTransaction(test.Coins, test.Destinations, relayFee)
+		switch e := err.(type) {
+		case nil:
+			if test.Err != nil || test.InputSourceError {
+				t.Errorf("Test %d: Expected error", i)
+				continue
+			}
+		case InputSourceError:
+			if !test.InputSourceError {
+				t.Errorf("Test %d: Unexpected InputSourceError", i)
+			}
+			continue
+		default:
+			if e != test.Err {
+				t.Errorf("Test %d: Unexpected error: %v", i, e)
+			}
+			continue
+		}
+
+		if len(tx.Tx.TxIn) != len(test.Coins) {
+			t.Errorf("Test %d: Transaction has %d inputs, expected %d", i,
+				len(tx.Tx.TxIn), len(test.Coins))
+		}
+		if tx.ChangeIndex != -1 {
+			t.Errorf("Test %d: Transaction has change", i)
+		}
+		if len(tx.Tx.TxOut) != len(test.OutputAmounts) {
+			t.Errorf("Test %d: Transaction has %d outputs, expected %d", i,
+				len(tx.Tx.TxOut), len(test.OutputAmounts))
+			continue
+		}
+		for j, amount := range test.OutputAmounts {
+			if abcutil.Amount(tx.Tx.TxOut[j].Value) != amount {
+				t.Errorf("Test %d: Output %d has amount %v, expected %v",
+					i, j, abcutil.Amount(tx.Tx.TxOut[j].Value), amount)
+			}
+		}
+	}
+}
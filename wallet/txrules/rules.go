@@ -57,6 +57,7 @@ var (
 	ErrAmountNegative   = errors.New("transaction output amount is negative")
 	ErrAmountExceedsMax = errors.New("transaction output amount exceeds maximum value")
 	ErrOutputIsDust     = errors.New("transaction output is dust")
+	ErrNullDataTooLarge = errors.New("null data output exceeds the standard size limit")
 )
 
 // CheckOutput performs simple consensus and policy tests on a transaction
@@ -74,6 +75,21 @@ func CheckOutput(output *wire.TxOut, relayFeePerKb abcutil.Amount) error {
 	return nil
 }
 
+// NullDataOutput returns a zero value transaction output with a null data
+// (OP_RETURN) script carrying data.  Null data outputs are unspendable and are
+// not checked for dust, but a transaction with more than one of them, or one
+// carrying more than txscript.MaxDataCarrierSize bytes, is not standard.
+func NullDataOutput(data []byte) (*wire.TxOut, error) {
+	if len(data) > txscript.MaxDataCarrierSize {
+		return nil, ErrNullDataTooLarge
+	}
+	script, err := txscript.GenerateProvablyPruneableOut(data)
+	if err != nil {
+		return nil, err
+	}
+	return wire.NewTxOut(0, script), nil
+}
+
 // FeeForSerializeSize calculates the required fee for a transaction of some
 // arbitrary size given a mempool's relay fee policy.
 func FeeForSerializeSize(relayFeePerKb abcutil.Amount, txSerializeSize int) abcutil.Amount {
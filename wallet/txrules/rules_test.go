@@ -0,0 +1,56 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txrules_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/abcsuite/abcd/txscript"
+	. "github.com/abcsuite/abcwallet/wallet/txrules"
+)
+
+func TestNullDataOutput(t *testing.T) {
+	tests := []struct {
+		DataSize int
+		Err      error
+	}{
+		0: {0, nil},
+		1: {32, nil},
+		2: {80, nil},
+		3: {txscript.MaxDataCarrierSize, nil},
+		4: {txscript.MaxDataCarrierSize + 1, ErrNullDataTooLarge},
+	}
+	for i, test := range tests {
+		data := bytes.Repeat([]byte{0x2a}, test.DataSize)
+		output, err := NullDataOutput(data)
+		if err != test.Err {
+			t.Errorf("Test %d: Got error %v: Want %v", i, err, test.Err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if output.Value != 0 {
+			t.Errorf("Test %d: Got value %v: Want 0", i, output.Value)
+		}
+		class := txscript.GetScriptClass(output.Version, output.PkScript)
+		if class != txscript.NullDataTy {
+			t.Errorf("Test %d: Got script class %v: Want %v", i, class,
+				txscript.NullDataTy)
+		}
+		pushes, err := txscript.PushedData(output.PkScript)
+		if err != nil {
+			t.Errorf("Test %d: Cannot parse script: %v", i, err)
+			continue
+		}
+		if !bytes.Equal(bytes.Join(pushes, nil), data) {
+			t.Errorf("Test %d: Script does not carry the data", i)
+		}
+		if IsDustOutput(output, DefaultRelayFeePerKb) {
+			t.Errorf("Test %d: Null data output is dust", i)
+		}
+	}
+}
@@ -285,3 +285,56 @@ func newScriptAddress(m *Manager, account uint32, scriptHash []byte) (*scriptAdd
 		address: address,
 	}, nil
 }
+
+// multisigAddress represents a pay-to-script-hash address of a multisig
+// account.  Unlike imported script addresses, it is part of an account branch
+// and its redeem script is derived from the extended keys of the account's
+// cosigners.
+type multisigAddress struct {
+	scriptAddress
+	internal bool
+}
+
+// Enforce multisigAddress satisfies the ManagedScriptAddress interface.
+var _ ManagedScriptAddress = (*multisigAddress)(nil)
+
+// Imported always returns false since multisig account addresses are part of
+// an address chain.
+//
+// This is part of the ManagedAddress interface implementation.
+func (a *multisigAddress) Imported() bool {
+	return false
+}
+
+// Internal returns true if the address was created for internal use such as a
+// change output of a transaction.
+//
+// This is part of the ManagedAddress interface implementation.
+func (a *multisigAddress) Internal() bool {
+	return a.internal
+}
+
+// Multisig always returns true since multisig account addresses pay to
+// multisig scripts.
+//
+// This is part of the ManagedAddress interface implementation.
+func (a *multisigAddress) Multisig() bool {
+	return true
+}
+
+// newMultisigAddress initializes and returns a new multisig account address.
+func newMultisigAddress(m *Manager, account uint32, script []byte, internal bool) (*multisigAddress, error) {
+	address, err := abcutil.NewAddressScriptHash(script, m.chainParams)
+	if err != nil {
+		return nil, err
+	}
+
+	return &multisigAddress{
+		scriptAddress: scriptAddress{
+			manager: m,
+			account: account,
+			address: address,
+		},
+		internal: internal,
+	}, nil
+}
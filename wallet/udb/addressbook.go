@@ -0,0 +1,260 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"unicode/utf8"
+
+	"github.com/abcsuite/abcwallet/apperrors"
+	"github.com/abcsuite/abcwallet/walletdb"
+)
+
+// Limits on the lengths in bytes of address book strings.
+const (
+	MaxAddressLabelLen = 500
+	MaxPayeeNameLen    = 100
+	MaxPayeeNoteLen    = 500
+)
+
+// AddressLabel describes a user-provided label attached to an address
+// controlled by the wallet.
+type AddressLabel struct {
+	Address string
+	Label   string
+}
+
+// Payee describes a named external address saved in the wallet's address
+// book.
+type Payee struct {
+	Name    string
+	Address string
+	Note    string
+}
+
+// Address labels are saved as k/v pairs in the address labels bucket.  The key
+// is the encoded payment address and the value is the UTF-8 label.
+//
+// Payees are saved as k/v pairs in the payees bucket.  The key is the payee
+// name and the value is serialized as such:
+//
+//   [0:1]   Encoded address length (1 byte)
+//   [1:N]   Encoded address (varies)
+//   [N:]    Note (varies)
+
+type addressLabelsTy struct {
+}
+
+var addressLabels addressLabelsTy
+
+var addressLabelsRootBucketKey = []byte("addresslabels")
+
+func (addressLabelsTy) rootBucketKey() []byte { return addressLabelsRootBucketKey }
+
+func (t addressLabelsTy) get(tx walletdb.ReadTx, addr string) string {
+	b := tx.ReadBucket(t.rootBucketKey())
+	return string(b.Get([]byte(addr)))
+}
+
+func (t addressLabelsTy) put(tx walletdb.ReadWriteTx, addr, label string) error {
+	b := tx.ReadWriteBucket(t.rootBucketKey())
+	return b.Put([]byte(addr), []byte(label))
+}
+
+func (t addressLabelsTy) delete(tx walletdb.ReadWriteTx, addr string) error {
+	b := tx.ReadWriteBucket(t.rootBucketKey())
+	return b.Delete([]byte(addr))
+}
+
+func (t addressLabelsTy) all(tx walletdb.ReadTx) ([]AddressLabel, error) {
+	var labels []AddressLabel
+	b := tx.ReadBucket(t.rootBucketKey())
+	err := b.ForEach(func(k, v []byte) error {
+		labels = append(labels, AddressLabel{Address: string(k), Label: string(v)})
+		return nil
+	})
+	return labels, err
+}
+
+type payeesTy struct {
+}
+
+var payees payeesTy
+
+var payeesRootBucketKey = []byte("payees")
+
+func (payeesTy) rootBucketKey() []byte { return payeesRootBucketKey }
+
+func (payeesTy) value(p *Payee) []byte {
+	v := make([]byte, 1+len(p.Address)+len(p.Note))
+	v[0] = byte(len(p.Address))
+	copy(v[1:], p.Address)
+	copy(v[1+len(p.Address):], p.Note)
+	return v
+}
+
+func (payeesTy) read(k, v []byte, p *Payee) error {
+	if len(v) < 1 || len(v) < 1+int(v[0]) {
+		const str = "short payee value"
+		return apperrors.E{ErrorCode: apperrors.ErrData, Description: str, Err: nil}
+	}
+	n := 1 + int(v[0])
+	p.Name = string(k)
+	p.Address = string(v[1:n])
+	p.Note = string(v[n:])
+	return nil
+}
+
+func (t payeesTy) get(tx walletdb.ReadTx, name string) []byte {
+	b := tx.ReadBucket(t.rootBucketKey())
+	return b.Get([]byte(name))
+}
+
+func (t payeesTy) put(tx walletdb.ReadWriteTx, p *Payee) error {
+	b := tx.ReadWriteBucket(t.rootBucketKey())
+	return b.Put([]byte(p.Name), t.value(p))
+}
+
+func (t payeesTy) delete(tx walletdb.ReadWriteTx, name string) error {
+	b := tx.ReadWriteBucket(t.rootBucketKey())
+	return b.Delete([]byte(name))
+}
+
+func (t payeesTy) all(tx walletdb.ReadTx) ([]Payee, error) {
+	var all []Payee
+	b := tx.ReadBucket(t.rootBucketKey())
+	err := b.ForEach(func(k, v []byte) error {
+		var p Payee
+		err := t.read(k, v, &p)
+		if err != nil {
+			return err
+		}
+		all = append(all, p)
+		return nil
+	})
+	return all, err
+}
+
+// checkAddressBookString returns an ErrInput error if s is not valid UTF-8 or
+// is longer than maxLen bytes.
+func checkAddressBookString(s, field string, maxLen int) error {
+	if len(s) > maxLen {
+		str := field + " exceeds maximum length"
+		return apperrors.E{ErrorCode: apperrors.ErrInput, Description: str, Err: nil}
+	}
+	if !utf8.ValidString(s) {
+		str := field + " is not valid UTF-8"
+		return apperrors.E{ErrorCode: apperrors.ErrInput, Description: str, Err: nil}
+	}
+	return nil
+}
+
+// PutAddressLabel saves the label of an encoded address, replacing any previous
+// label.  Setting an empty label removes the label from the address.  Labels
+// must be valid UTF-8 and may not exceed MaxAddressLabelLen bytes.
+func PutAddressLabel(tx walletdb.ReadWriteTx, addr, label string) error {
+	err := checkAddressBookString(label, "address label", MaxAddressLabelLen)
+	if err != nil {
+		return err
+	}
+
+	if label == "" {
+		err = addressLabels.delete(tx, addr)
+	} else {
+		err = addressLabels.put(tx, addr, label)
+	}
+	if err != nil {
+		const str = "failed to put address label"
+		return apperrors.E{ErrorCode: apperrors.ErrDatabase, Description: str, Err: err}
+	}
+	return nil
+}
+
+// FetchAddressLabel returns the label of an encoded address, or the empty
+// string if the address is not labeled.
+func FetchAddressLabel(tx walletdb.ReadTx, addr string) string {
+	return addressLabels.get(tx, addr)
+}
+
+// AddressLabels returns all saved address labels.
+func AddressLabels(tx walletdb.ReadTx) ([]AddressLabel, error) {
+	labels, err := addressLabels.all(tx)
+	if err != nil {
+		const str = "failed to read address labels"
+		return nil, apperrors.E{ErrorCode: apperrors.ErrDatabase, Description: str, Err: err}
+	}
+	return labels, nil
+}
+
+// PutPayee saves a payee to the address book, replacing any previous payee
+// with the same name.  Payee names may not be empty.
+func PutPayee(tx walletdb.ReadWriteTx, p *Payee) error {
+	if p.Name == "" {
+		const str = "payee name may not be empty"
+		return apperrors.E{ErrorCode: apperrors.ErrInput, Description: str, Err: nil}
+	}
+	if p.Address == "" || len(p.Address) > 255 {
+		const str = "invalid payee address"
+		return apperrors.E{ErrorCode: apperrors.ErrInput, Description: str, Err: nil}
+	}
+	err := checkAddressBookString(p.Name, "payee name", MaxPayeeNameLen)
+	if err != nil {
+		return err
+	}
+	err = checkAddressBookString(p.Note, "payee note", MaxPayeeNoteLen)
+	if err != nil {
+		return err
+	}
+
+	err = payees.put(tx, p)
+	if err != nil {
+		const str = "failed to put payee"
+		return apperrors.E{ErrorCode: apperrors.ErrDatabase, Description: str, Err: err}
+	}
+	return nil
+}
+
+// DeletePayee removes a payee from the address book.  ErrValueNoExists is
+// returned if no payee is saved with the name.
+func DeletePayee(tx walletdb.ReadWriteTx, name string) error {
+	if payees.get(tx, name) == nil {
+		const str = "payee not found"
+		return apperrors.E{ErrorCode: apperrors.ErrValueNoExists, Description: str, Err: nil}
+	}
+	err := payees.delete(tx, name)
+	if err != nil {
+		const str = "failed to delete payee"
+		return apperrors.E{ErrorCode: apperrors.ErrDatabase, Description: str, Err: err}
+	}
+	return nil
+}
+
+// FetchPayee returns the payee saved with a name.  ErrValueNoExists is returned
+// if no payee is saved with the name.
+func FetchPayee(tx walletdb.ReadTx, name string) (*Payee, error) {
+	v := payees.get(tx, name)
+	if v == nil {
+		const str = "payee not found"
+		return nil, apperrors.E{ErrorCode: apperrors.ErrValueNoExists, Description: str, Err: nil}
+	}
+	p := new(Payee)
+	err := payees.read([]byte(name), v, p)
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Payees returns all payees saved in the address book.
+func Payees(tx walletdb.ReadTx) ([]Payee, error) {
+	all, err := payees.all(tx)
+	if err != nil {
+		if _, ok := err.(apperrors.E); ok {
+			return nil, err
+		}
+		const str = "failed to read payees"
+		return nil, apperrors.E{ErrorCode: apperrors.ErrDatabase, Description: str, Err: err}
+	}
+	return all, nil
+}
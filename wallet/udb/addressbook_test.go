@@ -0,0 +1,113 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"testing"
+
+	"github.com/abcsuite/abcwallet/apperrors"
+	"github.com/abcsuite/abcwallet/walletdb"
+)
+
+func TestAddressBook(t *testing.T) {
+	db, _, teardown, err := setup()
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const ownAddr = "TsR28UZRprhgQQhzWns2M6cAwchrNVvbYq2"
+	payee := Payee{
+		Name:    "landlord",
+		Address: "TsWjioPrP8E1TuTMmTrVMM2BA4iPrjQXBpR",
+		Note:    "monthly rent",
+	}
+
+	err = walletdb.Update(db, func(dbtx walletdb.ReadWriteTx) error {
+		_, err := dbtx.CreateTopLevelBucket(addressLabels.rootBucketKey())
+		if err != nil {
+			return err
+		}
+		_, err = dbtx.CreateTopLevelBucket(payees.rootBucketKey())
+		if err != nil {
+			return err
+		}
+		err = PutAddressLabel(dbtx, ownAddr, "donations")
+		if err != nil {
+			return err
+		}
+		err = PutPayee(dbtx, &payee)
+		if err != nil {
+			return err
+		}
+
+		err = PutPayee(dbtx, &Payee{Address: payee.Address})
+		if !apperrors.IsError(err, apperrors.ErrInput) {
+			t.Errorf("unnamed payee: expected ErrInput, got %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = walletdb.View(db, func(dbtx walletdb.ReadTx) error {
+		if l := FetchAddressLabel(dbtx, ownAddr); l != "donations" {
+			t.Errorf("got address label %q, want %q", l, "donations")
+		}
+		labels, err := AddressLabels(dbtx)
+		if err != nil {
+			return err
+		}
+		if len(labels) != 1 || labels[0].Address != ownAddr {
+			t.Errorf("unexpected address labels %+v", labels)
+		}
+
+		p, err := FetchPayee(dbtx, payee.Name)
+		if err != nil {
+			return err
+		}
+		if *p != payee {
+			t.Errorf("got payee %+v, want %+v", *p, payee)
+		}
+		_, err = FetchPayee(dbtx, "unknown")
+		if !apperrors.IsError(err, apperrors.ErrValueNoExists) {
+			t.Errorf("unknown payee: expected ErrValueNoExists, got %v", err)
+		}
+		all, err := Payees(dbtx)
+		if err != nil {
+			return err
+		}
+		if len(all) != 1 || all[0] != payee {
+			t.Errorf("unexpected payees %+v", all)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = walletdb.Update(db, func(dbtx walletdb.ReadWriteTx) error {
+		err := PutAddressLabel(dbtx, ownAddr, "")
+		if err != nil {
+			return err
+		}
+		if l := FetchAddressLabel(dbtx, ownAddr); l != "" {
+			t.Errorf("cleared address label still exists: %q", l)
+		}
+		err = DeletePayee(dbtx, payee.Name)
+		if err != nil {
+			return err
+		}
+		err = DeletePayee(dbtx, payee.Name)
+		if !apperrors.IsError(err, apperrors.ErrValueNoExists) {
+			t.Errorf("deleted payee: expected ErrValueNoExists, got %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
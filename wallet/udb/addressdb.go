@@ -126,6 +126,14 @@ type dbScriptAddressRow struct {
 	encryptedScript []byte
 }
 
+// dbMultisigAccountRow houses the signature threshold and the encrypted
+// account extended public keys of the other cosigners of a multisig account.
+// The wallet's own account keys are saved in the account's BIP0044 row.
+type dbMultisigAccountRow struct {
+	requiredSigs          uint8
+	cosignerKeysEncrypted [][]byte
+}
+
 // Key names for various database fields.
 var (
 	// nullVall is null byte used as a flag value in a bucket entry
@@ -161,6 +169,14 @@ var (
 	// and id changes e.g. RenameAccount
 	acctIDIdxBucketName = []byte("acctididx")
 
+	// multisigAcctBucketName is used to store the multisig parameters of
+	// accounts created from the extended public keys of several cosigners.
+	// Entries map the account id to the serialized multisig account row.
+	// Accounts without an entry are single key BIP0044 accounts.
+	//
+	// This was added by database version 11.
+	multisigAcctBucketName = []byte("multisigacct")
+
 	// meta is used to store meta-data about the address manager
 	// e.g. last account number
 	metaBucketName = []byte("meta")
@@ -841,6 +857,93 @@ func putLastAccount(ns walletdb.ReadWriteBucket, account uint32) error {
 	return nil
 }
 
+// deserializeMultisigAccountRow deserializes the passed serialized multisig
+// account row.
+func deserializeMultisigAccountRow(accountID []byte, serialized []byte) (*dbMultisigAccountRow, error) {
+	// The serialized multisig account format is:
+	//   <reqsigs><nkeys>[<enckeylen><enckey>...]
+	//
+	// 1 byte required signatures + 1 byte number of cosigner keys + for each
+	// cosigner key, 4 bytes encrypted key length + encrypted key
+	malformed := func() error {
+		str := fmt.Sprintf("malformed serialized multisig account for "+
+			"key %x", accountID)
+		return managerError(apperrors.ErrDatabase, str, nil)
+	}
+	if len(serialized) < 2 {
+		return nil, malformed()
+	}
+	row := &dbMultisigAccountRow{
+		requiredSigs:          serialized[0],
+		cosignerKeysEncrypted: make([][]byte, serialized[1]),
+	}
+	offset := uint32(2)
+	for i := range row.cosignerKeysEncrypted {
+		if uint32(len(serialized)) < offset+4 {
+			return nil, malformed()
+		}
+		keyLen := binary.LittleEndian.Uint32(serialized[offset : offset+4])
+		offset += 4
+		if uint32(len(serialized)) < offset+keyLen {
+			return nil, malformed()
+		}
+		key := make([]byte, keyLen)
+		copy(key, serialized[offset:offset+keyLen])
+		row.cosignerKeysEncrypted[i] = key
+		offset += keyLen
+	}
+	return row, nil
+}
+
+// serializeMultisigAccountRow returns the serialization of the passed multisig
+// account row.
+func serializeMultisigAccountRow(row *dbMultisigAccountRow) []byte {
+	// The serialized multisig account format is:
+	//   <reqsigs><nkeys>[<enckeylen><enckey>...]
+	//
+	// 1 byte required signatures + 1 byte number of cosigner keys + for each
+	// cosigner key, 4 bytes encrypted key length + encrypted key
+	size := 2
+	for _, key := range row.cosignerKeysEncrypted {
+		size += 4 + len(key)
+	}
+	buf := make([]byte, 2, size)
+	buf[0] = row.requiredSigs
+	buf[1] = uint8(len(row.cosignerKeysEncrypted))
+	for _, key := range row.cosignerKeysEncrypted {
+		buf = append(buf, uint32ToBytes(uint32(len(key)))...)
+		buf = append(buf, key...)
+	}
+	return buf
+}
+
+// fetchMultisigAccount loads the multisig parameters of the passed account from
+// the database.  A nil row is returned without error for accounts which are not
+// multisig accounts.
+func fetchMultisigAccount(ns walletdb.ReadBucket, account uint32) (*dbMultisigAccountRow, error) {
+	bucket := ns.NestedReadBucket(multisigAcctBucketName)
+
+	accountID := uint32ToBytes(account)
+	serializedRow := bucket.Get(accountID)
+	if serializedRow == nil {
+		return nil, nil
+	}
+	return deserializeMultisigAccountRow(accountID, serializedRow)
+}
+
+// putMultisigAccount stores the multisig parameters of the passed account to
+// the database.
+func putMultisigAccount(ns walletdb.ReadWriteBucket, account uint32, row *dbMultisigAccountRow) error {
+	bucket := ns.NestedReadWriteBucket(multisigAcctBucketName)
+
+	err := bucket.Put(uint32ToBytes(account), serializeMultisigAccountRow(row))
+	if err != nil {
+		str := fmt.Sprintf("failed to store multisig account %d", account)
+		return managerError(apperrors.ErrDatabase, str, err)
+	}
+	return nil
+}
+
 // deserializeAddressRow deserializes the passed serialized address information.
 // This is used as a common base for the various address types to deserialize
 // the common parts.
@@ -165,6 +165,12 @@ type accountInfo struct {
 	acctKeyEncrypted []byte
 	acctKeyPriv      *hdkeychain.ExtendedKey
 	acctKeyPub       *hdkeychain.ExtendedKey
+
+	// Multisig accounts additionally record the number of signatures
+	// required to redeem outputs and the account extended public keys of
+	// the other cosigners.  cosignerKeys is nil for BIP0044 accounts.
+	requiredSigs int
+	cosignerKeys []*hdkeychain.ExtendedKey
 }
 
 // AccountProperties contains properties associated with each account, such as
@@ -516,6 +522,30 @@ func (m *Manager) loadAccountInfo(ns walletdb.ReadBucket, account uint32) (*acco
 		acctKeyPub:       acctKeyPub,
 	}
 
+	// Load the cosigner keys of multisig accounts.
+	msRow, err := fetchMultisigAccount(ns, account)
+	if err != nil {
+		return nil, err
+	}
+	if msRow != nil {
+		acctInfo.requiredSigs = int(msRow.requiredSigs)
+		acctInfo.cosignerKeys = make([]*hdkeychain.ExtendedKey, len(msRow.cosignerKeysEncrypted))
+		for i, keyEnc := range msRow.cosignerKeysEncrypted {
+			serializedKey, err := m.cryptoKeyPub.Decrypt(keyEnc)
+			if err != nil {
+				str := fmt.Sprintf("failed to decrypt cosigner key for "+
+					"account %d", account)
+				return nil, managerError(apperrors.ErrCrypto, str, err)
+			}
+			acctInfo.cosignerKeys[i], err = hdkeychain.NewKeyFromString(string(serializedKey))
+			if err != nil {
+				str := fmt.Sprintf("failed to create cosigner extended "+
+					"public key for account %d", account)
+				return nil, managerError(apperrors.ErrKeyChain, str, err)
+			}
+		}
+	}
+
 	if !m.locked {
 		// Use the crypto private key to decrypt the account private
 		// extended keys.
@@ -682,6 +712,24 @@ func (m *Manager) deriveKeyFromPath(ns walletdb.ReadBucket, account, branch, ind
 // This function MUST be called with the manager lock held for writes.
 func (m *Manager) chainAddressRowToManaged(ns walletdb.ReadBucket,
 	row *dbChainAddressRow) (ManagedAddress, error) {
+	// Addresses of multisig accounts pay to scripts derived from the keys
+	// of every cosigner.
+	acctInfo, err := m.loadAccountInfo(ns, row.account)
+	if err != nil {
+		return nil, err
+	}
+	if acctInfo.cosignerKeys != nil {
+		script, err := acctInfo.multisigScript(row.branch, row.index,
+			m.chainParams)
+		if err != nil {
+			str := fmt.Sprintf("failed to derive multisig script -- "+
+				"branch %d, child %d", row.branch, row.index)
+			return nil, managerError(apperrors.ErrKeyChain, str, err)
+		}
+		return newMultisigAddress(m, row.account, script,
+			row.branch == InternalBranch)
+	}
+
 	addressKey, err := m.deriveKeyFromPath(ns, row.account, row.branch,
 		row.index, !m.locked)
 	if err != nil {
@@ -797,6 +845,25 @@ func (m *Manager) AddrAccount(ns walletdb.ReadBucket, address abcutil.Address) (
 	return account, nil
 }
 
+// AddressDerivation returns the account, branch, and child index used to
+// derive an address from its account's extended key.  An error with the
+// ErrInput code is returned for imported addresses and scripts, which are not
+// derived from an account key.
+func (m *Manager) AddressDerivation(ns walletdb.ReadBucket, address abcutil.Address) (account, branch, child uint32, err error) {
+	address = normalizeAddress(address)
+	row, err := fetchAddress(ns, address.ScriptAddress())
+	if err != nil {
+		return 0, 0, 0, maybeConvertDbError(err)
+	}
+	chainRow, ok := row.(*dbChainAddressRow)
+	if !ok {
+		str := fmt.Sprintf("address %v is not derived from an account key",
+			address)
+		return 0, 0, 0, managerError(apperrors.ErrInput, str, nil)
+	}
+	return chainRow.account, chainRow.branch, chainRow.index, nil
+}
+
 // ChangePassphrase changes either the public or private passphrase to the
 // provided value depending on the private flag.  In order to change the private
 // password, the address manager must not be watching-only.  The new passphrase
@@ -1524,18 +1591,37 @@ func (m *Manager) syncAccountToAddrIndex(ns walletdb.ReadWriteBucket, account ui
 	// been recorded.  As soon as any already-saved address is found, the loop
 	// can end, because we know that all addresses before that child have also
 	// been created.
+	//
+	// Multisig accounts save the P2SH addresses of the child multisig scripts
+	// rather than the P2PKH addresses of the account's own keys.
 	for child := syncToIndex; ; child-- {
-		xpubChild, err := xpubBranch.Child(child)
-		if err == hdkeychain.ErrInvalidChild {
-			continue
-		}
-		if err != nil {
-			const str = "failed to derive child xpub"
-			return apperrors.E{ErrorCode: apperrors.ErrKeyChain, Description: str, Err: err}
+		var addr abcutil.Address
+		if acctInfo.cosignerKeys != nil {
+			script, err := acctInfo.multisigScript(branch, child, m.chainParams)
+			if err == hdkeychain.ErrInvalidChild {
+				continue
+			}
+			if err != nil {
+				const str = "failed to derive child multisig script"
+				return apperrors.E{ErrorCode: apperrors.ErrKeyChain, Description: str, Err: err}
+			}
+			addr, err = abcutil.NewAddressScriptHash(script, m.chainParams)
+			if err != nil {
+				return err
+			}
+		} else {
+			xpubChild, err := xpubBranch.Child(child)
+			if err == hdkeychain.ErrInvalidChild {
+				continue
+			}
+			if err != nil {
+				const str = "failed to derive child xpub"
+				return apperrors.E{ErrorCode: apperrors.ErrKeyChain, Description: str, Err: err}
+			}
+			// This can't error as only good input is passed to
+			// abcutil.NewAddressPubKeyHash.
+			addr, _ = xpubChild.Address(m.chainParams)
 		}
-		// This can't error as only good input is passed to
-		// abcutil.NewAddressPubKeyHash.
-		addr, _ := xpubChild.Address(m.chainParams)
 		_, err = fetchAddress(ns, addr.Hash160()[:])
 		if err == nil {
 			// address was found and there are no more to generate
@@ -1601,44 +1687,77 @@ func (m *Manager) NewAccount(ns walletdb.ReadWriteBucket, name string) (uint32,
 		return 0, managerError(apperrors.ErrLocked, errLocked, nil)
 	}
 
+	if err := checkNewAccountName(ns, name); err != nil {
+		return 0, err
+	}
+
+	account, acctKeyPriv, err := m.deriveNextAccountKey(ns)
+	if err != nil {
+		return 0, err
+	}
+	defer acctKeyPriv.Zero()
+	acctKeyPub, err := acctKeyPriv.Neuter()
+	if err != nil {
+		str := "failed to convert public key for account"
+		return 0, managerError(apperrors.ErrKeyChain, str, err)
+	}
+	err = m.putNewAccount(ns, account, name, acctKeyPub, acctKeyPriv)
+	if err != nil {
+		return 0, err
+	}
+
+	return account, nil
+}
+
+// checkNewAccountName validates the name of a new account and ensures no
+// account with the same name already exists.
+func checkNewAccountName(ns walletdb.ReadBucket, name string) error {
 	// Validate account name
 	if err := ValidateAccountName(name); err != nil {
-		return 0, err
+		return err
 	}
 
 	// Check that account with the same name does not exist
 	_, err := fetchAccountByName(ns, name)
 	if err == nil {
 		str := fmt.Sprintf("account with the same name already exists")
-		return 0, managerError(apperrors.ErrDuplicateAccount, str, err)
+		return managerError(apperrors.ErrDuplicateAccount, str, err)
 	}
+	return nil
+}
 
-	// Fetch latest account, and create a new account in the same transaction
+// deriveNextAccountKey returns the next account number and its extended
+// private key derived from the cointype key.  The caller must zero the key when
+// finished.
+//
+// This function MUST be called with the manager lock held for writes and with
+// the manager unlocked.
+func (m *Manager) deriveNextAccountKey(ns walletdb.ReadBucket) (uint32, *hdkeychain.ExtendedKey, error) {
 	// Fetch the latest account number to generate the next account number
 	account, err := fetchLastAccount(ns)
 	if err != nil {
-		return 0, err
+		return 0, nil, err
 	}
 	account++
 	// Fetch the cointype key which will be used to derive the next account
 	// extended keys
 	_, coinTypePrivEnc, err := fetchCoinTypeKeys(ns)
 	if err != nil {
-		return 0, err
+		return 0, nil, err
 	}
 
 	// Decrypt the cointype key
 	serializedKeyPriv, err := m.cryptoKeyPriv.Decrypt(coinTypePrivEnc)
 	if err != nil {
 		str := fmt.Sprintf("failed to decrypt cointype serialized private key")
-		return 0, managerError(apperrors.ErrLocked, str, err)
+		return 0, nil, managerError(apperrors.ErrLocked, str, err)
 	}
 	coinTypeKeyPriv, err :=
 		hdkeychain.NewKeyFromString(string(serializedKeyPriv))
 	zero.Bytes(serializedKeyPriv)
 	if err != nil {
 		str := fmt.Sprintf("failed to create cointype extended private key")
-		return 0, managerError(apperrors.ErrKeyChain, str, err)
+		return 0, nil, managerError(apperrors.ErrKeyChain, str, err)
 	}
 
 	// Derive the account key using the cointype key
@@ -1646,33 +1765,42 @@ func (m *Manager) NewAccount(ns walletdb.ReadWriteBucket, name string) (uint32,
 	coinTypeKeyPriv.Zero()
 	if err != nil {
 		str := "failed to convert private key for account"
-		return 0, managerError(apperrors.ErrKeyChain, str, err)
+		return 0, nil, managerError(apperrors.ErrKeyChain, str, err)
 	}
-	acctKeyPub, err := acctKeyPriv.Neuter()
-	if err != nil {
-		str := "failed to convert public key for account"
-		return 0, managerError(apperrors.ErrKeyChain, str, err)
-	}
-	// Encrypt the default account keys with the associated crypto keys.
+	return account, acctKeyPriv, nil
+}
+
+// putNewAccount encrypts the extended keys of a new account and saves the
+// account and the last account number to the database.  The private key may be
+// nil for accounts created by watching-only managers.
+//
+// This function MUST be called with the manager lock held for writes.
+func (m *Manager) putNewAccount(ns walletdb.ReadWriteBucket, account uint32,
+	name string, acctKeyPub, acctKeyPriv *hdkeychain.ExtendedKey) error {
+
+	// Encrypt the account keys with the associated crypto keys.
 	apes, err := acctKeyPub.String()
 	if err != nil {
 		str := "failed to get public key string for account"
-		return 0, managerError(apperrors.ErrCrypto, str, err)
+		return managerError(apperrors.ErrCrypto, str, err)
 	}
 	acctPubEnc, err := m.cryptoKeyPub.Encrypt([]byte(apes))
 	if err != nil {
 		str := "failed to  encrypt public key for account"
-		return 0, managerError(apperrors.ErrCrypto, str, err)
-	}
-	apes, err = acctKeyPriv.String()
-	if err != nil {
-		str := "failed to get private key string for account"
-		return 0, managerError(apperrors.ErrCrypto, str, err)
+		return managerError(apperrors.ErrCrypto, str, err)
 	}
-	acctPrivEnc, err := m.cryptoKeyPriv.Encrypt([]byte(apes))
-	if err != nil {
-		str := "failed to encrypt private key for account"
-		return 0, managerError(apperrors.ErrCrypto, str, err)
+	var acctPrivEnc []byte
+	if acctKeyPriv != nil {
+		apes, err = acctKeyPriv.String()
+		if err != nil {
+			str := "failed to get private key string for account"
+			return managerError(apperrors.ErrCrypto, str, err)
+		}
+		acctPrivEnc, err = m.cryptoKeyPriv.Encrypt([]byte(apes))
+		if err != nil {
+			str := "failed to encrypt private key for account"
+			return managerError(apperrors.ErrCrypto, str, err)
+		}
 	}
 	// We have the encrypted account extended keys, so save them to the
 	// database
@@ -1680,15 +1808,11 @@ func (m *Manager) NewAccount(ns walletdb.ReadWriteBucket, name string) (uint32,
 		^uint32(0), ^uint32(0), 0, 0, name, DBVersion)
 	err = putAccountInfo(ns, account, row)
 	if err != nil {
-		return 0, err
+		return err
 	}
 
 	// Save last account metadata
-	if err := putLastAccount(ns, account); err != nil {
-		return 0, err
-	}
-
-	return account, nil
+	return putLastAccount(ns, account)
 }
 
 // RenameAccount renames an account stored in the manager based on the
@@ -1915,22 +2039,96 @@ func (m *Manager) PrivateKey(ns walletdb.ReadBucket, addr abcutil.Address) (key
 	return key, done, nil
 }
 
-// RedeemScript retreives the redeem script to redeem an output paid to a P2SH
-// address.  If this function returns without error, the returned 'done'
-// function must be called when the script is no longer being used. Failure to
-// do so will cause deadlocks when the manager is locked.
-func (m *Manager) RedeemScript(ns walletdb.ReadBucket, addr abcutil.Address) (script []byte, done func(), err error) {
-	// No scripts are available for a watching-only address manager.
+// DerivedPrivateKey derives the private key at a child index of an account
+// branch.  Unlike PrivateKey, the address of the key need not be recorded by the
+// address manager, which allows signing for addresses that were never derived
+// by this wallet, such as when signing on an offline wallet.  If this function
+// returns without error, the returned 'done' function must be called when the
+// private key is no longer being used.  Failure to do so will cause deadlocks
+// when the manager is locked.
+func (m *Manager) DerivedPrivateKey(ns walletdb.ReadBucket, account, branch, child uint32) (key chainec.PrivateKey, done func(), err error) {
+	// No private keys are available for a watching-only address manager.
 	if m.watchingOnly {
 		err := apperrors.E{ErrorCode: apperrors.ErrWatchingOnly, Description: errWatchingOnly, Err: nil}
 		return nil, nil, err
 	}
 
+	defer m.mtx.Unlock()
+	m.mtx.Lock()
+
+	if m.locked {
+		err := apperrors.E{ErrorCode: apperrors.ErrLocked, Description: errLocked, Err: nil}
+		return nil, nil, err
+	}
+
+	xpriv, err := m.deriveKeyFromPath(ns, account, branch, child, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	pubKey, err := xpriv.ECPubKey()
+	if err == nil {
+		key, err = xpriv.ECPrivKey()
+	}
+	xpriv.Zero()
+	if err != nil {
+		const str = "failed to create private key from xpriv"
+		err := apperrors.E{ErrorCode: apperrors.ErrKeyChain, Description: str, Err: err}
+		return nil, nil, err
+	}
+
+	m.returnedSecretsMu.RLock()
+	done = m.returnedSecretsMu.RUnlock
+
+	// Add the key to the manager so it can be zeroed on wallet lock.
+	if m.returnedPrivKeys == nil {
+		m.returnedPrivKeys = make(map[[ripemd160.Size]byte]chainec.PrivateKey)
+	}
+	var hash160 [ripemd160.Size]byte
+	copy(hash160[:], abcutil.Hash160(pubKey.SerializeCompressed()))
+	m.returnedPrivKeys[hash160] = key
+
+	return key, done, nil
+}
+
+// RedeemScript retreives the redeem script to redeem an output paid to a P2SH
+// address.  If this function returns without error, the returned 'done'
+// function must be called when the script is no longer being used. Failure to
+// do so will cause deadlocks when the manager is locked.
+//
+// Scripts of multisig account addresses are derived from public keys, and are
+// returned even when the manager is locked or watching-only.
+func (m *Manager) RedeemScript(ns walletdb.ReadBucket, addr abcutil.Address) (script []byte, done func(), err error) {
 	// Lock the manager mutex for writes.  This protects read access to m.locked
 	// and write access to m.returnedScripts and the cached accounts.
 	defer m.mtx.Unlock()
 	m.mtx.Lock()
 
+	// Multisig account scripts are not secret and need no unlocking.
+	if row, err := fetchAddress(ns, addr.Hash160()[:]); err == nil {
+		if row, ok := row.(*dbChainAddressRow); ok {
+			acctInfo, err := m.loadAccountInfo(ns, row.account)
+			if err != nil {
+				return nil, nil, err
+			}
+			if acctInfo.cosignerKeys != nil {
+				script, err := acctInfo.multisigScript(row.branch,
+					row.index, m.chainParams)
+				if err != nil {
+					const str = "failed to derive multisig script"
+					err := apperrors.E{ErrorCode: apperrors.ErrKeyChain, Description: str, Err: err}
+					return nil, nil, err
+				}
+				return script, func() {}, nil
+			}
+		}
+	}
+
+	// No other scripts are available for a watching-only address manager.
+	if m.watchingOnly {
+		err := apperrors.E{ErrorCode: apperrors.ErrWatchingOnly, Description: errWatchingOnly, Err: nil}
+		return nil, nil, err
+	}
+
 	if m.locked {
 		err := apperrors.E{ErrorCode: apperrors.ErrLocked, Description: errLocked, Err: nil}
 		return nil, nil, err
@@ -0,0 +1,462 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/abcsuite/abcd/chaincfg"
+	"github.com/abcsuite/abcd/chaincfg/chainhash"
+	"github.com/abcsuite/abcd/txscript"
+	"github.com/abcsuite/abcutil"
+	"github.com/abcsuite/abcwallet/apperrors"
+	"github.com/abcsuite/abcwallet/walletdb"
+)
+
+// The address transaction index maps each address of the wallet to the
+// transactions which credit the address or debit a previous credit to the
+// address.  It allows the history of an address to be read without reading
+// every transaction recorded by the store.
+//
+// Addresses are identified by the 20 byte hash of the normalized address (the
+// pubkey hash of pubkey addresses), so pay-to-pubkey and pay-to-pubkey-hash
+// outputs for the same key are indexed together.
+//
+// The index entries are keyed as such:
+//
+//   [0:20]  Address hash (20 bytes)
+//   [20:24] Block height, or the maximum uint32 for unmined transactions
+//           (4 bytes)
+//   [24:56] Transaction hash (32 bytes)
+//
+// And the value is the block hash (32 bytes), which is zeroed for unmined
+// transactions.
+//
+// Each indexed transaction also records where it is indexed so the entries can
+// be moved when the transaction is mined or its block is rolled back, and
+// removed when the transaction is removed.  These are keyed by the transaction
+// hash and serialized as such:
+//
+//   [0:4]   Block height, or the maximum uint32 for unmined transactions
+//           (4 bytes)
+//   [4:36]  Block hash (32 bytes)
+//   [36:]   Address hashes (20 bytes each)
+
+const (
+	addrTxKeySize = 20 + 4 + 32
+
+	// unminedAddrTxHeight is the height of index entries for unmined
+	// transactions.  Unmined transactions are sorted after all mined
+	// transactions of an address.
+	unminedAddrTxHeight = ^uint32(0)
+)
+
+func addrTxLocation(block *Block) (height uint32, blockHash []byte) {
+	if block == nil {
+		return unminedAddrTxHeight, make([]byte, 32)
+	}
+	return uint32(block.Height), block.Hash[:]
+}
+
+func keyAddrTx(addrID []byte, height uint32, txHash []byte) []byte {
+	k := make([]byte, addrTxKeySize)
+	copy(k[0:20], addrID)
+	byteOrder.PutUint32(k[20:24], height)
+	copy(k[24:56], txHash)
+	return k
+}
+
+// addrTxIDs returns the address hashes of the addresses paid by a pkScript.
+// The hashes do not depend on the network of params.
+func addrTxIDs(pkScript []byte, params *chaincfg.Params) [][]byte {
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(
+		txscript.DefaultScriptVersion, pkScript, params)
+	if err != nil {
+		return nil
+	}
+	ids := make([][]byte, 0, len(addrs))
+	for _, a := range addrs {
+		id := normalizeAddress(a).ScriptAddress()
+		if len(id) == 20 {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// spentOutputAddrIDs returns the address hashes of a previous output of the
+// wallet spent by the input with the previous outpoint key prevOutKey, or nil
+// if the previous output is not a credit.  The spent credit may be either mined
+// and unspent, or unmined.
+func (s *Store) spentOutputAddrIDs(ns walletdb.ReadBucket, prevOutKey []byte) [][]byte {
+	credKey := existsRawUnspent(ns, prevOutKey)
+	if credKey == nil && existsRawUnminedCredit(ns, prevOutKey) == nil {
+		return nil
+	}
+	pkScript, err := s.fastCreditPkScriptLookup(ns, credKey, prevOutKey)
+	if err != nil {
+		return nil
+	}
+	return addrTxIDs(pkScript, s.chainParams)
+}
+
+// addAddrTxs indexes the transaction txHash, recorded in block (or unmined
+// when block is nil), under each address hash of addrIDs.  Any entries
+// previously indexed for the transaction are moved to the block.
+func addAddrTxs(ns walletdb.ReadWriteBucket, txHash *chainhash.Hash, block *Block, addrIDs [][]byte) error {
+	if ns.NestedReadBucket(bucketTxAddrs) == nil {
+		return nil
+	}
+	err := relocateAddrTxs(ns, txHash, block)
+	if err != nil {
+		return err
+	}
+
+	height, blockHash := addrTxLocation(block)
+	v := ns.NestedReadBucket(bucketTxAddrs).Get(txHash[:])
+	if v == nil {
+		v = make([]byte, 36)
+		byteOrder.PutUint32(v[0:4], height)
+		copy(v[4:36], blockHash)
+	} else {
+		v = append([]byte(nil), v...)
+	}
+	modified := false
+	txAddrs := ns.NestedReadWriteBucket(bucketAddrTxs)
+addrIDs:
+	for _, id := range addrIDs {
+		for off := 36; off+20 <= len(v); off += 20 {
+			if bytes.Equal(v[off:off+20], id) {
+				continue addrIDs
+			}
+		}
+		err := txAddrs.Put(keyAddrTx(id, height, txHash[:]), blockHash)
+		if err != nil {
+			str := "failed to put address transaction"
+			return storeError(apperrors.ErrDatabase, str, err)
+		}
+		v = append(v, id...)
+		modified = true
+	}
+	if !modified {
+		return nil
+	}
+	err = ns.NestedReadWriteBucket(bucketTxAddrs).Put(txHash[:], v)
+	if err != nil {
+		str := "failed to put transaction addresses"
+		return storeError(apperrors.ErrDatabase, str, err)
+	}
+	return nil
+}
+
+// relocateAddrTxs moves the index entries of the transaction txHash to block,
+// or to the unmined transactions when block is nil.  It is called when a
+// transaction is mined, and when the block of a mined transaction is rolled
+// back and the transaction is moved to the unmined transactions.
+func relocateAddrTxs(ns walletdb.ReadWriteBucket, txHash *chainhash.Hash, block *Block) error {
+	if ns.NestedReadBucket(bucketTxAddrs) == nil {
+		return nil
+	}
+	v := ns.NestedReadBucket(bucketTxAddrs).Get(txHash[:])
+	if len(v) < 36 {
+		return nil
+	}
+	height, blockHash := addrTxLocation(block)
+	oldHeight := byteOrder.Uint32(v[0:4])
+	if oldHeight == height && bytes.Equal(v[4:36], blockHash) {
+		return nil
+	}
+
+	txAddrs := ns.NestedReadWriteBucket(bucketAddrTxs)
+	for off := 36; off+20 <= len(v); off += 20 {
+		id := v[off : off+20]
+		err := txAddrs.Delete(keyAddrTx(id, oldHeight, txHash[:]))
+		if err != nil {
+			str := "failed to delete address transaction"
+			return storeError(apperrors.ErrDatabase, str, err)
+		}
+		err = txAddrs.Put(keyAddrTx(id, height, txHash[:]), blockHash)
+		if err != nil {
+			str := "failed to put address transaction"
+			return storeError(apperrors.ErrDatabase, str, err)
+		}
+	}
+
+	newV := append([]byte(nil), v...)
+	byteOrder.PutUint32(newV[0:4], height)
+	copy(newV[4:36], blockHash)
+	err := ns.NestedReadWriteBucket(bucketTxAddrs).Put(txHash[:], newV)
+	if err != nil {
+		str := "failed to put transaction addresses"
+		return storeError(apperrors.ErrDatabase, str, err)
+	}
+	return nil
+}
+
+// removeAddrTxs removes all index entries of the transaction txHash.
+func removeAddrTxs(ns walletdb.ReadWriteBucket, txHash *chainhash.Hash) error {
+	if ns.NestedReadBucket(bucketTxAddrs) == nil {
+		return nil
+	}
+	v := ns.NestedReadBucket(bucketTxAddrs).Get(txHash[:])
+	if v == nil {
+		return nil
+	}
+	if len(v) < 36 {
+		str := "short transaction addresses value"
+		return storeError(apperrors.ErrData, str, nil)
+	}
+	height := byteOrder.Uint32(v[0:4])
+	txAddrs := ns.NestedReadWriteBucket(bucketAddrTxs)
+	for off := 36; off+20 <= len(v); off += 20 {
+		err := txAddrs.Delete(keyAddrTx(v[off:off+20], height, txHash[:]))
+		if err != nil {
+			str := "failed to delete address transaction"
+			return storeError(apperrors.ErrDatabase, str, err)
+		}
+	}
+	err := ns.NestedReadWriteBucket(bucketTxAddrs).Delete(txHash[:])
+	if err != nil {
+		str := "failed to delete transaction addresses"
+		return storeError(apperrors.ErrDatabase, str, err)
+	}
+	return nil
+}
+
+// rebuildAddrTxIndex recreates the address transaction index from the credits
+// and debits of every mined and unmined transaction.
+func (s *Store) rebuildAddrTxIndex(ns walletdb.ReadWriteBucket) error {
+	for _, bucketKey := range [][]byte{bucketAddrTxs, bucketTxAddrs} {
+		if ns.NestedReadBucket(bucketKey) != nil {
+			err := ns.DeleteNestedBucket(bucketKey)
+			if err != nil {
+				str := "failed to delete address transaction index bucket"
+				return storeError(apperrors.ErrDatabase, str, err)
+			}
+		}
+		_, err := ns.CreateBucket(bucketKey)
+		if err != nil {
+			str := "failed to create address transaction index bucket"
+			return storeError(apperrors.ErrDatabase, str, err)
+		}
+	}
+
+	// The address hashes of every transaction are collected before writing
+	// the index since buckets may not be modified while iterated.
+	type indexedTx struct {
+		hash    chainhash.Hash
+		block   *Block
+		addrIDs [][]byte
+	}
+	var txs []indexedTx
+	err := ns.NestedReadBucket(bucketTxRecords).ForEach(func(k, v []byte) error {
+		tx := indexedTx{block: new(Block)}
+		copy(tx.hash[:], k)
+		err := readRawTxRecordBlock(k, tx.block)
+		if err != nil {
+			return err
+		}
+
+		credIter := makeReadCreditIterator(ns, k)
+		for credIter.next() {
+			pkScript, err := s.fastCreditPkScriptLookup(ns, credIter.ck, nil)
+			if err != nil {
+				continue
+			}
+			tx.addrIDs = append(tx.addrIDs, addrTxIDs(pkScript, s.chainParams)...)
+		}
+		if credIter.err != nil {
+			return credIter.err
+		}
+		debIter := makeReadDebitIterator(ns, k)
+		for debIter.next() {
+			credKey := extractRawDebitCreditKey(debIter.cv)
+			pkScript, err := s.fastCreditPkScriptLookup(ns, credKey, nil)
+			if err != nil {
+				continue
+			}
+			tx.addrIDs = append(tx.addrIDs, addrTxIDs(pkScript, s.chainParams)...)
+		}
+		if debIter.err != nil {
+			return debIter.err
+		}
+
+		txs = append(txs, tx)
+		return nil
+	})
+	if err != nil {
+		str := "failed iterating transaction records"
+		return storeError(apperrors.ErrDatabase, str, err)
+	}
+	err = ns.NestedReadBucket(bucketUnmined).ForEach(func(k, v []byte) error {
+		var tx indexedTx
+		err := readRawUnminedHash(k, &tx.hash)
+		if err != nil {
+			return err
+		}
+		var rec TxRecord
+		err = readRawTxRecord(&tx.hash, v, &rec)
+		if err != nil {
+			return err
+		}
+
+		it := makeReadUnminedCreditIterator(ns, &tx.hash)
+		for it.next() {
+			pkScript, err := s.fastCreditPkScriptLookup(ns, nil, it.ck)
+			if err != nil {
+				continue
+			}
+			tx.addrIDs = append(tx.addrIDs, addrTxIDs(pkScript, s.chainParams)...)
+		}
+		if it.err != nil {
+			return it.err
+		}
+		for _, input := range rec.MsgTx.TxIn {
+			prevOut := &input.PreviousOutPoint
+			prevOutKey := canonicalOutPoint(&prevOut.Hash, prevOut.Index)
+			tx.addrIDs = append(tx.addrIDs, s.spentOutputAddrIDs(ns, prevOutKey)...)
+		}
+
+		txs = append(txs, tx)
+		return nil
+	})
+	if err != nil {
+		str := "failed iterating unmined transactions"
+		return storeError(apperrors.ErrDatabase, str, err)
+	}
+
+	for i := range txs {
+		if len(txs[i].addrIDs) == 0 {
+			continue
+		}
+		err = addAddrTxs(ns, &txs[i].hash, txs[i].block, txs[i].addrIDs)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type addrTxEntry struct {
+	height uint32
+	hash   chainhash.Hash
+	block  Block
+}
+
+type addrTxEntries []addrTxEntry
+
+func (e addrTxEntries) Len() int      { return len(e) }
+func (e addrTxEntries) Swap(i, j int) { e[i], e[j] = e[j], e[i] }
+func (e addrTxEntries) Less(i, j int) bool {
+	if e[i].height != e[j].height {
+		return e[i].height < e[j].height
+	}
+	return bytes.Compare(e[i].hash[:], e[j].hash[:]) < 0
+}
+
+// RangeAddressTransactions runs the function f on the details of all
+// transactions which credit or debit any of the addresses addrs and are
+// recorded in blocks of the best chain over the height range [begin,end].  The
+// special height -1 may be used to also include unmined transactions.  If the
+// end height comes before the begin height, blocks are iterated in reverse
+// order and unmined transactions (if any) are processed first.
+//
+// As with RangeTransactions, f is called once for the transactions of each
+// block (and once for all unmined transactions), and may return true to stop
+// iteration early.  Transactions of a block are ordered by their hashes.  Each
+// transaction is only included once, even when it involves several of the
+// addresses.
+func (s *Store) RangeAddressTransactions(ns walletdb.ReadBucket, addrs []abcutil.Address,
+	begin, end int32, f func([]TxDetails) (bool, error)) error {
+
+	// Mempool height is considered a high bound.
+	lo, hi := uint32(begin), uint32(end)
+	if begin < 0 {
+		lo = unminedAddrTxHeight
+	}
+	if end < 0 {
+		hi = unminedAddrTxHeight
+	}
+	reverse := lo > hi
+	if reverse {
+		lo, hi = hi, lo
+	}
+
+	var entries addrTxEntries
+	seen := make(map[chainhash.Hash]struct{})
+	c := ns.NestedReadBucket(bucketAddrTxs).ReadCursor()
+	for _, addr := range addrs {
+		id := normalizeAddress(addr).ScriptAddress()
+		if len(id) != 20 {
+			continue
+		}
+		start := keyAddrTx(id, lo, nil)
+		for k, v := c.Seek(start[:24]); k != nil; k, v = c.Next() {
+			if len(k) != addrTxKeySize || !bytes.Equal(k[:20], id) {
+				break
+			}
+			height := byteOrder.Uint32(k[20:24])
+			if height > hi {
+				break
+			}
+			var e addrTxEntry
+			copy(e.hash[:], k[24:56])
+			if _, ok := seen[e.hash]; ok {
+				continue
+			}
+			seen[e.hash] = struct{}{}
+			e.height = height
+			e.block.Height = int32(height)
+			copy(e.block.Hash[:], v)
+			entries = append(entries, e)
+		}
+	}
+	sort.Sort(entries)
+	if reverse {
+		for i, j := 0, len(entries)-1; i < j; i, j = i+1, j-1 {
+			entries[i], entries[j] = entries[j], entries[i]
+		}
+	}
+
+	var details []TxDetails
+	for i, e := range entries {
+		var d *TxDetails
+		var err error
+		if e.height == unminedAddrTxHeight {
+			v := existsRawUnmined(ns, e.hash[:])
+			if v == nil {
+				str := fmt.Sprintf("missing unmined transaction %v "+
+					"for address index", &e.hash)
+				return storeError(apperrors.ErrData, str, nil)
+			}
+			d, err = s.unminedTxDetails(ns, &e.hash, v)
+		} else {
+			k := keyTxRecord(&e.hash, &e.block)
+			v := existsRawTxRecord(ns, k)
+			if v == nil {
+				str := fmt.Sprintf("missing transaction %v for "+
+					"block %v in address index", &e.hash, e.block.Height)
+				return storeError(apperrors.ErrData, str, nil)
+			}
+			d, err = s.minedTxDetails(ns, &e.hash, k, v)
+		}
+		if err != nil {
+			return err
+		}
+		details = append(details, *d)
+
+		if i+1 < len(entries) && entries[i+1].height == e.height {
+			continue
+		}
+		brk, err := f(details)
+		if err != nil || brk {
+			return err
+		}
+		details = details[:0]
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/abcsuite/abcd/chaincfg"
+	"github.com/abcsuite/abcd/chaincfg/chainec"
+	"github.com/abcsuite/abcd/chaincfg/chainhash"
+	"github.com/abcsuite/abcd/txscript"
+	"github.com/abcsuite/abcd/wire"
+	"github.com/abcsuite/abcutil"
+	"github.com/abcsuite/abcwallet/walletdb"
+)
+
+type addrTx struct {
+	height int32
+	hash   chainhash.Hash
+}
+
+// addressTransactions returns the height and hash of each transaction of an
+// address as returned by RangeAddressTransactions.
+func addressTransactions(t *testing.T, s *Store, ns walletdb.ReadBucket,
+	addr abcutil.Address, begin, end int32) []addrTx {
+
+	var txs []addrTx
+	err := s.RangeAddressTransactions(ns, []abcutil.Address{addr}, begin, end,
+		func(details []TxDetails) (bool, error) {
+			for i := range details {
+				txs = append(txs, addrTx{details[i].Block.Height, details[i].Hash})
+			}
+			return false, nil
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return txs
+}
+
+func TestAddressTransactionIndex(t *testing.T) {
+	db, s, teardown, err := setup()
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	params := &chaincfg.TestNet2Params
+	var addrs [2]abcutil.Address
+	var scripts [2][]byte
+	for i := range addrs {
+		pkHash := make([]byte, 20)
+		pkHash[0] = byte(i + 1)
+		addrs[i], err = abcutil.NewAddressPubKeyHash(pkHash, params,
+			chainec.ECTypeSecp256k1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		scripts[i], err = txscript.PayToAddrScript(addrs[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	addrA, addrB := addrs[0], addrs[1]
+
+	g := makeBlockGenerator()
+	block1Header := g.generate(abcutil.BlockValid)
+	block2Header := g.generate(abcutil.BlockValid)
+
+	tx1 := wire.MsgTx{
+		TxOut: []*wire.TxOut{
+			{Value: 3e8, PkScript: scripts[0]},
+			{Value: 2e8, PkScript: scripts[1]},
+		},
+	}
+	tx2 := wire.MsgTx{
+		TxIn: []*wire.TxIn{
+			{PreviousOutPoint: wire.OutPoint{Hash: tx1.TxHash(), Index: 0, Tree: 0}},
+		},
+		TxOut: []*wire.TxOut{{Value: 1e8, PkScript: scripts[1]}},
+	}
+	tx1Rec, err := NewTxRecordFromMsgTx(&tx1, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx2Rec, err := NewTxRecordFromMsgTx(&tx2, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	check := func(ns walletdb.ReadBucket, desc string, addr abcutil.Address,
+		begin, end int32, expected ...addrTx) {
+
+		txs := addressTransactions(t, s, ns, addr, begin, end)
+		if !reflect.DeepEqual(txs, expected) {
+			t.Errorf("%s: address %v: got transactions %v, expected %v",
+				desc, addr, txs, expected)
+		}
+	}
+
+	err = walletdb.Update(db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+		addrmgrNs := dbtx.ReadBucket(waddrmgrBucketKey)
+
+		check(ns, "empty store", addrA, 0, -1)
+
+		err := s.InsertMemPoolTx(ns, tx1Rec)
+		if err != nil {
+			return err
+		}
+		err = s.AddCredit(ns, tx1Rec, nil, 0, false, 0)
+		if err != nil {
+			return err
+		}
+		err = s.AddCredit(ns, tx1Rec, nil, 1, false, 0)
+		if err != nil {
+			return err
+		}
+		check(ns, "unmined credits", addrA, 0, -1, addrTx{-1, tx1Rec.Hash})
+		check(ns, "unmined credits", addrB, 0, -1, addrTx{-1, tx1Rec.Hash})
+
+		err = s.InsertMemPoolTx(ns, tx2Rec)
+		if err != nil {
+			return err
+		}
+		err = s.AddCredit(ns, tx2Rec, nil, 0, false, 0)
+		if err != nil {
+			return err
+		}
+		unmined := []addrTx{{-1, tx1Rec.Hash}, {-1, tx2Rec.Hash}}
+		if bytes.Compare(tx2Rec.Hash[:], tx1Rec.Hash[:]) < 0 {
+			unmined[0], unmined[1] = unmined[1], unmined[0]
+		}
+		check(ns, "unmined spend", addrA, 0, -1, unmined...)
+		check(ns, "unmined spend", addrB, 0, -1, unmined...)
+
+		headerData := makeHeaderDataSlice(block1Header, block2Header)
+		err = s.InsertMainChainHeaders(ns, addrmgrNs, headerData)
+		if err != nil {
+			return err
+		}
+		err = s.InsertMinedTx(ns, addrmgrNs, tx1Rec, &headerData[0].BlockHash)
+		if err != nil {
+			return err
+		}
+		err = s.InsertMinedTx(ns, addrmgrNs, tx2Rec, &headerData[1].BlockHash)
+		if err != nil {
+			return err
+		}
+		mined := []addrTx{{1, tx1Rec.Hash}, {2, tx2Rec.Hash}}
+		check(ns, "mined", addrA, 0, -1, mined...)
+		check(ns, "mined", addrB, 0, -1, mined...)
+		check(ns, "mined range", addrB, 2, 2, mined[1])
+		check(ns, "mined reverse", addrB, -1, 0, mined[1], mined[0])
+
+		err = s.Rollback(ns, addrmgrNs, 2)
+		if err != nil {
+			return err
+		}
+		check(ns, "rollback", addrA, 0, -1, addrTx{1, tx1Rec.Hash}, addrTx{-1, tx2Rec.Hash})
+		check(ns, "rollback", addrB, 0, 1, addrTx{1, tx1Rec.Hash})
+
+		err = s.removeUnconfirmed(ns, tx2Rec)
+		if err != nil {
+			return err
+		}
+		check(ns, "removed unmined spend", addrA, 0, -1, addrTx{1, tx1Rec.Hash})
+		check(ns, "removed unmined spend", addrB, 0, -1, addrTx{1, tx1Rec.Hash})
+
+		// Rebuilding the index must produce the same results.
+		err = s.rebuildAddrTxIndex(ns)
+		if err != nil {
+			return err
+		}
+		check(ns, "rebuilt index", addrA, 0, -1, addrTx{1, tx1Rec.Hash})
+		check(ns, "rebuilt index", addrB, 0, -1, addrTx{1, tx1Rec.Hash})
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -53,3 +53,17 @@ func SetAgendaPreference(tx walletdb.ReadWriteTx, version uint32, agendaID, choi
 func AgendaPreference(tx walletdb.ReadTx, version uint32, agendaID string) (choiceID string) {
 	return agendaPreferences.preference(tx, version, agendaID)
 }
+
+// ForEachAgendaPreference calls f with the deployment version, agenda ID, and
+// choice ID of every saved agenda preference.  Preferences are visited in order
+// of deployment version and agenda ID.
+func ForEachAgendaPreference(tx walletdb.ReadTx, f func(version uint32, agendaID, choiceID string) error) error {
+	b := tx.ReadBucket(agendaPreferences.rootBucketKey())
+	return b.ForEach(func(k, v []byte) error {
+		if len(k) < 4 {
+			const str = "agenda preference key is too short"
+			return apperrors.E{ErrorCode: apperrors.ErrData, Description: str}
+		}
+		return f(byteOrder.Uint32(k), string(k[4:]), string(v))
+	})
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/abcsuite/abcwallet/walletdb"
+)
+
+func TestForEachAgendaPreference(t *testing.T) {
+	db, _, teardown, err := setup()
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type pref struct {
+		version  uint32
+		agendaID string
+		choiceID string
+	}
+	prefs := []pref{
+		{5, "sdiffalgorithm", "yes"},
+		{4, "lnsupport", "no"},
+		{5, "lnfeatures", "abstain"},
+	}
+	err = walletdb.Update(db, func(dbtx walletdb.ReadWriteTx) error {
+		_, err := dbtx.CreateTopLevelBucket(agendaPreferences.rootBucketKey())
+		if err != nil {
+			return err
+		}
+		for _, p := range prefs {
+			err = SetAgendaPreference(dbtx, p.version, p.agendaID, p.choiceID)
+			if err != nil {
+				return err
+			}
+		}
+		// Overwriting a preference must not duplicate it.
+		return SetAgendaPreference(dbtx, 4, "lnsupport", "yes")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []pref
+	err = walletdb.View(db, func(dbtx walletdb.ReadTx) error {
+		return ForEachAgendaPreference(dbtx, func(version uint32, agendaID, choiceID string) error {
+			got = append(got, pref{version, agendaID, choiceID})
+			return nil
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []pref{
+		{4, "lnsupport", "yes"},
+		{5, "lnfeatures", "abstain"},
+		{5, "sdiffalgorithm", "yes"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ForEachAgendaPreference visited %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,525 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"github.com/abcsuite/abcd/txscript"
+	"github.com/abcsuite/abcutil"
+	"github.com/abcsuite/abcwallet/apperrors"
+	"github.com/abcsuite/abcwallet/walletdb"
+)
+
+// The balance index records the per-account balances of all unspent credits so
+// that account balances can be calculated without reading every unspent output
+// and its credit record.  It is maintained by the functions which write and
+// delete the raw unspent, unmined credit, and unmined input records, and
+// therefore follows every change made by adding credits, moving transactions
+// into blocks, spending outputs, and rolling back blocks.
+//
+// Credits are summed into balance totals keyed by the account, the kind of
+// credit, and, for mined credits, the block height.  Keying by the height
+// allows the confirmation and maturity rules to be applied when balances are
+// queried, and the number of totals grows only with the number of blocks
+// containing unspent credits rather than the number of credits.
+//
+// Ticket purchase outputs and legacy credits which do not record their account
+// can not be summed without consulting the address manager, so their outpoints
+// are instead recorded in a scan bucket and their balances are calculated in
+// the same manner as a full scan of the unspent outputs.
+//
+// The balance totals are keyed as such:
+//
+//   [0:4] Account (4 bytes)
+//   [4]   Credit kind (1 byte)
+//   [5:9] Block height, or 0 for unmined credits (4 bytes)
+//
+// And their values are serialized as such:
+//
+//   [0:4]  Number of credits (4 bytes)
+//   [4:12] Amount (8 bytes)
+//
+// The indexed outputs are keyed by the canonical outpoint of each credit, and
+// record how the credit is counted in the totals so it can be removed or
+// excluded later without reading the credit record again:
+//
+//   [0]     Credit kind (1 byte)
+//   [1]     Flags (1 byte)
+//   [2:6]   Account (4 bytes)
+//   [6:10]  Block height (4 bytes)
+//   [10:18] Amount (8 bytes)
+//
+// Credits spent by unmined transactions remain indexed but are flagged as
+// excluded and are not counted by the totals or scan bucket.
+
+type balanceKind byte
+
+const (
+	balanceRegular balanceKind = iota
+	balanceCoinbase
+	balanceStakeGen
+	balanceTicketChange
+	balanceUnminedRegular
+	balanceUnminedTicket
+	balanceUnminedStakeGen
+	balanceOther
+	balanceMinedScan
+	balanceUnminedScan
+	balanceUnminedOther
+)
+
+func (k balanceKind) mined() bool {
+	switch k {
+	case balanceUnminedRegular, balanceUnminedTicket, balanceUnminedStakeGen,
+		balanceUnminedScan, balanceUnminedOther:
+		return false
+	}
+	return true
+}
+
+func (k balanceKind) scan() bool {
+	return k == balanceMinedScan || k == balanceUnminedScan
+}
+
+const balanceExcludedFlag = 1 << 0
+
+type balanceEntry struct {
+	kind     balanceKind
+	excluded bool
+	account  uint32
+	height   int32
+	amount   abcutil.Amount
+}
+
+func valueBalanceEntry(e *balanceEntry) []byte {
+	v := make([]byte, 18)
+	v[0] = byte(e.kind)
+	if e.excluded {
+		v[1] |= balanceExcludedFlag
+	}
+	byteOrder.PutUint32(v[2:6], e.account)
+	byteOrder.PutUint32(v[6:10], uint32(e.height))
+	byteOrder.PutUint64(v[10:18], uint64(e.amount))
+	return v
+}
+
+func readBalanceEntry(v []byte, e *balanceEntry) error {
+	if len(v) < 18 {
+		str := "short balance index entry"
+		return storeError(apperrors.ErrData, str, nil)
+	}
+	e.kind = balanceKind(v[0])
+	e.excluded = v[1]&balanceExcludedFlag != 0
+	e.account = byteOrder.Uint32(v[2:6])
+	e.height = int32(byteOrder.Uint32(v[6:10]))
+	e.amount = abcutil.Amount(byteOrder.Uint64(v[10:18]))
+	return nil
+}
+
+func keyBalanceTotal(account uint32, kind balanceKind, height int32) []byte {
+	k := make([]byte, 9)
+	byteOrder.PutUint32(k[0:4], account)
+	k[4] = byte(kind)
+	byteOrder.PutUint32(k[5:9], uint32(height))
+	return k
+}
+
+// minedBalanceEntry returns the balance index entry for a mined credit.
+func minedBalanceEntry(credKey, credVal []byte) (*balanceEntry, error) {
+	amount, err := fetchRawCreditAmount(credVal)
+	if err != nil {
+		return nil, err
+	}
+	e := &balanceEntry{
+		height: extractRawCreditHeight(credKey),
+		amount: amount,
+	}
+	switch fetchRawCreditTagOpCode(credVal) {
+	case OP_NONSTAKE:
+		e.kind = balanceRegular
+		if fetchRawCreditIsCoinbase(credVal) {
+			e.kind = balanceCoinbase
+		}
+	case txscript.OP_SSGEN, txscript.OP_SSRTX:
+		e.kind = balanceStakeGen
+	case txscript.OP_SSTXCHANGE:
+		e.kind = balanceTicketChange
+	case txscript.OP_SSTX:
+		e.kind = balanceMinedScan
+	default:
+		e.kind = balanceOther
+	}
+	e.account, err = fetchRawCreditAccount(credVal)
+	if err != nil {
+		e.kind = balanceMinedScan
+	}
+	if e.kind == balanceOther || e.kind == balanceMinedScan {
+		e.height = 0
+	}
+	return e, nil
+}
+
+// unminedBalanceEntry returns the balance index entry for an unmined credit.
+func unminedBalanceEntry(v []byte) (*balanceEntry, error) {
+	amount, err := fetchRawUnminedCreditAmount(v)
+	if err != nil {
+		return nil, err
+	}
+	e := &balanceEntry{amount: amount}
+	switch fetchRawUnminedCreditTagOpcode(v) {
+	case OP_NONSTAKE:
+		e.kind = balanceUnminedRegular
+	case txscript.OP_SSTX:
+		e.kind = balanceUnminedTicket
+	case txscript.OP_SSGEN, txscript.OP_SSRTX:
+		e.kind = balanceUnminedStakeGen
+	default:
+		e.kind = balanceUnminedOther
+	}
+	e.account, err = fetchRawUnminedCreditAccount(v)
+	if err != nil {
+		e.kind = balanceUnminedScan
+	}
+	return e, nil
+}
+
+// addBalanceEntry adds (or, when subtract is true, removes) the credit of the
+// indexed output k to the balance totals or scan bucket.
+func addBalanceEntry(ns walletdb.ReadWriteBucket, k []byte, e *balanceEntry, subtract bool) error {
+	if e.kind.scan() {
+		var err error
+		b := ns.NestedReadWriteBucket(bucketBalanceScan)
+		if subtract {
+			err = b.Delete(k)
+		} else {
+			err = b.Put(k, []byte{byte(e.kind)})
+		}
+		if err != nil {
+			str := "failed to update balance index scan bucket"
+			return storeError(apperrors.ErrDatabase, str, err)
+		}
+		return nil
+	}
+
+	b := ns.NestedReadWriteBucket(bucketBalanceTotals)
+	totalKey := keyBalanceTotal(e.account, e.kind, e.height)
+	var count uint32
+	var amount abcutil.Amount
+	if v := b.Get(totalKey); len(v) == 12 {
+		count = byteOrder.Uint32(v[0:4])
+		amount = abcutil.Amount(byteOrder.Uint64(v[4:12]))
+	}
+	if subtract {
+		if count == 0 || amount < e.amount {
+			str := "balance index total underflow"
+			return storeError(apperrors.ErrData, str, nil)
+		}
+		count--
+		amount -= e.amount
+	} else {
+		count++
+		amount += e.amount
+	}
+
+	var err error
+	if count == 0 {
+		err = b.Delete(totalKey)
+	} else {
+		v := make([]byte, 12)
+		byteOrder.PutUint32(v[0:4], count)
+		byteOrder.PutUint64(v[4:12], uint64(amount))
+		err = b.Put(totalKey, v)
+	}
+	if err != nil {
+		str := "failed to update balance index total"
+		return storeError(apperrors.ErrDatabase, str, err)
+	}
+	return nil
+}
+
+// fetchBalanceEntry returns the indexed entry for the outpoint k, or nil if the
+// output is not indexed.
+func fetchBalanceEntry(ns walletdb.ReadBucket, k []byte) (*balanceEntry, error) {
+	v := ns.NestedReadBucket(bucketBalanceOutputs).Get(k)
+	if v == nil {
+		return nil, nil
+	}
+	e := new(balanceEntry)
+	err := readBalanceEntry(v, e)
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// putBalanceEntry indexes the credit for the outpoint k, replacing any entry
+// previously recorded for the outpoint.
+func putBalanceEntry(ns walletdb.ReadWriteBucket, k []byte, e *balanceEntry) error {
+	old, err := fetchBalanceEntry(ns, k)
+	if err != nil {
+		return err
+	}
+	if old != nil && !old.excluded {
+		err = addBalanceEntry(ns, k, old, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	e.excluded = existsRawUnminedInput(ns, k) != nil
+	if !e.excluded {
+		err = addBalanceEntry(ns, k, e, false)
+		if err != nil {
+			return err
+		}
+	}
+	err = ns.NestedReadWriteBucket(bucketBalanceOutputs).Put(k,
+		valueBalanceEntry(e))
+	if err != nil {
+		str := "failed to put balance index entry"
+		return storeError(apperrors.ErrDatabase, str, err)
+	}
+	return nil
+}
+
+// The following functions are called when writing and deleting the records
+// tracked by the balance index.  Each does nothing when the index buckets do
+// not exist, which is the case when the records are modified by database
+// upgrades performed before the index was introduced.
+
+// indexUnspent indexes the mined credit for the unspent output k/v.
+func indexUnspent(ns walletdb.ReadWriteBucket, k, v []byte) error {
+	if ns.NestedReadBucket(bucketBalanceOutputs) == nil {
+		return nil
+	}
+	credKey := make([]byte, 72)
+	copy(credKey[0:32], k[0:32])   // Tx hash
+	copy(credKey[32:68], v[0:36])  // Block height and hash
+	copy(credKey[68:72], k[32:36]) // Output index
+	credVal := existsRawCredit(ns, credKey)
+	if credVal == nil {
+		str := "missing credit for indexed unspent output"
+		return storeError(apperrors.ErrData, str, nil)
+	}
+	e, err := minedBalanceEntry(credKey, credVal)
+	if err != nil {
+		return err
+	}
+	return putBalanceEntry(ns, k, e)
+}
+
+// indexUnminedCredit indexes the unmined credit k/v.
+func indexUnminedCredit(ns walletdb.ReadWriteBucket, k, v []byte) error {
+	if ns.NestedReadBucket(bucketBalanceOutputs) == nil {
+		return nil
+	}
+	e, err := unminedBalanceEntry(v)
+	if err != nil {
+		return err
+	}
+	return putBalanceEntry(ns, k, e)
+}
+
+// unindexOutput removes the indexed credit for the outpoint k if it was indexed
+// as a mined (or, when mined is false, unmined) credit.  An output moved between
+// the mined and unmined buckets is reindexed when it is written to the new
+// bucket, and that entry must not be removed when the old record is deleted.
+func unindexOutput(ns walletdb.ReadWriteBucket, k []byte, mined bool) error {
+	if ns.NestedReadBucket(bucketBalanceOutputs) == nil {
+		return nil
+	}
+	e, err := fetchBalanceEntry(ns, k)
+	if err != nil || e == nil || e.kind.mined() != mined {
+		return err
+	}
+	if !e.excluded {
+		err = addBalanceEntry(ns, k, e, true)
+		if err != nil {
+			return err
+		}
+	}
+	err = ns.NestedReadWriteBucket(bucketBalanceOutputs).Delete(k)
+	if err != nil {
+		str := "failed to delete balance index entry"
+		return storeError(apperrors.ErrDatabase, str, err)
+	}
+	return nil
+}
+
+// excludeOutput excludes the indexed credit for the outpoint k from the
+// balances when it is spent by an unmined transaction, and includes it again
+// when the unmined spend is removed.
+func excludeOutput(ns walletdb.ReadWriteBucket, k []byte, excluded bool) error {
+	if ns.NestedReadBucket(bucketBalanceOutputs) == nil {
+		return nil
+	}
+	e, err := fetchBalanceEntry(ns, k)
+	if err != nil || e == nil || e.excluded == excluded {
+		return err
+	}
+	err = addBalanceEntry(ns, k, e, excluded)
+	if err != nil {
+		return err
+	}
+	e.excluded = excluded
+	err = ns.NestedReadWriteBucket(bucketBalanceOutputs).Put(k,
+		valueBalanceEntry(e))
+	if err != nil {
+		str := "failed to put balance index entry"
+		return storeError(apperrors.ErrDatabase, str, err)
+	}
+	return nil
+}
+
+// rebuildBalanceIndex recreates the balance index buckets and indexes every
+// unspent output and unmined credit.  Unspent outputs without a credit record
+// are not indexed, as they are also ignored by spending and are reported by the
+// consistency checker.
+func rebuildBalanceIndex(ns walletdb.ReadWriteBucket) error {
+	for _, bucketKey := range [][]byte{bucketBalanceTotals,
+		bucketBalanceOutputs, bucketBalanceScan} {
+		if ns.NestedReadBucket(bucketKey) != nil {
+			err := ns.DeleteNestedBucket(bucketKey)
+			if err != nil {
+				str := "failed to delete balance index bucket"
+				return storeError(apperrors.ErrDatabase, str, err)
+			}
+		}
+		_, err := ns.CreateBucket(bucketKey)
+		if err != nil {
+			str := "failed to create balance index bucket"
+			return storeError(apperrors.ErrDatabase, str, err)
+		}
+	}
+
+	// The records are collected before indexing them since buckets should
+	// not be modified while iterating over other buckets of the namespace.
+	type kvpair struct{ k, v []byte }
+	var unspent, unminedCredits []kvpair
+	err := ns.NestedReadBucket(bucketUnspent).ForEach(func(k, v []byte) error {
+		if existsRawCredit(ns, existsRawUnspent(ns, k)) == nil {
+			return nil
+		}
+		unspent = append(unspent, kvpair{k, v})
+		return nil
+	})
+	if err != nil {
+		str := "failed iterating unspent outputs"
+		return storeError(apperrors.ErrDatabase, str, err)
+	}
+	err = ns.NestedReadBucket(bucketUnminedCredits).ForEach(func(k, v []byte) error {
+		unminedCredits = append(unminedCredits, kvpair{k, v})
+		return nil
+	})
+	if err != nil {
+		str := "failed iterating unmined credits"
+		return storeError(apperrors.ErrDatabase, str, err)
+	}
+
+	for _, r := range unspent {
+		err = indexUnspent(ns, r.k, r.v)
+		if err != nil {
+			return err
+		}
+	}
+	for _, r := range unminedCredits {
+		err = indexUnminedCredit(ns, r.k, r.v)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// balanceIndexed calculates the balances of every account using the balance
+// index.  The results are identical to balanceFullScan.
+func (s *Store) balanceIndexed(ns, addrmgrNs walletdb.ReadBucket, minConf int32,
+	syncHeight int32) (map[uint32]*Balances, error) {
+
+	accountBalances := make(map[uint32]*Balances)
+	err := ns.NestedReadBucket(bucketBalanceTotals).ForEach(func(k, v []byte) error {
+		if len(k) != 9 || len(v) != 12 {
+			str := "malformed balance index total"
+			return storeError(apperrors.ErrData, str, nil)
+		}
+		account := byteOrder.Uint32(k[0:4])
+		kind := balanceKind(k[4])
+		height := int32(byteOrder.Uint32(k[5:9]))
+		amt := abcutil.Amount(byteOrder.Uint64(v[4:12]))
+
+		ab, ok := accountBalances[account]
+		if !ok {
+			ab = &Balances{Account: account}
+			accountBalances[account] = ab
+		}
+		ab.Total += amt
+
+		switch kind {
+		case balanceRegular:
+			if confirmed(minConf, height, syncHeight) {
+				ab.Spendable += amt
+			}
+		case balanceCoinbase:
+			if confirmed(int32(s.chainParams.CoinbaseMaturity), height,
+				syncHeight) {
+				ab.Spendable += amt
+			} else {
+				ab.ImmatureCoinbaseRewards += amt
+			}
+		case balanceStakeGen:
+			if confirmed(int32(s.chainParams.CoinbaseMaturity), height,
+				syncHeight) {
+				ab.Spendable += amt
+			} else {
+				ab.ImmatureStakeGeneration += amt
+			}
+		case balanceTicketChange:
+			if confirmed(int32(s.chainParams.SStxChangeMaturity), height,
+				syncHeight) {
+				ab.Spendable += amt
+			}
+		case balanceUnminedRegular:
+			if minConf == 0 {
+				ab.Spendable += amt
+			}
+		case balanceUnminedTicket:
+			if minConf == 0 {
+				ab.VotingAuthority += amt
+			}
+			ab.LockedByTickets += amt
+		case balanceUnminedStakeGen:
+			ab.ImmatureStakeGeneration += amt
+		}
+		return nil
+	})
+	if err != nil {
+		str := "failed iterating balance index totals"
+		return nil, storeError(apperrors.ErrDatabase, str, err)
+	}
+
+	err = ns.NestedReadBucket(bucketBalanceScan).ForEach(func(k, v []byte) error {
+		if len(v) == 1 && balanceKind(v[0]) == balanceUnminedScan {
+			credVal := existsRawUnminedCredit(ns, k)
+			if credVal == nil {
+				str := "missing unmined credit for balance index"
+				return storeError(apperrors.ErrData, str, nil)
+			}
+			return s.addUnminedCreditBalance(ns, addrmgrNs, accountBalances,
+				k, credVal, minConf)
+		}
+		unspentVal := ns.NestedReadBucket(bucketUnspent).Get(k)
+		if unspentVal == nil {
+			str := "missing unspent output for balance index"
+			return storeError(apperrors.ErrData, str, nil)
+		}
+		return s.addMinedCreditBalance(ns, addrmgrNs, accountBalances, k,
+			unspentVal, minConf, syncHeight)
+	})
+	if err != nil {
+		str := "failed iterating balance index scan bucket"
+		return nil, storeError(apperrors.ErrDatabase, str, err)
+	}
+
+	return accountBalances, nil
+}
@@ -0,0 +1,294 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/abcsuite/abcd/blockchain/stake"
+	"github.com/abcsuite/abcd/chaincfg/chainhash"
+	"github.com/abcsuite/abcd/txscript"
+	"github.com/abcsuite/abcd/wire"
+	"github.com/abcsuite/abcutil"
+	"github.com/abcsuite/abcwallet/walletdb"
+)
+
+// checkBalanceIndex compares the balances calculated using the balance index
+// against a full scan for several confirmation requirements and sync heights.
+func checkBalanceIndex(t *testing.T, s *Store, ns, addrmgrNs walletdb.ReadBucket, desc string) {
+	_, tipHeight := s.MainChainTip(ns)
+	maturity := int32(s.chainParams.CoinbaseMaturity)
+	for _, minConf := range []int32{0, 1, 2} {
+		for _, syncHeight := range []int32{tipHeight, tipHeight + maturity} {
+			fullScan, err := s.balanceFullScan(ns, addrmgrNs, minConf, syncHeight)
+			if err != nil {
+				t.Fatalf("%s: full scan: %v", desc, err)
+			}
+			indexed, err := s.balanceIndexed(ns, addrmgrNs, minConf, syncHeight)
+			if err != nil {
+				t.Fatalf("%s: indexed: %v", desc, err)
+			}
+			if !reflect.DeepEqual(fullScan, indexed) {
+				t.Errorf("%s: minconf %d height %d: indexed balances do "+
+					"not match full scan", desc, minConf, syncHeight)
+				for acct, b := range fullScan {
+					t.Logf("full scan account %d: %+v", acct, *b)
+				}
+				for acct, b := range indexed {
+					t.Logf("indexed account %d: %+v", acct, *b)
+				}
+			}
+		}
+	}
+}
+
+func TestBalanceIndex(t *testing.T) {
+	db, s, teardown, err := setup()
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	g := makeBlockGenerator()
+	block1Header := g.generate(abcutil.BlockValid)
+	block2Header := g.generate(abcutil.BlockValid)
+	block3Header := g.generate(abcutil.BlockValid)
+
+	tx1 := wire.MsgTx{
+		TxOut: []*wire.TxOut{{Value: 3e8}, {Value: 2e8}},
+	}
+	tx2 := wire.MsgTx{
+		TxIn: []*wire.TxIn{
+			{PreviousOutPoint: wire.OutPoint{Hash: tx1.TxHash(), Index: 0, Tree: 0}},
+		},
+		TxOut: []*wire.TxOut{{Value: 1e8}},
+	}
+	coinbaseTx := wire.MsgTx{
+		TxIn: []*wire.TxIn{
+			{PreviousOutPoint: wire.OutPoint{Hash: chainhash.Hash{}, Index: math.MaxUint32, Tree: 0}},
+		},
+		TxOut: []*wire.TxOut{{Value: 5e8}},
+	}
+	tx1Rec, err := NewTxRecordFromMsgTx(&tx1, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx2Rec, err := NewTxRecordFromMsgTx(&tx2, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	coinbaseRec, err := NewTxRecordFromMsgTx(&coinbaseTx, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = walletdb.Update(db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+		addrmgrNs := dbtx.ReadBucket(waddrmgrBucketKey)
+
+		checkBalanceIndex(t, s, ns, addrmgrNs, "empty store")
+
+		err := s.InsertMemPoolTx(ns, tx1Rec)
+		if err != nil {
+			return err
+		}
+		err = s.AddCredit(ns, tx1Rec, nil, 0, false, 0)
+		if err != nil {
+			return err
+		}
+		err = s.AddCredit(ns, tx1Rec, nil, 1, false, 1)
+		if err != nil {
+			return err
+		}
+		checkBalanceIndex(t, s, ns, addrmgrNs, "unmined credits")
+
+		err = s.InsertMemPoolTx(ns, tx2Rec)
+		if err != nil {
+			return err
+		}
+		err = s.AddCredit(ns, tx2Rec, nil, 0, true, 0)
+		if err != nil {
+			return err
+		}
+		checkBalanceIndex(t, s, ns, addrmgrNs, "unmined spend")
+
+		headerData := makeHeaderDataSlice(block1Header, block2Header, block3Header)
+		err = s.InsertMainChainHeaders(ns, addrmgrNs, headerData)
+		if err != nil {
+			return err
+		}
+		err = s.InsertMinedTx(ns, addrmgrNs, tx1Rec, &headerData[0].BlockHash)
+		if err != nil {
+			return err
+		}
+		checkBalanceIndex(t, s, ns, addrmgrNs, "mined credits spent by unmined tx")
+
+		err = s.InsertMinedTx(ns, addrmgrNs, tx2Rec, &headerData[1].BlockHash)
+		if err != nil {
+			return err
+		}
+		checkBalanceIndex(t, s, ns, addrmgrNs, "mined spend")
+
+		err = s.InsertMinedTx(ns, addrmgrNs, coinbaseRec, &headerData[2].BlockHash)
+		if err != nil {
+			return err
+		}
+		err = s.AddCredit(ns, coinbaseRec, makeBlockMeta(block3Header), 0, false, 1)
+		if err != nil {
+			return err
+		}
+		checkBalanceIndex(t, s, ns, addrmgrNs, "coinbase")
+
+		bal, err := s.AccountBalance(ns, addrmgrNs, 1, 1)
+		if err != nil {
+			return err
+		}
+		if bal.Total != 7e8 || bal.Spendable != 2e8 || bal.ImmatureCoinbaseRewards != 5e8 {
+			t.Errorf("Wrong account 1 balance: %+v", bal)
+		}
+
+		err = s.Rollback(ns, addrmgrNs, 2)
+		if err != nil {
+			return err
+		}
+		checkBalanceIndex(t, s, ns, addrmgrNs, "rollback")
+
+		err = s.removeUnconfirmed(ns, tx2Rec)
+		if err != nil {
+			return err
+		}
+		checkBalanceIndex(t, s, ns, addrmgrNs, "removed unmined spend")
+
+		bal, err = s.AccountBalance(ns, addrmgrNs, 0, 0)
+		if err != nil {
+			return err
+		}
+		if bal.Total != 3e8 || bal.Spendable != 3e8 {
+			t.Errorf("Wrong account 0 balance: %+v", bal)
+		}
+
+		// Rebuilding the index must produce the same balances.
+		err = rebuildBalanceIndex(ns)
+		if err != nil {
+			return err
+		}
+		checkBalanceIndex(t, s, ns, addrmgrNs, "rebuilt index")
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestBalanceIndexTicketChange checks that the change output of a ticket
+// purchase is indexed as an unmined credit while the ticket is unmined, so
+// that it is removed along with the ticket and survives a rollback.
+func TestBalanceIndexTicketChange(t *testing.T) {
+	db, s, teardown, err := setup()
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	g := makeBlockGenerator()
+	block1Header := g.generate(abcutil.BlockValid)
+	block2Header := g.generate(abcutil.BlockValid)
+
+	p2pkh := func(tag byte) []byte {
+		script := []byte{txscript.OP_DUP, txscript.OP_HASH160,
+			txscript.OP_DATA_20}
+		script = append(script, make([]byte, 20)...)
+		script = append(script, txscript.OP_EQUALVERIFY, txscript.OP_CHECKSIG)
+		if tag != 0 {
+			script = append([]byte{tag}, script...)
+		}
+		return script
+	}
+	commitment := append([]byte{txscript.OP_RETURN, txscript.OP_DATA_30},
+		make([]byte, 30)...)
+	ticket := wire.MsgTx{
+		TxIn: []*wire.TxIn{{
+			PreviousOutPoint: wire.OutPoint{Hash: chainhash.Hash{1}},
+			ValueIn:          5e8,
+		}},
+		TxOut: []*wire.TxOut{
+			{Value: 3e8, PkScript: p2pkh(txscript.OP_SSTX)},
+			{Value: 0, PkScript: commitment},
+			{Value: 2e8, PkScript: p2pkh(txscript.OP_SSTXCHANGE)},
+		},
+	}
+	ticketRec, err := NewTxRecordFromMsgTx(&ticket, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ticketRec.TxType != stake.TxTypeSStx {
+		t.Fatalf("test ticket is not a ticket purchase")
+	}
+
+	err = walletdb.Update(db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+		addrmgrNs := dbtx.ReadBucket(waddrmgrBucketKey)
+
+		headerData := makeHeaderDataSlice(block1Header, block2Header)
+		err := s.InsertMainChainHeaders(ns, addrmgrNs, headerData)
+		if err != nil {
+			return err
+		}
+
+		err = s.InsertMemPoolTx(ns, ticketRec)
+		if err != nil {
+			return err
+		}
+		err = s.AddCredit(ns, ticketRec, nil, 2, true, 0)
+		if err != nil {
+			return err
+		}
+		checkBalanceIndex(t, s, ns, addrmgrNs, "unmined ticket change")
+
+		// Prune the ticket as below the stake difficulty.
+		_, err = s.PruneUnconfirmed(ns, 2, 4e8)
+		if err != nil {
+			return err
+		}
+		checkBalanceIndex(t, s, ns, addrmgrNs, "pruned ticket change")
+		bal, err := s.AccountBalance(ns, addrmgrNs, 0, 0)
+		if err != nil {
+			return err
+		}
+		if bal.Total != 0 {
+			t.Errorf("Wrong balance after pruning ticket: %+v", bal)
+		}
+
+		err = s.InsertMinedTx(ns, addrmgrNs, ticketRec, &headerData[1].BlockHash)
+		if err != nil {
+			return err
+		}
+		err = s.AddCredit(ns, ticketRec, makeBlockMeta(block2Header), 2, true, 0)
+		if err != nil {
+			return err
+		}
+		checkBalanceIndex(t, s, ns, addrmgrNs, "mined ticket change")
+
+		err = s.Rollback(ns, addrmgrNs, 2)
+		if err != nil {
+			return err
+		}
+		checkBalanceIndex(t, s, ns, addrmgrNs, "rolled back ticket change")
+		bal, err = s.AccountBalance(ns, addrmgrNs, 0, 0)
+		if err != nil {
+			return err
+		}
+		if bal.Total != 2e8 {
+			t.Errorf("Wrong balance after rolling back ticket: %+v", bal)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,653 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+
+	"github.com/abcsuite/abcd/blockchain/stake"
+	"github.com/abcsuite/abcd/chaincfg"
+	"github.com/abcsuite/abcd/chaincfg/chainhash"
+	"github.com/abcsuite/abcutil"
+	"github.com/abcsuite/abcwallet/apperrors"
+	"github.com/abcsuite/abcwallet/walletdb"
+)
+
+// Names of the checks performed by CheckConsistency.  These are used as the
+// Check field of each reported ConsistencyProblem.
+const (
+	CheckBlocks       = "blocks"
+	CheckTxRecords    = "txrecords"
+	CheckCredits      = "credits"
+	CheckDebits       = "debits"
+	CheckUnspent      = "unspent"
+	CheckBalance      = "balance"
+	CheckBalanceIndex = "balanceindex"
+	CheckUnmined      = "unmined"
+	CheckTickets      = "tickets"
+	CheckAccounts     = "accounts"
+	CheckAddresses    = "addresses"
+)
+
+// ConsistencyProblem describes a single inconsistency found in the wallet
+// database.
+type ConsistencyProblem struct {
+	// Check names the check which found the problem.
+	Check string
+
+	// Description describes the problem and the records involved.
+	Description string
+
+	// Fixable is true if the problem can be repaired without losing any
+	// information that can not be recovered from other records.
+	Fixable bool
+
+	// Fixed is true if the problem was repaired.
+	Fixed bool
+}
+
+// ConsistencyReport is the result of checking the consistency of a wallet
+// database.  It records the number of records of each kind that were checked
+// and all problems that were found.
+type ConsistencyReport struct {
+	Blocks    int
+	TxRecords int
+	Credits   int
+	Debits    int
+	Unspent   int
+	Unmined   int
+	Tickets   int
+	Accounts  int
+	Addresses int
+
+	Problems []ConsistencyProblem
+}
+
+// Consistent returns whether the database was found to be consistent, or all
+// found problems were repaired.
+func (r *ConsistencyReport) Consistent() bool {
+	for i := range r.Problems {
+		if !r.Problems[i].Fixed {
+			return false
+		}
+	}
+	return true
+}
+
+// consistencyChecker accumulates the report of CheckConsistency and the
+// repairs of fixable problems.  Repairs modify the transaction store namespace
+// and are only applied after every check has completed, since buckets may not
+// be modified while they are iterated.
+type consistencyChecker struct {
+	params *chaincfg.Params
+	report ConsistencyReport
+	fixes  map[int]func(ns walletdb.ReadWriteBucket) error
+}
+
+func (c *consistencyChecker) problem(check, format string, args ...interface{}) {
+	c.report.Problems = append(c.report.Problems, ConsistencyProblem{
+		Check:       check,
+		Description: fmt.Sprintf(format, args...),
+	})
+}
+
+func (c *consistencyChecker) fixable(fix func(ns walletdb.ReadWriteBucket) error,
+	check, format string, args ...interface{}) {
+	c.fixes[len(c.report.Problems)] = fix
+	c.report.Problems = append(c.report.Problems, ConsistencyProblem{
+		Check:       check,
+		Description: fmt.Sprintf(format, args...),
+		Fixable:     true,
+	})
+}
+
+// CheckConsistency walks the transaction store, stake store, and address
+// manager of a wallet database and checks that the records of each refer to
+// one another correctly.  It verifies that the main chain block records and
+// headers form a contiguous chain, that every credit, debit, and unspent output
+// refers to existing records, that the spent flag of every credit matches the
+// debits, that the mined balance and the balance index match the unspent
+// credits, that unmined transaction indexes refer to unmined transactions, that
+// ticket records hold ticket purchases, and that accounts and addresses are
+// correctly indexed.
+//
+// When repair is true, problems that can be fixed using the information
+// contained by other records are repaired, and are marked Fixed in the report.
+// Problems that can not be repaired are only reported.  The database must not
+// be opened by a wallet while repairing it unless the wallet is reloaded
+// afterwards.
+//
+// Errors are only returned when the database can not be read, or is not a
+// wallet database at the current version.  Inconsistencies are described by
+// the returned report.
+func CheckConsistency(db walletdb.DB, params *chaincfg.Params, repair bool) (*ConsistencyReport, error) {
+	err := VerifyDB(db)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &consistencyChecker{
+		params: params,
+		fixes:  make(map[int]func(ns walletdb.ReadWriteBucket) error),
+	}
+	check := func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrBucketKey)
+		stakemgrNs := dbtx.ReadBucket(wstakemgrBucketKey)
+		addrmgrNs := dbtx.ReadBucket(waddrmgrBucketKey)
+		for _, f := range []func() error{
+			// The balance index is checked first so that it is rebuilt
+			// before the repairs of later checks update it.
+			func() error { return c.checkBalanceIndex(txmgrNs, addrmgrNs) },
+			func() error { return c.checkBlocks(txmgrNs) },
+			func() error { return c.checkTxRecords(txmgrNs) },
+			func() error { return c.checkCredits(txmgrNs) },
+			func() error { return c.checkUnspent(txmgrNs) },
+			func() error { return c.checkDebits(txmgrNs) },
+			func() error { return c.checkUnmined(txmgrNs) },
+			func() error { return c.checkTickets(stakemgrNs) },
+			func() error { return c.checkAccounts(addrmgrNs) },
+			func() error { return c.checkAddresses(addrmgrNs) },
+		} {
+			err := f()
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if repair {
+		err = walletdb.Update(db, func(dbtx walletdb.ReadWriteTx) error {
+			err := check(dbtx)
+			if err != nil {
+				return err
+			}
+			txmgrNs := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+			for i := range c.report.Problems {
+				fix, ok := c.fixes[i]
+				if !ok {
+					continue
+				}
+				err := fix(txmgrNs)
+				if err != nil {
+					return err
+				}
+				c.report.Problems[i].Fixed = true
+			}
+			return nil
+		})
+	} else {
+		err = walletdb.View(db, check)
+	}
+	switch err.(type) {
+	case nil:
+	case apperrors.E:
+		return nil, err
+	default:
+		const str = "consistency check failed"
+		return nil, apperrors.E{ErrorCode: apperrors.ErrDatabase, Description: str, Err: err}
+	}
+	return &c.report, nil
+}
+
+// checkBlocks checks that the main chain block records begin at the genesis
+// block and are contiguous, that each has a saved header which connects to the
+// previous block, that the transactions of each block are recorded, and that
+// the tip block is the last main chain block.
+func (c *consistencyChecker) checkBlocks(ns walletdb.ReadBucket) error {
+	var prev *blockRecord
+	err := ns.NestedReadBucket(bucketBlocks).ForEach(func(k, v []byte) error {
+		c.report.Blocks++
+
+		var rec blockRecord
+		err := readRawBlockRecord(k, v, &rec)
+		if err != nil {
+			c.problem(CheckBlocks, "unreadable block record %x: %v", k, err)
+			return nil
+		}
+		switch {
+		case prev == nil && rec.Height != 0:
+			c.problem(CheckBlocks, "main chain begins at height %d", rec.Height)
+		case prev == nil && rec.Hash != *c.params.GenesisHash:
+			c.problem(CheckBlocks, "main chain does not begin with the "+
+				"genesis block (hash %v)", &rec.Hash)
+		case prev != nil && rec.Height != prev.Height+1:
+			c.problem(CheckBlocks, "main chain skips from height %d to %d",
+				prev.Height, rec.Height)
+		}
+
+		header := existsBlockHeader(ns, rec.Hash[:])
+		switch {
+		case header == nil:
+			c.problem(CheckBlocks, "missing header for block %v (height %d)",
+				&rec.Hash, rec.Height)
+		case len(header) != len(RawBlockHeader{}):
+			c.problem(CheckBlocks, "header for block %v has bad length %d",
+				&rec.Hash, len(header))
+		default:
+			if h := extractBlockHeaderHeight(header); h != rec.Height {
+				c.problem(CheckBlocks, "header for block %v records height "+
+					"%d but is saved at height %d", &rec.Hash, h, rec.Height)
+			}
+			parent := extractBlockHeaderParentHash(header)
+			if prev != nil && !bytes.Equal(parent, prev.Hash[:]) {
+				c.problem(CheckBlocks, "block %v (height %d) does not "+
+					"connect to the previous main chain block %v",
+					&rec.Hash, rec.Height, &prev.Hash)
+			}
+		}
+
+		for i := range rec.transactions {
+			_, v := existsTxRecord(ns, &rec.transactions[i], &rec.Block)
+			if v == nil {
+				c.problem(CheckBlocks, "block %v (height %d) records "+
+					"missing transaction %v", &rec.Hash, rec.Height,
+					&rec.transactions[i])
+			}
+		}
+
+		prev = &rec
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	tip := ns.Get(rootTipBlock)
+	if prev != nil && !bytes.Equal(tip, prev.Hash[:]) {
+		c.problem(CheckBlocks, "tip block %x is not the last main chain "+
+			"block %v", tip, &prev.Hash)
+	}
+	return nil
+}
+
+// checkTxRecords checks that every mined transaction record can be read, is
+// keyed by the hash of the transaction, and is recorded in a main chain block.
+func (c *consistencyChecker) checkTxRecords(ns walletdb.ReadBucket) error {
+	return ns.NestedReadBucket(bucketTxRecords).ForEach(func(k, v []byte) error {
+		c.report.TxRecords++
+
+		var block Block
+		err := readRawTxRecordBlock(k, &block)
+		if err != nil {
+			c.problem(CheckTxRecords, "bad transaction record key %x", k)
+			return nil
+		}
+		var txHash chainhash.Hash
+		copy(txHash[:], k)
+
+		var rec TxRecord
+		err = readRawTxRecord(&txHash, v, &rec)
+		if err != nil {
+			c.problem(CheckTxRecords, "unreadable transaction %v: %v", &txHash, err)
+		} else if h := rec.MsgTx.TxHash(); h != txHash {
+			c.problem(CheckTxRecords, "transaction %v is recorded with the "+
+				"hash %v", &h, &txHash)
+		}
+
+		_, blockVal := existsBlockRecord(ns, block.Height)
+		if blockVal == nil || !bytes.Equal(extractRawBlockRecordHash(blockVal), block.Hash[:]) {
+			c.problem(CheckTxRecords, "transaction %v is recorded in block %v "+
+				"(height %d) which is not in the main chain", &txHash,
+				&block.Hash, block.Height)
+		}
+		return nil
+	})
+}
+
+// checkCredits checks that every credit refers to a recorded transaction, that
+// unspent credits are saved in the unspent index, and that spent credits refer
+// to the debit spending them.  The unspent credits must sum to the recorded
+// mined balance.
+func (c *consistencyChecker) checkCredits(ns walletdb.ReadBucket) error {
+	var balance abcutil.Amount
+	err := ns.NestedReadBucket(bucketCredits).ForEach(func(k, v []byte) error {
+		c.report.Credits++
+
+		if len(k) < creditKeySize {
+			c.problem(CheckCredits, "bad credit key %x", k)
+			return nil
+		}
+		txHash := extractRawCreditTxHash(k)
+		index := extractRawCreditIndex(k)
+		if existsRawTxRecord(ns, extractRawCreditTxRecordKey(k)) == nil {
+			c.problem(CheckCredits, "credit %v:%d refers to a missing "+
+				"transaction record", &txHash, index)
+		}
+		amount, spent, err := fetchRawCreditAmountSpent(v)
+		if err != nil {
+			c.problem(CheckCredits, "unreadable credit %v:%d: %v", &txHash,
+				index, err)
+			return nil
+		}
+
+		// Keys are copied since repairs are applied after the view of the
+		// bucket is no longer valid.
+		creditKey := append([]byte(nil), k...)
+		unspentKey := canonicalOutPoint(&txHash, index)
+		unspentVal := creditKey[32:68]
+		markUnspent := func(ns walletdb.ReadWriteBucket) error {
+			return putRawUnspent(ns, unspentKey, unspentVal)
+		}
+
+		if !spent {
+			balance += amount
+			if !bytes.Equal(existsRawUnspent(ns, unspentKey), creditKey) {
+				c.fixable(markUnspent, CheckCredits, "unspent credit %v:%d "+
+					"is missing from the unspent index", &txHash, index)
+			}
+			return nil
+		}
+
+		if len(v) < 81 {
+			c.problem(CheckCredits, "spent credit %v:%d does not record the "+
+				"spending debit", &txHash, index)
+			return nil
+		}
+		debitKey := v[9:81]
+		debitVal := ns.NestedReadBucket(bucketDebits).Get(debitKey)
+		switch {
+		case debitVal == nil:
+			// The spender is not recorded, so the credit is marked unspent
+			// again.  It will be marked spent if the spending transaction is
+			// seen again.
+			balance += amount
+			c.fixable(func(ns walletdb.ReadWriteBucket) error {
+				_, err := unspendRawCredit(ns, creditKey)
+				if err != nil {
+					return err
+				}
+				return markUnspent(ns)
+			}, CheckCredits, "credit %v:%d is marked spent by missing debit %x",
+				&txHash, index, debitKey)
+		case len(debitVal) < 80 || !bytes.Equal(extractRawDebitCreditKey(debitVal), creditKey):
+			c.problem(CheckCredits, "credit %v:%d is marked spent by debit "+
+				"%x which spends a different credit", &txHash, index, debitKey)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	minedBalance, err := fetchMinedBalance(ns)
+	if err != nil {
+		c.fixable(func(ns walletdb.ReadWriteBucket) error {
+			return putMinedBalance(ns, balance)
+		}, CheckBalance, "unreadable mined balance: %v", err)
+		return nil
+	}
+	if minedBalance != balance {
+		c.fixable(func(ns walletdb.ReadWriteBucket) error {
+			return putMinedBalance(ns, balance)
+		}, CheckBalance, "mined balance %v does not match the unspent "+
+			"credit total %v", minedBalance, balance)
+	}
+	return nil
+}
+
+// checkBalanceIndex checks that the account balances calculated using the
+// balance index match the balances calculated by a full scan of the unspent
+// outputs and unmined credits.
+func (c *consistencyChecker) checkBalanceIndex(ns, addrmgrNs walletdb.ReadBucket) error {
+	rebuild := func(ns walletdb.ReadWriteBucket) error {
+		return rebuildBalanceIndex(ns)
+	}
+	for _, bucketKey := range [][]byte{bucketBalanceTotals,
+		bucketBalanceOutputs, bucketBalanceScan} {
+		if ns.NestedReadBucket(bucketKey) == nil {
+			c.fixable(rebuild, CheckBalanceIndex, "missing balance index "+
+				"bucket %q", bucketKey)
+			return nil
+		}
+	}
+
+	s := &Store{
+		chainParams: c.params,
+		acctLookupFunc: func(ns walletdb.ReadBucket, addr abcutil.Address) (uint32, error) {
+			return fetchAddrAccount(ns, normalizeAddress(addr).ScriptAddress())
+		},
+	}
+	_, tipHeight := s.MainChainTip(ns)
+	for _, minConf := range []int32{0, 1} {
+		// The full scan only fails when unspent outputs refer to missing
+		// credits, which is reported by checkUnspent.
+		fullScan, err := s.balanceFullScan(ns, addrmgrNs, minConf, tipHeight)
+		if err != nil {
+			return nil
+		}
+		indexed, err := s.balanceIndexed(ns, addrmgrNs, minConf, tipHeight)
+		if err != nil {
+			c.fixable(rebuild, CheckBalanceIndex, "unreadable balance "+
+				"index: %v", err)
+			return nil
+		}
+		if !reflect.DeepEqual(fullScan, indexed) {
+			c.fixable(rebuild, CheckBalanceIndex, "indexed account "+
+				"balances with %d confirmations do not match the unspent "+
+				"credits", minConf)
+			return nil
+		}
+	}
+	return nil
+}
+
+// checkUnspent checks that every output in the unspent index refers to an
+// existing credit which is not marked spent.
+func (c *consistencyChecker) checkUnspent(ns walletdb.ReadBucket) error {
+	return ns.NestedReadBucket(bucketUnspent).ForEach(func(k, v []byte) error {
+		c.report.Unspent++
+
+		unspentKey := append([]byte(nil), k...)
+		remove := func(ns walletdb.ReadWriteBucket) error {
+			return deleteRawUnspent(ns, unspentKey)
+		}
+
+		credKey := existsRawUnspent(ns, k)
+		if credKey == nil {
+			c.fixable(remove, CheckUnspent, "bad unspent output %x", k)
+			return nil
+		}
+		txHash := extractRawCreditTxHash(credKey)
+		index := extractRawCreditIndex(credKey)
+		credVal := existsRawCredit(ns, credKey)
+		if credVal == nil {
+			c.fixable(remove, CheckUnspent, "unspent output %v:%d refers to "+
+				"a missing credit", &txHash, index)
+			return nil
+		}
+		_, spent, err := fetchRawCreditAmountSpent(credVal)
+		if err != nil || !spent {
+			return nil
+		}
+		// Credits spent by a missing debit are marked unspent again by the
+		// repairs of checkCredits, and the unspent output must be kept.
+		if len(credVal) < 81 || ns.NestedReadBucket(bucketDebits).Get(credVal[9:81]) == nil {
+			return nil
+		}
+		c.fixable(remove, CheckUnspent, "unspent output %v:%d refers to a "+
+			"spent credit", &txHash, index)
+		return nil
+	})
+}
+
+// checkDebits checks that every debit refers to a recorded transaction and a
+// credit which is marked spent by the debit.
+func (c *consistencyChecker) checkDebits(ns walletdb.ReadBucket) error {
+	return ns.NestedReadBucket(bucketDebits).ForEach(func(k, v []byte) error {
+		c.report.Debits++
+
+		if len(k) < 72 || len(v) < 80 {
+			c.problem(CheckDebits, "bad debit %x", k)
+			return nil
+		}
+		var txHash chainhash.Hash
+		copy(txHash[:], k)
+		index := byteOrder.Uint32(k[68:72])
+		if existsRawTxRecord(ns, k[:68]) == nil {
+			c.problem(CheckDebits, "debit %v:%d refers to a missing "+
+				"transaction record", &txHash, index)
+		}
+
+		credKey := extractRawDebitCreditKey(v)
+		credVal := existsRawCredit(ns, credKey)
+		if credVal == nil {
+			c.problem(CheckDebits, "debit %v:%d spends missing credit %x",
+				&txHash, index, credKey)
+			return nil
+		}
+		_, spent, err := fetchRawCreditAmountSpent(credVal)
+		if err != nil {
+			return nil // Reported by checkCredits
+		}
+		if !spent || len(credVal) < 81 || !bytes.Equal(credVal[9:81], k) {
+			c.problem(CheckDebits, "debit %v:%d spends credit %x which is "+
+				"not marked spent by the debit", &txHash, index, credKey)
+		}
+		return nil
+	})
+}
+
+// checkUnmined checks that every unmined transaction can be read and that the
+// unmined credit and input indexes only refer to unmined transactions.
+func (c *consistencyChecker) checkUnmined(ns walletdb.ReadBucket) error {
+	err := ns.NestedReadBucket(bucketUnmined).ForEach(func(k, v []byte) error {
+		c.report.Unmined++
+
+		var txHash chainhash.Hash
+		err := readRawUnminedHash(k, &txHash)
+		if err != nil {
+			c.problem(CheckUnmined, "bad unmined transaction key %x", k)
+			return nil
+		}
+		var rec TxRecord
+		err = readRawTxRecord(&txHash, v, &rec)
+		if err != nil {
+			c.problem(CheckUnmined, "unreadable unmined transaction %v: %v",
+				&txHash, err)
+		} else if h := rec.MsgTx.TxHash(); h != txHash {
+			c.problem(CheckUnmined, "unmined transaction %v is recorded with "+
+				"the hash %v", &h, &txHash)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = ns.NestedReadBucket(bucketUnminedCredits).ForEach(func(k, v []byte) error {
+		if len(k) >= 32 && existsRawUnmined(ns, k[:32]) != nil {
+			return nil
+		}
+		key := append([]byte(nil), k...)
+		c.fixable(func(ns walletdb.ReadWriteBucket) error {
+			return deleteRawUnminedCredit(ns, key)
+		}, CheckUnmined, "unmined credit %x refers to a missing unmined "+
+			"transaction", k)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return ns.NestedReadBucket(bucketUnminedInputs).ForEach(func(k, v []byte) error {
+		if len(v) >= 32 && existsRawUnmined(ns, v[:32]) != nil {
+			return nil
+		}
+		key := append([]byte(nil), k...)
+		c.fixable(func(ns walletdb.ReadWriteBucket) error {
+			return deleteRawUnminedInput(ns, key)
+		}, CheckUnmined, "unmined input %x is spent by missing unmined "+
+			"transaction %x", k, v)
+		return nil
+	})
+}
+
+// checkTickets checks that every ticket record of the stake store holds a
+// ticket purchase transaction keyed by its hash.
+func (c *consistencyChecker) checkTickets(ns walletdb.ReadBucket) error {
+	bucket := ns.NestedReadBucket(sstxRecordsBucketName)
+	if bucket == nil {
+		c.problem(CheckTickets, "missing ticket records bucket")
+		return nil
+	}
+	return bucket.ForEach(func(k, v []byte) error {
+		c.report.Tickets++
+
+		record, err := deserializeSStxRecord(v, DBVersion)
+		if err != nil {
+			c.problem(CheckTickets, "unreadable ticket record %x: %v", k, err)
+			return nil
+		}
+		if !bytes.Equal(record.tx.Hash()[:], k) {
+			c.problem(CheckTickets, "ticket %v is recorded with the hash %x",
+				record.tx.Hash(), k)
+		}
+		if stake.DetermineTxType(record.tx.MsgTx()) != stake.TxTypeSStx {
+			c.problem(CheckTickets, "ticket record %x is not a ticket "+
+				"purchase", k)
+		}
+		return nil
+	})
+}
+
+// checkAccounts checks that every account can be read, is indexed by both its
+// name and number, and does not exceed the last recorded account.
+func (c *consistencyChecker) checkAccounts(ns walletdb.ReadBucket) error {
+	lastAccount, err := fetchLastAccount(ns)
+	if err != nil {
+		c.problem(CheckAccounts, "unreadable last account: %v", err)
+		return nil
+	}
+	return forEachAccount(ns, func(account uint32) error {
+		c.report.Accounts++
+
+		_, err := fetchAccountInfo(ns, account, DBVersion)
+		if err != nil {
+			c.problem(CheckAccounts, "unreadable account %d: %v", account, err)
+		}
+		if account > lastAccount && account != ImportedAddrAccount {
+			c.problem(CheckAccounts, "account %d exceeds the last account %d",
+				account, lastAccount)
+		}
+		name, err := fetchAccountName(ns, account)
+		if err != nil {
+			c.problem(CheckAccounts, "account %d is missing from the account "+
+				"number index", account)
+			return nil
+		}
+		byName, err := fetchAccountByName(ns, name)
+		if err != nil || byName != account {
+			c.problem(CheckAccounts, "account %d name %q is not indexed "+
+				"to the account", account, name)
+		}
+		return nil
+	})
+}
+
+// checkAddresses checks that every address can be read and belongs to an
+// existing account.
+func (c *consistencyChecker) checkAddresses(ns walletdb.ReadBucket) error {
+	accounts := ns.NestedReadBucket(acctBucketName)
+	return ns.NestedReadBucket(addrBucketName).ForEach(func(k, v []byte) error {
+		// Skip buckets.
+		if v == nil {
+			return nil
+		}
+		c.report.Addresses++
+
+		row, err := deserializeAddressRow(v)
+		if err != nil {
+			c.problem(CheckAddresses, "unreadable address %x: %v", k, err)
+			return nil
+		}
+		if row.account != ImportedAddrAccount && accounts.Get(uint32ToBytes(row.account)) == nil {
+			c.problem(CheckAddresses, "address %x belongs to missing account "+
+				"%d", k, row.account)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,207 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/abcsuite/abcd/chaincfg"
+	"github.com/abcsuite/abcd/wire"
+	"github.com/abcsuite/abcutil"
+	"github.com/abcsuite/abcwallet/walletdb"
+)
+
+func TestCheckConsistency(t *testing.T) {
+	db, s, teardown, err := setup()
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	g := makeBlockGenerator()
+	block1Header := g.generate(abcutil.BlockValid)
+	block2Header := g.generate(abcutil.BlockValid)
+
+	block1Tx := wire.MsgTx{
+		TxOut: []*wire.TxOut{{Value: 2e8}},
+	}
+	block2Tx := wire.MsgTx{
+		TxIn: []*wire.TxIn{
+			{PreviousOutPoint: wire.OutPoint{Hash: block1Tx.TxHash(), Index: 0, Tree: 0}},
+		},
+		TxOut: []*wire.TxOut{{Value: 1e8}},
+	}
+	block1TxRec, err := NewTxRecordFromMsgTx(&block1Tx, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	block2TxRec, err := NewTxRecordFromMsgTx(&block2Tx, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = walletdb.Update(db, func(dbtx walletdb.ReadWriteTx) error {
+		// Create the remaining namespaces and buckets checked by
+		// CheckConsistency which are not created by setup.
+		b, err := dbtx.CreateTopLevelBucket(unifiedDBMetadata{}.rootBucketKey())
+		if err != nil {
+			return err
+		}
+		err = unifiedDBMetadata{}.putVersion(b, DBVersion)
+		if err != nil {
+			return err
+		}
+		stakemgrNs, err := dbtx.CreateTopLevelBucket(wstakemgrBucketKey)
+		if err != nil {
+			return err
+		}
+		_, err = stakemgrNs.CreateBucket(sstxRecordsBucketName)
+		if err != nil {
+			return err
+		}
+		addrmgrNs := dbtx.ReadWriteBucket(waddrmgrBucketKey)
+		for _, name := range [][]byte{acctBucketName, addrBucketName,
+			acctNameIdxBucketName, acctIDIdxBucketName, metaBucketName} {
+			_, err = addrmgrNs.CreateBucket(name)
+			if err != nil {
+				return err
+			}
+		}
+		err = putLastAccount(addrmgrNs, DefaultAccountNum)
+		if err != nil {
+			return err
+		}
+
+		ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+		err = s.InsertMemPoolTx(ns, block1TxRec)
+		if err != nil {
+			return err
+		}
+		err = s.AddCredit(ns, block1TxRec, nil, 0, false, 0)
+		if err != nil {
+			return err
+		}
+		err = s.InsertMemPoolTx(ns, block2TxRec)
+		if err != nil {
+			return err
+		}
+		err = s.AddCredit(ns, block2TxRec, nil, 0, false, 0)
+		if err != nil {
+			return err
+		}
+		headerData := makeHeaderDataSlice(block1Header, block2Header)
+		err = s.InsertMainChainHeaders(ns, addrmgrNs, headerData)
+		if err != nil {
+			return err
+		}
+		err = s.InsertMinedTx(ns, addrmgrNs, block1TxRec, &headerData[0].BlockHash)
+		if err != nil {
+			return err
+		}
+		return s.InsertMinedTx(ns, addrmgrNs, block2TxRec, &headerData[1].BlockHash)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := CheckConsistency(db, &chaincfg.TestNet2Params, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.Consistent() {
+		t.Fatalf("consistent database reported problems: %+v", report.Problems)
+	}
+	if report.Blocks != 3 || report.TxRecords != 2 || report.Credits != 2 ||
+		report.Debits != 1 || report.Unspent != 1 {
+		t.Errorf("unexpected record counts: %+v", report)
+	}
+
+	// Remove the unspent output of the block 2 credit and record a wrong
+	// mined balance.  Both problems can be repaired.
+	err = walletdb.Update(db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+		block2TxHash := block2Tx.TxHash()
+		err := deleteRawUnspent(ns, canonicalOutPoint(&block2TxHash, 0))
+		if err != nil {
+			return err
+		}
+		return putMinedBalance(ns, 5e8)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report, err = CheckConsistency(db, &chaincfg.TestNet2Params, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Consistent() || len(report.Problems) != 2 {
+		t.Fatalf("expected two problems, got %+v", report.Problems)
+	}
+	for _, p := range report.Problems {
+		if !p.Fixable || p.Fixed {
+			t.Errorf("problem should be fixable and unfixed: %+v", p)
+		}
+	}
+
+	report, err = CheckConsistency(db, &chaincfg.TestNet2Params, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.Consistent() || len(report.Problems) != 2 {
+		t.Errorf("expected two fixed problems, got %+v", report.Problems)
+	}
+
+	report, err = CheckConsistency(db, &chaincfg.TestNet2Params, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Problems) != 0 {
+		t.Errorf("repaired database reported problems: %+v", report.Problems)
+	}
+	err = walletdb.View(db, func(dbtx walletdb.ReadTx) error {
+		bal, err := fetchMinedBalance(dbtx.ReadBucket(wtxmgrBucketKey))
+		if err != nil {
+			return err
+		}
+		if bal != 1e8 {
+			t.Errorf("repaired mined balance is %v, expected %v", bal, abcutil.Amount(1e8))
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Record a balance for an account without any credits in the balance
+	// index.  The index is repaired by rebuilding it.
+	err = walletdb.Update(db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+		v := make([]byte, 12)
+		byteOrder.PutUint32(v[0:4], 1)
+		byteOrder.PutUint64(v[4:12], 1e8)
+		return ns.NestedReadWriteBucket(bucketBalanceTotals).Put(
+			keyBalanceTotal(5, balanceRegular, 1), v)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	report, err = CheckConsistency(db, &chaincfg.TestNet2Params, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Problems) != 1 || report.Problems[0].Check != CheckBalanceIndex ||
+		!report.Problems[0].Fixed {
+		t.Fatalf("expected a fixed balance index problem, got %+v", report.Problems)
+	}
+	report, err = CheckConsistency(db, &chaincfg.TestNet2Params, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Problems) != 0 {
+		t.Errorf("rebuilt balance index reported problems: %+v", report.Problems)
+	}
+}
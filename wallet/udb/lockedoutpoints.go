@@ -0,0 +1,159 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"github.com/abcsuite/abcd/wire"
+	"github.com/abcsuite/abcwallet/apperrors"
+	"github.com/abcsuite/abcwallet/walletdb"
+)
+
+// LockedOutpoint describes a transaction output that has been locked by the
+// user and must not be used as an input for newly created transactions.
+type LockedOutpoint struct {
+	OutPoint wire.OutPoint
+
+	// Reason is an optional user-provided description of why the outpoint
+	// was locked.
+	Reason string
+
+	// ExpiryHeight is the main chain height at which the lock is
+	// automatically released.  Locks with a zero expiry height never expire.
+	ExpiryHeight int32
+}
+
+// Expired returns whether the lock has expired at a main chain height.
+func (l *LockedOutpoint) Expired(height int32) bool {
+	return l.ExpiryHeight != 0 && height >= l.ExpiryHeight
+}
+
+// Locked outpoints are saved as k/v pairs in the locked outpoints bucket.  The
+// key is the canonical outpoint serialization and the value is serialized as
+// such:
+//
+//   [0:1]   Transaction tree (1 byte)
+//   [1:5]   Expiry height (4 bytes)
+//   [5:]    Reason (varies)
+
+type lockedOutpointsTy struct {
+}
+
+var lockedOutpoints lockedOutpointsTy
+
+var lockedOutpointsRootBucketKey = []byte("lockedoutpoints")
+
+func (lockedOutpointsTy) rootBucketKey() []byte { return lockedOutpointsRootBucketKey }
+
+func (lockedOutpointsTy) key(op *wire.OutPoint) []byte {
+	return canonicalOutPoint(&op.Hash, op.Index)
+}
+
+func (lockedOutpointsTy) value(l *LockedOutpoint) []byte {
+	v := make([]byte, 5+len(l.Reason))
+	v[0] = byte(l.OutPoint.Tree)
+	byteOrder.PutUint32(v[1:5], uint32(l.ExpiryHeight))
+	copy(v[5:], l.Reason)
+	return v
+}
+
+func (lockedOutpointsTy) read(k, v []byte, l *LockedOutpoint) error {
+	if len(v) < 5 {
+		const str = "short locked outpoint value"
+		return apperrors.E{ErrorCode: apperrors.ErrData, Description: str, Err: nil}
+	}
+	err := readCanonicalOutPoint(k, &l.OutPoint)
+	if err != nil {
+		return err
+	}
+	l.OutPoint.Tree = int8(v[0])
+	l.ExpiryHeight = int32(byteOrder.Uint32(v[1:5]))
+	l.Reason = string(v[5:])
+	return nil
+}
+
+func (t lockedOutpointsTy) put(tx walletdb.ReadWriteTx, l *LockedOutpoint) error {
+	b := tx.ReadWriteBucket(t.rootBucketKey())
+	return b.Put(t.key(&l.OutPoint), t.value(l))
+}
+
+func (t lockedOutpointsTy) delete(tx walletdb.ReadWriteTx, op *wire.OutPoint) error {
+	b := tx.ReadWriteBucket(t.rootBucketKey())
+	return b.Delete(t.key(op))
+}
+
+func (t lockedOutpointsTy) exists(tx walletdb.ReadTx, op *wire.OutPoint) bool {
+	b := tx.ReadBucket(t.rootBucketKey())
+	return b.Get(t.key(op)) != nil
+}
+
+func (t lockedOutpointsTy) all(tx walletdb.ReadTx) ([]LockedOutpoint, error) {
+	var locked []LockedOutpoint
+	b := tx.ReadBucket(t.rootBucketKey())
+	err := b.ForEach(func(k, v []byte) error {
+		var l LockedOutpoint
+		err := t.read(k, v, &l)
+		if err != nil {
+			return err
+		}
+		locked = append(locked, l)
+		return nil
+	})
+	return locked, err
+}
+
+// PutLockedOutpoint saves an outpoint lock, replacing any previous lock for
+// the same outpoint.
+func PutLockedOutpoint(tx walletdb.ReadWriteTx, l *LockedOutpoint) error {
+	err := lockedOutpoints.put(tx, l)
+	if err != nil {
+		const str = "failed to put locked outpoint"
+		return apperrors.E{ErrorCode: apperrors.ErrDatabase, Description: str, Err: err}
+	}
+	return nil
+}
+
+// DeleteLockedOutpoint removes a saved lock for an outpoint.  It is not an
+// error to remove the lock of an outpoint that is not locked.
+func DeleteLockedOutpoint(tx walletdb.ReadWriteTx, op *wire.OutPoint) error {
+	err := lockedOutpoints.delete(tx, op)
+	if err != nil {
+		const str = "failed to delete locked outpoint"
+		return apperrors.E{ErrorCode: apperrors.ErrDatabase, Description: str, Err: err}
+	}
+	return nil
+}
+
+// DeleteAllLockedOutpoints removes every saved outpoint lock.
+func DeleteAllLockedOutpoints(tx walletdb.ReadWriteTx) error {
+	locked, err := lockedOutpoints.all(tx)
+	if err != nil {
+		return err
+	}
+	for i := range locked {
+		err := DeleteLockedOutpoint(tx, &locked[i].OutPoint)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExistsLockedOutpoint returns whether a lock has been saved for an outpoint.
+func ExistsLockedOutpoint(tx walletdb.ReadTx, op *wire.OutPoint) bool {
+	return lockedOutpoints.exists(tx, op)
+}
+
+// LockedOutpoints returns all saved outpoint locks.
+func LockedOutpoints(tx walletdb.ReadTx) ([]LockedOutpoint, error) {
+	locked, err := lockedOutpoints.all(tx)
+	if err != nil {
+		if _, ok := err.(apperrors.E); ok {
+			return nil, err
+		}
+		const str = "failed to read locked outpoints"
+		return nil, apperrors.E{ErrorCode: apperrors.ErrDatabase, Description: str, Err: err}
+	}
+	return locked, nil
+}
@@ -37,3 +37,15 @@ func (unifiedDBMetadata) getVersion(bucket walletdb.ReadBucket) (uint32, error)
 	}
 	return byteOrder.Uint32(v), nil
 }
+
+// DatabaseVersion returns the version recorded by an initialized wallet
+// database.  Unlike Open, the version is not required to be supported by this
+// software, so it may be used to report the version of any wallet database.
+func DatabaseVersion(tx walletdb.ReadTx) (uint32, error) {
+	metadataBucket := tx.ReadBucket(unifiedDBMetadata{}.rootBucketKey())
+	if metadataBucket == nil {
+		const str = "database has not been initialized"
+		return 0, apperrors.E{ErrorCode: apperrors.ErrNoExist, Description: str}
+	}
+	return unifiedDBMetadata{}.getVersion(metadataBucket)
+}
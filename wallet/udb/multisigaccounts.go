@@ -0,0 +1,250 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/abcsuite/abcd/chaincfg"
+	"github.com/abcsuite/abcd/txscript"
+	"github.com/abcsuite/abcutil"
+	"github.com/abcsuite/abcutil/hdkeychain"
+	"github.com/abcsuite/abcwallet/apperrors"
+	"github.com/abcsuite/abcwallet/walletdb"
+)
+
+// MultisigAccount describes the signature threshold and cosigners of a
+// multisig account.  Addresses of the account are P2SH addresses of
+// RequiredSigs-of-N multisig scripts, where N counts the account's own
+// extended key and every cosigner key.
+type MultisigAccount struct {
+	RequiredSigs int
+
+	// CosignerKeys are the account extended public keys of every cosigner
+	// other than the wallet.  The wallet's own key is the account's
+	// extended key.
+	CosignerKeys []*hdkeychain.ExtendedKey
+}
+
+// pubKeysByBytes sorts serialized public keys in lexicographical order.
+type pubKeysByBytes []*abcutil.AddressSecpPubKey
+
+func (s pubKeysByBytes) Len() int      { return len(s) }
+func (s pubKeysByBytes) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s pubKeysByBytes) Less(i, j int) bool {
+	return bytes.Compare(s[i].ScriptAddress(), s[j].ScriptAddress()) < 0
+}
+
+// MultisigScript returns the requiredSigs-of-N multisig script paying to the
+// child keys derived from the N branch extended public keys.  The public keys
+// are sorted, so every cosigner derives the same script no matter the order of
+// the branch keys.  If the child can not be derived from any of the branch
+// keys, hdkeychain.ErrInvalidChild is returned and the child index should be
+// skipped.
+func MultisigScript(branchKeys []*hdkeychain.ExtendedKey, child uint32,
+	requiredSigs int, params *chaincfg.Params) ([]byte, error) {
+
+	pubKeys := make([]*abcutil.AddressSecpPubKey, len(branchKeys))
+	for i, branchKey := range branchKeys {
+		childKey, err := branchKey.Child(child)
+		if err != nil {
+			return nil, err
+		}
+		pubKey, err := childKey.ECPubKey()
+		if err != nil {
+			return nil, err
+		}
+		pubKeys[i], err = abcutil.NewAddressSecpPubKey(
+			pubKey.SerializeCompressed(), params)
+		if err != nil {
+			return nil, err
+		}
+	}
+	sort.Sort(pubKeysByBytes(pubKeys))
+	return txscript.MultiSigScript(pubKeys, requiredSigs)
+}
+
+// multisigScript derives the multisig script of an account branch child.
+func (acctInfo *accountInfo) multisigScript(branch, child uint32,
+	params *chaincfg.Params) ([]byte, error) {
+
+	acctKeys := make([]*hdkeychain.ExtendedKey, 0, 1+len(acctInfo.cosignerKeys))
+	acctKeys = append(acctKeys, acctInfo.acctKeyPub)
+	acctKeys = append(acctKeys, acctInfo.cosignerKeys...)
+	branchKeys := make([]*hdkeychain.ExtendedKey, len(acctKeys))
+	for i, acctKey := range acctKeys {
+		var err error
+		branchKeys[i], err = acctKey.Child(branch)
+		if err != nil {
+			str := fmt.Sprintf("failed to derive extended key branch %d",
+				branch)
+			return nil, managerError(apperrors.ErrKeyChain, str, err)
+		}
+	}
+	return MultisigScript(branchKeys, child, acctInfo.requiredSigs, params)
+}
+
+// checkCosignerKey ensures a cosigner key is an extended public key for the
+// network from which branch keys can be derived.
+func checkCosignerKey(key *hdkeychain.ExtendedKey, params *chaincfg.Params) error {
+	if key.IsPrivate() {
+		str := "cosigner keys must be extended public keys"
+		return managerError(apperrors.ErrInput, str, nil)
+	}
+	if !key.IsForNet(params) {
+		str := fmt.Sprintf("the provided extended public key is not "+
+			"for %s", params.Net)
+		return managerError(apperrors.ErrWrongNet, str, nil)
+	}
+	if err := checkBranchKeys(key); err != nil {
+		str := "branch keys can not be derived from cosigner key"
+		return managerError(apperrors.ErrKeyChain, str, err)
+	}
+	return nil
+}
+
+// NewMultisigAccount creates a multisig account from the account extended
+// public keys of other cosigners and returns its account number.  Addresses of
+// the account are P2SH addresses of requiredSigs-of-N multisig scripts, where N
+// counts the account's own key and each cosigner key.
+//
+// The account's own key is derived from the cointype key like the key of a
+// BIP0044 account created by NewAccount, so the manager must be unlocked.
+// Watching-only managers have no keys of their own, and instead use the first
+// cosigner key as the account key.  This allows a watching-only wallet to be
+// created for a multisig account of another wallet by passing the account key
+// of that wallet first.
+//
+// Multisig accounts are not discovered when restoring a wallet from seed, since
+// the cosigner keys can not be derived from it.
+func (m *Manager) NewMultisigAccount(ns walletdb.ReadWriteBucket, name string,
+	requiredSigs int, cosignerKeys []*hdkeychain.ExtendedKey) (uint32, error) {
+
+	n := len(cosignerKeys)
+	if !m.watchingOnly {
+		n++
+	}
+	switch {
+	case len(cosignerKeys) == 0 || n < 2:
+		str := "multisig accounts require at least one cosigner key"
+		return 0, managerError(apperrors.ErrInput, str, nil)
+	case n > txscript.MaxPubKeysPerMultiSig:
+		str := fmt.Sprintf("multisig accounts may not have more than %d keys",
+			txscript.MaxPubKeysPerMultiSig)
+		return 0, managerError(apperrors.ErrInput, str, nil)
+	case requiredSigs < 1 || requiredSigs > n:
+		str := fmt.Sprintf("required signatures must be between 1 and the "+
+			"number of keys (%d)", n)
+		return 0, managerError(apperrors.ErrInput, str, nil)
+	}
+	for _, key := range cosignerKeys {
+		if err := checkCosignerKey(key, m.chainParams); err != nil {
+			return 0, err
+		}
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if !m.watchingOnly && m.locked {
+		return 0, managerError(apperrors.ErrLocked, errLocked, nil)
+	}
+
+	if err := checkNewAccountName(ns, name); err != nil {
+		return 0, err
+	}
+
+	var account uint32
+	var acctKeyPub, acctKeyPriv *hdkeychain.ExtendedKey
+	var err error
+	if m.watchingOnly {
+		account, err = fetchLastAccount(ns)
+		if err != nil {
+			return 0, err
+		}
+		account++
+		acctKeyPub, cosignerKeys = cosignerKeys[0], cosignerKeys[1:]
+	} else {
+		account, acctKeyPriv, err = m.deriveNextAccountKey(ns)
+		if err != nil {
+			return 0, err
+		}
+		defer acctKeyPriv.Zero()
+		acctKeyPub, err = acctKeyPriv.Neuter()
+		if err != nil {
+			str := "failed to convert public key for account"
+			return 0, managerError(apperrors.ErrKeyChain, str, err)
+		}
+	}
+	if account > MaxAccountNum {
+		return 0, managerError(apperrors.ErrAccountNumTooHigh, errAcctTooHigh, nil)
+	}
+
+	// Ensure every key is unique, since each cosigner must contribute a
+	// different key to the multisig scripts.
+	keyStrings := make(map[string]struct{}, n)
+	row := &dbMultisigAccountRow{
+		requiredSigs:          uint8(requiredSigs),
+		cosignerKeysEncrypted: make([][]byte, 0, len(cosignerKeys)),
+	}
+	for i, key := range append([]*hdkeychain.ExtendedKey{acctKeyPub}, cosignerKeys...) {
+		keyString, err := key.String()
+		if err != nil {
+			str := "failed to get cosigner key string"
+			return 0, managerError(apperrors.ErrKeyChain, str, err)
+		}
+		if _, ok := keyStrings[keyString]; ok {
+			str := "multisig account keys must be unique"
+			return 0, managerError(apperrors.ErrInput, str, nil)
+		}
+		keyStrings[keyString] = struct{}{}
+		if i == 0 {
+			continue
+		}
+		keyEnc, err := m.cryptoKeyPub.Encrypt([]byte(keyString))
+		if err != nil {
+			str := "failed to encrypt cosigner key"
+			return 0, managerError(apperrors.ErrCrypto, str, err)
+		}
+		row.cosignerKeysEncrypted = append(row.cosignerKeysEncrypted, keyEnc)
+	}
+
+	err = m.putNewAccount(ns, account, name, acctKeyPub, acctKeyPriv)
+	if err != nil {
+		return 0, err
+	}
+	err = putMultisigAccount(ns, account, row)
+	if err != nil {
+		return 0, err
+	}
+
+	return account, nil
+}
+
+// MultisigAccount returns the signature threshold and cosigner keys of a
+// multisig account.  A nil MultisigAccount is returned without error for
+// accounts which are not multisig accounts.
+func (m *Manager) MultisigAccount(dbtx walletdb.ReadTx, account uint32) (*MultisigAccount, error) {
+	if account == ImportedAddrAccount {
+		return nil, nil
+	}
+
+	ns := dbtx.ReadBucket(waddrmgrBucketKey)
+	m.mtx.Lock()
+	acctInfo, err := m.loadAccountInfo(ns, account)
+	m.mtx.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	if acctInfo.cosignerKeys == nil {
+		return nil, nil
+	}
+	return &MultisigAccount{
+		RequiredSigs: acctInfo.requiredSigs,
+		CosignerKeys: acctInfo.cosignerKeys,
+	}, nil
+}
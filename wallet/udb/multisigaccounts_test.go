@@ -0,0 +1,73 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/abcsuite/abcd/chaincfg"
+	"github.com/abcsuite/abcutil/hdkeychain"
+)
+
+func TestMultisigScriptKeyOrder(t *testing.T) {
+	params := &chaincfg.TestNetParams
+	keys := make([]*hdkeychain.ExtendedKey, 3)
+	for i := range keys {
+		seed := bytes.Repeat([]byte{byte(i + 1)}, hdkeychain.RecommendedSeedLen)
+		master, err := hdkeychain.NewMaster(seed, params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys[i], err = master.Neuter()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	script, err := MultisigScript(keys, 0, 2, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reordered := []*hdkeychain.ExtendedKey{keys[2], keys[0], keys[1]}
+	script2, err := MultisigScript(reordered, 0, 2, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(script, script2) {
+		t.Errorf("multisig script depends on the order of branch keys")
+	}
+	next, err := MultisigScript(keys, 1, 2, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(script, next) {
+		t.Errorf("multisig scripts of different children are equal")
+	}
+}
+
+func TestMultisigAccountRowSerialization(t *testing.T) {
+	row := &dbMultisigAccountRow{
+		requiredSigs: 2,
+		cosignerKeysEncrypted: [][]byte{
+			bytes.Repeat([]byte{1}, 140),
+			bytes.Repeat([]byte{2}, 141),
+		},
+	}
+	parsed, err := deserializeMultisigAccountRow(uint32ToBytes(1), serializeMultisigAccountRow(row))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(parsed, row) {
+		t.Errorf("multisig account row differs after round trip:\n got %+v\nwant %+v",
+			parsed, row)
+	}
+
+	_, err = deserializeMultisigAccountRow(uint32ToBytes(1), []byte{2, 1, 0})
+	if err == nil {
+		t.Errorf("deserialized truncated multisig account row")
+	}
+}
@@ -63,3 +63,45 @@ func Open(db walletdb.DB, params *chaincfg.Params, pubPass []byte) (addrMgr *Man
 	}
 	return
 }
+
+// VerifyDB checks that a database, such as a backup copy of a wallet database,
+// has been initialized at the current database version and contains every
+// wallet namespace.  Unlike Open, it does not require the public passphrase and
+// never modifies the database, so it may be used with databases opened
+// read-only.
+func VerifyDB(db walletdb.DB) error {
+	err := walletdb.View(db, func(tx walletdb.ReadTx) error {
+		metadataBucket := tx.ReadBucket(unifiedDBMetadata{}.rootBucketKey())
+		if metadataBucket == nil {
+			const str = "database has not been initialized"
+			return apperrors.E{ErrorCode: apperrors.ErrNoExist, Description: str}
+		}
+		dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+		if err != nil {
+			return err
+		}
+		if dbVersion < DBVersion {
+			const str = "database upgrade required"
+			return apperrors.E{ErrorCode: apperrors.ErrNeedsUpgrade, Description: str}
+		}
+		if dbVersion > DBVersion {
+			const str = "database has been upgraded to an unknown newer version"
+			return apperrors.E{ErrorCode: apperrors.ErrUnknownVersion, Description: str}
+		}
+
+		for _, key := range [][]byte{waddrmgrBucketKey, wtxmgrBucketKey, wstakemgrBucketKey} {
+			if tx.ReadBucket(key) == nil {
+				str := "missing " + string(key) + " namespace"
+				return apperrors.E{ErrorCode: apperrors.ErrData, Description: str}
+			}
+		}
+		return nil
+	})
+	switch err.(type) {
+	case nil, apperrors.E:
+	default:
+		const str = "database view failed"
+		err = apperrors.E{ErrorCode: apperrors.ErrDatabase, Description: str, Err: err}
+	}
+	return err
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"testing"
+
+	"github.com/abcsuite/abcwallet/apperrors"
+	"github.com/abcsuite/abcwallet/walletdb"
+)
+
+func TestVerifyDB(t *testing.T) {
+	db, _, teardown, err := setup()
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = VerifyDB(db)
+	if !apperrors.IsError(err, apperrors.ErrNoExist) {
+		t.Errorf("uninitialized db: expected ErrNoExist, got %v", err)
+	}
+
+	putVersion := func(version uint32) error {
+		return walletdb.Update(db, func(dbtx walletdb.ReadWriteTx) error {
+			b := dbtx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+			if b == nil {
+				var err error
+				b, err = dbtx.CreateTopLevelBucket(unifiedDBMetadata{}.rootBucketKey())
+				if err != nil {
+					return err
+				}
+			}
+			return unifiedDBMetadata{}.putVersion(b, version)
+		})
+	}
+
+	err = putVersion(DBVersion)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = walletdb.View(db, func(dbtx walletdb.ReadTx) error {
+		version, err := DatabaseVersion(dbtx)
+		if err != nil {
+			return err
+		}
+		if version != DBVersion {
+			t.Errorf("DatabaseVersion returned %d, want %d", version, DBVersion)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = VerifyDB(db)
+	if !apperrors.IsError(err, apperrors.ErrData) {
+		t.Errorf("missing stake namespace: expected ErrData, got %v", err)
+	}
+
+	err = walletdb.Update(db, func(dbtx walletdb.ReadWriteTx) error {
+		_, err := dbtx.CreateTopLevelBucket(wstakemgrBucketKey)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = VerifyDB(db)
+	if err != nil {
+		t.Errorf("VerifyDB failed: %v", err)
+	}
+
+	err = putVersion(DBVersion - 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = VerifyDB(db)
+	if !apperrors.IsError(err, apperrors.ErrNeedsUpgrade) {
+		t.Errorf("old version: expected ErrNeedsUpgrade, got %v", err)
+	}
+
+	err = putVersion(DBVersion + 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = VerifyDB(db)
+	if !apperrors.IsError(err, apperrors.ErrUnknownVersion) {
+		t.Errorf("new version: expected ErrUnknownVersion, got %v", err)
+	}
+}
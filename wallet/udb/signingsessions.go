@@ -0,0 +1,153 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"time"
+
+	"github.com/abcsuite/abcd/chaincfg/chainhash"
+	"github.com/abcsuite/abcwallet/apperrors"
+	"github.com/abcsuite/abcwallet/walletdb"
+)
+
+// SigningSession describes a saved session collecting the signatures of
+// multisig cosigners for a transaction.  Sessions are identified by the hash of
+// the transaction, which does not commit to signature scripts.  The partially
+// signed transaction carries the redeem scripts and signatures collected so
+// far, and is serialized by the pstx package.
+type SigningSession struct {
+	Hash      chainhash.Hash
+	Created   time.Time
+	PartialTx []byte
+}
+
+// Signing sessions are saved as k/v pairs in the signing sessions bucket.  The
+// key is the transaction hash and the value is serialized as such:
+//
+//   [0:8]   Creation time (8 bytes)
+//   [8:]    Serialized partially signed transaction (varies)
+
+type signingSessionsTy struct {
+}
+
+var signingSessions signingSessionsTy
+
+var signingSessionsRootBucketKey = []byte("signingsessions")
+
+func (signingSessionsTy) rootBucketKey() []byte { return signingSessionsRootBucketKey }
+
+func (signingSessionsTy) key(txHash *chainhash.Hash) []byte { return txHash[:] }
+
+func (signingSessionsTy) value(s *SigningSession) []byte {
+	v := make([]byte, 8+len(s.PartialTx))
+	byteOrder.PutUint64(v, uint64(s.Created.Unix()))
+	copy(v[8:], s.PartialTx)
+	return v
+}
+
+func (signingSessionsTy) read(k, v []byte, s *SigningSession) error {
+	if len(k) != chainhash.HashSize {
+		const str = "bad signing session key length"
+		return apperrors.E{ErrorCode: apperrors.ErrData, Description: str, Err: nil}
+	}
+	if len(v) < 8 {
+		const str = "short signing session value"
+		return apperrors.E{ErrorCode: apperrors.ErrData, Description: str, Err: nil}
+	}
+	copy(s.Hash[:], k)
+	s.Created = time.Unix(int64(byteOrder.Uint64(v)), 0)
+	s.PartialTx = append([]byte(nil), v[8:]...)
+	return nil
+}
+
+func (t signingSessionsTy) get(tx walletdb.ReadTx, txHash *chainhash.Hash) []byte {
+	b := tx.ReadBucket(t.rootBucketKey())
+	return b.Get(t.key(txHash))
+}
+
+func (t signingSessionsTy) put(tx walletdb.ReadWriteTx, s *SigningSession) error {
+	b := tx.ReadWriteBucket(t.rootBucketKey())
+	return b.Put(t.key(&s.Hash), t.value(s))
+}
+
+func (t signingSessionsTy) delete(tx walletdb.ReadWriteTx, txHash *chainhash.Hash) error {
+	b := tx.ReadWriteBucket(t.rootBucketKey())
+	return b.Delete(t.key(txHash))
+}
+
+// PutSigningSession saves a signing session, replacing any previous session for
+// the same transaction.
+func PutSigningSession(tx walletdb.ReadWriteTx, s *SigningSession) error {
+	if len(s.PartialTx) == 0 {
+		const str = "signing session has no partially signed transaction"
+		return apperrors.E{ErrorCode: apperrors.ErrInput, Description: str, Err: nil}
+	}
+	err := signingSessions.put(tx, s)
+	if err != nil {
+		const str = "failed to put signing session"
+		return apperrors.E{ErrorCode: apperrors.ErrDatabase, Description: str, Err: err}
+	}
+	return nil
+}
+
+// ExistsSigningSession returns whether a signing session is saved for a
+// transaction.
+func ExistsSigningSession(tx walletdb.ReadTx, txHash *chainhash.Hash) bool {
+	return signingSessions.get(tx, txHash) != nil
+}
+
+// FetchSigningSession returns the signing session of a transaction.
+// ErrValueNoExists is returned if no session is saved for the transaction.
+func FetchSigningSession(tx walletdb.ReadTx, txHash *chainhash.Hash) (*SigningSession, error) {
+	v := signingSessions.get(tx, txHash)
+	if v == nil {
+		const str = "signing session not found"
+		return nil, apperrors.E{ErrorCode: apperrors.ErrValueNoExists, Description: str, Err: nil}
+	}
+	s := new(SigningSession)
+	err := signingSessions.read(signingSessions.key(txHash), v, s)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// DeleteSigningSession removes the signing session of a transaction.
+// ErrValueNoExists is returned if no session is saved for the transaction.
+func DeleteSigningSession(tx walletdb.ReadWriteTx, txHash *chainhash.Hash) error {
+	if signingSessions.get(tx, txHash) == nil {
+		const str = "signing session not found"
+		return apperrors.E{ErrorCode: apperrors.ErrValueNoExists, Description: str, Err: nil}
+	}
+	err := signingSessions.delete(tx, txHash)
+	if err != nil {
+		const str = "failed to delete signing session"
+		return apperrors.E{ErrorCode: apperrors.ErrDatabase, Description: str, Err: err}
+	}
+	return nil
+}
+
+// SigningSessions returns every saved signing session.
+func SigningSessions(tx walletdb.ReadTx) ([]SigningSession, error) {
+	var sessions []SigningSession
+	b := tx.ReadBucket(signingSessions.rootBucketKey())
+	err := b.ForEach(func(k, v []byte) error {
+		var s SigningSession
+		err := signingSessions.read(k, v, &s)
+		if err != nil {
+			return err
+		}
+		sessions = append(sessions, s)
+		return nil
+	})
+	if err != nil {
+		if _, ok := err.(apperrors.E); ok {
+			return nil, err
+		}
+		const str = "failed to read signing sessions"
+		return nil, apperrors.E{ErrorCode: apperrors.ErrDatabase, Description: str, Err: err}
+	}
+	return sessions, nil
+}
@@ -301,6 +301,33 @@ func (s *StakeStore) DumpSSGenHashes(ns walletdb.ReadBucket) ([]chainhash.Hash,
 	return s.dumpSSGenHashes(ns)
 }
 
+// dumpSSGenTickets fetches the entire list of tickets spent as votes by this
+// wallet, including tickets whose votes were never included in the blockchain.
+func (s *StakeStore) dumpSSGenTickets(ns walletdb.ReadBucket) ([]chainhash.Hash, error) {
+	var ticketList []chainhash.Hash
+
+	// Open the vote records database.
+	bucket := ns.NestedReadBucket(ssgenRecordsBucketName)
+
+	// Store each hash sequentially.
+	err := bucket.ForEach(func(k []byte, v []byte) error {
+		ticket, errDeser := chainhash.NewHash(k)
+		if errDeser != nil {
+			return errDeser
+		}
+
+		ticketList = append(ticketList, *ticket)
+		return nil
+	})
+	return ticketList, err
+}
+
+// DumpSSGenTickets is the exported version of dumpSSGenTickets that is safe
+// for concurrent access.
+func (s *StakeStore) DumpSSGenTickets(ns walletdb.ReadBucket) ([]chainhash.Hash, error) {
+	return s.dumpSSGenTickets(ns)
+}
+
 // dumpSSRtxTickets fetches the entire list of tickets spent as revocations
 // byt this wallet.
 func (s *StakeStore) dumpSSRtxTickets(ns walletdb.ReadBucket) ([]chainhash.Hash, error) {
@@ -0,0 +1,216 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"time"
+
+	"github.com/abcsuite/abcd/chaincfg/chainhash"
+	"github.com/abcsuite/abcd/wire"
+	"github.com/abcsuite/abcutil"
+	"github.com/abcsuite/abcwallet/apperrors"
+	"github.com/abcsuite/abcwallet/walletdb"
+)
+
+// SwapRole describes the wallet's role in an atomic swap contract.
+type SwapRole byte
+
+// Swap roles.
+const (
+	// SwapInitiator contracts are funded by the wallet, which created the
+	// secret.
+	SwapInitiator SwapRole = iota
+
+	// SwapParticipant contracts are funded by the wallet in response to a
+	// counterparty's contract, and the secret is unknown until the
+	// counterparty redeems.
+	SwapParticipant
+
+	// SwapCounterparty contracts are funded by the counterparty of a swap
+	// and pay to a wallet key.
+	SwapCounterparty
+)
+
+// SwapContract describes a saved atomic swap contract output.  The contract is
+// the redeem script of the P2SH output.  Secret is nil until it is known, and
+// SpendTx is nil until the contract is redeemed or refunded.  Redeemed records
+// whether the spend revealed the secret.
+type SwapContract struct {
+	OutPoint wire.OutPoint
+	Role     SwapRole
+	Account  uint32
+	Amount   abcutil.Amount
+	Contract []byte
+	Secret   []byte
+	Created  time.Time
+	SpendTx  *chainhash.Hash
+	Redeemed bool
+}
+
+// Swap contracts are saved as k/v pairs in the swap contracts bucket.  The key
+// is the contract outpoint's transaction hash and output index, and the value
+// is serialized as such:
+//
+//   [0]       Role (1 byte)
+//   [1]       Outpoint tree (1 byte)
+//   [2]       Flags (1 byte)
+//   [3:7]     Account (4 bytes)
+//   [7:15]    Amount (8 bytes)
+//   [15:23]   Creation time (8 bytes)
+//   [23:55]   Secret, or zeros if unknown (32 bytes)
+//   [55:87]   Spending transaction hash, or zeros if unspent (32 bytes)
+//   [87:]     Contract script (varies)
+
+const (
+	swapFlagSecret = 1 << iota
+	swapFlagSpent
+	swapFlagRedeemed
+)
+
+const swapContractSecretSize = 32
+
+type swapContractsTy struct {
+}
+
+var swapContracts swapContractsTy
+
+var swapContractsRootBucketKey = []byte("swapcontracts")
+
+func (swapContractsTy) rootBucketKey() []byte { return swapContractsRootBucketKey }
+
+func (swapContractsTy) key(op *wire.OutPoint) []byte {
+	k := make([]byte, 36)
+	copy(k, op.Hash[:])
+	byteOrder.PutUint32(k[32:], op.Index)
+	return k
+}
+
+func (swapContractsTy) value(c *SwapContract) []byte {
+	v := make([]byte, 87+len(c.Contract))
+	v[0] = byte(c.Role)
+	v[1] = byte(c.OutPoint.Tree)
+	byteOrder.PutUint32(v[3:7], c.Account)
+	byteOrder.PutUint64(v[7:15], uint64(c.Amount))
+	byteOrder.PutUint64(v[15:23], uint64(c.Created.Unix()))
+	if c.Secret != nil {
+		v[2] |= swapFlagSecret
+		copy(v[23:55], c.Secret)
+	}
+	if c.SpendTx != nil {
+		v[2] |= swapFlagSpent
+		copy(v[55:87], c.SpendTx[:])
+	}
+	if c.Redeemed {
+		v[2] |= swapFlagRedeemed
+	}
+	copy(v[87:], c.Contract)
+	return v
+}
+
+func (swapContractsTy) read(k, v []byte, c *SwapContract) error {
+	if len(k) != 36 {
+		const str = "bad swap contract key length"
+		return apperrors.E{ErrorCode: apperrors.ErrData, Description: str, Err: nil}
+	}
+	if len(v) < 87 {
+		const str = "short swap contract value"
+		return apperrors.E{ErrorCode: apperrors.ErrData, Description: str, Err: nil}
+	}
+	copy(c.OutPoint.Hash[:], k)
+	c.OutPoint.Index = byteOrder.Uint32(k[32:])
+	c.OutPoint.Tree = int8(v[1])
+	c.Role = SwapRole(v[0])
+	c.Account = byteOrder.Uint32(v[3:7])
+	c.Amount = abcutil.Amount(byteOrder.Uint64(v[7:15]))
+	c.Created = time.Unix(int64(byteOrder.Uint64(v[15:23])), 0)
+	c.Secret = nil
+	if v[2]&swapFlagSecret != 0 {
+		c.Secret = append([]byte(nil), v[23:55]...)
+	}
+	c.SpendTx = nil
+	if v[2]&swapFlagSpent != 0 {
+		c.SpendTx = new(chainhash.Hash)
+		copy(c.SpendTx[:], v[55:87])
+	}
+	c.Redeemed = v[2]&swapFlagRedeemed != 0
+	c.Contract = append([]byte(nil), v[87:]...)
+	return nil
+}
+
+func (t swapContractsTy) get(tx walletdb.ReadTx, op *wire.OutPoint) []byte {
+	b := tx.ReadBucket(t.rootBucketKey())
+	return b.Get(t.key(op))
+}
+
+func (t swapContractsTy) put(tx walletdb.ReadWriteTx, c *SwapContract) error {
+	b := tx.ReadWriteBucket(t.rootBucketKey())
+	return b.Put(t.key(&c.OutPoint), t.value(c))
+}
+
+// PutSwapContract saves a swap contract, replacing any previous contract for
+// the same outpoint.
+func PutSwapContract(tx walletdb.ReadWriteTx, c *SwapContract) error {
+	switch {
+	case c.Role > SwapCounterparty:
+		const str = "unknown swap contract role"
+		return apperrors.E{ErrorCode: apperrors.ErrInput, Description: str, Err: nil}
+	case len(c.Contract) == 0:
+		const str = "swap contract has no script"
+		return apperrors.E{ErrorCode: apperrors.ErrInput, Description: str, Err: nil}
+	case c.Secret != nil && len(c.Secret) != swapContractSecretSize:
+		const str = "swap contract secret has bad length"
+		return apperrors.E{ErrorCode: apperrors.ErrInput, Description: str, Err: nil}
+	}
+	err := swapContracts.put(tx, c)
+	if err != nil {
+		const str = "failed to put swap contract"
+		return apperrors.E{ErrorCode: apperrors.ErrDatabase, Description: str, Err: err}
+	}
+	return nil
+}
+
+// ExistsSwapContract returns whether a swap contract is saved for an outpoint.
+func ExistsSwapContract(tx walletdb.ReadTx, op *wire.OutPoint) bool {
+	return swapContracts.get(tx, op) != nil
+}
+
+// FetchSwapContract returns the swap contract of an outpoint.
+// ErrValueNoExists is returned if no contract is saved for the outpoint.
+func FetchSwapContract(tx walletdb.ReadTx, op *wire.OutPoint) (*SwapContract, error) {
+	v := swapContracts.get(tx, op)
+	if v == nil {
+		const str = "swap contract not found"
+		return nil, apperrors.E{ErrorCode: apperrors.ErrValueNoExists, Description: str, Err: nil}
+	}
+	c := new(SwapContract)
+	err := swapContracts.read(swapContracts.key(op), v, c)
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// SwapContracts returns every saved swap contract.
+func SwapContracts(tx walletdb.ReadTx) ([]SwapContract, error) {
+	var contracts []SwapContract
+	b := tx.ReadBucket(swapContracts.rootBucketKey())
+	err := b.ForEach(func(k, v []byte) error {
+		var c SwapContract
+		err := swapContracts.read(k, v, &c)
+		if err != nil {
+			return err
+		}
+		contracts = append(contracts, c)
+		return nil
+	})
+	if err != nil {
+		if _, ok := err.(apperrors.E); ok {
+			return nil, err
+		}
+		const str = "failed to read swap contracts"
+		return nil, apperrors.E{ErrorCode: apperrors.ErrDatabase, Description: str, Err: err}
+	}
+	return contracts, nil
+}
@@ -143,6 +143,11 @@ var (
 	bucketMultisigUsp             = []byte("mu")
 	bucketStakeInvalidatedCredits = []byte("ic")
 	bucketStakeInvalidatedDebits  = []byte("id")
+	bucketBalanceTotals           = []byte("bt")
+	bucketBalanceOutputs          = []byte("bo")
+	bucketBalanceScan             = []byte("bs")
+	bucketAddrTxs                 = []byte("at")
+	bucketTxAddrs                 = []byte("ta")
 )
 
 // Root (namespace) bucket keys
@@ -1044,12 +1049,7 @@ func valueUnspent(block *Block) []byte {
 func putUnspent(ns walletdb.ReadWriteBucket, outPoint *wire.OutPoint, block *Block) error {
 	k := canonicalOutPoint(&outPoint.Hash, outPoint.Index)
 	v := valueUnspent(block)
-	err := ns.NestedReadWriteBucket(bucketUnspent).Put(k, v)
-	if err != nil {
-		str := "cannot put unspent"
-		return storeError(apperrors.ErrDatabase, str, err)
-	}
-	return nil
+	return putRawUnspent(ns, k, v)
 }
 
 func putRawUnspent(ns walletdb.ReadWriteBucket, k, v []byte) error {
@@ -1058,7 +1058,7 @@ func putRawUnspent(ns walletdb.ReadWriteBucket, k, v []byte) error {
 		str := "cannot put unspent"
 		return storeError(apperrors.ErrDatabase, str, err)
 	}
-	return nil
+	return indexUnspent(ns, k, v)
 }
 
 func readUnspentBlock(v []byte, block *Block) error {
@@ -1103,7 +1103,7 @@ func deleteRawUnspent(ns walletdb.ReadWriteBucket, k []byte) error {
 		str := "failed to delete unspent"
 		return storeError(apperrors.ErrDatabase, str, err)
 	}
-	return nil
+	return unindexOutput(ns, k, true)
 }
 
 // All transaction debits (inputs which spend credits) are keyed as such:
@@ -1387,7 +1387,7 @@ func putRawUnminedCredit(ns walletdb.ReadWriteBucket, k, v []byte) error {
 		str := "cannot put unmined credit"
 		return storeError(apperrors.ErrDatabase, str, err)
 	}
-	return nil
+	return indexUnminedCredit(ns, k, v)
 }
 
 func fetchRawUnminedCreditIndex(k []byte) (uint32, error) {
@@ -1470,7 +1470,7 @@ func deleteRawUnminedCredit(ns walletdb.ReadWriteBucket, k []byte) error {
 		str := "failed to delete unmined credit"
 		return storeError(apperrors.ErrDatabase, str, err)
 	}
-	return nil
+	return unindexOutput(ns, k, false)
 }
 
 // unminedCreditIterator allows for cursor iteration over all credits, in order,
@@ -1594,7 +1594,7 @@ func putRawUnminedInput(ns walletdb.ReadWriteBucket, k, v []byte) error {
 		str := "failed to put unmined input"
 		return storeError(apperrors.ErrDatabase, str, err)
 	}
-	return nil
+	return excludeOutput(ns, k, true)
 }
 
 func existsRawUnminedInput(ns walletdb.ReadBucket, k []byte) (v []byte) {
@@ -1607,7 +1607,7 @@ func deleteRawUnminedInput(ns walletdb.ReadWriteBucket, k []byte) error {
 		str := "failed to delete unmined input"
 		return storeError(apperrors.ErrDatabase, str, err)
 	}
-	return nil
+	return excludeOutput(ns, k, false)
 }
 
 // Tx scripts are stored as the raw serialized script. The key in the database
@@ -1964,6 +1964,20 @@ func createStore(ns walletdb.ReadWriteBucket, chainParams *chaincfg.Params) erro
 		str := "failed to create invalidated debits bucket"
 		return storeError(apperrors.ErrDatabase, str, err)
 	}
+	err = rebuildBalanceIndex(ns)
+	if err != nil {
+		return err
+	}
+	_, err = ns.CreateBucket(bucketAddrTxs)
+	if err != nil {
+		str := "failed to create address transactions bucket"
+		return storeError(apperrors.ErrDatabase, str, err)
+	}
+	_, err = ns.CreateBucket(bucketTxAddrs)
+	if err != nil {
+		str := "failed to create transaction addresses bucket"
+		return storeError(apperrors.ErrDatabase, str, err)
+	}
 
 	// Insert the genesis block header.
 	var serializedGenesisBlock RawBlockHeader
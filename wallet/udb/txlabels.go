@@ -0,0 +1,120 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/abcsuite/abcd/chaincfg/chainhash"
+	"github.com/abcsuite/abcwallet/apperrors"
+	"github.com/abcsuite/abcwallet/walletdb"
+)
+
+// MaxTxLabelLen is the maximum length in bytes of a transaction label.
+const MaxTxLabelLen = 500
+
+// TxLabel describes a user-provided label, such as a memo or note, that is
+// attached to a transaction.
+type TxLabel struct {
+	Hash  chainhash.Hash
+	Label string
+}
+
+// Transaction labels are saved as k/v pairs in the transaction labels bucket.
+// The key is the transaction hash and the value is the UTF-8 label.
+
+type txLabelsTy struct {
+}
+
+var txLabels txLabelsTy
+
+var txLabelsRootBucketKey = []byte("txlabels")
+
+func (txLabelsTy) rootBucketKey() []byte { return txLabelsRootBucketKey }
+
+func (txLabelsTy) key(txHash *chainhash.Hash) []byte { return txHash[:] }
+
+func (t txLabelsTy) get(tx walletdb.ReadTx, txHash *chainhash.Hash) string {
+	b := tx.ReadBucket(t.rootBucketKey())
+	return string(b.Get(t.key(txHash)))
+}
+
+func (t txLabelsTy) put(tx walletdb.ReadWriteTx, txHash *chainhash.Hash, label string) error {
+	b := tx.ReadWriteBucket(t.rootBucketKey())
+	return b.Put(t.key(txHash), []byte(label))
+}
+
+func (t txLabelsTy) delete(tx walletdb.ReadWriteTx, txHash *chainhash.Hash) error {
+	b := tx.ReadWriteBucket(t.rootBucketKey())
+	return b.Delete(t.key(txHash))
+}
+
+func (t txLabelsTy) forEach(tx walletdb.ReadTx, f func(*TxLabel) error) error {
+	b := tx.ReadBucket(t.rootBucketKey())
+	return b.ForEach(func(k, v []byte) error {
+		if len(k) != chainhash.HashSize {
+			const str = "bad transaction label key length"
+			return apperrors.E{ErrorCode: apperrors.ErrData, Description: str, Err: nil}
+		}
+		var l TxLabel
+		copy(l.Hash[:], k)
+		l.Label = string(v)
+		return f(&l)
+	})
+}
+
+// PutTxLabel saves the label of a transaction, replacing any previous label.
+// Setting an empty label removes the label from the transaction.  Labels must
+// be valid UTF-8 and may not exceed MaxTxLabelLen bytes.
+func PutTxLabel(tx walletdb.ReadWriteTx, txHash *chainhash.Hash, label string) error {
+	if len(label) > MaxTxLabelLen {
+		const str = "transaction label exceeds maximum length"
+		return apperrors.E{ErrorCode: apperrors.ErrInput, Description: str, Err: nil}
+	}
+	if !utf8.ValidString(label) {
+		const str = "transaction label is not valid UTF-8"
+		return apperrors.E{ErrorCode: apperrors.ErrInput, Description: str, Err: nil}
+	}
+
+	var err error
+	if label == "" {
+		err = txLabels.delete(tx, txHash)
+	} else {
+		err = txLabels.put(tx, txHash, label)
+	}
+	if err != nil {
+		const str = "failed to put transaction label"
+		return apperrors.E{ErrorCode: apperrors.ErrDatabase, Description: str, Err: err}
+	}
+	return nil
+}
+
+// FetchTxLabel returns the label of a transaction, or the empty string if the
+// transaction is not labeled.
+func FetchTxLabel(tx walletdb.ReadTx, txHash *chainhash.Hash) string {
+	return txLabels.get(tx, txHash)
+}
+
+// SearchTxLabels returns all transaction labels which contain query as a
+// case-insensitive substring.  An empty query matches every label.
+func SearchTxLabels(tx walletdb.ReadTx, query string) ([]TxLabel, error) {
+	query = strings.ToLower(query)
+	var labels []TxLabel
+	err := txLabels.forEach(tx, func(l *TxLabel) error {
+		if strings.Contains(strings.ToLower(l.Label), query) {
+			labels = append(labels, *l)
+		}
+		return nil
+	})
+	if err != nil {
+		if _, ok := err.(apperrors.E); ok {
+			return nil, err
+		}
+		const str = "failed to search transaction labels"
+		return nil, apperrors.E{ErrorCode: apperrors.ErrDatabase, Description: str, Err: err}
+	}
+	return labels, nil
+}
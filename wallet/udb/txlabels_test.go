@@ -0,0 +1,100 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/abcsuite/abcwallet/apperrors"
+	"github.com/abcsuite/abcwallet/walletdb"
+)
+
+func TestTxLabels(t *testing.T) {
+	db, _, teardown, err := setup()
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	invoice := decodeHash("2b29213f06354455c235021e541604607ed738b1bc6217f2fe3ae5bffbfe1218")
+	payroll := decodeHash("4d97e8bbd2ce0b8ddc6c03d5a2e16d8f73c2b8a5e9f2e4c7b1a3e69b00c0f1a2")
+
+	err = walletdb.Update(db, func(dbtx walletdb.ReadWriteTx) error {
+		_, err := dbtx.CreateTopLevelBucket(txLabels.rootBucketKey())
+		if err != nil {
+			return err
+		}
+		err = PutTxLabel(dbtx, invoice, "Invoice #1234")
+		if err != nil {
+			return err
+		}
+		err = PutTxLabel(dbtx, payroll, "payroll")
+		if err != nil {
+			return err
+		}
+
+		err = PutTxLabel(dbtx, payroll, strings.Repeat("x", MaxTxLabelLen+1))
+		if !apperrors.IsError(err, apperrors.ErrInput) {
+			t.Errorf("long label: expected ErrInput, got %v", err)
+		}
+		err = PutTxLabel(dbtx, payroll, "\xff")
+		if !apperrors.IsError(err, apperrors.ErrInput) {
+			t.Errorf("invalid UTF-8 label: expected ErrInput, got %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = walletdb.View(db, func(dbtx walletdb.ReadTx) error {
+		if l := FetchTxLabel(dbtx, invoice); l != "Invoice #1234" {
+			t.Errorf("got label %q, want %q", l, "Invoice #1234")
+		}
+		if l := FetchTxLabel(dbtx, payroll); l != "payroll" {
+			t.Errorf("got label %q, want %q", l, "payroll")
+		}
+
+		tests := []struct {
+			query   string
+			matches int
+		}{
+			{"", 2},
+			{"INVOICE", 1},
+			{"roll", 1},
+			{"#", 1},
+			{"missing", 0},
+		}
+		for _, test := range tests {
+			labels, err := SearchTxLabels(dbtx, test.query)
+			if err != nil {
+				return err
+			}
+			if len(labels) != test.matches {
+				t.Errorf("search %q: got %d matches, want %d", test.query,
+					len(labels), test.matches)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = walletdb.Update(db, func(dbtx walletdb.ReadWriteTx) error {
+		err := PutTxLabel(dbtx, invoice, "")
+		if err != nil {
+			return err
+		}
+		if l := FetchTxLabel(dbtx, invoice); l != "" {
+			t.Errorf("cleared label still exists: %q", l)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
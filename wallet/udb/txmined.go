@@ -350,6 +350,10 @@ func (s *Store) PruneOldTickets(ns walletdb.ReadWriteBucket) error {
 		}
 
 		// Delete the transaction record itself.
+		err = removeAddrTxs(ns, &rec.Hash)
+		if err != nil {
+			return err
+		}
 		err = deleteRawUnmined(ns, rec.Hash[:])
 		if err != nil {
 			return err
@@ -1030,8 +1034,11 @@ func (s *Store) GetMainChainBlockHashes(ns walletdb.ReadBucket, startHash *chain
 }
 
 // PruneUnconfirmed prunes old stake tickets that are below the current stake
-// difficulty or any unconfirmed transaction which is expired.
-func (s *Store) PruneUnconfirmed(ns walletdb.ReadWriteBucket, height int32, stakeDiff int64) error {
+// difficulty or any unconfirmed transaction which is expired.  Removing a
+// transaction releases the previous outputs it spent, and also removes any
+// unconfirmed transactions which spend its outputs.  The hashes of all removed
+// transactions, including removed spenders, are returned.
+func (s *Store) PruneUnconfirmed(ns walletdb.ReadWriteBucket, height int32, stakeDiff int64) ([]*chainhash.Hash, error) {
 	var unconfTxRs []*TxRecord
 	var uTxRstoRemove []*TxRecord
 
@@ -1057,7 +1064,7 @@ func (s *Store) PruneUnconfirmed(ns walletdb.ReadWriteBucket, height int32, stak
 		return nil
 	})
 	if errDb != nil {
-		return errDb
+		return nil, errDb
 	}
 
 	for _, uTxR := range unconfTxRs {
@@ -1067,6 +1074,7 @@ func (s *Store) PruneUnconfirmed(ns walletdb.ReadWriteBucket, height int32, stak
 			log.Debugf("Tagging expired tx %v for removal (expiry %v, "+
 				"height %v)", uTxR.Hash, uTxR.MsgTx.Expiry, height)
 			uTxRstoRemove = append(uTxRstoRemove, uTxR)
+			continue
 		}
 
 		// Tag all stake tickets which are below
@@ -1082,12 +1090,26 @@ func (s *Store) PruneUnconfirmed(ns walletdb.ReadWriteBucket, height int32, stak
 	}
 
 	for _, uTxR := range uTxRstoRemove {
+		// Skip transactions already removed as the spender of another
+		// pruned transaction.
+		if existsRawUnmined(ns, uTxR.Hash[:]) == nil {
+			continue
+		}
 		errLocal := s.removeUnconfirmed(ns, uTxR)
 		if errLocal != nil {
-			return errLocal
+			return nil, errLocal
 		}
 	}
-	return nil
+
+	// Spenders are removed recursively, so determine every removed
+	// transaction by checking which previously unmined transactions remain.
+	var pruned []*chainhash.Hash
+	for _, uTxR := range unconfTxRs {
+		if existsRawUnmined(ns, uTxR.Hash[:]) == nil {
+			pruned = append(pruned, &uTxR.Hash)
+		}
+	}
+	return pruned, nil
 }
 
 // fetchAccountForPkScript fetches an account for a given pkScript given a
@@ -1359,6 +1381,7 @@ func (s *Store) InsertMinedTx(ns walletdb.ReadWriteBucket, addrmgrNs walletdb.Re
 		invalidated = extractRawBlockRecordStakeInvalid(rawBlockRecVal)
 	}
 
+	var addrIDs [][]byte
 	for i, input := range rec.MsgTx.TxIn {
 		unspentKey, credKey := existsUnspent(ns, &input.PreviousOutPoint)
 		if credKey == nil {
@@ -1381,6 +1404,8 @@ func (s *Store) InsertMinedTx(ns walletdb.ReadWriteBucket, addrmgrNs walletdb.Re
 			continue
 		}
 
+		addrIDs = append(addrIDs, s.spentOutputAddrIDs(ns, unspentKey)...)
+
 		if invalidated {
 			// Add an invalidated debit but do not spend the previous credit,
 			// remove it from the utxo set, or decrement the mined balance.
@@ -1430,6 +1455,13 @@ func (s *Store) InsertMinedTx(ns walletdb.ReadWriteBucket, addrmgrNs walletdb.Re
 		return nil
 	}
 
+	// Index the addresses of the spent credits.  This also moves the index
+	// entries of the transaction to the block if it was previously unmined.
+	err = addAddrTxs(ns, &rec.Hash, &block.Block, addrIDs)
+	if err != nil {
+		return err
+	}
+
 	// If a transaction record for this tx hash and block already exist,
 	// there is nothing left to do.
 	k, v := existsTxRecord(ns, &rec.Hash, &block.Block)
@@ -1520,7 +1552,8 @@ func (s *Store) AddCredit(ns walletdb.ReadWriteBucket, rec *TxRecord, block *Blo
 			const str = "failed to write invalidated credit"
 			return storeError(apperrors.ErrDatabase, str, err)
 		}
-		return nil
+		return addAddrTxs(ns, &rec.Hash, &block.Block,
+			addrTxIDs(pkScript, s.chainParams))
 	}
 
 	_, err := s.addCredit(ns, rec, block, index, change, account)
@@ -1606,7 +1639,12 @@ func (s *Store) addCredit(ns walletdb.ReadWriteBucket, rec *TxRecord, block *Blo
 		v := valueUnminedCredit(abcutil.Amount(rec.MsgTx.TxOut[index].Value),
 			change, opCode, isCoinbase, scrType, uint32(scrLoc),
 			uint32(scrLen), account)
-		return true, putRawUnminedCredit(ns, k, v)
+		err := putRawUnminedCredit(ns, k, v)
+		if err != nil {
+			return false, err
+		}
+		addrIDs := addrTxIDs(rec.MsgTx.TxOut[index].PkScript, s.chainParams)
+		return true, addAddrTxs(ns, &rec.Hash, nil, addrIDs)
 	}
 
 	k, v := existsCredit(ns, &rec.Hash, index, &block.Block)
@@ -1654,7 +1692,12 @@ func (s *Store) addCredit(ns walletdb.ReadWriteBucket, rec *TxRecord, block *Blo
 		}
 	}
 
-	return true, putUnspent(ns, &cred.outPoint, &block.Block)
+	err = putUnspent(ns, &cred.outPoint, &block.Block)
+	if err != nil {
+		return false, err
+	}
+	addrIDs := addrTxIDs(rec.MsgTx.TxOut[index].PkScript, s.chainParams)
+	return true, addAddrTxs(ns, &rec.Hash, &block.Block, addrIDs)
 }
 
 // AddMultisigOut adds a P2SH multisignature spendable output into the
@@ -1932,6 +1975,10 @@ func (s *Store) rollback(ns walletdb.ReadWriteBucket, addrmgrNs walletdb.ReadBuc
 					}
 				}
 
+				err = removeAddrTxs(ns, txHash)
+				if err != nil {
+					return err
+				}
 				continue
 			}
 
@@ -1939,6 +1986,10 @@ func (s *Store) rollback(ns walletdb.ReadWriteBucket, addrmgrNs walletdb.ReadBuc
 			if err != nil {
 				return err
 			}
+			err = relocateAddrTxs(ns, txHash, nil)
+			if err != nil {
+				return err
+			}
 
 			txType := stake.DetermineTxType(&rec.MsgTx)
 
@@ -3185,6 +3236,188 @@ func (s *InputSource) SelectInputs(target abcutil.Amount) (abcutil.Amount, []*wi
 	return s.source(target)
 }
 
+// InputCandidate describes an unspent output which is eligible to be redeemed
+// by a transaction input.
+type InputCandidate struct {
+	Input    *wire.TxIn
+	Amount   abcutil.Amount
+	PkScript []byte
+
+	// Height is the height of the block which mined the transaction
+	// creating the output, or -1 if the transaction is unmined.
+	Height int32
+}
+
+// minedInputCandidate returns the mined unspent output k/v as an input
+// candidate if it is controlled by the account and may be spent under the
+// minConf and syncHeight policy, or nil if it is not eligible.
+func (s *Store) minedInputCandidate(ns, addrmgrNs walletdb.ReadBucket, k, v []byte,
+	account uint32, minConf, syncHeight int32) (*InputCandidate, error) {
+
+	if existsRawUnminedInput(ns, k) != nil {
+		// Output is spent by an unmined transaction.
+		return nil, nil
+	}
+
+	cKey := make([]byte, 72)
+	copy(cKey[0:32], k[0:32])   // Tx hash
+	copy(cKey[32:36], v[0:4])   // Block height
+	copy(cKey[36:68], v[4:36])  // Block hash
+	copy(cKey[68:72], k[32:36]) // Output index
+
+	cVal := existsRawCredit(ns, cKey)
+
+	// Check the account first.
+	pkScript, err := s.fastCreditPkScriptLookup(ns, cKey, nil)
+	if err != nil {
+		return nil, err
+	}
+	thisAcct, err := s.fetchAccountForPkScript(addrmgrNs, cVal, nil, pkScript)
+	if err != nil {
+		return nil, err
+	}
+	if account != thisAcct {
+		return nil, nil
+	}
+
+	amt, spent, err := fetchRawCreditAmountSpent(cVal)
+	if err != nil {
+		return nil, err
+	}
+
+	// This should never happen since this is already in bucket
+	// unspent, but let's be careful anyway.
+	if spent {
+		return nil, nil
+	}
+
+	// Skip zero value outputs.
+	if amt == 0 {
+		return nil, nil
+	}
+
+	// Skip ticket outputs, as only SSGen can spend these.
+	opcode := fetchRawCreditTagOpCode(cVal)
+	if opcode == txscript.OP_SSTX {
+		return nil, nil
+	}
+
+	// Only include this output if it meets the required number of
+	// confirmations.  Coinbase transactions must have have reached
+	// maturity before their outputs may be spent.
+	txHeight := extractRawCreditHeight(cKey)
+	if !confirmed(minConf, txHeight, syncHeight) {
+		return nil, nil
+	}
+
+	// Skip outputs that are not mature.
+	if opcode == OP_NONSTAKE && fetchRawCreditIsCoinbase(cVal) {
+		if !confirmed(int32(s.chainParams.CoinbaseMaturity), txHeight,
+			syncHeight) {
+			return nil, nil
+		}
+	}
+	if opcode == txscript.OP_SSGEN || opcode == txscript.OP_SSRTX {
+		if !confirmed(int32(s.chainParams.CoinbaseMaturity), txHeight,
+			syncHeight) {
+			return nil, nil
+		}
+	}
+	if opcode == txscript.OP_SSTXCHANGE {
+		if !confirmed(int32(s.chainParams.SStxChangeMaturity), txHeight,
+			syncHeight) {
+			return nil, nil
+		}
+	}
+
+	// Determine the txtree for the outpoint by whether or not it's
+	// using stake tagged outputs.
+	tree := wire.TxTreeRegular
+	if opcode != OP_NONSTAKE {
+		tree = wire.TxTreeStake
+	}
+
+	var op wire.OutPoint
+	err = readCanonicalOutPoint(k, &op)
+	if err != nil {
+		return nil, err
+	}
+	op.Tree = tree
+
+	return &InputCandidate{
+		Input:    wire.NewTxIn(&op, nil),
+		Amount:   amt,
+		PkScript: pkScript,
+		Height:   txHeight,
+	}, nil
+}
+
+// unminedInputCandidate returns the unmined credit k/v as an input candidate
+// if it is controlled by the account and may be spent, or nil if it is not
+// eligible.
+func (s *Store) unminedInputCandidate(ns, addrmgrNs walletdb.ReadBucket, k, v []byte,
+	account uint32) (*InputCandidate, error) {
+
+	// Make sure this output was not spent by an unmined transaction.
+	// If it was, skip this credit.
+	if existsRawUnminedInput(ns, k) != nil {
+		return nil, nil
+	}
+
+	// Check the account first.
+	pkScript, err := s.fastCreditPkScriptLookup(ns, nil, k)
+	if err != nil {
+		return nil, err
+	}
+	thisAcct, err := s.fetchAccountForPkScript(addrmgrNs, nil, v, pkScript)
+	if err != nil {
+		return nil, err
+	}
+	if account != thisAcct {
+		return nil, nil
+	}
+
+	amt, err := fetchRawUnminedCreditAmount(v)
+	if err != nil {
+		return nil, err
+	}
+
+	// Skip ticket outputs, as only SSGen can spend these.
+	opcode := fetchRawUnminedCreditTagOpcode(v)
+	if opcode == txscript.OP_SSTX {
+		return nil, nil
+	}
+
+	// Skip outputs that are not mature.
+	if opcode == txscript.OP_SSGEN || opcode == txscript.OP_SSRTX {
+		return nil, nil
+	}
+	if opcode == txscript.OP_SSTXCHANGE {
+		return nil, nil
+	}
+
+	// Determine the txtree for the outpoint by whether or not it's
+	// using stake tagged outputs.
+	tree := wire.TxTreeRegular
+	if opcode != OP_NONSTAKE {
+		tree = wire.TxTreeStake
+	}
+
+	var op wire.OutPoint
+	err = readCanonicalOutPoint(k, &op)
+	if err != nil {
+		return nil, err
+	}
+	op.Tree = tree
+
+	return &InputCandidate{
+		Input:    wire.NewTxIn(&op, nil),
+		Amount:   amt,
+		PkScript: pkScript,
+		Height:   -1,
+	}, nil
+}
+
 // MakeInputSource creates an InputSource to redeem unspent outputs from an
 // account.  The minConf and syncHeight parameters are used to filter outputs
 // based on some spendable policy.
@@ -3222,102 +3455,19 @@ func (s *Store) MakeInputSource(ns, addrmgrNs walletdb.ReadBucket, account uint3
 			if k == nil || v == nil {
 				break
 			}
-			if existsRawUnminedInput(ns, k) != nil {
-				// Output is spent by an unmined transaction.
-				// Skip to next unmined credit.
-				continue
-			}
-
-			cKey := make([]byte, 72)
-			copy(cKey[0:32], k[0:32])   // Tx hash
-			copy(cKey[32:36], v[0:4])   // Block height
-			copy(cKey[36:68], v[4:36])  // Block hash
-			copy(cKey[68:72], k[32:36]) // Output index
-
-			cVal := existsRawCredit(ns, cKey)
 
-			// Check the account first.
-			pkScript, err := s.fastCreditPkScriptLookup(ns, cKey, nil)
-			if err != nil {
-				return 0, nil, nil, err
-			}
-			thisAcct, err := s.fetchAccountForPkScript(addrmgrNs, cVal, nil, pkScript)
+			c, err := s.minedInputCandidate(ns, addrmgrNs, k, v, account,
+				minConf, syncHeight)
 			if err != nil {
 				return 0, nil, nil, err
 			}
-			if account != thisAcct {
+			if c == nil {
 				continue
 			}
 
-			amt, spent, err := fetchRawCreditAmountSpent(cVal)
-			if err != nil {
-				return 0, nil, nil, err
-			}
-
-			// This should never happen since this is already in bucket
-			// unspent, but let's be careful anyway.
-			if spent {
-				continue
-			}
-
-			// Skip zero value outputs.
-			if amt == 0 {
-				continue
-			}
-
-			// Skip ticket outputs, as only SSGen can spend these.
-			opcode := fetchRawCreditTagOpCode(cVal)
-			if opcode == txscript.OP_SSTX {
-				continue
-			}
-
-			// Only include this output if it meets the required number of
-			// confirmations.  Coinbase transactions must have have reached
-			// maturity before their outputs may be spent.
-			txHeight := extractRawCreditHeight(cKey)
-			if !confirmed(minConf, txHeight, syncHeight) {
-				continue
-			}
-
-			// Skip outputs that are not mature.
-			if opcode == OP_NONSTAKE && fetchRawCreditIsCoinbase(cVal) {
-				if !confirmed(int32(s.chainParams.CoinbaseMaturity), txHeight,
-					syncHeight) {
-					continue
-				}
-			}
-			if opcode == txscript.OP_SSGEN || opcode == txscript.OP_SSRTX {
-				if !confirmed(int32(s.chainParams.CoinbaseMaturity), txHeight,
-					syncHeight) {
-					continue
-				}
-			}
-			if opcode == txscript.OP_SSTXCHANGE {
-				if !confirmed(int32(s.chainParams.SStxChangeMaturity), txHeight,
-					syncHeight) {
-					continue
-				}
-			}
-
-			// Determine the txtree for the outpoint by whether or not it's
-			// using stake tagged outputs.
-			tree := wire.TxTreeRegular
-			if opcode != OP_NONSTAKE {
-				tree = wire.TxTreeStake
-			}
-
-			var op wire.OutPoint
-			err = readCanonicalOutPoint(k, &op)
-			if err != nil {
-				return 0, nil, nil, err
-			}
-			op.Tree = tree
-
-			input := wire.NewTxIn(&op, nil)
-
-			currentTotal += amt
-			currentInputs = append(currentInputs, input)
-			currentScripts = append(currentScripts, pkScript)
+			currentTotal += c.Amount
+			currentInputs = append(currentInputs, c.Input)
+			currentScripts = append(currentScripts, c.PkScript)
 		}
 
 		// Return the current results if the target amount was reached
@@ -3341,63 +3491,17 @@ func (s *Store) MakeInputSource(ns, addrmgrNs walletdb.ReadBucket, account uint3
 				break
 			}
 
-			// Make sure this output was not spent by an unmined transaction.
-			// If it was, skip this credit.
-			if existsRawUnminedInput(ns, k) != nil {
-				continue
-			}
-
-			// Check the account first.
-			pkScript, err := s.fastCreditPkScriptLookup(ns, nil, k)
-			if err != nil {
-				return 0, nil, nil, err
-			}
-			thisAcct, err := s.fetchAccountForPkScript(addrmgrNs, nil, v, pkScript)
+			c, err := s.unminedInputCandidate(ns, addrmgrNs, k, v, account)
 			if err != nil {
 				return 0, nil, nil, err
 			}
-			if account != thisAcct {
+			if c == nil {
 				continue
 			}
 
-			amt, err := fetchRawUnminedCreditAmount(v)
-			if err != nil {
-				return 0, nil, nil, err
-			}
-
-			// Skip ticket outputs, as only SSGen can spend these.
-			opcode := fetchRawUnminedCreditTagOpcode(v)
-			if opcode == txscript.OP_SSTX {
-				continue
-			}
-
-			// Skip outputs that are not mature.
-			if opcode == txscript.OP_SSGEN || opcode == txscript.OP_SSRTX {
-				continue
-			}
-			if opcode == txscript.OP_SSTXCHANGE {
-				continue
-			}
-
-			// Determine the txtree for the outpoint by whether or not it's
-			// using stake tagged outputs.
-			tree := wire.TxTreeRegular
-			if opcode != OP_NONSTAKE {
-				tree = wire.TxTreeStake
-			}
-
-			var op wire.OutPoint
-			err = readCanonicalOutPoint(k, &op)
-			if err != nil {
-				return 0, nil, nil, err
-			}
-			op.Tree = tree
-
-			input := wire.NewTxIn(&op, nil)
-
-			currentTotal += amt
-			currentInputs = append(currentInputs, input)
-			currentScripts = append(currentScripts, pkScript)
+			currentTotal += c.Amount
+			currentInputs = append(currentInputs, c.Input)
+			currentScripts = append(currentScripts, c.PkScript)
 		}
 		return currentTotal, currentInputs, currentScripts, nil
 	}
@@ -3405,214 +3509,272 @@ func (s *Store) MakeInputSource(ns, addrmgrNs walletdb.ReadBucket, account uint3
 	return InputSource{source: f}
 }
 
-// balanceFullScan does a fullscan of the UTXO set to get the current balance.
-// It is less efficient than the other balance functions, but works fine for
-// accounts.
-func (s *Store) balanceFullScan(ns, addrmgrNs walletdb.ReadBucket, minConf int32,
-	syncHeight int32) (map[uint32]*Balances, error) {
+// InputCandidates returns every unspent output of an account which may be
+// redeemed under the same spendable policy used by MakeInputSource.  Unlike an
+// InputSource, this allows callers to choose inputs using their own coin
+// selection strategy.
+func (s *Store) InputCandidates(ns, addrmgrNs walletdb.ReadBucket, account uint32,
+	minConf, syncHeight int32) ([]InputCandidate, error) {
 
-	accountBalances := make(map[uint32]*Balances)
+	var candidates []InputCandidate
 	err := ns.NestedReadBucket(bucketUnspent).ForEach(func(k, v []byte) error {
-		if existsRawUnminedInput(ns, k) != nil {
-			// Output is spent by an unmined transaction.
-			// Skip to next unmined credit.
-			return nil
-		}
-
-		cKey := make([]byte, 72)
-		copy(cKey[0:32], k[0:32])   // Tx hash
-		copy(cKey[32:36], v[0:4])   // Block height
-		copy(cKey[36:68], v[4:36])  // Block hash
-		copy(cKey[68:72], k[32:36]) // Output index
-
-		// Skip unmined credits.
-		cVal := existsRawCredit(ns, cKey)
-		if cVal == nil {
-			return fmt.Errorf("couldn't find a credit for unspent txo")
-		}
-
-		// Check the account first.
-		pkScript, err := s.fastCreditPkScriptLookup(ns, cKey, nil)
-		if err != nil {
-			return err
-		}
-		thisAcct, err := s.fetchAccountForPkScript(addrmgrNs, cVal, nil, pkScript)
-		if err != nil {
+		c, err := s.minedInputCandidate(ns, addrmgrNs, k, v, account,
+			minConf, syncHeight)
+		if err != nil || c == nil {
 			return err
 		}
+		candidates = append(candidates, *c)
+		return nil
+	})
+	if err != nil {
+		str := "failed iterating unspent outputs"
+		return nil, storeError(apperrors.ErrDatabase, str, err)
+	}
+	if minConf != 0 {
+		return candidates, nil
+	}
 
-		utxoAmt, err := fetchRawCreditAmount(cVal)
-		if err != nil {
+	err = ns.NestedReadBucket(bucketUnminedCredits).ForEach(func(k, v []byte) error {
+		c, err := s.unminedInputCandidate(ns, addrmgrNs, k, v, account)
+		if err != nil || c == nil {
 			return err
 		}
+		candidates = append(candidates, *c)
+		return nil
+	})
+	if err != nil {
+		str := "failed iterating unmined credits"
+		return nil, storeError(apperrors.ErrDatabase, str, err)
+	}
+	return candidates, nil
+}
 
-		height := extractRawCreditHeight(cKey)
-		opcode := fetchRawCreditTagOpCode(cVal)
+// balanceFullScan does a fullscan of the UTXO set to get the current balance.
+// It is much less efficient than balanceIndexed and is only used to verify the
+// balance index.
+func (s *Store) balanceFullScan(ns, addrmgrNs walletdb.ReadBucket, minConf int32,
+	syncHeight int32) (map[uint32]*Balances, error) {
 
-		ab, ok := accountBalances[thisAcct]
-		if !ok {
-			ab = &Balances{
-				Account: thisAcct,
-				Total:   utxoAmt,
-			}
-			accountBalances[thisAcct] = ab
-		} else {
-			ab.Total += utxoAmt
-		}
+	accountBalances := make(map[uint32]*Balances)
+	err := ns.NestedReadBucket(bucketUnspent).ForEach(func(k, v []byte) error {
+		return s.addMinedCreditBalance(ns, addrmgrNs, accountBalances, k, v,
+			minConf, syncHeight)
+	})
+	if err != nil {
+		str := "failed iterating mined credits bucket for fullscan balance"
+		return nil, storeError(apperrors.ErrDatabase, str, err)
+	}
 
-		switch opcode {
-		case OP_NONSTAKE:
-			isConfirmed := confirmed(minConf, height, syncHeight)
-			creditFromCoinbase := fetchRawCreditIsCoinbase(cVal)
-			matureCoinbase := (creditFromCoinbase &&
-				confirmed(int32(s.chainParams.CoinbaseMaturity),
-					height,
-					syncHeight))
+	// Unconfirmed transaction output handling.
+	err = ns.NestedReadBucket(bucketUnminedCredits).ForEach(func(k, v []byte) error {
+		return s.addUnminedCreditBalance(ns, addrmgrNs, accountBalances, k, v,
+			minConf)
+	})
+	if err != nil {
+		str := "failed iterating unmined credits bucket for fullscan balance"
+		return nil, storeError(apperrors.ErrDatabase, str, err)
+	}
 
-			if (isConfirmed && !creditFromCoinbase) ||
-				matureCoinbase {
-				ab.Spendable += utxoAmt
-			} else if creditFromCoinbase && !matureCoinbase {
-				ab.ImmatureCoinbaseRewards += utxoAmt
-			}
+	return accountBalances, nil
+}
 
-		case txscript.OP_SSTX:
-			// Locked as stake ticket.
-			txHash := extractRawCreditTxHash(k)
+// addMinedCreditBalance adds the mined credit for the unspent output k/v to the
+// account balances.  Outputs spent by unmined transactions are not counted.
+func (s *Store) addMinedCreditBalance(ns, addrmgrNs walletdb.ReadBucket,
+	balances map[uint32]*Balances, k, v []byte, minConf, syncHeight int32) error {
 
-			blockRec, err := fetchBlockRecord(ns, height)
-			if err != nil {
-				return err
-			}
+	if existsRawUnminedInput(ns, k) != nil {
+		// Output is spent by an unmined transaction.
+		// Skip to next unmined credit.
+		return nil
+	}
 
-			_, txv := existsTxRecord(ns, &txHash, &blockRec.Block)
-			if txv == nil {
-				str := fmt.Sprintf("missing transaction record for tx %v block %v",
-					txHash, &blockRec.Block.Hash)
-				return storeError(apperrors.ErrData, str, err)
-			}
+	cKey := make([]byte, 72)
+	copy(cKey[0:32], k[0:32])   // Tx hash
+	copy(cKey[32:36], v[0:4])   // Block height
+	copy(cKey[36:68], v[4:36])  // Block hash
+	copy(cKey[68:72], k[32:36]) // Output index
 
-			var rec TxRecord
-			err = readRawTxRecord(&txHash, txv, &rec)
-			if err != nil {
-				return err
-			}
+	// Skip unmined credits.
+	cVal := existsRawCredit(ns, cKey)
+	if cVal == nil {
+		return fmt.Errorf("couldn't find a credit for unspent txo")
+	}
 
-			for i, txout := range rec.MsgTx.TxOut {
-				if i%2 != 0 {
-					addr, err := stake.AddrFromSStxPkScrCommitment(txout.PkScript,
-						s.chainParams)
-					if err != nil {
-						return err
-					}
-					amt, err := stake.AmountFromSStxPkScrCommitment(txout.PkScript)
-					if err != nil {
-						return err
-					}
-					ab.VotingAuthority += amt
-					if _, err := s.acctLookupFunc(addrmgrNs, addr); err != nil {
-						if apperrors.IsError(err, apperrors.ErrAddressNotFound) {
-							continue
-						}
-						return err
-					}
-					ab.LockedByTickets += amt
-				}
-			}
+	// Check the account first.
+	pkScript, err := s.fastCreditPkScriptLookup(ns, cKey, nil)
+	if err != nil {
+		return err
+	}
+	thisAcct, err := s.fetchAccountForPkScript(addrmgrNs, cVal, nil, pkScript)
+	if err != nil {
+		return err
+	}
 
-		case txscript.OP_SSGEN:
-			fallthrough
-		case txscript.OP_SSRTX:
-			if confirmed(int32(s.chainParams.CoinbaseMaturity),
-				height, syncHeight) {
-				ab.Spendable += utxoAmt
-			} else {
-				ab.ImmatureStakeGeneration += utxoAmt
-			}
+	utxoAmt, err := fetchRawCreditAmount(cVal)
+	if err != nil {
+		return err
+	}
 
-		case txscript.OP_SSTXCHANGE:
-			if confirmed(int32(s.chainParams.SStxChangeMaturity),
-				height, syncHeight) {
-				ab.Spendable += utxoAmt
-			}
+	height := extractRawCreditHeight(cKey)
+	opcode := fetchRawCreditTagOpCode(cVal)
 
-		default:
-			log.Warnf("Unhandled opcode: %v", opcode)
+	ab, ok := balances[thisAcct]
+	if !ok {
+		ab = &Balances{
+			Account: thisAcct,
+			Total:   utxoAmt,
 		}
-
-		return nil
-	})
-	if err != nil {
-		str := "failed iterating mined credits bucket for fullscan balance"
-		return nil, storeError(apperrors.ErrDatabase, str, err)
+		balances[thisAcct] = ab
+	} else {
+		ab.Total += utxoAmt
 	}
 
-	// Unconfirmed transaction output handling.
-	err = ns.NestedReadBucket(bucketUnminedCredits).ForEach(func(k, v []byte) error {
-		// Make sure this output was not spent by an unmined transaction.
-		// If it was, skip this credit.
-		if existsRawUnminedInput(ns, k) != nil {
-			return nil
+	switch opcode {
+	case OP_NONSTAKE:
+		isConfirmed := confirmed(minConf, height, syncHeight)
+		creditFromCoinbase := fetchRawCreditIsCoinbase(cVal)
+		matureCoinbase := (creditFromCoinbase &&
+			confirmed(int32(s.chainParams.CoinbaseMaturity),
+				height,
+				syncHeight))
+
+		if (isConfirmed && !creditFromCoinbase) ||
+			matureCoinbase {
+			ab.Spendable += utxoAmt
+		} else if creditFromCoinbase && !matureCoinbase {
+			ab.ImmatureCoinbaseRewards += utxoAmt
 		}
 
-		// Check the account first.
-		pkScript, err := s.fastCreditPkScriptLookup(ns, nil, k)
+	case txscript.OP_SSTX:
+		// Locked as stake ticket.
+		txHash := extractRawCreditTxHash(k)
+
+		blockRec, err := fetchBlockRecord(ns, height)
 		if err != nil {
 			return err
 		}
-		thisAcct, err := s.fetchAccountForPkScript(addrmgrNs, nil, v, pkScript)
-		if err != nil {
-			return err
+
+		_, txv := existsTxRecord(ns, &txHash, &blockRec.Block)
+		if txv == nil {
+			str := fmt.Sprintf("missing transaction record for tx %v block %v",
+				txHash, &blockRec.Block.Hash)
+			return storeError(apperrors.ErrData, str, err)
 		}
 
-		utxoAmt, err := fetchRawUnminedCreditAmount(v)
+		var rec TxRecord
+		err = readRawTxRecord(&txHash, txv, &rec)
 		if err != nil {
 			return err
 		}
 
-		ab, ok := accountBalances[thisAcct]
-		if !ok {
-			ab = &Balances{
-				Account: thisAcct,
-				Total:   utxoAmt,
+		for i, txout := range rec.MsgTx.TxOut {
+			if i%2 != 0 {
+				addr, err := stake.AddrFromSStxPkScrCommitment(txout.PkScript,
+					s.chainParams)
+				if err != nil {
+					return err
+				}
+				amt, err := stake.AmountFromSStxPkScrCommitment(txout.PkScript)
+				if err != nil {
+					return err
+				}
+				ab.VotingAuthority += amt
+				if _, err := s.acctLookupFunc(addrmgrNs, addr); err != nil {
+					if apperrors.IsError(err, apperrors.ErrAddressNotFound) {
+						continue
+					}
+					return err
+				}
+				ab.LockedByTickets += amt
 			}
-			accountBalances[thisAcct] = ab
+		}
+
+	case txscript.OP_SSGEN:
+		fallthrough
+	case txscript.OP_SSRTX:
+		if confirmed(int32(s.chainParams.CoinbaseMaturity),
+			height, syncHeight) {
+			ab.Spendable += utxoAmt
 		} else {
-			ab.Total += utxoAmt
+			ab.ImmatureStakeGeneration += utxoAmt
 		}
 
-		// Skip ticket outputs, as only SSGen can spend these.
-		opcode := fetchRawUnminedCreditTagOpcode(v)
+	case txscript.OP_SSTXCHANGE:
+		if confirmed(int32(s.chainParams.SStxChangeMaturity),
+			height, syncHeight) {
+			ab.Spendable += utxoAmt
+		}
 
-		switch opcode {
-		case OP_NONSTAKE:
-			if minConf == 0 {
-				ab.Spendable += utxoAmt
-			}
-		case txscript.OP_SSTX:
-			if minConf == 0 {
-				ab.VotingAuthority += utxoAmt
-			}
-			ab.LockedByTickets += utxoAmt
+	default:
+		log.Warnf("Unhandled opcode: %v", opcode)
+	}
 
-		case txscript.OP_SSGEN:
-			fallthrough
-		case txscript.OP_SSRTX:
-			ab.ImmatureStakeGeneration += utxoAmt
-		case txscript.OP_SSTXCHANGE:
-			return nil
-		default:
-			log.Warnf("Unhandled unconfirmed opcode %v: %v", opcode, v)
-		}
+	return nil
+}
+
+// addUnminedCreditBalance adds the unmined credit k/v to the account balances.
+// Outputs spent by other unmined transactions are not counted.
+func (s *Store) addUnminedCreditBalance(ns, addrmgrNs walletdb.ReadBucket,
+	balances map[uint32]*Balances, k, v []byte, minConf int32) error {
 
+	// Make sure this output was not spent by an unmined transaction.
+	// If it was, skip this credit.
+	if existsRawUnminedInput(ns, k) != nil {
 		return nil
-	})
+	}
+
+	// Check the account first.
+	pkScript, err := s.fastCreditPkScriptLookup(ns, nil, k)
 	if err != nil {
-		str := "failed iterating unmined credits bucket for fullscan balance"
-		return nil, storeError(apperrors.ErrDatabase, str, err)
+		return err
+	}
+	thisAcct, err := s.fetchAccountForPkScript(addrmgrNs, nil, v, pkScript)
+	if err != nil {
+		return err
 	}
 
-	return accountBalances, nil
+	utxoAmt, err := fetchRawUnminedCreditAmount(v)
+	if err != nil {
+		return err
+	}
+
+	ab, ok := balances[thisAcct]
+	if !ok {
+		ab = &Balances{
+			Account: thisAcct,
+			Total:   utxoAmt,
+		}
+		balances[thisAcct] = ab
+	} else {
+		ab.Total += utxoAmt
+	}
+
+	// Skip ticket outputs, as only SSGen can spend these.
+	opcode := fetchRawUnminedCreditTagOpcode(v)
+
+	switch opcode {
+	case OP_NONSTAKE:
+		if minConf == 0 {
+			ab.Spendable += utxoAmt
+		}
+	case txscript.OP_SSTX:
+		if minConf == 0 {
+			ab.VotingAuthority += utxoAmt
+		}
+		ab.LockedByTickets += utxoAmt
+
+	case txscript.OP_SSGEN:
+		fallthrough
+	case txscript.OP_SSRTX:
+		ab.ImmatureStakeGeneration += utxoAmt
+	case txscript.OP_SSTXCHANGE:
+		return nil
+	default:
+		log.Warnf("Unhandled unconfirmed opcode %v: %v", opcode, v)
+	}
+
+	return nil
 }
 
 // balanceFullScanSimulated is a simulated version of the balanceFullScan
@@ -3707,6 +3869,7 @@ type Balances struct {
 	ImmatureCoinbaseRewards abcutil.Amount
 	ImmatureStakeGeneration abcutil.Amount
 	LockedByTickets         abcutil.Amount
+	LockedByVaults          abcutil.Amount
 	Spendable               abcutil.Amount
 	Total                   abcutil.Amount
 	VotingAuthority         abcutil.Amount
@@ -3739,7 +3902,7 @@ func (s *Store) AccountBalances(ns, addrmgrNs walletdb.ReadBucket,
 	minConf int32) (map[uint32]*Balances, error) {
 
 	_, syncHeight := s.MainChainTip(ns)
-	return s.balanceFullScan(ns, addrmgrNs, minConf, syncHeight)
+	return s.balanceIndexed(ns, addrmgrNs, minConf, syncHeight)
 }
 
 // InsertTxScript is the exported version of insertTxScript.
@@ -6,8 +6,11 @@
 package udb
 
 import (
+	"fmt"
+
 	"github.com/abcsuite/abcd/chaincfg/chainhash"
 	"github.com/abcsuite/abcd/wire"
+	"github.com/abcsuite/abcwallet/apperrors"
 	"github.com/abcsuite/abcwallet/walletdb"
 )
 
@@ -30,9 +33,11 @@ func (s *Store) InsertMemPoolTx(ns walletdb.ReadWriteBucket, rec *TxRecord) erro
 		return err
 	}
 
+	var addrIDs [][]byte
 	for _, input := range rec.MsgTx.TxIn {
 		prevOut := &input.PreviousOutPoint
 		k := canonicalOutPoint(&prevOut.Hash, prevOut.Index)
+		addrIDs = append(addrIDs, s.spentOutputAddrIDs(ns, k)...)
 		err = putRawUnminedInput(ns, k, rec.Hash[:])
 		if err != nil {
 			return err
@@ -42,7 +47,9 @@ func (s *Store) InsertMemPoolTx(ns walletdb.ReadWriteBucket, rec *TxRecord) erro
 	// TODO: increment credit amount for each credit (but those are unknown
 	// here currently).
 
-	return nil
+	// Index the addresses of spent credits.  The addresses of credits are
+	// indexed when the credits are added.
+	return addAddrTxs(ns, &rec.Hash, nil, addrIDs)
 }
 
 // removeDoubleSpends checks for any unmined transactions which would introduce
@@ -123,9 +130,53 @@ func (s *Store) removeUnconfirmed(ns walletdb.ReadWriteBucket, rec *TxRecord) er
 		}
 	}
 
+	err := removeAddrTxs(ns, &rec.Hash)
+	if err != nil {
+		return err
+	}
 	return deleteRawUnmined(ns, rec.Hash[:])
 }
 
+// RemoveUnminedTx removes an unmined transaction, and all unmined transactions
+// which spend its outputs, from the store.  Previous outputs spent by the
+// removed transactions become unspent again.  The hashes of all removed
+// transactions are returned.  Mined transactions may not be removed.
+func (s *Store) RemoveUnminedTx(ns walletdb.ReadWriteBucket, txHash *chainhash.Hash) ([]*chainhash.Hash, error) {
+	v := existsRawUnmined(ns, txHash[:])
+	if v == nil {
+		if _, v := latestTxRecord(ns, txHash); v != nil {
+			str := fmt.Sprintf("transaction %v is mined", txHash)
+			return nil, storeError(apperrors.ErrInput, str, nil)
+		}
+		str := fmt.Sprintf("no unmined transaction %v", txHash)
+		return nil, storeError(apperrors.ErrValueNoExists, str, nil)
+	}
+	var rec TxRecord
+	err := readRawTxRecord(txHash, v, &rec)
+	if err != nil {
+		return nil, err
+	}
+
+	unmined, err := s.unminedTxHashes(ns)
+	if err != nil {
+		return nil, err
+	}
+	err = s.removeUnconfirmed(ns, &rec)
+	if err != nil {
+		return nil, err
+	}
+
+	// Spenders are removed recursively, so determine every removed
+	// transaction by checking which previously unmined transactions remain.
+	var removed []*chainhash.Hash
+	for _, hash := range unmined {
+		if existsRawUnmined(ns, hash[:]) == nil {
+			removed = append(removed, hash)
+		}
+	}
+	return removed, nil
+}
+
 // UnminedTxs returns the underlying transactions for all unmined transactions
 // which are not known to have been mined in a block.  Transactions are
 // guaranteed to be sorted by their dependency order.
@@ -143,6 +194,17 @@ func (s *Store) UnminedTxs(ns walletdb.ReadBucket) ([]*wire.MsgTx, error) {
 	return txs, nil
 }
 
+// UnminedTxRecords returns the records of all transactions not known to have
+// been mined in a block.  Records are guaranteed to be sorted by their
+// dependency order.
+func (s *Store) UnminedTxRecords(ns walletdb.ReadBucket) ([]*TxRecord, error) {
+	recSet, err := s.unminedTxRecords(ns)
+	if err != nil {
+		return nil, err
+	}
+	return dependencySort(recSet), nil
+}
+
 func (s *Store) unminedTxRecords(ns walletdb.ReadBucket) (map[chainhash.Hash]*TxRecord, error) {
 	unmined := make(map[chainhash.Hash]*TxRecord)
 	err := ns.NestedReadBucket(bucketUnmined).ForEach(func(k, v []byte) error {
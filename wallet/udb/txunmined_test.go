@@ -0,0 +1,249 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/abcsuite/abcd/chaincfg/chainhash"
+	"github.com/abcsuite/abcd/wire"
+	"github.com/abcsuite/abcutil"
+	"github.com/abcsuite/abcwallet/apperrors"
+	"github.com/abcsuite/abcwallet/walletdb"
+)
+
+// checkHashSet checks that hashes contains exactly the expected hashes.
+func checkHashSet(t *testing.T, desc string, hashes []*chainhash.Hash, expected ...*chainhash.Hash) {
+	set := make(map[chainhash.Hash]struct{}, len(hashes))
+	for _, h := range hashes {
+		set[*h] = struct{}{}
+	}
+	if len(set) != len(hashes) {
+		t.Errorf("%s: duplicate hashes: %v", desc, hashes)
+	}
+	if len(set) != len(expected) {
+		t.Errorf("%s: got %d hashes, expected %d", desc, len(set), len(expected))
+	}
+	for _, h := range expected {
+		if _, ok := set[*h]; !ok {
+			t.Errorf("%s: missing hash %v", desc, h)
+		}
+	}
+}
+
+func TestPruneUnconfirmedExpiry(t *testing.T) {
+	db, s, teardown, err := setup()
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	g := makeBlockGenerator()
+	block1Header := g.generate(abcutil.BlockValid)
+	block2Header := g.generate(abcutil.BlockValid)
+
+	// The mined output of tx1 is spent by the expiring tx2, which is spent
+	// by its unmined child tx3.  The unrelated tx4 does not expire.
+	tx1 := wire.MsgTx{
+		TxOut: []*wire.TxOut{{Value: 3e8}},
+	}
+	tx2 := wire.MsgTx{
+		TxIn: []*wire.TxIn{
+			{PreviousOutPoint: wire.OutPoint{Hash: tx1.TxHash(), Index: 0, Tree: 0}},
+		},
+		TxOut:  []*wire.TxOut{{Value: 2e8}},
+		Expiry: 2,
+	}
+	tx3 := wire.MsgTx{
+		TxIn: []*wire.TxIn{
+			{PreviousOutPoint: wire.OutPoint{Hash: tx2.TxHash(), Index: 0, Tree: 0}},
+		},
+		TxOut: []*wire.TxOut{{Value: 1e8}},
+	}
+	tx4 := wire.MsgTx{
+		TxIn: []*wire.TxIn{
+			{PreviousOutPoint: wire.OutPoint{Hash: chainhash.Hash{1}, Index: 0, Tree: 0}},
+		},
+		TxOut: []*wire.TxOut{{Value: 1e8}},
+	}
+	var recs []*TxRecord
+	for _, tx := range []*wire.MsgTx{&tx1, &tx2, &tx3, &tx4} {
+		rec, err := NewTxRecordFromMsgTx(tx, time.Time{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		recs = append(recs, rec)
+	}
+
+	err = walletdb.Update(db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+		addrmgrNs := dbtx.ReadBucket(waddrmgrBucketKey)
+
+		headerData := makeHeaderDataSlice(block1Header, block2Header)
+		err := s.InsertMainChainHeaders(ns, addrmgrNs, headerData)
+		if err != nil {
+			return err
+		}
+		err = s.InsertMinedTx(ns, addrmgrNs, recs[0], &headerData[0].BlockHash)
+		if err != nil {
+			return err
+		}
+		err = s.AddCredit(ns, recs[0], makeBlockMeta(block1Header), 0, false, 0)
+		if err != nil {
+			return err
+		}
+		for _, rec := range recs[1:] {
+			err = s.InsertMemPoolTx(ns, rec)
+			if err != nil {
+				return err
+			}
+			err = s.AddCredit(ns, rec, nil, 0, false, 0)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Nothing expires before the expiry height.
+		pruned, err := s.PruneUnconfirmed(ns, 1, 0)
+		if err != nil {
+			return err
+		}
+		checkHashSet(t, "before expiry", pruned)
+
+		pruned, err = s.PruneUnconfirmed(ns, 2, 0)
+		if err != nil {
+			return err
+		}
+		checkHashSet(t, "expiry", pruned, &recs[1].Hash, &recs[2].Hash)
+
+		unmined, err := s.UnminedTxHashes(ns)
+		if err != nil {
+			return err
+		}
+		checkHashSet(t, "remaining unmined", unmined, &recs[3].Hash)
+
+		// The output spent by the expired transaction is spendable again.
+		bal, err := s.AccountBalance(ns, addrmgrNs, 1, 0)
+		if err != nil {
+			return err
+		}
+		if bal.Total != 4e8 || bal.Spendable != 3e8 {
+			t.Errorf("Wrong balance after expiry: %+v", bal)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRemoveUnminedTx(t *testing.T) {
+	db, s, teardown, err := setup()
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	g := makeBlockGenerator()
+	block1Header := g.generate(abcutil.BlockValid)
+
+	// The mined output of tx1 is spent by the unmined parent tx2, which is
+	// spent by its unmined child tx3.
+	tx1 := wire.MsgTx{
+		TxOut: []*wire.TxOut{{Value: 3e8}},
+	}
+	tx2 := wire.MsgTx{
+		TxIn: []*wire.TxIn{
+			{PreviousOutPoint: wire.OutPoint{Hash: tx1.TxHash(), Index: 0, Tree: 0}},
+		},
+		TxOut: []*wire.TxOut{{Value: 2e8}},
+	}
+	tx3 := wire.MsgTx{
+		TxIn: []*wire.TxIn{
+			{PreviousOutPoint: wire.OutPoint{Hash: tx2.TxHash(), Index: 0, Tree: 0}},
+		},
+		TxOut: []*wire.TxOut{{Value: 1e8}},
+	}
+	var recs []*TxRecord
+	for _, tx := range []*wire.MsgTx{&tx1, &tx2, &tx3} {
+		rec, err := NewTxRecordFromMsgTx(tx, time.Time{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		recs = append(recs, rec)
+	}
+
+	err = walletdb.Update(db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+		addrmgrNs := dbtx.ReadBucket(waddrmgrBucketKey)
+
+		headerData := makeHeaderDataSlice(block1Header)
+		err := s.InsertMainChainHeaders(ns, addrmgrNs, headerData)
+		if err != nil {
+			return err
+		}
+		err = s.InsertMinedTx(ns, addrmgrNs, recs[0], &headerData[0].BlockHash)
+		if err != nil {
+			return err
+		}
+		err = s.AddCredit(ns, recs[0], makeBlockMeta(block1Header), 0, false, 0)
+		if err != nil {
+			return err
+		}
+		for _, rec := range recs[1:] {
+			err = s.InsertMemPoolTx(ns, rec)
+			if err != nil {
+				return err
+			}
+			err = s.AddCredit(ns, rec, nil, 0, false, 0)
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err = s.RemoveUnminedTx(ns, &recs[0].Hash)
+		if !apperrors.IsError(err, apperrors.ErrInput) {
+			t.Errorf("remove mined tx: expected ErrInput, got %v", err)
+		}
+		_, err = s.RemoveUnminedTx(ns, &chainhash.Hash{1})
+		if !apperrors.IsError(err, apperrors.ErrValueNoExists) {
+			t.Errorf("remove unknown tx: expected ErrValueNoExists, got %v", err)
+		}
+
+		removed, err := s.RemoveUnminedTx(ns, &recs[1].Hash)
+		if err != nil {
+			return err
+		}
+		checkHashSet(t, "removed", removed, &recs[1].Hash, &recs[2].Hash)
+
+		unmined, err := s.UnminedTxHashes(ns)
+		if err != nil {
+			return err
+		}
+		checkHashSet(t, "remaining unmined", unmined)
+
+		// The credit spent by the removed parent is unspent again.
+		unspent, err := s.UnspentOutputs(ns)
+		if err != nil {
+			return err
+		}
+		if len(unspent) != 1 || unspent[0].Hash != recs[0].Hash ||
+			unspent[0].Index != 0 {
+			t.Errorf("Wrong unspent outputs after removal: %v", unspent)
+		}
+		bal, err := s.AccountBalance(ns, addrmgrNs, 0, 0)
+		if err != nil {
+			return err
+		}
+		if bal.Total != 3e8 || bal.Spendable != 3e8 {
+			t.Errorf("Wrong balance after removal: %+v", bal)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
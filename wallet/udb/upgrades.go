@@ -49,10 +49,52 @@ const (
 	// across application restarts.
 	lastReturnedAddressVersion = 5
 
+	// lockedOutpointsVersion is the sixth version of the database.  It adds a
+	// top level bucket for saving outpoints that have been locked by the user
+	// so locks are not lost across application restarts.
+	lockedOutpointsVersion = 6
+
+	// txLabelsVersion is the seventh version of the database.  It adds a top
+	// level bucket for saving user-provided labels of transactions.
+	txLabelsVersion = 7
+
+	// addressBookVersion is the eighth version of the database.  It adds top
+	// level buckets for saving labels of wallet addresses and named external
+	// payees.
+	addressBookVersion = 8
+
+	// balanceIndexVersion is the ninth version of the database.  It adds
+	// buckets to the transaction store namespace indexing the balances of
+	// unspent credits by account.
+	balanceIndexVersion = 9
+
+	// addrTxIndexVersion is the tenth version of the database.  It adds
+	// buckets to the transaction store namespace indexing the transactions
+	// which credit or debit each address.
+	addrTxIndexVersion = 10
+
+	// multisigAccountsVersion is the eleventh version of the database.  It
+	// adds a bucket to the address manager namespace for saving the
+	// signature thresholds and cosigner extended public keys of multisig
+	// accounts.
+	multisigAccountsVersion = 11
+
+	// signingSessionsVersion is the twelfth version of the database.  It
+	// adds a top level bucket for saving multisig signing sessions.
+	signingSessionsVersion = 12
+
+	// swapContractsVersion is the thirteenth version of the database.  It
+	// adds a top level bucket for saving atomic swap contracts.
+	swapContractsVersion = 13
+
+	// vaultsVersion is the fourteenth version of the database.  It adds a
+	// top level bucket for saving time locked vault outputs.
+	vaultsVersion = 14
+
 	// DBVersion is the latest version of the database that is understood by the
 	// program.  Databases with recorded versions higher than this will fail to
 	// open (meaning any upgrades prevent reverting to older software).
-	DBVersion = lastReturnedAddressVersion
+	DBVersion = vaultsVersion
 )
 
 // upgrades maps between old database versions and the upgrade function to
@@ -63,6 +105,15 @@ var upgrades = [...]func(walletdb.ReadWriteTx, []byte) error{
 	votingPreferencesVersion - 1:    votingPreferencesUpgrade,
 	noEncryptedSeedVersion - 1:      noEncryptedSeedUpgrade,
 	lastReturnedAddressVersion - 1:  lastReturnedAddressUpgrade,
+	lockedOutpointsVersion - 1:      lockedOutpointsUpgrade,
+	txLabelsVersion - 1:             txLabelsUpgrade,
+	addressBookVersion - 1:          addressBookUpgrade,
+	balanceIndexVersion - 1:         balanceIndexUpgrade,
+	addrTxIndexVersion - 1:          addrTxIndexUpgrade,
+	multisigAccountsVersion - 1:     multisigAccountsUpgrade,
+	signingSessionsVersion - 1:      signingSessionsUpgrade,
+	swapContractsVersion - 1:        swapContractsUpgrade,
+	vaultsVersion - 1:               vaultsUpgrade,
 }
 
 func lastUsedAddressIndexUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte) error {
@@ -371,6 +422,253 @@ func lastReturnedAddressUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte
 	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
 }
 
+func lockedOutpointsUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte) error {
+	const oldVersion = 5
+	const newVersion = 6
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+
+	// Assert that this function is only called on version 5 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		const str = "lockedOutpointsUpgrade inappropriately called"
+		return apperrors.E{ErrorCode: apperrors.ErrUpgrade, Description: str, Err: nil}
+	}
+
+	// Create the top level bucket for locked outpoints.
+	_, err = tx.CreateTopLevelBucket(lockedOutpoints.rootBucketKey())
+	if err != nil {
+		return err
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func txLabelsUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte) error {
+	const oldVersion = 6
+	const newVersion = 7
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+
+	// Assert that this function is only called on version 6 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		const str = "txLabelsUpgrade inappropriately called"
+		return apperrors.E{ErrorCode: apperrors.ErrUpgrade, Description: str, Err: nil}
+	}
+
+	// Create the top level bucket for transaction labels.
+	_, err = tx.CreateTopLevelBucket(txLabels.rootBucketKey())
+	if err != nil {
+		return err
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func addressBookUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte) error {
+	const oldVersion = 7
+	const newVersion = 8
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+
+	// Assert that this function is only called on version 7 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		const str = "addressBookUpgrade inappropriately called"
+		return apperrors.E{ErrorCode: apperrors.ErrUpgrade, Description: str, Err: nil}
+	}
+
+	// Create the top level buckets for address labels and payees.
+	_, err = tx.CreateTopLevelBucket(addressLabels.rootBucketKey())
+	if err != nil {
+		return err
+	}
+	_, err = tx.CreateTopLevelBucket(payees.rootBucketKey())
+	if err != nil {
+		return err
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func balanceIndexUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte) error {
+	const oldVersion = 8
+	const newVersion = 9
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	txmgrBucket := tx.ReadWriteBucket(wtxmgrBucketKey)
+
+	// Assert that this function is only called on version 8 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		const str = "balanceIndexUpgrade inappropriately called"
+		return apperrors.E{ErrorCode: apperrors.ErrUpgrade, Description: str, Err: nil}
+	}
+
+	// Create and populate the balance index from the unspent outputs and
+	// unmined credits.
+	err = rebuildBalanceIndex(txmgrBucket)
+	if err != nil {
+		return err
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func addrTxIndexUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte) error {
+	const oldVersion = 9
+	const newVersion = 10
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	txmgrBucket := tx.ReadWriteBucket(wtxmgrBucketKey)
+
+	// Assert that this function is only called on version 9 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		const str = "addrTxIndexUpgrade inappropriately called"
+		return apperrors.E{ErrorCode: apperrors.ErrUpgrade, Description: str, Err: nil}
+	}
+
+	// Create and populate the address transaction index from the credits
+	// and debits of every transaction.  The network of the wallet is not
+	// known during upgrades, but the indexed address hashes do not depend on
+	// it, so any network parameters may be used to extract them.
+	s := &Store{chainParams: &chaincfg.MainNetParams}
+	err = s.rebuildAddrTxIndex(txmgrBucket)
+	if err != nil {
+		return err
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func multisigAccountsUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte) error {
+	const oldVersion = 10
+	const newVersion = 11
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	addrmgrBucket := tx.ReadWriteBucket(waddrmgrBucketKey)
+
+	// Assert that this function is only called on version 10 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		const str = "multisigAccountsUpgrade inappropriately called"
+		return apperrors.E{ErrorCode: apperrors.ErrUpgrade, Description: str, Err: nil}
+	}
+
+	// Create the address manager bucket for multisig accounts.  No existing
+	// accounts are multisig accounts, so the bucket begins empty.
+	_, err = addrmgrBucket.CreateBucket(multisigAcctBucketName)
+	if err != nil {
+		return err
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func signingSessionsUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte) error {
+	const oldVersion = 11
+	const newVersion = 12
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+
+	// Assert that this function is only called on version 11 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		const str = "signingSessionsUpgrade inappropriately called"
+		return apperrors.E{ErrorCode: apperrors.ErrUpgrade, Description: str, Err: nil}
+	}
+
+	// Create the top level bucket for signing sessions.
+	_, err = tx.CreateTopLevelBucket(signingSessions.rootBucketKey())
+	if err != nil {
+		return err
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func swapContractsUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte) error {
+	const oldVersion = 12
+	const newVersion = 13
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+
+	// Assert that this function is only called on version 12 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		const str = "swapContractsUpgrade inappropriately called"
+		return apperrors.E{ErrorCode: apperrors.ErrUpgrade, Description: str, Err: nil}
+	}
+
+	// Create the top level bucket for swap contracts.
+	_, err = tx.CreateTopLevelBucket(swapContracts.rootBucketKey())
+	if err != nil {
+		return err
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func vaultsUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte) error {
+	const oldVersion = 13
+	const newVersion = 14
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+
+	// Assert that this function is only called on version 13 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		const str = "vaultsUpgrade inappropriately called"
+		return apperrors.E{ErrorCode: apperrors.ErrUpgrade, Description: str, Err: nil}
+	}
+
+	// Create the top level bucket for vaults.
+	_, err = tx.CreateTopLevelBucket(vaults.rootBucketKey())
+	if err != nil {
+		return err
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
 // Upgrade checks whether the any upgrades are necessary before the database is
 // ready for application usage.  If any are, they are performed.
 func Upgrade(db walletdb.DB, publicPassphrase []byte) error {
@@ -0,0 +1,165 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"time"
+
+	"github.com/abcsuite/abcd/chaincfg/chainhash"
+	"github.com/abcsuite/abcd/wire"
+	"github.com/abcsuite/abcutil"
+	"github.com/abcsuite/abcwallet/apperrors"
+	"github.com/abcsuite/abcwallet/walletdb"
+)
+
+// Vault describes a saved time locked savings output.  The script is the
+// redeem script of the P2SH output, which pays to a key of the account once the
+// lock expires.  SpendTx is nil until the vault is spent.
+type Vault struct {
+	OutPoint wire.OutPoint
+	Account  uint32
+	Amount   abcutil.Amount
+	Script   []byte
+	Created  time.Time
+	SpendTx  *chainhash.Hash
+}
+
+// Vaults are saved as k/v pairs in the vaults bucket.  The key is the vault
+// outpoint's transaction hash and output index, and the value is serialized as
+// such:
+//
+//   [0]       Outpoint tree (1 byte)
+//   [1]       Flags (1 byte)
+//   [2:6]     Account (4 bytes)
+//   [6:14]    Amount (8 bytes)
+//   [14:22]   Creation time (8 bytes)
+//   [22:54]   Spending transaction hash, or zeros if unspent (32 bytes)
+//   [54:]     Vault script (varies)
+
+const (
+	vaultFlagSpent = 1 << iota
+)
+
+type vaultsTy struct {
+}
+
+var vaults vaultsTy
+
+var vaultsRootBucketKey = []byte("vaults")
+
+func (vaultsTy) rootBucketKey() []byte { return vaultsRootBucketKey }
+
+func (vaultsTy) key(op *wire.OutPoint) []byte {
+	k := make([]byte, 36)
+	copy(k, op.Hash[:])
+	byteOrder.PutUint32(k[32:], op.Index)
+	return k
+}
+
+func (vaultsTy) value(v *Vault) []byte {
+	val := make([]byte, 54+len(v.Script))
+	val[0] = byte(v.OutPoint.Tree)
+	byteOrder.PutUint32(val[2:6], v.Account)
+	byteOrder.PutUint64(val[6:14], uint64(v.Amount))
+	byteOrder.PutUint64(val[14:22], uint64(v.Created.Unix()))
+	if v.SpendTx != nil {
+		val[1] |= vaultFlagSpent
+		copy(val[22:54], v.SpendTx[:])
+	}
+	copy(val[54:], v.Script)
+	return val
+}
+
+func (vaultsTy) read(k, val []byte, v *Vault) error {
+	if len(k) != 36 {
+		const str = "bad vault key length"
+		return apperrors.E{ErrorCode: apperrors.ErrData, Description: str, Err: nil}
+	}
+	if len(val) < 54 {
+		const str = "short vault value"
+		return apperrors.E{ErrorCode: apperrors.ErrData, Description: str, Err: nil}
+	}
+	copy(v.OutPoint.Hash[:], k)
+	v.OutPoint.Index = byteOrder.Uint32(k[32:])
+	v.OutPoint.Tree = int8(val[0])
+	v.Account = byteOrder.Uint32(val[2:6])
+	v.Amount = abcutil.Amount(byteOrder.Uint64(val[6:14]))
+	v.Created = time.Unix(int64(byteOrder.Uint64(val[14:22])), 0)
+	v.SpendTx = nil
+	if val[1]&vaultFlagSpent != 0 {
+		v.SpendTx = new(chainhash.Hash)
+		copy(v.SpendTx[:], val[22:54])
+	}
+	v.Script = append([]byte(nil), val[54:]...)
+	return nil
+}
+
+func (t vaultsTy) get(tx walletdb.ReadTx, op *wire.OutPoint) []byte {
+	b := tx.ReadBucket(t.rootBucketKey())
+	return b.Get(t.key(op))
+}
+
+func (t vaultsTy) put(tx walletdb.ReadWriteTx, v *Vault) error {
+	b := tx.ReadWriteBucket(t.rootBucketKey())
+	return b.Put(t.key(&v.OutPoint), t.value(v))
+}
+
+// PutVault saves a vault, replacing any previous vault for the same outpoint.
+func PutVault(tx walletdb.ReadWriteTx, v *Vault) error {
+	if len(v.Script) == 0 {
+		const str = "vault has no script"
+		return apperrors.E{ErrorCode: apperrors.ErrInput, Description: str, Err: nil}
+	}
+	err := vaults.put(tx, v)
+	if err != nil {
+		const str = "failed to put vault"
+		return apperrors.E{ErrorCode: apperrors.ErrDatabase, Description: str, Err: err}
+	}
+	return nil
+}
+
+// ExistsVault returns whether a vault is saved for an outpoint.
+func ExistsVault(tx walletdb.ReadTx, op *wire.OutPoint) bool {
+	return vaults.get(tx, op) != nil
+}
+
+// FetchVault returns the vault of an outpoint.  ErrValueNoExists is returned if
+// no vault is saved for the outpoint.
+func FetchVault(tx walletdb.ReadTx, op *wire.OutPoint) (*Vault, error) {
+	val := vaults.get(tx, op)
+	if val == nil {
+		const str = "vault not found"
+		return nil, apperrors.E{ErrorCode: apperrors.ErrValueNoExists, Description: str, Err: nil}
+	}
+	v := new(Vault)
+	err := vaults.read(vaults.key(op), val, v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Vaults returns every saved vault.
+func Vaults(tx walletdb.ReadTx) ([]Vault, error) {
+	var saved []Vault
+	b := tx.ReadBucket(vaults.rootBucketKey())
+	err := b.ForEach(func(k, val []byte) error {
+		var v Vault
+		err := vaults.read(k, val, &v)
+		if err != nil {
+			return err
+		}
+		saved = append(saved, v)
+		return nil
+	})
+	if err != nil {
+		if _, ok := err.(apperrors.E); ok {
+			return nil, err
+		}
+		const str = "failed to read vaults"
+		return nil, apperrors.E{ErrorCode: apperrors.ErrDatabase, Description: str, Err: err}
+	}
+	return saved, nil
+}
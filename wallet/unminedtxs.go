@@ -0,0 +1,216 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"time"
+
+	"github.com/abcsuite/abcd/chaincfg/chainhash"
+	"github.com/abcsuite/abcwallet/chain"
+	"github.com/abcsuite/abcwallet/wallet/udb"
+	"github.com/abcsuite/abcwallet/walletdb"
+)
+
+const (
+	// rebroadcastInterval is the delay before the first rebroadcast of an
+	// unmined transaction.  The delay doubles after every later attempt, up
+	// to maxRebroadcastInterval.
+	rebroadcastInterval    = 5 * time.Minute
+	maxRebroadcastInterval = 12 * time.Hour
+)
+
+// UnminedTxState describes the broadcast history of an unmined transaction.
+// Broadcast attempts are only tracked while the wallet is running.
+type UnminedTxState struct {
+	Hash chainhash.Hash
+
+	// FirstSeen is the time the transaction was added to the wallet.
+	// LastSeen is the latest time the transaction was accepted by the
+	// consensus server, either when broadcast by the wallet or when notified
+	// as a relevant transaction.
+	FirstSeen time.Time
+	LastSeen  time.Time
+
+	// Attempts is the number of times the transaction was broadcast.
+	// LastError is the reason the latest broadcast was rejected, or nil if it
+	// was accepted.
+	Attempts    uint32
+	LastAttempt time.Time
+	LastError   error
+
+	// NextAttempt is the earliest time the transaction will be rebroadcast.
+	NextAttempt time.Time
+}
+
+// rebroadcastDelay returns the delay before rebroadcasting a transaction that
+// has been broadcast attempts times.
+func rebroadcastDelay(attempts uint32) time.Duration {
+	delay := rebroadcastInterval
+	for i := uint32(1); i < attempts && delay < maxRebroadcastInterval; i++ {
+		delay *= 2
+	}
+	if delay > maxRebroadcastInterval {
+		delay = maxRebroadcastInterval
+	}
+	return delay
+}
+
+// unminedTxState returns the state of an unmined transaction, adding it with
+// the first seen time if the transaction is not yet tracked.  The unminedTxsMu
+// mutex must be held.
+func (w *Wallet) unminedTxState(hash *chainhash.Hash, firstSeen time.Time) *UnminedTxState {
+	s, ok := w.unminedTxs[*hash]
+	if !ok {
+		s = &UnminedTxState{
+			Hash:      *hash,
+			FirstSeen: firstSeen,
+			LastSeen:  firstSeen,
+		}
+		w.unminedTxs[*hash] = s
+	}
+	return s
+}
+
+// recordUnminedTxSeen records that an unmined transaction was notified by the
+// consensus server at time t.  Since the server just accepted the transaction,
+// newly tracked transactions are not rebroadcast until after the initial
+// rebroadcast interval.
+func (w *Wallet) recordUnminedTxSeen(hash *chainhash.Hash, t time.Time) {
+	w.unminedTxsMu.Lock()
+	s := w.unminedTxState(hash, t)
+	s.LastSeen = t
+	if s.NextAttempt.IsZero() {
+		s.NextAttempt = t.Add(rebroadcastInterval)
+	}
+	w.unminedTxsMu.Unlock()
+}
+
+// recordBroadcast records an attempt at time t to broadcast an unmined
+// transaction and schedules the next rebroadcast.  The error is the reason the
+// transaction was rejected, or nil if it was accepted.
+func (w *Wallet) recordBroadcast(hash *chainhash.Hash, t time.Time, err error) {
+	w.unminedTxsMu.Lock()
+	s := w.unminedTxState(hash, t)
+	s.Attempts++
+	s.LastAttempt = t
+	s.LastError = err
+	if err == nil {
+		s.LastSeen = t
+	}
+	s.NextAttempt = t.Add(rebroadcastDelay(s.Attempts))
+	w.unminedTxsMu.Unlock()
+}
+
+// trackUnminedTxs begins tracking the state of every unmined transaction
+// record not already tracked, and stops tracking transactions which are no
+// longer unmined.  The unminedTxsMu mutex must be held.
+func (w *Wallet) trackUnminedTxs(recs []*udb.TxRecord) {
+	unmined := make(map[chainhash.Hash]struct{}, len(recs))
+	for _, rec := range recs {
+		unmined[rec.Hash] = struct{}{}
+		w.unminedTxState(&rec.Hash, rec.Received)
+	}
+	for hash := range w.unminedTxs {
+		if _, ok := unmined[hash]; !ok {
+			delete(w.unminedTxs, hash)
+		}
+	}
+}
+
+// rebroadcastUnminedTxs sends each unmined transaction that is due to be
+// rebroadcast to the consensus server for relay.  The delay between attempts
+// to broadcast a transaction grows exponentially, so transactions which are
+// never mined do not cause repeated broadcasts every time the wallet
+// reconnects.
+func (w *Wallet) rebroadcastUnminedTxs(chainClient *chain.RPCClient) {
+	var recs []*udb.TxRecord
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		var err error
+		recs, err = w.TxStore.UnminedTxRecords(txmgrNs)
+		return err
+	})
+	if err != nil {
+		log.Errorf("Cannot load unmined transactions for rebroadcasting: %v", err)
+		return
+	}
+
+	now := time.Now()
+	var due []*udb.TxRecord
+	w.unminedTxsMu.Lock()
+	w.trackUnminedTxs(recs)
+	for _, rec := range recs {
+		if !now.Before(w.unminedTxs[rec.Hash].NextAttempt) {
+			due = append(due, rec)
+		}
+	}
+	w.unminedTxsMu.Unlock()
+
+	// Records are sorted by dependency order, so parent transactions are
+	// always broadcast before their spenders.
+	for _, rec := range due {
+		_, err := chainClient.SendRawTransaction(&rec.MsgTx, w.AllowHighFees)
+		w.recordBroadcast(&rec.Hash, time.Now(), err)
+		if err != nil {
+			log.Debugf("Could not rebroadcast transaction %v: %v",
+				&rec.Hash, err)
+			continue
+		}
+		log.Tracef("Rebroadcast unmined transaction %v", &rec.Hash)
+	}
+}
+
+// UnminedTxStates returns the broadcast state of every unmined transaction,
+// sorted by dependency order.
+func (w *Wallet) UnminedTxStates() ([]UnminedTxState, error) {
+	var recs []*udb.TxRecord
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		var err error
+		recs, err = w.TxStore.UnminedTxRecords(txmgrNs)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	states := make([]UnminedTxState, 0, len(recs))
+	w.unminedTxsMu.Lock()
+	w.trackUnminedTxs(recs)
+	for _, rec := range recs {
+		states = append(states, *w.unminedTxs[rec.Hash])
+	}
+	w.unminedTxsMu.Unlock()
+	return states, nil
+}
+
+// AbandonTransaction removes an unmined transaction that will never be mined,
+// and every unmined transaction spending its outputs, from the wallet.  The
+// previous outputs spent by the removed transactions become spendable again.
+// The hashes of all removed transactions are returned.
+//
+// Abandoning a transaction does not remove it from the mempools of the
+// network, and the transaction may be added back to the wallet if it is mined
+// or notified again by the consensus server.
+func (w *Wallet) AbandonTransaction(txHash *chainhash.Hash) ([]*chainhash.Hash, error) {
+	var removed []*chainhash.Hash
+	err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		var err error
+		removed, err = w.TxStore.RemoveUnminedTx(txmgrNs, txHash)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	w.unminedTxsMu.Lock()
+	for _, hash := range removed {
+		delete(w.unminedTxs, *hash)
+		log.Infof("Abandoned unmined transaction %v", hash)
+	}
+	w.unminedTxsMu.Unlock()
+	return removed, nil
+}
@@ -0,0 +1,464 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/abcsuite/abcd/chaincfg/chainec"
+	"github.com/abcsuite/abcd/chaincfg/chainhash"
+	"github.com/abcsuite/abcd/txscript"
+	"github.com/abcsuite/abcd/wire"
+	"github.com/abcsuite/abcutil"
+	"github.com/abcsuite/abcwallet/apperrors"
+	"github.com/abcsuite/abcwallet/wallet/txauthor"
+	"github.com/abcsuite/abcwallet/wallet/txrules"
+	"github.com/abcsuite/abcwallet/wallet/udb"
+	"github.com/abcsuite/abcwallet/walletdb"
+
+	"github.com/abcsuite/abcwallet/wallet/internal/txsizes"
+	"github.com/abcsuite/abcwallet/wallet/internal/vaultscript"
+)
+
+// VaultLockType describes how the lock of a vault is specified.
+type VaultLockType int
+
+// Vault lock types.  Height and time locks are absolute and enforced with
+// OP_CHECKLOCKTIMEVERIFY.  Block and second locks are relative to the block
+// the vault is mined in and enforced with OP_CHECKSEQUENCEVERIFY.
+const (
+	VaultLockHeight VaultLockType = iota
+	VaultLockTime
+	VaultLockBlocks
+	VaultLockSeconds
+)
+
+// String returns the name of a vault lock type.
+func (t VaultLockType) String() string {
+	switch t {
+	case VaultLockHeight:
+		return "height"
+	case VaultLockTime:
+		return "time"
+	case VaultLockBlocks:
+		return "blocks"
+	case VaultLockSeconds:
+		return "seconds"
+	default:
+		return "unknown"
+	}
+}
+
+// Vault describes a time locked savings output.  The vault script is paid to by
+// a P2SH output, which may only be spent by the wallet key of KeyAddress once
+// the lock expires.  LockValue is a block height, unix time, number of blocks,
+// or number of seconds depending on the lock type.
+type Vault struct {
+	OutPoint   wire.OutPoint
+	Account    uint32
+	Amount     abcutil.Amount
+	Script     []byte
+	Address    abcutil.Address
+	KeyAddress abcutil.Address
+	LockType   VaultLockType
+	LockValue  int64
+
+	// Unlocked records whether the vault may be spent by a transaction
+	// mined in the block after the main chain tip.  SpendTx is nil until
+	// the vault is spent back to its account.
+	Unlocked bool
+	Created  time.Time
+	SpendTx  *chainhash.Hash
+}
+
+// vaultUnlocked returns whether a vault may be spent by a transaction mined in
+// the block after a block of some height and median time past.  Relative locks
+// are never expired for vaults which are not mined, indicated by a negative
+// minedHeight.  Relative time locks are measured from minedMedianTime, the
+// median time past of the block before the block which mined the vault.
+func vaultUnlocked(details *vaultscript.Vault, minedHeight int32,
+	minedMedianTime time.Time, height int32, medianTime time.Time) bool {
+
+	if !details.Relative {
+		return swapLockTimeReached(details.LockTime, height, medianTime)
+	}
+	if minedHeight < 0 {
+		return false
+	}
+	lock := vaultscript.RelativeLockValue(details.LockTime)
+	if vaultscript.IsSeconds(details.LockTime) {
+		return int64(medianTime.Sub(minedMedianTime)/time.Second) >= lock
+	}
+	return int64(height+1-minedHeight) >= lock
+}
+
+// describeVault parses the script of a saved vault and determines whether its
+// lock is expired as of the main chain tip.
+func (w *Wallet) describeVault(dbtx walletdb.ReadTx, v *udb.Vault) (*Vault, error) {
+	details, err := vaultscript.Parse(v.Script)
+	if err != nil {
+		return nil, apperrors.E{
+			ErrorCode:   apperrors.ErrData,
+			Description: "saved vault is invalid",
+			Err:         err,
+		}
+	}
+	addr, err := abcutil.NewAddressScriptHash(v.Script, w.chainParams)
+	if err != nil {
+		return nil, err
+	}
+	keyAddr, err := abcutil.NewAddressPubKeyHash(details.PubKeyHash[:],
+		w.chainParams, chainec.ECTypeSecp256k1)
+	if err != nil {
+		return nil, err
+	}
+	desc := &Vault{
+		OutPoint:   v.OutPoint,
+		Account:    v.Account,
+		Amount:     v.Amount,
+		Script:     v.Script,
+		Address:    addr,
+		KeyAddress: keyAddr,
+		LockValue:  details.LockTime,
+		Created:    v.Created,
+		SpendTx:    v.SpendTx,
+	}
+	switch {
+	case !details.Relative && details.LockTime < txscript.LockTimeThreshold:
+		desc.LockType = VaultLockHeight
+	case !details.Relative:
+		desc.LockType = VaultLockTime
+	case vaultscript.IsSeconds(details.LockTime):
+		desc.LockType = VaultLockSeconds
+		desc.LockValue = vaultscript.RelativeLockValue(details.LockTime)
+	default:
+		desc.LockType = VaultLockBlocks
+		desc.LockValue = vaultscript.RelativeLockValue(details.LockTime)
+	}
+
+	txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+	_, tipHeight := w.TxStore.MainChainTip(txmgrNs)
+	medianTime, err := w.medianTime(txmgrNs, tipHeight)
+	if err != nil {
+		return nil, err
+	}
+	minedHeight, minedMedianTime := int32(-1), time.Time{}
+	txDetails, err := w.TxStore.TxDetails(txmgrNs, &v.OutPoint.Hash)
+	if err != nil {
+		return nil, err
+	}
+	if txDetails != nil && txDetails.Block.Height != -1 {
+		minedHeight = txDetails.Block.Height
+		prevHeight := minedHeight - 1
+		if prevHeight < 0 {
+			prevHeight = 0
+		}
+		minedMedianTime, err = w.medianTime(txmgrNs, prevHeight)
+		if err != nil {
+			return nil, err
+		}
+	}
+	desc.Unlocked = vaultUnlocked(details, minedHeight, minedMedianTime,
+		tipHeight, medianTime)
+
+	return desc, nil
+}
+
+// vaultScript returns the vault script locking an output to a pubkey hash.
+func vaultScript(pkh *abcutil.AddressPubKeyHash, lockType VaultLockType,
+	lockValue int64) ([]byte, error) {
+
+	details := &vaultscript.Vault{LockTime: lockValue}
+	copy(details.PubKeyHash[:], pkh.ScriptAddress())
+	var ok bool
+	switch lockType {
+	case VaultLockHeight:
+		ok = lockValue > 0 && lockValue < txscript.LockTimeThreshold
+	case VaultLockTime:
+		ok = lockValue >= txscript.LockTimeThreshold && lockValue <= 0xffffffff
+	case VaultLockBlocks:
+		ok = lockValue > 0 && lockValue <= wire.SequenceLockTimeMask
+		details.LockTime = vaultscript.RelativeBlocks(uint16(lockValue))
+		details.Relative = true
+	case VaultLockSeconds:
+		var err error
+		details.LockTime, err = vaultscript.RelativeSeconds(lockValue)
+		ok = err == nil
+		details.Relative = true
+	}
+	if !ok {
+		return nil, apperrors.E{
+			ErrorCode:   apperrors.ErrInput,
+			Description: "invalid vault lock",
+		}
+	}
+	return details.Script()
+}
+
+// watchVault loads the vault outpoint into the consensus server's transaction
+// filter so the wallet is notified when it is spent.
+func (w *Wallet) watchVault(op *wire.OutPoint) {
+	chainClient := w.ChainClient()
+	if chainClient == nil {
+		return
+	}
+	err := chainClient.LoadTxFilter(false, nil, []wire.OutPoint{*op})
+	if err != nil {
+		log.Errorf("Failed to watch vault %v: %v", op, err)
+	}
+}
+
+// CreateVault publishes a transaction from an account which pays amount to a
+// vault owned by a new key of the account.  The vault is counted as locked in
+// the account's balance until its lock expires and it is automatically spent
+// back to the account.  The vault and the funding transaction are returned.
+//
+// This method requires the wallet to be unlocked.
+func (w *Wallet) CreateVault(account uint32, amount abcutil.Amount,
+	lockType VaultLockType, lockValue int64) (*Vault, *wire.MsgTx, error) {
+
+	// Vaults are spent by a signature of a pubkey hash address, so they
+	// can not be owned by multisig accounts.
+	var ms *udb.MultisigAccount
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		ms, err = w.Manager.MultisigAccount(dbtx, account)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if ms != nil {
+		return nil, nil, apperrors.E{
+			ErrorCode:   apperrors.ErrInput,
+			Description: "vaults can not be created by multisig accounts",
+		}
+	}
+	keyAddr, err := w.NewChangeAddress(account)
+	if err != nil {
+		return nil, nil, err
+	}
+	pkh, ok := keyAddr.(*abcutil.AddressPubKeyHash)
+	if !ok {
+		return nil, nil, apperrors.E{
+			ErrorCode:   apperrors.ErrInput,
+			Description: "vault key address must be a pubkey hash address",
+		}
+	}
+	script, err := vaultScript(pkh, lockType, lockValue)
+	if err != nil {
+		return nil, nil, err
+	}
+	addr, err := abcutil.NewAddressScriptHash(script, w.chainParams)
+	if err != nil {
+		return nil, nil, err
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return nil, nil, err
+	}
+	output := wire.NewTxOut(int64(amount), pkScript)
+	err = txrules.CheckOutput(output, w.RelayFee())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	atx, err := w.CreateSimpleTx(account, []*wire.TxOut{output}, 1,
+		txauthor.CoinSelectionDefault, nil, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	v := &udb.Vault{
+		OutPoint: wire.OutPoint{Hash: atx.Tx.TxHash(), Tree: wire.TxTreeRegular},
+		Account:  account,
+		Amount:   amount,
+		Script:   script,
+		Created:  time.Now(),
+	}
+	for i, out := range atx.Tx.TxOut {
+		if bytes.Equal(out.PkScript, pkScript) {
+			v.OutPoint.Index = uint32(i)
+			break
+		}
+	}
+	var desc *Vault
+	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		err := udb.PutVault(dbtx, v)
+		if err != nil {
+			return err
+		}
+		desc, err = w.describeVault(dbtx, v)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	w.watchVault(&v.OutPoint)
+
+	log.Infof("Created vault %v locking %v", &v.OutPoint, amount)
+
+	return desc, atx.Tx, nil
+}
+
+// Vault returns the saved vault of an outpoint.
+func (w *Wallet) Vault(op *wire.OutPoint) (*Vault, error) {
+	var desc *Vault
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		v, err := udb.FetchVault(dbtx, op)
+		if err != nil {
+			return err
+		}
+		desc, err = w.describeVault(dbtx, v)
+		return err
+	})
+	return desc, err
+}
+
+// Vaults returns every saved vault.
+func (w *Wallet) Vaults() ([]*Vault, error) {
+	var descs []*Vault
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		saved, err := udb.Vaults(dbtx)
+		if err != nil {
+			return err
+		}
+		descs = make([]*Vault, len(saved))
+		for i := range saved {
+			descs[i], err = w.describeVault(dbtx, &saved[i])
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return descs, err
+}
+
+// addVaultBalances adds the unspent vaults of accounts in the balances map to
+// the accounts' total and locked balances.  Vaults remain locked until they are
+// spent back to their account, even after their locks expire.
+func addVaultBalances(dbtx walletdb.ReadTx, balances map[uint32]*udb.Balances) error {
+	saved, err := udb.Vaults(dbtx)
+	if err != nil {
+		return err
+	}
+	for i := range saved {
+		v := &saved[i]
+		bal, ok := balances[v.Account]
+		if !ok || v.SpendTx != nil {
+			continue
+		}
+		bal.LockedByVaults += v.Amount
+		bal.Total += v.Amount
+	}
+	return nil
+}
+
+// processVaultSpends records the spends of saved vaults by a transaction.
+func (w *Wallet) processVaultSpends(dbtx walletdb.ReadWriteTx, rec *udb.TxRecord) error {
+	for _, in := range rec.MsgTx.TxIn {
+		if !udb.ExistsVault(dbtx, &in.PreviousOutPoint) {
+			continue
+		}
+		v, err := udb.FetchVault(dbtx, &in.PreviousOutPoint)
+		if err != nil {
+			return err
+		}
+		if v.SpendTx != nil && *v.SpendTx == rec.Hash {
+			continue
+		}
+		spendHash := rec.Hash
+		v.SpendTx = &spendHash
+		err = udb.PutVault(dbtx, v)
+		if err != nil {
+			return err
+		}
+		log.Infof("Vault %v spent by transaction %v", &v.OutPoint, &rec.Hash)
+	}
+	return nil
+}
+
+// spendVault creates, signs, and publishes a transaction spending an unlocked
+// vault to a change address of its account.
+func (w *Wallet) spendVault(v *Vault) (*chainhash.Hash, error) {
+	details, err := vaultscript.Parse(v.Script)
+	if err != nil {
+		return nil, err
+	}
+
+	credit := &scriptCredit{
+		desc:          "vault",
+		outPoint:      v.OutPoint,
+		amount:        v.Amount,
+		address:       v.Address,
+		script:        v.Script,
+		keyAddr:       v.KeyAddress,
+		account:       v.Account,
+		sigScriptSize: txsizes.RedeemVaultSigScriptSize(len(v.Script)),
+		sigScript: func(sig, pubKey []byte) ([]byte, error) {
+			return vaultscript.SigScript(sig, pubKey, v.Script)
+		},
+	}
+	if details.Relative {
+		// Relative locks are only enforced for inputs of version 2
+		// transactions with a sequence number of at least the lock.
+		credit.txVersion = 2
+		credit.sequence = uint32(details.LockTime)
+	} else {
+		// The locktime must be reached by the spending transaction, and
+		// is only enforced for inputs which are not final.
+		credit.lockTime = uint32(details.LockTime)
+		credit.sequence = 0
+	}
+	return w.spendScriptCredit(credit)
+}
+
+// handleVaults spends every unspent vault whose lock is expired as of the main
+// chain tip back to its account.  Vaults which can not be spent now are
+// retried when the next block is attached, unless the wallet is locked, in
+// which case nothing is attempted.  Vaults with a pending spend published by
+// the wallet are not spent again.
+func (w *Wallet) handleVaults() {
+	if w.Manager.IsLocked() {
+		return
+	}
+	vaults, err := w.Vaults()
+	if err != nil {
+		log.Errorf("Failed to load vaults: %v", err)
+		return
+	}
+	for _, v := range vaults {
+		if v.SpendTx != nil {
+			w.forgetScriptSpend(&v.OutPoint)
+			continue
+		}
+		if !v.Unlocked || w.pendingScriptSpend(&v.OutPoint) {
+			continue
+		}
+		txHash, err := w.spendVault(v)
+		if err != nil {
+			log.Warnf("Failed to spend vault %v: %v", &v.OutPoint, err)
+			continue
+		}
+		log.Infof("Spent unlocked vault %v with transaction %v",
+			&v.OutPoint, txHash)
+	}
+}
+
+// unspentVaultOutpoints returns the outpoints of every unspent saved vault.
+func unspentVaultOutpoints(dbtx walletdb.ReadTx) ([]wire.OutPoint, error) {
+	saved, err := udb.Vaults(dbtx)
+	if err != nil {
+		return nil, err
+	}
+	var outpoints []wire.OutPoint
+	for i := range saved {
+		if saved[i].SpendTx == nil {
+			outpoints = append(outpoints, saved[i].OutPoint)
+		}
+	}
+	return outpoints, nil
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/abcsuite/abcwallet/wallet/internal/vaultscript"
+)
+
+func TestVaultUnlocked(t *testing.T) {
+	const minedMedianTime = 1500000000
+	seconds, err := vaultscript.RelativeSeconds(1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name        string
+		vault       vaultscript.Vault
+		minedHeight int32
+		height      int32
+		medianTime  int64
+		unlocked    bool
+	}{
+		// CLTV locks use the height or median time past of the tip,
+		// whether or not the vault is mined.
+		{"height lock before", vaultscript.Vault{LockTime: 100}, -1, 99, 0, false},
+		{"height lock reached", vaultscript.Vault{LockTime: 100}, -1, 100, 0, true},
+		{"time lock at median time", vaultscript.Vault{LockTime: minedMedianTime}, 10, 50, minedMedianTime, false},
+		{"time lock after median time", vaultscript.Vault{LockTime: minedMedianTime}, 10, 50, minedMedianTime + 1, true},
+
+		// CSV locks count from the block which mined the vault and are
+		// never expired for unmined vaults.
+		{"blocks unmined", vaultscript.Vault{LockTime: vaultscript.RelativeBlocks(5), Relative: true}, -1, 1000, 0, false},
+		{"blocks before", vaultscript.Vault{LockTime: vaultscript.RelativeBlocks(5), Relative: true}, 10, 13, 0, false},
+		{"blocks reached", vaultscript.Vault{LockTime: vaultscript.RelativeBlocks(5), Relative: true}, 10, 14, 0, true},
+		{"seconds unmined", vaultscript.Vault{LockTime: seconds, Relative: true}, -1, 1000, minedMedianTime + 1e6, false},
+		{"seconds before", vaultscript.Vault{LockTime: seconds, Relative: true}, 10, 1000, minedMedianTime + 1023, false},
+		{"seconds reached", vaultscript.Vault{LockTime: seconds, Relative: true}, 10, 11, minedMedianTime + 1024, true},
+	}
+	for _, test := range tests {
+		unlocked := vaultUnlocked(&test.vault, test.minedHeight,
+			time.Unix(minedMedianTime, 0), test.height,
+			time.Unix(test.medianTime, 0))
+		if unlocked != test.unlocked {
+			t.Errorf("%s: Got %v: Want %v", test.name, unlocked, test.unlocked)
+		}
+	}
+}
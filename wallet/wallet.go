@@ -36,6 +36,7 @@ import (
 	"github.com/abcsuite/abcwallet/wallet/txrules"
 	"github.com/abcsuite/abcwallet/wallet/udb"
 	"github.com/abcsuite/abcwallet/walletdb"
+	"github.com/abcsuite/abcwallet/walletdb/encdb"
 )
 
 const (
@@ -46,7 +47,8 @@ const (
 	// they gain access to the wallet database.
 	//
 	// NOTE: at time of writing, public encryption only applies to public
-	// data in the waddrmgr namespace.  Transactions are not yet encrypted.
+	// data in the waddrmgr namespace.  Transactions are only encrypted when
+	// the entire database is encrypted by the encdb driver.
 	InsecurePubPassphrase = "public"
 )
 
@@ -87,7 +89,8 @@ type VotingInfo struct {
 // complete wallet.  It contains the Armory-style key store
 // addresses and keys),
 type Wallet struct {
-	publicPassphrase []byte
+	publicPassphrase   []byte
+	publicPassphraseMu sync.Mutex
 
 	// Data stores
 	db       walletdb.DB
@@ -114,10 +117,21 @@ type Wallet struct {
 	chainClient     *chain.RPCClient
 	chainClientLock sync.Mutex
 
-	lockedOutpoints map[wire.OutPoint]struct{}
+	lockedOutpoints  map[wire.OutPoint]*udb.LockedOutpoint
+	lockedOutpointMu sync.Mutex
+
+	unminedTxs   map[chainhash.Hash]*UnminedTxState
+	unminedTxsMu sync.Mutex
+
+	pendingScriptSpends   map[wire.OutPoint]chainhash.Hash
+	pendingScriptSpendsMu sync.Mutex
 
 	relayFee               abcutil.Amount
 	relayFeeMu             sync.Mutex
+	coinSelection          txauthor.CoinSelectionStrategy
+	coinSelectionMu        sync.Mutex
+	txExpiry               uint32
+	txExpiryMu             sync.Mutex
 	ticketFeeIncrementLock sync.Mutex
 	ticketFeeIncrement     abcutil.Amount
 	DisallowFree           bool
@@ -127,6 +141,8 @@ type Wallet struct {
 	consolidateRequests      chan consolidateRequest
 	createTxRequests         chan createTxRequest
 	createMultisigTxRequests chan createMultisigTxRequest
+	sweepAccountRequests     chan sweepAccountRequest
+	bumpFeeRequests          chan bumpFeeRequest
 
 	// Channels for stake tx creation requests.
 	createSStxRequests     chan createSStxRequest
@@ -178,13 +194,17 @@ func newWallet(votingEnabled bool, addressReuse bool, ticketAddress abcutil.Addr
 		TxStore:                  txs,
 		StakeMgr:                 smgr,
 		votingEnabled:            votingEnabled,
-		lockedOutpoints:          map[wire.OutPoint]struct{}{},
+		lockedOutpoints:          map[wire.OutPoint]*udb.LockedOutpoint{},
+		unminedTxs:               map[chainhash.Hash]*UnminedTxState{},
+		pendingScriptSpends:      map[wire.OutPoint]chainhash.Hash{},
 		relayFee:                 relayFee,
 		ticketFeeIncrement:       ticketFee,
 		AllowHighFees:            AllowHighFees,
 		consolidateRequests:      make(chan consolidateRequest),
 		createTxRequests:         make(chan createTxRequest),
 		createMultisigTxRequests: make(chan createMultisigTxRequest),
+		sweepAccountRequests:     make(chan sweepAccountRequest),
+		bumpFeeRequests:          make(chan bumpFeeRequest),
 		createSStxRequests:       make(chan createSStxRequest),
 		createSSGenRequests:      make(chan createSSGenRequest),
 		createSSRtxRequests:      make(chan createSSRtxRequest),
@@ -217,11 +237,7 @@ func newWallet(votingEnabled bool, addressReuse bool, ticketAddress abcutil.Addr
 			return err
 		}
 		for acct := uint32(0); acct <= lastAcct; acct++ {
-			xpub, err := w.Manager.AccountExtendedPubKey(tx, acct)
-			if err != nil {
-				return err
-			}
-			extKey, intKey, err := deriveBranches(xpub)
+			extKeys, intKeys, err := w.accountBranchKeys(tx, acct)
 			if err != nil {
 				return err
 			}
@@ -231,12 +247,12 @@ func newWallet(votingEnabled bool, addressReuse bool, ticketAddress abcutil.Addr
 			}
 			w.addressBuffers[acct] = &bip0044AccountData{
 				albExternal: addressBuffer{
-					branchXpub: extKey,
+					branchKeys: extKeys,
 					lastUsed:   props.LastUsedExternalIndex,
 					cursor:     props.LastReturnedExternalIndex - props.LastUsedExternalIndex,
 				},
 				albInternal: addressBuffer{
-					branchXpub: intKey,
+					branchKeys: intKeys,
 					lastUsed:   props.LastUsedInternalIndex,
 					cursor:     props.LastReturnedInternalIndex - props.LastUsedInternalIndex,
 				},
@@ -651,6 +667,43 @@ func (w *Wallet) SetRelayFee(relayFee abcutil.Amount) {
 	w.relayFeeMu.Unlock()
 }
 
+// CoinSelectionStrategy returns the default strategy used to select unspent
+// outputs when constructing transactions.
+func (w *Wallet) CoinSelectionStrategy() txauthor.CoinSelectionStrategy {
+	w.coinSelectionMu.Lock()
+	strategy := w.coinSelection
+	w.coinSelectionMu.Unlock()
+	return strategy
+}
+
+// SetCoinSelectionStrategy sets the default strategy used to select unspent
+// outputs when constructing transactions.  Transactions which do not specify
+// their own strategy use this default.
+func (w *Wallet) SetCoinSelectionStrategy(strategy txauthor.CoinSelectionStrategy) {
+	w.coinSelectionMu.Lock()
+	w.coinSelection = strategy
+	w.coinSelectionMu.Unlock()
+}
+
+// DefaultTxExpiry returns the default relative expiry, in blocks, of created
+// transactions.  Zero indicates that transactions do not expire by default.
+func (w *Wallet) DefaultTxExpiry() uint32 {
+	w.txExpiryMu.Lock()
+	blocks := w.txExpiry
+	w.txExpiryMu.Unlock()
+	return blocks
+}
+
+// SetDefaultTxExpiry sets the default relative expiry, in blocks, of created
+// transactions.  Transactions which do not specify their own expiry may only
+// be mined in this many blocks after the current main chain tip.  Zero
+// disables the default expiry.
+func (w *Wallet) SetDefaultTxExpiry(blocks uint32) {
+	w.txExpiryMu.Lock()
+	w.txExpiry = blocks
+	w.txExpiryMu.Unlock()
+}
+
 // TicketFeeIncrement is used to get the current feeIncrement for the wallet.
 func (w *Wallet) TicketFeeIncrement() abcutil.Amount {
 	w.ticketFeeIncrementLock.Lock()
@@ -752,7 +805,7 @@ func (w *Wallet) loadActiveAddrs(dbtx walletdb.ReadTx, chainClient *chain.RPCCli
 	getAddrs := func() []abcutil.Address { return pool.Get().([]abcutil.Address) }
 
 	// loadBranchAddrs loads addresses for the branch with the child range [0,n].
-	loadBranchAddrs := func(branchKey *hdkeychain.ExtendedKey, n uint32, errs chan<- error) {
+	loadBranchAddrs := func(keys *branchKeys, n uint32, errs chan<- error) {
 		jobs := n/256 + 1
 		jobErrs := make(chan error, jobs)
 		for child := uint32(0); child <= n; child += 256 {
@@ -760,7 +813,7 @@ func (w *Wallet) loadActiveAddrs(dbtx walletdb.ReadTx, chainClient *chain.RPCCli
 				addrs := getAddrs()
 				stop := minUint32(n+1, child+256)
 				for ; child < stop; child++ {
-					addr, err := deriveChildAddress(branchKey, child, w.chainParams)
+					addr, err := keys.deriveChildAddress(child, w.chainParams)
 					if err == hdkeychain.ErrInvalidChild {
 						continue
 					}
@@ -797,11 +850,7 @@ func (w *Wallet) loadActiveAddrs(dbtx walletdb.ReadTx, chainClient *chain.RPCCli
 		if err != nil {
 			return 0, err
 		}
-		acctXpub, err := w.Manager.AccountExtendedPubKey(dbtx, acct)
-		if err != nil {
-			return 0, err
-		}
-		extKey, intKey, err := deriveBranches(acctXpub)
+		extKeys, intKeys, err := w.accountBranchKeys(dbtx, acct)
 		if err != nil {
 			return 0, err
 		}
@@ -809,10 +858,14 @@ func (w *Wallet) loadActiveAddrs(dbtx walletdb.ReadTx, chainClient *chain.RPCCli
 		extn := minUint32(props.LastReturnedExternalIndex+gapLimit, hdkeychain.HardenedKeyStart-1)
 		intn := minUint32(props.LastReturnedInternalIndex+gapLimit, hdkeychain.HardenedKeyStart-1)
 		// pre-cache the pubkey results so concurrent access does not race.
-		extKey.ECPubKey()
-		intKey.ECPubKey()
-		go loadBranchAddrs(extKey, extn, errs)
-		go loadBranchAddrs(intKey, intn, errs)
+		for _, keys := range []*branchKeys{extKeys, intKeys} {
+			keys.xpub.ECPubKey()
+			for _, cosigner := range keys.cosigners {
+				cosigner.ECPubKey()
+			}
+		}
+		go loadBranchAddrs(extKeys, extn, errs)
+		go loadBranchAddrs(intKeys, intn, errs)
 		bip0044AddrCount += uint64(extn) + uint64(intn)
 	}
 	go func() {
@@ -860,6 +913,16 @@ func (w *Wallet) LoadActiveDataFilters(chainClient *chain.RPCClient) error {
 		if err != nil {
 			return err
 		}
+		contracts, err := unspentSwapContractOutpoints(dbtx)
+		if err != nil {
+			return err
+		}
+		unspent = append(unspent, contracts...)
+		vaults, err := unspentVaultOutpoints(dbtx)
+		if err != nil {
+			return err
+		}
+		unspent = append(unspent, vaults...)
 		utxoCount = uint64(len(unspent))
 		err = chainClient.LoadTxFilter(false, nil, unspent)
 		return err
@@ -1092,7 +1155,7 @@ func (w *Wallet) syncWithChain(chainClient *chain.RPCClient) error {
 		}
 	}
 
-	w.resendUnminedTxs(chainClient)
+	w.rebroadcastUnminedTxs(chainClient)
 
 	// Send winning and missed ticket notifications out so that the wallet
 	// can immediately vote and redeem any tickets it may have missed on
@@ -1122,10 +1185,14 @@ type (
 		resp    chan consolidateResponse
 	}
 	createTxRequest struct {
-		account uint32
-		outputs []*wire.TxOut
-		minconf int32
-		resp    chan createTxResponse
+		account         uint32
+		outputs         []*wire.TxOut
+		minconf         int32
+		strategy        txauthor.CoinSelectionStrategy
+		coinControl     *CoinControl
+		subtractFeeFrom []int
+		expiry          *TxExpiry
+		resp            chan createTxResponse
 	}
 	createMultisigTxRequest struct {
 		account   uint32
@@ -1135,6 +1202,18 @@ type (
 		minconf   int32
 		resp      chan createMultisigTxResponse
 	}
+	sweepAccountRequest struct {
+		account      uint32
+		minconf      int32
+		minValue     abcutil.Amount
+		destinations []txauthor.SweepDestination
+		resp         chan createTxResponse
+	}
+	bumpFeeRequest struct {
+		parentHash *chainhash.Hash
+		feeRate    abcutil.Amount
+		resp       chan bumpFeeResponse
+	}
 	createSStxRequest struct {
 		usedInputs []udb.Credit
 		pair       map[string]abcutil.Amount
@@ -1177,6 +1256,10 @@ type (
 		tx  *txauthor.AuthoredTx
 		err error
 	}
+	bumpFeeResponse struct {
+		bump *FeeBump
+		err  error
+	}
 	createMultisigTxResponse struct {
 		tx           *CreatedTx
 		address      abcutil.Address
@@ -1233,7 +1316,8 @@ out:
 				continue
 			}
 			tx, err := w.txToOutputs(txr.outputs, txr.account,
-				txr.minconf, true)
+				txr.minconf, txr.strategy, txr.coinControl,
+				txr.subtractFeeFrom, txr.expiry, true)
 			heldUnlock.release()
 			txr.resp <- createTxResponse{tx, err}
 
@@ -1248,6 +1332,27 @@ out:
 			heldUnlock.release()
 			txr.resp <- createMultisigTxResponse{tx, address, redeemScript, err}
 
+		case txr := <-w.sweepAccountRequests:
+			heldUnlock, err := w.holdUnlock()
+			if err != nil {
+				txr.resp <- createTxResponse{nil, err}
+				continue
+			}
+			tx, err := w.sweepAccount(txr.account, txr.minconf,
+				txr.minValue, txr.destinations)
+			heldUnlock.release()
+			txr.resp <- createTxResponse{tx, err}
+
+		case txr := <-w.bumpFeeRequests:
+			heldUnlock, err := w.holdUnlock()
+			if err != nil {
+				txr.resp <- bumpFeeResponse{nil, err}
+				continue
+			}
+			bump, err := w.bumpFee(txr.parentHash, txr.feeRate)
+			heldUnlock.release()
+			txr.resp <- bumpFeeResponse{bump, err}
+
 		case txr := <-w.createSStxRequests:
 			heldUnlock, err := w.holdUnlock()
 			if err != nil {
@@ -1322,23 +1427,72 @@ func (w *Wallet) Consolidate(inputs int, account uint32,
 // CreateSimpleTx creates a new signed transaction spending unspent P2PKH
 // outputs with at laest minconf confirmations spending to any number of
 // address/amount pairs.  Change and an appropriate transaction fee are
-// automatically included, if necessary.  All transaction creation through this
-// function is serialized to prevent the creation of many transactions which
-// spend the same outputs.
+// automatically included, if necessary.  Outputs are selected using the coin
+// selection strategy, or the wallet's default strategy if the strategy is
+// txauthor.CoinSelectionDefault.  The coin control is optional and may be nil;
+// when set, it chooses or restricts the outputs which are spent.  If any
+// output indexes are specified by subtractFeeFrom, the fee is subtracted from
+// these outputs instead of being paid in addition to the output amounts.  The
+// transaction expires according to the expiry, or the wallet's default expiry
+// if the expiry is nil.  All transaction creation through this function is
+// serialized to prevent the creation of many transactions which spend the same
+// outputs.
 func (w *Wallet) CreateSimpleTx(account uint32, outputs []*wire.TxOut,
-	minconf int32) (*txauthor.AuthoredTx, error) {
+	minconf int32, strategy txauthor.CoinSelectionStrategy,
+	coinControl *CoinControl, subtractFeeFrom []int,
+	expiry *TxExpiry) (*txauthor.AuthoredTx, error) {
 
 	req := createTxRequest{
-		account: account,
-		outputs: outputs,
-		minconf: minconf,
-		resp:    make(chan createTxResponse),
+		account:         account,
+		outputs:         outputs,
+		minconf:         minconf,
+		strategy:        strategy,
+		coinControl:     coinControl,
+		subtractFeeFrom: subtractFeeFrom,
+		expiry:          expiry,
+		resp:            make(chan createTxResponse),
 	}
 	w.createTxRequests <- req
 	resp := <-req.resp
 	return resp.tx, resp.err
 }
 
+// SweepAccount creates, signs, and publishes a transaction spending every
+// spendable and unlocked output of an account with at least minconf
+// confirmations and a value of at least minValue.  The swept value, less a fee
+// based on the size of the transaction, is split between the destinations by
+// their ratios.  Sweeps are serialized with all other transaction creation
+// through txCreator.
+func (w *Wallet) SweepAccount(account uint32, minconf int32, minValue abcutil.Amount,
+	destinations []txauthor.SweepDestination) (*txauthor.AuthoredTx, error) {
+
+	req := sweepAccountRequest{
+		account:      account,
+		minconf:      minconf,
+		minValue:     minValue,
+		destinations: destinations,
+		resp:         make(chan createTxResponse),
+	}
+	w.sweepAccountRequests <- req
+	resp := <-req.resp
+	return resp.tx, resp.err
+}
+
+// BumpFee creates, signs, and publishes a child-pays-for-parent transaction
+// spending the change output of an unmined transaction, so that the unmined
+// transaction and its child together pay feeRate per kB.  Fee bumps are
+// serialized with all other transaction creation through txCreator.
+func (w *Wallet) BumpFee(parentHash *chainhash.Hash, feeRate abcutil.Amount) (*FeeBump, error) {
+	req := bumpFeeRequest{
+		parentHash: parentHash,
+		feeRate:    feeRate,
+		resp:       make(chan bumpFeeResponse),
+	}
+	w.bumpFeeRequests <- req
+	resp := <-req.resp
+	return resp.bump, resp.err
+}
+
 // CreateMultisigTx receives a request from the RPC and ships it to txCreator to
 // generate a new multisigtx.
 func (w *Wallet) CreateMultisigTx(account uint32, amount abcutil.Amount,
@@ -1623,10 +1777,28 @@ func (w *Wallet) ChangePrivatePassphrase(old, new []byte) error {
 
 // ChangePublicPassphrase modifies the public passphrase of the wallet.
 func (w *Wallet) ChangePublicPassphrase(old, new []byte) error {
-	return walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+	w.publicPassphraseMu.Lock()
+	defer w.publicPassphraseMu.Unlock()
+
+	err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
 		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
-		return w.Manager.ChangePassphrase(addrmgrNs, old, new, false)
+		err := w.Manager.ChangePassphrase(addrmgrNs, old, new, false)
+		if err != nil {
+			return err
+		}
+
+		// Databases encrypted by the public passphrase must be rekeyed in
+		// the same transaction so they remain openable.
+		if encdb.IsWrapped(w.db) {
+			return encdb.ChangePassphrase(tx, old, new)
+		}
+		return nil
 	})
+	if err != nil {
+		return err
+	}
+	w.publicPassphrase = append([]byte(nil), new...)
+	return nil
 }
 
 // CalculateAccountBalance sums the amounts of all unspent transaction
@@ -1640,7 +1812,10 @@ func (w *Wallet) CalculateAccountBalance(account uint32, confirms int32) (udb.Ba
 
 		balance, err = w.TxStore.AccountBalance(txmgrNs, addrmgrNs,
 			confirms, account)
-		return err
+		if err != nil {
+			return err
+		}
+		return addVaultBalances(tx, map[uint32]*udb.Balances{account: &balance})
 	})
 	return balance, err
 }
@@ -1654,7 +1829,7 @@ func (w *Wallet) CalculateAccountBalances(confirms int32) (map[uint32]*udb.Balan
 	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
 		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
 		txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
-		return w.Manager.ForEachAccount(addrmgrNs, func(acct uint32) error {
+		err := w.Manager.ForEachAccount(addrmgrNs, func(acct uint32) error {
 			balance, err := w.TxStore.AccountBalance(txmgrNs, addrmgrNs,
 				confirms, acct)
 			if err != nil {
@@ -1663,6 +1838,10 @@ func (w *Wallet) CalculateAccountBalances(confirms int32) (map[uint32]*udb.Balan
 			balances[acct] = &balance
 			return nil
 		})
+		if err != nil {
+			return err
+		}
+		return addVaultBalances(tx, balances)
 	})
 
 	return balances, err
@@ -1684,12 +1863,12 @@ func (w *Wallet) CurrentAddress(account uint32) (abcutil.Address, error) {
 	buf := &data.albExternal
 
 	childIndex := buf.lastUsed + 1 + buf.cursor
-	child, err := buf.branchXpub.Child(childIndex)
+	addr, err := buf.branchKeys.deriveChildAddress(childIndex, w.chainParams)
 	if err != nil {
 		const str = "failed to derive child key"
 		return nil, apperrors.E{ErrorCode: apperrors.ErrKeyChain, Description: str, Err: nil}
 	}
-	return child.Address(w.chainParams)
+	return addr, nil
 }
 
 // PubKeyForAddress looks up the associated public key for a P2PKH address.
@@ -1869,7 +2048,7 @@ const maxEmptyAccounts = 100
 func (w *Wallet) NextAccount(name string) (uint32, error) {
 	var account uint32
 	var props *udb.AccountProperties
-	var xpub *hdkeychain.ExtendedKey
+	var extKeys, intKeys *branchKeys
 	err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
 		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
 
@@ -1905,47 +2084,71 @@ func (w *Wallet) NextAccount(name string) (uint32, error) {
 			return err
 		}
 
-		props, err = w.Manager.AccountProperties(addrmgrNs, account)
-		if err != nil {
-			return err
-		}
-
-		xpub, err = w.Manager.AccountExtendedPubKey(tx, account)
-		if err != nil {
-			return err
-		}
-
-		gapLimit := uint32(w.gapLimit)
-		err = w.Manager.SyncAccountToAddrIndex(addrmgrNs, account,
-			gapLimit, udb.ExternalBranch)
-		if err != nil {
-			return err
-		}
-		return w.Manager.SyncAccountToAddrIndex(addrmgrNs, account,
-			gapLimit, udb.InternalBranch)
+		props, extKeys, intKeys, err = w.syncNewAccount(tx, account)
+		return err
 	})
 	if err != nil {
 		return 0, err
 	}
 
-	extKey, intKey, err := deriveBranches(xpub)
+	err = w.watchNewAccount(account, extKeys, intKeys)
 	if err != nil {
 		return 0, err
 	}
+
+	w.NtfnServer.notifyAccountProperties(props)
+
+	return account, nil
+}
+
+// syncNewAccount derives the gap limit of addresses on both branches of a newly
+// created account.  It returns the account properties and branch keys.
+func (w *Wallet) syncNewAccount(tx walletdb.ReadWriteTx, account uint32) (props *udb.AccountProperties,
+	extKeys, intKeys *branchKeys, err error) {
+
+	addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+	props, err = w.Manager.AccountProperties(addrmgrNs, account)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	extKeys, intKeys, err = w.accountBranchKeys(tx, account)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	gapLimit := uint32(w.gapLimit)
+	err = w.Manager.SyncAccountToAddrIndex(addrmgrNs, account,
+		gapLimit, udb.ExternalBranch)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	err = w.Manager.SyncAccountToAddrIndex(addrmgrNs, account,
+		gapLimit, udb.InternalBranch)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return props, extKeys, intKeys, nil
+}
+
+// watchNewAccount creates the address buffers of a newly created account and
+// loads the consensus RPC server with the gap limit of addresses of both
+// branches.
+func (w *Wallet) watchNewAccount(account uint32, extKeys, intKeys *branchKeys) error {
 	w.addressBuffersMu.Lock()
 	w.addressBuffers[account] = &bip0044AccountData{
-		albExternal: addressBuffer{branchXpub: extKey, lastUsed: ^uint32(0)},
-		albInternal: addressBuffer{branchXpub: intKey, lastUsed: ^uint32(0)},
+		albExternal: addressBuffer{branchKeys: extKeys, lastUsed: ^uint32(0)},
+		albInternal: addressBuffer{branchKeys: intKeys, lastUsed: ^uint32(0)},
 	}
 	w.addressBuffersMu.Unlock()
 
 	client := w.ChainClient()
 	if client != nil {
 		errs := make(chan error, 2)
-		for _, branchKey := range []*hdkeychain.ExtendedKey{extKey, intKey} {
-			branchKey := branchKey
+		for _, keys := range []*branchKeys{extKeys, intKeys} {
+			keys := keys
 			go func() {
-				addrs, err := deriveChildAddresses(branchKey, 0,
+				addrs, err := keys.deriveChildAddresses(0,
 					uint32(w.gapLimit), w.chainParams)
 				if err != nil {
 					errs <- err
@@ -1957,14 +2160,11 @@ func (w *Wallet) NextAccount(name string) (uint32, error) {
 		for i := 0; i < cap(errs); i++ {
 			err := <-errs
 			if err != nil {
-				return 0, err
+				return err
 			}
 		}
 	}
-
-	w.NtfnServer.notifyAccountProperties(props)
-
-	return account, nil
+	return nil
 }
 
 // MasterPubKey returns the BIP0044 master public key for the passed account.
@@ -2054,6 +2254,7 @@ func listTransactions(tx walletdb.ReadTx, details *udb.TxDetails, addrMgr *udb.M
 	received := details.Received.Unix()
 	generated := blockchain.IsCoinBaseTx(&details.MsgTx)
 	recvCat := RecvCategory(details, syncHeight, net).String()
+	label := udb.FetchTxLabel(tx, &details.Hash)
 
 	send := len(details.Debits) != 0
 
@@ -2141,6 +2342,7 @@ outputs:
 			Time:            received,
 			TimeReceived:    received,
 			TxType:          &txTypeStr,
+			Comment:         label,
 		}
 
 		// Add a received/generated/immature result if this is a credit.
@@ -2246,7 +2448,7 @@ func (w *Wallet) ListTransactions(from, count int) ([]abcjson.ListTransactionsRe
 // ListAddressTransactions returns a slice of objects with details about
 // recorded transactions to or from any address belonging to a set.  This is
 // intended to be used for listaddresstransactions RPC replies.
-func (w *Wallet) ListAddressTransactions(pkHashes map[string]struct{}) ([]abcjson.ListTransactionsResult, error) {
+func (w *Wallet) ListAddressTransactions(addrs []abcutil.Address) ([]abcjson.ListTransactionsResult, error) {
 	txList := []abcjson.ListTransactionsResult{}
 	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
 		txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
@@ -2255,39 +2457,15 @@ func (w *Wallet) ListAddressTransactions(pkHashes map[string]struct{}) ([]abcjso
 		// the number of tx confirmations.
 		_, tipHeight := w.TxStore.MainChainTip(txmgrNs)
 		rangeFn := func(details []udb.TxDetails) (bool, error) {
-		loopDetails:
 			for i := range details {
-				detail := &details[i]
-
-				for _, cred := range detail.Credits {
-					pkScript := detail.MsgTx.TxOut[cred.Index].PkScript
-					_, addrs, _, err := txscript.ExtractPkScriptAddrs(
-						txscript.DefaultScriptVersion, pkScript, w.chainParams)
-					if err != nil || len(addrs) != 1 {
-						continue
-					}
-					apkh, ok := addrs[0].(*abcutil.AddressPubKeyHash)
-					if !ok {
-						continue
-					}
-					_, ok = pkHashes[string(apkh.ScriptAddress())]
-					if !ok {
-						continue
-					}
-
-					jsonResults := listTransactions(tx, detail,
-						w.Manager, tipHeight, w.chainParams)
-					if err != nil {
-						return false, err
-					}
-					txList = append(txList, jsonResults...)
-					continue loopDetails
-				}
+				jsonResults := listTransactions(tx, &details[i],
+					w.Manager, tipHeight, w.chainParams)
+				txList = append(txList, jsonResults...)
 			}
 			return false, nil
 		}
 
-		return w.TxStore.RangeTransactions(txmgrNs, 0, -1, rangeFn)
+		return w.TxStore.RangeAddressTransactions(txmgrNs, addrs, 0, -1, rangeFn)
 	})
 	return txList, err
 }
@@ -2407,6 +2585,43 @@ func (w *Wallet) TransactionSummary(txHash *chainhash.Hash) (*TransactionSummary
 	return txSummary, err
 }
 
+// SetTransactionLabel attaches a label, such as a memo or note, to a
+// transaction recorded by the wallet, replacing any previous label.  Setting
+// an empty label removes the label from the transaction.
+func (w *Wallet) SetTransactionLabel(txHash *chainhash.Hash, label string) error {
+	return walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		if label != "" && !w.TxStore.ExistsTx(ns, txHash) {
+			const str = "transaction not found"
+			return apperrors.E{ErrorCode: apperrors.ErrValueNoExists, Description: str, Err: nil}
+		}
+		return udb.PutTxLabel(dbtx, txHash, label)
+	})
+}
+
+// TransactionLabel returns the label of a transaction, or the empty string if
+// the transaction is not labeled.
+func (w *Wallet) TransactionLabel(txHash *chainhash.Hash) (string, error) {
+	var label string
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		label = udb.FetchTxLabel(dbtx, txHash)
+		return nil
+	})
+	return label, err
+}
+
+// SearchTransactionLabels returns all transaction labels which contain query
+// as a case-insensitive substring.  An empty query returns every label.
+func (w *Wallet) SearchTransactionLabels(query string) ([]udb.TxLabel, error) {
+	var labels []udb.TxLabel
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		labels, err = udb.SearchTxLabels(dbtx, query)
+		return err
+	})
+	return labels, err
+}
+
 // GetTransactionsResult is the result of the wallet's GetTransactions method.
 // See GetTransactions for more details.
 type GetTransactionsResult struct {
@@ -2512,6 +2727,63 @@ func (w *Wallet) GetTransactions(startBlock, endBlock *BlockIdentifier, cancel <
 	return &res, err
 }
 
+// AddressTransactions returns the transactions which credit or debit an
+// address between the starting and ending block heights.  An ending height of
+// -1 includes all mined transactions through the best block and all unmined
+// transactions.  Results are organized in the same manner as GetTransactions,
+// with blocks in ascending order followed by unmined transactions.
+//
+// When maxTxs is positive, no more blocks are included after the block which
+// causes the number of transactions to reach maxTxs.  Since the transactions of
+// a block are never split, following results can be requested using a starting
+// height one greater than the height of the last returned block.
+//
+// If the cancel channel unblocks, the results created thus far will be
+// returned.
+func (w *Wallet) AddressTransactions(addr abcutil.Address, start, end int32,
+	maxTxs int, cancel <-chan struct{}) (*GetTransactionsResult, error) {
+
+	var res GetTransactionsResult
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+
+		var count int
+		rangeFn := func(details []udb.TxDetails) (bool, error) {
+			txs := make([]TransactionSummary, 0, len(details))
+			for i := range details {
+				txs = append(txs, makeTxSummary(dbtx, w, &details[i]))
+			}
+			count += len(txs)
+
+			if details[0].Block.Height != -1 {
+				blockHash := details[0].Block.Hash
+				res.MinedTransactions = append(res.MinedTransactions, Block{
+					Hash:         &blockHash,
+					Height:       details[0].Block.Height,
+					Timestamp:    details[0].Block.Time.Unix(),
+					Transactions: txs,
+				})
+			} else {
+				res.UnminedTransactions = txs
+			}
+
+			if maxTxs > 0 && count >= maxTxs {
+				return true, nil
+			}
+			select {
+			case <-cancel:
+				return true, nil
+			default:
+				return false, nil
+			}
+		}
+
+		return w.TxStore.RangeAddressTransactions(txmgrNs,
+			[]abcutil.Address{addr}, start, end, rangeFn)
+	})
+	return &res, err
+}
+
 // AccountResult is a single account result for the AccountsResult type.
 type AccountResult struct {
 	udb.AccountProperties
@@ -3251,74 +3523,191 @@ func (w *Wallet) StakeInfo(chainClient *abcrpcclient.Client) (*StakeInfoData, er
 	return resp, err
 }
 
+// outpointLockKey returns the key of an outpoint in the locked outpoints map.
+// Locks apply to a transaction output regardless of the tree recorded by the
+// outpoint, so the tree is not part of the key.
+func outpointLockKey(op *wire.OutPoint) wire.OutPoint {
+	return wire.OutPoint{Hash: op.Hash, Index: op.Index}
+}
+
 // LockedOutpoint returns whether an outpoint has been marked as locked and
 // should not be used as an input for created transactions.
 func (w *Wallet) LockedOutpoint(op wire.OutPoint) bool {
-	_, locked := w.lockedOutpoints[op]
+	w.lockedOutpointMu.Lock()
+	_, locked := w.lockedOutpoints[outpointLockKey(&op)]
+	w.lockedOutpointMu.Unlock()
 	return locked
 }
 
 // LockOutpoint marks an outpoint as locked, that is, it should not be used as
-// an input for newly created transactions.
-func (w *Wallet) LockOutpoint(op wire.OutPoint) {
-	w.lockedOutpoints[op] = struct{}{}
+// an input for newly created transactions.  The lock is saved to the wallet
+// database and remains in effect across restarts until the outpoint is
+// unlocked, spent, or the main chain reaches the expiry height.  An expiry
+// height of zero never expires.  The reason is an optional description saved
+// with the lock.
+func (w *Wallet) LockOutpoint(op wire.OutPoint, reason string, expiryHeight int32) error {
+	lock := &udb.LockedOutpoint{
+		OutPoint:     op,
+		Reason:       reason,
+		ExpiryHeight: expiryHeight,
+	}
+
+	w.lockedOutpointMu.Lock()
+	defer w.lockedOutpointMu.Unlock()
+
+	err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		return udb.PutLockedOutpoint(tx, lock)
+	})
+	if err != nil {
+		return err
+	}
+	w.lockedOutpoints[outpointLockKey(&op)] = lock
+	return nil
 }
 
 // UnlockOutpoint marks an outpoint as unlocked, that is, it may be used as an
 // input for newly created transactions.
-func (w *Wallet) UnlockOutpoint(op wire.OutPoint) {
-	delete(w.lockedOutpoints, op)
+func (w *Wallet) UnlockOutpoint(op wire.OutPoint) error {
+	w.lockedOutpointMu.Lock()
+	defer w.lockedOutpointMu.Unlock()
+
+	err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		return udb.DeleteLockedOutpoint(tx, &op)
+	})
+	if err != nil {
+		return err
+	}
+	delete(w.lockedOutpoints, outpointLockKey(&op))
+	return nil
 }
 
 // ResetLockedOutpoints resets the set of locked outpoints so all may be used
 // as inputs for new transactions.
-func (w *Wallet) ResetLockedOutpoints() {
-	w.lockedOutpoints = map[wire.OutPoint]struct{}{}
+func (w *Wallet) ResetLockedOutpoints() error {
+	w.lockedOutpointMu.Lock()
+	defer w.lockedOutpointMu.Unlock()
+
+	err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		return udb.DeleteAllLockedOutpoints(tx)
+	})
+	if err != nil {
+		return err
+	}
+	w.lockedOutpoints = map[wire.OutPoint]*udb.LockedOutpoint{}
+	return nil
 }
 
-// LockedOutpoints returns a slice of currently locked outpoints.  This is
-// intended to be used by marshaling the result as a JSON array for
-// listlockunspent RPC results.
-func (w *Wallet) LockedOutpoints() []abcjson.TransactionInput {
-	locked := make([]abcjson.TransactionInput, len(w.lockedOutpoints))
-	i := 0
-	for op := range w.lockedOutpoints {
-		locked[i] = abcjson.TransactionInput{
-			Txid: op.Hash.String(),
-			Vout: op.Index,
-		}
-		i++
+// LockedOutpoints returns a slice of currently locked outpoints, including the
+// reason and expiry height of each lock.
+func (w *Wallet) LockedOutpoints() []udb.LockedOutpoint {
+	w.lockedOutpointMu.Lock()
+	locked := make([]udb.LockedOutpoint, 0, len(w.lockedOutpoints))
+	for _, l := range w.lockedOutpoints {
+		locked = append(locked, *l)
 	}
+	w.lockedOutpointMu.Unlock()
 	return locked
 }
 
-// resendUnminedTxs iterates through all transactions that spend from wallet
-// credits that are not known to have been mined into a block, and attempts
-// to send each to the chain server for relay.
-func (w *Wallet) resendUnminedTxs(chainClient *chain.RPCClient) {
-	var txs []*wire.MsgTx
+// loadLockedOutpoints reads all outpoint locks saved in the wallet database
+// into memory.
+func (w *Wallet) loadLockedOutpoints() error {
+	var locked []udb.LockedOutpoint
 	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
-		txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
 		var err error
-		txs, err = w.TxStore.UnminedTxs(txmgrNs)
+		locked, err = udb.LockedOutpoints(tx)
 		return err
 	})
 	if err != nil {
-		log.Errorf("Cannot load unmined transactions for resending: %v", err)
-		return
+		return err
 	}
 
-	for _, tx := range txs {
-		resp, err := chainClient.SendRawTransaction(tx, w.AllowHighFees)
-		if err != nil {
-			// TODO(jrick): Check error for if this tx is a double spend,
-			// remove it if so.
-			log.Tracef("Could not resend transaction %v: %v",
-				tx.TxHash(), err)
+	w.lockedOutpointMu.Lock()
+	for i := range locked {
+		w.lockedOutpoints[outpointLockKey(&locked[i].OutPoint)] = &locked[i]
+	}
+	w.lockedOutpointMu.Unlock()
+	return nil
+}
+
+// releaseSpentOutpointLocks removes the saved locks of all locked outpoints
+// that are spent by a transaction.  Locks are looked up in the database rather
+// than in memory, since the in-memory locks must not change until the update
+// commits.  forgetReleasedOutpointLocks must be called after the update
+// commits to remove the released locks from memory.
+func (w *Wallet) releaseSpentOutpointLocks(dbtx walletdb.ReadWriteTx, tx *wire.MsgTx) error {
+	for _, in := range tx.TxIn {
+		op := &in.PreviousOutPoint
+		if !udb.ExistsLockedOutpoint(dbtx, op) {
 			continue
 		}
-		log.Tracef("Resent unmined transaction %v", resp)
+		err := udb.DeleteLockedOutpoint(dbtx, op)
+		if err != nil {
+			return err
+		}
+		log.Infof("Released lock on outpoint %v spent by transaction %v",
+			op, tx.TxHash())
 	}
+	return nil
+}
+
+// forgetReleasedOutpointLocks removes the in-memory locks of all outpoints
+// whose saved locks were released by a committed database update.  If the
+// update was rolled back, the saved locks remain and the in-memory locks are
+// kept.
+func (w *Wallet) forgetReleasedOutpointLocks() {
+	w.lockedOutpointMu.Lock()
+	defer w.lockedOutpointMu.Unlock()
+
+	if len(w.lockedOutpoints) == 0 {
+		return
+	}
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		for key, l := range w.lockedOutpoints {
+			if !udb.ExistsLockedOutpoint(tx, &l.OutPoint) {
+				delete(w.lockedOutpoints, key)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Errorf("Failed to read saved outpoint locks: %v", err)
+	}
+}
+
+// releaseExpiredOutpointLocks removes the locks of all locked outpoints that
+// have expired at the main chain height.
+func (w *Wallet) releaseExpiredOutpointLocks(height int32) error {
+	w.lockedOutpointMu.Lock()
+	defer w.lockedOutpointMu.Unlock()
+
+	var expired []*udb.LockedOutpoint
+	for _, l := range w.lockedOutpoints {
+		if l.Expired(height) {
+			expired = append(expired, l)
+		}
+	}
+	if len(expired) == 0 {
+		return nil
+	}
+
+	err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		for _, l := range expired {
+			err := udb.DeleteLockedOutpoint(tx, &l.OutPoint)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, l := range expired {
+		delete(w.lockedOutpoints, outpointLockKey(&l.OutPoint))
+		log.Infof("Released expired lock on outpoint %v", &l.OutPoint)
+	}
+	return nil
 }
 
 // SortedActivePaymentAddresses returns a slice of all active payment
@@ -3432,9 +3821,8 @@ func (w *Wallet) TotalReceivedForAccounts(minConf int32) ([]AccountTotalReceived
 	return results, err
 }
 
-// TotalReceivedForAddr iterates through a wallet's transaction history,
-// returning the total amount of aero received for a single wallet
-// address.
+// TotalReceivedForAddr returns the total amount of aero received by a single
+// wallet address in transactions with at least minConf confirmations.
 func (w *Wallet) TotalReceivedForAddr(addr abcutil.Address, minConf int32) (abcutil.Amount, error) {
 	var amount abcutil.Amount
 	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
@@ -3475,15 +3863,24 @@ func (w *Wallet) TotalReceivedForAddr(addr abcutil.Address, minConf int32) (abcu
 			}
 			return false, nil
 		}
-		return w.TxStore.RangeTransactions(txmgrNs, 0, stopHeight, rangeFn)
+		return w.TxStore.RangeAddressTransactions(txmgrNs,
+			[]abcutil.Address{addr}, 0, stopHeight, rangeFn)
 	})
 	return amount, err
 }
 
 // SendOutputs creates and sends payment transactions. It returns the
-// transaction hash upon success
+// transaction hash upon success.  Previous outputs are selected using the coin
+// selection strategy, or the wallet's default strategy if the strategy is
+// txauthor.CoinSelectionDefault.  The optional coin control chooses or
+// restricts the previous outputs which are spent.  The fee is subtracted from
+// the outputs at the indexes in subtractFeeFrom, if any.  The transaction
+// expires according to the expiry, or the wallet's default expiry if the
+// expiry is nil.
 func (w *Wallet) SendOutputs(outputs []*wire.TxOut, account uint32,
-	minconf int32) (*chainhash.Hash, error) {
+	minconf int32, strategy txauthor.CoinSelectionStrategy,
+	coinControl *CoinControl, subtractFeeFrom []int,
+	expiry *TxExpiry) (*chainhash.Hash, error) {
 
 	relayFee := w.RelayFee()
 	for _, output := range outputs {
@@ -3495,7 +3892,8 @@ func (w *Wallet) SendOutputs(outputs []*wire.TxOut, account uint32,
 
 	// Create transaction, replying with an error if the creation
 	// was not successful.
-	createdTx, err := w.CreateSimpleTx(account, outputs, minconf)
+	createdTx, err := w.CreateSimpleTx(account, outputs, minconf, strategy,
+		coinControl, subtractFeeFrom, expiry)
 	if err != nil {
 		return nil, err
 	}
@@ -3764,7 +4162,12 @@ func (w *Wallet) PublishTransaction(tx *wire.MsgTx, serializedTx []byte, client
 		txHash, err = client.SendRawTransaction(tx, w.AllowHighFees)
 		return err
 	})
-	return txHash, err
+	if err != nil {
+		return nil, err
+	}
+	w.forgetReleasedOutpointLocks()
+	w.recordBroadcast(txHash, time.Now(), nil)
+	return txHash, nil
 }
 
 // ChainParams returns the network parameters for the blockchain the wallet
@@ -3995,6 +4398,13 @@ func Open(db walletdb.DB, pubPass []byte, votingEnabled bool, addressReuse bool,
 		&db,
 		params,
 	)
+	w.publicPassphrase = append([]byte(nil), pubPass...)
+
+	// Reload outpoint locks saved by previous runs of the wallet.
+	err = w.loadLockedOutpoints()
+	if err != nil {
+		return nil, err
+	}
 
 	return w, nil
 }
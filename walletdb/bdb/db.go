@@ -331,3 +331,14 @@ func openDB(dbPath string, create bool) (walletdb.DB, error) {
 	boltDB, err := bolt.Open(dbPath, 0600, nil)
 	return (*db)(boltDB), convertErr(err)
 }
+
+// openDBReadOnly opens an existing database without write access.  Attempts
+// to begin a read-write transaction on the returned database fail.
+func openDBReadOnly(dbPath string) (walletdb.DB, error) {
+	if !fileExists(dbPath) {
+		return nil, walletdb.ErrDbDoesNotExist
+	}
+
+	boltDB, err := bolt.Open(dbPath, 0600, &bolt.Options{ReadOnly: true})
+	return (*db)(boltDB), convertErr(err)
+}
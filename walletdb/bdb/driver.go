@@ -32,13 +32,27 @@ func parseArgs(funcName string, args ...interface{}) (string, error) {
 }
 
 // openDBDriver is the callback provided during driver registration that opens
-// an existing database for use.
+// an existing database for use.  An optional second boolean argument opens the
+// database read-only.
 func openDBDriver(args ...interface{}) (walletdb.DB, error) {
+	readOnly := false
+	if len(args) == 2 {
+		ro, ok := args[1].(bool)
+		if !ok {
+			return nil, fmt.Errorf("second argument to %s.Open is "+
+				"invalid -- expected read-only bool", dbType)
+		}
+		readOnly = ro
+		args = args[:1]
+	}
 	dbPath, err := parseArgs("Open", args...)
 	if err != nil {
 		return nil, err
 	}
 
+	if readOnly {
+		return openDBReadOnly(dbPath)
+	}
 	return openDB(dbPath, false)
 }
 
@@ -176,6 +176,71 @@ func TestPersistence(t *testing.T) {
 	}
 }
 
+// TestReadOnly ensures that a database opened read-only can be read from but
+// not modified.
+func TestReadOnly(t *testing.T) {
+	dbPath := "readonlytest.db"
+	db, err := walletdb.Create(dbType, dbPath)
+	if err != nil {
+		t.Errorf("Failed to create test database (%s) %v", dbType, err)
+		return
+	}
+	defer os.Remove(dbPath)
+
+	ns1Key := []byte("ns1")
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		b, err := tx.CreateTopLevelBucket(ns1Key)
+		if err != nil {
+			return err
+		}
+		return b.Put([]byte("key"), []byte("value"))
+	})
+	if err != nil {
+		t.Errorf("Update: unexpected error: %v", err)
+		return
+	}
+	db.Close()
+
+	// Ensure an invalid read-only flag is rejected.
+	wantErr := fmt.Errorf("second argument to %s.Open is invalid -- "+
+		"expected read-only bool", dbType)
+	if _, err := walletdb.Open(dbType, dbPath, 1); err == nil ||
+		err.Error() != wantErr.Error() {
+		t.Errorf("Open: did not receive expected error - got %v, "+
+			"want %v", err, wantErr)
+		return
+	}
+
+	db, err = walletdb.Open(dbType, dbPath, true)
+	if err != nil {
+		t.Errorf("Failed to open test database read-only (%s) %v", dbType, err)
+		return
+	}
+	defer db.Close()
+
+	err = walletdb.View(db, func(tx walletdb.ReadTx) error {
+		b := tx.ReadBucket(ns1Key)
+		if b == nil {
+			return fmt.Errorf("ReadBucket: missing bucket")
+		}
+		if v := b.Get([]byte("key")); string(v) != "value" {
+			return fmt.Errorf("Get: got %q, want %q", v, "value")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Errorf("View: unexpected error: %v", err)
+		return
+	}
+
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		return tx.DeleteTopLevelBucket(ns1Key)
+	})
+	if err == nil {
+		t.Errorf("Update: read-only database allowed modification")
+	}
+}
+
 // TestInterface performs all interfaces tests for this database driver.
 func TestInterface(t *testing.T) {
 	// Create a new database to run tests against.
@@ -0,0 +1,659 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package encdb
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/abcsuite/abcwallet/walletdb"
+)
+
+// Buckets and keys are stored in the wrapped database under the HMAC of their
+// plaintext key and the ID of their parent bucket.  The ID of a nested bucket is
+// the key it is stored under, and top level buckets use rootID as the ID of
+// their parent.
+//
+// Values are stored encrypted after their separately encrypted plaintext key, so
+// keys can be recovered without decrypting values.  The plaintext name of a
+// nested bucket is stored encrypted under bucketNameKey in the bucket
+// itself.  bucketNameKey can never collide with the key of an encrypted value,
+// which is always sha256.Size bytes long.
+var (
+	rootID        = make([]byte, sha256.Size)
+	bucketNameKey = []byte{0}
+)
+
+// maxKeySize is the maximum length of a plaintext key, in bytes.  This matches
+// the limit of the bdb driver.
+const maxKeySize = 32768
+
+// mustDecrypt panics when data of the wrapped database fails to decrypt or
+// authenticate.  It is only used by the Get and cursor methods, which provide
+// no means to report such errors, since continuing with missing or altered
+// data could corrupt the wallet.  Other methods return the error.
+func mustDecrypt(err error) {
+	if err != nil {
+		panic(fmt.Sprintf("encdb: encrypted database is corrupt or has "+
+			"been tampered with: %v", err))
+	}
+}
+
+// encryptedDB wraps a database opened by another driver, encrypting all keys and
+// values.  It implements the walletdb.DB interface.
+type encryptedDB struct {
+	inner walletdb.DB
+	keys  *keys
+}
+
+// Enforce encryptedDB implements the walletdb.Db interface.
+var _ walletdb.DB = (*encryptedDB)(nil)
+
+func (db *encryptedDB) BeginReadTx() (walletdb.ReadTx, error) {
+	tx, err := db.inner.BeginReadTx()
+	if err != nil {
+		return nil, err
+	}
+	err = checkEncrypted(tx)
+	if err != nil {
+		return nil, err
+	}
+	return &transaction{db: db, inner: tx}, nil
+}
+
+func (db *encryptedDB) BeginReadWriteTx() (walletdb.ReadWriteTx, error) {
+	tx, err := db.inner.BeginReadWriteTx()
+	if err != nil {
+		return nil, err
+	}
+	err = checkEncrypted(tx)
+	if err != nil {
+		return nil, err
+	}
+	return &transaction{db: db, inner: tx, writable: true}, nil
+}
+
+// checkEncrypted rolls back a transaction of the wrapped database and returns
+// ErrNotEncrypted if the database no longer holds the encryption metadata, so
+// that unencrypted data is reported as an error before it is read.
+func checkEncrypted(tx walletdb.ReadTx) error {
+	if tx.ReadBucket(metaBucketKey) != nil {
+		return nil
+	}
+	tx.Rollback()
+	return ErrNotEncrypted
+}
+
+// Copy writes a copy of the wrapped database to the provided writer.  All data
+// of the copy remains encrypted.
+//
+// This function is part of the walletdb.Db interface implementation.
+func (db *encryptedDB) Copy(w io.Writer) error {
+	return db.inner.Copy(w)
+}
+
+// Close closes the wrapped database and zeroes the encryption keys.
+//
+// This function is part of the walletdb.Db interface implementation.
+func (db *encryptedDB) Close() error {
+	err := db.inner.Close()
+	if err != nil {
+		return err
+	}
+	db.keys.zero()
+	return nil
+}
+
+// indexEntry describes a key of a bucket in the plaintext key order.
+type indexEntry struct {
+	key       []byte
+	storedKey []byte
+	isBucket  bool
+}
+
+// index holds all keys of a bucket sorted by plaintext key.  As the keys of the
+// wrapped database are HMACs, they are iterated in an unrelated order, and each
+// transaction reads the plaintext keys of the buckets it iterates into an index.
+type index struct {
+	entries []indexEntry
+}
+
+// byKey implements sort.Interface to sort index entries by plaintext key.
+type byKey []indexEntry
+
+func (s byKey) Len() int           { return len(s) }
+func (s byKey) Less(i, j int) bool { return bytes.Compare(s[i].key, s[j].key) < 0 }
+func (s byKey) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+func (idx *index) search(key []byte) (int, bool) {
+	i := sort.Search(len(idx.entries), func(i int) bool {
+		return bytes.Compare(idx.entries[i].key, key) >= 0
+	})
+	return i, i < len(idx.entries) && bytes.Equal(idx.entries[i].key, key)
+}
+
+func (idx *index) add(e indexEntry) {
+	i, ok := idx.search(e.key)
+	if ok {
+		return
+	}
+	idx.entries = append(idx.entries, indexEntry{})
+	copy(idx.entries[i+1:], idx.entries[i:])
+	idx.entries[i] = e
+}
+
+func (idx *index) remove(key []byte) {
+	i, ok := idx.search(key)
+	if !ok {
+		return
+	}
+	copy(idx.entries[i:], idx.entries[i+1:])
+	idx.entries[len(idx.entries)-1] = indexEntry{}
+	idx.entries = idx.entries[:len(idx.entries)-1]
+}
+
+// transaction represents a database transaction.  It can either by read-only or
+// read-write and implements the walletdb Tx interfaces.
+type transaction struct {
+	db       *encryptedDB
+	inner    walletdb.ReadTx
+	writable bool
+
+	// indexes holds the plaintext key index of each bucket iterated by the
+	// transaction, keyed by bucket ID.  Indexes are updated by writes made
+	// through the transaction.
+	indexes map[string]*index
+}
+
+// Enforce transaction implements the walletdb transaction interfaces.
+var _ walletdb.ReadWriteTx = (*transaction)(nil)
+
+func (tx *transaction) ReadBucket(key []byte) walletdb.ReadBucket {
+	return tx.ReadWriteBucket(key)
+}
+
+func (tx *transaction) ReadWriteBucket(key []byte) walletdb.ReadWriteBucket {
+	storedKey := tx.db.keys.keyHash(rootID, key)
+	inner := tx.inner.ReadBucket(storedKey)
+	// Don't return a non-nil interface to a nil pointer.
+	if inner == nil {
+		return nil
+	}
+	return &bucket{tx: tx, inner: inner, id: storedKey}
+}
+
+func (tx *transaction) CreateTopLevelBucket(key []byte) (walletdb.ReadWriteBucket, error) {
+	if !tx.writable {
+		return nil, walletdb.ErrTxNotWritable
+	}
+	if len(key) == 0 {
+		return nil, walletdb.ErrBucketNameRequired
+	}
+	storedKey := tx.db.keys.keyHash(rootID, key)
+	inner, err := tx.inner.(walletdb.ReadWriteTx).CreateTopLevelBucket(storedKey)
+	if err != nil {
+		return nil, err
+	}
+	return tx.initBucket(inner, storedKey, key)
+}
+
+// initBucket records the encrypted name of a newly created bucket.
+func (tx *transaction) initBucket(inner walletdb.ReadWriteBucket, storedKey, name []byte) (*bucket, error) {
+	sealedName, err := tx.db.keys.enc.Encrypt(name)
+	if err != nil {
+		return nil, err
+	}
+	err = inner.Put(bucketNameKey, sealedName)
+	if err != nil {
+		return nil, err
+	}
+	return &bucket{tx: tx, inner: inner, id: storedKey}, nil
+}
+
+func (tx *transaction) DeleteTopLevelBucket(key []byte) error {
+	if !tx.writable {
+		return walletdb.ErrTxNotWritable
+	}
+	storedKey := tx.db.keys.keyHash(rootID, key)
+	err := tx.inner.(walletdb.ReadWriteTx).DeleteTopLevelBucket(storedKey)
+	if err != nil {
+		return err
+	}
+	// The indexes of the deleted bucket and all of its nested buckets
+	// are no longer valid.
+	tx.indexes = nil
+	return nil
+}
+
+// Commit commits all changes that have been made through the root bucket and
+// all of its sub-buckets to the wrapped database.
+//
+// This function is part of the walletdb.Tx interface implementation.
+func (tx *transaction) Commit() error {
+	if !tx.writable {
+		return walletdb.ErrTxNotWritable
+	}
+	tx.indexes = nil
+	return tx.inner.(walletdb.ReadWriteTx).Commit()
+}
+
+// Rollback undoes all changes that have been made to the root bucket and all of
+// its sub-buckets.
+//
+// This function is part of the walletdb.Tx interface implementation.
+func (tx *transaction) Rollback() error {
+	tx.indexes = nil
+	return tx.inner.Rollback()
+}
+
+// index returns the plaintext key index of the bucket b, reading it from the
+// wrapped database if the bucket has not been iterated by the transaction.
+// Only the plaintext keys are decrypted.
+func (tx *transaction) index(b *bucket) (*index, error) {
+	if idx, ok := tx.indexes[string(b.id)]; ok {
+		return idx, nil
+	}
+
+	idx := new(index)
+	err := b.inner.ForEach(func(k, v []byte) error {
+		if len(k) != sha256.Size {
+			return nil
+		}
+		e := indexEntry{storedKey: copyBytes(k)}
+		if v == nil {
+			nested := b.inner.NestedReadBucket(k)
+			name, err := tx.db.keys.openBucketName(b.id, k,
+				nested.Get(bucketNameKey))
+			if err != nil {
+				return err
+			}
+			e.key = name
+			e.isBucket = true
+		} else {
+			key, err := tx.db.keys.openKey(b.id, k, v)
+			if err != nil {
+				return err
+			}
+			e.key = key
+		}
+		idx.entries = append(idx.entries, e)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Sort(byKey(idx.entries))
+
+	if tx.indexes == nil {
+		tx.indexes = make(map[string]*index)
+	}
+	tx.indexes[string(b.id)] = idx
+	return idx, nil
+}
+
+// mustIndex returns the plaintext key index of the bucket b, panicking if any
+// key fails to decrypt.
+func (tx *transaction) mustIndex(b *bucket) *index {
+	idx, err := tx.index(b)
+	mustDecrypt(err)
+	return idx
+}
+
+// cachedIndex returns the index of the bucket if it has already been read by
+// the transaction, or nil.
+func (tx *transaction) cachedIndex(b *bucket) *index {
+	return tx.indexes[string(b.id)]
+}
+
+// bucket is an internal type used to represent a collection of key/value pairs
+// and implements the walletdb Bucket interfaces.
+type bucket struct {
+	tx    *transaction
+	inner walletdb.ReadBucket
+	id    []byte
+}
+
+// Enforce bucket implements the walletdb Bucket interfaces.
+var _ walletdb.ReadWriteBucket = (*bucket)(nil)
+
+// writable returns the wrapped bucket for writes, or ErrTxNotWritable if the
+// transaction is read-only.
+func (b *bucket) writable() (walletdb.ReadWriteBucket, error) {
+	if !b.tx.writable {
+		return nil, walletdb.ErrTxNotWritable
+	}
+	return b.inner.(walletdb.ReadWriteBucket), nil
+}
+
+// NestedReadWriteBucket retrieves a nested bucket with the given key.  Returns
+// nil if the bucket does not exist.
+//
+// This function is part of the walletdb.ReadWriteBucket interface implementation.
+func (b *bucket) NestedReadWriteBucket(key []byte) walletdb.ReadWriteBucket {
+	storedKey := b.tx.db.keys.keyHash(b.id, key)
+	inner := b.inner.NestedReadBucket(storedKey)
+	// Don't return a non-nil interface to a nil pointer.
+	if inner == nil {
+		return nil
+	}
+	return &bucket{tx: b.tx, inner: inner, id: storedKey}
+}
+
+func (b *bucket) NestedReadBucket(key []byte) walletdb.ReadBucket {
+	return b.NestedReadWriteBucket(key)
+}
+
+// CreateBucket creates and returns a new nested bucket with the given key.
+// Returns ErrBucketExists if the bucket already exists, ErrBucketNameRequired
+// if the key is empty, or ErrIncompatibleValue if the key value is otherwise
+// invalid.
+//
+// This function is part of the walletdb.Bucket interface implementation.
+func (b *bucket) CreateBucket(key []byte) (walletdb.ReadWriteBucket, error) {
+	w, err := b.writable()
+	if err != nil {
+		return nil, err
+	}
+	if len(key) == 0 {
+		return nil, walletdb.ErrBucketNameRequired
+	}
+	if len(key) > maxKeySize {
+		return nil, walletdb.ErrKeyTooLarge
+	}
+
+	storedKey := b.tx.db.keys.keyHash(b.id, key)
+	inner, err := w.CreateBucket(storedKey)
+	if err != nil {
+		return nil, err
+	}
+	nested, err := b.tx.initBucket(inner, storedKey, key)
+	if err != nil {
+		return nil, err
+	}
+	if idx := b.tx.cachedIndex(b); idx != nil {
+		idx.add(indexEntry{key: copyBytes(key), storedKey: storedKey, isBucket: true})
+	}
+	return nested, nil
+}
+
+// CreateBucketIfNotExists creates and returns a new nested bucket with the
+// given key if it does not already exist.  Returns ErrBucketNameRequired if the
+// key is empty or ErrIncompatibleValue if the key value is otherwise invalid.
+//
+// This function is part of the walletdb.Bucket interface implementation.
+func (b *bucket) CreateBucketIfNotExists(key []byte) (walletdb.ReadWriteBucket, error) {
+	nested, err := b.CreateBucket(key)
+	if err == walletdb.ErrBucketExists {
+		return b.NestedReadWriteBucket(key), nil
+	}
+	return nested, err
+}
+
+// DeleteNestedBucket removes a nested bucket with the given key.  Returns
+// ErrTxNotWritable if attempted against a read-only transaction and
+// ErrBucketNotFound if the specified bucket does not exist.
+//
+// This function is part of the walletdb.Bucket interface implementation.
+func (b *bucket) DeleteNestedBucket(key []byte) error {
+	w, err := b.writable()
+	if err != nil {
+		return err
+	}
+	// Buckets are never named by an empty key.
+	if len(key) == 0 {
+		return walletdb.ErrIncompatibleValue
+	}
+
+	err = w.DeleteNestedBucket(b.tx.db.keys.keyHash(b.id, key))
+	if err != nil {
+		return err
+	}
+	// The indexes of the deleted bucket and all of its nested buckets
+	// are no longer valid.
+	b.tx.indexes = nil
+	return nil
+}
+
+// ForEach invokes the passed function with every key/value pair in the bucket.
+// This includes nested buckets, in which case the value is nil, but it does not
+// include the key/value pairs within those nested buckets.
+//
+// This function is part of the walletdb.Bucket interface implementation.
+func (b *bucket) ForEach(fn func(k, v []byte) error) error {
+	// As with cursors, the next entry is searched by key after each call,
+	// since the index may be modified by fn.
+	var key []byte
+	for {
+		idx, err := b.tx.index(b)
+		if err != nil {
+			return err
+		}
+		i := 0
+		if key != nil {
+			var ok bool
+			i, ok = idx.search(key)
+			if ok {
+				i++
+			}
+		}
+		if i >= len(idx.entries) {
+			return nil
+		}
+		e := &idx.entries[i]
+		key = e.key
+		var value []byte
+		if !e.isBucket {
+			value, err = b.value(e.storedKey)
+			if err != nil {
+				return err
+			}
+		}
+		err = fn(e.key, value)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// Put saves the specified key/value pair to the bucket.  Keys that do not
+// already exist are added and keys that already exist are overwritten.  Returns
+// ErrTxNotWritable if attempted against a read-only transaction.
+//
+// This function is part of the walletdb.Bucket interface implementation.
+func (b *bucket) Put(key, value []byte) error {
+	w, err := b.writable()
+	if err != nil {
+		return err
+	}
+	if len(key) == 0 {
+		return walletdb.ErrKeyRequired
+	}
+	if len(key) > maxKeySize {
+		return walletdb.ErrKeyTooLarge
+	}
+
+	storedKey := b.tx.db.keys.keyHash(b.id, key)
+	sealed, err := b.tx.db.keys.sealValue(storedKey, key, value)
+	if err != nil {
+		return err
+	}
+	err = w.Put(storedKey, sealed)
+	if err != nil {
+		return err
+	}
+	if idx := b.tx.cachedIndex(b); idx != nil {
+		idx.add(indexEntry{key: copyBytes(key), storedKey: storedKey})
+	}
+	return nil
+}
+
+// value returns the decrypted value stored under storedKey, or nil if there is
+// no value.
+func (b *bucket) value(storedKey []byte) ([]byte, error) {
+	sealed := b.inner.Get(storedKey)
+	if sealed == nil {
+		return nil, nil
+	}
+	return b.tx.db.keys.openValue(storedKey, sealed)
+}
+
+// get returns the decrypted value stored under storedKey, or nil if there is
+// no value, panicking if the value fails to decrypt.
+func (b *bucket) get(storedKey []byte) []byte {
+	value, err := b.value(storedKey)
+	mustDecrypt(err)
+	return value
+}
+
+// Get returns the value for the given key.  Returns nil if the key does
+// not exist in this bucket (or nested buckets).
+//
+// This function is part of the walletdb.Bucket interface implementation.
+func (b *bucket) Get(key []byte) []byte {
+	return b.get(b.tx.db.keys.keyHash(b.id, key))
+}
+
+// Delete removes the specified key from the bucket.  Deleting a key that does
+// not exist does not return an error.  Returns ErrTxNotWritable if attempted
+// against a read-only transaction.
+//
+// This function is part of the walletdb.Bucket interface implementation.
+func (b *bucket) Delete(key []byte) error {
+	w, err := b.writable()
+	if err != nil {
+		return err
+	}
+
+	err = w.Delete(b.tx.db.keys.keyHash(b.id, key))
+	if err != nil {
+		return err
+	}
+	if idx := b.tx.cachedIndex(b); idx != nil {
+		idx.remove(key)
+	}
+	return nil
+}
+
+func (b *bucket) cursor() *cursor {
+	return &cursor{bucket: b}
+}
+
+func (b *bucket) ReadCursor() walletdb.ReadCursor {
+	return b.cursor()
+}
+
+// ReadWriteCursor returns a new cursor, allowing for iteration over the bucket's
+// key/value pairs and nested buckets in forward or backward order.
+//
+// This function is part of the walletdb.Bucket interface implementation.
+func (b *bucket) ReadWriteCursor() walletdb.ReadWriteCursor {
+	return b.cursor()
+}
+
+// cursor represents a cursor over key/value pairs and nested buckets of a
+// bucket in plaintext key order.
+//
+// The cursor only remembers the key it is positioned at, and each movement
+// searches the index of the bucket for the next key.  Modifying the bucket
+// therefore never invalidates the cursor.
+type cursor struct {
+	bucket *bucket
+	key    []byte
+}
+
+// Enforce cursor implements the walletdb Cursor interfaces.
+var _ walletdb.ReadWriteCursor = (*cursor)(nil)
+
+// moveTo positions the cursor at the entry with index i and returns the pair.
+// The cursor is unpositioned if the index is out of range.
+func (c *cursor) moveTo(idx *index, i int) (key, value []byte) {
+	if i < 0 || i >= len(idx.entries) {
+		c.key = nil
+		return nil, nil
+	}
+	e := &idx.entries[i]
+	c.key = e.key
+	if e.isBucket {
+		return e.key, nil
+	}
+	return e.key, c.bucket.get(e.storedKey)
+}
+
+// Delete removes the current key/value pair the cursor is at without
+// invalidating the cursor. Returns ErrTxNotWritable if attempted on a read-only
+// transaction, or ErrIncompatibleValue if attempted when the cursor points to a
+// nested bucket.
+//
+// This function is part of the walletdb.Cursor interface implementation.
+func (c *cursor) Delete() error {
+	if _, err := c.bucket.writable(); err != nil {
+		return err
+	}
+	if c.key == nil {
+		return nil
+	}
+	return c.bucket.Delete(c.key)
+}
+
+// First positions the cursor at the first key/value pair and returns the pair.
+//
+// This function is part of the walletdb.Cursor interface implementation.
+func (c *cursor) First() (key, value []byte) {
+	return c.moveTo(c.bucket.tx.mustIndex(c.bucket), 0)
+}
+
+// Last positions the cursor at the last key/value pair and returns the pair.
+//
+// This function is part of the walletdb.Cursor interface implementation.
+func (c *cursor) Last() (key, value []byte) {
+	idx := c.bucket.tx.mustIndex(c.bucket)
+	return c.moveTo(idx, len(idx.entries)-1)
+}
+
+// Next moves the cursor one key/value pair forward and returns the new pair.
+//
+// This function is part of the walletdb.Cursor interface implementation.
+func (c *cursor) Next() (key, value []byte) {
+	idx := c.bucket.tx.mustIndex(c.bucket)
+	if c.key == nil {
+		return c.moveTo(idx, -1)
+	}
+	i, ok := idx.search(c.key)
+	if ok {
+		i++
+	}
+	return c.moveTo(idx, i)
+}
+
+// Prev moves the cursor one key/value pair backward and returns the new pair.
+//
+// This function is part of the walletdb.Cursor interface implementation.
+func (c *cursor) Prev() (key, value []byte) {
+	idx := c.bucket.tx.mustIndex(c.bucket)
+	if c.key == nil {
+		return c.moveTo(idx, -1)
+	}
+	i, _ := idx.search(c.key)
+	return c.moveTo(idx, i-1)
+}
+
+// Seek positions the cursor at the passed seek key. If the key does not exist,
+// the cursor is moved to the next key after seek. Returns the new pair.
+//
+// This function is part of the walletdb.Cursor interface implementation.
+func (c *cursor) Seek(seek []byte) (key, value []byte) {
+	idx := c.bucket.tx.mustIndex(c.bucket)
+	i, _ := idx.search(seek)
+	return c.moveTo(idx, i)
+}
+
+func copyBytes(b []byte) []byte {
+	c := make([]byte, len(b))
+	copy(c, b)
+	return c
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+/*
+Package encdb implements an instance of walletdb that wraps a database of
+another driver and encrypts all data at rest.
+
+Every key and value written through the wrapper is encrypted before it reaches
+the wrapped database.  Keys are stored as an HMAC of the plaintext key, and
+values are stored encrypted after their separately encrypted plaintext key,
+using NaCl secretbox.  The names of buckets are protected the same way.  The data
+keys are generated randomly and are stored encrypted by a key derived from a
+passphrase using scrypt, so the passphrase may be changed without rewriting any
+data.  Abcwallet uses the public passphrase of the wallet.
+
+All walletdb semantics are preserved, including iteration of keys in sorted
+order.  As the wrapped database orders keys by their HMAC, each transaction
+reads the plaintext keys of a bucket into memory the first time the bucket is
+iterated with a cursor or ForEach.  Only the keys are decrypted to build this
+index, and values are decrypted as they are read.
+
+Usage
+
+This package is a driver to the walletdb package and provides the database type
+of "encdb".  The first parameter to Create and Open is the database type of the
+wrapped driver, the second is the passphrase, and all remaining parameters are
+passed to the wrapped driver:
+
+	db, err := walletdb.Create("encdb", "bdb", passphrase, "path/to/database.db")
+	if err != nil {
+		// Handle error
+	}
+
+	db, err := walletdb.Open("encdb", "bdb", passphrase, "path/to/database.db")
+	if err != nil {
+		// Handle error
+	}
+
+Databases already opened by another driver may be checked for encryption with
+Detect and opened with Wrap.
+*/
+package encdb
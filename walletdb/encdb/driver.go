@@ -0,0 +1,92 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package encdb
+
+import (
+	"fmt"
+
+	"github.com/abcsuite/abcwallet/walletdb"
+)
+
+const (
+	dbType = "encdb"
+)
+
+// parseArgs parses the arguments from the walletdb Open/Create methods.  The
+// first argument is the type of the wrapped database driver, the second is the
+// passphrase, and all remaining arguments are passed to the wrapped driver.
+func parseArgs(funcName string, args ...interface{}) (string, []byte, []interface{}, error) {
+	if len(args) < 2 {
+		return "", nil, nil, fmt.Errorf("invalid arguments to %s.%s -- "+
+			"expected database type and passphrase", dbType, funcName)
+	}
+
+	innerType, ok := args[0].(string)
+	if !ok {
+		return "", nil, nil, fmt.Errorf("first argument to %s.%s is "+
+			"invalid -- expected database type string", dbType, funcName)
+	}
+
+	passphrase, ok := args[1].([]byte)
+	if !ok {
+		return "", nil, nil, fmt.Errorf("second argument to %s.%s is "+
+			"invalid -- expected passphrase bytes", dbType, funcName)
+	}
+
+	return innerType, passphrase, args[2:], nil
+}
+
+// openDBDriver is the callback provided during driver registration that opens
+// an existing encrypted database using the wrapped driver.
+func openDBDriver(args ...interface{}) (walletdb.DB, error) {
+	innerType, passphrase, innerArgs, err := parseArgs("Open", args...)
+	if err != nil {
+		return nil, err
+	}
+
+	inner, err := walletdb.Open(innerType, innerArgs...)
+	if err != nil {
+		return nil, err
+	}
+	db, err := Wrap(inner, passphrase)
+	if err != nil {
+		inner.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// createDBDriver is the callback provided during driver registration that
+// creates a database using the wrapped driver and initializes its encryption.
+func createDBDriver(args ...interface{}) (walletdb.DB, error) {
+	innerType, passphrase, innerArgs, err := parseArgs("Create", args...)
+	if err != nil {
+		return nil, err
+	}
+
+	inner, err := walletdb.Create(innerType, innerArgs...)
+	if err != nil {
+		return nil, err
+	}
+	db, err := Init(inner, passphrase)
+	if err != nil {
+		inner.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+func init() {
+	// Register the driver.
+	driver := walletdb.Driver{
+		DbType: dbType,
+		Create: createDBDriver,
+		Open:   openDBDriver,
+	}
+	if err := walletdb.RegisterDriver(driver); err != nil {
+		panic(fmt.Sprintf("Failed to register database driver '%s': %v",
+			dbType, err))
+	}
+}
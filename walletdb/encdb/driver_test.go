@@ -0,0 +1,414 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package encdb_test
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/abcsuite/abcwallet/walletdb"
+	"github.com/abcsuite/abcwallet/walletdb/encdb"
+	_ "github.com/abcsuite/abcwallet/walletdb/memdb"
+)
+
+// dbType is the database type name for this driver.
+const dbType = "encdb"
+
+// innerType is the database type name of the wrapped driver used by the tests.
+const innerType = "memdb"
+
+var passphrase = []byte("passphrase")
+
+func init() {
+	encdb.TstUseFastScrypt()
+}
+
+// TestCreateOpenFail ensures that errors related to creating and opening a
+// database are handled properly.
+func TestCreateOpenFail(t *testing.T) {
+	// Ensure that attempting to open a database with the wrong number of
+	// parameters returns the expected error.
+	wantErr := fmt.Errorf("invalid arguments to %s.Open -- expected "+
+		"database type and passphrase", dbType)
+	if _, err := walletdb.Open(dbType, innerType); err.Error() != wantErr.Error() {
+		t.Errorf("Open: did not receive expected error - got %v, "+
+			"want %v", err, wantErr)
+		return
+	}
+
+	// Ensure that attempting to open a database with an invalid type for
+	// the first parameter returns the expected error.
+	wantErr = fmt.Errorf("first argument to %s.Open is invalid -- "+
+		"expected database type string", dbType)
+	if _, err := walletdb.Open(dbType, 1, passphrase); err.Error() != wantErr.Error() {
+		t.Errorf("Open: did not receive expected error - got %v, "+
+			"want %v", err, wantErr)
+		return
+	}
+
+	// Ensure that attempting to create a database with an invalid type for
+	// the second parameter returns the expected error.
+	wantErr = fmt.Errorf("second argument to %s.Create is invalid -- "+
+		"expected passphrase bytes", dbType)
+	if _, err := walletdb.Create(dbType, innerType, "passphrase"); err.Error() != wantErr.Error() {
+		t.Errorf("Create: did not receive expected error - got %v, "+
+			"want %v", err, wantErr)
+		return
+	}
+
+	// Ensure that errors of the wrapped driver are returned.
+	wantErr = walletdb.ErrDbUnknownType
+	if _, err := walletdb.Create(dbType, "noexist", passphrase); err != wantErr {
+		t.Errorf("Create: did not receive expected error - got %v, "+
+			"want %v", err, wantErr)
+		return
+	}
+
+	// Ensure operations against a closed database return the expected
+	// error.
+	db, err := walletdb.Create(dbType, innerType, passphrase)
+	if err != nil {
+		t.Errorf("Create: unexpected error: %v", err)
+		return
+	}
+	db.Close()
+
+	wantErr = walletdb.ErrDbNotOpen
+	if _, err := db.BeginReadTx(); err != wantErr {
+		t.Errorf("BeginReadTx: did not receive expected error - got %v, "+
+			"want %v", err, wantErr)
+		return
+	}
+	if _, err := db.BeginReadWriteTx(); err != wantErr {
+		t.Errorf("BeginReadWriteTx: did not receive expected error - "+
+			"got %v, want %v", err, wantErr)
+		return
+	}
+}
+
+// TestInterface performs all interfaces tests for this database driver.
+func TestInterface(t *testing.T) {
+	// Create a new database to run tests against.
+	db, err := walletdb.Create(dbType, innerType, passphrase)
+	if err != nil {
+		t.Errorf("Failed to create test database (%s) %v", dbType, err)
+		return
+	}
+	defer db.Close()
+
+	// Run all of the interface tests against the database.
+	testInterface(t, db)
+}
+
+// TestWrap ensures that encrypted databases are detected and may only be
+// wrapped using the correct passphrase, and that the passphrase may be changed.
+func TestWrap(t *testing.T) {
+	inner, err := walletdb.Create(innerType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer inner.Close()
+
+	if _, err := encdb.Wrap(inner, passphrase); err != encdb.ErrNotEncrypted {
+		t.Errorf("Wrap: got %v, want %v", err, encdb.ErrNotEncrypted)
+	}
+	encrypted, err := encdb.Detect(inner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if encrypted {
+		t.Errorf("Detect: unencrypted database detected as encrypted")
+	}
+
+	db, err := encdb.Init(inner, passphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !encdb.IsWrapped(db) || encdb.IsWrapped(inner) {
+		t.Errorf("IsWrapped: did not identify the wrapped database")
+	}
+	if _, err := encdb.Init(inner, passphrase); err != encdb.ErrEncrypted {
+		t.Errorf("Init: got %v, want %v", err, encdb.ErrEncrypted)
+	}
+	encrypted, err = encdb.Detect(inner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !encrypted {
+		t.Errorf("Detect: encrypted database not detected")
+	}
+
+	nsKey, key, value := []byte("ns"), []byte("key"), []byte("value")
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		b, err := tx.CreateTopLevelBucket(nsKey)
+		if err != nil {
+			return err
+		}
+		return b.Put(key, value)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newPassphrase := []byte("new passphrase")
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		err := encdb.ChangePassphrase(tx, newPassphrase, newPassphrase)
+		if err != encdb.ErrWrongPassphrase {
+			t.Errorf("ChangePassphrase: got %v, want %v", err,
+				encdb.ErrWrongPassphrase)
+		}
+		return encdb.ChangePassphrase(tx, passphrase, newPassphrase)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := encdb.Wrap(inner, passphrase); err != encdb.ErrWrongPassphrase {
+		t.Errorf("Wrap: got %v, want %v", err, encdb.ErrWrongPassphrase)
+	}
+	rewrapped, err := encdb.Wrap(inner, newPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = walletdb.View(rewrapped, func(tx walletdb.ReadTx) error {
+		b := tx.ReadBucket(nsKey)
+		if b == nil {
+			return fmt.Errorf("missing bucket %s", nsKey)
+		}
+		if got := b.Get(key); !bytes.Equal(got, value) {
+			return fmt.Errorf("key %s has value %q, want %q", key, got, value)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+// TestCursor ensures cursors iterate the plaintext keys and nested buckets in
+// order in both directions, even though the wrapped database orders them by
+// their encrypted keys.
+func TestCursor(t *testing.T) {
+	db, err := walletdb.Create(dbType, innerType, passphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	nsKey := []byte("ns")
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		b, err := tx.CreateTopLevelBucket(nsKey)
+		if err != nil {
+			return err
+		}
+		for _, k := range []string{"d", "b", "a", "e"} {
+			err = b.Put([]byte(k), []byte("v"+k))
+			if err != nil {
+				return err
+			}
+		}
+		_, err = b.CreateBucket([]byte("c"))
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		b := tx.ReadWriteBucket(nsKey)
+		var keys []string
+		c := b.ReadWriteCursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			keys = append(keys, string(k))
+			if string(k) == "c" && v != nil {
+				t.Errorf("nested bucket has non-nil value %x", v)
+			} else if string(k) != "c" && string(v) != "v"+string(k) {
+				t.Errorf("key %s has value %q", k, v)
+			}
+		}
+		if want := []string{"a", "b", "c", "d", "e"}; !reflect.DeepEqual(keys, want) {
+			t.Errorf("forward iteration got %v, want %v", keys, want)
+		}
+
+		keys = keys[:0]
+		for k, _ := c.Last(); k != nil; k, _ = c.Prev() {
+			keys = append(keys, string(k))
+		}
+		if want := []string{"e", "d", "c", "b", "a"}; !reflect.DeepEqual(keys, want) {
+			t.Errorf("backward iteration got %v, want %v", keys, want)
+		}
+
+		if k, v := c.Seek([]byte("bb")); string(k) != "c" || v != nil {
+			t.Errorf("Seek got %s, want c", k)
+		}
+
+		// Keys added after the cursor is created must be visible to it.
+		err := b.Put([]byte("ba"), []byte("vba"))
+		if err != nil {
+			return err
+		}
+		if k, _ := c.Seek([]byte("b")); string(k) != "b" {
+			t.Errorf("Seek got %s, want b", k)
+		}
+		if k, _ := c.Next(); string(k) != "ba" {
+			t.Errorf("Next got %s, want ba", k)
+		}
+
+		// Delete every key holding a value while iterating.
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			if v == nil {
+				continue
+			}
+			err := c.Delete()
+			if err != nil {
+				return err
+			}
+		}
+		keys = keys[:0]
+		err = b.ForEach(func(k, v []byte) error {
+			keys = append(keys, string(k))
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if want := []string{"c"}; !reflect.DeepEqual(keys, want) {
+			t.Errorf("keys after cursor deletes got %v, want %v", keys, want)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestNoPlaintext ensures that none of the bucket names, keys, or values written
+// through the driver are stored in plaintext by the wrapped database.
+func TestNoPlaintext(t *testing.T) {
+	inner, err := walletdb.Create(innerType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := encdb.Init(inner, passphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	secrets := [][]byte{
+		[]byte("topsecretbucket"),
+		[]byte("nestedsecretbucket"),
+		[]byte("secretkeymaterial"),
+		[]byte("secretvaluematerial"),
+	}
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		b, err := tx.CreateTopLevelBucket(secrets[0])
+		if err != nil {
+			return err
+		}
+		nested, err := b.CreateBucket(secrets[1])
+		if err != nil {
+			return err
+		}
+		return nested.Put(secrets[2], secrets[3])
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	err = inner.Copy(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range secrets {
+		if bytes.Contains(buf.Bytes(), s) {
+			t.Errorf("wrapped database contains plaintext %q", s)
+		}
+	}
+
+	err = walletdb.View(db, func(tx walletdb.ReadTx) error {
+		b := tx.ReadBucket(secrets[0]).NestedReadBucket(secrets[1])
+		if got := b.Get(secrets[2]); !bytes.Equal(got, secrets[3]) {
+			return fmt.Errorf("key %s has value %q, want %q", secrets[2],
+				got, secrets[3])
+		}
+		return nil
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+// TestTampered ensures that values moved to another key of the wrapped database
+// and missing encryption metadata are reported as errors.
+func TestTampered(t *testing.T) {
+	inner, err := walletdb.Create(innerType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := encdb.Init(inner, passphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	bucketKey := []byte("bucket")
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		b, err := tx.CreateTopLevelBucket(bucketKey)
+		if err != nil {
+			return err
+		}
+		err = b.Put([]byte("key1"), []byte("value1"))
+		if err != nil {
+			return err
+		}
+		return b.Put([]byte("key2"), []byte("value2"))
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Swap the sealed values of both keys in the wrapped database.
+	storedBucketKey, storedKey1 := encdb.TstStoredKeys(db, bucketKey, []byte("key1"))
+	_, storedKey2 := encdb.TstStoredKeys(db, bucketKey, []byte("key2"))
+	err = walletdb.Update(inner, func(tx walletdb.ReadWriteTx) error {
+		b := tx.ReadWriteBucket(storedBucketKey)
+		v1 := append([]byte(nil), b.Get(storedKey1)...)
+		v2 := append([]byte(nil), b.Get(storedKey2)...)
+		err := b.Put(storedKey1, v2)
+		if err != nil {
+			return err
+		}
+		return b.Put(storedKey2, v1)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = walletdb.View(db, func(tx walletdb.ReadTx) error {
+		return tx.ReadBucket(bucketKey).ForEach(func(k, v []byte) error {
+			return nil
+		})
+	})
+	if err == nil {
+		t.Error("ForEach over tampered bucket did not return an error")
+	}
+
+	// Remove the encryption metadata.
+	err = walletdb.Update(inner, func(tx walletdb.ReadWriteTx) error {
+		return tx.DeleteTopLevelBucket([]byte("encdb"))
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = db.BeginReadTx()
+	if err != encdb.ErrNotEncrypted {
+		t.Errorf("BeginReadTx without metadata returned error %v, want %v",
+			err, encdb.ErrNotEncrypted)
+	}
+}
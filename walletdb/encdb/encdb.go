@@ -0,0 +1,352 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package encdb
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+
+	"github.com/abcsuite/abcwallet/snacl"
+	"github.com/abcsuite/abcwallet/walletdb"
+)
+
+// Errors returned when initializing, opening, or rekeying encrypted databases.
+var (
+	// ErrNotEncrypted is returned when an encrypted database is required
+	// but the database does not hold encrypted data.
+	ErrNotEncrypted = errors.New("database is not encrypted")
+
+	// ErrEncrypted is returned when initializing encryption of a database
+	// which is already encrypted.
+	ErrEncrypted = errors.New("database is already encrypted")
+
+	// ErrWrongPassphrase is returned when the passphrase does not derive
+	// the key of an encrypted database.
+	ErrWrongPassphrase = errors.New("wrong passphrase for encrypted database")
+
+	// ErrUnknownVersion is returned when an encrypted database was written
+	// by a newer version of this package.
+	ErrUnknownVersion = errors.New("unknown encrypted database version")
+)
+
+// The encryption metadata is stored in a single top level bucket of the wrapped
+// database which is not itself encrypted.  Its key can never collide with the
+// keys of encrypted buckets, which are always sha256.Size bytes long.
+var (
+	metaBucketKey = []byte("encdb")
+
+	// versionKey records the version of the encrypted format.
+	versionKey = []byte("version")
+
+	// passphraseParamsKey records the marshaled snacl parameters used to
+	// derive the passphrase key.
+	passphraseParamsKey = []byte("passparams")
+
+	// dataKeysKey records the data encryption and MAC keys, encrypted by the
+	// passphrase key.
+	dataKeysKey = []byte("datakeys")
+)
+
+// version is the current version of the encrypted format.
+const version = 1
+
+// scryptOptions are the parameters used to derive the passphrase key of newly
+// encrypted databases.  These match the parameters used to protect the public
+// master key of the wallet.
+var scryptOptions = struct{ N, R, P int }{
+	N: 262144, // 2^18
+	R: 8,
+	P: 1,
+}
+
+// keys holds the secrets used to encrypt the data of a database.  The data keys
+// are generated randomly when encryption is initialized and never change, so
+// changing the passphrase only requires encrypting them again.
+type keys struct {
+	enc snacl.CryptoKey
+	mac [sha256.Size]byte
+}
+
+// keyHash returns the key used in the wrapped database for the plaintext key of
+// the bucket identified by parentID.
+func (k *keys) keyHash(parentID, key []byte) []byte {
+	h := hmac.New(sha256.New, k.mac[:])
+	h.Write(parentID)
+	h.Write(key)
+	return h.Sum(nil)
+}
+
+// sealValue encrypts a key/value pair stored under storedKey.  The plaintext
+// key and the value are encrypted separately so that iterating a bucket only
+// decrypts keys.  The sealed key is prefixed by its length, and the value is
+// encrypted together with storedKey to bind it to its location.
+func (k *keys) sealValue(storedKey, key, value []byte) ([]byte, error) {
+	sealedKey, err := k.enc.Encrypt(key)
+	if err != nil {
+		return nil, err
+	}
+	plaintext := make([]byte, 0, len(storedKey)+len(value))
+	plaintext = append(plaintext, storedKey...)
+	plaintext = append(plaintext, value...)
+	sealedValue, err := k.enc.Encrypt(plaintext)
+	if err != nil {
+		return nil, err
+	}
+	sealed := make([]byte, binary.MaxVarintLen64+len(sealedKey)+len(sealedValue))
+	n := binary.PutUvarint(sealed, uint64(len(sealedKey)))
+	n += copy(sealed[n:], sealedKey)
+	n += copy(sealed[n:], sealedValue)
+	return sealed[:n], nil
+}
+
+// splitSealed splits a key/value pair sealed by sealValue into the sealed key
+// and sealed value.
+func splitSealed(sealed []byte) (sealedKey, sealedValue []byte, err error) {
+	keyLen, n := binary.Uvarint(sealed)
+	if n <= 0 || uint64(len(sealed)-n) < keyLen {
+		return nil, nil, snacl.ErrMalformed
+	}
+	return sealed[n : n+int(keyLen)], sealed[n+int(keyLen):], nil
+}
+
+// openKey decrypts the plaintext key of a key/value pair sealed by sealValue
+// and verifies that it is stored at the expected location.  The value is not
+// decrypted.
+func (k *keys) openKey(parentID, storedKey, sealed []byte) ([]byte, error) {
+	sealedKey, _, err := splitSealed(sealed)
+	if err != nil {
+		return nil, err
+	}
+	key, err := k.enc.Decrypt(sealedKey)
+	if err != nil {
+		return nil, err
+	}
+	if !hmac.Equal(k.keyHash(parentID, key), storedKey) {
+		return nil, snacl.ErrMalformed
+	}
+	return key, nil
+}
+
+// openValue decrypts the value of a key/value pair sealed by sealValue and
+// verifies that it is stored at the expected location.
+func (k *keys) openValue(storedKey, sealed []byte) ([]byte, error) {
+	_, sealedValue, err := splitSealed(sealed)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := k.enc.Decrypt(sealedValue)
+	if err != nil {
+		return nil, err
+	}
+	if len(plaintext) < len(storedKey) ||
+		!hmac.Equal(plaintext[:len(storedKey)], storedKey) {
+		return nil, snacl.ErrMalformed
+	}
+	return plaintext[len(storedKey):], nil
+}
+
+// openBucketName decrypts the name recorded in a nested bucket and verifies
+// that the bucket is stored at the expected location.
+func (k *keys) openBucketName(parentID, storedKey, sealed []byte) ([]byte, error) {
+	name, err := k.enc.Decrypt(sealed)
+	if err != nil {
+		return nil, err
+	}
+	if !hmac.Equal(k.keyHash(parentID, name), storedKey) {
+		return nil, snacl.ErrMalformed
+	}
+	return name, nil
+}
+
+// marshalDataKeys encrypts the data keys with the passphrase key sk.
+func (k *keys) marshalDataKeys(sk *snacl.SecretKey) ([]byte, error) {
+	plaintext := make([]byte, 0, len(k.enc)+len(k.mac))
+	plaintext = append(plaintext, k.enc[:]...)
+	plaintext = append(plaintext, k.mac[:]...)
+	return sk.Encrypt(plaintext)
+}
+
+func (k *keys) zero() {
+	k.enc.Zero()
+	for i := range k.mac {
+		k.mac[i] = 0
+	}
+}
+
+// Detect returns whether a database opened by another driver holds encrypted
+// data.  Encrypted databases must be opened with Wrap.
+func Detect(db walletdb.DB) (bool, error) {
+	var encrypted bool
+	err := walletdb.View(db, func(tx walletdb.ReadTx) error {
+		encrypted = tx.ReadBucket(metaBucketKey) != nil
+		return nil
+	})
+	return encrypted, err
+}
+
+// Init initializes encryption of an empty database opened by another driver and
+// returns the database wrapped by Wrap.  The data keys are protected by a key
+// derived from passphrase.
+func Init(db walletdb.DB, passphrase []byte) (walletdb.DB, error) {
+	var k keys
+	encKey, err := snacl.GenerateCryptoKey()
+	if err != nil {
+		return nil, err
+	}
+	k.enc = *encKey
+	encKey.Zero()
+	macKey, err := snacl.GenerateCryptoKey()
+	if err != nil {
+		return nil, err
+	}
+	copy(k.mac[:], macKey[:])
+	macKey.Zero()
+
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		if tx.ReadBucket(metaBucketKey) != nil {
+			return ErrEncrypted
+		}
+		meta, err := tx.CreateTopLevelBucket(metaBucketKey)
+		if err != nil {
+			return err
+		}
+		var v [4]byte
+		binary.LittleEndian.PutUint32(v[:], version)
+		err = meta.Put(versionKey, v[:])
+		if err != nil {
+			return err
+		}
+		return putPassphraseKey(meta, &k, passphrase)
+	})
+	if err != nil {
+		k.zero()
+		return nil, err
+	}
+
+	return &encryptedDB{inner: db, keys: &k}, nil
+}
+
+// putPassphraseKey derives a new passphrase key and records it along with the
+// data keys encrypted by it.
+func putPassphraseKey(meta walletdb.ReadWriteBucket, k *keys, passphrase []byte) error {
+	sk, err := snacl.NewSecretKey(&passphrase, scryptOptions.N,
+		scryptOptions.R, scryptOptions.P)
+	if err != nil {
+		return err
+	}
+	defer sk.Zero()
+	dataKeys, err := k.marshalDataKeys(sk)
+	if err != nil {
+		return err
+	}
+	err = meta.Put(passphraseParamsKey, sk.Marshal())
+	if err != nil {
+		return err
+	}
+	return meta.Put(dataKeysKey, dataKeys)
+}
+
+// fetchPassphraseKey derives the passphrase key recorded in the metadata
+// bucket, returning ErrWrongPassphrase if the passphrase is incorrect.
+func fetchPassphraseKey(meta walletdb.ReadBucket, passphrase []byte) (*snacl.SecretKey, error) {
+	v := meta.Get(versionKey)
+	if len(v) != 4 {
+		return nil, snacl.ErrMalformed
+	}
+	if binary.LittleEndian.Uint32(v) > version {
+		return nil, ErrUnknownVersion
+	}
+
+	sk := &snacl.SecretKey{Key: &snacl.CryptoKey{}}
+	err := sk.Unmarshal(meta.Get(passphraseParamsKey))
+	if err != nil {
+		return nil, err
+	}
+	err = sk.DeriveKey(&passphrase)
+	if err == snacl.ErrInvalidPassword {
+		return nil, ErrWrongPassphrase
+	}
+	if err != nil {
+		return nil, err
+	}
+	return sk, nil
+}
+
+// Wrap returns a database which transparently encrypts all data written to, and
+// decrypts all data read from, the encrypted database db opened by another
+// driver.  ErrNotEncrypted is returned if the database is not encrypted and
+// ErrWrongPassphrase if the passphrase is incorrect.
+//
+// Closing the returned database closes db.
+func Wrap(db walletdb.DB, passphrase []byte) (walletdb.DB, error) {
+	k := new(keys)
+	err := walletdb.View(db, func(tx walletdb.ReadTx) error {
+		meta := tx.ReadBucket(metaBucketKey)
+		if meta == nil {
+			return ErrNotEncrypted
+		}
+		sk, err := fetchPassphraseKey(meta, passphrase)
+		if err != nil {
+			return err
+		}
+		defer sk.Zero()
+		plaintext, err := sk.Decrypt(meta.Get(dataKeysKey))
+		if err != nil {
+			return err
+		}
+		if len(plaintext) != len(k.enc)+len(k.mac) {
+			return snacl.ErrMalformed
+		}
+		copy(k.enc[:], plaintext)
+		copy(k.mac[:], plaintext[len(k.enc):])
+		for i := range plaintext {
+			plaintext[i] = 0
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &encryptedDB{inner: db, keys: k}, nil
+}
+
+// IsWrapped returns whether db is an encrypted database returned by Init or
+// Wrap.
+func IsWrapped(db walletdb.DB) bool {
+	_, ok := db.(*encryptedDB)
+	return ok
+}
+
+// ChangePassphrase changes the passphrase protecting an encrypted database
+// using a read-write transaction of a database returned by Init or Wrap.
+// Performing the change in a transaction allows it to be committed atomically
+// with other changes, such as changing the public passphrase of the wallet.
+// The data keys, and therefore all encrypted data, are unchanged.
+func ChangePassphrase(tx walletdb.ReadWriteTx, oldPassphrase, newPassphrase []byte) error {
+	t, ok := tx.(*transaction)
+	if !ok {
+		return ErrNotEncrypted
+	}
+	if !t.writable {
+		return walletdb.ErrTxNotWritable
+	}
+	innerTx := t.inner.(walletdb.ReadWriteTx)
+	meta := innerTx.ReadWriteBucket(metaBucketKey)
+	if meta == nil {
+		return ErrNotEncrypted
+	}
+
+	// Ensure the old passphrase is correct before replacing it.
+	sk, err := fetchPassphraseKey(meta, oldPassphrase)
+	if err != nil {
+		return err
+	}
+	sk.Zero()
+
+	return putPassphraseKey(meta, t.db.keys, newPassphrase)
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+/*
+This test file is part of the encdb package rather than than the encdb_test
+package so it can bridge access to the internals to properly test cases which
+are either not possible or can't reliably be tested via the public interface.
+The functions are only exported while the tests are being run.
+*/
+
+package encdb
+
+import "github.com/abcsuite/abcwallet/walletdb"
+
+// TstUseFastScrypt replaces the scrypt parameters used to derive passphrase
+// keys with cheap parameters to speed up the tests.
+func TstUseFastScrypt() {
+	scryptOptions.N = 16
+	scryptOptions.R = 8
+	scryptOptions.P = 1
+}
+
+// TstStoredKeys returns the keys used in the wrapped database of an encrypted
+// database returned by Init or Wrap for a top level bucket and a key of the
+// bucket.
+func TstStoredKeys(db walletdb.DB, bucketKey, key []byte) (storedBucketKey, storedKey []byte) {
+	k := db.(*encryptedDB).keys
+	storedBucketKey = k.keyHash(rootID, bucketKey)
+	return storedBucketKey, k.keyHash(storedBucketKey, key)
+}
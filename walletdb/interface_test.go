@@ -6,14 +6,11 @@
 // This file intended to be copied into each backend driver directory.  Each
 // driver should have their own driver_test.go file which creates a database and
 // invokes the testInterface function in this file to ensure the driver properly
-// implements the interface.  See the bdb backend driver for a working example.
+// implements the interface.  See the memdb backend driver for a working example.
 //
 // NOTE: When copying this file into the backend driver folder, the package name
 // will need to be changed accordingly.
 
-// Test must be updated for API changes.
-//+build disabled
-
 package walletdb_test
 
 import (
@@ -49,7 +46,7 @@ func rollbackValues(values map[string]string) map[string]string {
 // testGetValues checks that all of the provided key/value pairs can be
 // retrieved from the database and the retrieved values match the provided
 // values.
-func testGetValues(tc *testContext, bucket walletdb.Bucket, values map[string]string) bool {
+func testGetValues(tc *testContext, bucket walletdb.ReadWriteBucket, values map[string]string) bool {
 	for k, v := range values {
 		var vBytes []byte
 		if v != "" {
@@ -69,7 +66,7 @@ func testGetValues(tc *testContext, bucket walletdb.Bucket, values map[string]st
 
 // testPutValues stores all of the provided key/value pairs in the provided
 // bucket while checking for errors.
-func testPutValues(tc *testContext, bucket walletdb.Bucket, values map[string]string) bool {
+func testPutValues(tc *testContext, bucket walletdb.ReadWriteBucket, values map[string]string) bool {
 	for k, v := range values {
 		var vBytes []byte
 		if v != "" {
@@ -86,7 +83,7 @@ func testPutValues(tc *testContext, bucket walletdb.Bucket, values map[string]st
 
 // testDeleteValues removes all of the provided key/value pairs from the
 // provided bucket.
-func testDeleteValues(tc *testContext, bucket walletdb.Bucket, values map[string]string) bool {
+func testDeleteValues(tc *testContext, bucket walletdb.ReadWriteBucket, values map[string]string) bool {
 	for k := range values {
 		if err := bucket.Delete([]byte(k)); err != nil {
 			tc.t.Errorf("Delete: unexpected error: %v", err)
@@ -99,7 +96,7 @@ func testDeleteValues(tc *testContext, bucket walletdb.Bucket, values map[string
 
 // testNestedBucket reruns the testBucketInterface against a nested bucket along
 // with a counter to only test a couple of level deep.
-func testNestedBucket(tc *testContext, testBucket walletdb.Bucket) bool {
+func testNestedBucket(tc *testContext, testBucket walletdb.ReadWriteBucket) bool {
 	// Don't go more than 2 nested level deep.
 	if tc.bucketDepth > 1 {
 		return true
@@ -118,12 +115,7 @@ func testNestedBucket(tc *testContext, testBucket walletdb.Bucket) bool {
 
 // testBucketInterface ensures the bucket interface is working properly by
 // exercising all of its functions.
-func testBucketInterface(tc *testContext, bucket walletdb.Bucket) bool {
-	if bucket.Writable() != tc.isWritable {
-		tc.t.Errorf("Bucket writable state does not match.")
-		return false
-	}
-
+func testBucketInterface(tc *testContext, bucket walletdb.ReadWriteBucket) bool {
 	if tc.isWritable {
 		// keyValues holds the keys and values to use when putting
 		// values into the bucket.
@@ -214,18 +206,18 @@ func testBucketInterface(tc *testContext, bucket walletdb.Bucket) bool {
 		}
 
 		// Ensure retrieving and existing bucket works as expected.
-		testBucket = bucket.Bucket(testBucketName)
+		testBucket = bucket.NestedReadWriteBucket(testBucketName)
 		if !testNestedBucket(tc, testBucket) {
 			return false
 		}
 
 		// Ensure deleting a bucket works as intended.
-		if err := bucket.DeleteBucket(testBucketName); err != nil {
-			tc.t.Errorf("DeleteBucket: unexpected error: %v", err)
+		if err := bucket.DeleteNestedBucket(testBucketName); err != nil {
+			tc.t.Errorf("DeleteNestedBucket: unexpected error: %v", err)
 			return false
 		}
-		if b := bucket.Bucket(testBucketName); b != nil {
-			tc.t.Errorf("DeleteBucket: bucket '%s' still exists",
+		if b := bucket.NestedReadWriteBucket(testBucketName); b != nil {
+			tc.t.Errorf("DeleteNestedBucket: bucket '%s' still exists",
 				testBucketName)
 			return false
 		}
@@ -233,8 +225,8 @@ func testBucketInterface(tc *testContext, bucket walletdb.Bucket) bool {
 		// Ensure deleting a bucket that doesn't exist returns the
 		// expected error.
 		wantErr = walletdb.ErrBucketNotFound
-		if err := bucket.DeleteBucket(testBucketName); err != wantErr {
-			tc.t.Errorf("DeleteBucket: unexpected error - got %v, "+
+		if err := bucket.DeleteNestedBucket(testBucketName); err != wantErr {
+			tc.t.Errorf("DeleteNestedBucket: unexpected error - got %v, "+
 				"want %v", err, wantErr)
 			return false
 		}
@@ -253,12 +245,12 @@ func testBucketInterface(tc *testContext, bucket walletdb.Bucket) bool {
 
 		// Delete the test bucket to avoid leaving it around for future
 		// calls.
-		if err := bucket.DeleteBucket(testBucketName); err != nil {
-			tc.t.Errorf("DeleteBucket: unexpected error: %v", err)
+		if err := bucket.DeleteNestedBucket(testBucketName); err != nil {
+			tc.t.Errorf("DeleteNestedBucket: unexpected error: %v", err)
 			return false
 		}
-		if b := bucket.Bucket(testBucketName); b != nil {
-			tc.t.Errorf("DeleteBucket: bucket '%s' still exists",
+		if b := bucket.NestedReadWriteBucket(testBucketName); b != nil {
+			tc.t.Errorf("DeleteNestedBucket: bucket '%s' still exists",
 				testBucketName)
 			return false
 		}
@@ -292,9 +284,9 @@ func testBucketInterface(tc *testContext, bucket walletdb.Bucket) bool {
 			return false
 		}
 
-		// DeleteBucket should fail with bucket that is not writable.
-		if err := bucket.DeleteBucket(failBytes); err != wantErr {
-			tc.t.Errorf("DeleteBucket did not fail with unwritable " +
+		// DeleteNestedBucket should fail with bucket that is not writable.
+		if err := bucket.DeleteNestedBucket(failBytes); err != wantErr {
+			tc.t.Errorf("DeleteNestedBucket did not fail with unwritable " +
 				"bucket")
 			return false
 		}
@@ -303,29 +295,49 @@ func testBucketInterface(tc *testContext, bucket walletdb.Bucket) bool {
 	return true
 }
 
+// beginTx begins a read-only or read-write transaction and returns it along with
+// the namespace bucket described by namespaceKey.  Drivers return buckets which
+// implement the ReadWriteBucket interface from read-only transactions as well,
+// failing all writes with ErrTxNotWritable, which allows the same bucket tests
+// to be run in both kinds of transactions.
+func beginTx(tc *testContext, namespaceKey []byte, writable bool) (walletdb.ReadTx, walletdb.ReadWriteBucket, bool) {
+	var tx walletdb.ReadTx
+	var err error
+	if writable {
+		tx, err = tc.db.BeginReadWriteTx()
+	} else {
+		tx, err = tc.db.BeginReadTx()
+	}
+	if err != nil {
+		tc.t.Errorf("Begin: unexpected error %v", err)
+		return nil, nil, false
+	}
+
+	rootBucket, _ := tx.ReadBucket(namespaceKey).(walletdb.ReadWriteBucket)
+	if rootBucket == nil {
+		tc.t.Errorf("ReadBucket: unexpected nil root bucket")
+		_ = tx.Rollback()
+		return nil, nil, false
+	}
+
+	return tx, rootBucket, true
+}
+
 // testManualTxInterface ensures that manual transactions work as expected.
-func testManualTxInterface(tc *testContext, namespace walletdb.Namespace) bool {
+func testManualTxInterface(tc *testContext, namespaceKey []byte) bool {
 	// populateValues tests that populating values works as expected.
 	//
 	// When the writable flag is false, a read-only tranasction is created,
 	// standard bucket tests for read-only transactions are performed, and
-	// the Commit function is checked to ensure it fails as expected.
+	// the transaction is rolled back.
 	//
 	// Otherwise, a read-write transaction is created, the values are
 	// written, standard bucket tests for read-write transactions are
 	// performed, and then the transaction is either commited or rolled
 	// back depending on the flag.
 	populateValues := func(writable, rollback bool, putValues map[string]string) bool {
-		tx, err := namespace.Begin(writable)
-		if err != nil {
-			tc.t.Errorf("Begin: unexpected error %v", err)
-			return false
-		}
-
-		rootBucket := tx.RootBucket()
-		if rootBucket == nil {
-			tc.t.Errorf("RootBucket: unexpected nil root bucket")
-			_ = tx.Rollback()
+		tx, rootBucket, ok := beginTx(tc, namespaceKey, writable)
+		if !ok {
 			return false
 		}
 
@@ -336,18 +348,9 @@ func testManualTxInterface(tc *testContext, namespace walletdb.Namespace) bool {
 		}
 
 		if !writable {
-			// The transaction is not writable, so it should fail
-			// the commit.
-			if err := tx.Commit(); err != walletdb.ErrTxNotWritable {
-				tc.t.Errorf("Commit: unexpected error %v, "+
-					"want %v", err, walletdb.ErrTxNotWritable)
-				_ = tx.Rollback()
-				return false
-			}
-
 			// Rollback the transaction.
 			if err := tx.Rollback(); err != nil {
-				tc.t.Errorf("Commit: unexpected error %v", err)
+				tc.t.Errorf("Rollback: unexpected error %v", err)
 				return false
 			}
 		} else {
@@ -364,7 +367,7 @@ func testManualTxInterface(tc *testContext, namespace walletdb.Namespace) bool {
 				}
 			} else {
 				// The commit should succeed.
-				if err := tx.Commit(); err != nil {
+				if err := tx.(walletdb.ReadWriteTx).Commit(); err != nil {
 					tc.t.Errorf("Commit: unexpected error "+
 						"%v", err)
 					return false
@@ -380,16 +383,8 @@ func testManualTxInterface(tc *testContext, namespace walletdb.Namespace) bool {
 	// what's in the database.
 	checkValues := func(expectedValues map[string]string) bool {
 		// Begin another read-only transaction to ensure...
-		tx, err := namespace.Begin(false)
-		if err != nil {
-			tc.t.Errorf("Begin: unexpected error %v", err)
-			return false
-		}
-
-		rootBucket := tx.RootBucket()
-		if rootBucket == nil {
-			tc.t.Errorf("RootBucket: unexpected nil root bucket")
-			_ = tx.Rollback()
+		tx, rootBucket, ok := beginTx(tc, namespaceKey, false)
+		if !ok {
 			return false
 		}
 
@@ -400,7 +395,7 @@ func testManualTxInterface(tc *testContext, namespace walletdb.Namespace) bool {
 
 		// Rollback the read-only transaction.
 		if err := tx.Rollback(); err != nil {
-			tc.t.Errorf("Commit: unexpected error %v", err)
+			tc.t.Errorf("Rollback: unexpected error %v", err)
 			return false
 		}
 
@@ -410,15 +405,8 @@ func testManualTxInterface(tc *testContext, namespace walletdb.Namespace) bool {
 	// deleteValues starts a read-write transaction and deletes the keys
 	// in the passed key/value pairs.
 	deleteValues := func(values map[string]string) bool {
-		tx, err := namespace.Begin(true)
-		if err != nil {
-
-		}
-
-		rootBucket := tx.RootBucket()
-		if rootBucket == nil {
-			tc.t.Errorf("RootBucket: unexpected nil root bucket")
-			_ = tx.Rollback()
+		tx, rootBucket, ok := beginTx(tc, namespaceKey, true)
+		if !ok {
 			return false
 		}
 
@@ -433,7 +421,7 @@ func testManualTxInterface(tc *testContext, namespace walletdb.Namespace) bool {
 		}
 
 		// Commit the changes and ensure it was successful.
-		if err := tx.Commit(); err != nil {
+		if err := tx.(walletdb.ReadWriteTx).Commit(); err != nil {
 			tc.t.Errorf("Commit: unexpected error %v", err)
 			return false
 		}
@@ -484,25 +472,47 @@ func testManualTxInterface(tc *testContext, namespace walletdb.Namespace) bool {
 	return true
 }
 
+// createNamespace creates the top level bucket used as a namespace by the
+// tests.
+func createNamespace(tc *testContext, namespaceKey []byte) bool {
+	err := walletdb.Update(tc.db, func(tx walletdb.ReadWriteTx) error {
+		_, err := tx.CreateTopLevelBucket(namespaceKey)
+		return err
+	})
+	if err != nil {
+		tc.t.Errorf("CreateTopLevelBucket: unexpected error: %v", err)
+		return false
+	}
+	return true
+}
+
+// deleteNamespace removes the top level bucket used as a namespace by the
+// tests.
+func deleteNamespace(tc *testContext, namespaceKey []byte) bool {
+	err := walletdb.Update(tc.db, func(tx walletdb.ReadWriteTx) error {
+		return tx.DeleteTopLevelBucket(namespaceKey)
+	})
+	if err != nil {
+		tc.t.Errorf("DeleteTopLevelBucket: unexpected error: %v", err)
+		return false
+	}
+	return true
+}
+
 // testNamespaceAndTxInterfaces creates a namespace using the provided key and
 // tests all facets of it interface as well as  transaction and bucket
 // interfaces under it.
 func testNamespaceAndTxInterfaces(tc *testContext, namespaceKey string) bool {
 	namespaceKeyBytes := []byte(namespaceKey)
-	namespace, err := tc.db.Namespace(namespaceKeyBytes)
-	if err != nil {
-		tc.t.Errorf("Namespace: unexpected error: %v", err)
+	if !createNamespace(tc, namespaceKeyBytes) {
 		return false
 	}
 	defer func() {
 		// Remove the namespace now that the tests are done for it.
-		if err := tc.db.DeleteNamespace(namespaceKeyBytes); err != nil {
-			tc.t.Errorf("DeleteNamespace: unexpected error: %v", err)
-			return
-		}
+		deleteNamespace(tc, namespaceKeyBytes)
 	}()
 
-	if !testManualTxInterface(tc, namespace) {
+	if !testManualTxInterface(tc, namespaceKeyBytes) {
 		return false
 	}
 
@@ -515,10 +525,10 @@ func testNamespaceAndTxInterfaces(tc *testContext, namespaceKey string) bool {
 	}
 
 	// Test the bucket interface via a managed read-only transaction.
-	err = namespace.View(func(tx walletdb.Tx) error {
-		rootBucket := tx.RootBucket()
+	err := walletdb.View(tc.db, func(tx walletdb.ReadTx) error {
+		rootBucket, _ := tx.ReadBucket(namespaceKeyBytes).(walletdb.ReadWriteBucket)
 		if rootBucket == nil {
-			return fmt.Errorf("RootBucket: unexpected nil root bucket")
+			return fmt.Errorf("ReadBucket: unexpected nil root bucket")
 		}
 
 		tc.isWritable = false
@@ -538,7 +548,7 @@ func testNamespaceAndTxInterfaces(tc *testContext, namespaceKey string) bool {
 	// Ensure errors returned from the user-supplied View function are
 	// returned.
 	viewError := fmt.Errorf("example view error")
-	err = namespace.View(func(tx walletdb.Tx) error {
+	err = walletdb.View(tc.db, func(tx walletdb.ReadTx) error {
 		return viewError
 	})
 	if err != viewError {
@@ -551,10 +561,10 @@ func testNamespaceAndTxInterfaces(tc *testContext, namespaceKey string) bool {
 	// Also, put a series of values and force a rollback so the following
 	// code can ensure the values were not stored.
 	forceRollbackError := fmt.Errorf("force rollback")
-	err = namespace.Update(func(tx walletdb.Tx) error {
-		rootBucket := tx.RootBucket()
+	err = walletdb.Update(tc.db, func(tx walletdb.ReadWriteTx) error {
+		rootBucket := tx.ReadWriteBucket(namespaceKeyBytes)
 		if rootBucket == nil {
-			return fmt.Errorf("RootBucket: unexpected nil root bucket")
+			return fmt.Errorf("ReadWriteBucket: unexpected nil root bucket")
 		}
 
 		tc.isWritable = true
@@ -581,10 +591,10 @@ func testNamespaceAndTxInterfaces(tc *testContext, namespaceKey string) bool {
 
 	// Ensure the values that should have not been stored due to the forced
 	// rollback above were not actually stored.
-	err = namespace.View(func(tx walletdb.Tx) error {
-		rootBucket := tx.RootBucket()
+	err = walletdb.View(tc.db, func(tx walletdb.ReadTx) error {
+		rootBucket, _ := tx.ReadBucket(namespaceKeyBytes).(walletdb.ReadWriteBucket)
 		if rootBucket == nil {
-			return fmt.Errorf("RootBucket: unexpected nil root bucket")
+			return fmt.Errorf("ReadBucket: unexpected nil root bucket")
 		}
 
 		if !testGetValues(tc, rootBucket, rollbackValues(keyValues)) {
@@ -601,10 +611,10 @@ func testNamespaceAndTxInterfaces(tc *testContext, namespaceKey string) bool {
 	}
 
 	// Store a series of values via a managed read-write transaction.
-	err = namespace.Update(func(tx walletdb.Tx) error {
-		rootBucket := tx.RootBucket()
+	err = walletdb.Update(tc.db, func(tx walletdb.ReadWriteTx) error {
+		rootBucket := tx.ReadWriteBucket(namespaceKeyBytes)
 		if rootBucket == nil {
-			return fmt.Errorf("RootBucket: unexpected nil root bucket")
+			return fmt.Errorf("ReadWriteBucket: unexpected nil root bucket")
 		}
 
 		if !testPutValues(tc, rootBucket, keyValues) {
@@ -621,10 +631,10 @@ func testNamespaceAndTxInterfaces(tc *testContext, namespaceKey string) bool {
 	}
 
 	// Ensure the values stored above were committed as expected.
-	err = namespace.View(func(tx walletdb.Tx) error {
-		rootBucket := tx.RootBucket()
+	err = walletdb.View(tc.db, func(tx walletdb.ReadTx) error {
+		rootBucket, _ := tx.ReadBucket(namespaceKeyBytes).(walletdb.ReadWriteBucket)
 		if rootBucket == nil {
-			return fmt.Errorf("RootBucket: unexpected nil root bucket")
+			return fmt.Errorf("ReadBucket: unexpected nil root bucket")
 		}
 
 		if !testGetValues(tc, rootBucket, keyValues) {
@@ -641,10 +651,10 @@ func testNamespaceAndTxInterfaces(tc *testContext, namespaceKey string) bool {
 	}
 
 	// Clean up the values stored above in a managed read-write transaction.
-	err = namespace.Update(func(tx walletdb.Tx) error {
-		rootBucket := tx.RootBucket()
+	err = walletdb.Update(tc.db, func(tx walletdb.ReadWriteTx) error {
+		rootBucket := tx.ReadWriteBucket(namespaceKeyBytes)
 		if rootBucket == nil {
-			return fmt.Errorf("RootBucket: unexpected nil root bucket")
+			return fmt.Errorf("ReadWriteBucket: unexpected nil root bucket")
 		}
 
 		if !testDeleteValues(tc, rootBucket, keyValues) {
@@ -667,64 +677,70 @@ func testNamespaceAndTxInterfaces(tc *testContext, namespaceKey string) bool {
 // elsewhere in the tests and therefore improves negative test coverage.
 func testAdditionalErrors(tc *testContext) bool {
 	// Create a new namespace and then intentionally delete the namespace
-	// bucket out from under it to force errors.
+	// bucket to force errors.
 	ns3Key := []byte("ns3")
-	ns3, err := tc.db.Namespace(ns3Key)
-	if err != nil {
-		tc.t.Errorf("Namespace: unexpected error: %v", err)
+	if !createNamespace(tc, ns3Key) {
 		return false
 	}
-	if err := tc.db.DeleteNamespace(ns3Key); err != nil {
-		tc.t.Errorf("DeleteNamespace: unexpected error: %v", err)
+	if !deleteNamespace(tc, ns3Key) {
 		return false
 	}
 
-	// Ensure Begin fails when the namespace bucket does not exist.
-	wantErr := walletdb.ErrBucketNotFound
-	if _, err := ns3.Begin(false); err != wantErr {
-		tc.t.Errorf("Begin: did not receive expected error - got %v, "+
-			"want %v", err, wantErr)
-		return false
-	}
-
-	// Ensure View fails when the namespace bucket does not exist.
-	err = ns3.View(func(tx walletdb.Tx) error {
+	// Ensure the namespace bucket is not returned by a read transaction
+	// when it does not exist.
+	err := walletdb.View(tc.db, func(tx walletdb.ReadTx) error {
+		if tx.ReadBucket(ns3Key) != nil {
+			return fmt.Errorf("ReadBucket: deleted bucket returned")
+		}
 		return nil
 	})
-	if err != wantErr {
-		tc.t.Errorf("View: did not receive expected error - got %v, "+
-			"want %v", err, wantErr)
+	if err != nil {
+		tc.t.Errorf("%v", err)
 		return false
 	}
 
-	// Ensure Update fails when the namespace bucket does not exist.
-	err = ns3.Update(func(tx walletdb.Tx) error {
-		return nil
+	// Ensure deleting the namespace bucket again fails when it does not
+	// exist.
+	wantErr := walletdb.ErrBucketNotFound
+	err = walletdb.Update(tc.db, func(tx walletdb.ReadWriteTx) error {
+		if tx.ReadWriteBucket(ns3Key) != nil {
+			return fmt.Errorf("ReadWriteBucket: deleted bucket " +
+				"returned")
+		}
+		return tx.DeleteTopLevelBucket(ns3Key)
 	})
 	if err != wantErr {
-		tc.t.Errorf("View: did not receive expected error - got %v, "+
-			"want %v", err, wantErr)
+		tc.t.Errorf("DeleteTopLevelBucket: did not receive expected "+
+			"error - got %v, want %v", err, wantErr)
 		return false
 	}
 
 	// Recreate the namespace to bring the bucket back.
-	ns3, err = tc.db.Namespace(ns3Key)
-	if err != nil {
-		tc.t.Errorf("Namespace: unexpected error: %v", err)
+	if !createNamespace(tc, ns3Key) {
 		return false
 	}
 	defer func() {
 		// Remove the namespace now that the tests are done for it.
-		if err := tc.db.DeleteNamespace(ns3Key); err != nil {
-			tc.t.Errorf("DeleteNamespace: unexpected error: %v", err)
-			return
-		}
+		deleteNamespace(tc, ns3Key)
 	}()
 
-	err = ns3.Update(func(tx walletdb.Tx) error {
-		rootBucket := tx.RootBucket()
+	// Ensure creating the namespace bucket again fails when it already
+	// exists.
+	wantErr = walletdb.ErrBucketExists
+	err = walletdb.Update(tc.db, func(tx walletdb.ReadWriteTx) error {
+		_, err := tx.CreateTopLevelBucket(ns3Key)
+		return err
+	})
+	if err != wantErr {
+		tc.t.Errorf("CreateTopLevelBucket: did not receive expected "+
+			"error - got %v, want %v", err, wantErr)
+		return false
+	}
+
+	err = walletdb.Update(tc.db, func(tx walletdb.ReadWriteTx) error {
+		rootBucket := tx.ReadWriteBucket(ns3Key)
 		if rootBucket == nil {
-			return fmt.Errorf("RootBucket: unexpected nil root bucket")
+			return fmt.Errorf("ReadWriteBucket: unexpected nil root bucket")
 		}
 
 		// Ensure CreateBucket returns the expected error when no bucket
@@ -735,11 +751,11 @@ func testAdditionalErrors(tc *testContext) bool {
 				"got %v, want %v", err, wantErr)
 		}
 
-		// Ensure DeleteBucket returns the expected error when no bucket
-		// key is specified.
+		// Ensure DeleteNestedBucket returns the expected error when no
+		// bucket key is specified.
 		wantErr = walletdb.ErrIncompatibleValue
-		if err := rootBucket.DeleteBucket(nil); err != wantErr {
-			return fmt.Errorf("DeleteBucket: unexpected error - "+
+		if err := rootBucket.DeleteNestedBucket(nil); err != wantErr {
+			return fmt.Errorf("DeleteNestedBucket: unexpected error - "+
 				"got %v, want %v", err, wantErr)
 		}
 
@@ -762,7 +778,7 @@ func testAdditionalErrors(tc *testContext) bool {
 
 	// Ensure that attempting to rollback or commit a transaction that is
 	// already closed returns the expected error.
-	tx, err := ns3.Begin(false)
+	tx, err := tc.db.BeginReadWriteTx()
 	if err != nil {
 		tc.t.Errorf("Begin: unexpected error: %v", err)
 		return false
@@ -783,6 +799,22 @@ func testAdditionalErrors(tc *testContext) bool {
 		return false
 	}
 
+	// Ensure the same for read-only transactions.
+	rtx, err := tc.db.BeginReadTx()
+	if err != nil {
+		tc.t.Errorf("Begin: unexpected error: %v", err)
+		return false
+	}
+	if err := rtx.Rollback(); err != nil {
+		tc.t.Errorf("Rollback: unexpected error: %v", err)
+		return false
+	}
+	if err := rtx.Rollback(); err != wantErr {
+		tc.t.Errorf("Rollback: unexpected error - got %v, want %v", err,
+			wantErr)
+		return false
+	}
+
 	return true
 }
 
@@ -0,0 +1,715 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package memdb
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/abcsuite/abcwallet/walletdb"
+	"github.com/boltdb/bolt"
+)
+
+// These limits match those of the bdb driver so that every database created by
+// this driver can be copied to a bdb database.
+const (
+	// maxKeySize is the maximum length of a key, in bytes.
+	maxKeySize = 32768
+
+	// maxValueSize is the maximum length of a value, in bytes.
+	maxValueSize = (1 << 31) - 2
+)
+
+// entry is a single key of a bucket.  The key either holds a value or a nested
+// bucket, in which case value is nil.
+type entry struct {
+	key    []byte
+	value  []byte
+	bucket *node
+}
+
+// node holds the entries of a bucket sorted by key.  Nodes are copied on write:
+// a node may only be modified by the read-write transaction which owns it, and
+// all other transactions share nodes without modifying them.  This allows read
+// transactions to keep reading a snapshot of the database while it is changed
+// by a read-write transaction.
+type node struct {
+	owner   *transaction
+	entries []entry
+}
+
+// clone returns a copy of the node owned by the transaction tx.  The keys,
+// values, and nested buckets are shared with the original node.
+func (n *node) clone(tx *transaction) *node {
+	entries := make([]entry, len(n.entries))
+	copy(entries, n.entries)
+	return &node{owner: tx, entries: entries}
+}
+
+// search returns the index of the first entry with a key greater than or equal
+// to key and whether the key of that entry equals key.
+func (n *node) search(key []byte) (int, bool) {
+	i := sort.Search(len(n.entries), func(i int) bool {
+		return bytes.Compare(n.entries[i].key, key) >= 0
+	})
+	return i, i < len(n.entries) && bytes.Equal(n.entries[i].key, key)
+}
+
+// insert adds the entry e at index i.
+func (n *node) insert(i int, e entry) {
+	n.entries = append(n.entries, entry{})
+	copy(n.entries[i+1:], n.entries[i:])
+	n.entries[i] = e
+}
+
+// remove deletes the entry at index i.
+func (n *node) remove(i int) {
+	copy(n.entries[i:], n.entries[i+1:])
+	n.entries[len(n.entries)-1] = entry{}
+	n.entries = n.entries[:len(n.entries)-1]
+}
+
+func copyBytes(b []byte) []byte {
+	c := make([]byte, len(b))
+	copy(c, b)
+	return c
+}
+
+// transaction represents a database transaction.  It can either by read-only or
+// read-write and implements the walletdb Tx interfaces.
+type transaction struct {
+	db       *db
+	root     *node
+	writable bool
+	closed   bool
+}
+
+// Enforce transaction implements the walletdb transaction interfaces.
+var _ walletdb.ReadWriteTx = (*transaction)(nil)
+
+// node returns the node of the bucket described by path, or nil if the bucket
+// does not exist.
+func (tx *transaction) node(path [][]byte) *node {
+	n := tx.root
+	for _, key := range path {
+		if n == nil {
+			return nil
+		}
+		i, ok := n.search(key)
+		if !ok {
+			return nil
+		}
+		n = n.entries[i].bucket
+	}
+	return n
+}
+
+// writableNode returns the node of the bucket described by path after copying
+// it and every parent node which is not yet owned by the transaction.
+func (tx *transaction) writableNode(path [][]byte) (*node, error) {
+	if tx.closed {
+		return nil, walletdb.ErrTxClosed
+	}
+	if !tx.writable {
+		return nil, walletdb.ErrTxNotWritable
+	}
+
+	if tx.root.owner != tx {
+		tx.root = tx.root.clone(tx)
+	}
+	n := tx.root
+	for _, key := range path {
+		i, ok := n.search(key)
+		if !ok || n.entries[i].bucket == nil {
+			return nil, walletdb.ErrBucketNotFound
+		}
+		child := n.entries[i].bucket
+		if child.owner != tx {
+			child = child.clone(tx)
+			n.entries[i].bucket = child
+		}
+		n = child
+	}
+	return n, nil
+}
+
+func (tx *transaction) rootBucket() *bucket {
+	return &bucket{tx: tx}
+}
+
+func (tx *transaction) ReadBucket(key []byte) walletdb.ReadBucket {
+	return tx.ReadWriteBucket(key)
+}
+
+func (tx *transaction) ReadWriteBucket(key []byte) walletdb.ReadWriteBucket {
+	// Don't return a non-nil interface to a nil pointer.
+	b := tx.rootBucket().nestedBucket(key)
+	if b == nil {
+		return nil
+	}
+	return b
+}
+
+func (tx *transaction) CreateTopLevelBucket(key []byte) (walletdb.ReadWriteBucket, error) {
+	return tx.rootBucket().CreateBucket(key)
+}
+
+func (tx *transaction) DeleteTopLevelBucket(key []byte) error {
+	return tx.rootBucket().DeleteNestedBucket(key)
+}
+
+// close ends the transaction, releasing the database writer lock if the
+// transaction is writable.
+func (tx *transaction) close() {
+	tx.closed = true
+	tx.root = nil
+	if tx.writable {
+		tx.db.writeMu.Unlock()
+	}
+}
+
+// Commit commits all changes that have been made through the root bucket and
+// all of its sub-buckets.
+//
+// This function is part of the walletdb.Tx interface implementation.
+func (tx *transaction) Commit() error {
+	if tx.closed {
+		return walletdb.ErrTxClosed
+	}
+	if !tx.writable {
+		return walletdb.ErrTxNotWritable
+	}
+
+	tx.db.mu.Lock()
+	tx.db.root = tx.root
+	tx.db.mu.Unlock()
+
+	tx.close()
+	return nil
+}
+
+// Rollback undoes all changes that have been made to the root bucket and all of
+// its sub-buckets.
+//
+// This function is part of the walletdb.Tx interface implementation.
+func (tx *transaction) Rollback() error {
+	if tx.closed {
+		return walletdb.ErrTxClosed
+	}
+	tx.close()
+	return nil
+}
+
+// bucket is an internal type used to represent a collection of key/value pairs
+// and implements the walletdb Bucket interfaces.  Buckets are described by the
+// keys of all of their parent buckets and are looked up again by each
+// operation, so every bucket of a transaction observes the changes made through
+// the others.
+type bucket struct {
+	tx   *transaction
+	path [][]byte
+}
+
+// Enforce bucket implements the walletdb Bucket interfaces.
+var _ walletdb.ReadWriteBucket = (*bucket)(nil)
+
+// nestedBucket returns the nested bucket with the given key, or nil if the
+// bucket does not exist.
+func (b *bucket) nestedBucket(key []byte) *bucket {
+	n := b.tx.node(b.path)
+	if n == nil {
+		return nil
+	}
+	i, ok := n.search(key)
+	if !ok || n.entries[i].bucket == nil {
+		return nil
+	}
+	path := make([][]byte, len(b.path)+1)
+	copy(path, b.path)
+	path[len(b.path)] = n.entries[i].key
+	return &bucket{tx: b.tx, path: path}
+}
+
+// NestedReadWriteBucket retrieves a nested bucket with the given key.  Returns
+// nil if the bucket does not exist.
+//
+// This function is part of the walletdb.ReadWriteBucket interface implementation.
+func (b *bucket) NestedReadWriteBucket(key []byte) walletdb.ReadWriteBucket {
+	// Don't return a non-nil interface to a nil pointer.
+	nested := b.nestedBucket(key)
+	if nested == nil {
+		return nil
+	}
+	return nested
+}
+
+func (b *bucket) NestedReadBucket(key []byte) walletdb.ReadBucket {
+	return b.NestedReadWriteBucket(key)
+}
+
+// CreateBucket creates and returns a new nested bucket with the given key.
+// Returns ErrBucketExists if the bucket already exists, ErrBucketNameRequired
+// if the key is empty, or ErrIncompatibleValue if the key value is otherwise
+// invalid.
+//
+// This function is part of the walletdb.Bucket interface implementation.
+func (b *bucket) CreateBucket(key []byte) (walletdb.ReadWriteBucket, error) {
+	n, err := b.tx.writableNode(b.path)
+	if err != nil {
+		return nil, err
+	}
+	if len(key) == 0 {
+		return nil, walletdb.ErrBucketNameRequired
+	}
+	if len(key) > maxKeySize {
+		return nil, walletdb.ErrKeyTooLarge
+	}
+
+	i, ok := n.search(key)
+	if ok {
+		if n.entries[i].bucket != nil {
+			return nil, walletdb.ErrBucketExists
+		}
+		return nil, walletdb.ErrIncompatibleValue
+	}
+	n.insert(i, entry{key: copyBytes(key), bucket: &node{owner: b.tx}})
+	return b.nestedBucket(key), nil
+}
+
+// CreateBucketIfNotExists creates and returns a new nested bucket with the
+// given key if it does not already exist.  Returns ErrBucketNameRequired if the
+// key is empty or ErrIncompatibleValue if the key value is otherwise invalid.
+//
+// This function is part of the walletdb.Bucket interface implementation.
+func (b *bucket) CreateBucketIfNotExists(key []byte) (walletdb.ReadWriteBucket, error) {
+	nested, err := b.CreateBucket(key)
+	if err == walletdb.ErrBucketExists {
+		return b.nestedBucket(key), nil
+	}
+	return nested, err
+}
+
+// DeleteNestedBucket removes a nested bucket with the given key.  Returns
+// ErrTxNotWritable if attempted against a read-only transaction and
+// ErrBucketNotFound if the specified bucket does not exist.
+//
+// This function is part of the walletdb.Bucket interface implementation.
+func (b *bucket) DeleteNestedBucket(key []byte) error {
+	n, err := b.tx.writableNode(b.path)
+	if err != nil {
+		return err
+	}
+	// Buckets are never named by an empty key.
+	if len(key) == 0 {
+		return walletdb.ErrIncompatibleValue
+	}
+
+	i, ok := n.search(key)
+	if !ok {
+		return walletdb.ErrBucketNotFound
+	}
+	if n.entries[i].bucket == nil {
+		return walletdb.ErrIncompatibleValue
+	}
+	n.remove(i)
+	return nil
+}
+
+// ForEach invokes the passed function with every key/value pair in the bucket.
+// This includes nested buckets, in which case the value is nil, but it does not
+// include the key/value pairs within those nested buckets.
+//
+// This function is part of the walletdb.Bucket interface implementation.
+func (b *bucket) ForEach(fn func(k, v []byte) error) error {
+	c := b.cursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		err := fn(k, v)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Put saves the specified key/value pair to the bucket.  Keys that do not
+// already exist are added and keys that already exist are overwritten.  Returns
+// ErrTxNotWritable if attempted against a read-only transaction.
+//
+// This function is part of the walletdb.Bucket interface implementation.
+func (b *bucket) Put(key, value []byte) error {
+	n, err := b.tx.writableNode(b.path)
+	if err != nil {
+		return err
+	}
+	switch {
+	case len(key) == 0:
+		return walletdb.ErrKeyRequired
+	case len(key) > maxKeySize:
+		return walletdb.ErrKeyTooLarge
+	case int64(len(value)) > maxValueSize:
+		return walletdb.ErrValueTooLarge
+	}
+
+	i, ok := n.search(key)
+	if !ok {
+		n.insert(i, entry{key: copyBytes(key), value: copyBytes(value)})
+		return nil
+	}
+	if n.entries[i].bucket != nil {
+		return walletdb.ErrIncompatibleValue
+	}
+	n.entries[i].value = copyBytes(value)
+	return nil
+}
+
+// Get returns the value for the given key.  Returns nil if the key does
+// not exist in this bucket (or nested buckets).
+//
+// NOTE: The value returned by this function is only valid during a
+// transaction.  Modifying it results in undefined behavior.
+//
+// This function is part of the walletdb.Bucket interface implementation.
+func (b *bucket) Get(key []byte) []byte {
+	n := b.tx.node(b.path)
+	if n == nil {
+		return nil
+	}
+	i, ok := n.search(key)
+	if !ok {
+		return nil
+	}
+	return n.entries[i].value
+}
+
+// Delete removes the specified key from the bucket.  Deleting a key that does
+// not exist does not return an error.  Returns ErrTxNotWritable if attempted
+// against a read-only transaction.
+//
+// This function is part of the walletdb.Bucket interface implementation.
+func (b *bucket) Delete(key []byte) error {
+	n, err := b.tx.writableNode(b.path)
+	if err != nil {
+		return err
+	}
+
+	i, ok := n.search(key)
+	if !ok {
+		return nil
+	}
+	if n.entries[i].bucket != nil {
+		return walletdb.ErrIncompatibleValue
+	}
+	n.remove(i)
+	return nil
+}
+
+func (b *bucket) cursor() *cursor {
+	return &cursor{bucket: b}
+}
+
+func (b *bucket) ReadCursor() walletdb.ReadCursor {
+	return b.cursor()
+}
+
+// ReadWriteCursor returns a new cursor, allowing for iteration over the bucket's
+// key/value pairs and nested buckets in forward or backward order.
+//
+// This function is part of the walletdb.Bucket interface implementation.
+func (b *bucket) ReadWriteCursor() walletdb.ReadWriteCursor {
+	return b.cursor()
+}
+
+// cursor represents a cursor over key/value pairs and nested buckets of a
+// bucket.
+//
+// The cursor only remembers the key it is positioned at, and each movement
+// searches the bucket for the next key.  Modifying the bucket therefore never
+// invalidates the cursor.
+type cursor struct {
+	bucket *bucket
+	key    []byte
+}
+
+// Enforce cursor implements the walletdb Cursor interfaces.
+var _ walletdb.ReadWriteCursor = (*cursor)(nil)
+
+// moveTo positions the cursor at the entry with index i of node n and returns
+// the pair.  The cursor is unpositioned if the index is out of range.
+func (c *cursor) moveTo(n *node, i int) (key, value []byte) {
+	if n == nil || i < 0 || i >= len(n.entries) {
+		c.key = nil
+		return nil, nil
+	}
+	e := &n.entries[i]
+	c.key = e.key
+	return e.key, e.value
+}
+
+// Delete removes the current key/value pair the cursor is at without
+// invalidating the cursor. Returns ErrTxNotWritable if attempted on a read-only
+// transaction, or ErrIncompatibleValue if attempted when the cursor points to a
+// nested bucket.
+//
+// This function is part of the walletdb.Cursor interface implementation.
+func (c *cursor) Delete() error {
+	n, err := c.bucket.tx.writableNode(c.bucket.path)
+	if err != nil {
+		return err
+	}
+	if c.key == nil {
+		return nil
+	}
+
+	i, ok := n.search(c.key)
+	if !ok {
+		return nil
+	}
+	if n.entries[i].bucket != nil {
+		return walletdb.ErrIncompatibleValue
+	}
+	n.remove(i)
+	return nil
+}
+
+// First positions the cursor at the first key/value pair and returns the pair.
+//
+// This function is part of the walletdb.Cursor interface implementation.
+func (c *cursor) First() (key, value []byte) {
+	return c.moveTo(c.bucket.tx.node(c.bucket.path), 0)
+}
+
+// Last positions the cursor at the last key/value pair and returns the pair.
+//
+// This function is part of the walletdb.Cursor interface implementation.
+func (c *cursor) Last() (key, value []byte) {
+	n := c.bucket.tx.node(c.bucket.path)
+	if n == nil {
+		return c.moveTo(nil, 0)
+	}
+	return c.moveTo(n, len(n.entries)-1)
+}
+
+// Next moves the cursor one key/value pair forward and returns the new pair.
+//
+// This function is part of the walletdb.Cursor interface implementation.
+func (c *cursor) Next() (key, value []byte) {
+	n := c.bucket.tx.node(c.bucket.path)
+	if n == nil || c.key == nil {
+		return c.moveTo(nil, 0)
+	}
+	i, ok := n.search(c.key)
+	if ok {
+		i++
+	}
+	return c.moveTo(n, i)
+}
+
+// Prev moves the cursor one key/value pair backward and returns the new pair.
+//
+// This function is part of the walletdb.Cursor interface implementation.
+func (c *cursor) Prev() (key, value []byte) {
+	n := c.bucket.tx.node(c.bucket.path)
+	if n == nil || c.key == nil {
+		return c.moveTo(nil, 0)
+	}
+	i, _ := n.search(c.key)
+	return c.moveTo(n, i-1)
+}
+
+// Seek positions the cursor at the passed seek key. If the key does not exist,
+// the cursor is moved to the next key after seek. Returns the new pair.
+//
+// This function is part of the walletdb.Cursor interface implementation.
+func (c *cursor) Seek(seek []byte) (key, value []byte) {
+	n := c.bucket.tx.node(c.bucket.path)
+	if n == nil {
+		return c.moveTo(nil, 0)
+	}
+	i, _ := n.search(seek)
+	return c.moveTo(n, i)
+}
+
+// db represents a collection of buckets which are kept in memory and implements
+// the walletdb.Db interface.  All database access is performed through
+// transactions.
+type db struct {
+	// mu protects root and closed.  writeMu is held by the single open
+	// read-write transaction.
+	mu      sync.RWMutex
+	writeMu sync.Mutex
+	root    *node
+	closed  bool
+}
+
+// Enforce db implements the walletdb.Db interface.
+var _ walletdb.DB = (*db)(nil)
+
+func newDB() *db {
+	return &db{root: &node{}}
+}
+
+func (db *db) BeginReadTx() (walletdb.ReadTx, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	if db.closed {
+		return nil, walletdb.ErrDbNotOpen
+	}
+	return &transaction{db: db, root: db.root}, nil
+}
+
+func (db *db) BeginReadWriteTx() (walletdb.ReadWriteTx, error) {
+	db.writeMu.Lock()
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	if db.closed {
+		db.writeMu.Unlock()
+		return nil, walletdb.ErrDbNotOpen
+	}
+	return &transaction{db: db, root: db.root, writable: true}, nil
+}
+
+// Copy writes a copy of the database to the provided writer.  The copy is
+// written in the bdb file format.  This call will start a read-only transaction
+// to perform all operations.
+//
+// This function is part of the walletdb.Db interface implementation.
+func (db *db) Copy(w io.Writer) error {
+	tx, err := db.BeginReadTx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	root := tx.(*transaction).root
+
+	// The bolt file is created in a temporary file as bolt databases can only
+	// be written to disk.
+	f, err := ioutil.TempFile("", "memdb")
+	if err != nil {
+		return err
+	}
+	path := f.Name()
+	f.Close()
+	defer os.Remove(path)
+
+	boltDB, err := bolt.Open(path, 0600, nil)
+	if err != nil {
+		return err
+	}
+	defer boltDB.Close()
+	err = boltDB.Update(func(boltTx *bolt.Tx) error {
+		for i := range root.entries {
+			e := &root.entries[i]
+			b, err := boltTx.CreateBucket(e.key)
+			if err != nil {
+				return err
+			}
+			err = copyToBolt(b, e.bucket)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return boltDB.View(func(boltTx *bolt.Tx) error {
+		return boltTx.Copy(w)
+	})
+}
+
+// copyToBolt recursively writes all entries of the node n to the bolt bucket b.
+func copyToBolt(b *bolt.Bucket, n *node) error {
+	for i := range n.entries {
+		e := &n.entries[i]
+		if e.bucket == nil {
+			err := b.Put(e.key, e.value)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		nested, err := b.CreateBucket(e.key)
+		if err != nil {
+			return err
+		}
+		err = copyToBolt(nested, e.bucket)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close shuts down the database and discards all data.  Close waits for an open
+// read-write transaction to end.
+//
+// This function is part of the walletdb.Db interface implementation.
+func (db *db) Close() error {
+	db.writeMu.Lock()
+	defer db.writeMu.Unlock()
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.closed = true
+	db.root = nil
+	return nil
+}
+
+// loadDB reads the bdb database at the provided path into a new in-memory
+// database.  walletdb.ErrDbDoesNotExist is returned if the database doesn't
+// exist.
+func loadDB(dbPath string) (walletdb.DB, error) {
+	if _, err := os.Stat(dbPath); os.IsNotExist(err) {
+		return nil, walletdb.ErrDbDoesNotExist
+	}
+
+	boltDB, err := bolt.Open(dbPath, 0600, &bolt.Options{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer boltDB.Close()
+
+	db := newDB()
+	err = boltDB.View(func(boltTx *bolt.Tx) error {
+		return boltTx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			n, err := loadBucket(b)
+			if err != nil {
+				return err
+			}
+			db.root.entries = append(db.root.entries,
+				entry{key: copyBytes(name), bucket: n})
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+// loadBucket recursively reads all keys of the bolt bucket b into a new node.
+// Bolt iterates keys in sorted order, so entries are appended in order.
+func loadBucket(b *bolt.Bucket) (*node, error) {
+	n := new(node)
+	err := b.ForEach(func(k, v []byte) error {
+		e := entry{key: copyBytes(k)}
+		if nested := b.Bucket(k); nested != nil {
+			var err error
+			e.bucket, err = loadBucket(nested)
+			if err != nil {
+				return err
+			}
+		} else {
+			e.value = copyBytes(v)
+		}
+		n.entries = append(n.entries, e)
+		return nil
+	})
+	return n, err
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+/*
+Package memdb implements an instance of walletdb that keeps all data in memory.
+
+Usage
+
+This package is only a driver to the walletdb package and provides the database
+type of "memdb".  The Create function takes no parameters and returns a new
+empty database.  Open takes the path of an existing bdb database, which is read
+into memory.  Changes made to an opened database are never written back to the
+file:
+
+	db, err := walletdb.Create("memdb")
+	if err != nil {
+		// Handle error
+	}
+
+	db, err := walletdb.Open("memdb", "path/to/database.db")
+	if err != nil {
+		// Handle error
+	}
+
+All data is lost when the database is closed or the process exits.  Copy writes
+the database in the bdb file format, so a copy may later be opened by the bdb
+driver.
+
+Read-write transactions are serialized and do not block read transactions.
+Each read transaction observes the database as it was when the transaction
+began.
+*/
+package memdb
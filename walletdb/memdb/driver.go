@@ -0,0 +1,56 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package memdb
+
+import (
+	"fmt"
+
+	"github.com/abcsuite/abcwallet/walletdb"
+)
+
+const (
+	dbType = "memdb"
+)
+
+// openDBDriver is the callback provided during driver registration that reads
+// an existing bdb database into memory.
+func openDBDriver(args ...interface{}) (walletdb.DB, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("invalid arguments to %s.Open -- "+
+			"expected database path", dbType)
+	}
+
+	dbPath, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("first argument to %s.Open is invalid -- "+
+			"expected database path string", dbType)
+	}
+
+	return loadDB(dbPath)
+}
+
+// createDBDriver is the callback provided during driver registration that
+// creates a new empty database.
+func createDBDriver(args ...interface{}) (walletdb.DB, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf("invalid arguments to %s.Create -- "+
+			"expected no arguments", dbType)
+	}
+
+	return newDB(), nil
+}
+
+func init() {
+	// Register the driver.
+	driver := walletdb.Driver{
+		DbType: dbType,
+		Create: createDBDriver,
+		Open:   openDBDriver,
+	}
+	if err := walletdb.RegisterDriver(driver); err != nil {
+		panic(fmt.Sprintf("Failed to register database driver '%s': %v",
+			dbType, err))
+	}
+}
@@ -0,0 +1,333 @@
+// Copyright (c) 2017 The Aero Blockchain developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package memdb_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/abcsuite/abcwallet/walletdb"
+	_ "github.com/abcsuite/abcwallet/walletdb/bdb"
+	_ "github.com/abcsuite/abcwallet/walletdb/memdb"
+)
+
+// dbType is the database type name for this driver.
+const dbType = "memdb"
+
+// TestCreateOpenFail ensures that errors related to creating and opening a
+// database are handled properly.
+func TestCreateOpenFail(t *testing.T) {
+	// Ensure that attempting to open a database that doesn't exist returns
+	// the expected error.
+	wantErr := walletdb.ErrDbDoesNotExist
+	if _, err := walletdb.Open(dbType, "noexist.db"); err != wantErr {
+		t.Errorf("Open: did not receive expected error - got %v, "+
+			"want %v", err, wantErr)
+		return
+	}
+
+	// Ensure that attempting to open a database with the wrong number of
+	// parameters returns the expected error.
+	wantErr = fmt.Errorf("invalid arguments to %s.Open -- expected "+
+		"database path", dbType)
+	if _, err := walletdb.Open(dbType, 1, 2, 3); err.Error() != wantErr.Error() {
+		t.Errorf("Open: did not receive expected error - got %v, "+
+			"want %v", err, wantErr)
+		return
+	}
+
+	// Ensure that attempting to open a database with an invalid type for
+	// the first parameter returns the expected error.
+	wantErr = fmt.Errorf("first argument to %s.Open is invalid -- "+
+		"expected database path string", dbType)
+	if _, err := walletdb.Open(dbType, 1); err.Error() != wantErr.Error() {
+		t.Errorf("Open: did not receive expected error - got %v, "+
+			"want %v", err, wantErr)
+		return
+	}
+
+	// Ensure that attempting to create a database with any parameters
+	// returns the expected error.
+	wantErr = fmt.Errorf("invalid arguments to %s.Create -- expected "+
+		"no arguments", dbType)
+	if _, err := walletdb.Create(dbType, "path.db"); err.Error() != wantErr.Error() {
+		t.Errorf("Create: did not receive expected error - got %v, "+
+			"want %v", err, wantErr)
+		return
+	}
+
+	// Ensure operations against a closed database return the expected
+	// error.
+	db, err := walletdb.Create(dbType)
+	if err != nil {
+		t.Errorf("Create: unexpected error: %v", err)
+		return
+	}
+	db.Close()
+
+	wantErr = walletdb.ErrDbNotOpen
+	if _, err := db.BeginReadTx(); err != wantErr {
+		t.Errorf("BeginReadTx: did not receive expected error - got %v, "+
+			"want %v", err, wantErr)
+		return
+	}
+	if _, err := db.BeginReadWriteTx(); err != wantErr {
+		t.Errorf("BeginReadWriteTx: did not receive expected error - "+
+			"got %v, want %v", err, wantErr)
+		return
+	}
+}
+
+// TestInterface performs all interfaces tests for this database driver.
+func TestInterface(t *testing.T) {
+	// Create a new database to run tests against.
+	db, err := walletdb.Create(dbType)
+	if err != nil {
+		t.Errorf("Failed to create test database (%s) %v", dbType, err)
+		return
+	}
+	defer db.Close()
+
+	// Run all of the interface tests against the database.
+	testInterface(t, db)
+}
+
+// TestCursor ensures cursors iterate keys and nested buckets in order in both
+// directions and can delete keys while iterating.
+func TestCursor(t *testing.T) {
+	db, err := walletdb.Create(dbType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	nsKey := []byte("ns")
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		b, err := tx.CreateTopLevelBucket(nsKey)
+		if err != nil {
+			return err
+		}
+		for _, k := range []string{"d", "b", "a", "e"} {
+			err = b.Put([]byte(k), []byte("v"+k))
+			if err != nil {
+				return err
+			}
+		}
+		_, err = b.CreateBucket([]byte("c"))
+		if err != nil {
+			return err
+		}
+
+		var keys []string
+		c := b.ReadWriteCursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			keys = append(keys, string(k))
+			if string(k) == "c" && v != nil {
+				t.Errorf("nested bucket has non-nil value %x", v)
+			}
+		}
+		if want := []string{"a", "b", "c", "d", "e"}; !reflect.DeepEqual(keys, want) {
+			t.Errorf("forward iteration got %v, want %v", keys, want)
+		}
+
+		keys = keys[:0]
+		for k, _ := c.Last(); k != nil; k, _ = c.Prev() {
+			keys = append(keys, string(k))
+		}
+		if want := []string{"e", "d", "c", "b", "a"}; !reflect.DeepEqual(keys, want) {
+			t.Errorf("backward iteration got %v, want %v", keys, want)
+		}
+
+		if k, v := c.Seek([]byte("bb")); string(k) != "c" || v != nil {
+			t.Errorf("Seek got %s, want c", k)
+		}
+		if err := c.Delete(); err != walletdb.ErrIncompatibleValue {
+			t.Errorf("Delete of nested bucket: got %v, want %v", err,
+				walletdb.ErrIncompatibleValue)
+		}
+
+		// Delete every key holding a value while iterating.
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			if v == nil {
+				continue
+			}
+			err := c.Delete()
+			if err != nil {
+				return err
+			}
+		}
+		keys = keys[:0]
+		err = b.ForEach(func(k, v []byte) error {
+			keys = append(keys, string(k))
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if want := []string{"c"}; !reflect.DeepEqual(keys, want) {
+			t.Errorf("keys after cursor deletes got %v, want %v", keys, want)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestSnapshot ensures a read transaction observes the database as it was when
+// the transaction began, and that rolled back changes to nested buckets are
+// discarded.
+func TestSnapshot(t *testing.T) {
+	db, err := walletdb.Create(dbType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	nsKey, nestedKey, key := []byte("ns"), []byte("nested"), []byte("key")
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		b, err := tx.CreateTopLevelBucket(nsKey)
+		if err != nil {
+			return err
+		}
+		nested, err := b.CreateBucket(nestedKey)
+		if err != nil {
+			return err
+		}
+		return nested.Put(key, []byte("old"))
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rtx, err := db.BeginReadTx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rtx.Rollback()
+
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		nested := tx.ReadWriteBucket(nsKey).NestedReadWriteBucket(nestedKey)
+		return nested.Put(key, []byte("new"))
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rollbackErr := fmt.Errorf("rollback")
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		nested := tx.ReadWriteBucket(nsKey).NestedReadWriteBucket(nestedKey)
+		err := nested.Put(key, []byte("discarded"))
+		if err != nil {
+			return err
+		}
+		return rollbackErr
+	})
+	if err != rollbackErr {
+		t.Fatalf("Update: got %v, want %v", err, rollbackErr)
+	}
+
+	v := rtx.ReadBucket(nsKey).NestedReadBucket(nestedKey).Get(key)
+	if string(v) != "old" {
+		t.Errorf("snapshot value is %q, want %q", v, "old")
+	}
+	err = walletdb.View(db, func(tx walletdb.ReadTx) error {
+		v := tx.ReadBucket(nsKey).NestedReadBucket(nestedKey).Get(key)
+		if string(v) != "new" {
+			t.Errorf("committed value is %q, want %q", v, "new")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestCopy ensures a copy of the database can be opened by the bdb driver and
+// read back into memory.
+func TestCopy(t *testing.T) {
+	dir, err := ioutil.TempDir("", "memdb_TestCopy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	db, err := walletdb.Create(dbType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	nsKey, nestedKey := []byte("ns"), []byte("nested")
+	values := map[string][]byte{
+		"key1":  []byte("value1"),
+		"key2":  []byte("value2"),
+		"empty": {},
+	}
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		b, err := tx.CreateTopLevelBucket(nsKey)
+		if err != nil {
+			return err
+		}
+		nested, err := b.CreateBucket(nestedKey)
+		if err != nil {
+			return err
+		}
+		for k, v := range values {
+			err = nested.Put([]byte(k), v)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	err = db.Copy(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "copy.db")
+	err = ioutil.WriteFile(path, buf.Bytes(), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, driver := range []string{"bdb", dbType} {
+		copyDB, err := walletdb.Open(driver, path)
+		if err != nil {
+			t.Fatalf("Open %s: %v", driver, err)
+		}
+		err = walletdb.View(copyDB, func(tx walletdb.ReadTx) error {
+			b := tx.ReadBucket(nsKey)
+			if b == nil {
+				return fmt.Errorf("missing bucket %s", nsKey)
+			}
+			nested := b.NestedReadBucket(nestedKey)
+			if nested == nil {
+				return fmt.Errorf("missing nested bucket %s", nestedKey)
+			}
+			for k, v := range values {
+				got := nested.Get([]byte(k))
+				if !bytes.Equal(got, v) {
+					return fmt.Errorf("key %s has value %q, want %q",
+						k, got, v)
+				}
+			}
+			return nil
+		})
+		copyDB.Close()
+		if err != nil {
+			t.Errorf("%s copy: %v", driver, err)
+		}
+	}
+}